@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	accountrepo "wechat-clone/core/modules/account/infra/persistent/repository"
+	accountes "wechat-clone/core/modules/account/infra/projection/elasticsearch"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/infra/db"
+	sharedes "wechat-clone/core/shared/infra/elasticsearch"
+	"wechat-clone/core/shared/pkg/logging"
+
+	"go.uber.org/zap"
+)
+
+// account-reindex fills the configured account search index from the
+// accounts table. Run it after ELASTICSEARCH_ACCOUNT_INDEX moves to a new
+// index version; pass -drop-index with the previous index to delete it once
+// the new one is filled.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := logging.FromContext(ctx)
+
+	batchSize := flag.Int("batch", 500, "accounts read per page")
+	dropIndex := flag.String("drop-index", "", "previous account index to delete after the reindex")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(ctx)
+	if err != nil {
+		logger.Errorw("Failed to load config", zap.Error(err))
+		os.Exit(1)
+	}
+	if !cfg.ElasticsearchConfig.Enabled {
+		logger.Warnw("Elasticsearch is disabled, nothing to reindex")
+		return
+	}
+
+	conn, err := db.NewConnection(ctx, cfg)
+	if err != nil {
+		logger.Errorw("Failed to connect database", zap.Error(err))
+		os.Exit(1)
+	}
+	client, err := sharedes.NewClient(cfg.ElasticsearchConfig)
+	if err != nil {
+		logger.Errorw("Failed to create elasticsearch client", zap.Error(err))
+		os.Exit(1)
+	}
+	search, err := accountes.NewAccountSearchProjection(cfg.ElasticsearchConfig, client, accountrepo.NewFindabilityRepoImpl(conn))
+	if err != nil {
+		logger.Errorw("Failed to prepare account search index", zap.Error(err))
+		os.Exit(1)
+	}
+
+	indexed, err := accountprojection.ReindexAccounts(ctx, accountrepo.NewAccountScannerImpl(conn), search, *batchSize)
+	if err != nil {
+		logger.Errorw("Failed to reindex accounts", zap.Error(err), zap.Int("indexed", indexed))
+		os.Exit(1)
+	}
+	logger.Infow("Account reindex completed", "accounts", indexed, "index", cfg.ElasticsearchConfig.AccountIndex)
+
+	if *dropIndex != "" && *dropIndex != cfg.ElasticsearchConfig.AccountIndex {
+		if err := accountes.DropAccountSearchIndex(ctx, client, *dropIndex); err != nil {
+			logger.Errorw("Failed to drop previous account index", zap.Error(err))
+			os.Exit(1)
+		}
+		logger.Infow("Dropped previous account index", "index", *dropIndex)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	ledgerrepo "wechat-clone/core/modules/ledger/infra/persistent/repository"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/logging"
+
+	"go.uber.org/zap"
+)
+
+// ledger-audit replays every ledger account stream, checks it against
+// snapshots, the ledger_entries projection and double-entry balance, and
+// writes a JSON report with a trial balance to stdout. All reads run in one
+// read-only transaction. It exits 2 when drift is found.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := logging.FromContext(ctx)
+
+	cfg, err := config.LoadConfig(ctx)
+	if err != nil {
+		logger.Errorw("Failed to load config", zap.Error(err))
+		os.Exit(1)
+	}
+
+	conn, err := db.NewConnection(ctx, cfg)
+	if err != nil {
+		logger.Errorw("Failed to connect database", zap.Error(err))
+		os.Exit(1)
+	}
+
+	auditService := ledgerservice.NewLedgerAuditService(ledgerrepo.NewLedgerAuditRepoImpl(conn))
+	report, err := auditService.RunAudit(ctx)
+	if err != nil {
+		logger.Errorw("Failed to run ledger audit", zap.Error(err))
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Errorw("Failed to write audit report", zap.Error(err))
+		os.Exit(1)
+	}
+
+	if !report.Healthy {
+		logger.Warnw("Ledger audit found drift", "drifts", len(report.Drifts))
+		os.Exit(2)
+	}
+	logger.Infow("Ledger audit completed", "accounts", report.AccountsChecked, "transactions", report.TransactionsChecked)
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	appCtx "wechat-clone/core/context"
 	accountassembly "wechat-clone/core/modules/account/assembly"
+	foreignexchangeassembly "wechat-clone/core/modules/foreign_exchange/assembly"
 	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
 	notificationassembly "wechat-clone/core/modules/notification/assembly"
 	paymentassembly "wechat-clone/core/modules/payment/assembly"
@@ -58,6 +59,7 @@ func main() {
 
 	appServer := apptransport.NewServer(cfg, apptransport.WithHTTPModuleBuilders(
 		accountassembly.BuildHTTPServer,
+		foreignexchangeassembly.BuildHTTPServer,
 		ledgerassembly.BuildHTTPServer,
 		notificationassembly.BuildHTTPServer,
 		paymentassembly.BuildHTTPServer,
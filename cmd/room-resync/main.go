@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	roomprojection "wechat-clone/core/modules/room/application/projection"
+	roomrepo "wechat-clone/core/modules/room/infra/persistent/repository"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/logging"
+
+	"go.uber.org/zap"
+)
+
+// room-resync publishes a fresh projection event for every group room
+// through the room outbox. Run it once after migration 000082 so friend
+// suggestions mirror the group rooms that existed before they were fed by
+// room events; the relationship consumer recounts shared rooms as the events
+// arrive.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := logging.FromContext(ctx)
+
+	batchSize := flag.Int("batch", 500, "rooms read per page")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(ctx)
+	if err != nil {
+		logger.Errorw("Failed to load config", zap.Error(err))
+		os.Exit(1)
+	}
+
+	conn, err := db.NewConnection(ctx, cfg)
+	if err != nil {
+		logger.Errorw("Failed to connect database", zap.Error(err))
+		os.Exit(1)
+	}
+
+	published, err := roomprojection.ResyncGroupRooms(ctx, roomrepo.NewGroupRoomRepublisherImpl(conn), *batchSize)
+	if err != nil {
+		logger.Errorw("Failed to resync group rooms", zap.Error(err), zap.Int("published", published))
+		os.Exit(1)
+	}
+	logger.Infow("Group room resync completed", "rooms", published)
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/aggregate"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/domain/rules"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/contxt"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const adminForceLogoutReason = "admin_force_logout"
+
+type adminBanState struct {
+	BannedReason string `json:"banned_reason"`
+	BannedUntil  string `json:"banned_until,omitempty"`
+}
+
+type adminProfileState struct {
+	DisplayName     string `json:"display_name"`
+	AvatarObjectKey string `json:"avatar_object_key"`
+}
+
+type adminSessionState struct {
+	ActiveSessions int `json:"active_sessions"`
+}
+
+func loadModerationTarget(ctx context.Context, baseRepo repos.Repos, actorAccountID, targetAccountID string) (*aggregate.AccountAggregate, error) {
+	targetAccountID = strings.TrimSpace(targetAccountID)
+	if targetAccountID == strings.TrimSpace(actorAccountID) {
+		return nil, stackErr.Error(ErrAdminSelfModeration)
+	}
+
+	accountAgg, err := baseRepo.AccountAggregateRepository().Load(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(ErrAdminAccountNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	if !accountAgg.IsRegistered() {
+		return nil, stackErr.Error(ErrAdminAccountNotFound)
+	}
+	return accountAgg, nil
+}
+
+func appendAdminAuditLog(
+	ctx context.Context,
+	txRepos repos.Repos,
+	actorAccountID string,
+	action accounttypes.AdminAuditAction,
+	targetAccountID string,
+	before interface{},
+	after interface{},
+	reason string,
+	now time.Time,
+) error {
+	auditLog, err := entity.NewAdminAuditLog(
+		uuid.NewString(),
+		actorAccountID,
+		action,
+		targetAccountID,
+		before,
+		after,
+		reason,
+		contxt.RequestIDFromCtx(ctx),
+		now,
+	)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(txRepos.AdminAuditLogRepository().Append(ctx, auditLog))
+}
+
+// revokeAccountSessions revokes every live session of the account and reports
+// how many were changed. Access tokens already issued stay valid until they
+// expire, but no session can be refreshed afterwards.
+func revokeAccountSessions(ctx context.Context, txRepos repos.Repos, accountID, reason string, now time.Time) (int, error) {
+	sessionAggs, err := txRepos.SessionAggregateRepository().ListByAccountID(ctx, accountID)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	revoked := 0
+	for _, sessionAgg := range sessionAggs {
+		changed, err := sessionAgg.Revoke(reason, now)
+		if err != nil {
+			return 0, stackErr.Error(err)
+		}
+		if !changed {
+			continue
+		}
+		if err := txRepos.SessionAggregateRepository().Save(ctx, sessionAgg); err != nil {
+			return 0, stackErr.Error(err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+func banStateOf(account *entity.Account) adminBanState {
+	return adminBanState{
+		BannedReason: account.BannedReason,
+		BannedUntil:  utils.FormatOptionalTime(account.BannedUntil),
+	}
+}
+
+func profileStateOf(account *entity.Account) adminProfileState {
+	return adminProfileState{
+		DisplayName:     account.DisplayName,
+		AvatarObjectKey: utils.StringValue(account.AvatarObjectKey),
+	}
+}
+
+func mapModerationDomainError(err error) error {
+	switch {
+	case errors.Is(err, rules.ErrAccountNotBanned):
+		return ErrAdminInvalidState
+	case errors.Is(err, rules.ErrAccountBanUntilInPast):
+		return ErrAdminBannedUntilValue
+	default:
+		return err
+	}
+}
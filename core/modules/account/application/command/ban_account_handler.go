@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type banAccountHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewBanAccountHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.BanAccountRequest, *out.BanAccountResponse] {
+	return &banAccountHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *banAccountHandler) Handle(ctx context.Context, req *in.BanAccountRequest) (*out.BanAccountResponse, error) {
+	log := logging.FromContext(ctx).Named("BanAccount")
+
+	admin, err := support.AdminFromRepo(ctx, u.baseRepo)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := time.Now().UTC()
+	var bannedUntil *time.Time
+	if req.BannedUntil != "" {
+		parsed, err := time.Parse(time.RFC3339, req.BannedUntil)
+		if err != nil || !parsed.After(now) {
+			return nil, stackErr.Error(ErrAdminBannedUntilValue)
+		}
+		bannedUntil = &parsed
+	}
+
+	accountAgg, err := loadModerationTarget(ctx, u.baseRepo, admin.AccountID, req.AccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	before, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if err := accountAgg.Ban(req.Reason, bannedUntil, now); err != nil {
+		return nil, stackErr.Error(mapModerationDomainError(err))
+	}
+	after, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	revoked := 0
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if err := txRepos.AccountAggregateRepository().Save(ctx, accountAgg); err != nil {
+			return stackErr.Error(err)
+		}
+		revoked, err = revokeAccountSessions(ctx, txRepos, after.ID, adminForceLogoutReason, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		return appendAdminAuditLog(
+			ctx,
+			txRepos,
+			admin.AccountID,
+			accounttypes.AdminAuditActionBanAccount,
+			after.ID,
+			banStateOf(before),
+			banStateOf(after),
+			req.Reason,
+			now,
+		)
+	}); txErr != nil {
+		log.Errorw("Failed to persist account ban", zap.String("target_account_id", after.ID), zap.Error(txErr))
+		return nil, stackErr.Error(txErr)
+	}
+
+	return &out.BanAccountResponse{
+		AccountID:       after.ID,
+		BannedReason:    after.BannedReason,
+		BannedUntil:     utils.FormatOptionalTime(after.BannedUntil),
+		RevokedSessions: revoked,
+	}, nil
+}
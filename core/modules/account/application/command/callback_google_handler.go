@@ -14,7 +14,8 @@ import (
 	"wechat-clone/core/modules/account/domain/entity"
 	repos "wechat-clone/core/modules/account/domain/repos"
 	valueobject "wechat-clone/core/modules/account/domain/value_object"
-	"wechat-clone/core/shared/infra/xpaseto"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/infra/geoip"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/logging"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -26,24 +27,28 @@ import (
 
 type callbackGoogleHandler struct {
 	baseRepo             repos.Repos
-	paseto               xpaseto.PasetoService
 	authProviderRegistry *provider.AuthProviderRegistry
+	risk                 loginRiskDependencies
+	sessions             loginSessionIssuer
 }
 
 func NewCallbackGoogle(
 	appCtx *appCtx.AppContext,
 	baseRepo repos.Repos,
 	authProviderRegistry *provider.AuthProviderRegistry,
+	geo geoip.Resolver,
 ) cqrs.Handler[*in.CallbackGoogleRequest, *out.CallbackGoogleResponse] {
+	risk := newLoginRiskDependencies(appCtx, geo)
 	return &callbackGoogleHandler{
 		baseRepo:             baseRepo,
-		paseto:               appCtx.GetPaseto(),
 		authProviderRegistry: authProviderRegistry,
+		risk:                 risk,
+		sessions:             newLoginSessionIssuer(appCtx, risk),
 	}
 }
 
 func (u *callbackGoogleHandler) Handle(ctx context.Context, req *in.CallbackGoogleRequest) (*out.CallbackGoogleResponse, error) {
-	log := logging.FromContext(ctx)
+	log := logging.FromContext(ctx).Named("CallbackGoogle")
 	googleProvider, err := u.authProviderRegistry.Get("google")
 	if err != nil {
 		return nil, stackErr.Error(err)
@@ -62,15 +67,15 @@ func (u *callbackGoogleHandler) Handle(ctx context.Context, req *in.CallbackGoog
 		return nil, stackErr.Error(err)
 	}
 	now := time.Now().UTC()
-	var res out.CallbackGoogleResponse
+	var accountAgg *aggregate.AccountAggregate
 	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
 		accountRepo := txRepos.AccountAggregateRepository()
-		accountAgg, err := accountRepo.LoadByEmail(ctx, email.Value())
+		loaded, err := accountRepo.LoadByEmail(ctx, email.Value())
 		if err != nil {
 			if !errors.Is(err, gorm.ErrRecordNotFound) {
 				return stackErr.Error(fmt.Errorf("load account aggregate by email: %w", err))
 			}
-			accountAgg, err = aggregate.NewAccountAggregate(uuid.NewString())
+			loaded, err = aggregate.NewAccountAggregate(uuid.NewString())
 			if err != nil {
 				return stackErr.Error(err)
 			}
@@ -78,99 +83,93 @@ func (u *callbackGoogleHandler) Handle(ctx context.Context, req *in.CallbackGoog
 			if displayName == "" {
 				displayName = userInfo.Email
 			}
-			if err := accountAgg.OpenRegister(email.Value(), displayName, userInfo.Picture, now); err != nil {
+			if err := loaded.OpenRegister(email.Value(), displayName, userInfo.Picture, now); err != nil {
 				return stackErr.Error(err)
 			}
-			if err := accountRepo.Save(ctx, accountAgg); err != nil {
+			if err := accountRepo.Save(ctx, loaded); err != nil {
 				return stackErr.Error(fmt.Errorf("save account: %w", err))
 			}
-		} else if !accountAgg.IsEmailVerified() {
-			if err := accountAgg.ConfirmEmailVerified(email, now); err != nil {
+		} else if !loaded.IsEmailVerified() {
+			if err := loaded.ConfirmEmailVerified(email, now); err != nil {
 				return stackErr.Error(err)
 			}
-			if err := accountRepo.Save(ctx, accountAgg); err != nil {
+			if err := accountRepo.Save(ctx, loaded); err != nil {
 				return stackErr.Error(fmt.Errorf("save account: %w", err))
 			}
 		}
+		accountAgg = loaded
+		return nil
+	}); txErr != nil {
+		log.Errorw("Google login failed", zap.Error(txErr), zap.Any("userInfo", userInfo))
+		return nil, stackErr.Error(txErr)
+	}
 
-		snapshot, err := accountAgg.Snapshot()
-		if err != nil {
-			return stackErr.Error(err)
-		}
-		deviceReg := entity.DeviceRegistration{
-			DeviceUID: req.DeviceUid, DeviceName: req.DeviceName, DeviceType: req.DeviceType,
-			OSName: req.OsName, OSVersion: req.OsVersion, AppVersion: req.AppVersion,
-			UserAgent: req.UserAgent, IPAddress: req.IpAddress,
-		}
-		deviceAgg, err := txRepos.DeviceAggregateRepository().FindByAccountAndUID(ctx, snapshot.ID, req.DeviceUid)
-		if err != nil {
-			if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return stackErr.Error(fmt.Errorf("load device: %w", err))
-			}
-			deviceAgg, err = aggregate.NewDeviceAggregate(uuid.NewString())
-			if err != nil {
-				return stackErr.Error(err)
-			}
-			if err := deviceAgg.Register(snapshot.ID, deviceReg, now); err != nil {
-				return stackErr.Error(err)
-			}
-		} else if err := deviceAgg.RefreshRegistration(deviceReg, now); err != nil {
-			return stackErr.Error(err)
+	if accountAgg.IsLocked(now) {
+		log.Warnw("Google login rejected for locked account", zap.String("account_id", accountAgg.AccountID))
+		return nil, stackErr.Error(ErrAccountLocked)
+	}
+	if accountAgg.IsBanned(now) {
+		log.Warnw("Google login rejected for banned account", zap.String("account_id", accountAgg.AccountID))
+		return nil, stackErr.Error(ErrAccountBanned)
+	}
+
+	// A Google sign-in proves the mailbox, not the device or the location, so
+	// it goes through the same risk evaluation as a password login.
+	evaluation, err := u.risk.Evaluate(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, now)
+	if err != nil {
+		log.Errorw("Failed to evaluate login risk", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	deviceReg := entity.DeviceRegistration{
+		DeviceUID: req.DeviceUid, DeviceName: req.DeviceName, DeviceType: req.DeviceType,
+		OSName: req.OsName, OSVersion: req.OsVersion, AppVersion: req.AppVersion,
+		UserAgent: req.UserAgent, IPAddress: req.IpAddress,
+	}
+
+	switch evaluation.Assessment.Decision {
+	case accounttypes.LoginRiskDecisionLock:
+		log.Warnw("Google login locked by risk evaluation",
+			zap.String("account_id", accountAgg.AccountID),
+			zap.Int("risk_score", evaluation.Assessment.Score),
+			zap.Any("risk_signals", evaluation.Assessment.Signals),
+		)
+		if err := u.risk.RecordAttempt(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, req.UserAgent, accounttypes.LoginAttemptOutcomeLocked, evaluation, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-		if err := txRepos.DeviceAggregateRepository().Save(ctx, deviceAgg); err != nil {
-			return stackErr.Error(fmt.Errorf("save device: %w", err))
+		if _, err := u.risk.Lock(ctx, u.baseRepo, accountAgg, loginRiskLockReason, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-
-		sessionID := uuid.NewString()
-		accessToken, accessExp, refreshToken, refreshExp, err := u.issueTokenPair(ctx, u.paseto, *snapshot, xpaseto.RefreshTokenSubject{
-			SessionID: sessionID,
-			DeviceID:  deviceAgg.DeviceID(),
-		})
+		return nil, stackErr.Error(ErrAccountLocked)
+	case accounttypes.LoginRiskDecisionStepUp:
+		snapshot, err := accountAgg.Snapshot()
 		if err != nil {
-			return stackErr.Error(err)
+			return nil, stackErr.Error(err)
 		}
-		sessionAgg, err := aggregate.NewSessionAggregate(sessionID)
+		token, expiresAt, err := u.risk.IssueStepUpChallenge(ctx, snapshot, deviceReg, req.IpAddress, req.UserAgent, evaluation, now)
 		if err != nil {
-			return stackErr.Error(err)
-		}
-		if err := sessionAgg.Create(snapshot.ID, deviceAgg.DeviceID(), refreshToken, refreshExp, now, req.IpAddress, req.UserAgent); err != nil {
-			return stackErr.Error(err)
+			log.Errorw("Failed to issue step-up challenge", zap.Error(err))
+			return nil, stackErr.Error(err)
 		}
-		if err := txRepos.SessionAggregateRepository().Save(ctx, sessionAgg); err != nil {
-			return stackErr.Error(fmt.Errorf("save session: %w", err))
+		if err := u.risk.RecordAttempt(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, req.UserAgent, accounttypes.LoginAttemptOutcomeStepUpRequired, evaluation, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-
-		res = out.CallbackGoogleResponse{
-			AccessToken:      accessToken,
-			AccessExpiresAt:  accessExp.UnixMilli(),
-			RefreshToken:     refreshToken,
-			RefreshExpiresAt: refreshExp.UnixMilli(),
-		}
-		return nil
-	}); txErr != nil {
-		log.Errorw("Google login failed", zap.Error(txErr), zap.Any("userInfo", userInfo))
-		return nil, stackErr.Error(txErr)
+		return &out.CallbackGoogleResponse{
+			StepUpRequired:  true,
+			StepUpToken:     token,
+			StepUpExpiresAt: expiresAt.UnixMilli(),
+		}, nil
 	}
 
-	return &res, nil
-}
-
-func (u *callbackGoogleHandler) issueTokenPair(
-	ctx context.Context,
-	pasetoSvc xpaseto.PasetoService,
-	account entity.Account,
-	subject xpaseto.RefreshTokenSubject,
-) (string, time.Time, string, time.Time, error) {
-	if account.ID == "" {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("account snapshot is required"))
-	}
-	accessToken, accessExpiresAt, err := pasetoSvc.GenerateAccessToken(ctx, &account)
-	if err != nil {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("generate access token failed: %w", err))
-	}
-	refreshToken, refreshExpiresAt, err := pasetoSvc.GenerateRefreshToken(ctx, &account, subject)
+	tokens, err := u.sessions.Issue(ctx, u.baseRepo, accountAgg, deviceReg, req.IpAddress, req.UserAgent, evaluation, now)
 	if err != nil {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("generate refresh token failed: %w", err))
+		log.Errorw("Google login failed", zap.Error(err), zap.Any("userInfo", userInfo))
+		return nil, stackErr.Error(err)
 	}
-	return accessToken, accessExpiresAt, refreshToken, refreshExpiresAt, nil
+
+	return &out.CallbackGoogleResponse{
+		AccessToken:      tokens.AccessToken,
+		AccessExpiresAt:  tokens.AccessExpiresAt.UnixMilli(),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt.UnixMilli(),
+	}, nil
 }
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type claimUsernameHandler struct {
+	baseRepo  repos.Repos
+	usernames usernamePolicy
+}
+
+func NewClaimUsernameHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.ClaimUsernameRequest, *out.ClaimUsernameResponse] {
+	return &claimUsernameHandler{
+		baseRepo:  baseRepo,
+		usernames: newUsernamePolicy(appCtx.GetConfig().AuthConfig.Username),
+	}
+}
+
+func (u *claimUsernameHandler) Handle(ctx context.Context, req *in.ClaimUsernameRequest) (*out.ClaimUsernameResponse, error) {
+	log := logging.FromContext(ctx).Named("ClaimUsername")
+	now := time.Now().UTC()
+
+	accountID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := u.baseRepo.AccountAggregateRepository().Load(ctx, accountID)
+	if err != nil {
+		log.Errorw("Failed to load account aggregate", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		changed, err := u.usernames.Claim(ctx, txRepos, accountAgg, req.Username, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if !changed {
+			return nil
+		}
+		return stackErr.Error(txRepos.AccountAggregateRepository().Save(ctx, accountAgg))
+	}); txErr != nil {
+		log.Warnw("Failed to claim username", zap.Error(txErr))
+		return nil, stackErr.Error(mapUsernameDomainError(txErr))
+	}
+
+	return &out.ClaimUsernameResponse{
+		ID:                    accountAgg.AggregateID(),
+		Username:              utils.StringValue(accountAgg.Username),
+		UsernameChangedAt:     utils.FormatOptionalTime(accountAgg.UsernameChangedAt),
+		NextChangeAvailableAt: utils.FormatOptionalTime(accountAgg.UsernameCooldownEndsAt(u.usernames.cooldown, now)),
+	}, nil
+}
@@ -2,6 +2,9 @@ package command
 
 import (
 	"errors"
+	"net/http"
+
+	"wechat-clone/core/shared/pkg/apperr"
 )
 
 var (
@@ -16,4 +19,18 @@ var (
 	ErrRefreshTokenInvalid       = errors.New("refresh token is invalid")
 	ErrRefreshSessionExpired     = errors.New("refresh session expired")
 	ErrRefreshSessionRevoked     = errors.New("refresh session revoked")
+
+	ErrAccountBanned          = apperr.New("account.banned", "account is banned", http.StatusForbidden)
+	ErrAccountLocked          = apperr.New("account.locked", "account is temporarily locked, try again later", http.StatusLocked)
+	ErrStepUpChallengeInvalid = apperr.New("account.step_up_invalid", "verification code is invalid or expired", http.StatusUnauthorized)
+	ErrLoginAlertTokenInvalid = apperr.New("account.login_alert_token_invalid", "link is invalid or has already been used", http.StatusBadRequest)
+	ErrUsernameInvalid        = apperr.New("account.username_invalid", "username must be 3-30 characters of letters, digits, underscores or dots", http.StatusBadRequest)
+	ErrUsernameReserved       = apperr.New("account.username_reserved", "username is reserved", http.StatusConflict)
+	ErrUsernameCooldown       = apperr.New("account.username_cooldown", "username was changed recently, try again later", http.StatusConflict)
+	ErrProfileDetailsInvalid  = apperr.New("account.profile_invalid", "profile details are invalid", http.StatusBadRequest)
+	ErrAdminAccountNotFound   = apperr.New("account.not_found", "account not found", http.StatusNotFound)
+	ErrAdminInvalidState      = apperr.New("account.invalid_state", "account moderation action is not valid for the current state", http.StatusConflict)
+	ErrAdminSelfModeration    = apperr.New("account.self_moderation", "admins cannot moderate their own account", http.StatusForbidden)
+	ErrAdminNothingToReset    = apperr.New("account.nothing_to_reset", "select at least one profile field to reset", http.StatusBadRequest)
+	ErrAdminBannedUntilValue  = apperr.New("account.invalid_banned_until", "banned_until must be an RFC3339 timestamp in the future", http.StatusBadRequest)
 )
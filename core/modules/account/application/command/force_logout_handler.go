@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+type forceLogoutHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewForceLogoutHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.ForceLogoutRequest, *out.ForceLogoutResponse] {
+	return &forceLogoutHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *forceLogoutHandler) Handle(ctx context.Context, req *in.ForceLogoutRequest) (*out.ForceLogoutResponse, error) {
+	log := logging.FromContext(ctx).Named("ForceLogout")
+
+	admin, err := support.AdminFromRepo(ctx, u.baseRepo)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := loadModerationTarget(ctx, u.baseRepo, admin.AccountID, req.AccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	targetAccountID := accountAgg.AggregateID()
+
+	now := time.Now().UTC()
+	revoked := 0
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		revoked, err = revokeAccountSessions(ctx, txRepos, targetAccountID, adminForceLogoutReason, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		return appendAdminAuditLog(
+			ctx,
+			txRepos,
+			admin.AccountID,
+			accounttypes.AdminAuditActionForceLogout,
+			targetAccountID,
+			adminSessionState{ActiveSessions: revoked},
+			adminSessionState{ActiveSessions: 0},
+			req.Reason,
+			now,
+		)
+	}); txErr != nil {
+		log.Errorw("Failed to force logout account", zap.String("target_account_id", targetAccountID), zap.Error(txErr))
+		return nil, stackErr.Error(txErr)
+	}
+
+	return &out.ForceLogoutResponse{
+		AccountID:       targetAccountID,
+		RevokedSessions: revoked,
+	}, nil
+}
@@ -9,17 +9,16 @@ import (
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/account/application/dto/in"
 	"wechat-clone/core/modules/account/application/dto/out"
-	"wechat-clone/core/modules/account/domain/aggregate"
 	"wechat-clone/core/modules/account/domain/entity"
 	repos "wechat-clone/core/modules/account/domain/repos"
 	valueobject "wechat-clone/core/modules/account/domain/value_object"
-	"wechat-clone/core/shared/infra/xpaseto"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/infra/geoip"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/hasher"
 	"wechat-clone/core/shared/pkg/logging"
 	"wechat-clone/core/shared/pkg/stackErr"
 
-	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -27,14 +26,17 @@ import (
 type loginHandler struct {
 	baseRepo repos.Repos
 	hasher   hasher.Hasher
-	paseto   xpaseto.PasetoService
+	risk     loginRiskDependencies
+	sessions loginSessionIssuer
 }
 
-func NewLoginHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.LoginRequest, *out.LoginResponse] {
+func NewLoginHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos, geo geoip.Resolver) cqrs.Handler[*in.LoginRequest, *out.LoginResponse] {
+	risk := newLoginRiskDependencies(appCtx, geo)
 	return &loginHandler{
 		baseRepo: baseRepo,
 		hasher:   appCtx.GetHasher(),
-		paseto:   appCtx.GetPaseto(),
+		risk:     risk,
+		sessions: newLoginSessionIssuer(appCtx, risk),
 	}
 }
 
@@ -60,6 +62,11 @@ func (u *loginHandler) Handle(ctx context.Context, req *in.LoginRequest) (*out.L
 		log.Errorw("Login failed", zap.Error(err), zap.String("email", req.Email))
 		return nil, stackErr.Error(fmt.Errorf("load account aggregate by email failed: %w", err))
 	}
+	// Checked before the password so a locked account cannot be brute-forced.
+	if accountAgg.IsLocked(now) {
+		log.Warnw("Login rejected for locked account", zap.String("account_id", accountAgg.AccountID))
+		return nil, stackErr.Error(ErrAccountLocked)
+	}
 
 	currentHash, err := accountAgg.CurrentPasswordHash()
 	if err != nil {
@@ -71,105 +78,74 @@ func (u *loginHandler) Handle(ctx context.Context, req *in.LoginRequest) (*out.L
 	}
 	if !valid {
 		log.Errorw("Invalid credentials", zap.String("email", req.Email))
+		locked, err := u.risk.RecordFailedPassword(ctx, u.baseRepo, accountAgg, req.DeviceUid, req.IpAddress, req.UserAgent, accounttypes.LoginAttemptOutcomeInvalidPassword, now)
+		if err != nil {
+			log.Errorw("Failed to record failed login attempt", zap.Error(err))
+		} else if locked {
+			log.Warnw("Account locked after failed password burst", zap.String("account_id", accountAgg.AccountID))
+		}
 		return nil, stackErr.Error(ErrInvalidCredentials)
 	}
+	if accountAgg.IsBanned(now) {
+		log.Warnw("Login rejected for banned account", zap.String("account_id", accountAgg.AccountID))
+		return nil, stackErr.Error(ErrAccountBanned)
+	}
 
-	snapshot, err := accountAgg.Snapshot()
+	evaluation, err := u.risk.Evaluate(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, now)
 	if err != nil {
+		log.Errorw("Failed to evaluate login risk", zap.Error(err))
 		return nil, stackErr.Error(err)
 	}
+	deviceReg := entity.DeviceRegistration{
+		DeviceUID: req.DeviceUid, DeviceName: req.DeviceName, DeviceType: req.DeviceType,
+		OSName: req.OsName, OSVersion: req.OsVersion, AppVersion: req.AppVersion,
+		UserAgent: req.UserAgent, IPAddress: req.IpAddress,
+	}
 
-	var res out.LoginResponse
-	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
-		deviceAgg, err := txRepos.DeviceAggregateRepository().FindByAccountAndUID(ctx, snapshot.ID, req.DeviceUid)
-		if err != nil {
-			if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return stackErr.Error(fmt.Errorf("load device: %w", err))
-			}
-			deviceAgg, err = aggregate.NewDeviceAggregate(uuid.NewString())
-			if err != nil {
-				return stackErr.Error(err)
-			}
-			deviceReg := entity.DeviceRegistration{
-				DeviceUID: req.DeviceUid, DeviceName: req.DeviceName, DeviceType: req.DeviceType,
-				OSName: req.OsName, OSVersion: req.OsVersion, AppVersion: req.AppVersion,
-				UserAgent: req.UserAgent, IPAddress: req.IpAddress,
-			}
-			if err := deviceAgg.Register(snapshot.ID, deviceReg, now); err != nil {
-				return stackErr.Error(err)
-			}
-		} else {
-			deviceReg := entity.DeviceRegistration{
-				DeviceUID: req.DeviceUid, DeviceName: req.DeviceName, DeviceType: req.DeviceType,
-				OSName: req.OsName, OSVersion: req.OsVersion, AppVersion: req.AppVersion,
-				UserAgent: req.UserAgent, IPAddress: req.IpAddress,
-			}
-			if err := deviceAgg.RefreshRegistration(deviceReg, now); err != nil {
-				return stackErr.Error(err)
-			}
+	switch evaluation.Assessment.Decision {
+	case accounttypes.LoginRiskDecisionLock:
+		log.Warnw("Login locked by risk evaluation",
+			zap.String("account_id", accountAgg.AccountID),
+			zap.Int("risk_score", evaluation.Assessment.Score),
+			zap.Any("risk_signals", evaluation.Assessment.Signals),
+		)
+		if err := u.risk.RecordAttempt(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, req.UserAgent, accounttypes.LoginAttemptOutcomeLocked, evaluation, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-		if err := txRepos.DeviceAggregateRepository().Save(ctx, deviceAgg); err != nil {
-			return stackErr.Error(fmt.Errorf("save device: %w", err))
+		if _, err := u.risk.Lock(ctx, u.baseRepo, accountAgg, loginRiskLockReason, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-
-		sessionID := uuid.NewString()
-		accessToken, accessExp, refreshToken, refreshExp, err := u.issueTokenPair(ctx, u.paseto, *snapshot, xpaseto.RefreshTokenSubject{
-			SessionID: sessionID,
-			DeviceID:  deviceAgg.DeviceID(),
-		})
+		return nil, stackErr.Error(ErrAccountLocked)
+	case accounttypes.LoginRiskDecisionStepUp:
+		snapshot, err := accountAgg.Snapshot()
 		if err != nil {
-			return stackErr.Error(err)
+			return nil, stackErr.Error(err)
 		}
-		refreshTokenHash, err := u.hasher.Hash(ctx, refreshToken)
+		token, expiresAt, err := u.risk.IssueStepUpChallenge(ctx, snapshot, deviceReg, req.IpAddress, req.UserAgent, evaluation, now)
 		if err != nil {
-			return stackErr.Error(err)
-		}
-
-		sessionAgg, err := aggregate.NewSessionAggregate(sessionID)
-		if err != nil {
-			return stackErr.Error(err)
-		}
-		if err := sessionAgg.Create(snapshot.ID, deviceAgg.DeviceID(), refreshTokenHash, refreshExp, now, req.IpAddress, req.UserAgent); err != nil {
-			return stackErr.Error(err)
+			log.Errorw("Failed to issue step-up challenge", zap.Error(err))
+			return nil, stackErr.Error(err)
 		}
-		if err := txRepos.SessionAggregateRepository().Save(ctx, sessionAgg); err != nil {
-			return stackErr.Error(fmt.Errorf("save session: %w", err))
+		if err := u.risk.RecordAttempt(ctx, u.baseRepo, accountAgg.AggregateID(), req.DeviceUid, req.IpAddress, req.UserAgent, accounttypes.LoginAttemptOutcomeStepUpRequired, evaluation, now); err != nil {
+			return nil, stackErr.Error(err)
 		}
-
-		res = out.LoginResponse{
-			AccessToken:      accessToken,
-			AccessExpiresAt:  accessExp.UnixMilli(),
-			RefreshToken:     refreshToken,
-			RefreshExpiresAt: refreshExp.UnixMilli(),
-		}
-		return nil
-	}); txErr != nil {
-		log.Errorw("Login failed", zap.Error(txErr), zap.String("email", req.Email))
-		return nil, stackErr.Error(txErr)
-	}
-
-	return &res, nil
-}
-
-func (u *loginHandler) issueTokenPair(
-	ctx context.Context,
-	pasetoSvc xpaseto.PasetoService,
-	account entity.Account,
-	subject xpaseto.RefreshTokenSubject,
-) (string, time.Time, string, time.Time, error) {
-	if account.ID == "" {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("account snapshot is required"))
+		return &out.LoginResponse{
+			StepUpRequired:  true,
+			StepUpToken:     token,
+			StepUpExpiresAt: expiresAt.UnixMilli(),
+		}, nil
 	}
 
-	accessToken, accessExpiresAt, err := pasetoSvc.GenerateAccessToken(ctx, &account)
+	tokens, err := u.sessions.Issue(ctx, u.baseRepo, accountAgg, deviceReg, req.IpAddress, req.UserAgent, evaluation, now)
 	if err != nil {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("generate access token failed: %w", err))
-	}
-
-	refreshToken, refreshExpiresAt, err := pasetoSvc.GenerateRefreshToken(ctx, &account, subject)
-	if err != nil {
-		return "", time.Time{}, "", time.Time{}, stackErr.Error(fmt.Errorf("generate refresh token failed: %w", err))
+		log.Errorw("Login failed", zap.Error(err), zap.String("email", req.Email))
+		return nil, stackErr.Error(err)
 	}
 
-	return accessToken, accessExpiresAt, refreshToken, refreshExpiresAt, nil
+	return &out.LoginResponse{
+		AccessToken:      tokens.AccessToken,
+		AccessExpiresAt:  tokens.AccessExpiresAt.UnixMilli(),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt.UnixMilli(),
+	}, nil
 }
@@ -0,0 +1,374 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/domain/aggregate"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/domain/service"
+	accounttypes "wechat-clone/core/modules/account/types"
+	sharedcache "wechat-clone/core/shared/infra/cache"
+	"wechat-clone/core/shared/infra/geoip"
+	"wechat-clone/core/shared/infra/smtp"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	stepUpChallengeTTL      = 10 * time.Minute
+	stepUpMaxCodeAttempts   = 5
+	loginRiskLockReason     = "login_risk"
+	failedPasswordLock      = "failed_password_burst"
+	unrecognizedLoginReason = "reported_unrecognized_login"
+)
+
+// stepUpChallenge is the login that was paused for verification. It holds
+// everything needed to finish the login once the emailed code is confirmed.
+type stepUpChallenge struct {
+	AccountID   string                         `json:"account_id"`
+	Device      entity.DeviceRegistration      `json:"device"`
+	IPAddress   string                         `json:"ip_address"`
+	UserAgent   string                         `json:"user_agent"`
+	CodeHash    string                         `json:"code_hash"`
+	RiskScore   int                            `json:"risk_score"`
+	RiskSignals []accounttypes.LoginRiskSignal `json:"risk_signals"`
+	Location    *geoip.Location                `json:"location,omitempty"`
+	ExpiresAt   time.Time                      `json:"expires_at"`
+}
+
+// loginRiskEvaluation is one risk check for one login, with the location that
+// was resolved so it can be stored on the attempt.
+type loginRiskEvaluation struct {
+	Assessment service.LoginRiskAssessment
+	Location   *geoip.Location
+	NewDevice  bool
+}
+
+type loginRiskDependencies struct {
+	cache                       sharedcache.Cache
+	smtp                        Mailer
+	geo                         geoip.Resolver
+	policy                      service.LoginRiskPolicy
+	lockDuration                time.Duration
+	failedPasswordWindow        time.Duration
+	failedPasswordLockThreshold int
+}
+
+func newLoginRiskDependencies(appCtx *appCtx.AppContext, geo geoip.Resolver) loginRiskDependencies {
+	cfg := appCtx.GetConfig().AuthConfig.LoginRisk
+
+	policy := service.DefaultLoginRiskPolicy()
+	policy.StepUpScore = cfg.StepUpScore
+	policy.LockScore = cfg.LockScore
+	policy.FailedPasswordThreshold = cfg.FailedPasswordThreshold
+	if cfg.MaxTravelSpeedKmh > 0 {
+		policy.MaxTravelSpeedKmh = cfg.MaxTravelSpeedKmh
+	}
+
+	return loginRiskDependencies{
+		cache:                       appCtx.GetCache(),
+		smtp:                        appCtx.GetSMTP(),
+		geo:                         geo,
+		policy:                      policy,
+		lockDuration:                time.Duration(cfg.LockDurationSeconds) * time.Second,
+		failedPasswordWindow:        time.Duration(cfg.FailedPasswordWindowSeconds) * time.Second,
+		failedPasswordLockThreshold: cfg.FailedPasswordLockThreshold,
+	}
+}
+
+func (d loginRiskDependencies) resolve(ctx context.Context, ipAddress string) *geoip.Location {
+	if d.geo == nil {
+		return nil
+	}
+	location, ok := d.geo.Lookup(ctx, ipAddress)
+	if !ok {
+		return nil
+	}
+	return location
+}
+
+func (d loginRiskDependencies) Evaluate(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	accountID string,
+	deviceUID string,
+	ipAddress string,
+	now time.Time,
+) (*loginRiskEvaluation, error) {
+	newDevice := false
+	if _, err := baseRepo.DeviceAggregateRepository().FindByAccountAndUID(ctx, accountID, deviceUID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(fmt.Errorf("load device: %w", err))
+		}
+		newDevice = true
+	}
+
+	attempts := baseRepo.LoginAttemptRepository()
+	previous, err := attempts.LatestSucceeded(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	failures, err := attempts.CountFailuresSince(ctx, accountID, now.Add(-d.failedPasswordWindow))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	input := service.LoginRiskInput{
+		NewDevice:      newDevice,
+		PreviousLogin:  previous,
+		RecentFailures: int(failures),
+		Now:            now,
+	}
+	location := d.resolve(ctx, ipAddress)
+	if location != nil {
+		input.Location = &service.LoginLocation{
+			CountryCode:    location.CountryCode,
+			ASN:            location.ASN,
+			Latitude:       location.Latitude,
+			Longitude:      location.Longitude,
+			HasCoordinates: location.HasCoordinates,
+		}
+		if location.CountryCode != "" {
+			if input.CountryKnown, err = attempts.HasSucceededFromCountry(ctx, accountID, location.CountryCode); err != nil {
+				return nil, stackErr.Error(err)
+			}
+		}
+		if location.ASN != 0 {
+			if input.ASNKnown, err = attempts.HasSucceededFromASN(ctx, accountID, location.ASN); err != nil {
+				return nil, stackErr.Error(err)
+			}
+		}
+	}
+
+	return &loginRiskEvaluation{
+		Assessment: service.EvaluateLoginRisk(d.policy, input),
+		Location:   location,
+		NewDevice:  newDevice,
+	}, nil
+}
+
+func (d loginRiskDependencies) RecordAttempt(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	accountID string,
+	deviceUID string,
+	ipAddress string,
+	userAgent string,
+	outcome accounttypes.LoginAttemptOutcome,
+	evaluation *loginRiskEvaluation,
+	now time.Time,
+) error {
+	attempt, err := entity.NewLoginAttempt(uuid.NewString(), accountID, deviceUID, ipAddress, userAgent, outcome, now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	var location *geoip.Location
+	if evaluation != nil {
+		attempt.RiskScore = evaluation.Assessment.Score
+		attempt.RiskSignals = evaluation.Assessment.Signals
+		location = evaluation.Location
+	} else {
+		location = d.resolve(ctx, ipAddress)
+	}
+	if location != nil {
+		attempt.CountryCode = location.CountryCode
+		attempt.ASN = location.ASN
+		if location.HasCoordinates {
+			lat, lon := location.Latitude, location.Longitude
+			attempt.Latitude = &lat
+			attempt.Longitude = &lon
+		}
+	}
+	return stackErr.Error(baseRepo.LoginAttemptRepository().Append(ctx, attempt))
+}
+
+// RecordFailedPassword stores the failure and locks the account once the
+// burst crosses the hard threshold, independently of the risk score.
+func (d loginRiskDependencies) RecordFailedPassword(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	accountAgg *aggregate.AccountAggregate,
+	deviceUID string,
+	ipAddress string,
+	userAgent string,
+	outcome accounttypes.LoginAttemptOutcome,
+	now time.Time,
+) (bool, error) {
+	accountID := accountAgg.AggregateID()
+	if err := d.RecordAttempt(ctx, baseRepo, accountID, deviceUID, ipAddress, userAgent, outcome, nil, now); err != nil {
+		return false, stackErr.Error(err)
+	}
+	if d.failedPasswordLockThreshold <= 0 {
+		return false, nil
+	}
+	failures, err := baseRepo.LoginAttemptRepository().CountFailuresSince(ctx, accountID, now.Add(-d.failedPasswordWindow))
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if failures < int64(d.failedPasswordLockThreshold) {
+		return false, nil
+	}
+	return d.Lock(ctx, baseRepo, accountAgg, failedPasswordLock, now)
+}
+
+func (d loginRiskDependencies) Lock(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	accountAgg *aggregate.AccountAggregate,
+	reason string,
+	now time.Time,
+) (bool, error) {
+	if d.lockDuration <= 0 {
+		return false, nil
+	}
+	changed, err := accountAgg.Lock(reason, now.Add(d.lockDuration), now)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if !changed {
+		return true, nil
+	}
+	if err := baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		return txRepos.AccountAggregateRepository().Save(ctx, accountAgg)
+	}); err != nil {
+		return false, stackErr.Error(err)
+	}
+	return true, nil
+}
+
+func (d loginRiskDependencies) IssueStepUpChallenge(
+	ctx context.Context,
+	account *entity.Account,
+	device entity.DeviceRegistration,
+	ipAddress string,
+	userAgent string,
+	evaluation *loginRiskEvaluation,
+	now time.Time,
+) (string, time.Time, error) {
+	code, err := generateStepUpCode()
+	if err != nil {
+		return "", time.Time{}, stackErr.Error(err)
+	}
+	token := uuid.NewString()
+	expiresAt := now.Add(stepUpChallengeTTL)
+
+	challenge := stepUpChallenge{
+		AccountID:   account.ID,
+		Device:      device,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+		CodeHash:    hashStepUpCode(token, code),
+		RiskScore:   evaluation.Assessment.Score,
+		RiskSignals: evaluation.Assessment.Signals,
+		Location:    evaluation.Location,
+		ExpiresAt:   expiresAt,
+	}
+	if err := d.cache.SetObject(ctx, stepUpChallengeCacheKey(token), challenge, stepUpChallengeTTL); err != nil {
+		return "", time.Time{}, stackErr.Error(err)
+	}
+
+	if err := d.smtp.SendTemplate(ctx, account.Email.Value(), "Confirm it's you", smtp.Template_OTP, map[string]interface{}{
+		"OTP":       code,
+		"Name":      account.DisplayName,
+		"ExpiredIn": fmt.Sprintf("%d minutes", int(stepUpChallengeTTL/time.Minute)),
+	}); err != nil {
+		return "", time.Time{}, stackErr.Error(err)
+	}
+	return token, expiresAt, nil
+}
+
+// VerifyStepUpChallenge checks the code. Every guess takes a slot from an
+// atomic counter before the code is compared, so parallel requests cannot
+// share one attempt; the challenge is dropped after the last one or as soon
+// as the code matches.
+func (d loginRiskDependencies) VerifyStepUpChallenge(ctx context.Context, token, code string, now time.Time) (*stepUpChallenge, bool, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, false, stackErr.Error(ErrStepUpChallengeInvalid)
+	}
+	key := stepUpChallengeCacheKey(token)
+	if d.cache.Exists(ctx, key) == 0 {
+		return nil, false, stackErr.Error(ErrStepUpChallengeInvalid)
+	}
+	data, err := d.cache.Get(ctx, key)
+	if err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+	var challenge stepUpChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+	if challenge.AccountID == "" || now.After(challenge.ExpiresAt) {
+		d.dropStepUpChallenge(ctx, token)
+		return nil, false, stackErr.Error(ErrStepUpChallengeInvalid)
+	}
+
+	attemptsKey := stepUpAttemptsCacheKey(token)
+	attempts, err := d.cache.Incr(ctx, attemptsKey)
+	if err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+	if attempts == 1 {
+		if err := d.cache.SetExpireTime(ctx, attemptsKey, int64(stepUpChallengeTTL/time.Second)); err != nil {
+			return nil, false, stackErr.Error(err)
+		}
+	}
+	if attempts > stepUpMaxCodeAttempts {
+		d.dropStepUpChallenge(ctx, token)
+		return nil, false, stackErr.Error(ErrStepUpChallengeInvalid)
+	}
+
+	expected := hashStepUpCode(token, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(challenge.CodeHash)) == 1 {
+		if err := d.cache.Delete(ctx, key); err != nil {
+			return nil, false, stackErr.Error(err)
+		}
+		_ = d.cache.Delete(ctx, attemptsKey)
+		return &challenge, true, nil
+	}
+
+	if attempts == stepUpMaxCodeAttempts {
+		d.dropStepUpChallenge(ctx, token)
+	}
+	return &challenge, false, nil
+}
+
+func (d loginRiskDependencies) dropStepUpChallenge(ctx context.Context, token string) {
+	_ = d.cache.Delete(ctx, stepUpChallengeCacheKey(token))
+	_ = d.cache.Delete(ctx, stepUpAttemptsCacheKey(token))
+}
+
+func generateStepUpCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashStepUpCode(token, code string) string {
+	sum := sha256.Sum256([]byte(token + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func stepUpChallengeCacheKey(token string) string {
+	return "account:login_step_up:" + token
+}
+
+func stepUpAttemptsCacheKey(token string) string {
+	return "account:login_step_up_attempts:" + token
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/domain/aggregate"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/infra/xpaseto"
+	"wechat-clone/core/shared/pkg/hasher"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type issuedLoginTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// loginSessionIssuer finishes a login that passed risk evaluation: it upserts
+// the device, opens a session, records the attempt and raises the new-device
+// alert, all in one transaction.
+type loginSessionIssuer struct {
+	hasher hasher.Hasher
+	paseto xpaseto.PasetoService
+	risk   loginRiskDependencies
+}
+
+func newLoginSessionIssuer(appCtx *appCtx.AppContext, risk loginRiskDependencies) loginSessionIssuer {
+	return loginSessionIssuer{
+		hasher: appCtx.GetHasher(),
+		paseto: appCtx.GetPaseto(),
+		risk:   risk,
+	}
+}
+
+func (s loginSessionIssuer) Issue(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	accountAgg *aggregate.AccountAggregate,
+	deviceReg entity.DeviceRegistration,
+	ipAddress string,
+	userAgent string,
+	evaluation *loginRiskEvaluation,
+	now time.Time,
+) (*issuedLoginTokens, error) {
+	snapshot, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var tokens issuedLoginTokens
+	if txErr := baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		deviceAgg, err := txRepos.DeviceAggregateRepository().FindByAccountAndUID(ctx, snapshot.ID, deviceReg.DeviceUID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return stackErr.Error(fmt.Errorf("load device: %w", err))
+			}
+			deviceAgg, err = aggregate.NewDeviceAggregate(uuid.NewString())
+			if err != nil {
+				return stackErr.Error(err)
+			}
+			if err := deviceAgg.Register(snapshot.ID, deviceReg, now); err != nil {
+				return stackErr.Error(err)
+			}
+		} else if err := deviceAgg.RefreshRegistration(deviceReg, now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.DeviceAggregateRepository().Save(ctx, deviceAgg); err != nil {
+			return stackErr.Error(fmt.Errorf("save device: %w", err))
+		}
+
+		sessionID := uuid.NewString()
+		subject := xpaseto.RefreshTokenSubject{
+			SessionID: sessionID,
+			DeviceID:  deviceAgg.DeviceID(),
+		}
+		accessToken, accessExp, err := s.paseto.GenerateAccessToken(ctx, snapshot)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("generate access token failed: %w", err))
+		}
+		refreshToken, refreshExp, err := s.paseto.GenerateRefreshToken(ctx, snapshot, subject)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("generate refresh token failed: %w", err))
+		}
+		refreshTokenHash, err := s.hasher.Hash(ctx, refreshToken)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+
+		sessionAgg, err := aggregate.NewSessionAggregate(sessionID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := sessionAgg.Create(snapshot.ID, deviceAgg.DeviceID(), refreshTokenHash, refreshExp, now, ipAddress, userAgent); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.SessionAggregateRepository().Save(ctx, sessionAgg); err != nil {
+			return stackErr.Error(fmt.Errorf("save session: %w", err))
+		}
+
+		if err := s.risk.RecordAttempt(ctx, txRepos, snapshot.ID, deviceReg.DeviceUID, ipAddress, userAgent, accounttypes.LoginAttemptOutcomeSucceeded, evaluation, now); err != nil {
+			return stackErr.Error(err)
+		}
+
+		if evaluation != nil && evaluation.Assessment.Has(accounttypes.LoginRiskSignalNewDevice) {
+			countryCode := ""
+			if evaluation.Location != nil {
+				countryCode = evaluation.Location.CountryCode
+			}
+			if err := accountAgg.RecordNewDeviceLogin(aggregate.EventAccountNewDeviceLogin{
+				SessionID:   sessionID,
+				DeviceID:    deviceAgg.DeviceID(),
+				DeviceName:  utils.FirstNonEmpty(deviceReg.DeviceName, deviceReg.OSName, deviceReg.DeviceType),
+				IPAddress:   ipAddress,
+				UserAgent:   userAgent,
+				CountryCode: countryCode,
+			}, now); err != nil {
+				return stackErr.Error(err)
+			}
+			if err := txRepos.AccountAggregateRepository().Save(ctx, accountAgg); err != nil {
+				return stackErr.Error(err)
+			}
+		}
+
+		tokens = issuedLoginTokens{
+			AccessToken:      accessToken,
+			AccessExpiresAt:  accessExp,
+			RefreshToken:     refreshToken,
+			RefreshExpiresAt: refreshExp,
+		}
+		return nil
+	}); txErr != nil {
+		return nil, stackErr.Error(txErr)
+	}
+
+	return &tokens, nil
+}
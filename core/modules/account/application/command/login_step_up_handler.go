@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/domain/service"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/infra/geoip"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+type loginStepUpHandler struct {
+	baseRepo repos.Repos
+	risk     loginRiskDependencies
+	sessions loginSessionIssuer
+}
+
+func NewLoginStepUpHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos, geo geoip.Resolver) cqrs.Handler[*in.LoginStepUpRequest, *out.LoginStepUpResponse] {
+	risk := newLoginRiskDependencies(appCtx, geo)
+	return &loginStepUpHandler{
+		baseRepo: baseRepo,
+		risk:     risk,
+		sessions: newLoginSessionIssuer(appCtx, risk),
+	}
+}
+
+func (u *loginStepUpHandler) Handle(ctx context.Context, req *in.LoginStepUpRequest) (*out.LoginStepUpResponse, error) {
+	log := logging.FromContext(ctx).Named("LoginStepUp")
+	now := time.Now().UTC()
+
+	challenge, verified, err := u.risk.VerifyStepUpChallenge(ctx, req.StepUpToken, req.Code, now)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := u.baseRepo.AccountAggregateRepository().Load(ctx, challenge.AccountID)
+	if err != nil {
+		log.Errorw("Failed to load account for step-up", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	if !verified {
+		locked, err := u.risk.RecordFailedPassword(ctx, u.baseRepo, accountAgg, challenge.Device.DeviceUID, challenge.IPAddress, challenge.UserAgent, accounttypes.LoginAttemptOutcomeStepUpFailed, now)
+		if err != nil {
+			log.Errorw("Failed to record failed step-up attempt", zap.Error(err))
+		} else if locked {
+			log.Warnw("Account locked after failed step-up attempts", zap.String("account_id", challenge.AccountID))
+		}
+		return nil, stackErr.Error(ErrStepUpChallengeInvalid)
+	}
+
+	if accountAgg.IsLocked(now) {
+		return nil, stackErr.Error(ErrAccountLocked)
+	}
+	if accountAgg.IsBanned(now) {
+		return nil, stackErr.Error(ErrAccountBanned)
+	}
+
+	// The risk was already assessed when the challenge was issued; the stored
+	// signals are reused so the new-device alert still fires after step-up.
+	evaluation := &loginRiskEvaluation{
+		Assessment: service.LoginRiskAssessment{
+			Score:    challenge.RiskScore,
+			Signals:  challenge.RiskSignals,
+			Decision: accounttypes.LoginRiskDecisionStepUp,
+		},
+		Location: challenge.Location,
+	}
+	tokens, err := u.sessions.Issue(ctx, u.baseRepo, accountAgg, challenge.Device, challenge.IPAddress, challenge.UserAgent, evaluation, now)
+	if err != nil {
+		log.Errorw("Step-up login failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.LoginStepUpResponse{
+		AccessToken:      tokens.AccessToken,
+		AccessExpiresAt:  tokens.AccessExpiresAt.UnixMilli(),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt.UnixMilli(),
+	}, nil
+}
@@ -89,6 +89,9 @@ func (u *refreshHandler) Handle(ctx context.Context, req *in.RefreshRequest) (*o
 		if err != nil {
 			return stackErr.Error(fmt.Errorf("load account aggregate: %w", err))
 		}
+		if accountAgg.IsBanned(now) {
+			return stackErr.Error(ErrAccountBanned)
+		}
 		accountSnapshot, err := accountAgg.Snapshot()
 		if err != nil {
 			return stackErr.Error(err)
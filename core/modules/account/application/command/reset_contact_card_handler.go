@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/shared/pkg/contactcard"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type resetContactCardHandler struct {
+	baseRepo repos.Repos
+	issuer   support.ContactCardIssuer
+}
+
+func NewResetContactCardHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos, signer contactcard.Signer) cqrs.Handler[*in.ResetContactCardRequest, *out.ResetContactCardResponse] {
+	return &resetContactCardHandler{
+		baseRepo: baseRepo,
+		issuer:   support.NewContactCardIssuer(appCtx.GetConfig().AuthConfig.ContactCard, signer),
+	}
+}
+
+func (u *resetContactCardHandler) Handle(ctx context.Context, req *in.ResetContactCardRequest) (*out.ResetContactCardResponse, error) {
+	log := logging.FromContext(ctx).Named("ResetContactCard")
+
+	accountID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := u.baseRepo.AccountAggregateRepository().Load(ctx, accountID)
+	if err != nil {
+		log.Errorw("Failed to load account aggregate", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	if err := accountAgg.RotateContactCard(time.Now().UTC()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := u.baseRepo.AccountAggregateRepository().Save(ctx, accountAgg); err != nil {
+		log.Errorw("Failed to persist contact card reset", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	account, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	card, err := u.issuer.Issue(account)
+	if err != nil {
+		log.Errorw("Failed to issue contact card", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.ResetContactCardResponse{
+		Token:       card.Token,
+		QrContent:   card.QRContent,
+		QrPngBase64: card.QRPNG,
+		RotatedAt:   utils.FormatOptionalTime(card.RotatedAt),
+	}, nil
+}
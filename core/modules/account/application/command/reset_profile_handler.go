@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type resetProfileHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewResetProfileHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.ResetProfileRequest, *out.ResetProfileResponse] {
+	return &resetProfileHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *resetProfileHandler) Handle(ctx context.Context, req *in.ResetProfileRequest) (*out.ResetProfileResponse, error) {
+	log := logging.FromContext(ctx).Named("ResetProfile")
+
+	admin, err := support.AdminFromRepo(ctx, u.baseRepo)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !req.ResetDisplayName && !req.ResetAvatar {
+		return nil, stackErr.Error(ErrAdminNothingToReset)
+	}
+
+	accountAgg, err := loadModerationTarget(ctx, u.baseRepo, admin.AccountID, req.AccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	before, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := time.Now().UTC()
+	updated, err := accountAgg.ResetProfile(req.ResetDisplayName, req.ResetAvatar, now)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	after, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if updated {
+			if err := txRepos.AccountAggregateRepository().Save(ctx, accountAgg); err != nil {
+				return stackErr.Error(err)
+			}
+		}
+		return appendAdminAuditLog(
+			ctx,
+			txRepos,
+			admin.AccountID,
+			accounttypes.AdminAuditActionResetProfile,
+			after.ID,
+			profileStateOf(before),
+			profileStateOf(after),
+			req.Reason,
+			now,
+		)
+	}); txErr != nil {
+		log.Errorw("Failed to persist profile reset", zap.String("target_account_id", after.ID), zap.Error(txErr))
+		return nil, stackErr.Error(txErr)
+	}
+
+	return &out.ResetProfileResponse{
+		AccountID:       after.ID,
+		DisplayName:     after.DisplayName,
+		AvatarObjectKey: utils.StringValue(after.AvatarObjectKey),
+	}, nil
+}
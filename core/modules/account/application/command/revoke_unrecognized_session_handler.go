@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type revokeUnrecognizedSessionHandler struct {
+	baseRepo repos.Repos
+	revoker  support.LoginAlertRevoker
+}
+
+func NewRevokeUnrecognizedSessionHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse] {
+	return &revokeUnrecognizedSessionHandler{
+		baseRepo: baseRepo,
+		revoker:  support.NewLoginAlertRevoker(appCtx.GetConfig().AuthConfig.LoginRisk, appCtx.GetCache()),
+	}
+}
+
+func (u *revokeUnrecognizedSessionHandler) Handle(ctx context.Context, req *in.RevokeUnrecognizedSessionRequest) (*out.RevokeUnrecognizedSessionResponse, error) {
+	log := logging.FromContext(ctx).Named("RevokeUnrecognizedSession")
+
+	payload, err := u.revoker.Consume(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, support.ErrLoginAlertRevokeInvalid) {
+			return nil, stackErr.Error(ErrLoginAlertTokenInvalid)
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	now := time.Now().UTC()
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		sessionAgg, err := txRepos.SessionAggregateRepository().Load(ctx, payload.SessionID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return stackErr.Error(err)
+		}
+		if sessionAgg.AccountID() != payload.AccountID {
+			return stackErr.Error(ErrLoginAlertTokenInvalid)
+		}
+		changed, err := sessionAgg.Revoke(unrecognizedLoginReason, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if !changed {
+			return nil
+		}
+		return txRepos.SessionAggregateRepository().Save(ctx, sessionAgg)
+	}); txErr != nil {
+		log.Errorw("Failed to revoke unrecognized session", zap.String("session_id", payload.SessionID), zap.Error(txErr))
+		return nil, stackErr.Error(txErr)
+	}
+
+	log.Infow("Session revoked from login alert", zap.String("account_id", payload.AccountID), zap.String("session_id", payload.SessionID))
+	return &out.RevokeUnrecognizedSessionResponse{
+		Message: "The session has been signed out. Change your password if you did not sign in.",
+	}, nil
+}
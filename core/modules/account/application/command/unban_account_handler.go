@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+type unbanAccountHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewUnbanAccountHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.UnbanAccountRequest, *out.UnbanAccountResponse] {
+	return &unbanAccountHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *unbanAccountHandler) Handle(ctx context.Context, req *in.UnbanAccountRequest) (*out.UnbanAccountResponse, error) {
+	log := logging.FromContext(ctx).Named("UnbanAccount")
+
+	admin, err := support.AdminFromRepo(ctx, u.baseRepo)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := loadModerationTarget(ctx, u.baseRepo, admin.AccountID, req.AccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	before, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := time.Now().UTC()
+	if err := accountAgg.Unban(now); err != nil {
+		return nil, stackErr.Error(mapModerationDomainError(err))
+	}
+	after, err := accountAgg.Snapshot()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if err := txRepos.AccountAggregateRepository().Save(ctx, accountAgg); err != nil {
+			return stackErr.Error(err)
+		}
+		return appendAdminAuditLog(
+			ctx,
+			txRepos,
+			admin.AccountID,
+			accounttypes.AdminAuditActionUnbanAccount,
+			after.ID,
+			banStateOf(before),
+			banStateOf(after),
+			req.Reason,
+			now,
+		)
+	}); txErr != nil {
+		log.Errorw("Failed to persist account unban", zap.String("target_account_id", after.ID), zap.Error(txErr))
+		return nil, stackErr.Error(txErr)
+	}
+
+	return &out.UnbanAccountResponse{
+		AccountID: after.ID,
+		Message:   "Account unbanned",
+	}, nil
+}
@@ -3,13 +3,16 @@ package command
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/account/application/dto/in"
 	"wechat-clone/core/modules/account/application/dto/out"
 	"wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/account/domain/aggregate"
 	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/domain/rules"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/logging"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -18,12 +21,14 @@ import (
 )
 
 type updateProfileHandler struct {
-	baseRepo repos.Repos
+	baseRepo  repos.Repos
+	usernames usernamePolicy
 }
 
 func NewUpdateProfileHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.UpdateProfileRequest, *out.UpdateProfileResponse] {
 	return &updateProfileHandler{
-		baseRepo: baseRepo,
+		baseRepo:  baseRepo,
+		usernames: newUsernamePolicy(appCtx.GetConfig().AuthConfig.Username),
 	}
 }
 
@@ -42,24 +47,52 @@ func (u *updateProfileHandler) Handle(ctx context.Context, req *in.UpdateProfile
 		return nil, stackErr.Error(err)
 	}
 
-	updated, err := accountAggregate.UpdateProfile(req.DisplayName, req.Username, req.AvatarObjectKey, time.Now().UTC())
+	now := time.Now().UTC()
+	// The handle goes through the username policy so cooldown and reservations
+	// apply here too. Handles can be changed but not cleared, so an empty
+	// username leaves the current one untouched.
+	updated, err := accountAggregate.UpdateProfile(req.DisplayName, nil, req.AvatarObjectKey, now)
 	if err != nil {
 		log.Errorw("Failed to update account profile aggregate", zap.Error(err))
 		return nil, stackErr.Error(err)
 	}
-	if !updated {
-		accountEntity, err := accountAggregate.Snapshot()
-		if err != nil {
-			return nil, stackErr.Error(err)
+
+	detailsUpdated, err := accountAggregate.UpdateProfileDetails(aggregate.ProfileDetailsUpdate{
+		Bio:                req.Bio,
+		Region:             req.Region,
+		Gender:             req.Gender,
+		Birthday:           req.Birthday,
+		CoverObjectKey:     req.CoverObjectKey,
+		BioVisibility:      req.BioVisibility,
+		RegionVisibility:   req.RegionVisibility,
+		GenderVisibility:   req.GenderVisibility,
+		BirthdayVisibility: req.BirthdayVisibility,
+		CoverVisibility:    req.CoverVisibility,
+	}, now)
+	if err != nil {
+		if mapped := mapProfileDetailsError(err); mapped != err {
+			return nil, stackErr.Error(mapped)
 		}
-		return support.ToUpdateProfileResponse(accountEntity), nil
+		log.Errorw("Failed to update account profile details", zap.Error(err))
+		return nil, stackErr.Error(err)
 	}
+	updated = updated || detailsUpdated
 
 	if txErr := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if req.Username != nil && strings.TrimSpace(*req.Username) != "" {
+			usernameChanged, err := u.usernames.Claim(ctx, txRepos, accountAggregate, *req.Username, now)
+			if err != nil {
+				return stackErr.Error(err)
+			}
+			updated = updated || usernameChanged
+		}
+		if !updated {
+			return nil
+		}
 		return txRepos.AccountAggregateRepository().Save(ctx, accountAggregate)
 	}); txErr != nil {
-		if errors.Is(txErr, repos.ErrAccountUsernameAlreadyExists) {
-			return nil, stackErr.Error(ErrUsernameExists)
+		if mapped := mapUsernameDomainError(txErr); mapped != txErr {
+			return nil, stackErr.Error(mapped)
 		}
 		log.Errorw("Failed to persist updated profile", zap.Error(txErr))
 		return nil, stackErr.Error(txErr)
@@ -71,3 +104,16 @@ func (u *updateProfileHandler) Handle(ctx context.Context, req *in.UpdateProfile
 	}
 	return support.ToUpdateProfileResponse(accountEntity), nil
 }
+
+func mapProfileDetailsError(err error) error {
+	switch {
+	case errors.Is(err, rules.ErrAccountBioTooLong),
+		errors.Is(err, rules.ErrAccountRegionTooLong),
+		errors.Is(err, rules.ErrAccountGenderInvalid),
+		errors.Is(err, rules.ErrAccountBirthdayInvalid),
+		errors.Is(err, rules.ErrAccountVisibilityInvalid):
+		return ErrProfileDetailsInvalid
+	default:
+		return err
+	}
+}
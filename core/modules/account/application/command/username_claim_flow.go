@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/aggregate"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/domain/rules"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// usernamePolicy applies the handle rules shared by the claim endpoint and
+// the profile update: reserved words, change cooldown and holding a released
+// handle for its former owner.
+type usernamePolicy struct {
+	cooldown      time.Duration
+	reservation   time.Duration
+	reservedWords []string
+}
+
+func newUsernamePolicy(cfg config.UsernameConfig) usernamePolicy {
+	reservedWords := make([]string, 0)
+	for _, word := range strings.Split(cfg.ReservedWords, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			reservedWords = append(reservedWords, word)
+		}
+	}
+	return usernamePolicy{
+		cooldown:      time.Duration(cfg.ChangeCooldownSeconds) * time.Second,
+		reservation:   time.Duration(cfg.ReservationSeconds) * time.Second,
+		reservedWords: reservedWords,
+	}
+}
+
+// Claim changes the handle on the aggregate and, when a different handle was
+// released, reserves it for the account. The caller saves the aggregate in
+// the same transaction.
+func (p usernamePolicy) Claim(ctx context.Context, txRepos repos.Repos, accountAgg *aggregate.AccountAggregate, username string, now time.Time) (bool, error) {
+	normalized, err := rules.NormalizeUsername(username)
+	if err != nil {
+		return false, stackErr.Error(mapUsernameDomainError(err))
+	}
+	if rules.IsReservedUsername(normalized, p.reservedWords) {
+		return false, stackErr.Error(ErrUsernameReserved)
+	}
+
+	key := rules.UsernameKey(normalized)
+	reservation, err := txRepos.UsernameReservationRepository().FindActive(ctx, key, now)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if reservation != nil && reservation.AccountID != accountAgg.AggregateID() {
+		return false, stackErr.Error(ErrUsernameReserved)
+	}
+
+	previous, changed, err := accountAgg.ClaimUsername(normalized, p.cooldown, now)
+	if err != nil {
+		return false, stackErr.Error(mapUsernameDomainError(err))
+	}
+	if !changed {
+		return false, nil
+	}
+
+	if previous != nil && rules.UsernameKey(*previous) != key && p.reservation > 0 {
+		held, err := entity.NewUsernameReservation(*previous, accountAgg.AggregateID(), now.Add(p.reservation), now)
+		if err != nil {
+			return false, stackErr.Error(err)
+		}
+		if err := txRepos.UsernameReservationRepository().Reserve(ctx, held); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+
+	return true, nil
+}
+
+func mapUsernameDomainError(err error) error {
+	switch {
+	case errors.Is(err, rules.ErrAccountUsernameRequired),
+		errors.Is(err, rules.ErrAccountUsernameInvalid):
+		return ErrUsernameInvalid
+	case errors.Is(err, rules.ErrAccountUsernameReserved):
+		return ErrUsernameReserved
+	case errors.Is(err, rules.ErrAccountUsernameChangeCooldown):
+		return ErrUsernameCooldown
+	case errors.Is(err, repos.ErrAccountUsernameAlreadyExists):
+		return ErrUsernameExists
+	default:
+		return err
+	}
+}
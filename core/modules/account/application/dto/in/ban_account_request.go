@@ -0,0 +1,32 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type BanAccountRequest struct {
+	AccountID   string `json:"account_id" form:"account_id" binding:"required"`
+	Reason      string `json:"reason" form:"reason" binding:"required"`
+	BannedUntil string `json:"banned_until" form:"banned_until"`
+}
+
+func (r *BanAccountRequest) Normalize() {
+	r.AccountID = strings.TrimSpace(r.AccountID)
+	r.Reason = strings.TrimSpace(r.Reason)
+	r.BannedUntil = strings.TrimSpace(r.BannedUntil)
+}
+
+func (r *BanAccountRequest) Validate() error {
+	r.Normalize()
+	if r.AccountID == "" {
+		return stackErr.Error(errors.New("account_id is required"))
+	}
+	if r.Reason == "" {
+		return stackErr.Error(errors.New("reason is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ClaimUsernameRequest struct {
+	Username string `json:"username" form:"username" binding:"required"`
+}
+
+func (r *ClaimUsernameRequest) Normalize() {
+	r.Username = strings.TrimSpace(r.Username)
+}
+
+func (r *ClaimUsernameRequest) Validate() error {
+	r.Normalize()
+	if r.Username == "" {
+		return stackErr.Error(errors.New("username is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetAccountByUsernameRequest struct {
+	Username string `json:"username" form:"username" binding:"required"`
+}
+
+func (r *GetAccountByUsernameRequest) Normalize() {
+	r.Username = strings.TrimSpace(r.Username)
+}
+
+func (r *GetAccountByUsernameRequest) Validate() error {
+	r.Normalize()
+	if r.Username == "" {
+		return stackErr.Error(errors.New("username is required"))
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type GetContactCardRequest struct {
+}
+
+func (r *GetContactCardRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,32 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ListAuditLogsRequest struct {
+	ActorAccountID  string `json:"actor_account_id" form:"actor_account_id"`
+	TargetAccountID string `json:"target_account_id" form:"target_account_id"`
+	Action          string `json:"action" form:"action"`
+	RequestID       string `json:"request_id" form:"request_id"`
+	From            string `json:"from" form:"from"`
+	To              string `json:"to" form:"to"`
+	Limit           int    `json:"limit" form:"limit"`
+	Offset          int    `json:"offset" form:"offset"`
+}
+
+func (r *ListAuditLogsRequest) Normalize() {
+	r.ActorAccountID = strings.TrimSpace(r.ActorAccountID)
+	r.TargetAccountID = strings.TrimSpace(r.TargetAccountID)
+	r.Action = strings.TrimSpace(r.Action)
+	r.RequestID = strings.TrimSpace(r.RequestID)
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+}
+
+func (r *ListAuditLogsRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
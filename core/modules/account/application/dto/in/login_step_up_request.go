@@ -0,0 +1,30 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type LoginStepUpRequest struct {
+	StepUpToken string `json:"step_up_token" form:"step_up_token" binding:"required"`
+	Code        string `json:"code" form:"code" binding:"required"`
+}
+
+func (r *LoginStepUpRequest) Normalize() {
+	r.StepUpToken = strings.TrimSpace(r.StepUpToken)
+	r.Code = strings.TrimSpace(r.Code)
+}
+
+func (r *LoginStepUpRequest) Validate() error {
+	r.Normalize()
+	if r.StepUpToken == "" {
+		return stackErr.Error(errors.New("step_up_token is required"))
+	}
+	if r.Code == "" {
+		return stackErr.Error(errors.New("code is required"))
+	}
+	return nil
+}
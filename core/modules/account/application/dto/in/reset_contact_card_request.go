@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type ResetContactCardRequest struct {
+}
+
+func (r *ResetContactCardRequest) Validate() error {
+	return nil
+}
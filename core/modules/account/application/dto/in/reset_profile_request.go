@@ -0,0 +1,29 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ResetProfileRequest struct {
+	AccountID        string `json:"account_id" form:"account_id" binding:"required"`
+	ResetDisplayName bool   `json:"reset_display_name" form:"reset_display_name"`
+	ResetAvatar      bool   `json:"reset_avatar" form:"reset_avatar"`
+	Reason           string `json:"reason" form:"reason"`
+}
+
+func (r *ResetProfileRequest) Normalize() {
+	r.AccountID = strings.TrimSpace(r.AccountID)
+	r.Reason = strings.TrimSpace(r.Reason)
+}
+
+func (r *ResetProfileRequest) Validate() error {
+	r.Normalize()
+	if r.AccountID == "" {
+		return stackErr.Error(errors.New("account_id is required"))
+	}
+	return nil
+}
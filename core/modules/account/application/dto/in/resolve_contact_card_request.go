@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ResolveContactCardRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+}
+
+func (r *ResolveContactCardRequest) Normalize() {
+	r.Token = strings.TrimSpace(r.Token)
+}
+
+func (r *ResolveContactCardRequest) Validate() error {
+	r.Normalize()
+	if r.Token == "" {
+		return stackErr.Error(errors.New("token is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type RevokeUnrecognizedSessionRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+}
+
+func (r *RevokeUnrecognizedSessionRequest) Normalize() {
+	r.Token = strings.TrimSpace(r.Token)
+}
+
+func (r *RevokeUnrecognizedSessionRequest) Validate() error {
+	r.Normalize()
+	if r.Token == "" {
+		return stackErr.Error(errors.New("token is required"))
+	}
+	return nil
+}
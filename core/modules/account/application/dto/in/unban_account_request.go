@@ -0,0 +1,27 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type UnbanAccountRequest struct {
+	AccountID string `json:"account_id" form:"account_id" binding:"required"`
+	Reason    string `json:"reason" form:"reason"`
+}
+
+func (r *UnbanAccountRequest) Normalize() {
+	r.AccountID = strings.TrimSpace(r.AccountID)
+	r.Reason = strings.TrimSpace(r.Reason)
+}
+
+func (r *UnbanAccountRequest) Validate() error {
+	r.Normalize()
+	if r.AccountID == "" {
+		return stackErr.Error(errors.New("account_id is required"))
+	}
+	return nil
+}
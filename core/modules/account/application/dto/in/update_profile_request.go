@@ -9,9 +9,19 @@ import (
 )
 
 type UpdateProfileRequest struct {
-	DisplayName     string  `json:"display_name" form:"display_name" binding:"required"`
-	Username        *string `json:"username" form:"username"`
-	AvatarObjectKey *string `json:"avatar_object_key" form:"avatar_object_key"`
+	DisplayName        string  `json:"display_name" form:"display_name" binding:"required"`
+	Username           *string `json:"username" form:"username"`
+	AvatarObjectKey    *string `json:"avatar_object_key" form:"avatar_object_key"`
+	Bio                *string `json:"bio" form:"bio"`
+	Region             *string `json:"region" form:"region"`
+	Gender             *string `json:"gender" form:"gender"`
+	Birthday           *string `json:"birthday" form:"birthday"`
+	CoverObjectKey     *string `json:"cover_object_key" form:"cover_object_key"`
+	BioVisibility      *string `json:"bio_visibility" form:"bio_visibility"`
+	RegionVisibility   *string `json:"region_visibility" form:"region_visibility"`
+	GenderVisibility   *string `json:"gender_visibility" form:"gender_visibility"`
+	BirthdayVisibility *string `json:"birthday_visibility" form:"birthday_visibility"`
+	CoverVisibility    *string `json:"cover_visibility" form:"cover_visibility"`
 }
 
 func (r *UpdateProfileRequest) Normalize() {
@@ -22,6 +32,36 @@ func (r *UpdateProfileRequest) Normalize() {
 	if r.AvatarObjectKey != nil {
 		*r.AvatarObjectKey = strings.TrimSpace(*r.AvatarObjectKey)
 	}
+	if r.Bio != nil {
+		*r.Bio = strings.TrimSpace(*r.Bio)
+	}
+	if r.Region != nil {
+		*r.Region = strings.TrimSpace(*r.Region)
+	}
+	if r.Gender != nil {
+		*r.Gender = strings.TrimSpace(*r.Gender)
+	}
+	if r.Birthday != nil {
+		*r.Birthday = strings.TrimSpace(*r.Birthday)
+	}
+	if r.CoverObjectKey != nil {
+		*r.CoverObjectKey = strings.TrimSpace(*r.CoverObjectKey)
+	}
+	if r.BioVisibility != nil {
+		*r.BioVisibility = strings.TrimSpace(*r.BioVisibility)
+	}
+	if r.RegionVisibility != nil {
+		*r.RegionVisibility = strings.TrimSpace(*r.RegionVisibility)
+	}
+	if r.GenderVisibility != nil {
+		*r.GenderVisibility = strings.TrimSpace(*r.GenderVisibility)
+	}
+	if r.BirthdayVisibility != nil {
+		*r.BirthdayVisibility = strings.TrimSpace(*r.BirthdayVisibility)
+	}
+	if r.CoverVisibility != nil {
+		*r.CoverVisibility = strings.TrimSpace(*r.CoverVisibility)
+	}
 }
 
 func (r *UpdateProfileRequest) Validate() error {
@@ -0,0 +1,9 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type BanAccountResponse struct {
+	AccountID       string `json:"account_id,omitempty"`
+	BannedReason    string `json:"banned_reason,omitempty"`
+	BannedUntil     string `json:"banned_until,omitempty"`
+	RevokedSessions int    `json:"revoked_sessions,omitempty"`
+}
@@ -0,0 +1,9 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ClaimUsernameResponse struct {
+	ID                    string `json:"id,omitempty"`
+	Username              string `json:"username,omitempty"`
+	UsernameChangedAt     string `json:"username_changed_at,omitempty"`
+	NextChangeAvailableAt string `json:"next_change_available_at,omitempty"`
+}
@@ -0,0 +1,7 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ForceLogoutResponse struct {
+	AccountID       string `json:"account_id,omitempty"`
+	RevokedSessions int    `json:"revoked_sessions,omitempty"`
+}
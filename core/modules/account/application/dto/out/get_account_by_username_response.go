@@ -0,0 +1,17 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type GetAccountByUsernameResponse struct {
+	ID              string `json:"id,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	Username        string `json:"username,omitempty"`
+	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
+	Status          string `json:"status,omitempty"`
+	Bio             string `json:"bio,omitempty"`
+	Region          string `json:"region,omitempty"`
+	Gender          string `json:"gender,omitempty"`
+	Birthday        string `json:"birthday,omitempty"`
+	CoverObjectKey  string `json:"cover_object_key,omitempty"`
+	IsFriend        bool   `json:"is_friend,omitempty"`
+	ContactToken    string `json:"contact_token,omitempty"`
+}
@@ -0,0 +1,9 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type GetContactCardResponse struct {
+	Token       string `json:"token,omitempty"`
+	QrContent   string `json:"qr_content,omitempty"`
+	QrPngBase64 string `json:"qr_png_base64,omitempty"`
+	RotatedAt   string `json:"rotated_at,omitempty"`
+}
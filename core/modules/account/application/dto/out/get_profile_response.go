@@ -2,16 +2,26 @@
 package out
 
 type GetProfileResponse struct {
-	ID                string `json:"id,omitempty"`
-	DisplayName       string `json:"display_name,omitempty"`
-	Email             string `json:"email,omitempty"`
-	Username          string `json:"username,omitempty"`
-	AvatarObjectKey   string `json:"avatar_object_key,omitempty"`
-	Status            string `json:"status,omitempty"`
-	EmailVerified     bool   `json:"email_verified,omitempty"`
-	EmailVerifiedAt   string `json:"email_verified_at,omitempty"`
-	LastLoginAt       string `json:"last_login_at,omitempty"`
-	PasswordChangedAt string `json:"password_changed_at,omitempty"`
-	CreatedAt         string `json:"created_at,omitempty"`
-	UpdatedAt         string `json:"updated_at,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	DisplayName        string `json:"display_name,omitempty"`
+	Email              string `json:"email,omitempty"`
+	Username           string `json:"username,omitempty"`
+	AvatarObjectKey    string `json:"avatar_object_key,omitempty"`
+	Status             string `json:"status,omitempty"`
+	EmailVerified      bool   `json:"email_verified,omitempty"`
+	EmailVerifiedAt    string `json:"email_verified_at,omitempty"`
+	LastLoginAt        string `json:"last_login_at,omitempty"`
+	PasswordChangedAt  string `json:"password_changed_at,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+	Bio                string `json:"bio,omitempty"`
+	Region             string `json:"region,omitempty"`
+	Gender             string `json:"gender,omitempty"`
+	Birthday           string `json:"birthday,omitempty"`
+	CoverObjectKey     string `json:"cover_object_key,omitempty"`
+	BioVisibility      string `json:"bio_visibility,omitempty"`
+	RegionVisibility   string `json:"region_visibility,omitempty"`
+	GenderVisibility   string `json:"gender_visibility,omitempty"`
+	BirthdayVisibility string `json:"birthday_visibility,omitempty"`
+	CoverVisibility    string `json:"cover_visibility,omitempty"`
 }
@@ -0,0 +1,36 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListAccountSessionsResponse struct {
+	AccountID string             `json:"account_id,omitempty"`
+	Sessions  []AdminSessionItem `json:"sessions,omitempty"`
+	Devices   []AdminDeviceItem  `json:"devices,omitempty"`
+}
+
+type AdminSessionItem struct {
+	ID             string `json:"id,omitempty"`
+	DeviceID       string `json:"device_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+	IpAddress      string `json:"ip_address,omitempty"`
+	UserAgent      string `json:"user_agent,omitempty"`
+	LastActivityAt string `json:"last_activity_at,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+	RevokedAt      string `json:"revoked_at,omitempty"`
+	RevokedReason  string `json:"revoked_reason,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+type AdminDeviceItem struct {
+	ID            string `json:"id,omitempty"`
+	DeviceUid     string `json:"device_uid,omitempty"`
+	DeviceName    string `json:"device_name,omitempty"`
+	DeviceType    string `json:"device_type,omitempty"`
+	OsName        string `json:"os_name,omitempty"`
+	OsVersion     string `json:"os_version,omitempty"`
+	AppVersion    string `json:"app_version,omitempty"`
+	UserAgent     string `json:"user_agent,omitempty"`
+	LastIpAddress string `json:"last_ip_address,omitempty"`
+	LastSeenAt    string `json:"last_seen_at,omitempty"`
+	IsTrusted     bool   `json:"is_trusted,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
@@ -0,0 +1,21 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListAuditLogsResponse struct {
+	Total  int64          `json:"total,omitempty"`
+	Limit  int            `json:"limit,omitempty"`
+	Offset int            `json:"offset,omitempty"`
+	Items  []AuditLogItem `json:"items,omitempty"`
+}
+
+type AuditLogItem struct {
+	ID              string `json:"id,omitempty"`
+	ActorAccountID  string `json:"actor_account_id,omitempty"`
+	Action          string `json:"action,omitempty"`
+	TargetAccountID string `json:"target_account_id,omitempty"`
+	Before          string `json:"before,omitempty"`
+	After           string `json:"after,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+	RequestID       string `json:"request_id,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+}
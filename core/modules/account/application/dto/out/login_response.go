@@ -6,4 +6,7 @@ type LoginResponse struct {
 	RefreshToken     string `json:"refresh_token,omitempty"`
 	AccessExpiresAt  int64  `json:"access_expires_at,omitempty"`
 	RefreshExpiresAt int64  `json:"refresh_expires_at,omitempty"`
+	StepUpRequired   bool   `json:"step_up_required,omitempty"`
+	StepUpToken      string `json:"step_up_token,omitempty"`
+	StepUpExpiresAt  int64  `json:"step_up_expires_at,omitempty"`
 }
@@ -0,0 +1,9 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type LoginStepUpResponse struct {
+	AccessToken      string `json:"access_token,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	AccessExpiresAt  int64  `json:"access_expires_at,omitempty"`
+	RefreshExpiresAt int64  `json:"refresh_expires_at,omitempty"`
+}
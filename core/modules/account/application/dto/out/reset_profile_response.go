@@ -0,0 +1,8 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ResetProfileResponse struct {
+	AccountID       string `json:"account_id,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
+}
@@ -0,0 +1,16 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ResolveContactCardResponse struct {
+	ID                  string `json:"id,omitempty"`
+	DisplayName         string `json:"display_name,omitempty"`
+	Username            string `json:"username,omitempty"`
+	AvatarObjectKey     string `json:"avatar_object_key,omitempty"`
+	Bio                 string `json:"bio,omitempty"`
+	Region              string `json:"region,omitempty"`
+	Gender              string `json:"gender,omitempty"`
+	CoverObjectKey      string `json:"cover_object_key,omitempty"`
+	IsSelf              bool   `json:"is_self,omitempty"`
+	IsFriend            bool   `json:"is_friend,omitempty"`
+	FriendRequestSource string `json:"friend_request_source,omitempty"`
+}
@@ -0,0 +1,6 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type RevokeUnrecognizedSessionResponse struct {
+	Message string `json:"message,omitempty"`
+}
@@ -15,4 +15,5 @@ type SearchUserItem struct {
 	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
 	Status          string `json:"status,omitempty"`
 	EmailVerified   bool   `json:"email_verified,omitempty"`
+	ContactToken    string `json:"contact_token,omitempty"`
 }
@@ -0,0 +1,7 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type UnbanAccountResponse struct {
+	AccountID string `json:"account_id,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
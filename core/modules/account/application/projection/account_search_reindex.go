@@ -0,0 +1,41 @@
+package projection
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// AccountScanner pages through every account in id order.
+type AccountScanner interface {
+	ListAccountsAfter(ctx context.Context, afterID string, limit int) ([]*entity.Account, error)
+}
+
+// ReindexAccounts writes every account to the search projection again. It
+// fills a fresh index after the document shape changes, since documents
+// written under the old shape are only replaced when an account changes.
+func ReindexAccounts(ctx context.Context, scanner AccountScanner, search SearchProjection, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	indexed := 0
+	afterID := ""
+	for {
+		accounts, err := scanner.ListAccountsAfter(ctx, afterID, batchSize)
+		if err != nil {
+			return indexed, stackErr.Error(err)
+		}
+		for _, account := range accounts {
+			if err := search.SyncAccount(ctx, account); err != nil {
+				return indexed, stackErr.Error(err)
+			}
+			indexed++
+		}
+		if len(accounts) < batchSize {
+			return indexed, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
@@ -0,0 +1,15 @@
+package projection
+
+import (
+	"context"
+	"time"
+)
+
+// AudienceListRepository mirrors the audience lists each account keeps in the
+// relationship module, so profile privacy can treat close friends and
+// restricted friends differently from other friends.
+type AudienceListRepository interface {
+	ProjectAudienceList(ctx context.Context, ownerID, friendID, audienceList string, updatedAt time.Time) error
+	RemoveBetween(ctx context.Context, accountID, otherAccountID string) error
+	GetAudienceList(ctx context.Context, ownerID, friendID string) (string, error)
+}
@@ -0,0 +1,14 @@
+package projection
+
+import (
+	"context"
+	"time"
+)
+
+// BlockRelationRepository mirrors the relationship module's block edges so
+// account lookups can hide profiles without calling across modules.
+type BlockRelationRepository interface {
+	ProjectBlocked(ctx context.Context, blockerID, blockedID string, blockedAt time.Time) error
+	RemoveBlocked(ctx context.Context, blockerID, blockedID string) error
+	IsBlockedBetween(ctx context.Context, accountID, otherAccountID string) (bool, error)
+}
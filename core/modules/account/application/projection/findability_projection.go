@@ -0,0 +1,27 @@
+package projection
+
+import (
+	"context"
+	"time"
+)
+
+// Findability mirrors the add-by switches each account keeps in the
+// relationship module, so account lookups can hide it on the channels it
+// switched off.
+type Findability struct {
+	ByUsername bool
+	ByEmail    bool
+	ByQR       bool
+}
+
+// DefaultFindability applies to accounts that never saved their settings.
+func DefaultFindability() Findability {
+	return Findability{ByUsername: true, ByEmail: true, ByQR: true}
+}
+
+type FindabilityRepository interface {
+	// ProjectFindability keeps the newest settings; an update older than the
+	// stored one is ignored.
+	ProjectFindability(ctx context.Context, accountID string, findability Findability, updatedAt time.Time) error
+	GetFindability(ctx context.Context, accountID string) (Findability, error)
+}
@@ -0,0 +1,14 @@
+package projection
+
+import (
+	"context"
+	"time"
+)
+
+// FriendshipRepository mirrors accepted friendships from the relationship
+// module so profile privacy can tell friends from strangers.
+type FriendshipRepository interface {
+	ProjectFriendship(ctx context.Context, accountID, friendID string, since time.Time) error
+	RemoveFriendship(ctx context.Context, accountID, friendID string) error
+	AreFriends(ctx context.Context, accountID, otherAccountID string) (bool, error)
+}
@@ -27,20 +27,49 @@ type processor struct {
 	consumer         []infraMessaging.Consumer
 	accountReadRepo  accountprojection.AccountReadRepository
 	searchProjection accountprojection.SearchProjection
+	blockRelations   accountprojection.BlockRelationRepository
+	friendships      accountprojection.FriendshipRepository
+	audienceLists    accountprojection.AudienceListRepository
+	findability      accountprojection.FindabilityRepository
 }
 
-func NewProcessor(cfg *config.Config, accountReadRepo accountprojection.AccountReadRepository, searchProjection accountprojection.SearchProjection) (Processor, error) {
+func NewProcessor(
+	cfg *config.Config,
+	accountReadRepo accountprojection.AccountReadRepository,
+	searchProjection accountprojection.SearchProjection,
+	blockRelations accountprojection.BlockRelationRepository,
+	friendships accountprojection.FriendshipRepository,
+	audienceLists accountprojection.AudienceListRepository,
+	findability accountprojection.FindabilityRepository,
+) (Processor, error) {
 	instance := &processor{
-		consumer:         make([]infraMessaging.Consumer, 0, 1),
+		consumer:         make([]infraMessaging.Consumer, 0, 2),
 		accountReadRepo:  accountReadRepo,
 		searchProjection: searchProjection,
+		blockRelations:   blockRelations,
+		friendships:      friendships,
+		audienceLists:    audienceLists,
+		findability:      findability,
 	}
 
 	topic := strings.TrimSpace(cfg.KafkaConfig.KafkaAccountConsumer.AccountOutboxTopic)
-	if topic == "" || accountReadRepo == nil || searchProjection == nil {
-		return instance, nil
+	if topic != "" && accountReadRepo != nil && searchProjection != nil {
+		if err := instance.addConsumer(cfg, topic, instance.handleAccountOutboxEvent); err != nil {
+			return nil, stackErr.Error(err)
+		}
 	}
 
+	relationshipTopic := strings.TrimSpace(cfg.KafkaConfig.KafkaAccountConsumer.RelationshipOutboxTopic)
+	if relationshipTopic != "" && blockRelations != nil && friendships != nil && audienceLists != nil && findability != nil {
+		if err := instance.addConsumer(cfg, relationshipTopic, instance.handleRelationshipOutboxEvent); err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+
+	return instance, nil
+}
+
+func (p *processor) addConsumer(cfg *config.Config, topic string, handle func(context.Context, []byte) error) error {
 	handlerName := fmt.Sprintf("account-projection-%s-handler", strings.ToLower(topic))
 	consumer, err := infraMessaging.NewConsumer(&infraMessaging.Config{
 		Servers:      cfg.KafkaConfig.KafkaServers,
@@ -51,15 +80,12 @@ func NewProcessor(cfg *config.Config, accountReadRepo accountprojection.AccountR
 		DLQ:          true,
 	})
 	if err != nil {
-		return nil, stackErr.Error(err)
+		return stackErr.Error(err)
 	}
 
-	consumer.SetHandler(func(ctx context.Context, value []byte) error {
-		return instance.handleAccountOutboxEvent(ctx, value)
-	})
-	instance.consumer = append(instance.consumer, consumer)
-
-	return instance, nil
+	consumer.SetHandler(handle)
+	p.consumer = append(p.consumer, consumer)
+	return nil
 }
 
 func (p *processor) Start() error {
@@ -91,9 +117,12 @@ func (p *processor) handleAccountOutboxEvent(ctx context.Context, value []byte)
 	case sharedevents.EventAccountCreated,
 		sharedevents.EventAccountUpdated,
 		sharedevents.EventAccountProfileUpdated,
+		sharedevents.EventAccountUsernameChanged,
+		sharedevents.EventAccountProfileDetailsUpdated,
 		sharedevents.EventAccountEmailVerified,
 		sharedevents.EventAccountPasswordChanged,
-		sharedevents.EventAccountBanned:
+		sharedevents.EventAccountBanned,
+		sharedevents.EventAccountUnbanned:
 		return p.syncAccount(ctx, event.AggregateID)
 	default:
 		return nil
@@ -117,3 +146,70 @@ func (p *processor) syncAccount(ctx context.Context, accountID string) error {
 	}
 	return nil
 }
+
+func (p *processor) handleRelationshipOutboxEvent(ctx context.Context, value []byte) error {
+	var event contracts.OutboxMessage
+	if err := json.Unmarshal(value, &event); err != nil {
+		return stackErr.Error(fmt.Errorf("unmarshal relationship outbox event failed: %w", err))
+	}
+
+	switch event.EventName {
+	case sharedevents.EventRelationshipPairFriendRequestAccepted:
+		var payload sharedevents.RelationshipPairFriendRequestAcceptedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode relationship friend request accepted payload failed: %w", err))
+		}
+		return stackErr.Error(p.friendships.ProjectFriendship(ctx, payload.RequesterID, payload.AddresseeID, payload.AcceptedAt))
+	case sharedevents.EventRelationshipPairUnfriended:
+		var payload sharedevents.RelationshipPairUnfriendedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode relationship unfriended payload failed: %w", err))
+		}
+		if err := p.friendships.RemoveFriendship(ctx, payload.UserID, payload.FriendID); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(p.audienceLists.RemoveBetween(ctx, payload.UserID, payload.FriendID))
+	case sharedevents.EventRelationshipPairBlocked:
+		var payload sharedevents.RelationshipPairBlockedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode relationship blocked payload failed: %w", err))
+		}
+		// Blocking ends the friendship on the relationship side without a
+		// separate unfriend event.
+		if err := p.friendships.RemoveFriendship(ctx, payload.BlockerID, payload.BlockedID); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := p.audienceLists.RemoveBetween(ctx, payload.BlockerID, payload.BlockedID); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(p.blockRelations.ProjectBlocked(ctx, payload.BlockerID, payload.BlockedID, payload.CreatedAt))
+	case sharedevents.EventRelationshipPairUnblocked:
+		var payload sharedevents.RelationshipPairUnblockedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode relationship unblocked payload failed: %w", err))
+		}
+		return stackErr.Error(p.blockRelations.RemoveBlocked(ctx, payload.BlockerID, payload.BlockedID))
+	case sharedevents.EventFriendContactAudienceListUpdated:
+		var payload sharedevents.FriendContactAudienceListUpdatedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode friend contact audience list payload failed: %w", err))
+		}
+		return stackErr.Error(p.audienceLists.ProjectAudienceList(ctx, payload.OwnerID, payload.FriendID, payload.AudienceList, payload.UpdatedAt))
+	case sharedevents.EventFriendRequestPrivacyUpdated:
+		var payload sharedevents.FriendRequestPrivacyUpdatedEvent
+		if err := contracts.UnmarshalEventData(event.EventData, &payload); err != nil {
+			return stackErr.Error(fmt.Errorf("decode friend request privacy payload failed: %w", err))
+		}
+		if err := p.findability.ProjectFindability(ctx, payload.AccountID, accountprojection.Findability{
+			ByUsername: payload.FindableByUsername,
+			ByEmail:    payload.FindableByEmail,
+			ByQR:       payload.FindableByQR,
+		}, payload.UpdatedAt); err != nil {
+			return stackErr.Error(err)
+		}
+		// The search document carries the flags, so it is reindexed with them.
+		return p.syncAccount(ctx, payload.AccountID)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,12 @@
+package projection
+
+import (
+	"context"
+	"time"
+)
+
+// ProfileViewRecorder publishes profile views so the relationship module can
+// weigh them in friend suggestions.
+type ProfileViewRecorder interface {
+	RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error
+}
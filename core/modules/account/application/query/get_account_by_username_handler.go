@@ -0,0 +1,126 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/application/support"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/contactcard"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var errAccountByUsernameNotFound = apperr.New("account.not_found", "account not found", http.StatusNotFound)
+
+type getAccountByUsernameHandler struct {
+	accountReadRepo projection.AccountReadRepository
+	blockRelations  projection.BlockRelationRepository
+	friendships     projection.FriendshipRepository
+	audienceLists   projection.AudienceListRepository
+	findability     projection.FindabilityRepository
+	profileViews    projection.ProfileViewRecorder
+	signer          contactcard.Signer
+}
+
+func NewGetAccountByUsernameHandler(
+	appCtx *appCtx.AppContext,
+	accountReadRepo projection.AccountReadRepository,
+	blockRelations projection.BlockRelationRepository,
+	friendships projection.FriendshipRepository,
+	audienceLists projection.AudienceListRepository,
+	findability projection.FindabilityRepository,
+	profileViews projection.ProfileViewRecorder,
+	signer contactcard.Signer,
+) cqrs.Handler[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse] {
+	return &getAccountByUsernameHandler{
+		accountReadRepo: accountReadRepo,
+		blockRelations:  blockRelations,
+		friendships:     friendships,
+		audienceLists:   audienceLists,
+		findability:     findability,
+		profileViews:    profileViews,
+		signer:          signer,
+	}
+}
+
+func (u *getAccountByUsernameHandler) Handle(ctx context.Context, req *in.GetAccountByUsernameRequest) (*out.GetAccountByUsernameResponse, error) {
+	log := logging.FromContext(ctx).Named("GetAccountByUsername")
+	viewerID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	account, err := u.accountReadRepo.GetAccountByUsername(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(errAccountByUsernameNotFound)
+		}
+		log.Errorw("Failed to get account by username", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	if account.Status != accounttypes.AccountStatusActive || account.IsBanned(time.Now().UTC()) {
+		return nil, stackErr.Error(errAccountByUsernameNotFound)
+	}
+
+	// A block in either direction hides the profile, the same answer as an
+	// unknown handle so the lookup does not reveal the block.
+	if account.ID != viewerID && u.blockRelations != nil {
+		blocked, err := u.blockRelations.IsBlockedBetween(ctx, viewerID, account.ID)
+		if err != nil {
+			log.Errorw("Failed to check block state", zap.Error(err))
+			return nil, stackErr.Error(err)
+		}
+		if blocked {
+			return nil, stackErr.Error(errAccountByUsernameNotFound)
+		}
+	}
+
+	viewer, err := resolveProfileViewer(ctx, u.friendships, u.audienceLists, viewerID, account.ID)
+	if err != nil {
+		log.Errorw("Failed to resolve friendship", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	if viewer == accounttypes.ProfileViewerSelf {
+		return support.ToGetAccountByUsernameResponse(account, viewer), nil
+	}
+
+	// Friends keep finding each other; anyone else gets the unknown-handle
+	// answer when the account switched username lookups off.
+	if !viewer.IsFriend() {
+		findability, err := u.findability.GetFindability(ctx, account.ID)
+		if err != nil {
+			log.Errorw("Failed to load findability", zap.Error(err))
+			return nil, stackErr.Error(err)
+		}
+		if !findability.ByUsername {
+			return nil, stackErr.Error(errAccountByUsernameNotFound)
+		}
+	}
+
+	// A lost view only weakens a suggestion, so it never fails the lookup.
+	if u.profileViews != nil {
+		if err := u.profileViews.RecordProfileView(ctx, viewerID, account.ID, time.Now().UTC()); err != nil {
+			log.Warnw("Failed to record profile view", zap.Error(err))
+		}
+	}
+
+	response := support.ToGetAccountByUsernameResponse(account, viewer)
+	response.ContactToken, err = support.LookupToken(u.signer, account.ID, viewerID, contactcard.FriendRequestSourceSearchUsername)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return response, nil
+}
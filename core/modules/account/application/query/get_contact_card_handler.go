@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+	"net/http"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/contactcard"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+var errContactCardNotIssued = apperr.New("account.contact_card_not_issued", "contact card has not been issued, reset it to create one", http.StatusConflict)
+
+type getContactCardHandler struct {
+	accountReadRepo projection.AccountReadRepository
+	issuer          support.ContactCardIssuer
+}
+
+func NewGetContactCardHandler(
+	appCtx *appCtx.AppContext,
+	accountReadRepo projection.AccountReadRepository,
+	signer contactcard.Signer,
+) cqrs.Handler[*in.GetContactCardRequest, *out.GetContactCardResponse] {
+	return &getContactCardHandler{
+		accountReadRepo: accountReadRepo,
+		issuer:          support.NewContactCardIssuer(appCtx.GetConfig().AuthConfig.ContactCard, signer),
+	}
+}
+
+func (u *getContactCardHandler) Handle(ctx context.Context, req *in.GetContactCardRequest) (*out.GetContactCardResponse, error) {
+	log := logging.FromContext(ctx).Named("GetContactCard")
+
+	accountID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	account, err := u.accountReadRepo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		log.Errorw("Failed to get account", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	if account.ContactCardNonce == "" {
+		return nil, stackErr.Error(errContactCardNotIssued)
+	}
+
+	card, err := u.issuer.Issue(account)
+	if err != nil {
+		log.Errorw("Failed to issue contact card", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.GetContactCardResponse{
+		Token:       card.Token,
+		QrContent:   card.QRContent,
+		QrPngBase64: card.QRPNG,
+		RotatedAt:   utils.FormatOptionalTime(card.RotatedAt),
+	}, nil
+}
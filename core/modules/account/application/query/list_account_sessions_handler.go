@@ -0,0 +1,137 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/contxt"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var errAdminTargetNotFound = apperr.New("account.not_found", "account not found", http.StatusNotFound)
+
+type listAccountSessionsHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewListAccountSessionsHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse] {
+	return &listAccountSessionsHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *listAccountSessionsHandler) Handle(ctx context.Context, req *in.ListAccountSessionsRequest) (*out.ListAccountSessionsResponse, error) {
+	log := logging.FromContext(ctx).Named("ListAccountSessions")
+
+	admin, err := support.AdminFromRepo(ctx, u.baseRepo)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accountAgg, err := u.baseRepo.AccountAggregateRepository().Load(ctx, req.AccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(errAdminTargetNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	if !accountAgg.IsRegistered() {
+		return nil, stackErr.Error(errAdminTargetNotFound)
+	}
+	targetAccountID := accountAgg.AggregateID()
+
+	sessionAggs, err := u.baseRepo.SessionAggregateRepository().ListByAccountID(ctx, targetAccountID)
+	if err != nil {
+		log.Errorw("Failed to list account sessions", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	deviceAggs, err := u.baseRepo.DeviceAggregateRepository().ListByAccountID(ctx, targetAccountID)
+	if err != nil {
+		log.Errorw("Failed to list account devices", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	// Reading another user's sessions exposes IPs and user agents, so the
+	// lookup itself is recorded in the audit log.
+	auditLog, err := entity.NewAdminAuditLog(
+		uuid.NewString(),
+		admin.AccountID,
+		accounttypes.AdminAuditActionViewSessions,
+		targetAccountID,
+		nil,
+		nil,
+		"",
+		contxt.RequestIDFromCtx(ctx),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := u.baseRepo.AdminAuditLogRepository().Append(ctx, auditLog); err != nil {
+		log.Errorw("Failed to append admin audit log", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	response := &out.ListAccountSessionsResponse{
+		AccountID: targetAccountID,
+		Sessions:  make([]out.AdminSessionItem, 0, len(sessionAggs)),
+		Devices:   make([]out.AdminDeviceItem, 0, len(deviceAggs)),
+	}
+	for _, sessionAgg := range sessionAggs {
+		session, err := sessionAgg.Snapshot()
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		response.Sessions = append(response.Sessions, out.AdminSessionItem{
+			ID:             session.ID,
+			DeviceID:       session.DeviceID,
+			Status:         string(session.Status),
+			IpAddress:      utils.StringValue(session.IPAddress),
+			UserAgent:      utils.StringValue(session.UserAgent),
+			LastActivityAt: utils.FormatOptionalTime(session.LastActivityAt),
+			ExpiresAt:      session.ExpiresAt.UTC().Format(time.RFC3339),
+			RevokedAt:      utils.FormatOptionalTime(session.RevokedAt),
+			RevokedReason:  utils.StringValue(session.RevokedReason),
+			CreatedAt:      session.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	for _, deviceAgg := range deviceAggs {
+		device, err := deviceAgg.Snapshot()
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		response.Devices = append(response.Devices, out.AdminDeviceItem{
+			ID:            device.ID,
+			DeviceUid:     device.DeviceUID,
+			DeviceName:    utils.StringValue(device.DeviceName),
+			DeviceType:    strings.TrimSpace(string(device.DeviceType)),
+			OsName:        utils.StringValue(device.OSName),
+			OsVersion:     utils.StringValue(device.OSVersion),
+			AppVersion:    utils.StringValue(device.AppVersion),
+			UserAgent:     utils.StringValue(device.UserAgent),
+			LastIpAddress: utils.StringValue(device.LastIPAddress),
+			LastSeenAt:    utils.FormatOptionalTime(device.LastSeenAt),
+			IsTrusted:     device.IsTrusted,
+			CreatedAt:     device.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,123 @@
+package query
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/account/domain/entity"
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAuditLogLimit = 50
+	maxAuditLogLimit     = 200
+)
+
+var errInvalidAuditLogFilter = apperr.New("account.invalid_audit_filter", "audit log filter is invalid", http.StatusBadRequest)
+
+type listAuditLogsHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewListAuditLogsHandler(appCtx *appCtx.AppContext, baseRepo repos.Repos) cqrs.Handler[*in.ListAuditLogsRequest, *out.ListAuditLogsResponse] {
+	return &listAuditLogsHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *listAuditLogsHandler) Handle(ctx context.Context, req *in.ListAuditLogsRequest) (*out.ListAuditLogsResponse, error) {
+	log := logging.FromContext(ctx).Named("ListAuditLogs")
+
+	if _, err := support.AdminFromRepo(ctx, u.baseRepo); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	filter, err := buildAuditLogFilter(req)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	logs, total, err := u.baseRepo.AdminAuditLogRepository().List(ctx, filter)
+	if err != nil {
+		log.Errorw("Failed to list admin audit logs", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]out.AuditLogItem, 0, len(logs))
+	for _, item := range logs {
+		items = append(items, out.AuditLogItem{
+			ID:              item.ID,
+			ActorAccountID:  item.ActorAccountID,
+			Action:          item.Action.String(),
+			TargetAccountID: item.TargetAccountID,
+			Before:          item.Before,
+			After:           item.After,
+			Reason:          item.Reason,
+			RequestID:       item.RequestID,
+			CreatedAt:       item.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return &out.ListAuditLogsResponse{
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+		Items:  items,
+	}, nil
+}
+
+func buildAuditLogFilter(req *in.ListAuditLogsRequest) (entity.AdminAuditLogFilter, error) {
+	filter := entity.AdminAuditLogFilter{
+		ActorAccountID:  req.ActorAccountID,
+		TargetAccountID: req.TargetAccountID,
+		RequestID:       req.RequestID,
+		Limit:           req.Limit,
+		Offset:          req.Offset,
+	}
+	if req.Action != "" {
+		action, err := accounttypes.ParseAdminAuditAction(req.Action)
+		if err != nil {
+			return filter, errInvalidAuditLogFilter
+		}
+		filter.Action = action
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return filter, errInvalidAuditLogFilter
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return filter, errInvalidAuditLogFilter
+		}
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errInvalidAuditLogFilter
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLogLimit
+	}
+	if filter.Limit > maxAuditLogLimit {
+		filter.Limit = maxAuditLogLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return filter, nil
+}
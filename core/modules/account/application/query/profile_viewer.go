@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/account/application/projection"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// resolveProfileViewer classifies the viewer from the owner's side. Friends
+// the owner put on an audience list see the profile as that list allows.
+func resolveProfileViewer(
+	ctx context.Context,
+	friendships projection.FriendshipRepository,
+	audienceLists projection.AudienceListRepository,
+	viewerID, ownerID string,
+) (accounttypes.ProfileViewer, error) {
+	if viewerID == ownerID {
+		return accounttypes.ProfileViewerSelf, nil
+	}
+	if friendships == nil {
+		return accounttypes.ProfileViewerStranger, nil
+	}
+	friends, err := friendships.AreFriends(ctx, viewerID, ownerID)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	if !friends {
+		return accounttypes.ProfileViewerStranger, nil
+	}
+	if audienceLists == nil {
+		return accounttypes.ProfileViewerFriend, nil
+	}
+
+	list, err := audienceLists.GetAudienceList(ctx, ownerID, viewerID)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	switch list {
+	case "close_friends":
+		return accounttypes.ProfileViewerCloseFriend, nil
+	case "restricted":
+		return accounttypes.ProfileViewerRestricted, nil
+	default:
+		return accounttypes.ProfileViewerFriend, nil
+	}
+}
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/application/support"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/contactcard"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var errContactCardInvalid = apperr.New("account.contact_card_invalid", "contact card is invalid or has been reset", http.StatusNotFound)
+
+type resolveContactCardHandler struct {
+	signer          contactcard.Signer
+	accountReadRepo projection.AccountReadRepository
+	blockRelations  projection.BlockRelationRepository
+	friendships     projection.FriendshipRepository
+	audienceLists   projection.AudienceListRepository
+	findability     projection.FindabilityRepository
+}
+
+func NewResolveContactCardHandler(
+	appCtx *appCtx.AppContext,
+	signer contactcard.Signer,
+	accountReadRepo projection.AccountReadRepository,
+	blockRelations projection.BlockRelationRepository,
+	friendships projection.FriendshipRepository,
+	audienceLists projection.AudienceListRepository,
+	findability projection.FindabilityRepository,
+) cqrs.Handler[*in.ResolveContactCardRequest, *out.ResolveContactCardResponse] {
+	return &resolveContactCardHandler{
+		signer:          signer,
+		accountReadRepo: accountReadRepo,
+		blockRelations:  blockRelations,
+		friendships:     friendships,
+		audienceLists:   audienceLists,
+		findability:     findability,
+	}
+}
+
+func (u *resolveContactCardHandler) Handle(ctx context.Context, req *in.ResolveContactCardRequest) (*out.ResolveContactCardResponse, error) {
+	log := logging.FromContext(ctx).Named("ResolveContactCard")
+	viewerID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	card, err := u.signer.Parse(contactcard.ExtractToken(req.Token))
+	if err != nil {
+		return nil, stackErr.Error(errContactCardInvalid)
+	}
+
+	account, err := u.accountReadRepo.GetAccountByID(ctx, card.AccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(errContactCardInvalid)
+		}
+		log.Errorw("Failed to load contact card owner", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	// A reset rotates the nonce, so codes printed before it no longer match.
+	if account.ContactCardNonce == "" || account.ContactCardNonce != card.Nonce {
+		return nil, stackErr.Error(errContactCardInvalid)
+	}
+	if account.Status != accounttypes.AccountStatusActive || account.IsBanned(time.Now().UTC()) {
+		return nil, stackErr.Error(errContactCardInvalid)
+	}
+
+	if account.ID != viewerID {
+		blocked, err := u.blockRelations.IsBlockedBetween(ctx, viewerID, account.ID)
+		if err != nil {
+			log.Errorw("Failed to check block state", zap.Error(err))
+			return nil, stackErr.Error(err)
+		}
+		if blocked {
+			return nil, stackErr.Error(errContactCardInvalid)
+		}
+	}
+
+	viewer, err := resolveProfileViewer(ctx, u.friendships, u.audienceLists, viewerID, account.ID)
+	if err != nil {
+		log.Errorw("Failed to resolve friendship", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+
+	// Switching QR adds off makes every code answer as if it had been reset,
+	// except to friends and the owner.
+	if viewer != accounttypes.ProfileViewerSelf && !viewer.IsFriend() {
+		findability, err := u.findability.GetFindability(ctx, account.ID)
+		if err != nil {
+			log.Errorw("Failed to load findability", zap.Error(err))
+			return nil, stackErr.Error(err)
+		}
+		if !findability.ByQR {
+			return nil, stackErr.Error(errContactCardInvalid)
+		}
+	}
+
+	details := account.Profile.VisibleTo(viewer)
+	return &out.ResolveContactCardResponse{
+		ID:                  account.ID,
+		DisplayName:         account.DisplayName,
+		Username:            utils.StringValue(account.Username),
+		AvatarObjectKey:     utils.StringValue(account.AvatarObjectKey),
+		Bio:                 details.Bio,
+		Region:              details.Region,
+		Gender:              details.Gender.String(),
+		CoverObjectKey:      utils.StringValue(details.CoverObjectKey),
+		IsSelf:              viewer == accounttypes.ProfileViewerSelf,
+		IsFriend:            viewer.IsFriend(),
+		FriendRequestSource: contactcard.FriendRequestSource,
+	}, nil
+}
@@ -8,7 +8,9 @@ import (
 	"wechat-clone/core/modules/account/application/dto/in"
 	"wechat-clone/core/modules/account/application/dto/out"
 	"wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/application/support"
 	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 
@@ -17,14 +19,17 @@ import (
 
 type searchUsersHandler struct {
 	accountReadRepo projection.AccountReadRepository
+	signer          contactcard.Signer
 }
 
 func NewSearchUsers(
 	appCtx *appCtx.AppContext,
 	accountReadRepo projection.AccountReadRepository,
+	signer contactcard.Signer,
 ) cqrs.Handler[*in.SearchUsersRequest, *out.SearchUsersResponse] {
 	return &searchUsersHandler{
 		accountReadRepo: accountReadRepo,
+		signer:          signer,
 	}
 }
 
@@ -32,6 +37,10 @@ func (u *searchUsersHandler) Handle(ctx context.Context, req *in.SearchUsersRequ
 	if req == nil {
 		return nil, stackErr.Error(fmt.Errorf("request is required"))
 	}
+	viewerID, err := support.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	q := strings.TrimSpace(req.Q)
 	if q == "" {
@@ -56,23 +65,48 @@ func (u *searchUsersHandler) Handle(ctx context.Context, req *in.SearchUsersRequ
 		return nil, stackErr.Error(fmt.Errorf("search users: %w", err))
 	}
 
-	return &out.SearchUsersResponse{
-		Items: lo.Map(accounts, func(account *entity.Account, _ int) out.SearchUserItem {
-			return out.SearchUserItem{
-				ID:          account.ID,
-				DisplayName: account.DisplayName,
-				Username: func(data *string) string {
-					return lo.Ternary(data != nil, *data, "")
-				}(account.Username),
-				AvatarObjectKey: func(data *string) string {
-					return lo.Ternary(data != nil, *data, "")
-				}(account.AvatarObjectKey),
-				Status:        account.Status.String(),
-				EmailVerified: account.EmailVerifiedAt != nil,
+	items := make([]out.SearchUserItem, 0, len(accounts))
+	for _, account := range accounts {
+		item := out.SearchUserItem{
+			ID:          account.ID,
+			DisplayName: account.DisplayName,
+			Username: func(data *string) string {
+				return lo.Ternary(data != nil, *data, "")
+			}(account.Username),
+			AvatarObjectKey: func(data *string) string {
+				return lo.Ternary(data != nil, *data, "")
+			}(account.AvatarObjectKey),
+			Status:        account.Status.String(),
+			EmailVerified: account.EmailVerifiedAt != nil,
+		}
+		if via := searchMatchSource(account, q); via != "" && account.ID != viewerID {
+			item.ContactToken, err = support.LookupToken(u.signer, account.ID, viewerID, via)
+			if err != nil {
+				return nil, stackErr.Error(err)
 			}
-		}),
+		}
+		items = append(items, item)
+	}
+
+	return &out.SearchUsersResponse{
+		Items:  items,
 		Total:  total,
 		Limit:  limit,
 		Offset: offset,
 	}, nil
 }
+
+// searchMatchSource names the lookup that found the account. A display name
+// match is not a lookup channel and gets no card; a result matching neither
+// the username nor the display name can only have matched on email, which is
+// not returned by the search.
+func searchMatchSource(account *entity.Account, q string) string {
+	q = strings.ToLower(q)
+	if account.Username != nil && strings.HasPrefix(strings.ToLower(*account.Username), q) {
+		return contactcard.FriendRequestSourceSearchUsername
+	}
+	if strings.Contains(strings.ToLower(account.DisplayName), q) {
+		return ""
+	}
+	return contactcard.FriendRequestSourceSearchEmail
+}
@@ -3,11 +3,17 @@ package support
 import (
 	"context"
 	"errors"
+	"net/http"
 
+	repos "wechat-clone/core/modules/account/domain/repos"
+	accounttypes "wechat-clone/core/modules/account/types"
 	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/apperr"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
+var ErrAdminRequired = apperr.New("account.admin_required", "admin role is required", http.StatusForbidden)
+
 func ActorFromCtx(ctx context.Context) (*actorctx.Actor, error) {
 	actor, ok := actorctx.FromContext(ctx)
 	if !ok || actor == nil {
@@ -23,3 +29,68 @@ func AccountIDFromCtx(ctx context.Context) (string, error) {
 	}
 	return actor.AccountID, nil
 }
+
+func AdminFromCtx(ctx context.Context) (*actorctx.Actor, error) {
+	actor, err := ActorFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !actor.HasRole(accounttypes.AccountRoleAdmin.String()) {
+		return nil, stackErr.Error(ErrAdminRequired)
+	}
+	return actor, nil
+}
+
+// AdminFromRepo checks the admin claim of the token against the account
+// record, so a demoted admin's token stops working before it expires.
+func AdminFromRepo(ctx context.Context, baseRepo repos.Repos) (*actorctx.Actor, error) {
+	actor, err := ActorFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	isAdmin, err := ActorIsAdmin(ctx, actor, NewAdminRoles(baseRepo))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !isAdmin {
+		return nil, stackErr.Error(ErrAdminRequired)
+	}
+	return actor, nil
+}
+
+// AdminRoles reads the role from the account record. Admin endpoints of
+// other modules check it through ActorIsAdmin instead of trusting the token.
+//
+//go:generate mockgen -package=support -destination=account_context_mock.go -source=account_context.go
+type AdminRoles interface {
+	IsAdmin(ctx context.Context, accountID string) (bool, error)
+}
+
+type adminRoles struct {
+	baseRepo repos.Repos
+}
+
+func NewAdminRoles(baseRepo repos.Repos) AdminRoles {
+	return &adminRoles{baseRepo: baseRepo}
+}
+
+func (a *adminRoles) IsAdmin(ctx context.Context, accountID string) (bool, error) {
+	accountAgg, err := a.baseRepo.AccountAggregateRepository().Load(ctx, accountID)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return accountAgg != nil && accountAgg.Role == accounttypes.AccountRoleAdmin, nil
+}
+
+// ActorIsAdmin reports whether the actor claims the admin role and the
+// account record still grants it.
+func ActorIsAdmin(ctx context.Context, actor *actorctx.Actor, admins AdminRoles) (bool, error) {
+	if actor == nil || !actor.HasRole(accounttypes.AccountRoleAdmin.String()) {
+		return false, nil
+	}
+	isAdmin, err := admins.IsAdmin(ctx, actor.AccountID)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return isAdmin, nil
+}
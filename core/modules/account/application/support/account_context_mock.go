@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_context.go
+//
+// Generated by this command:
+//
+//	mockgen -package=support -destination=account_context_mock.go -source=account_context.go
+//
+
+// Package support is a generated GoMock package.
+package support
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminRoles is a mock of AdminRoles interface.
+type MockAdminRoles struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminRolesMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminRolesMockRecorder is the mock recorder for MockAdminRoles.
+type MockAdminRolesMockRecorder struct {
+	mock *MockAdminRoles
+}
+
+// NewMockAdminRoles creates a new mock instance.
+func NewMockAdminRoles(ctrl *gomock.Controller) *MockAdminRoles {
+	mock := &MockAdminRoles{ctrl: ctrl}
+	mock.recorder = &MockAdminRolesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminRoles) EXPECT() *MockAdminRolesMockRecorder {
+	return m.recorder
+}
+
+// IsAdmin mocks base method.
+func (m *MockAdminRoles) IsAdmin(ctx context.Context, accountID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, accountID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAdminRolesMockRecorder) IsAdmin(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAdminRoles)(nil).IsAdmin), ctx, accountID)
+}
@@ -0,0 +1,37 @@
+package support
+
+import (
+	"context"
+	"testing"
+
+	"wechat-clone/core/shared/pkg/actorctx"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestActorIsAdminRechecksTheAccountRecord(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	admins := NewMockAdminRoles(ctrl)
+	ctx := context.Background()
+
+	isAdmin, err := ActorIsAdmin(ctx, &actorctx.Actor{AccountID: "user-1", Role: "user"}, admins)
+	if err != nil || isAdmin {
+		t.Fatalf("expected a user token to be refused without a lookup, got %v, %v", isAdmin, err)
+	}
+
+	admins.EXPECT().IsAdmin(gomock.Any(), "admin-1").Return(false, nil)
+	isAdmin, err = ActorIsAdmin(ctx, &actorctx.Actor{AccountID: "admin-1", Role: "admin"}, admins)
+	if err != nil || isAdmin {
+		t.Fatalf("expected a demoted admin's token to be refused, got %v, %v", isAdmin, err)
+	}
+
+	admins.EXPECT().IsAdmin(gomock.Any(), "admin-2").Return(true, nil)
+	isAdmin, err = ActorIsAdmin(ctx, &actorctx.Actor{AccountID: "admin-2", Role: "admin"}, admins)
+	if err != nil || !isAdmin {
+		t.Fatalf("expected an admin to pass, got %v, %v", isAdmin, err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"wechat-clone/core/modules/account/application/dto/out"
 	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/modules/account/domain/rules"
+	accounttypes "wechat-clone/core/modules/account/types"
 	"wechat-clone/core/shared/utils"
 )
 
@@ -13,19 +15,30 @@ func ToGetProfileResponse(account *entity.Account) *out.GetProfileResponse {
 		return nil
 	}
 
+	privacy := account.Profile.Privacy.WithDefaults()
 	return &out.GetProfileResponse{
-		ID:                account.ID,
-		DisplayName:       account.DisplayName,
-		Email:             account.Email.Value(),
-		Username:          utils.StringValue(account.Username),
-		AvatarObjectKey:   utils.StringValue(account.AvatarObjectKey),
-		Status:            account.Status.String(),
-		EmailVerified:     account.EmailVerifiedAt != nil,
-		EmailVerifiedAt:   utils.FormatOptionalTime(account.EmailVerifiedAt),
-		LastLoginAt:       utils.FormatOptionalTime(account.LastLoginAt),
-		PasswordChangedAt: utils.FormatOptionalTime(account.PasswordChangedAt),
-		CreatedAt:         account.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:         account.UpdatedAt.UTC().Format(time.RFC3339),
+		ID:                 account.ID,
+		DisplayName:        account.DisplayName,
+		Email:              account.Email.Value(),
+		Username:           utils.StringValue(account.Username),
+		AvatarObjectKey:    utils.StringValue(account.AvatarObjectKey),
+		Status:             account.Status.String(),
+		EmailVerified:      account.EmailVerifiedAt != nil,
+		EmailVerifiedAt:    utils.FormatOptionalTime(account.EmailVerifiedAt),
+		LastLoginAt:        utils.FormatOptionalTime(account.LastLoginAt),
+		PasswordChangedAt:  utils.FormatOptionalTime(account.PasswordChangedAt),
+		CreatedAt:          account.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          account.UpdatedAt.UTC().Format(time.RFC3339),
+		Bio:                account.Profile.Bio,
+		Region:             account.Profile.Region,
+		Gender:             account.Profile.Gender.String(),
+		Birthday:           formatBirthday(account.Profile.Birthday),
+		CoverObjectKey:     utils.StringValue(account.Profile.CoverObjectKey),
+		BioVisibility:      privacy.Bio.String(),
+		RegionVisibility:   privacy.Region.String(),
+		GenderVisibility:   privacy.Gender.String(),
+		BirthdayVisibility: privacy.Birthday.String(),
+		CoverVisibility:    privacy.Cover.String(),
 	}
 }
 
@@ -34,18 +47,59 @@ func ToUpdateProfileResponse(account *entity.Account) *out.UpdateProfileResponse
 		return nil
 	}
 
+	privacy := account.Profile.Privacy.WithDefaults()
 	return &out.UpdateProfileResponse{
-		ID:                account.ID,
-		DisplayName:       account.DisplayName,
-		Email:             account.Email.Value(),
-		Username:          utils.StringValue(account.Username),
-		AvatarObjectKey:   utils.StringValue(account.AvatarObjectKey),
-		Status:            account.Status.String(),
-		EmailVerified:     account.EmailVerifiedAt != nil,
-		EmailVerifiedAt:   utils.FormatOptionalTime(account.EmailVerifiedAt),
-		LastLoginAt:       utils.FormatOptionalTime(account.LastLoginAt),
-		PasswordChangedAt: utils.FormatOptionalTime(account.PasswordChangedAt),
-		CreatedAt:         account.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:         account.UpdatedAt.UTC().Format(time.RFC3339),
+		ID:                 account.ID,
+		DisplayName:        account.DisplayName,
+		Email:              account.Email.Value(),
+		Username:           utils.StringValue(account.Username),
+		AvatarObjectKey:    utils.StringValue(account.AvatarObjectKey),
+		Status:             account.Status.String(),
+		EmailVerified:      account.EmailVerifiedAt != nil,
+		EmailVerifiedAt:    utils.FormatOptionalTime(account.EmailVerifiedAt),
+		LastLoginAt:        utils.FormatOptionalTime(account.LastLoginAt),
+		PasswordChangedAt:  utils.FormatOptionalTime(account.PasswordChangedAt),
+		CreatedAt:          account.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          account.UpdatedAt.UTC().Format(time.RFC3339),
+		Bio:                account.Profile.Bio,
+		Region:             account.Profile.Region,
+		Gender:             account.Profile.Gender.String(),
+		Birthday:           formatBirthday(account.Profile.Birthday),
+		CoverObjectKey:     utils.StringValue(account.Profile.CoverObjectKey),
+		BioVisibility:      privacy.Bio.String(),
+		RegionVisibility:   privacy.Region.String(),
+		GenderVisibility:   privacy.Gender.String(),
+		BirthdayVisibility: privacy.Birthday.String(),
+		CoverVisibility:    privacy.Cover.String(),
 	}
 }
+
+// ToGetAccountByUsernameResponse renders another user's profile with the
+// extended fields already filtered for the viewer.
+func ToGetAccountByUsernameResponse(account *entity.Account, viewer accounttypes.ProfileViewer) *out.GetAccountByUsernameResponse {
+	if account == nil {
+		return nil
+	}
+
+	details := account.Profile.VisibleTo(viewer)
+	return &out.GetAccountByUsernameResponse{
+		ID:              account.ID,
+		DisplayName:     account.DisplayName,
+		Username:        utils.StringValue(account.Username),
+		AvatarObjectKey: utils.StringValue(account.AvatarObjectKey),
+		Status:          account.Status.String(),
+		Bio:             details.Bio,
+		Region:          details.Region,
+		Gender:          details.Gender.String(),
+		Birthday:        formatBirthday(details.Birthday),
+		CoverObjectKey:  utils.StringValue(details.CoverObjectKey),
+		IsFriend:        viewer.IsFriend(),
+	}
+}
+
+func formatBirthday(birthday *time.Time) string {
+	if birthday == nil {
+		return ""
+	}
+	return birthday.UTC().Format(rules.BirthdayLayout)
+}
@@ -0,0 +1,90 @@
+package support
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/contactcard"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// ContactCardIssuer turns an account's current contact-card nonce into a
+// signed token and the QR image that carries it.
+type ContactCardIssuer struct {
+	signer  contactcard.Signer
+	linkURL string
+	qrSize  int
+}
+
+type IssuedContactCard struct {
+	Token     string
+	QRContent string
+	QRPNG     string
+	RotatedAt *time.Time
+}
+
+func NewContactCardIssuer(cfg config.ContactCardConfig, signer contactcard.Signer) ContactCardIssuer {
+	return ContactCardIssuer{
+		signer:  signer,
+		linkURL: strings.TrimSpace(cfg.LinkURL),
+		qrSize:  cfg.QRSize,
+	}
+}
+
+func (i ContactCardIssuer) Issue(account *entity.Account) (*IssuedContactCard, error) {
+	token, err := i.signer.Sign(contactcard.Card{
+		AccountID: account.ID,
+		Nonce:     account.ContactCardNonce,
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	content := i.qrContent(token)
+	png, err := contactcard.RenderPNG(content, i.qrSize)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &IssuedContactCard{
+		Token:     token,
+		QRContent: content,
+		QRPNG:     base64.StdEncoding.EncodeToString(png),
+		RotatedAt: account.ContactCardRotatedAt,
+	}, nil
+}
+
+// LookupToken signs the card a search hands out with each account it finds,
+// so a friend request sent from the result records how the account was found.
+func LookupToken(signer contactcard.Signer, accountID, viewerID, via string) (string, error) {
+	token, err := signer.Sign(contactcard.Card{
+		AccountID: accountID,
+		Via:       via,
+		IssuedTo:  viewerID,
+		ExpiresAt: time.Now().UTC().Add(contactcard.LookupCardTTL),
+	})
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	return token, nil
+}
+
+// qrContent wraps the token in the configured link so a generic camera app
+// can open it; without a link the bare token is encoded.
+func (i ContactCardIssuer) qrContent(token string) string {
+	if i.linkURL == "" {
+		return token
+	}
+	link, err := url.Parse(i.linkURL)
+	if err != nil {
+		return token
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
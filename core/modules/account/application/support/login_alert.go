@@ -0,0 +1,98 @@
+package support
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/config"
+	sharedcache "wechat-clone/core/shared/infra/cache"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+const loginAlertRevokeTTL = 7 * 24 * time.Hour
+
+var ErrLoginAlertRevokeInvalid = errors.New("login alert revoke token is invalid or already used")
+
+// LoginAlertRevoker issues and consumes the single-use tokens behind the
+// "this wasn't me" link of a new-device alert. The token only lives in the
+// cache; the link is minted by whoever sends the alert, so it never travels
+// in an event.
+type LoginAlertRevoker struct {
+	cache   sharedcache.Cache
+	linkURL string
+}
+
+type LoginAlertRevoke struct {
+	AccountID string `json:"account_id"`
+	SessionID string `json:"session_id"`
+}
+
+func NewLoginAlertRevoker(cfg config.LoginRiskConfig, cache sharedcache.Cache) LoginAlertRevoker {
+	return LoginAlertRevoker{
+		cache:   cache,
+		linkURL: strings.TrimSpace(cfg.RevokeSessionURL),
+	}
+}
+
+// Issue stores a token that lets the owner revoke the session without
+// signing in and returns the link carrying it.
+func (r LoginAlertRevoker) Issue(ctx context.Context, accountID, sessionID string) (string, error) {
+	token := uuid.NewString()
+	if err := r.cache.SetObject(ctx, loginAlertRevokeCacheKey(token), LoginAlertRevoke{
+		AccountID: accountID,
+		SessionID: sessionID,
+	}, loginAlertRevokeTTL); err != nil {
+		return "", stackErr.Error(err)
+	}
+	return r.link(token), nil
+}
+
+func (r LoginAlertRevoker) Consume(ctx context.Context, token string) (*LoginAlertRevoke, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, stackErr.Error(ErrLoginAlertRevokeInvalid)
+	}
+	key := loginAlertRevokeCacheKey(token)
+	if r.cache.Exists(ctx, key) == 0 {
+		return nil, stackErr.Error(ErrLoginAlertRevokeInvalid)
+	}
+	data, err := r.cache.Get(ctx, key)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	var payload LoginAlertRevoke
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := r.cache.Delete(ctx, key); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if payload.AccountID == "" || payload.SessionID == "" {
+		return nil, stackErr.Error(ErrLoginAlertRevokeInvalid)
+	}
+	return &payload, nil
+}
+
+func (r LoginAlertRevoker) link(token string) string {
+	if r.linkURL == "" {
+		return ""
+	}
+	parsed, err := url.Parse(r.linkURL)
+	if err != nil {
+		return ""
+	}
+	query := parsed.Query()
+	query.Set("token", token)
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func loginAlertRevokeCacheKey(token string) string {
+	return "account:login_alert_revoke:" + token
+}
@@ -0,0 +1,13 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	accountrepo "wechat-clone/core/modules/account/infra/persistent/repository"
+)
+
+// BuildAdminRoles lets admin endpoints of other modules re-read the role from
+// the account record rather than trusting the token claim alone.
+func BuildAdminRoles(appContext *appCtx.AppContext) accountsupport.AdminRoles {
+	return accountsupport.NewAdminRoles(accountrepo.NewRepoImpl(appContext.GetDB(), appContext.GetCache()))
+}
@@ -11,7 +11,9 @@ import (
 )
 
 func buildSearchProjectionProcessor(cfg *config.Config, appCtx *appCtx.AppContext) (modruntime.Module, error) {
-	searchProjection, err := accountes.NewAccountSearchProjection(cfg.ElasticsearchConfig, appCtx.GetElasticsearchClient())
+	findability := accountrepo.NewFindabilityRepoImpl(appCtx.GetDB())
+
+	searchProjection, err := accountes.NewAccountSearchProjection(cfg.ElasticsearchConfig, appCtx.GetElasticsearchClient(), findability)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -22,7 +24,13 @@ func buildSearchProjectionProcessor(cfg *config.Config, appCtx *appCtx.AppContex
 	}
 	accountReadRepo := accountrepo.NewAccountRepoImpl(appCtx.GetDB(), appCtx.GetCache(), true, nil, searchRepository)
 
-	return processor.NewProcessor(cfg, accountReadRepo, searchProjection)
+	blockRelations := accountrepo.NewBlockRelationRepoImpl(appCtx.GetDB())
+
+	friendships := accountrepo.NewFriendshipRepoImpl(appCtx.GetDB())
+
+	audienceLists := accountrepo.NewAudienceListRepoImpl(appCtx.GetDB())
+
+	return processor.NewProcessor(cfg, accountReadRepo, searchProjection, blockRelations, friendships, audienceLists, findability)
 }
 
 func buildProjectionRuntime(cfg *config.Config, appCtx *appCtx.AppContext) (modruntime.Module, error) {
@@ -10,6 +10,8 @@ import (
 	accountrepo "wechat-clone/core/modules/account/infra/persistent/repository"
 	accountes "wechat-clone/core/modules/account/infra/projection/elasticsearch"
 	accountserver "wechat-clone/core/modules/account/transport/server"
+	"wechat-clone/core/shared/infra/geoip"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 	"wechat-clone/core/shared/transport/http"
@@ -25,7 +27,23 @@ func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (http.H
 	authProviderRegistry := provider.NewProviderRegistry()
 	authProviderRegistry.Register(google.NewGoogleProvider(ctx, appContext.GetConfig()))
 
-	login := cqrs.NewDispatcher(command.NewLoginHandler(appContext, accountRepos))
+	geoResolver, err := geoip.NewResolver(appContext.GetConfig().AuthConfig.LoginRisk.GeoIPDatabasePath)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	contactCardSigner, err := contactcard.NewHMACSigner(appContext.GetConfig().AuthConfig.ContactCard.Secret)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	blockRelations := accountrepo.NewBlockRelationRepoImpl(appContext.GetDB())
+	friendships := accountrepo.NewFriendshipRepoImpl(appContext.GetDB())
+	audienceLists := accountrepo.NewAudienceListRepoImpl(appContext.GetDB())
+	findability := accountrepo.NewFindabilityRepoImpl(appContext.GetDB())
+	profileViews := accountrepo.NewProfileViewRecorderImpl(appContext.GetDB())
+
+	login := cqrs.NewDispatcher(command.NewLoginHandler(appContext, accountRepos, geoResolver))
+	loginStepUp := cqrs.NewDispatcher(command.NewLoginStepUpHandler(appContext, accountRepos, geoResolver))
+	revokeUnrecognizedSession := cqrs.NewDispatcher(command.NewRevokeUnrecognizedSessionHandler(appContext, accountRepos))
 	register := cqrs.NewDispatcher(command.NewRegisterHandler(appContext, accountRepos))
 	logout := cqrs.NewDispatcher(command.NewLogoutHandler(appContext, accountRepos))
 	getProfile := cqrs.NewDispatcher(query.NewGetProfileHandler(appContext, accountReadRepo))
@@ -35,12 +53,25 @@ func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (http.H
 	verifyEmail := cqrs.NewDispatcher(command.NewVerifyEmailHandler(appContext, accountRepos))
 	confirmVerifyEmail := cqrs.NewDispatcher(command.NewConfirmVerifyEmailHandler(appContext, accountRepos))
 	changePassword := cqrs.NewDispatcher(command.NewChangePasswordHandler(appContext, accountRepos))
-	searchUsers := cqrs.NewDispatcher(query.NewSearchUsers(appContext, accountReadRepo))
+	searchUsers := cqrs.NewDispatcher(query.NewSearchUsers(appContext, accountReadRepo, contactCardSigner))
 	refresh := cqrs.NewDispatcher(command.NewRefresh(appContext, accountRepos))
 	loginGoogle := cqrs.NewDispatcher(command.NewLoginGoogle(appContext, accountRepos, authProviderRegistry))
-	callbackGoogle := cqrs.NewDispatcher(command.NewCallbackGoogle(appContext, accountRepos, authProviderRegistry))
+	callbackGoogle := cqrs.NewDispatcher(command.NewCallbackGoogle(appContext, accountRepos, authProviderRegistry, geoResolver))
+	banAccount := cqrs.NewDispatcher(command.NewBanAccountHandler(appContext, accountRepos))
+	unbanAccount := cqrs.NewDispatcher(command.NewUnbanAccountHandler(appContext, accountRepos))
+	forceLogout := cqrs.NewDispatcher(command.NewForceLogoutHandler(appContext, accountRepos))
+	resetProfile := cqrs.NewDispatcher(command.NewResetProfileHandler(appContext, accountRepos))
+	listAccountSessions := cqrs.NewDispatcher(query.NewListAccountSessionsHandler(appContext, accountRepos))
+	listAuditLogs := cqrs.NewDispatcher(query.NewListAuditLogsHandler(appContext, accountRepos))
+	claimUsername := cqrs.NewDispatcher(command.NewClaimUsernameHandler(appContext, accountRepos))
+	getAccountByUsername := cqrs.NewDispatcher(query.NewGetAccountByUsernameHandler(appContext, accountReadRepo, blockRelations, friendships, audienceLists, findability, profileViews, contactCardSigner))
+	getContactCard := cqrs.NewDispatcher(query.NewGetContactCardHandler(appContext, accountReadRepo, contactCardSigner))
+	resetContactCard := cqrs.NewDispatcher(command.NewResetContactCardHandler(appContext, accountRepos, contactCardSigner))
+	resolveContactCard := cqrs.NewDispatcher(query.NewResolveContactCardHandler(appContext, contactCardSigner, accountReadRepo, blockRelations, friendships, audienceLists, findability))
 	server, err := accountserver.NewHTTPServer(
 		login,
+		loginStepUp,
+		revokeUnrecognizedSession,
 		register,
 		logout,
 		refresh,
@@ -54,6 +85,17 @@ func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (http.H
 		searchUsers,
 		loginGoogle,
 		callbackGoogle,
+		banAccount,
+		unbanAccount,
+		forceLogout,
+		resetProfile,
+		listAccountSessions,
+		listAuditLogs,
+		claimUsername,
+		getAccountByUsername,
+		getContactCard,
+		resetContactCard,
+		resolveContactCard,
 	)
 	if err != nil {
 		return nil, stackErr.Error(err)
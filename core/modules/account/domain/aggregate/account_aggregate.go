@@ -27,8 +27,10 @@ type AccountAggregate struct {
 	Email                          string
 	DisplayName                    string
 	Username                       *string
+	UsernameChangedAt              *time.Time
 	AvatarObjectKey                *string
 	Status                         accounttypes.AccountStatus
+	Role                           accounttypes.AccountRole
 	PasswordHash                   string
 	EmailVerifiedAt                *time.Time
 	LastEmailVerificationRequested *time.Time
@@ -38,6 +40,10 @@ type AccountAggregate struct {
 	UpdatedAt                      time.Time
 	BannedReason                   string
 	BannedUntil                    *time.Time
+	LockedUntil                    *time.Time
+	Profile                        entity.ProfileDetails
+	ContactCardNonce               string
+	ContactCardRotatedAt           *time.Time
 }
 
 func (a *AccountAggregate) RegisterEvents(register event.RegisterEventsFunc) error {
@@ -45,10 +51,16 @@ func (a *AccountAggregate) RegisterEvents(register event.RegisterEventsFunc) err
 		&EventAccountCreated{},
 		&EventAccountUpdated{},
 		&EventAccountProfileUpdated{},
+		&EventAccountUsernameChanged{},
+		&EventAccountProfileDetailsUpdated{},
+		&EventAccountContactCardRotated{},
 		&EventAccountEmailVerificationRequested{},
 		&EventAccountEmailVerified{},
 		&EventAccountPasswordChanged{},
 		&EventAccountBanned{},
+		&EventAccountUnbanned{},
+		&EventAccountLocked{},
+		&EventAccountNewDeviceLogin{},
 	)
 }
 
@@ -60,6 +72,12 @@ func (a *AccountAggregate) Transition(e event.Event) error {
 		return a.applyAccountUpdated(data)
 	case *EventAccountProfileUpdated:
 		return a.applyAccountProfileUpdated(data)
+	case *EventAccountUsernameChanged:
+		return a.applyAccountUsernameChanged(data)
+	case *EventAccountProfileDetailsUpdated:
+		return a.applyAccountProfileDetailsUpdated(data)
+	case *EventAccountContactCardRotated:
+		return a.applyAccountContactCardRotated(data)
 	case *EventAccountEmailVerificationRequested:
 		return a.applyAccountEmailVerificationRequested(data)
 	case *EventAccountEmailVerified:
@@ -68,6 +86,12 @@ func (a *AccountAggregate) Transition(e event.Event) error {
 		return a.applyAccountPasswordChanged(data)
 	case *EventAccountBanned:
 		return a.applyAccountBanned(data)
+	case *EventAccountUnbanned:
+		return a.applyAccountUnbanned(data)
+	case *EventAccountLocked:
+		return a.applyAccountLocked(data)
+	case *EventAccountNewDeviceLogin:
+		return a.applyAccountNewDeviceLogin(data)
 	default:
 		return event.ErrUnsupportedEventType
 	}
@@ -79,6 +103,7 @@ func (a *AccountAggregate) applyAccountCreated(aggregateID string, data *EventAc
 	a.PasswordHash = data.PasswordHash
 	a.DisplayName = data.DisplayName
 	a.Status = data.Status
+	a.Role = accounttypes.AccountRoleUser
 	a.CreatedAt = data.CreatedAt
 	a.UpdatedAt = data.CreatedAt
 	return nil
@@ -98,6 +123,36 @@ func (a *AccountAggregate) applyAccountProfileUpdated(data *EventAccountProfileU
 	return nil
 }
 
+func (a *AccountAggregate) applyAccountUsernameChanged(data *EventAccountUsernameChanged) error {
+	username := data.Username
+	changedAt := data.ChangedAt
+	a.Username = &username
+	a.UsernameChangedAt = &changedAt
+	a.UpdatedAt = changedAt
+	return nil
+}
+
+func (a *AccountAggregate) applyAccountProfileDetailsUpdated(data *EventAccountProfileDetailsUpdated) error {
+	a.Profile = entity.ProfileDetails{
+		Bio:            data.Bio,
+		Region:         data.Region,
+		Gender:         data.Gender,
+		Birthday:       utils.ClonePtr(data.Birthday),
+		CoverObjectKey: utils.ClonePtr(data.CoverObjectKey),
+		Privacy:        data.Privacy,
+	}
+	a.UpdatedAt = data.UpdatedAt
+	return nil
+}
+
+func (a *AccountAggregate) applyAccountContactCardRotated(data *EventAccountContactCardRotated) error {
+	rotatedAt := data.RotatedAt
+	a.ContactCardNonce = data.Nonce
+	a.ContactCardRotatedAt = &rotatedAt
+	a.UpdatedAt = rotatedAt
+	return nil
+}
+
 func (a *AccountAggregate) applyAccountEmailVerificationRequested(data *EventAccountEmailVerificationRequested) error {
 	requestedAt := data.RequestedAt
 	a.LastEmailVerificationRequested = &requestedAt
@@ -122,7 +177,30 @@ func (a *AccountAggregate) applyAccountPasswordChanged(data *EventAccountPasswor
 
 func (a *AccountAggregate) applyAccountBanned(data *EventAccountBanned) error {
 	a.BannedReason = data.BanReason
-	a.BannedUntil = data.BanUntil
+	a.BannedUntil = utils.ClonePtr(data.BanUntil)
+	if !data.BannedAt.IsZero() {
+		a.UpdatedAt = data.BannedAt
+	}
+	return nil
+}
+
+func (a *AccountAggregate) applyAccountUnbanned(data *EventAccountUnbanned) error {
+	a.BannedReason = ""
+	a.BannedUntil = nil
+	a.UpdatedAt = data.UnbannedAt
+	return nil
+}
+
+func (a *AccountAggregate) applyAccountLocked(data *EventAccountLocked) error {
+	lockedUntil := data.LockedUntil
+	a.LockedUntil = &lockedUntil
+	a.UpdatedAt = data.LockedAt
+	return nil
+}
+
+// applyAccountNewDeviceLogin is a no-op: the event exists to alert the owner and
+// carries no state the aggregate needs to keep.
+func (a *AccountAggregate) applyAccountNewDeviceLogin(data *EventAccountNewDeviceLogin) error {
 	return nil
 }
 
@@ -145,14 +223,17 @@ func (a *AccountAggregate) Register(
 		return stackErr.Error(err)
 	}
 
-	return a.ApplyChange(a, &EventAccountCreated{
+	if err := a.ApplyChange(a, &EventAccountCreated{
 		AccountID:    a.AggregateID(),
 		Email:        email.Value(),
 		PasswordHash: passwordHash.Value(),
 		DisplayName:  normalizedDisplayName,
 		Status:       accounttypes.AccountStatusActive,
 		CreatedAt:    now,
-	})
+	}); err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(a.RotateContactCard(now))
 }
 
 func (a *AccountAggregate) OpenRegister(email, displayName, avatarObjectKey string, now time.Time) error {
@@ -196,7 +277,7 @@ func (a *AccountAggregate) OpenRegister(email, displayName, avatarObjectKey stri
 			return stackErr.Error(err)
 		}
 	}
-	return nil
+	return stackErr.Error(a.RotateContactCard(now))
 }
 
 func (a *AccountAggregate) UpdateProfile(displayName string, username, avatarObjectKey *string, updatedAt time.Time) (bool, error) {
@@ -240,6 +321,313 @@ func (a *AccountAggregate) UpdateProfile(displayName string, username, avatarObj
 	return true, nil
 }
 
+// ProfileDetailsUpdate carries the extended profile fields to change. A nil
+// field keeps its current value; an empty string clears it.
+type ProfileDetailsUpdate struct {
+	Bio                *string
+	Region             *string
+	Gender             *string
+	Birthday           *string
+	CoverObjectKey     *string
+	BioVisibility      *string
+	RegionVisibility   *string
+	GenderVisibility   *string
+	BirthdayVisibility *string
+	CoverVisibility    *string
+}
+
+func (a *AccountAggregate) UpdateProfileDetails(update ProfileDetailsUpdate, updatedAt time.Time) (bool, error) {
+	updatedAt, err := normalizeAccountOccurredAt(updatedAt)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return false, stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+
+	next := a.Profile
+	next.Privacy = next.Privacy.WithDefaults()
+	if next.Gender == "" {
+		next.Gender = accounttypes.GenderUnspecified
+	}
+	if update.Bio != nil {
+		if next.Bio, err = rules.NormalizeBio(*update.Bio); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+	if update.Region != nil {
+		if next.Region, err = rules.NormalizeRegion(*update.Region); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+	if update.Gender != nil {
+		if next.Gender, err = rules.NormalizeGender(*update.Gender); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+	if update.Birthday != nil {
+		if next.Birthday, err = rules.NormalizeBirthday(*update.Birthday, updatedAt); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+	if update.CoverObjectKey != nil {
+		next.CoverObjectKey = rules.NormalizeOptionalString(*update.CoverObjectKey)
+	}
+	visibilities := []struct {
+		value  *string
+		target *accounttypes.ProfileVisibility
+	}{
+		{update.BioVisibility, &next.Privacy.Bio},
+		{update.RegionVisibility, &next.Privacy.Region},
+		{update.GenderVisibility, &next.Privacy.Gender},
+		{update.BirthdayVisibility, &next.Privacy.Birthday},
+		{update.CoverVisibility, &next.Privacy.Cover},
+	}
+	for _, visibility := range visibilities {
+		if visibility.value == nil {
+			continue
+		}
+		if *visibility.target, err = rules.NormalizeVisibility(*visibility.value); err != nil {
+			return false, stackErr.Error(err)
+		}
+	}
+
+	if profileDetailsEqual(a.Profile, next) {
+		return false, nil
+	}
+
+	if err := a.ApplyChange(a, &EventAccountProfileDetailsUpdated{
+		AccountID:      a.AggregateID(),
+		Bio:            next.Bio,
+		Region:         next.Region,
+		Gender:         next.Gender,
+		Birthday:       next.Birthday,
+		CoverObjectKey: next.CoverObjectKey,
+		Privacy:        next.Privacy,
+		UpdatedAt:      updatedAt,
+	}); err != nil {
+		return false, stackErr.Error(err)
+	}
+	return true, nil
+}
+
+func profileDetailsEqual(left, right entity.ProfileDetails) bool {
+	sameBirthday := (left.Birthday == nil && right.Birthday == nil) ||
+		(left.Birthday != nil && right.Birthday != nil && left.Birthday.Equal(*right.Birthday))
+	return left.Bio == right.Bio &&
+		left.Region == right.Region &&
+		left.Gender == right.Gender &&
+		sameBirthday &&
+		rules.EqualOptionalString(left.CoverObjectKey, right.CoverObjectKey) &&
+		left.Privacy == right.Privacy
+}
+
+// ClaimUsername sets the account handle. The first claim is free; later
+// changes must wait for cooldown since the previous change. It returns the
+// handle that was released, if any, so the caller can hold it in reserve.
+func (a *AccountAggregate) ClaimUsername(username string, cooldown time.Duration, now time.Time) (*string, bool, error) {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return nil, false, stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+
+	normalized, err := rules.NormalizeUsername(username)
+	if err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+	if a.Username != nil && *a.Username == normalized {
+		return nil, false, nil
+	}
+	if a.UsernameChangedAt != nil && cooldown > 0 && now.Before(a.UsernameChangedAt.Add(cooldown)) {
+		return nil, false, stackErr.Error(rules.ErrAccountUsernameChangeCooldown)
+	}
+
+	previous := utils.ClonePtr(a.Username)
+	if err := a.ApplyChange(a, &EventAccountUsernameChanged{
+		AccountID:        a.AggregateID(),
+		Username:         normalized,
+		PreviousUsername: previous,
+		ChangedAt:        now,
+	}); err != nil {
+		return nil, false, stackErr.Error(err)
+	}
+
+	return previous, true, nil
+}
+
+// UsernameCooldownEndsAt reports when the handle may next be changed, or nil
+// when a change is allowed right away.
+func (a *AccountAggregate) UsernameCooldownEndsAt(cooldown time.Duration, now time.Time) *time.Time {
+	if a.UsernameChangedAt == nil || cooldown <= 0 {
+		return nil
+	}
+	endsAt := a.UsernameChangedAt.Add(cooldown)
+	if !now.Before(endsAt) {
+		return nil
+	}
+	return &endsAt
+}
+
+// HasContactCard reports whether a contact-card nonce has been issued yet.
+func (a *AccountAggregate) HasContactCard() bool {
+	return a.ContactCardNonce != ""
+}
+
+// RotateContactCard issues a fresh contact-card nonce. Tokens signed over the
+// previous nonce stop resolving once this is saved.
+func (a *AccountAggregate) RotateContactCard(now time.Time) error {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+
+	return stackErr.Error(a.ApplyChange(a, &EventAccountContactCardRotated{
+		AccountID: a.AggregateID(),
+		Nonce:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		RotatedAt: now,
+	}))
+}
+
+// ResetProfile restores moderator-selected profile fields to their defaults.
+// The username is left untouched because it is an identity handle, not content.
+func (a *AccountAggregate) ResetProfile(resetDisplayName, resetAvatar bool, updatedAt time.Time) (bool, error) {
+	if !a.IsRegistered() {
+		return false, stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+
+	displayName := a.DisplayName
+	if resetDisplayName {
+		displayName = rules.DefaultDisplayName(a.AccountID)
+	}
+	var avatarObjectKey *string
+	if resetAvatar {
+		emptyAvatar := ""
+		avatarObjectKey = &emptyAvatar
+	}
+
+	return a.UpdateProfile(displayName, nil, avatarObjectKey, updatedAt)
+}
+
+func (a *AccountAggregate) Ban(reason string, bannedUntil *time.Time, now time.Time) error {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+
+	normalizedReason, err := rules.NormalizeBanReason(reason)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	var normalizedUntil *time.Time
+	if bannedUntil != nil {
+		until := bannedUntil.UTC()
+		if !until.After(now) {
+			return stackErr.Error(rules.ErrAccountBanUntilInPast)
+		}
+		normalizedUntil = &until
+	}
+
+	return a.ApplyChange(a, &EventAccountBanned{
+		AccountID: a.AggregateID(),
+		BanReason: normalizedReason,
+		BanUntil:  normalizedUntil,
+		BannedAt:  now,
+	})
+}
+
+func (a *AccountAggregate) Unban(now time.Time) error {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+	if !a.IsBanned(now) {
+		return stackErr.Error(rules.ErrAccountNotBanned)
+	}
+
+	return a.ApplyChange(a, &EventAccountUnbanned{
+		AccountID:  a.AggregateID(),
+		UnbannedAt: now,
+	})
+}
+
+func (a *AccountAggregate) EnsureNotBanned(now time.Time) error {
+	if a.IsBanned(now) {
+		return stackErr.Error(rules.ErrAccountBanned)
+	}
+	return nil
+}
+
+// Lock blocks logins until the given time. Locks only ever extend: a shorter
+// lock than the current one is a no-op.
+func (a *AccountAggregate) Lock(reason string, lockedUntil time.Time, now time.Time) (bool, error) {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return false, stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+	lockedUntil = lockedUntil.UTC()
+	if !lockedUntil.After(now) {
+		return false, stackErr.Error(rules.ErrAccountLockUntilInPast)
+	}
+	if a.LockedUntil != nil && !lockedUntil.After(*a.LockedUntil) {
+		return false, nil
+	}
+
+	if err := a.ApplyChange(a, &EventAccountLocked{
+		AccountID:   a.AggregateID(),
+		Reason:      strings.TrimSpace(reason),
+		LockedUntil: lockedUntil,
+		LockedAt:    now,
+	}); err != nil {
+		return false, stackErr.Error(err)
+	}
+	return true, nil
+}
+
+func (a *AccountAggregate) EnsureNotLocked(now time.Time) error {
+	if a.IsLocked(now) {
+		return stackErr.Error(rules.ErrAccountLocked)
+	}
+	return nil
+}
+
+// RecordNewDeviceLogin publishes a login from a device the account has not used
+// before so the owner can be alerted and revoke the session.
+func (a *AccountAggregate) RecordNewDeviceLogin(login EventAccountNewDeviceLogin, now time.Time) error {
+	now, err := normalizeAccountOccurredAt(now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !a.IsRegistered() {
+		return stackErr.Error(rules.ErrAccountNotRegistered)
+	}
+	login.SessionID = strings.TrimSpace(login.SessionID)
+	login.DeviceID = strings.TrimSpace(login.DeviceID)
+	if login.SessionID == "" || login.DeviceID == "" {
+		return stackErr.Error(rules.ErrAccountLoginDeviceRequired)
+	}
+
+	login.AccountID = a.AggregateID()
+	login.Email = a.Email
+	login.DisplayName = a.DisplayName
+	login.LoggedInAt = now
+	return a.ApplyChange(a, &login)
+}
+
 func (a *AccountAggregate) RequestEmailVerification(token string, requestedAt time.Time) error {
 	requestedAt, err := normalizeAccountOccurredAt(requestedAt)
 	if err != nil {
@@ -329,22 +717,32 @@ func (a *AccountAggregate) Snapshot() (*entity.Account, error) {
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	role, err := rules.NormalizeRole(a.Role)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	return &entity.Account{
-		ID:                a.AccountID,
-		Email:             email,
-		PasswordHash:      passwordHash,
-		DisplayName:       a.DisplayName,
-		Username:          utils.ClonePtr(a.Username),
-		AvatarObjectKey:   utils.ClonePtr(a.AvatarObjectKey),
-		Status:            status,
-		EmailVerifiedAt:   utils.ClonePtr(a.EmailVerifiedAt),
-		LastLoginAt:       utils.ClonePtr(a.LastLoginAt),
-		PasswordChangedAt: utils.ClonePtr(a.PasswordChangedAt),
-		CreatedAt:         a.CreatedAt,
-		UpdatedAt:         a.UpdatedAt,
-		BannedReason:      a.BannedReason,
-		BannedUntil:       utils.ClonePtr(a.BannedUntil),
+		ID:                   a.AccountID,
+		Email:                email,
+		PasswordHash:         passwordHash,
+		DisplayName:          a.DisplayName,
+		Username:             utils.ClonePtr(a.Username),
+		UsernameChangedAt:    utils.ClonePtr(a.UsernameChangedAt),
+		AvatarObjectKey:      utils.ClonePtr(a.AvatarObjectKey),
+		Status:               status,
+		Role:                 role,
+		EmailVerifiedAt:      utils.ClonePtr(a.EmailVerifiedAt),
+		LastLoginAt:          utils.ClonePtr(a.LastLoginAt),
+		PasswordChangedAt:    utils.ClonePtr(a.PasswordChangedAt),
+		CreatedAt:            a.CreatedAt,
+		UpdatedAt:            a.UpdatedAt,
+		BannedReason:         a.BannedReason,
+		BannedUntil:          utils.ClonePtr(a.BannedUntil),
+		LockedUntil:          utils.ClonePtr(a.LockedUntil),
+		Profile:              cloneProfileDetails(a.Profile),
+		ContactCardNonce:     a.ContactCardNonce,
+		ContactCardRotatedAt: utils.ClonePtr(a.ContactCardRotatedAt),
 	}, nil
 }
 
@@ -358,8 +756,10 @@ func (a *AccountAggregate) RestoreFromProjection(snapshot *entity.Account, versi
 	a.PasswordHash = snapshot.PasswordHash.Value()
 	a.DisplayName = snapshot.DisplayName
 	a.Username = utils.ClonePtr(snapshot.Username)
+	a.UsernameChangedAt = utils.ClonePtr(snapshot.UsernameChangedAt)
 	a.AvatarObjectKey = utils.ClonePtr(snapshot.AvatarObjectKey)
 	a.Status = snapshot.Status
+	a.Role = snapshot.Role
 	a.EmailVerifiedAt = utils.ClonePtr(snapshot.EmailVerifiedAt)
 	a.LastLoginAt = utils.ClonePtr(snapshot.LastLoginAt)
 	a.PasswordChangedAt = utils.ClonePtr(snapshot.PasswordChangedAt)
@@ -367,6 +767,10 @@ func (a *AccountAggregate) RestoreFromProjection(snapshot *entity.Account, versi
 	a.UpdatedAt = snapshot.UpdatedAt
 	a.BannedReason = snapshot.BannedReason
 	a.BannedUntil = utils.ClonePtr(snapshot.BannedUntil)
+	a.LockedUntil = utils.ClonePtr(snapshot.LockedUntil)
+	a.Profile = cloneProfileDetails(snapshot.Profile)
+	a.ContactCardNonce = snapshot.ContactCardNonce
+	a.ContactCardRotatedAt = utils.ClonePtr(snapshot.ContactCardRotatedAt)
 	a.SetInternal(snapshot.ID, version, version)
 	return nil
 }
@@ -391,12 +795,19 @@ func (a *AccountAggregate) MergeProjection(snapshot *entity.Account) {
 	if a.Username == nil {
 		a.Username = utils.ClonePtr(snapshot.Username)
 	}
+	if a.UsernameChangedAt == nil {
+		a.UsernameChangedAt = utils.ClonePtr(snapshot.UsernameChangedAt)
+	}
 	if a.AvatarObjectKey == nil {
 		a.AvatarObjectKey = utils.ClonePtr(snapshot.AvatarObjectKey)
 	}
 	if a.Status == "" {
 		a.Status = snapshot.Status
 	}
+	// Roles are granted outside the event stream, so the projection is authoritative.
+	if snapshot.Role != "" {
+		a.Role = snapshot.Role
+	}
 	if a.EmailVerifiedAt == nil {
 		a.EmailVerifiedAt = utils.ClonePtr(snapshot.EmailVerifiedAt)
 	}
@@ -416,6 +827,24 @@ func (a *AccountAggregate) MergeProjection(snapshot *entity.Account) {
 	if a.BannedUntil == nil {
 		a.BannedUntil = utils.ClonePtr(snapshot.BannedUntil)
 	}
+	if a.LockedUntil == nil {
+		a.LockedUntil = utils.ClonePtr(snapshot.LockedUntil)
+	}
+	if profileDetailsEqual(a.Profile, entity.ProfileDetails{}) {
+		a.Profile = cloneProfileDetails(snapshot.Profile)
+	}
+	if a.ContactCardNonce == "" {
+		a.ContactCardNonce = snapshot.ContactCardNonce
+	}
+	if a.ContactCardRotatedAt == nil {
+		a.ContactCardRotatedAt = utils.ClonePtr(snapshot.ContactCardRotatedAt)
+	}
+}
+
+func cloneProfileDetails(details entity.ProfileDetails) entity.ProfileDetails {
+	details.Birthday = utils.ClonePtr(details.Birthday)
+	details.CoverObjectKey = utils.ClonePtr(details.CoverObjectKey)
+	return details
 }
 
 func (a *AccountAggregate) CurrentPasswordHash() (valueobject.HashedPassword, error) {
@@ -430,6 +859,17 @@ func (a *AccountAggregate) IsEmailVerified() bool {
 	return a.EmailVerifiedAt != nil
 }
 
+func (a *AccountAggregate) IsBanned(now time.Time) bool {
+	if a.BannedReason == "" {
+		return false
+	}
+	return a.BannedUntil == nil || now.Before(*a.BannedUntil)
+}
+
+func (a *AccountAggregate) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
 func (a *AccountAggregate) HasChangePassword() bool {
 	return a.PasswordChangedAt != nil
 }
@@ -2,6 +2,7 @@ package aggregate
 
 import (
 	"time"
+	"wechat-clone/core/modules/account/domain/entity"
 	accounttypes "wechat-clone/core/modules/account/types"
 )
 
@@ -28,6 +29,30 @@ type EventAccountProfileUpdated struct {
 	UpdatedAt       time.Time
 }
 
+type EventAccountProfileDetailsUpdated struct {
+	AccountID      string
+	Bio            string
+	Region         string
+	Gender         accounttypes.Gender
+	Birthday       *time.Time
+	CoverObjectKey *string
+	Privacy        entity.ProfilePrivacy
+	UpdatedAt      time.Time
+}
+
+type EventAccountUsernameChanged struct {
+	AccountID        string
+	Username         string
+	PreviousUsername *string
+	ChangedAt        time.Time
+}
+
+type EventAccountContactCardRotated struct {
+	AccountID string
+	Nonce     string
+	RotatedAt time.Time
+}
+
 type EventAccountEmailVerificationRequested struct {
 	AccountID         string
 	Email             string
@@ -50,4 +75,30 @@ type EventAccountBanned struct {
 	AccountID string
 	BanReason string
 	BanUntil  *time.Time
+	BannedAt  time.Time
+}
+
+type EventAccountUnbanned struct {
+	AccountID  string
+	UnbannedAt time.Time
+}
+
+type EventAccountLocked struct {
+	AccountID   string
+	Reason      string
+	LockedUntil time.Time
+	LockedAt    time.Time
+}
+
+type EventAccountNewDeviceLogin struct {
+	AccountID   string
+	Email       string
+	DisplayName string
+	SessionID   string
+	DeviceID    string
+	DeviceName  string
+	IPAddress   string
+	UserAgent   string
+	CountryCode string
+	LoggedInAt  time.Time
 }
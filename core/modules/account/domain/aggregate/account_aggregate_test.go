@@ -1,9 +1,11 @@
 package aggregate
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"wechat-clone/core/modules/account/domain/rules"
 	valueobject "wechat-clone/core/modules/account/domain/value_object"
 	accounttypes "wechat-clone/core/modules/account/types"
 )
@@ -101,3 +103,278 @@ func TestAccountAggregateUpdateProfileKeepsNilFieldsUntouched(t *testing.T) {
 		t.Fatalf("AvatarObjectKey = %v, want nil", agg.AvatarObjectKey)
 	}
 }
+
+func newRegisteredAccountAggregate(t *testing.T) *AccountAggregate {
+	t.Helper()
+
+	agg, err := NewAccountAggregate("3f2b8c1d-0000-4000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("NewAccountAggregate() error = %v", err)
+	}
+	email, err := valueobject.NewEmail("user@example.com")
+	if err != nil {
+		t.Fatalf("NewEmail() error = %v", err)
+	}
+	passwordHash, err := valueobject.NewHashedPassword("hashed-password")
+	if err != nil {
+		t.Fatalf("NewHashedPassword() error = %v", err)
+	}
+	if err := agg.Register(email, passwordHash, "User", time.Now().UTC()); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	return agg
+}
+
+func TestAccountAggregateBanAndUnban(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	now := time.Now().UTC()
+
+	if err := agg.Ban(" ", nil, now); !errors.Is(err, rules.ErrAccountBanReasonRequired) {
+		t.Fatalf("Ban() empty reason error = %v, want %v", err, rules.ErrAccountBanReasonRequired)
+	}
+	past := now.Add(-time.Hour)
+	if err := agg.Ban("spam", &past, now); !errors.Is(err, rules.ErrAccountBanUntilInPast) {
+		t.Fatalf("Ban() past until error = %v, want %v", err, rules.ErrAccountBanUntilInPast)
+	}
+
+	until := now.Add(24 * time.Hour)
+	if err := agg.Ban("spam", &until, now); err != nil {
+		t.Fatalf("Ban() error = %v", err)
+	}
+	if !agg.IsBanned(now) {
+		t.Fatalf("IsBanned() = false, want true")
+	}
+	if agg.IsBanned(until.Add(time.Second)) {
+		t.Fatalf("IsBanned() after expiry = true, want false")
+	}
+	if err := agg.EnsureNotBanned(now); !errors.Is(err, rules.ErrAccountBanned) {
+		t.Fatalf("EnsureNotBanned() error = %v, want %v", err, rules.ErrAccountBanned)
+	}
+
+	if err := agg.Unban(now); err != nil {
+		t.Fatalf("Unban() error = %v", err)
+	}
+	if agg.IsBanned(now) {
+		t.Fatalf("IsBanned() after unban = true, want false")
+	}
+	if agg.BannedReason != "" || agg.BannedUntil != nil {
+		t.Fatalf("ban fields = (%q, %v), want cleared", agg.BannedReason, agg.BannedUntil)
+	}
+	if err := agg.Unban(now); !errors.Is(err, rules.ErrAccountNotBanned) {
+		t.Fatalf("Unban() twice error = %v, want %v", err, rules.ErrAccountNotBanned)
+	}
+}
+
+func TestAccountAggregateResetProfile(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+
+	username := "keeper"
+	avatar := "avatar/object"
+	if _, err := agg.UpdateProfile("Offensive Name", &username, &avatar, time.Now().UTC()); err != nil {
+		t.Fatalf("UpdateProfile() setup error = %v", err)
+	}
+
+	updated, err := agg.ResetProfile(true, true, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("ResetProfile() error = %v", err)
+	}
+	if !updated {
+		t.Fatalf("ResetProfile() updated = false, want true")
+	}
+	if want := rules.DefaultDisplayName(agg.AccountID); agg.DisplayName != want {
+		t.Fatalf("DisplayName = %q, want %q", agg.DisplayName, want)
+	}
+	if agg.AvatarObjectKey != nil {
+		t.Fatalf("AvatarObjectKey = %v, want nil", agg.AvatarObjectKey)
+	}
+	if agg.Username == nil || *agg.Username != username {
+		t.Fatalf("Username = %v, want %q", agg.Username, username)
+	}
+
+	updated, err = agg.ResetProfile(true, true, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("ResetProfile() repeat error = %v", err)
+	}
+	if updated {
+		t.Fatalf("ResetProfile() repeat updated = true, want false")
+	}
+}
+
+func TestAccountAggregateClaimUsernameEnforcesCooldown(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	now := time.Now().UTC()
+	cooldown := 24 * time.Hour
+
+	previous, changed, err := agg.ClaimUsername(" Alice_01 ", cooldown, now)
+	if err != nil {
+		t.Fatalf("ClaimUsername() first claim error = %v", err)
+	}
+	if !changed || previous != nil {
+		t.Fatalf("ClaimUsername() first claim = (%v, %v), want (nil, true)", previous, changed)
+	}
+	if agg.Username == nil || *agg.Username != "Alice_01" {
+		t.Fatalf("Username = %v, want %q", agg.Username, "Alice_01")
+	}
+
+	if _, changed, err := agg.ClaimUsername("Alice_01", cooldown, now.Add(time.Minute)); err != nil || changed {
+		t.Fatalf("ClaimUsername() same handle = (%v, %v), want unchanged", changed, err)
+	}
+	if _, _, err := agg.ClaimUsername("alice_02", cooldown, now.Add(time.Hour)); !errors.Is(err, rules.ErrAccountUsernameChangeCooldown) {
+		t.Fatalf("ClaimUsername() within cooldown error = %v, want %v", err, rules.ErrAccountUsernameChangeCooldown)
+	}
+	if endsAt := agg.UsernameCooldownEndsAt(cooldown, now.Add(time.Hour)); endsAt == nil || !endsAt.Equal(now.Add(cooldown)) {
+		t.Fatalf("UsernameCooldownEndsAt() = %v, want %v", endsAt, now.Add(cooldown))
+	}
+
+	previous, changed, err = agg.ClaimUsername("alice_02", cooldown, now.Add(cooldown))
+	if err != nil || !changed {
+		t.Fatalf("ClaimUsername() after cooldown = (%v, %v), want changed", changed, err)
+	}
+	if previous == nil || *previous != "Alice_01" {
+		t.Fatalf("previous = %v, want %q", previous, "Alice_01")
+	}
+}
+
+func TestAccountAggregateClaimUsernameRejectsInvalidHandle(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+
+	for _, username := range []string{"", "ab", "has space", ".leading", "double..dot", "trailing."} {
+		if _, _, err := agg.ClaimUsername(username, time.Hour, time.Now().UTC()); err == nil {
+			t.Fatalf("ClaimUsername(%q) error = nil, want validation error", username)
+		}
+	}
+	if agg.Username != nil {
+		t.Fatalf("Username = %v, want nil", agg.Username)
+	}
+}
+
+func TestAccountAggregateUpdateProfileDetails(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	now := time.Now().UTC()
+	bio := "  hello there  "
+	birthday := "1995-04-12"
+	friends := "friends"
+
+	changed, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{
+		Bio:              &bio,
+		Birthday:         &birthday,
+		RegionVisibility: &friends,
+	}, now)
+	if err != nil || !changed {
+		t.Fatalf("UpdateProfileDetails() = (%v, %v), want changed", changed, err)
+	}
+	if agg.Profile.Bio != "hello there" {
+		t.Fatalf("Bio = %q, want %q", agg.Profile.Bio, "hello there")
+	}
+	if agg.Profile.Birthday == nil || agg.Profile.Birthday.Format(rules.BirthdayLayout) != birthday {
+		t.Fatalf("Birthday = %v, want %s", agg.Profile.Birthday, birthday)
+	}
+	if agg.Profile.Privacy.Region != accounttypes.ProfileVisibilityFriends {
+		t.Fatalf("Privacy.Region = %q, want %q", agg.Profile.Privacy.Region, accounttypes.ProfileVisibilityFriends)
+	}
+	if agg.Profile.Privacy.Bio != accounttypes.ProfileVisibilityEveryone {
+		t.Fatalf("Privacy.Bio = %q, want %q", agg.Profile.Privacy.Bio, accounttypes.ProfileVisibilityEveryone)
+	}
+
+	if changed, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{Bio: &bio}, now); err != nil || changed {
+		t.Fatalf("UpdateProfileDetails() same values = (%v, %v), want unchanged", changed, err)
+	}
+
+	future := now.AddDate(1, 0, 0).Format(rules.BirthdayLayout)
+	if _, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{Birthday: &future}, now); !errors.Is(err, rules.ErrAccountBirthdayInvalid) {
+		t.Fatalf("UpdateProfileDetails() future birthday error = %v, want %v", err, rules.ErrAccountBirthdayInvalid)
+	}
+	invalid := "public"
+	if _, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{BioVisibility: &invalid}, now); !errors.Is(err, rules.ErrAccountVisibilityInvalid) {
+		t.Fatalf("UpdateProfileDetails() invalid visibility error = %v, want %v", err, rules.ErrAccountVisibilityInvalid)
+	}
+	if agg.Profile.Bio != "hello there" || agg.Profile.Privacy.Bio != accounttypes.ProfileVisibilityEveryone {
+		t.Fatalf("rejected update mutated profile: %+v", agg.Profile)
+	}
+}
+
+func TestProfileDetailsVisibleTo(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	bio := "bio"
+	region := "Hanoi"
+	birthday := "1990-01-02"
+	onlyMe := "only_me"
+	if _, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{
+		Bio:              &bio,
+		Region:           &region,
+		Birthday:         &birthday,
+		RegionVisibility: &onlyMe,
+	}, time.Now().UTC()); err != nil {
+		t.Fatalf("UpdateProfileDetails() error = %v", err)
+	}
+
+	stranger := agg.Profile.VisibleTo(accounttypes.ProfileViewerStranger)
+	if stranger.Bio != "bio" || stranger.Region != "" || stranger.Birthday != nil {
+		t.Fatalf("stranger view = %+v, want bio only", stranger)
+	}
+	friend := agg.Profile.VisibleTo(accounttypes.ProfileViewerFriend)
+	if friend.Region != "" || friend.Birthday == nil {
+		t.Fatalf("friend view = %+v, want birthday without region", friend)
+	}
+	self := agg.Profile.VisibleTo(accounttypes.ProfileViewerSelf)
+	if self.Region != "Hanoi" || self.Birthday == nil {
+		t.Fatalf("self view = %+v, want every field", self)
+	}
+}
+
+func TestProfileDetailsVisibleToAudienceLists(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	region := "Hanoi"
+	birthday := "1990-01-02"
+	closeFriends := "close_friends"
+	if _, err := agg.UpdateProfileDetails(ProfileDetailsUpdate{
+		Region:           &region,
+		Birthday:         &birthday,
+		RegionVisibility: &closeFriends,
+	}, time.Now().UTC()); err != nil {
+		t.Fatalf("UpdateProfileDetails() error = %v", err)
+	}
+
+	closeFriend := agg.Profile.VisibleTo(accounttypes.ProfileViewerCloseFriend)
+	if closeFriend.Region != "Hanoi" || closeFriend.Birthday == nil {
+		t.Fatalf("close friend view = %+v, want region and birthday", closeFriend)
+	}
+	friend := agg.Profile.VisibleTo(accounttypes.ProfileViewerFriend)
+	if friend.Region != "" || friend.Birthday == nil {
+		t.Fatalf("friend view = %+v, want birthday without region", friend)
+	}
+	restricted := agg.Profile.VisibleTo(accounttypes.ProfileViewerRestricted)
+	if restricted.Region != "" || restricted.Birthday != nil {
+		t.Fatalf("restricted view = %+v, want stranger fields only", restricted)
+	}
+	if !accounttypes.ProfileViewerRestricted.IsFriend() {
+		t.Fatalf("ProfileViewerRestricted.IsFriend() = false, want true")
+	}
+}
+
+func TestAccountAggregateRotateContactCard(t *testing.T) {
+	agg := newRegisteredAccountAggregate(t)
+	if !agg.HasContactCard() {
+		t.Fatalf("HasContactCard() = false after Register, want true")
+	}
+	issued := agg.ContactCardNonce
+
+	rotatedAt := time.Now().UTC().Add(time.Minute)
+	if err := agg.RotateContactCard(rotatedAt); err != nil {
+		t.Fatalf("RotateContactCard() error = %v", err)
+	}
+	if agg.ContactCardNonce == "" || agg.ContactCardNonce == issued {
+		t.Fatalf("ContactCardNonce = %q, want a new nonce", agg.ContactCardNonce)
+	}
+	if agg.ContactCardRotatedAt == nil || !agg.ContactCardRotatedAt.Equal(rotatedAt) {
+		t.Fatalf("ContactCardRotatedAt = %v, want %v", agg.ContactCardRotatedAt, rotatedAt)
+	}
+
+	snapshot, err := agg.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if snapshot.ContactCardNonce != agg.ContactCardNonce {
+		t.Fatalf("snapshot nonce = %q, want %q", snapshot.ContactCardNonce, agg.ContactCardNonce)
+	}
+}
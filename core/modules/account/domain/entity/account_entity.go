@@ -9,20 +9,26 @@ import (
 )
 
 type Account struct {
-	ID                string                     `json:"id"`
-	Email             valueobject.Email          `json:"email"`
-	PasswordHash      valueobject.HashedPassword `json:"password_hash"`
-	DisplayName       string                     `json:"display_name"`
-	Username          *string                    `json:"username,omitempty"`
-	AvatarObjectKey   *string                    `json:"avatar_object_key,omitempty"`
-	Status            accounttypes.AccountStatus `json:"status"`
-	EmailVerifiedAt   *time.Time                 `json:"email_verified_at,omitempty"`
-	LastLoginAt       *time.Time                 `json:"last_login_at,omitempty"`
-	PasswordChangedAt *time.Time                 `json:"password_changed_at,omitempty"`
-	CreatedAt         time.Time                  `json:"created_at"`
-	UpdatedAt         time.Time                  `json:"updated_at"`
-	BannedReason      string                     `json:"banned_reason"`
-	BannedUntil       *time.Time                 `json:"banned_until"`
+	ID                   string                     `json:"id"`
+	Email                valueobject.Email          `json:"email"`
+	PasswordHash         valueobject.HashedPassword `json:"password_hash"`
+	DisplayName          string                     `json:"display_name"`
+	Username             *string                    `json:"username,omitempty"`
+	UsernameChangedAt    *time.Time                 `json:"username_changed_at,omitempty"`
+	AvatarObjectKey      *string                    `json:"avatar_object_key,omitempty"`
+	Status               accounttypes.AccountStatus `json:"status"`
+	Role                 accounttypes.AccountRole   `json:"role"`
+	EmailVerifiedAt      *time.Time                 `json:"email_verified_at,omitempty"`
+	LastLoginAt          *time.Time                 `json:"last_login_at,omitempty"`
+	PasswordChangedAt    *time.Time                 `json:"password_changed_at,omitempty"`
+	CreatedAt            time.Time                  `json:"created_at"`
+	UpdatedAt            time.Time                  `json:"updated_at"`
+	BannedReason         string                     `json:"banned_reason"`
+	BannedUntil          *time.Time                 `json:"banned_until"`
+	LockedUntil          *time.Time                 `json:"locked_until"`
+	Profile              ProfileDetails             `json:"profile"`
+	ContactCardNonce     string                     `json:"contact_card_nonce,omitempty"`
+	ContactCardRotatedAt *time.Time                 `json:"contact_card_rotated_at,omitempty"`
 }
 
 func NewAccount(
@@ -52,7 +58,19 @@ func NewAccount(
 		PasswordHash: passwordHash,
 		DisplayName:  normalizedDisplayName,
 		Status:       normalizedStatus,
+		Role:         accounttypes.AccountRoleUser,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
 }
+
+func (a *Account) IsAdmin() bool {
+	return a != nil && a.Role == accounttypes.AccountRoleAdmin
+}
+
+func (a *Account) IsBanned(now time.Time) bool {
+	if a == nil || a.BannedReason == "" {
+		return false
+	}
+	return a.BannedUntil == nil || now.Before(*a.BannedUntil)
+}
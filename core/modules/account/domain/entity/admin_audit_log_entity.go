@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var ErrInvalidAdminAuditLog = errors.New("invalid admin audit log")
+
+// AdminAuditLog is an immutable record of one moderation action. Before and
+// After hold JSON snapshots of the fields the action touched.
+type AdminAuditLog struct {
+	ID              string
+	ActorAccountID  string
+	Action          accounttypes.AdminAuditAction
+	TargetAccountID string
+	Before          string
+	After           string
+	Reason          string
+	RequestID       string
+	CreatedAt       time.Time
+}
+
+type AdminAuditLogFilter struct {
+	ActorAccountID  string
+	TargetAccountID string
+	Action          accounttypes.AdminAuditAction
+	RequestID       string
+	From            *time.Time
+	To              *time.Time
+	Limit           int
+	Offset          int
+}
+
+func NewAdminAuditLog(
+	id string,
+	actorAccountID string,
+	action accounttypes.AdminAuditAction,
+	targetAccountID string,
+	before interface{},
+	after interface{},
+	reason string,
+	requestID string,
+	now time.Time,
+) (*AdminAuditLog, error) {
+	id = strings.TrimSpace(id)
+	actorAccountID = strings.TrimSpace(actorAccountID)
+	targetAccountID = strings.TrimSpace(targetAccountID)
+	if id == "" || actorAccountID == "" || targetAccountID == "" {
+		return nil, stackErr.Error(ErrInvalidAdminAuditLog)
+	}
+	normalizedAction, err := accounttypes.ParseAdminAuditAction(action.String())
+	if err != nil {
+		return nil, stackErr.Error(ErrInvalidAdminAuditLog)
+	}
+
+	beforeJSON, err := marshalAuditValue(before)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	afterJSON, err := marshalAuditValue(after)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &AdminAuditLog{
+		ID:              id,
+		ActorAccountID:  actorAccountID,
+		Action:          normalizedAction,
+		TargetAccountID: targetAccountID,
+		Before:          beforeJSON,
+		After:           afterJSON,
+		Reason:          strings.TrimSpace(reason),
+		RequestID:       strings.TrimSpace(requestID),
+		CreatedAt:       now.UTC(),
+	}, nil
+}
+
+func marshalAuditValue(value interface{}) (string, error) {
+	if value == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	return string(data), nil
+}
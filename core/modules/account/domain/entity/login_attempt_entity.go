@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var ErrInvalidLoginAttempt = errors.New("invalid login attempt")
+
+// LoginAttempt is the login history used by risk evaluation. Location fields
+// are empty when the IP could not be resolved.
+type LoginAttempt struct {
+	ID          string
+	AccountID   string
+	DeviceUID   string
+	IPAddress   string
+	UserAgent   string
+	CountryCode string
+	ASN         uint32
+	Latitude    *float64
+	Longitude   *float64
+	Outcome     accounttypes.LoginAttemptOutcome
+	RiskScore   int
+	RiskSignals []accounttypes.LoginRiskSignal
+	CreatedAt   time.Time
+}
+
+func NewLoginAttempt(
+	id string,
+	accountID string,
+	deviceUID string,
+	ipAddress string,
+	userAgent string,
+	outcome accounttypes.LoginAttemptOutcome,
+	now time.Time,
+) (*LoginAttempt, error) {
+	id = strings.TrimSpace(id)
+	accountID = strings.TrimSpace(accountID)
+	if id == "" || accountID == "" || outcome == "" || now.IsZero() {
+		return nil, stackErr.Error(ErrInvalidLoginAttempt)
+	}
+	return &LoginAttempt{
+		ID:        id,
+		AccountID: accountID,
+		DeviceUID: strings.TrimSpace(deviceUID),
+		IPAddress: strings.TrimSpace(ipAddress),
+		UserAgent: strings.TrimSpace(userAgent),
+		Outcome:   outcome,
+		CreatedAt: now.UTC(),
+	}, nil
+}
+
+func (a *LoginAttempt) HasLocation() bool {
+	return a != nil && a.Latitude != nil && a.Longitude != nil
+}
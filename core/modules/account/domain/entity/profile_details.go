@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"time"
+
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/utils"
+)
+
+// ProfilePrivacy holds one visibility setting per extended profile field.
+type ProfilePrivacy struct {
+	Bio      accounttypes.ProfileVisibility `json:"bio"`
+	Region   accounttypes.ProfileVisibility `json:"region"`
+	Gender   accounttypes.ProfileVisibility `json:"gender"`
+	Birthday accounttypes.ProfileVisibility `json:"birthday"`
+	Cover    accounttypes.ProfileVisibility `json:"cover"`
+}
+
+// DefaultProfilePrivacy shows everything but the birthday to everyone; the
+// birthday is limited to friends.
+func DefaultProfilePrivacy() ProfilePrivacy {
+	return ProfilePrivacy{
+		Bio:      accounttypes.ProfileVisibilityEveryone,
+		Region:   accounttypes.ProfileVisibilityEveryone,
+		Gender:   accounttypes.ProfileVisibilityEveryone,
+		Birthday: accounttypes.ProfileVisibilityFriends,
+		Cover:    accounttypes.ProfileVisibilityEveryone,
+	}
+}
+
+// WithDefaults fills settings that were never chosen, so accounts created
+// before privacy existed behave like new ones.
+func (p ProfilePrivacy) WithDefaults() ProfilePrivacy {
+	defaults := DefaultProfilePrivacy()
+	if p.Bio == "" {
+		p.Bio = defaults.Bio
+	}
+	if p.Region == "" {
+		p.Region = defaults.Region
+	}
+	if p.Gender == "" {
+		p.Gender = defaults.Gender
+	}
+	if p.Birthday == "" {
+		p.Birthday = defaults.Birthday
+	}
+	if p.Cover == "" {
+		p.Cover = defaults.Cover
+	}
+	return p
+}
+
+// ProfileDetails are the optional fields shown on a profile page next to the
+// display name and avatar.
+type ProfileDetails struct {
+	Bio            string              `json:"bio"`
+	Region         string              `json:"region"`
+	Gender         accounttypes.Gender `json:"gender"`
+	Birthday       *time.Time          `json:"birthday,omitempty"`
+	CoverObjectKey *string             `json:"cover_object_key,omitempty"`
+	Privacy        ProfilePrivacy      `json:"privacy"`
+}
+
+// VisibleTo returns a copy with every field the viewer may not see cleared.
+func (d ProfileDetails) VisibleTo(viewer accounttypes.ProfileViewer) ProfileDetails {
+	privacy := d.Privacy.WithDefaults()
+	visible := ProfileDetails{Privacy: privacy}
+	if privacy.Bio.Allows(viewer) {
+		visible.Bio = d.Bio
+	}
+	if privacy.Region.Allows(viewer) {
+		visible.Region = d.Region
+	}
+	if privacy.Gender.Allows(viewer) {
+		visible.Gender = d.Gender
+	}
+	if privacy.Birthday.Allows(viewer) {
+		visible.Birthday = utils.ClonePtr(d.Birthday)
+	}
+	if privacy.Cover.Allows(viewer) {
+		visible.CoverObjectKey = utils.ClonePtr(d.CoverObjectKey)
+	}
+	return visible
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/rules"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var ErrInvalidUsernameReservation = errors.New("invalid username reservation")
+
+// UsernameReservation holds a released handle for its former owner so nobody
+// else can take it over straight after a rename.
+type UsernameReservation struct {
+	UsernameKey   string
+	AccountID     string
+	ReservedUntil time.Time
+	CreatedAt     time.Time
+}
+
+func NewUsernameReservation(username, accountID string, reservedUntil, now time.Time) (*UsernameReservation, error) {
+	key := rules.UsernameKey(username)
+	accountID = strings.TrimSpace(accountID)
+	if key == "" || accountID == "" || !reservedUntil.After(now) {
+		return nil, stackErr.Error(ErrInvalidUsernameReservation)
+	}
+	return &UsernameReservation{
+		UsernameKey:   key,
+		AccountID:     accountID,
+		ReservedUntil: reservedUntil.UTC(),
+		CreatedAt:     now.UTC(),
+	}, nil
+}
+
+func (r *UsernameReservation) IsActive(now time.Time) bool {
+	return r != nil && now.Before(r.ReservedUntil)
+}
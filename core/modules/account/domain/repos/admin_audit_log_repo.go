@@ -0,0 +1,13 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/account/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=admin_audit_log_repo_mock.go -source=admin_audit_log_repo.go
+type AdminAuditLogRepository interface {
+	Append(ctx context.Context, log *entity.AdminAuditLog) error
+	List(ctx context.Context, filter entity.AdminAuditLogFilter) ([]*entity.AdminAuditLog, int64, error)
+}
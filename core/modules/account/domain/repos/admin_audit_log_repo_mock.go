@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_audit_log_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=admin_audit_log_repo_mock.go -source=admin_audit_log_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/account/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAuditLogRepository is a mock of AdminAuditLogRepository interface.
+type MockAdminAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAuditLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAuditLogRepositoryMockRecorder is the mock recorder for MockAdminAuditLogRepository.
+type MockAdminAuditLogRepositoryMockRecorder struct {
+	mock *MockAdminAuditLogRepository
+}
+
+// NewMockAdminAuditLogRepository creates a new mock instance.
+func NewMockAdminAuditLogRepository(ctrl *gomock.Controller) *MockAdminAuditLogRepository {
+	mock := &MockAdminAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAuditLogRepository) EXPECT() *MockAdminAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockAdminAuditLogRepository) Append(ctx context.Context, log *entity.AdminAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockAdminAuditLogRepositoryMockRecorder) Append(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockAdminAuditLogRepository)(nil).Append), ctx, log)
+}
+
+// List mocks base method.
+func (m *MockAdminAuditLogRepository) List(ctx context.Context, filter entity.AdminAuditLogFilter) ([]*entity.AdminAuditLog, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entity.AdminAuditLog)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAdminAuditLogRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAdminAuditLogRepository)(nil).List), ctx, filter)
+}
@@ -10,6 +10,7 @@ import (
 type DeviceAggregateRepository interface {
 	FindByAccountAndUID(ctx context.Context, accountID string, deviceUID string) (*aggregate.DeviceAggregate, error)
 	GetByAccountAndID(ctx context.Context, accountID string, deviceID string) (*aggregate.DeviceAggregate, error)
+	ListByAccountID(ctx context.Context, accountID string) ([]*aggregate.DeviceAggregate, error)
 	Save(ctx context.Context, device *aggregate.DeviceAggregate) error
 }
 
@@ -71,6 +71,21 @@ func (mr *MockDeviceAggregateRepositoryMockRecorder) GetByAccountAndID(ctx, acco
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountAndID", reflect.TypeOf((*MockDeviceAggregateRepository)(nil).GetByAccountAndID), ctx, accountID, deviceID)
 }
 
+// ListByAccountID mocks base method.
+func (m *MockDeviceAggregateRepository) ListByAccountID(ctx context.Context, accountID string) ([]*aggregate.DeviceAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]*aggregate.DeviceAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockDeviceAggregateRepositoryMockRecorder) ListByAccountID(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockDeviceAggregateRepository)(nil).ListByAccountID), ctx, accountID)
+}
+
 // Save mocks base method.
 func (m *MockDeviceAggregateRepository) Save(ctx context.Context, device *aggregate.DeviceAggregate) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,18 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=login_attempt_repo_mock.go -source=login_attempt_repo.go
+type LoginAttemptRepository interface {
+	Append(ctx context.Context, attempt *entity.LoginAttempt) error
+	// LatestSucceeded returns nil when the account has no successful login yet.
+	LatestSucceeded(ctx context.Context, accountID string) (*entity.LoginAttempt, error)
+	CountFailuresSince(ctx context.Context, accountID string, since time.Time) (int64, error)
+	HasSucceededFromCountry(ctx context.Context, accountID, countryCode string) (bool, error)
+	HasSucceededFromASN(ctx context.Context, accountID string, asn uint32) (bool, error)
+}
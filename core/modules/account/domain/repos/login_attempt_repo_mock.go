@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: login_attempt_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=login_attempt_repo_mock.go -source=login_attempt_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	entity "wechat-clone/core/modules/account/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginAttemptRepository is a mock of LoginAttemptRepository interface.
+type MockLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginAttemptRepositoryMockRecorder is the mock recorder for MockLoginAttemptRepository.
+type MockLoginAttemptRepositoryMockRecorder struct {
+	mock *MockLoginAttemptRepository
+}
+
+// NewMockLoginAttemptRepository creates a new mock instance.
+func NewMockLoginAttemptRepository(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockLoginAttemptRepository) Append(ctx context.Context, attempt *entity.LoginAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockLoginAttemptRepositoryMockRecorder) Append(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLoginAttemptRepository)(nil).Append), ctx, attempt)
+}
+
+// CountFailuresSince mocks base method.
+func (m *MockLoginAttemptRepository) CountFailuresSince(ctx context.Context, accountID string, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFailuresSince", ctx, accountID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFailuresSince indicates an expected call of CountFailuresSince.
+func (mr *MockLoginAttemptRepositoryMockRecorder) CountFailuresSince(ctx, accountID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFailuresSince", reflect.TypeOf((*MockLoginAttemptRepository)(nil).CountFailuresSince), ctx, accountID, since)
+}
+
+// HasSucceededFromASN mocks base method.
+func (m *MockLoginAttemptRepository) HasSucceededFromASN(ctx context.Context, accountID string, asn uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSucceededFromASN", ctx, accountID, asn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSucceededFromASN indicates an expected call of HasSucceededFromASN.
+func (mr *MockLoginAttemptRepositoryMockRecorder) HasSucceededFromASN(ctx, accountID, asn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSucceededFromASN", reflect.TypeOf((*MockLoginAttemptRepository)(nil).HasSucceededFromASN), ctx, accountID, asn)
+}
+
+// HasSucceededFromCountry mocks base method.
+func (m *MockLoginAttemptRepository) HasSucceededFromCountry(ctx context.Context, accountID, countryCode string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSucceededFromCountry", ctx, accountID, countryCode)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSucceededFromCountry indicates an expected call of HasSucceededFromCountry.
+func (mr *MockLoginAttemptRepositoryMockRecorder) HasSucceededFromCountry(ctx, accountID, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSucceededFromCountry", reflect.TypeOf((*MockLoginAttemptRepository)(nil).HasSucceededFromCountry), ctx, accountID, countryCode)
+}
+
+// LatestSucceeded mocks base method.
+func (m *MockLoginAttemptRepository) LatestSucceeded(ctx context.Context, accountID string) (*entity.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestSucceeded", ctx, accountID)
+	ret0, _ := ret[0].(*entity.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestSucceeded indicates an expected call of LatestSucceeded.
+func (mr *MockLoginAttemptRepositoryMockRecorder) LatestSucceeded(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestSucceeded", reflect.TypeOf((*MockLoginAttemptRepository)(nil).LatestSucceeded), ctx, accountID)
+}
@@ -11,6 +11,9 @@ type Repos interface {
 	SessionAggregateRepository() SessionAggregateRepository
 	DeviceRepository() DeviceRepository
 	SessionRepository() SessionRepository
+	AdminAuditLogRepository() AdminAuditLogRepository
+	LoginAttemptRepository() LoginAttemptRepository
+	UsernameReservationRepository() UsernameReservationRepository
 
 	WithTransaction(ctx context.Context, fn func(Repos) error) error
 }
@@ -54,6 +54,20 @@ func (mr *MockReposMockRecorder) AccountAggregateRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAggregateRepository", reflect.TypeOf((*MockRepos)(nil).AccountAggregateRepository))
 }
 
+// AdminAuditLogRepository mocks base method.
+func (m *MockRepos) AdminAuditLogRepository() AdminAuditLogRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminAuditLogRepository")
+	ret0, _ := ret[0].(AdminAuditLogRepository)
+	return ret0
+}
+
+// AdminAuditLogRepository indicates an expected call of AdminAuditLogRepository.
+func (mr *MockReposMockRecorder) AdminAuditLogRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminAuditLogRepository", reflect.TypeOf((*MockRepos)(nil).AdminAuditLogRepository))
+}
+
 // DeviceAggregateRepository mocks base method.
 func (m *MockRepos) DeviceAggregateRepository() DeviceAggregateRepository {
 	m.ctrl.T.Helper()
@@ -82,6 +96,20 @@ func (mr *MockReposMockRecorder) DeviceRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceRepository", reflect.TypeOf((*MockRepos)(nil).DeviceRepository))
 }
 
+// LoginAttemptRepository mocks base method.
+func (m *MockRepos) LoginAttemptRepository() LoginAttemptRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginAttemptRepository")
+	ret0, _ := ret[0].(LoginAttemptRepository)
+	return ret0
+}
+
+// LoginAttemptRepository indicates an expected call of LoginAttemptRepository.
+func (mr *MockReposMockRecorder) LoginAttemptRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginAttemptRepository", reflect.TypeOf((*MockRepos)(nil).LoginAttemptRepository))
+}
+
 // SessionAggregateRepository mocks base method.
 func (m *MockRepos) SessionAggregateRepository() SessionAggregateRepository {
 	m.ctrl.T.Helper()
@@ -110,6 +138,20 @@ func (mr *MockReposMockRecorder) SessionRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionRepository", reflect.TypeOf((*MockRepos)(nil).SessionRepository))
 }
 
+// UsernameReservationRepository mocks base method.
+func (m *MockRepos) UsernameReservationRepository() UsernameReservationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsernameReservationRepository")
+	ret0, _ := ret[0].(UsernameReservationRepository)
+	return ret0
+}
+
+// UsernameReservationRepository indicates an expected call of UsernameReservationRepository.
+func (mr *MockReposMockRecorder) UsernameReservationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsernameReservationRepository", reflect.TypeOf((*MockRepos)(nil).UsernameReservationRepository))
+}
+
 // WithTransaction mocks base method.
 func (m *MockRepos) WithTransaction(ctx context.Context, fn func(Repos) error) error {
 	m.ctrl.T.Helper()
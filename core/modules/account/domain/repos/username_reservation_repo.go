@@ -0,0 +1,17 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=username_reservation_repo_mock.go -source=username_reservation_repo.go
+type UsernameReservationRepository interface {
+	// Reserve upserts the reservation so a later release of the same handle
+	// extends it.
+	Reserve(ctx context.Context, reservation *entity.UsernameReservation) error
+	// FindActive returns nil when the handle is not held for anyone.
+	FindActive(ctx context.Context, usernameKey string, now time.Time) (*entity.UsernameReservation, error)
+}
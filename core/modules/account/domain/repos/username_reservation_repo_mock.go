@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: username_reservation_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=username_reservation_repo_mock.go -source=username_reservation_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	entity "wechat-clone/core/modules/account/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsernameReservationRepository is a mock of UsernameReservationRepository interface.
+type MockUsernameReservationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsernameReservationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsernameReservationRepositoryMockRecorder is the mock recorder for MockUsernameReservationRepository.
+type MockUsernameReservationRepositoryMockRecorder struct {
+	mock *MockUsernameReservationRepository
+}
+
+// NewMockUsernameReservationRepository creates a new mock instance.
+func NewMockUsernameReservationRepository(ctrl *gomock.Controller) *MockUsernameReservationRepository {
+	mock := &MockUsernameReservationRepository{ctrl: ctrl}
+	mock.recorder = &MockUsernameReservationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsernameReservationRepository) EXPECT() *MockUsernameReservationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindActive mocks base method.
+func (m *MockUsernameReservationRepository) FindActive(ctx context.Context, usernameKey string, now time.Time) (*entity.UsernameReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, usernameKey, now)
+	ret0, _ := ret[0].(*entity.UsernameReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockUsernameReservationRepositoryMockRecorder) FindActive(ctx, usernameKey, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockUsernameReservationRepository)(nil).FindActive), ctx, usernameKey, now)
+}
+
+// Reserve mocks base method.
+func (m *MockUsernameReservationRepository) Reserve(ctx context.Context, reservation *entity.UsernameReservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockUsernameReservationRepositoryMockRecorder) Reserve(ctx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockUsernameReservationRepository)(nil).Reserve), ctx, reservation)
+}
@@ -17,8 +17,18 @@ var (
 	ErrAccountAlreadyRegistered    = errors.New("account already registered")
 	ErrAccountNotRegistered        = errors.New("account is not registered")
 	ErrAccountNotFound             = errors.New("account not found")
+	ErrAccountRoleInvalid          = errors.New("role is invalid")
+	ErrAccountBanned               = errors.New("account is banned")
+	ErrAccountNotBanned            = errors.New("account is not banned")
+	ErrAccountBanReasonRequired    = errors.New("ban reason is required")
+	ErrAccountBanUntilInPast       = errors.New("banned_until must be in the future")
+	ErrAccountLocked               = errors.New("account is temporarily locked")
+	ErrAccountLockUntilInPast      = errors.New("locked_until must be in the future")
+	ErrAccountLoginDeviceRequired  = errors.New("login session and device are required")
 )
 
+const defaultDisplayNamePrefix = "User "
+
 func NormalizeAccountID(id string) (string, error) {
 	normalized := strings.TrimSpace(id)
 	if normalized == "" {
@@ -43,6 +53,32 @@ func NormalizeStatus(status accounttypes.AccountStatus) (accounttypes.AccountSta
 	return parsed, nil
 }
 
+func NormalizeRole(role accounttypes.AccountRole) (accounttypes.AccountRole, error) {
+	parsed, err := accounttypes.ParseAccountRole(role.String())
+	if err != nil {
+		return "", ErrAccountRoleInvalid
+	}
+	return parsed, nil
+}
+
+func NormalizeBanReason(reason string) (string, error) {
+	normalized := strings.TrimSpace(reason)
+	if normalized == "" {
+		return "", ErrAccountBanReasonRequired
+	}
+	return normalized, nil
+}
+
+// DefaultDisplayName is the neutral name given to an account whose display name
+// was reset by a moderator.
+func DefaultDisplayName(accountID string) string {
+	shortID := strings.ReplaceAll(strings.TrimSpace(accountID), "-", "")
+	if len(shortID) > 8 {
+		shortID = shortID[:8]
+	}
+	return defaultDisplayNamePrefix + shortID
+}
+
 func NormalizeOptionalString(value string) *string {
 	normalized := strings.TrimSpace(value)
 	if normalized == "" {
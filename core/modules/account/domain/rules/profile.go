@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	accounttypes "wechat-clone/core/modules/account/types"
+)
+
+var (
+	ErrAccountBioTooLong        = errors.New("bio must be at most 200 characters")
+	ErrAccountRegionTooLong     = errors.New("region must be at most 100 characters")
+	ErrAccountGenderInvalid     = errors.New("gender is invalid")
+	ErrAccountBirthdayInvalid   = errors.New("birthday must be a past date formatted as YYYY-MM-DD")
+	ErrAccountVisibilityInvalid = errors.New("visibility must be everyone, friends, close_friends or only_me")
+)
+
+const (
+	BioMaxLength    = 200
+	RegionMaxLength = 100
+	BirthdayLayout  = "2006-01-02"
+)
+
+var earliestBirthday = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func NormalizeBio(bio string) (string, error) {
+	normalized := strings.TrimSpace(bio)
+	if utf8.RuneCountInString(normalized) > BioMaxLength {
+		return "", ErrAccountBioTooLong
+	}
+	return normalized, nil
+}
+
+func NormalizeRegion(region string) (string, error) {
+	normalized := strings.TrimSpace(region)
+	if utf8.RuneCountInString(normalized) > RegionMaxLength {
+		return "", ErrAccountRegionTooLong
+	}
+	return normalized, nil
+}
+
+func NormalizeGender(gender string) (accounttypes.Gender, error) {
+	parsed, err := accounttypes.ParseGender(gender)
+	if err != nil {
+		return "", ErrAccountGenderInvalid
+	}
+	return parsed, nil
+}
+
+// NormalizeBirthday parses a calendar date. An empty value clears the
+// birthday and yields nil.
+func NormalizeBirthday(birthday string, now time.Time) (*time.Time, error) {
+	normalized := strings.TrimSpace(birthday)
+	if normalized == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(BirthdayLayout, normalized)
+	if err != nil {
+		return nil, ErrAccountBirthdayInvalid
+	}
+	if parsed.Before(earliestBirthday) || parsed.After(now.UTC()) {
+		return nil, ErrAccountBirthdayInvalid
+	}
+	return &parsed, nil
+}
+
+func NormalizeVisibility(visibility string) (accounttypes.ProfileVisibility, error) {
+	parsed, err := accounttypes.ParseProfileVisibility(visibility)
+	if err != nil {
+		return "", ErrAccountVisibilityInvalid
+	}
+	return parsed, nil
+}
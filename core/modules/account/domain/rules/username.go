@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrAccountUsernameRequired       = errors.New("username is required")
+	ErrAccountUsernameInvalid        = errors.New("username must be 3-30 characters of letters, digits, underscores or dots")
+	ErrAccountUsernameReserved       = errors.New("username is reserved")
+	ErrAccountUsernameChangeCooldown = errors.New("username was changed too recently")
+)
+
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 30
+)
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+// defaultReservedUsernames are handles that could be mistaken for the service
+// itself or for a system route.
+var defaultReservedUsernames = []string{
+	"admin", "administrator", "root", "system", "support", "help", "security",
+	"moderator", "mod", "staff", "official", "team", "api", "www", "mail",
+	"account", "accounts", "auth", "login", "logout", "register", "settings",
+	"me", "null", "undefined", "anonymous", "everyone", "here", "all",
+}
+
+// NormalizeUsername trims the handle and validates its shape. The returned
+// value keeps the caller's casing; use UsernameKey for comparisons.
+func NormalizeUsername(username string) (string, error) {
+	normalized := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(username), "@"))
+	if normalized == "" {
+		return "", ErrAccountUsernameRequired
+	}
+	if len(normalized) < UsernameMinLength || len(normalized) > UsernameMaxLength {
+		return "", ErrAccountUsernameInvalid
+	}
+	if !usernamePattern.MatchString(normalized) ||
+		strings.HasPrefix(normalized, ".") ||
+		strings.HasSuffix(normalized, ".") ||
+		strings.Contains(normalized, "..") {
+		return "", ErrAccountUsernameInvalid
+	}
+	return normalized, nil
+}
+
+// UsernameKey is the case-insensitive identity of a handle.
+func UsernameKey(username string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(username), "@")))
+}
+
+// IsReservedUsername reports whether the handle is on the built-in reserved
+// list or on the extra list supplied by configuration.
+func IsReservedUsername(username string, extra []string) bool {
+	key := UsernameKey(username)
+	for _, reserved := range defaultReservedUsernames {
+		if key == reserved {
+			return true
+		}
+	}
+	for _, reserved := range extra {
+		if key == UsernameKey(reserved) && key != "" {
+			return true
+		}
+	}
+	return false
+}
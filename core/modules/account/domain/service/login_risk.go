@@ -0,0 +1,140 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	accounttypes "wechat-clone/core/modules/account/types"
+)
+
+const earthRadiusKm = 6371.0
+
+// LoginRiskPolicy weights each signal and maps the summed score to a decision.
+type LoginRiskPolicy struct {
+	NewDeviceWeight         int
+	NewCountryWeight        int
+	NewASNWeight            int
+	ImpossibleTravelWeight  int
+	FailedPasswordWeight    int
+	FailedPasswordThreshold int
+	MaxTravelSpeedKmh       float64
+	// MinTravelDistanceKm keeps GeoIP jitter between nearby cities from
+	// counting as travel.
+	MinTravelDistanceKm float64
+	StepUpScore         int
+	LockScore           int
+}
+
+func DefaultLoginRiskPolicy() LoginRiskPolicy {
+	return LoginRiskPolicy{
+		NewDeviceWeight:         30,
+		NewCountryWeight:        30,
+		NewASNWeight:            10,
+		ImpossibleTravelWeight:  50,
+		FailedPasswordWeight:    40,
+		FailedPasswordThreshold: 5,
+		MaxTravelSpeedKmh:       900,
+		MinTravelDistanceKm:     300,
+		StepUpScore:             40,
+		LockScore:               90,
+	}
+}
+
+type LoginLocation struct {
+	CountryCode    string
+	ASN            uint32
+	Latitude       float64
+	Longitude      float64
+	HasCoordinates bool
+}
+
+type LoginRiskInput struct {
+	NewDevice bool
+	// Location is nil when the IP could not be resolved.
+	Location *LoginLocation
+	// CountryKnown and ASNKnown report whether an earlier successful login
+	// came from the same country / network.
+	CountryKnown bool
+	ASNKnown     bool
+	// PreviousLogin is the latest successful login, nil on the first one.
+	PreviousLogin  *entity.LoginAttempt
+	RecentFailures int
+	Now            time.Time
+}
+
+type LoginRiskAssessment struct {
+	Score    int
+	Signals  []accounttypes.LoginRiskSignal
+	Decision accounttypes.LoginRiskDecision
+}
+
+func (a LoginRiskAssessment) Has(signal accounttypes.LoginRiskSignal) bool {
+	for _, item := range a.Signals {
+		if item == signal {
+			return true
+		}
+	}
+	return false
+}
+
+func EvaluateLoginRisk(policy LoginRiskPolicy, input LoginRiskInput) LoginRiskAssessment {
+	assessment := LoginRiskAssessment{Decision: accounttypes.LoginRiskDecisionAllow}
+	add := func(signal accounttypes.LoginRiskSignal, weight int) {
+		assessment.Signals = append(assessment.Signals, signal)
+		assessment.Score += weight
+	}
+
+	// Location novelty only means something once there is a history to compare with.
+	hasHistory := input.PreviousLogin != nil
+
+	if input.NewDevice && hasHistory {
+		add(accounttypes.LoginRiskSignalNewDevice, policy.NewDeviceWeight)
+	}
+	if hasHistory && input.Location != nil {
+		if input.Location.CountryCode != "" && !input.CountryKnown {
+			add(accounttypes.LoginRiskSignalNewCountry, policy.NewCountryWeight)
+		}
+		if input.Location.ASN != 0 && !input.ASNKnown {
+			add(accounttypes.LoginRiskSignalNewASN, policy.NewASNWeight)
+		}
+		if isImpossibleTravel(policy, input.PreviousLogin, input.Location, input.Now) {
+			add(accounttypes.LoginRiskSignalImpossibleTravel, policy.ImpossibleTravelWeight)
+		}
+	}
+	if policy.FailedPasswordThreshold > 0 && input.RecentFailures >= policy.FailedPasswordThreshold {
+		add(accounttypes.LoginRiskSignalFailedPasswords, policy.FailedPasswordWeight)
+	}
+
+	switch {
+	case policy.LockScore > 0 && assessment.Score >= policy.LockScore:
+		assessment.Decision = accounttypes.LoginRiskDecisionLock
+	case policy.StepUpScore > 0 && assessment.Score >= policy.StepUpScore:
+		assessment.Decision = accounttypes.LoginRiskDecisionStepUp
+	}
+	return assessment
+}
+
+func isImpossibleTravel(policy LoginRiskPolicy, previous *entity.LoginAttempt, current *LoginLocation, now time.Time) bool {
+	if policy.MaxTravelSpeedKmh <= 0 || !previous.HasLocation() || !current.HasCoordinates {
+		return false
+	}
+	distance := HaversineKm(*previous.Latitude, *previous.Longitude, current.Latitude, current.Longitude)
+	if distance < policy.MinTravelDistanceKm {
+		return false
+	}
+	elapsed := now.Sub(previous.CreatedAt)
+	if elapsed < time.Minute {
+		elapsed = time.Minute
+	}
+	return distance/elapsed.Hours() > policy.MaxTravelSpeedKmh
+}
+
+func HaversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
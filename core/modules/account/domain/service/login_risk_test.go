@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	accounttypes "wechat-clone/core/modules/account/types"
+)
+
+func previousLoginAt(lat, lon float64, at time.Time) *entity.LoginAttempt {
+	return &entity.LoginAttempt{
+		ID:          "attempt-1",
+		AccountID:   "account-1",
+		CountryCode: "VN",
+		ASN:         7552,
+		Latitude:    &lat,
+		Longitude:   &lon,
+		Outcome:     accounttypes.LoginAttemptOutcomeSucceeded,
+		CreatedAt:   at,
+	}
+}
+
+func TestEvaluateLoginRiskFirstLoginIsAllowed(t *testing.T) {
+	assessment := EvaluateLoginRisk(DefaultLoginRiskPolicy(), LoginRiskInput{
+		NewDevice: true,
+		Location:  &LoginLocation{CountryCode: "VN", ASN: 7552},
+		Now:       time.Now().UTC(),
+	})
+
+	if assessment.Decision != accounttypes.LoginRiskDecisionAllow {
+		t.Fatalf("Decision = %q, want %q", assessment.Decision, accounttypes.LoginRiskDecisionAllow)
+	}
+	if len(assessment.Signals) != 0 {
+		t.Fatalf("Signals = %v, want none", assessment.Signals)
+	}
+}
+
+func TestEvaluateLoginRiskNewDeviceAloneOnlyFlags(t *testing.T) {
+	now := time.Now().UTC()
+	assessment := EvaluateLoginRisk(DefaultLoginRiskPolicy(), LoginRiskInput{
+		NewDevice:     true,
+		Location:      &LoginLocation{CountryCode: "VN", ASN: 7552, Latitude: 21.03, Longitude: 105.85, HasCoordinates: true},
+		CountryKnown:  true,
+		ASNKnown:      true,
+		PreviousLogin: previousLoginAt(21.03, 105.85, now.Add(-time.Hour)),
+		Now:           now,
+	})
+
+	if !assessment.Has(accounttypes.LoginRiskSignalNewDevice) {
+		t.Fatalf("Signals = %v, want new_device", assessment.Signals)
+	}
+	if assessment.Decision != accounttypes.LoginRiskDecisionAllow {
+		t.Fatalf("Decision = %q, want %q", assessment.Decision, accounttypes.LoginRiskDecisionAllow)
+	}
+}
+
+func TestEvaluateLoginRiskNewCountryOnNewDeviceRequiresStepUp(t *testing.T) {
+	now := time.Now().UTC()
+	assessment := EvaluateLoginRisk(DefaultLoginRiskPolicy(), LoginRiskInput{
+		NewDevice:     true,
+		Location:      &LoginLocation{CountryCode: "SG", ASN: 4773},
+		PreviousLogin: previousLoginAt(21.03, 105.85, now.Add(-48*time.Hour)),
+		Now:           now,
+	})
+
+	if assessment.Decision != accounttypes.LoginRiskDecisionStepUp {
+		t.Fatalf("Decision = %q, want %q (score %d)", assessment.Decision, accounttypes.LoginRiskDecisionStepUp, assessment.Score)
+	}
+	if !assessment.Has(accounttypes.LoginRiskSignalNewCountry) || !assessment.Has(accounttypes.LoginRiskSignalNewASN) {
+		t.Fatalf("Signals = %v, want new_country and new_asn", assessment.Signals)
+	}
+}
+
+func TestEvaluateLoginRiskImpossibleTravelLocks(t *testing.T) {
+	now := time.Now().UTC()
+	// Hanoi to New York (~13,000 km) in one hour.
+	assessment := EvaluateLoginRisk(DefaultLoginRiskPolicy(), LoginRiskInput{
+		NewDevice:     true,
+		Location:      &LoginLocation{CountryCode: "US", ASN: 7018, Latitude: 40.71, Longitude: -74.0, HasCoordinates: true},
+		PreviousLogin: previousLoginAt(21.03, 105.85, now.Add(-time.Hour)),
+		Now:           now,
+	})
+
+	if !assessment.Has(accounttypes.LoginRiskSignalImpossibleTravel) {
+		t.Fatalf("Signals = %v, want impossible_travel", assessment.Signals)
+	}
+	if assessment.Decision != accounttypes.LoginRiskDecisionLock {
+		t.Fatalf("Decision = %q, want %q (score %d)", assessment.Decision, accounttypes.LoginRiskDecisionLock, assessment.Score)
+	}
+}
+
+func TestEvaluateLoginRiskFailedPasswordBurst(t *testing.T) {
+	now := time.Now().UTC()
+	assessment := EvaluateLoginRisk(DefaultLoginRiskPolicy(), LoginRiskInput{
+		Location:       &LoginLocation{CountryCode: "VN", ASN: 7552},
+		CountryKnown:   true,
+		ASNKnown:       true,
+		PreviousLogin:  previousLoginAt(21.03, 105.85, now.Add(-time.Hour)),
+		RecentFailures: 5,
+		Now:            now,
+	})
+
+	if !assessment.Has(accounttypes.LoginRiskSignalFailedPasswords) {
+		t.Fatalf("Signals = %v, want failed_password_burst", assessment.Signals)
+	}
+	if assessment.Decision != accounttypes.LoginRiskDecisionStepUp {
+		t.Fatalf("Decision = %q, want %q", assessment.Decision, accounttypes.LoginRiskDecisionStepUp)
+	}
+}
@@ -3,20 +3,31 @@ package models
 import "time"
 
 type AccountModel struct {
-	ID                string  `gorm:"primaryKey"`
-	Email             string  `gorm:"not null;uniqueIndex"`
-	Password          string  `gorm:"not null"`
-	DisplayName       string  `gorm:"not null"`
-	Username          *string `gorm:"uniqueIndex"`
-	AvatarObjectKey   *string
-	Status            string `gorm:"not null;default:active"`
-	EmailVerifiedAt   *time.Time
-	LastLoginAt       *time.Time
-	PasswordChangedAt *time.Time
-	BannedReason      string
-	BannedUntil       *time.Time
-	CreatedAt         time.Time `gorm:"autoCreateTime"`
-	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+	ID                   string  `gorm:"primaryKey"`
+	Email                string  `gorm:"not null;uniqueIndex"`
+	Password             string  `gorm:"not null"`
+	DisplayName          string  `gorm:"not null"`
+	Username             *string `gorm:"uniqueIndex"`
+	UsernameChangedAt    *time.Time
+	AvatarObjectKey      *string
+	Status               string `gorm:"not null;default:active"`
+	Role                 string `gorm:"not null;default:user"`
+	EmailVerifiedAt      *time.Time
+	LastLoginAt          *time.Time
+	PasswordChangedAt    *time.Time
+	BannedReason         string
+	BannedUntil          *time.Time
+	LockedUntil          *time.Time
+	Bio                  string `gorm:"not null;default:''"`
+	Region               string `gorm:"not null;default:''"`
+	Gender               string `gorm:"not null;default:unspecified"`
+	Birthday             *time.Time
+	CoverObjectKey       *string
+	ProfilePrivacy       string `gorm:"not null;default:'{}'"`
+	ContactCardNonce     string
+	ContactCardRotatedAt *time.Time
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
 }
 
 func (AccountModel) TableName() string {
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// AdminAuditLogModel is append-only; the table rejects UPDATE and DELETE.
+type AdminAuditLogModel struct {
+	ID              string    `gorm:"primaryKey"`
+	ActorAccountID  string    `gorm:"not null;index:ix_aal_actor_created"`
+	Action          string    `gorm:"not null;index:ix_aal_action_created"`
+	TargetAccountID string    `gorm:"not null;index:ix_aal_target_created"`
+	BeforeValue     string    `gorm:"type:text;not null"`
+	AfterValue      string    `gorm:"type:text;not null"`
+	Reason          string    `gorm:"not null;default:''"`
+	RequestID       string    `gorm:"not null;default:'';index:ix_aal_request"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (AdminAuditLogModel) TableName() string {
+	return "admin_audit_logs"
+}
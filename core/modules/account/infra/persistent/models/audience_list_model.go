@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// AudienceListModel stores the list the owner has put a friend on. Friends on
+// no list have no row.
+type AudienceListModel struct {
+	OwnerID      string    `gorm:"primaryKey"`
+	FriendID     string    `gorm:"primaryKey"`
+	AudienceList string    `gorm:"not null"`
+	UpdatedAt    time.Time `gorm:"not null"`
+}
+
+func (AudienceListModel) TableName() string {
+	return "account_audience_projections"
+}
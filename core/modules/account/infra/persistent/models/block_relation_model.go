@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type BlockRelationModel struct {
+	BlockerID string    `gorm:"primaryKey"`
+	BlockedID string    `gorm:"primaryKey"`
+	BlockedAt time.Time `gorm:"not null"`
+}
+
+func (BlockRelationModel) TableName() string {
+	return "account_block_projections"
+}
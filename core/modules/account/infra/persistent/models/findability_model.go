@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// FindabilityModel stores the add-by switches an account turned off in the
+// relationship module. Accounts without a row are findable everywhere.
+type FindabilityModel struct {
+	AccountID          string    `gorm:"primaryKey"`
+	FindableByUsername bool      `gorm:"not null"`
+	FindableByEmail    bool      `gorm:"not null"`
+	FindableByQR       bool      `gorm:"column:findable_by_qr;not null"`
+	UpdatedAt          time.Time `gorm:"not null"`
+}
+
+func (FindabilityModel) TableName() string {
+	return "account_findability_projections"
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// FriendshipModel stores each friendship once, with the lower account id in
+// AccountLowID.
+type FriendshipModel struct {
+	AccountLowID  string    `gorm:"primaryKey"`
+	AccountHighID string    `gorm:"primaryKey"`
+	Since         time.Time `gorm:"not null"`
+}
+
+func (FriendshipModel) TableName() string {
+	return "account_friend_projections"
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type LoginAttemptModel struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"not null;index:ix_login_attempts_account_created"`
+	DeviceUID   string `gorm:"not null;default:''"`
+	IPAddress   string `gorm:"not null;default:''"`
+	UserAgent   string `gorm:"not null;default:''"`
+	CountryCode string `gorm:"not null;default:''"`
+	ASN         int64  `gorm:"column:asn;not null;default:0"`
+	Latitude    *float64
+	Longitude   *float64
+	Outcome     string    `gorm:"not null"`
+	RiskScore   int       `gorm:"not null;default:0"`
+	RiskSignals string    `gorm:"not null;default:''"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (LoginAttemptModel) TableName() string {
+	return "account_login_attempts"
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type UsernameReservationModel struct {
+	UsernameKey   string    `gorm:"primaryKey"`
+	AccountID     string    `gorm:"not null"`
+	ReservedUntil time.Time `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (UsernameReservationModel) TableName() string {
+	return "account_username_reservations"
+}
@@ -186,6 +186,7 @@ func (r *accountAggregateRepoImpl) saveProjection(ctx context.Context, db *gorm.
 				"password",
 				"display_name",
 				"username",
+				"username_changed_at",
 				"avatar_object_key",
 				"status",
 				"email_verified_at",
@@ -193,6 +194,15 @@ func (r *accountAggregateRepoImpl) saveProjection(ctx context.Context, db *gorm.
 				"password_changed_at",
 				"banned_reason",
 				"banned_until",
+				"locked_until",
+				"bio",
+				"region",
+				"gender",
+				"birthday",
+				"cover_object_key",
+				"profile_privacy",
+				"contact_card_nonce",
+				"contact_card_rotated_at",
 				"updated_at",
 			}),
 		}).
@@ -1,6 +1,9 @@
 package repos
 
 import (
+	"encoding/json"
+	"strings"
+
 	"wechat-clone/core/modules/account/domain/entity"
 	valueobject "wechat-clone/core/modules/account/domain/value_object"
 	"wechat-clone/core/modules/account/infra/persistent/models"
@@ -21,14 +24,28 @@ func projectionModelToAccount(m *models.AccountModel) (*entity.Account, error) {
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	role, err := accounttypes.ParseAccountRole(m.Role)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	gender, err := accounttypes.ParseGender(m.Gender)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	privacy, err := decodeProfilePrivacy(m.ProfilePrivacy)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 	return &entity.Account{
 		ID:                m.ID,
 		Email:             email,
 		PasswordHash:      passwordHash,
 		DisplayName:       m.DisplayName,
 		Username:          m.Username,
+		UsernameChangedAt: m.UsernameChangedAt,
 		AvatarObjectKey:   m.AvatarObjectKey,
 		Status:            status,
+		Role:              role,
 		EmailVerifiedAt:   m.EmailVerifiedAt,
 		LastLoginAt:       m.LastLoginAt,
 		PasswordChangedAt: m.PasswordChangedAt,
@@ -36,24 +53,67 @@ func projectionModelToAccount(m *models.AccountModel) (*entity.Account, error) {
 		UpdatedAt:         m.UpdatedAt,
 		BannedReason:      m.BannedReason,
 		BannedUntil:       m.BannedUntil,
+		LockedUntil:       m.LockedUntil,
+		Profile: entity.ProfileDetails{
+			Bio:            m.Bio,
+			Region:         m.Region,
+			Gender:         gender,
+			Birthday:       m.Birthday,
+			CoverObjectKey: m.CoverObjectKey,
+			Privacy:        privacy,
+		},
+		ContactCardNonce:     m.ContactCardNonce,
+		ContactCardRotatedAt: m.ContactCardRotatedAt,
 	}, nil
 }
 
 func accountToProjectionModel(e *entity.Account) *models.AccountModel {
+	gender := e.Profile.Gender
+	if gender == "" {
+		gender = accounttypes.GenderUnspecified
+	}
 	return &models.AccountModel{
-		ID:                e.ID,
-		Email:             e.Email.Value(),
-		Password:          e.PasswordHash.Value(),
-		DisplayName:       e.DisplayName,
-		Username:          e.Username,
-		AvatarObjectKey:   e.AvatarObjectKey,
-		Status:            e.Status.String(),
-		EmailVerifiedAt:   e.EmailVerifiedAt,
-		LastLoginAt:       e.LastLoginAt,
-		PasswordChangedAt: e.PasswordChangedAt,
-		CreatedAt:         e.CreatedAt,
-		UpdatedAt:         e.UpdatedAt,
-		BannedReason:      e.BannedReason,
-		BannedUntil:       e.BannedUntil,
+		ID:                   e.ID,
+		Email:                e.Email.Value(),
+		Password:             e.PasswordHash.Value(),
+		DisplayName:          e.DisplayName,
+		Username:             e.Username,
+		UsernameChangedAt:    e.UsernameChangedAt,
+		AvatarObjectKey:      e.AvatarObjectKey,
+		Status:               e.Status.String(),
+		Role:                 e.Role.String(),
+		EmailVerifiedAt:      e.EmailVerifiedAt,
+		LastLoginAt:          e.LastLoginAt,
+		PasswordChangedAt:    e.PasswordChangedAt,
+		CreatedAt:            e.CreatedAt,
+		UpdatedAt:            e.UpdatedAt,
+		BannedReason:         e.BannedReason,
+		BannedUntil:          e.BannedUntil,
+		LockedUntil:          e.LockedUntil,
+		Bio:                  e.Profile.Bio,
+		Region:               e.Profile.Region,
+		Gender:               gender.String(),
+		Birthday:             e.Profile.Birthday,
+		CoverObjectKey:       e.Profile.CoverObjectKey,
+		ProfilePrivacy:       encodeProfilePrivacy(e.Profile.Privacy),
+		ContactCardNonce:     e.ContactCardNonce,
+		ContactCardRotatedAt: e.ContactCardRotatedAt,
+	}
+}
+
+func decodeProfilePrivacy(raw string) (entity.ProfilePrivacy, error) {
+	var privacy entity.ProfilePrivacy
+	if strings.TrimSpace(raw) == "" {
+		return privacy.WithDefaults(), nil
+	}
+	if err := json.Unmarshal([]byte(raw), &privacy); err != nil {
+		return entity.ProfilePrivacy{}, stackErr.Error(err)
 	}
+	return privacy.WithDefaults(), nil
+}
+
+func encodeProfilePrivacy(privacy entity.ProfilePrivacy) string {
+	// ProfilePrivacy only holds strings, so marshalling cannot fail.
+	raw, _ := json.Marshal(privacy.WithDefaults())
+	return string(raw)
 }
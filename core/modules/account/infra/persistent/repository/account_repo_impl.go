@@ -6,6 +6,7 @@ import (
 
 	accountprojection "wechat-clone/core/modules/account/application/projection"
 	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/modules/account/domain/rules"
 	accountcache "wechat-clone/core/modules/account/infra/cache"
 	"wechat-clone/core/modules/account/infra/persistent/models"
 	accounttypes "wechat-clone/core/modules/account/types"
@@ -111,6 +112,16 @@ func (r *accountRepoImpl) GetAccountByEmail(ctx context.Context, email string) (
 	return accountEntity, nil
 }
 
+func (r *accountRepoImpl) GetAccountByUsername(ctx context.Context, username string) (*entity.Account, error) {
+	var m models.AccountModel
+	if err := r.db.WithContext(ctx).
+		Where("USERNAME_NORM = ?", rules.UsernameKey(username)).
+		First(&m).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return r.toEntity(&m)
+}
+
 func (r *accountRepoImpl) toEntity(m *models.AccountModel) (*entity.Account, error) {
 	return projectionModelToAccount(m)
 }
@@ -147,13 +158,13 @@ func (r *accountRepoImpl) SearchUsers(ctx context.Context, q string, limit, offs
 		Model(&models.AccountModel{}).
 		Where("status = ?", accounttypes.AccountStatusActive.String()).
 		Where(r.db.WithContext(ctx).Where(
-			"USERNAME_NORM LIKE ?",
+			"USERNAME_NORM LIKE ? AND NOT EXISTS (SELECT 1 FROM account_findability_projections f WHERE f.account_id = accounts.id AND NOT f.findable_by_username)",
 			prefixQuery,
 		).Or(
 			"DISPLAY_NAME_NORM LIKE ?",
 			containsQuery,
 		).Or(
-			"EMAIL_NORM LIKE ?",
+			"EMAIL_NORM LIKE ? AND NOT EXISTS (SELECT 1 FROM account_findability_projections f WHERE f.account_id = accounts.id AND NOT f.findable_by_email)",
 			prefixQuery,
 		))
 
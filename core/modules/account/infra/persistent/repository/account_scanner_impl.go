@@ -0,0 +1,42 @@
+package repos
+
+import (
+	"context"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/domain/entity"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type accountScannerImpl struct {
+	db *gorm.DB
+}
+
+func NewAccountScannerImpl(db *gorm.DB) accountprojection.AccountScanner {
+	return &accountScannerImpl{db: db}
+}
+
+func (r *accountScannerImpl) ListAccountsAfter(ctx context.Context, afterID string, limit int) ([]*entity.Account, error) {
+	query := r.db.WithContext(ctx).Order("id ASC").Limit(limit)
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var rows []models.AccountModel
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	accounts := make([]*entity.Account, 0, len(rows))
+	for i := range rows {
+		account, err := projectionModelToAccount(&rows[i])
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
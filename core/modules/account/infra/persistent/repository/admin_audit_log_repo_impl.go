@@ -0,0 +1,103 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	accountrepos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type adminAuditLogRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewAdminAuditLogRepoImpl(db *gorm.DB) accountrepos.AdminAuditLogRepository {
+	return &adminAuditLogRepoImpl{db: db}
+}
+
+func (r *adminAuditLogRepoImpl) Append(ctx context.Context, log *entity.AdminAuditLog) error {
+	if log == nil {
+		return stackErr.Error(fmt.Errorf("admin audit log is nil"))
+	}
+	if err := r.db.WithContext(ctx).Create(r.toModel(log)).Error; err != nil {
+		return stackErr.Error(fmt.Errorf("append admin audit log failed: %w", err))
+	}
+	return nil
+}
+
+func (r *adminAuditLogRepoImpl) List(ctx context.Context, filter entity.AdminAuditLogFilter) ([]*entity.AdminAuditLog, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.AdminAuditLogModel{})
+	if actorID := strings.TrimSpace(filter.ActorAccountID); actorID != "" {
+		query = query.Where("actor_account_id = ?", actorID)
+	}
+	if targetID := strings.TrimSpace(filter.TargetAccountID); targetID != "" {
+		query = query.Where("target_account_id = ?", targetID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action.String())
+	}
+	if requestID := strings.TrimSpace(filter.RequestID); requestID != "" {
+		query = query.Where("request_id = ?", requestID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", filter.From.UTC())
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", filter.To.UTC())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, stackErr.Error(err)
+	}
+
+	var modelsList []models.AdminAuditLogModel
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&modelsList).Error; err != nil {
+		return nil, 0, stackErr.Error(err)
+	}
+
+	result := make([]*entity.AdminAuditLog, 0, len(modelsList))
+	for idx := range modelsList {
+		result = append(result, r.toEntity(&modelsList[idx]))
+	}
+	return result, total, nil
+}
+
+func (r *adminAuditLogRepoImpl) toModel(log *entity.AdminAuditLog) *models.AdminAuditLogModel {
+	return &models.AdminAuditLogModel{
+		ID:              log.ID,
+		ActorAccountID:  log.ActorAccountID,
+		Action:          log.Action.String(),
+		TargetAccountID: log.TargetAccountID,
+		BeforeValue:     log.Before,
+		AfterValue:      log.After,
+		Reason:          log.Reason,
+		RequestID:       log.RequestID,
+		CreatedAt:       log.CreatedAt,
+	}
+}
+
+func (r *adminAuditLogRepoImpl) toEntity(model *models.AdminAuditLogModel) *entity.AdminAuditLog {
+	return &entity.AdminAuditLog{
+		ID:              model.ID,
+		ActorAccountID:  model.ActorAccountID,
+		Action:          accounttypes.AdminAuditAction(model.Action),
+		TargetAccountID: model.TargetAccountID,
+		Before:          model.BeforeValue,
+		After:           model.AfterValue,
+		Reason:          model.Reason,
+		RequestID:       model.RequestID,
+		CreatedAt:       model.CreatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package repos
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type audienceListRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewAudienceListRepoImpl(db *gorm.DB) accountprojection.AudienceListRepository {
+	return &audienceListRepoImpl{db: db}
+}
+
+func (r *audienceListRepoImpl) ProjectAudienceList(ctx context.Context, ownerID, friendID, audienceList string, updatedAt time.Time) error {
+	ownerID = strings.TrimSpace(ownerID)
+	friendID = strings.TrimSpace(friendID)
+	if strings.TrimSpace(audienceList) == "" {
+		return stackErr.Error(r.db.WithContext(ctx).
+			Where("owner_id = ? AND friend_id = ?", ownerID, friendID).
+			Delete(&models.AudienceListModel{}).Error)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}, {Name: "friend_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"audience_list", "updated_at"}),
+		}).
+		Create(&models.AudienceListModel{
+			OwnerID:      ownerID,
+			FriendID:     friendID,
+			AudienceList: audienceList,
+			UpdatedAt:    updatedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *audienceListRepoImpl) RemoveBetween(ctx context.Context, accountID, otherAccountID string) error {
+	accountID = strings.TrimSpace(accountID)
+	otherAccountID = strings.TrimSpace(otherAccountID)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("(owner_id = ? AND friend_id = ?) OR (owner_id = ? AND friend_id = ?)", accountID, otherAccountID, otherAccountID, accountID).
+		Delete(&models.AudienceListModel{}).Error)
+}
+
+func (r *audienceListRepoImpl) GetAudienceList(ctx context.Context, ownerID, friendID string) (string, error) {
+	var model models.AudienceListModel
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ? AND friend_id = ?", strings.TrimSpace(ownerID), strings.TrimSpace(friendID)).
+		Take(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	return model.AudienceList, nil
+}
@@ -0,0 +1,56 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type blockRelationRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewBlockRelationRepoImpl(db *gorm.DB) accountprojection.BlockRelationRepository {
+	return &blockRelationRepoImpl{db: db}
+}
+
+func (r *blockRelationRepoImpl) ProjectBlocked(ctx context.Context, blockerID, blockedID string, blockedAt time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "blocker_id"}, {Name: "blocked_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"blocked_at"}),
+		}).
+		Create(&models.BlockRelationModel{
+			BlockerID: strings.TrimSpace(blockerID),
+			BlockedID: strings.TrimSpace(blockedID),
+			BlockedAt: blockedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *blockRelationRepoImpl) RemoveBlocked(ctx context.Context, blockerID, blockedID string) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", strings.TrimSpace(blockerID), strings.TrimSpace(blockedID)).
+		Delete(&models.BlockRelationModel{}).Error)
+}
+
+func (r *blockRelationRepoImpl) IsBlockedBetween(ctx context.Context, accountID, otherAccountID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.BlockRelationModel{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)",
+			accountID, otherAccountID, otherAccountID, accountID).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
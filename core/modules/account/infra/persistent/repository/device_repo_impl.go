@@ -53,6 +53,30 @@ func (r *deviceRepoImpl) GetByAccountAndID(ctx context.Context, accountID string
 	return r.toAggregate(device)
 }
 
+func (r *deviceRepoImpl) ListByAccountID(ctx context.Context, accountID string) ([]*aggregate.DeviceAggregate, error) {
+	var modelsList []models.DeviceModel
+	if err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		Order("last_seen_at DESC NULLS LAST, created_at DESC").
+		Find(&modelsList).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	result := make([]*aggregate.DeviceAggregate, 0, len(modelsList))
+	for _, model := range modelsList {
+		device, err := r.toEntity(&model)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		agg, err := r.toAggregate(device)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		result = append(result, agg)
+	}
+	return result, nil
+}
+
 func (r *deviceRepoImpl) Save(ctx context.Context, device *aggregate.DeviceAggregate) error {
 	if device == nil {
 		return stackErr.Error(fmt.Errorf("device is nil"))
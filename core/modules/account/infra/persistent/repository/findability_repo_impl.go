@@ -0,0 +1,64 @@
+package repos
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type findabilityRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewFindabilityRepoImpl(db *gorm.DB) accountprojection.FindabilityRepository {
+	return &findabilityRepoImpl{db: db}
+}
+
+func (r *findabilityRepoImpl) ProjectFindability(ctx context.Context, accountID string, findability accountprojection.Findability, updatedAt time.Time) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"findable_by_username",
+				"findable_by_email",
+				"findable_by_qr",
+				"updated_at",
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{clause.Expr{
+				SQL: "account_findability_projections.updated_at <= EXCLUDED.updated_at",
+			}}},
+		}).
+		Create(&models.FindabilityModel{
+			AccountID:          strings.TrimSpace(accountID),
+			FindableByUsername: findability.ByUsername,
+			FindableByEmail:    findability.ByEmail,
+			FindableByQR:       findability.ByQR,
+			UpdatedAt:          updatedAt.UTC(),
+		}).Error)
+}
+
+func (r *findabilityRepoImpl) GetFindability(ctx context.Context, accountID string) (accountprojection.Findability, error) {
+	var model models.FindabilityModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", strings.TrimSpace(accountID)).
+		Take(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return accountprojection.DefaultFindability(), nil
+	}
+	if err != nil {
+		return accountprojection.Findability{}, stackErr.Error(err)
+	}
+	return accountprojection.Findability{
+		ByUsername: model.FindableByUsername,
+		ByEmail:    model.FindableByEmail,
+		ByQR:       model.FindableByQR,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type friendshipRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewFriendshipRepoImpl(db *gorm.DB) accountprojection.FriendshipRepository {
+	return &friendshipRepoImpl{db: db}
+}
+
+func (r *friendshipRepoImpl) ProjectFriendship(ctx context.Context, accountID, friendID string, since time.Time) error {
+	low, high := orderedFriendPair(accountID, friendID)
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_low_id"}, {Name: "account_high_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"since"}),
+		}).
+		Create(&models.FriendshipModel{
+			AccountLowID:  low,
+			AccountHighID: high,
+			Since:         since.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *friendshipRepoImpl) RemoveFriendship(ctx context.Context, accountID, friendID string) error {
+	low, high := orderedFriendPair(accountID, friendID)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("account_low_id = ? AND account_high_id = ?", low, high).
+		Delete(&models.FriendshipModel{}).Error)
+}
+
+func (r *friendshipRepoImpl) AreFriends(ctx context.Context, accountID, otherAccountID string) (bool, error) {
+	low, high := orderedFriendPair(accountID, otherAccountID)
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.FriendshipModel{}).
+		Where("account_low_id = ? AND account_high_id = ?", low, high).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
+
+func orderedFriendPair(accountID, friendID string) (string, string) {
+	accountID = strings.TrimSpace(accountID)
+	friendID = strings.TrimSpace(friendID)
+	if accountID > friendID {
+		return friendID, accountID
+	}
+	return accountID, friendID
+}
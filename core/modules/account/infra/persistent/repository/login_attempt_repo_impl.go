@@ -0,0 +1,132 @@
+package repos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	accountrepos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	accounttypes "wechat-clone/core/modules/account/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type loginAttemptRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginAttemptRepoImpl(db *gorm.DB) accountrepos.LoginAttemptRepository {
+	return &loginAttemptRepoImpl{db: db}
+}
+
+func (r *loginAttemptRepoImpl) Append(ctx context.Context, attempt *entity.LoginAttempt) error {
+	if attempt == nil {
+		return stackErr.Error(fmt.Errorf("login attempt is nil"))
+	}
+	if err := r.db.WithContext(ctx).Create(r.toModel(attempt)).Error; err != nil {
+		return stackErr.Error(fmt.Errorf("append login attempt failed: %w", err))
+	}
+	return nil
+}
+
+func (r *loginAttemptRepoImpl) LatestSucceeded(ctx context.Context, accountID string) (*entity.LoginAttempt, error) {
+	var model models.LoginAttemptModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND outcome = ?", accountID, accounttypes.LoginAttemptOutcomeSucceeded.String()).
+		Order("created_at DESC").
+		Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *loginAttemptRepoImpl) CountFailuresSince(ctx context.Context, accountID string, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.LoginAttemptModel{}).
+		Where("account_id = ? AND created_at >= ?", accountID, since.UTC()).
+		Where("outcome IN ?", []string{
+			accounttypes.LoginAttemptOutcomeInvalidPassword.String(),
+			accounttypes.LoginAttemptOutcomeStepUpFailed.String(),
+		}).
+		Count(&count).Error; err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return count, nil
+}
+
+func (r *loginAttemptRepoImpl) HasSucceededFromCountry(ctx context.Context, accountID, countryCode string) (bool, error) {
+	return r.hasSucceeded(ctx, accountID, "country_code = ?", strings.ToUpper(strings.TrimSpace(countryCode)))
+}
+
+func (r *loginAttemptRepoImpl) HasSucceededFromASN(ctx context.Context, accountID string, asn uint32) (bool, error) {
+	return r.hasSucceeded(ctx, accountID, "asn = ?", int64(asn))
+}
+
+func (r *loginAttemptRepoImpl) hasSucceeded(ctx context.Context, accountID string, condition string, value interface{}) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.LoginAttemptModel{}).
+		Where("account_id = ? AND outcome = ?", accountID, accounttypes.LoginAttemptOutcomeSucceeded.String()).
+		Where(condition, value).
+		Limit(1).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
+
+func (r *loginAttemptRepoImpl) toModel(attempt *entity.LoginAttempt) *models.LoginAttemptModel {
+	signals := make([]string, 0, len(attempt.RiskSignals))
+	for _, signal := range attempt.RiskSignals {
+		signals = append(signals, signal.String())
+	}
+	return &models.LoginAttemptModel{
+		ID:          attempt.ID,
+		AccountID:   attempt.AccountID,
+		DeviceUID:   attempt.DeviceUID,
+		IPAddress:   attempt.IPAddress,
+		UserAgent:   attempt.UserAgent,
+		CountryCode: attempt.CountryCode,
+		ASN:         int64(attempt.ASN),
+		Latitude:    attempt.Latitude,
+		Longitude:   attempt.Longitude,
+		Outcome:     attempt.Outcome.String(),
+		RiskScore:   attempt.RiskScore,
+		RiskSignals: strings.Join(signals, ","),
+		CreatedAt:   attempt.CreatedAt,
+	}
+}
+
+func (r *loginAttemptRepoImpl) toEntity(model *models.LoginAttemptModel) *entity.LoginAttempt {
+	var signals []accounttypes.LoginRiskSignal
+	for _, signal := range strings.Split(model.RiskSignals, ",") {
+		if signal = strings.TrimSpace(signal); signal != "" {
+			signals = append(signals, accounttypes.LoginRiskSignal(signal))
+		}
+	}
+	return &entity.LoginAttempt{
+		ID:          model.ID,
+		AccountID:   model.AccountID,
+		DeviceUID:   model.DeviceUID,
+		IPAddress:   model.IPAddress,
+		UserAgent:   model.UserAgent,
+		CountryCode: model.CountryCode,
+		ASN:         uint32(model.ASN),
+		Latitude:    model.Latitude,
+		Longitude:   model.Longitude,
+		Outcome:     accounttypes.LoginAttemptOutcome(model.Outcome),
+		RiskScore:   model.RiskScore,
+		RiskSignals: signals,
+		CreatedAt:   model.CreatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	accountprojection "wechat-clone/core/modules/account/application/projection"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	shareddb "wechat-clone/core/shared/infra/db"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// profileViewAggregateType keeps view events apart from the account event
+	// stream, so replaying an account never sees them.
+	profileViewAggregateType = "AccountProfileView"
+	// profileViewBucket is how often the same viewer can record a view of the
+	// same profile; refreshing a profile repeatedly counts once per bucket.
+	profileViewBucket = time.Hour
+)
+
+type profileViewRecorderImpl struct {
+	publisher eventpkg.Publisher
+}
+
+func NewProfileViewRecorderImpl(db *gorm.DB) accountprojection.ProfileViewRecorder {
+	return &profileViewRecorderImpl{
+		publisher: eventpkg.NewPublisher(&accountOutboxEventStore{
+			db:         db,
+			serializer: eventpkg.NewSerializer(),
+		}),
+	}
+}
+
+func (r *profileViewRecorderImpl) RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error {
+	viewedAt = viewedAt.UTC()
+	err := r.publisher.Publish(ctx, eventpkg.Event{
+		AggregateID:   profileViewAggregateID(viewerID, ownerID, viewedAt),
+		AggregateType: profileViewAggregateType,
+		Version:       1,
+		EventName:     sharedevents.EventAccountProfileViewed,
+		EventData: &sharedevents.AccountProfileViewedEvent{
+			ViewerID: viewerID,
+			OwnerID:  ownerID,
+			ViewedAt: viewedAt,
+		},
+		CreatedAt: viewedAt.Unix(),
+	})
+	// The aggregate id is unique per viewer, owner and bucket, so a repeat
+	// view hits the (aggregate_id, version) index and is dropped.
+	if shareddb.IsUniqueConstraintError(err) {
+		return nil
+	}
+	return stackErr.Error(err)
+}
+
+func profileViewAggregateID(viewerID, ownerID string, viewedAt time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", viewerID, ownerID, viewedAt.Truncate(profileViewBucket).Unix())
+}
@@ -22,6 +22,9 @@ type repoImpl struct {
 	accountAggregateRepo repos.AccountAggregateRepository
 	deviceRepo           repos.DeviceAggregateRepository
 	sessionRepo          repos.SessionAggregateRepository
+	adminAuditLogRepo    repos.AdminAuditLogRepository
+	loginAttemptRepo     repos.LoginAttemptRepository
+	usernameReservations repos.UsernameReservationRepository
 }
 
 func NewRepoImpl(db *gorm.DB, cache sharedcache.Cache) repos.Repos {
@@ -41,6 +44,9 @@ func newRepoImplWithDB(
 	r.accountAggregateRepo = NewAccountAggregateRepoImpl(db, cache, r.runAfterCommit, !inTransaction)
 	r.deviceRepo = NewDeviceRepoImpl(db)
 	r.sessionRepo = NewSessionRepoImpl(db, cache, !inTransaction, r.runAfterCommit)
+	r.adminAuditLogRepo = NewAdminAuditLogRepoImpl(db)
+	r.loginAttemptRepo = NewLoginAttemptRepoImpl(db)
+	r.usernameReservations = NewUsernameReservationRepoImpl(db)
 	return r
 }
 
@@ -64,6 +70,18 @@ func (r *repoImpl) SessionRepository() repos.SessionRepository {
 	return r.sessionRepo
 }
 
+func (r *repoImpl) AdminAuditLogRepository() repos.AdminAuditLogRepository {
+	return r.adminAuditLogRepo
+}
+
+func (r *repoImpl) LoginAttemptRepository() repos.LoginAttemptRepository {
+	return r.loginAttemptRepo
+}
+
+func (r *repoImpl) UsernameReservationRepository() repos.UsernameReservationRepository {
+	return r.usernameReservations
+}
+
 func (r *repoImpl) WithTransaction(ctx context.Context, fn func(repos.Repos) error) (err error) {
 	log := logging.FromContext(ctx).Named("StartTransaction")
 	tx := r.db.WithContext(ctx).Begin()
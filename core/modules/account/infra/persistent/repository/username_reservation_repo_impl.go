@@ -0,0 +1,63 @@
+package repos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wechat-clone/core/modules/account/domain/entity"
+	accountrepos "wechat-clone/core/modules/account/domain/repos"
+	"wechat-clone/core/modules/account/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type usernameReservationRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewUsernameReservationRepoImpl(db *gorm.DB) accountrepos.UsernameReservationRepository {
+	return &usernameReservationRepoImpl{db: db}
+}
+
+func (r *usernameReservationRepoImpl) Reserve(ctx context.Context, reservation *entity.UsernameReservation) error {
+	if reservation == nil {
+		return stackErr.Error(fmt.Errorf("username reservation is nil"))
+	}
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "username_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"account_id", "reserved_until", "created_at"}),
+		}).
+		Create(&models.UsernameReservationModel{
+			UsernameKey:   reservation.UsernameKey,
+			AccountID:     reservation.AccountID,
+			ReservedUntil: reservation.ReservedUntil,
+			CreatedAt:     reservation.CreatedAt,
+		}).Error; err != nil {
+		return stackErr.Error(fmt.Errorf("reserve username failed: %w", err))
+	}
+	return nil
+}
+
+func (r *usernameReservationRepoImpl) FindActive(ctx context.Context, usernameKey string, now time.Time) (*entity.UsernameReservation, error) {
+	var model models.UsernameReservationModel
+	err := r.db.WithContext(ctx).
+		Where("username_key = ? AND reserved_until > ?", usernameKey, now.UTC()).
+		Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return &entity.UsernameReservation{
+		UsernameKey:   model.UsernameKey,
+		AccountID:     model.AccountID,
+		ReservedUntil: model.ReservedUntil,
+		CreatedAt:     model.CreatedAt,
+	}, nil
+}
@@ -2,18 +2,26 @@ package elasticsearch
 
 import "time"
 
+// accountDocument is what any signed-in user can find through search, so it
+// only carries public fields. Email is indexed for exact and prefix lookups
+// but excluded from _source, so hits never return it.
 type accountDocument struct {
-	ID                string     `json:"id"`
-	Email             string     `json:"email"`
-	DisplayName       string     `json:"display_name"`
-	Username          *string    `json:"username,omitempty"`
-	AvatarObjectKey   *string    `json:"avatar_object_key,omitempty"`
-	Status            string     `json:"status"`
-	EmailVerifiedAt   *time.Time `json:"email_verified_at,omitempty"`
-	LastLoginAt       *time.Time `json:"last_login_at,omitempty"`
-	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
-	BannedReason      string     `json:"banned_reason,omitempty"`
-	BannedUntil       *time.Time `json:"banned_until,omitempty"`
+	ID              string     `json:"id"`
+	Email           string     `json:"email,omitempty"`
+	DisplayName     string     `json:"display_name"`
+	Username        *string    `json:"username,omitempty"`
+	AvatarObjectKey *string    `json:"avatar_object_key,omitempty"`
+	Status          string     `json:"status"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	// Extended profile fields are only indexed when visible to everyone.
+	Bio            string  `json:"bio,omitempty"`
+	Region         string  `json:"region,omitempty"`
+	Gender         string  `json:"gender,omitempty"`
+	CoverObjectKey *string `json:"cover_object_key,omitempty"`
+	// Lookups by username or email skip accounts that switched the channel
+	// off in their friend request settings.
+	FindableByUsername bool `json:"findable_by_username"`
+	FindableByEmail    bool `json:"findable_by_email"`
 }
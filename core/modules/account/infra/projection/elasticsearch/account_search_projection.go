@@ -11,6 +11,7 @@ import (
 
 	accountprojection "wechat-clone/core/modules/account/application/projection"
 	"wechat-clone/core/modules/account/domain/entity"
+	accounttypes "wechat-clone/core/modules/account/types"
 	"wechat-clone/core/shared/config"
 	"wechat-clone/core/shared/pkg/stackErr"
 
@@ -18,21 +19,27 @@ import (
 	"github.com/elastic/go-elasticsearch/v8/esapi"
 )
 
-const defaultAccountSearchIndex = "accounts_v1"
+const defaultAccountSearchIndex = "accounts_v2"
 
 type accountSearchProjection struct {
-	client *es8.Client
-	index  string
+	client      *es8.Client
+	index       string
+	findability accountprojection.FindabilityRepository
 }
 
-func NewAccountSearchProjection(cfg config.ElasticsearchConfig, client *es8.Client) (accountprojection.SearchProjection, error) {
+func NewAccountSearchProjection(
+	cfg config.ElasticsearchConfig,
+	client *es8.Client,
+	findability accountprojection.FindabilityRepository,
+) (accountprojection.SearchProjection, error) {
 	if !cfg.Enabled || client == nil {
 		return nil, nil
 	}
 
 	projection := &accountSearchProjection{
-		client: client,
-		index:  resolveAccountSearchIndex(cfg),
+		client:      client,
+		index:       resolveAccountSearchIndex(cfg),
+		findability: findability,
 	}
 	if err := projection.ensureIndex(context.Background()); err != nil {
 		return nil, stackErr.Error(err)
@@ -46,20 +53,32 @@ func (p *accountSearchProjection) SyncAccount(ctx context.Context, account *enti
 	}
 
 	document := accountDocument{
-		ID:                strings.TrimSpace(account.ID),
-		Email:             account.Email.Value(),
-		DisplayName:       account.DisplayName,
-		Username:          cloneStringPtr(account.Username),
-		AvatarObjectKey:   cloneStringPtr(account.AvatarObjectKey),
-		Status:            account.Status.String(),
-		EmailVerifiedAt:   cloneTimePtr(account.EmailVerifiedAt),
-		LastLoginAt:       cloneTimePtr(account.LastLoginAt),
-		PasswordChangedAt: cloneTimePtr(account.PasswordChangedAt),
-		CreatedAt:         account.CreatedAt.UTC(),
-		UpdatedAt:         account.UpdatedAt.UTC(),
-		BannedReason:      account.BannedReason,
-		BannedUntil:       cloneTimePtr(account.BannedUntil),
+		ID:              strings.TrimSpace(account.ID),
+		Email:           account.Email.Value(),
+		DisplayName:     account.DisplayName,
+		Username:        cloneStringPtr(account.Username),
+		AvatarObjectKey: cloneStringPtr(account.AvatarObjectKey),
+		Status:          account.Status.String(),
+		EmailVerifiedAt: cloneTimePtr(account.EmailVerifiedAt),
+		CreatedAt:       account.CreatedAt.UTC(),
+		UpdatedAt:       account.UpdatedAt.UTC(),
 	}
+	public := account.Profile.VisibleTo(accounttypes.ProfileViewerStranger)
+	document.Bio = public.Bio
+	document.Region = public.Region
+	document.Gender = public.Gender.String()
+	document.CoverObjectKey = cloneStringPtr(public.CoverObjectKey)
+
+	findability := accountprojection.DefaultFindability()
+	if p.findability != nil {
+		loaded, err := p.findability.GetFindability(ctx, document.ID)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("load account findability failed: %w", err))
+		}
+		findability = loaded
+	}
+	document.FindableByUsername = findability.ByUsername
+	document.FindableByEmail = findability.ByEmail
 
 	body, err := json.Marshal(document)
 	if err != nil {
@@ -109,6 +128,30 @@ func (p *accountSearchProjection) DeleteAccount(ctx context.Context, accountID s
 	return nil
 }
 
+// DropAccountSearchIndex deletes an account index that was replaced by a
+// reindex, so documents written under an older shape stop being served.
+func DropAccountSearchIndex(ctx context.Context, client *es8.Client, index string) error {
+	index = strings.TrimSpace(index)
+	if client == nil || index == "" {
+		return nil
+	}
+
+	req := esapi.IndicesDeleteRequest{Index: []string{index}}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("delete account search index failed: %w", err))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if res.IsError() {
+		return stackErr.Error(fmt.Errorf("delete account search index returned status %s: %s", res.Status(), readBody(res.Body)))
+	}
+	return nil
+}
+
 func (p *accountSearchProjection) ensureIndex(ctx context.Context) error {
 	if p == nil || p.client == nil {
 		return nil
@@ -123,7 +166,7 @@ func (p *accountSearchProjection) ensureIndex(ctx context.Context) error {
 
 	switch existsRes.StatusCode {
 	case http.StatusOK:
-		return nil
+		return p.ensureMapping(ctx)
 	case http.StatusNotFound:
 	default:
 		return stackErr.Error(fmt.Errorf("check account search index returned status %s: %s", existsRes.Status(), readBody(existsRes.Body)))
@@ -154,6 +197,32 @@ func (p *accountSearchProjection) ensureIndex(ctx context.Context) error {
 	return nil
 }
 
+// ensureMapping adds fields introduced after the index was created. The index
+// is strict, so documents carrying new fields are rejected until this runs.
+func (p *accountSearchProjection) ensureMapping(ctx context.Context) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"properties": accountSearchIndexProperties(),
+	})
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal account search mapping failed: %w", err))
+	}
+
+	req := esapi.IndicesPutMappingRequest{
+		Index: []string{p.index},
+		Body:  bytes.NewReader(body),
+	}
+	res, err := req.Do(ctx, p.client)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("update account search mapping failed: %w", err))
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return stackErr.Error(fmt.Errorf("update account search mapping returned status %s: %s", res.Status(), readBody(res.Body)))
+	}
+	return nil
+}
+
 func accountSearchIndexDefinition() map[string]interface{} {
 	return map[string]interface{}{
 		"settings": map[string]interface{}{
@@ -167,28 +236,33 @@ func accountSearchIndexDefinition() map[string]interface{} {
 			},
 		},
 		"mappings": map[string]interface{}{
-			"dynamic": "strict",
-			"properties": map[string]interface{}{
-				"id":                map[string]interface{}{"type": "keyword"},
-				"email":             map[string]interface{}{"type": "keyword"},
-				"display_name":      map[string]interface{}{"type": "text", "analyzer": "account_text", "fields": map[string]interface{}{"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256}}},
-				"username":          map[string]interface{}{"type": "keyword"},
-				"avatar_object_key": map[string]interface{}{"type": "keyword", "ignore_above": 1024},
-				"status":            map[string]interface{}{"type": "keyword"},
-				"email_verified_at": map[string]interface{}{"type": "date"},
-				"last_login_at":     map[string]interface{}{"type": "date"},
-				"password_changed_at": map[string]interface{}{
-					"type": "date",
-				},
-				"created_at":    map[string]interface{}{"type": "date"},
-				"updated_at":    map[string]interface{}{"type": "date"},
-				"banned_reason": map[string]interface{}{"type": "keyword", "ignore_above": 512},
-				"banned_until":  map[string]interface{}{"type": "date"},
-			},
+			"dynamic":    "strict",
+			"_source":    map[string]interface{}{"excludes": []string{"email"}},
+			"properties": accountSearchIndexProperties(),
 		},
 	}
 }
 
+func accountSearchIndexProperties() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   map[string]interface{}{"type": "keyword"},
+		"email":                map[string]interface{}{"type": "keyword"},
+		"display_name":         map[string]interface{}{"type": "text", "analyzer": "account_text", "fields": map[string]interface{}{"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256}}},
+		"username":             map[string]interface{}{"type": "keyword"},
+		"avatar_object_key":    map[string]interface{}{"type": "keyword", "ignore_above": 1024},
+		"status":               map[string]interface{}{"type": "keyword"},
+		"email_verified_at":    map[string]interface{}{"type": "date"},
+		"created_at":           map[string]interface{}{"type": "date"},
+		"updated_at":           map[string]interface{}{"type": "date"},
+		"bio":                  map[string]interface{}{"type": "text", "analyzer": "account_text"},
+		"region":               map[string]interface{}{"type": "keyword", "ignore_above": 256},
+		"gender":               map[string]interface{}{"type": "keyword"},
+		"cover_object_key":     map[string]interface{}{"type": "keyword", "ignore_above": 1024},
+		"findable_by_username": map[string]interface{}{"type": "boolean"},
+		"findable_by_email":    map[string]interface{}{"type": "boolean"},
+	}
+}
+
 func resolveAccountSearchIndex(cfg config.ElasticsearchConfig) string {
 	index := strings.TrimSpace(cfg.AccountIndex)
 	if index == "" {
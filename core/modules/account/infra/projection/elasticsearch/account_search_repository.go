@@ -122,10 +122,14 @@ func searchUsersQuery(q string, limit, offset int) map[string]interface{} {
 					map[string]interface{}{"term": map[string]interface{}{"status": accounttypes.AccountStatusActive.String()}},
 				},
 				"should": []interface{}{
-					map[string]interface{}{"term": map[string]interface{}{"username": map[string]interface{}{"value": q, "boost": 10}}},
-					map[string]interface{}{"prefix": map[string]interface{}{"username": map[string]interface{}{"value": q, "boost": 7}}},
-					map[string]interface{}{"term": map[string]interface{}{"email": map[string]interface{}{"value": strings.ToLower(q), "boost": 6}}},
-					map[string]interface{}{"prefix": map[string]interface{}{"email": map[string]interface{}{"value": strings.ToLower(q), "boost": 5}}},
+					findableOn("findable_by_username",
+						map[string]interface{}{"term": map[string]interface{}{"username": map[string]interface{}{"value": q, "boost": 10}}},
+						map[string]interface{}{"prefix": map[string]interface{}{"username": map[string]interface{}{"value": q, "boost": 7}}},
+					),
+					findableOn("findable_by_email",
+						map[string]interface{}{"term": map[string]interface{}{"email": map[string]interface{}{"value": strings.ToLower(q), "boost": 6}}},
+						map[string]interface{}{"prefix": map[string]interface{}{"email": map[string]interface{}{"value": strings.ToLower(q), "boost": 5}}},
+					),
 					map[string]interface{}{"match_phrase_prefix": map[string]interface{}{"display_name": map[string]interface{}{"query": q, "boost": 4}}},
 					map[string]interface{}{"match": map[string]interface{}{"display_name": map[string]interface{}{"query": q, "boost": 2}}},
 				},
@@ -134,17 +138,13 @@ func searchUsersQuery(q string, limit, offset int) map[string]interface{} {
 		},
 		"sort": []interface{}{
 			"_score",
-			map[string]interface{}{"last_login_at": map[string]interface{}{"order": "desc", "missing": "_last"}},
 			map[string]interface{}{"created_at": map[string]interface{}{"order": "desc"}},
 		},
 	}
 }
 
+// documentToAccount leaves Email empty: it is not stored in _source.
 func documentToAccount(document accountDocument) (*entity.Account, error) {
-	email, err := valueobject.NewEmail(document.Email)
-	if err != nil {
-		return nil, stackErr.Error(err)
-	}
 	passwordHash, err := valueobject.NewHashedPassword("projection-redacted-password-hash")
 	if err != nil {
 		return nil, stackErr.Error(err)
@@ -154,21 +154,37 @@ func documentToAccount(document accountDocument) (*entity.Account, error) {
 		return nil, stackErr.Error(err)
 	}
 	return &entity.Account{
-		ID:                document.ID,
-		Email:             email,
-		PasswordHash:      passwordHash,
-		DisplayName:       document.DisplayName,
-		Username:          cloneStringPtr(document.Username),
-		AvatarObjectKey:   cloneStringPtr(document.AvatarObjectKey),
-		Status:            status,
-		EmailVerifiedAt:   cloneTimePtr(document.EmailVerifiedAt),
-		LastLoginAt:       cloneTimePtr(document.LastLoginAt),
-		PasswordChangedAt: cloneTimePtr(document.PasswordChangedAt),
-		CreatedAt:         document.CreatedAt.UTC(),
-		UpdatedAt:         document.UpdatedAt.UTC(),
-		BannedReason:      document.BannedReason,
-		BannedUntil:       cloneTimePtr(document.BannedUntil),
+		ID:              document.ID,
+		PasswordHash:    passwordHash,
+		DisplayName:     document.DisplayName,
+		Username:        cloneStringPtr(document.Username),
+		AvatarObjectKey: cloneStringPtr(document.AvatarObjectKey),
+		Status:          status,
+		EmailVerifiedAt: cloneTimePtr(document.EmailVerifiedAt),
+		CreatedAt:       document.CreatedAt.UTC(),
+		UpdatedAt:       document.UpdatedAt.UTC(),
+		Profile: entity.ProfileDetails{
+			Bio:            document.Bio,
+			Region:         document.Region,
+			Gender:         accounttypes.Gender(document.Gender),
+			CoverObjectKey: cloneStringPtr(document.CoverObjectKey),
+		},
 	}, nil
 }
 
 var _ accountprojection.SearchRepository = (*accountSearchRepository)(nil)
+
+// findableOn only lets the clauses match accounts that keep the lookup
+// channel switched on. Documents indexed before the flag existed have no
+// value and stay findable.
+func findableOn(field string, clauses ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should":               clauses,
+			"minimum_should_match": 1,
+			"must_not": []interface{}{
+				map[string]interface{}{"term": map[string]interface{}{field: false}},
+			},
+		},
+	}
+}
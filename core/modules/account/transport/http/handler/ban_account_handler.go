@@ -0,0 +1,52 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type banAccountHandler struct {
+	banAccount cqrs.Dispatcher[*in.BanAccountRequest, *out.BanAccountResponse]
+}
+
+func NewBanAccountHandler(
+	banAccount cqrs.Dispatcher[*in.BanAccountRequest, *out.BanAccountResponse],
+) *banAccountHandler {
+	return &banAccountHandler{
+		banAccount: banAccount,
+	}
+}
+
+func (h *banAccountHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.BanAccountRequest
+	request.AccountID = c.Param("account_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.banAccount.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("BanAccount failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getAccountByUsernameHandler struct {
+	getAccountByUsername cqrs.Dispatcher[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse]
+}
+
+func NewGetAccountByUsernameHandler(
+	getAccountByUsername cqrs.Dispatcher[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse],
+) *getAccountByUsernameHandler {
+	return &getAccountByUsernameHandler{
+		getAccountByUsername: getAccountByUsername,
+	}
+}
+
+func (h *getAccountByUsernameHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetAccountByUsernameRequest
+	request.Username = c.Param("username")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getAccountByUsername.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetAccountByUsername failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type listAccountSessionsHandler struct {
+	listAccountSessions cqrs.Dispatcher[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse]
+}
+
+func NewListAccountSessionsHandler(
+	listAccountSessions cqrs.Dispatcher[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse],
+) *listAccountSessionsHandler {
+	return &listAccountSessionsHandler{
+		listAccountSessions: listAccountSessions,
+	}
+}
+
+func (h *listAccountSessionsHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.ListAccountSessionsRequest
+	request.AccountID = c.Param("account_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.listAccountSessions.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("ListAccountSessions failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/account/application/dto/in"
+	"wechat-clone/core/modules/account/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type revokeUnrecognizedSessionHandler struct {
+	revokeUnrecognizedSession cqrs.Dispatcher[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse]
+}
+
+func NewRevokeUnrecognizedSessionHandler(
+	revokeUnrecognizedSession cqrs.Dispatcher[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse],
+) *revokeUnrecognizedSessionHandler {
+	return &revokeUnrecognizedSessionHandler{
+		revokeUnrecognizedSession: revokeUnrecognizedSession,
+	}
+}
+
+func (h *revokeUnrecognizedSessionHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.RevokeUnrecognizedSessionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.revokeUnrecognizedSession.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("RevokeUnrecognizedSession failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -14,6 +14,8 @@ import (
 func RegisterPublicRoutes(
 	routes *gin.RouterGroup,
 	login cqrs.Dispatcher[*in.LoginRequest, *out.LoginResponse],
+	loginStepUp cqrs.Dispatcher[*in.LoginStepUpRequest, *out.LoginStepUpResponse],
+	revokeUnrecognizedSession cqrs.Dispatcher[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse],
 	register cqrs.Dispatcher[*in.RegisterRequest, *out.RegisterResponse],
 	refresh cqrs.Dispatcher[*in.RefreshRequest, *out.RefreshResponse],
 	confirmVerifyEmail cqrs.Dispatcher[*in.ConfirmVerifyEmailRequest, *out.ConfirmVerifyEmailResponse],
@@ -21,6 +23,8 @@ func RegisterPublicRoutes(
 	callbackGoogle cqrs.Dispatcher[*in.CallbackGoogleRequest, *out.CallbackGoogleResponse],
 ) {
 	routes.POST("/auth/login", httpx.Wrap(handler.NewLoginHandler(login)))
+	routes.POST("/auth/login/step-up", httpx.Wrap(handler.NewLoginStepUpHandler(loginStepUp)))
+	routes.POST("/auth/sessions/revoke-unrecognized", httpx.Wrap(handler.NewRevokeUnrecognizedSessionHandler(revokeUnrecognizedSession)))
 	routes.POST("/auth/register", httpx.Wrap(handler.NewRegisterHandler(register)))
 	routes.POST("/auth/refresh", httpx.Wrap(handler.NewRefreshHandler(refresh)))
 	routes.POST("/account/verify-email/confirm", httpx.Wrap(handler.NewConfirmVerifyEmailHandler(confirmVerifyEmail)))
@@ -37,6 +41,17 @@ func RegisterPrivateRoutes(
 	getAvatar cqrs.Dispatcher[*in.GetAvatarRequest, *out.GetAvatarResponse],
 	createPresignedUrl cqrs.Dispatcher[*in.CreatePresignedUrlRequest, *out.CreatePresignedUrlResponse],
 	searchUsers cqrs.Dispatcher[*in.SearchUsersRequest, *out.SearchUsersResponse],
+	banAccount cqrs.Dispatcher[*in.BanAccountRequest, *out.BanAccountResponse],
+	unbanAccount cqrs.Dispatcher[*in.UnbanAccountRequest, *out.UnbanAccountResponse],
+	forceLogout cqrs.Dispatcher[*in.ForceLogoutRequest, *out.ForceLogoutResponse],
+	resetProfile cqrs.Dispatcher[*in.ResetProfileRequest, *out.ResetProfileResponse],
+	listAccountSessions cqrs.Dispatcher[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse],
+	listAuditLogs cqrs.Dispatcher[*in.ListAuditLogsRequest, *out.ListAuditLogsResponse],
+	claimUsername cqrs.Dispatcher[*in.ClaimUsernameRequest, *out.ClaimUsernameResponse],
+	getAccountByUsername cqrs.Dispatcher[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse],
+	getContactCard cqrs.Dispatcher[*in.GetContactCardRequest, *out.GetContactCardResponse],
+	resetContactCard cqrs.Dispatcher[*in.ResetContactCardRequest, *out.ResetContactCardResponse],
+	resolveContactCard cqrs.Dispatcher[*in.ResolveContactCardRequest, *out.ResolveContactCardResponse],
 ) {
 	routes.POST("/auth/logout", httpx.Wrap(handler.NewLogoutHandler(logout)))
 	routes.GET("/account/profile", httpx.Wrap(handler.NewGetProfileHandler(getProfile)))
@@ -46,4 +61,15 @@ func RegisterPrivateRoutes(
 	routes.GET("/account/avatar/:account_id", httpx.Wrap(handler.NewGetAvatarHandler(getAvatar)))
 	routes.POST("/account/avatar/presigned-url", httpx.Wrap(handler.NewCreatePresignedUrlHandler(createPresignedUrl)))
 	routes.GET("/account/search-users", httpx.Wrap(handler.NewSearchUsersHandler(searchUsers)))
+	routes.POST("/admin/accounts/:account_id/ban", httpx.Wrap(handler.NewBanAccountHandler(banAccount)))
+	routes.POST("/admin/accounts/:account_id/unban", httpx.Wrap(handler.NewUnbanAccountHandler(unbanAccount)))
+	routes.POST("/admin/accounts/:account_id/force-logout", httpx.Wrap(handler.NewForceLogoutHandler(forceLogout)))
+	routes.POST("/admin/accounts/:account_id/profile/reset", httpx.Wrap(handler.NewResetProfileHandler(resetProfile)))
+	routes.GET("/admin/accounts/:account_id/sessions", httpx.Wrap(handler.NewListAccountSessionsHandler(listAccountSessions)))
+	routes.GET("/admin/audit-logs", httpx.Wrap(handler.NewListAuditLogsHandler(listAuditLogs)))
+	routes.PUT("/account/username", httpx.Wrap(handler.NewClaimUsernameHandler(claimUsername)))
+	routes.GET("/account/by-username/:username", httpx.Wrap(handler.NewGetAccountByUsernameHandler(getAccountByUsername)))
+	routes.GET("/account/contact-card", httpx.Wrap(handler.NewGetContactCardHandler(getContactCard)))
+	routes.POST("/account/contact-card/reset", httpx.Wrap(handler.NewResetContactCardHandler(resetContactCard)))
+	routes.GET("/account/contact-card/resolve", httpx.Wrap(handler.NewResolveContactCardHandler(resolveContactCard)))
 }
@@ -14,24 +14,39 @@ import (
 )
 
 type accountHTTPServer struct {
-	login              cqrs.Dispatcher[*in.LoginRequest, *out.LoginResponse]
-	register           cqrs.Dispatcher[*in.RegisterRequest, *out.RegisterResponse]
-	logout             cqrs.Dispatcher[*in.LogoutRequest, *out.LogoutResponse]
-	refresh            cqrs.Dispatcher[*in.RefreshRequest, *out.RefreshResponse]
-	getProfile         cqrs.Dispatcher[*in.GetProfileRequest, *out.GetProfileResponse]
-	updateProfile      cqrs.Dispatcher[*in.UpdateProfileRequest, *out.UpdateProfileResponse]
-	verifyEmail        cqrs.Dispatcher[*in.VerifyEmailRequest, *out.VerifyEmailResponse]
-	confirmVerifyEmail cqrs.Dispatcher[*in.ConfirmVerifyEmailRequest, *out.ConfirmVerifyEmailResponse]
-	changePassword     cqrs.Dispatcher[*in.ChangePasswordRequest, *out.ChangePasswordResponse]
-	getAvatar          cqrs.Dispatcher[*in.GetAvatarRequest, *out.GetAvatarResponse]
-	createPresignedUrl cqrs.Dispatcher[*in.CreatePresignedUrlRequest, *out.CreatePresignedUrlResponse]
-	searchUsers        cqrs.Dispatcher[*in.SearchUsersRequest, *out.SearchUsersResponse]
-	loginGoogle        cqrs.Dispatcher[*in.LoginGoogleRequest, *out.LoginGoogleResponse]
-	callbackGoogle     cqrs.Dispatcher[*in.CallbackGoogleRequest, *out.CallbackGoogleResponse]
+	login                     cqrs.Dispatcher[*in.LoginRequest, *out.LoginResponse]
+	loginStepUp               cqrs.Dispatcher[*in.LoginStepUpRequest, *out.LoginStepUpResponse]
+	revokeUnrecognizedSession cqrs.Dispatcher[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse]
+	register                  cqrs.Dispatcher[*in.RegisterRequest, *out.RegisterResponse]
+	logout                    cqrs.Dispatcher[*in.LogoutRequest, *out.LogoutResponse]
+	refresh                   cqrs.Dispatcher[*in.RefreshRequest, *out.RefreshResponse]
+	getProfile                cqrs.Dispatcher[*in.GetProfileRequest, *out.GetProfileResponse]
+	updateProfile             cqrs.Dispatcher[*in.UpdateProfileRequest, *out.UpdateProfileResponse]
+	verifyEmail               cqrs.Dispatcher[*in.VerifyEmailRequest, *out.VerifyEmailResponse]
+	confirmVerifyEmail        cqrs.Dispatcher[*in.ConfirmVerifyEmailRequest, *out.ConfirmVerifyEmailResponse]
+	changePassword            cqrs.Dispatcher[*in.ChangePasswordRequest, *out.ChangePasswordResponse]
+	getAvatar                 cqrs.Dispatcher[*in.GetAvatarRequest, *out.GetAvatarResponse]
+	createPresignedUrl        cqrs.Dispatcher[*in.CreatePresignedUrlRequest, *out.CreatePresignedUrlResponse]
+	searchUsers               cqrs.Dispatcher[*in.SearchUsersRequest, *out.SearchUsersResponse]
+	loginGoogle               cqrs.Dispatcher[*in.LoginGoogleRequest, *out.LoginGoogleResponse]
+	callbackGoogle            cqrs.Dispatcher[*in.CallbackGoogleRequest, *out.CallbackGoogleResponse]
+	banAccount                cqrs.Dispatcher[*in.BanAccountRequest, *out.BanAccountResponse]
+	unbanAccount              cqrs.Dispatcher[*in.UnbanAccountRequest, *out.UnbanAccountResponse]
+	forceLogout               cqrs.Dispatcher[*in.ForceLogoutRequest, *out.ForceLogoutResponse]
+	resetProfile              cqrs.Dispatcher[*in.ResetProfileRequest, *out.ResetProfileResponse]
+	listAccountSessions       cqrs.Dispatcher[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse]
+	listAuditLogs             cqrs.Dispatcher[*in.ListAuditLogsRequest, *out.ListAuditLogsResponse]
+	claimUsername             cqrs.Dispatcher[*in.ClaimUsernameRequest, *out.ClaimUsernameResponse]
+	getAccountByUsername      cqrs.Dispatcher[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse]
+	getContactCard            cqrs.Dispatcher[*in.GetContactCardRequest, *out.GetContactCardResponse]
+	resetContactCard          cqrs.Dispatcher[*in.ResetContactCardRequest, *out.ResetContactCardResponse]
+	resolveContactCard        cqrs.Dispatcher[*in.ResolveContactCardRequest, *out.ResolveContactCardResponse]
 }
 
 func NewHTTPServer(
 	login cqrs.Dispatcher[*in.LoginRequest, *out.LoginResponse],
+	loginStepUp cqrs.Dispatcher[*in.LoginStepUpRequest, *out.LoginStepUpResponse],
+	revokeUnrecognizedSession cqrs.Dispatcher[*in.RevokeUnrecognizedSessionRequest, *out.RevokeUnrecognizedSessionResponse],
 	register cqrs.Dispatcher[*in.RegisterRequest, *out.RegisterResponse],
 	logout cqrs.Dispatcher[*in.LogoutRequest, *out.LogoutResponse],
 	refresh cqrs.Dispatcher[*in.RefreshRequest, *out.RefreshResponse],
@@ -45,31 +60,55 @@ func NewHTTPServer(
 	searchUsers cqrs.Dispatcher[*in.SearchUsersRequest, *out.SearchUsersResponse],
 	loginGoogle cqrs.Dispatcher[*in.LoginGoogleRequest, *out.LoginGoogleResponse],
 	callbackGoogle cqrs.Dispatcher[*in.CallbackGoogleRequest, *out.CallbackGoogleResponse],
+	banAccount cqrs.Dispatcher[*in.BanAccountRequest, *out.BanAccountResponse],
+	unbanAccount cqrs.Dispatcher[*in.UnbanAccountRequest, *out.UnbanAccountResponse],
+	forceLogout cqrs.Dispatcher[*in.ForceLogoutRequest, *out.ForceLogoutResponse],
+	resetProfile cqrs.Dispatcher[*in.ResetProfileRequest, *out.ResetProfileResponse],
+	listAccountSessions cqrs.Dispatcher[*in.ListAccountSessionsRequest, *out.ListAccountSessionsResponse],
+	listAuditLogs cqrs.Dispatcher[*in.ListAuditLogsRequest, *out.ListAuditLogsResponse],
+	claimUsername cqrs.Dispatcher[*in.ClaimUsernameRequest, *out.ClaimUsernameResponse],
+	getAccountByUsername cqrs.Dispatcher[*in.GetAccountByUsernameRequest, *out.GetAccountByUsernameResponse],
+	getContactCard cqrs.Dispatcher[*in.GetContactCardRequest, *out.GetContactCardResponse],
+	resetContactCard cqrs.Dispatcher[*in.ResetContactCardRequest, *out.ResetContactCardResponse],
+	resolveContactCard cqrs.Dispatcher[*in.ResolveContactCardRequest, *out.ResolveContactCardResponse],
 ) (infrahttp.HTTPServer, error) {
 	return &accountHTTPServer{
-		login:              login,
-		register:           register,
-		logout:             logout,
-		refresh:            refresh,
-		getProfile:         getProfile,
-		updateProfile:      updateProfile,
-		verifyEmail:        verifyEmail,
-		confirmVerifyEmail: confirmVerifyEmail,
-		changePassword:     changePassword,
-		getAvatar:          getAvatar,
-		createPresignedUrl: createPresignedUrl,
-		searchUsers:        searchUsers,
-		loginGoogle:        loginGoogle,
-		callbackGoogle:     callbackGoogle,
+		login:                     login,
+		loginStepUp:               loginStepUp,
+		revokeUnrecognizedSession: revokeUnrecognizedSession,
+		register:                  register,
+		logout:                    logout,
+		refresh:                   refresh,
+		getProfile:                getProfile,
+		updateProfile:             updateProfile,
+		verifyEmail:               verifyEmail,
+		confirmVerifyEmail:        confirmVerifyEmail,
+		changePassword:            changePassword,
+		getAvatar:                 getAvatar,
+		createPresignedUrl:        createPresignedUrl,
+		searchUsers:               searchUsers,
+		loginGoogle:               loginGoogle,
+		callbackGoogle:            callbackGoogle,
+		banAccount:                banAccount,
+		unbanAccount:              unbanAccount,
+		forceLogout:               forceLogout,
+		resetProfile:              resetProfile,
+		listAccountSessions:       listAccountSessions,
+		listAuditLogs:             listAuditLogs,
+		claimUsername:             claimUsername,
+		getAccountByUsername:      getAccountByUsername,
+		getContactCard:            getContactCard,
+		resetContactCard:          resetContactCard,
+		resolveContactCard:        resolveContactCard,
 	}, nil
 }
 
 func (s *accountHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
-	accounthttp.RegisterPublicRoutes(routes, s.login, s.register, s.refresh, s.confirmVerifyEmail, s.loginGoogle, s.callbackGoogle)
+	accounthttp.RegisterPublicRoutes(routes, s.login, s.loginStepUp, s.revokeUnrecognizedSession, s.register, s.refresh, s.confirmVerifyEmail, s.loginGoogle, s.callbackGoogle)
 }
 
 func (s *accountHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	accounthttp.RegisterPrivateRoutes(routes, s.logout, s.getProfile, s.updateProfile, s.verifyEmail, s.changePassword, s.getAvatar, s.createPresignedUrl, s.searchUsers)
+	accounthttp.RegisterPrivateRoutes(routes, s.logout, s.getProfile, s.updateProfile, s.verifyEmail, s.changePassword, s.getAvatar, s.createPresignedUrl, s.searchUsers, s.banAccount, s.unbanAccount, s.forceLogout, s.resetProfile, s.listAccountSessions, s.listAuditLogs, s.claimUsername, s.getAccountByUsername, s.getContactCard, s.resetContactCard, s.resolveContactCard)
 }
 
 func (s *accountHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
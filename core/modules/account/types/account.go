@@ -19,6 +19,21 @@ const (
 	AccountRoleAdmin AccountRole = "admin"
 )
 
+func ParseAccountRole(value string) (AccountRole, error) {
+	switch normalized := AccountRole(strings.ToLower(strings.TrimSpace(value))); normalized {
+	case AccountRoleUser, AccountRoleAdmin:
+		return normalized, nil
+	case "":
+		return AccountRoleUser, nil
+	default:
+		return "", errors.New("role is invalid")
+	}
+}
+
+func (r AccountRole) String() string {
+	return string(r)
+}
+
 type AccountStatus string
 
 const (
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+	"strings"
+)
+
+type AdminAuditAction string
+
+const (
+	AdminAuditActionBanAccount   AdminAuditAction = "account.ban"
+	AdminAuditActionUnbanAccount AdminAuditAction = "account.unban"
+	AdminAuditActionForceLogout  AdminAuditAction = "account.force_logout"
+	AdminAuditActionResetProfile AdminAuditAction = "account.reset_profile"
+	AdminAuditActionViewSessions AdminAuditAction = "account.view_sessions"
+)
+
+func ParseAdminAuditAction(value string) (AdminAuditAction, error) {
+	switch normalized := AdminAuditAction(strings.ToLower(strings.TrimSpace(value))); normalized {
+	case AdminAuditActionBanAccount,
+		AdminAuditActionUnbanAccount,
+		AdminAuditActionForceLogout,
+		AdminAuditActionResetProfile,
+		AdminAuditActionViewSessions:
+		return normalized, nil
+	default:
+		return "", errors.New("audit action is invalid")
+	}
+}
+
+func (a AdminAuditAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,46 @@
+package types
+
+type LoginRiskSignal string
+
+const (
+	LoginRiskSignalNewDevice        LoginRiskSignal = "new_device"
+	LoginRiskSignalNewCountry       LoginRiskSignal = "new_country"
+	LoginRiskSignalNewASN           LoginRiskSignal = "new_asn"
+	LoginRiskSignalImpossibleTravel LoginRiskSignal = "impossible_travel"
+	LoginRiskSignalFailedPasswords  LoginRiskSignal = "failed_password_burst"
+)
+
+func (s LoginRiskSignal) String() string {
+	return string(s)
+}
+
+type LoginRiskDecision string
+
+const (
+	LoginRiskDecisionAllow  LoginRiskDecision = "allow"
+	LoginRiskDecisionStepUp LoginRiskDecision = "step_up"
+	LoginRiskDecisionLock   LoginRiskDecision = "lock"
+)
+
+func (d LoginRiskDecision) String() string {
+	return string(d)
+}
+
+type LoginAttemptOutcome string
+
+const (
+	LoginAttemptOutcomeSucceeded       LoginAttemptOutcome = "succeeded"
+	LoginAttemptOutcomeInvalidPassword LoginAttemptOutcome = "invalid_password"
+	LoginAttemptOutcomeStepUpRequired  LoginAttemptOutcome = "step_up_required"
+	LoginAttemptOutcomeStepUpFailed    LoginAttemptOutcome = "step_up_failed"
+	LoginAttemptOutcomeLocked          LoginAttemptOutcome = "locked"
+)
+
+func (o LoginAttemptOutcome) String() string {
+	return string(o)
+}
+
+// IsFailure reports whether the attempt counts towards the failed-password burst.
+func (o LoginAttemptOutcome) IsFailure() bool {
+	return o == LoginAttemptOutcomeInvalidPassword || o == LoginAttemptOutcomeStepUpFailed
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"strings"
+)
+
+type ProfileVisibility string
+
+const (
+	ProfileVisibilityEveryone     ProfileVisibility = "everyone"
+	ProfileVisibilityFriends      ProfileVisibility = "friends"
+	ProfileVisibilityCloseFriends ProfileVisibility = "close_friends"
+	ProfileVisibilityOnlyMe       ProfileVisibility = "only_me"
+)
+
+func ParseProfileVisibility(value string) (ProfileVisibility, error) {
+	switch normalized := ProfileVisibility(strings.ToLower(strings.TrimSpace(value))); normalized {
+	case ProfileVisibilityEveryone, ProfileVisibilityFriends, ProfileVisibilityCloseFriends, ProfileVisibilityOnlyMe:
+		return normalized, nil
+	case "":
+		return ProfileVisibilityEveryone, nil
+	default:
+		return "", errors.New("visibility is invalid")
+	}
+}
+
+func (v ProfileVisibility) String() string {
+	return string(v)
+}
+
+type Gender string
+
+const (
+	GenderUnspecified Gender = "unspecified"
+	GenderMale        Gender = "male"
+	GenderFemale      Gender = "female"
+	GenderOther       Gender = "other"
+)
+
+func ParseGender(value string) (Gender, error) {
+	switch normalized := Gender(strings.ToLower(strings.TrimSpace(value))); normalized {
+	case GenderUnspecified, GenderMale, GenderFemale, GenderOther:
+		return normalized, nil
+	case "":
+		return GenderUnspecified, nil
+	default:
+		return "", errors.New("gender is invalid")
+	}
+}
+
+func (g Gender) String() string {
+	return string(g)
+}
+
+// ProfileViewer describes who is looking at a profile, from the owner's side.
+// Close friends and restricted friends come from the owner's audience lists.
+type ProfileViewer string
+
+const (
+	ProfileViewerSelf        ProfileViewer = "self"
+	ProfileViewerCloseFriend ProfileViewer = "close_friend"
+	ProfileViewerFriend      ProfileViewer = "friend"
+	ProfileViewerRestricted  ProfileViewer = "restricted"
+	ProfileViewerStranger    ProfileViewer = "stranger"
+)
+
+// IsFriend reports whether the viewer is a friend of the owner. A restricted
+// friend is still a friend, it just sees what a stranger sees.
+func (v ProfileViewer) IsFriend() bool {
+	switch v {
+	case ProfileViewerCloseFriend, ProfileViewerFriend, ProfileViewerRestricted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows reports whether a field with this visibility is shown to the viewer.
+func (v ProfileVisibility) Allows(viewer ProfileViewer) bool {
+	switch viewer {
+	case ProfileViewerSelf:
+		return true
+	case ProfileViewerCloseFriend:
+		return v == ProfileVisibilityEveryone || v == ProfileVisibilityFriends || v == ProfileVisibilityCloseFriends
+	case ProfileViewerFriend:
+		return v == ProfileVisibilityEveryone || v == ProfileVisibilityFriends
+	default:
+		return v == ProfileVisibilityEveryone
+	}
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/foreign_exchange/application/dto/in"
+	"wechat-clone/core/modules/foreign_exchange/application/dto/out"
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	repos "wechat-clone/core/modules/foreign_exchange/domain/repos"
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+type createConversionHandler struct {
+	baseRepo repos.Repos
+	ledger   service.ConversionLedger
+}
+
+func NewCreateConversion(
+	_ *appCtx.AppContext,
+	baseRepo repos.Repos,
+	ledger service.ConversionLedger,
+) cqrs.Handler[*in.CreateConversionRequest, *out.CreateConversionResponse] {
+	return &createConversionHandler{
+		baseRepo: baseRepo,
+		ledger:   ledger,
+	}
+}
+
+// Handle consumes the quote and records the conversion in one transaction,
+// then books the ledger legs. A ledger error other than insufficient funds
+// leaves the conversion pending; retrying with the same idempotency key or
+// the pending sweep resumes it, and the ledger ignores legs it has already
+// applied.
+func (u *createConversionHandler) Handle(ctx context.Context, req *in.CreateConversionRequest) (*out.CreateConversionResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var conversion *entity.Conversion
+	err = u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		// Locking the quote first serialises concurrent retries of one key, so
+		// the later request finds the conversion instead of a consumed quote.
+		quote, err := txRepos.QuoteRepository().GetForUpdate(ctx, req.QuoteID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		existing, err := txRepos.ConversionRepository().GetByIdempotencyKey(ctx, accountID, req.IdempotencyKey)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if existing != nil {
+			if existing.QuoteID != quote.ID {
+				return stackErr.Error(domain.ErrIdempotencyKeyUsed)
+			}
+			conversion = existing
+			return nil
+		}
+
+		now := time.Now().UTC()
+		if err := quote.Consume(accountID, entity.QuotePurposeConversion, now); err != nil {
+			return stackErr.Error(err)
+		}
+		conversion, err = entity.NewConversion(uuid.NewString(), req.IdempotencyKey, quote, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.QuoteRepository().Update(ctx, quote); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.ConversionRepository().Create(ctx, conversion))
+	})
+	if err != nil {
+		return nil, stackErr.Error(mapConversionError(err))
+	}
+
+	if conversion.IsPending() {
+		if err := service.SettleConversion(ctx, u.ledger, conversion); err != nil {
+			return nil, stackErr.Error(err)
+		}
+		if err := u.baseRepo.ConversionRepository().Update(ctx, conversion); err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+	if conversion.Status == entity.ConversionStatusFailed {
+		return nil, stackErr.Error(ErrInsufficientFunds)
+	}
+
+	return toCreateConversionResponse(conversion), nil
+}
+
+func toCreateConversionResponse(conversion *entity.Conversion) *out.CreateConversionResponse {
+	return &out.CreateConversionResponse{
+		ConversionID: conversion.ID,
+		QuoteID:      conversion.QuoteID,
+		Status:       conversion.Status.String(),
+		FromCurrency: conversion.FromCurrency,
+		FromAmount:   conversion.FromAmount,
+		FeeAmount:    conversion.FeeAmount,
+		ToCurrency:   conversion.ToCurrency,
+		ToAmount:     conversion.ToAmount,
+		CustomerRate: conversion.CustomerRate,
+		CreatedAt:    conversion.CreatedAt.Format(time.RFC3339),
+	}
+}
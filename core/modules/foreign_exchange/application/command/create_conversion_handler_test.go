@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/application/dto/in"
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	repos "wechat-clone/core/modules/foreign_exchange/domain/repos"
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateConversionHandle(t *testing.T) {
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	req := &in.CreateConversionRequest{QuoteID: "quote-1", IdempotencyKey: "key-1"}
+	openQuote := func() *entity.Quote {
+		return &entity.Quote{
+			ID:           "quote-1",
+			AccountID:    "acc-1",
+			FromCurrency: "USD",
+			ToCurrency:   "VND",
+			FromAmount:   3969,
+			ToAmount:     1000000,
+			MidRate:      "25400",
+			CustomerRate: "25196.8",
+			Purpose:      entity.QuotePurposeConversion,
+			Status:       entity.QuoteStatusOpen,
+			ExpiresAt:    time.Now().UTC().Add(time.Minute),
+		}
+	}
+	setup := func(t *testing.T) (*repos.MockRepos, *repos.MockQuoteRepository, *repos.MockConversionRepository, *service.MockConversionLedger) {
+		ctrl := gomock.NewController(t)
+		baseRepo := repos.NewMockRepos(ctrl)
+		quoteRepo := repos.NewMockQuoteRepository(ctrl)
+		conversionRepo := repos.NewMockConversionRepository(ctrl)
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(repos.Repos) error) error {
+				return fn(baseRepo)
+			})
+		baseRepo.EXPECT().QuoteRepository().Return(quoteRepo).AnyTimes()
+		baseRepo.EXPECT().ConversionRepository().Return(conversionRepo).AnyTimes()
+		return baseRepo, quoteRepo, conversionRepo, service.NewMockConversionLedger(ctrl)
+	}
+
+	t.Run("consumes quote and books ledger legs", func(t *testing.T) {
+		baseRepo, quoteRepo, conversionRepo, ledger := setup(t)
+		quoteRepo.EXPECT().GetForUpdate(gomock.Any(), "quote-1").Return(openQuote(), nil)
+		conversionRepo.EXPECT().GetByIdempotencyKey(gomock.Any(), "acc-1", "key-1").Return(nil, nil)
+		quoteRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, quote *entity.Quote) error {
+				if quote.Status != entity.QuoteStatusConsumed {
+					t.Fatalf("expected consumed quote, got %s", quote.Status)
+				}
+				return nil
+			})
+		conversionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		ledger.EXPECT().PostConversion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, conversion *entity.Conversion) error {
+				if conversion.SpreadAmount != 8126 {
+					t.Fatalf("unexpected spread amount: %d", conversion.SpreadAmount)
+				}
+				return nil
+			})
+		conversionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := NewCreateConversion(nil, baseRepo, ledger).Handle(ctx, req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Status != entity.ConversionStatusCompleted.String() || res.FromAmount != 3969 || res.ToAmount != 1000000 {
+			t.Fatalf("unexpected response: %+v", res)
+		}
+	})
+
+	t.Run("replays completed conversion for the same key", func(t *testing.T) {
+		baseRepo, quoteRepo, conversionRepo, ledger := setup(t)
+		consumed := openQuote()
+		consumed.Status = entity.QuoteStatusConsumed
+		quoteRepo.EXPECT().GetForUpdate(gomock.Any(), "quote-1").Return(consumed, nil)
+		conversionRepo.EXPECT().GetByIdempotencyKey(gomock.Any(), "acc-1", "key-1").Return(&entity.Conversion{
+			ID:      "conv-1",
+			QuoteID: "quote-1",
+			Status:  entity.ConversionStatusCompleted,
+		}, nil)
+
+		res, err := NewCreateConversion(nil, baseRepo, ledger).Handle(ctx, req)
+		if err != nil {
+			t.Fatalf("expected replay to succeed, got %v", err)
+		}
+		if res.ConversionID != "conv-1" {
+			t.Fatalf("unexpected conversion id: %s", res.ConversionID)
+		}
+	})
+
+	t.Run("rejects key reused for another quote", func(t *testing.T) {
+		baseRepo, quoteRepo, conversionRepo, ledger := setup(t)
+		quoteRepo.EXPECT().GetForUpdate(gomock.Any(), "quote-1").Return(openQuote(), nil)
+		conversionRepo.EXPECT().GetByIdempotencyKey(gomock.Any(), "acc-1", "key-1").Return(&entity.Conversion{
+			ID:      "conv-0",
+			QuoteID: "quote-0",
+		}, nil)
+
+		_, err := NewCreateConversion(nil, baseRepo, ledger).Handle(ctx, req)
+		if !errors.Is(err, ErrIdempotencyKeyUsed) {
+			t.Fatalf("expected idempotency key error, got %v", err)
+		}
+	})
+
+	t.Run("marks conversion failed on insufficient funds", func(t *testing.T) {
+		baseRepo, quoteRepo, conversionRepo, ledger := setup(t)
+		quoteRepo.EXPECT().GetForUpdate(gomock.Any(), "quote-1").Return(openQuote(), nil)
+		conversionRepo.EXPECT().GetByIdempotencyKey(gomock.Any(), "acc-1", "key-1").Return(nil, nil)
+		quoteRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		conversionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		ledger.EXPECT().PostConversion(gomock.Any(), gomock.Any()).Return(domain.ErrInsufficientFunds)
+		conversionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, conversion *entity.Conversion) error {
+				if conversion.Status != entity.ConversionStatusFailed {
+					t.Fatalf("expected failed conversion, got %s", conversion.Status)
+				}
+				return nil
+			})
+
+		_, err := NewCreateConversion(nil, baseRepo, ledger).Handle(ctx, req)
+		if !errors.Is(err, ErrInsufficientFunds) {
+			t.Fatalf("expected insufficient funds error, got %v", err)
+		}
+	})
+}
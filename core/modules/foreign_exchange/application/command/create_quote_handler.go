@@ -1,26 +1,113 @@
-// CODE_GENERATOR: application-handler
 package command
 
 import (
 	"context"
-	"fmt"
+	"time"
+
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/foreign_exchange/application/dto/in"
 	"wechat-clone/core/modules/foreign_exchange/application/dto/out"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
 	repos "wechat-clone/core/modules/foreign_exchange/domain/repos"
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/pkg/actorctx"
 	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
 )
 
+const defaultQuoteTTL = 30 * time.Second
+
 type createQuoteHandler struct {
+	baseRepo repos.Repos
+	rates    service.RateProvider
+	spreads  entity.SpreadSchedule
+	fees     service.FeeQuoter
+	ttl      time.Duration
 }
 
 func NewCreateQuote(
 	appCtx *appCtx.AppContext,
 	baseRepo repos.Repos,
+	rates service.RateProvider,
+	spreads entity.SpreadSchedule,
+	fees service.FeeQuoter,
 ) cqrs.Handler[*in.CreateQuoteRequest, *out.CreateQuoteResponse] {
-	return &createQuoteHandler{}
+	handler := &createQuoteHandler{
+		baseRepo: baseRepo,
+		rates:    rates,
+		spreads:  spreads,
+		fees:     fees,
+		ttl:      defaultQuoteTTL,
+	}
+	if appCtx != nil && appCtx.GetConfig() != nil {
+		if seconds := appCtx.GetConfig().ForeignExchangeConfig.QuoteTTLSeconds; seconds > 0 {
+			handler.ttl = time.Duration(seconds) * time.Second
+		}
+	}
+	return handler
 }
 
 func (u *createQuoteHandler) Handle(ctx context.Context, req *in.CreateQuoteRequest) (*out.CreateQuoteResponse, error) {
-	return nil, fmt.Errorf("not implemented yet")
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	purpose, err := entity.ParseQuotePurpose(req.Purpose)
+	if err != nil {
+		return nil, stackErr.Error(mapQuoteError(err))
+	}
+	if err := entity.ValidateCurrencyPair(req.FromCurrency, req.ToCurrency); err != nil {
+		return nil, stackErr.Error(mapQuoteError(err))
+	}
+
+	rate, err := u.rates.GetRate(ctx, req.FromCurrency, req.ToCurrency)
+	if err != nil {
+		return nil, stackErr.Error(mapQuoteError(err))
+	}
+	quote, err := entity.NewQuote(entity.QuoteParams{
+		ID:        uuid.NewString(),
+		AccountID: accountID,
+		ToAmount:  req.ToAmount,
+		Purpose:   purpose,
+		Rate:      rate,
+		SpreadBPS: u.spreads.SpreadFor(rate.From, rate.To),
+		Now:       time.Now().UTC(),
+		TTL:       u.ttl,
+	})
+	if err != nil {
+		return nil, stackErr.Error(mapQuoteError(err))
+	}
+	feeAmount, err := u.fees.QuoteConversionFee(ctx, accountID, quote.FromCurrency, quote.FromAmount)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := quote.ApplyFee(feeAmount); err != nil {
+		return nil, stackErr.Error(mapQuoteError(err))
+	}
+
+	if err := u.baseRepo.QuoteRepository().Create(ctx, quote); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return toCreateQuoteResponse(quote), nil
+}
+
+func toCreateQuoteResponse(quote *entity.Quote) *out.CreateQuoteResponse {
+	return &out.CreateQuoteResponse{
+		QuoteID:      quote.ID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		FromAmount:   quote.FromAmount,
+		ToAmount:     quote.ToAmount,
+		MidRate:      quote.MidRate,
+		CustomerRate: quote.CustomerRate,
+		SpreadBps:    quote.SpreadBPS,
+		FeeAmount:    quote.FeeAmount,
+		TotalAmount:  quote.FromAmount + quote.FeeAmount,
+		Purpose:      quote.Purpose.String(),
+		ExpiresAt:    quote.ExpiresAt.Format(time.RFC3339),
+	}
 }
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrCurrencyUnsupported = apperr.New("fx.currency_unsupported", "currency is not supported", http.StatusBadRequest)
+	ErrSameCurrency        = apperr.New("fx.same_currency", "from_currency and to_currency must differ", http.StatusBadRequest)
+	ErrAmountInvalid       = apperr.New("fx.amount_invalid", "to_amount must be greater than 0", http.StatusBadRequest)
+	ErrPurposeInvalid      = apperr.New("fx.purpose_invalid", "purpose must be conversion, transfer or payment", http.StatusBadRequest)
+	ErrRateUnavailable     = apperr.New("fx.rate_unavailable", "no exchange rate is available for this currency pair", http.StatusServiceUnavailable)
+	ErrQuoteNotFound       = apperr.New("fx.quote_not_found", "quote not found", http.StatusNotFound)
+	ErrQuoteExpired        = apperr.New("fx.quote_expired", "quote has expired, request a new one", http.StatusConflict)
+	ErrQuoteConsumed       = apperr.New("fx.quote_consumed", "quote has already been executed", http.StatusConflict)
+	ErrQuotePurposeInvalid = apperr.New("fx.quote_purpose_invalid", "quote was not issued for a conversion", http.StatusBadRequest)
+	ErrIdempotencyKeyUsed  = apperr.New("fx.idempotency_key_used", "idempotency key was already used for another quote", http.StatusConflict)
+	ErrInsufficientFunds   = apperr.New("fx.insufficient_funds", "insufficient funds in the source currency", http.StatusUnprocessableEntity)
+)
+
+func mapQuoteError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCurrencyUnsupported):
+		return ErrCurrencyUnsupported
+	case errors.Is(err, domain.ErrSameCurrency):
+		return ErrSameCurrency
+	case errors.Is(err, domain.ErrAmountInvalid):
+		return ErrAmountInvalid
+	case errors.Is(err, domain.ErrPurposeInvalid):
+		return ErrPurposeInvalid
+	case errors.Is(err, domain.ErrRateUnavailable):
+		return ErrRateUnavailable
+	default:
+		return err
+	}
+}
+
+func mapConversionError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrQuoteNotFound):
+		return ErrQuoteNotFound
+	case errors.Is(err, domain.ErrQuoteExpired):
+		return ErrQuoteExpired
+	case errors.Is(err, domain.ErrQuoteConsumed):
+		return ErrQuoteConsumed
+	case errors.Is(err, domain.ErrQuotePurposeInvalid):
+		return ErrQuotePurposeInvalid
+	case errors.Is(err, domain.ErrIdempotencyKeyUsed):
+		return ErrIdempotencyKeyUsed
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return err
+	}
+}
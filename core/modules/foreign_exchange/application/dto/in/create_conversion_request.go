@@ -0,0 +1,30 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type CreateConversionRequest struct {
+	QuoteID        string `json:"quote_id" form:"quote_id" binding:"required"`
+	IdempotencyKey string `json:"idempotency_key" form:"idempotency_key" binding:"required"`
+}
+
+func (r *CreateConversionRequest) Normalize() {
+	r.QuoteID = strings.TrimSpace(r.QuoteID)
+	r.IdempotencyKey = strings.TrimSpace(r.IdempotencyKey)
+}
+
+func (r *CreateConversionRequest) Validate() error {
+	r.Normalize()
+	if r.QuoteID == "" {
+		return stackErr.Error(errors.New("quote_id is required"))
+	}
+	if r.IdempotencyKey == "" {
+		return stackErr.Error(errors.New("idempotency_key is required"))
+	}
+	return nil
+}
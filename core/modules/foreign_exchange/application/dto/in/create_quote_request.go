@@ -11,14 +11,13 @@ import (
 type CreateQuoteRequest struct {
 	FromCurrency string `json:"from_currency" form:"from_currency" binding:"required"`
 	ToCurrency   string `json:"to_currency" form:"to_currency" binding:"required"`
-	ToAmount     string `json:"to_amount" form:"to_amount" binding:"required"`
+	ToAmount     int64  `json:"to_amount" form:"to_amount" binding:"required"`
 	Purpose      string `json:"purpose" form:"purpose" binding:"required"`
 }
 
 func (r *CreateQuoteRequest) Normalize() {
 	r.FromCurrency = strings.TrimSpace(r.FromCurrency)
 	r.ToCurrency = strings.TrimSpace(r.ToCurrency)
-	r.ToAmount = strings.TrimSpace(r.ToAmount)
 	r.Purpose = strings.TrimSpace(r.Purpose)
 }
 
@@ -30,7 +29,7 @@ func (r *CreateQuoteRequest) Validate() error {
 	if r.ToCurrency == "" {
 		return stackErr.Error(errors.New("to_currency is required"))
 	}
-	if r.ToAmount == "" {
+	if r.ToAmount == 0 {
 		return stackErr.Error(errors.New("to_amount is required"))
 	}
 	if r.Purpose == "" {
@@ -0,0 +1,15 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type CreateConversionResponse struct {
+	ConversionID string `json:"conversion_id,omitempty"`
+	QuoteID      string `json:"quote_id,omitempty"`
+	Status       string `json:"status,omitempty"`
+	FromCurrency string `json:"from_currency,omitempty"`
+	FromAmount   int64  `json:"from_amount,omitempty"`
+	FeeAmount    int64  `json:"fee_amount,omitempty"`
+	ToCurrency   string `json:"to_currency,omitempty"`
+	ToAmount     int64  `json:"to_amount,omitempty"`
+	CustomerRate string `json:"customer_rate,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+}
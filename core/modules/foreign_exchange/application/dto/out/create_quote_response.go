@@ -4,9 +4,14 @@ package out
 type CreateQuoteResponse struct {
 	QuoteID      string `json:"quote_id,omitempty"`
 	FromCurrency string `json:"from_currency,omitempty"`
-	ToCurrency   int64  `json:"to_currency,omitempty"`
+	ToCurrency   string `json:"to_currency,omitempty"`
 	FromAmount   int64  `json:"from_amount,omitempty"`
-	ToAmount     string `json:"to_amount,omitempty"`
+	ToAmount     int64  `json:"to_amount,omitempty"`
+	MidRate      string `json:"mid_rate,omitempty"`
 	CustomerRate string `json:"customer_rate,omitempty"`
+	SpreadBps    int64  `json:"spread_bps,omitempty"`
+	FeeAmount    int64  `json:"fee_amount,omitempty"`
+	TotalAmount  int64  `json:"total_amount,omitempty"`
+	Purpose      string `json:"purpose,omitempty"`
 	ExpiresAt    string `json:"expires_at,omitempty"`
 }
@@ -0,0 +1,59 @@
+package cronjob
+
+import (
+	"time"
+
+	fxtask "wechat-clone/core/modules/foreign_exchange/application/scheduler/task"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+)
+
+type CronJob interface {
+	Start() error
+	Stop() error
+}
+
+type cronJob struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewCronJob(scheduler *asynq.Scheduler, interval time.Duration) (CronJob, error) {
+	if scheduler == nil {
+		return &cronJob{}, nil
+	}
+
+	task := asynq.NewTask(fxtask.ResumePendingConversionsTask, nil)
+	if _, err := scheduler.Register(
+		fxtask.PeriodicSpec(interval),
+		task,
+		asynq.Queue(fxtask.QueueName),
+		asynq.MaxRetry(0),
+		asynq.Unique(interval),
+	); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &cronJob{scheduler: scheduler}, nil
+}
+
+func (j *cronJob) Start() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	if err := j.scheduler.Start(); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (j *cronJob) Stop() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	j.scheduler.Shutdown()
+	return nil
+}
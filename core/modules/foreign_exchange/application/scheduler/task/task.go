@@ -0,0 +1,19 @@
+package task
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ResumePendingConversionsTask = "fx:conversion:resume-pending"
+	QueueName                    = "fx:scheduler"
+)
+
+func PeriodicSpec(interval time.Duration) string {
+	seconds := int(interval / time.Second)
+	if seconds <= 0 {
+		seconds = 60
+	}
+	return fmt.Sprintf("@every %ds", seconds)
+}
@@ -0,0 +1,74 @@
+package taskhandler
+
+import (
+	"context"
+
+	fxtask "wechat-clone/core/modules/foreign_exchange/application/scheduler/task"
+	fxservice "wechat-clone/core/modules/foreign_exchange/application/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+)
+
+type TaskHandler interface {
+	Start() error
+	Stop() error
+}
+
+type taskHandler struct {
+	sweepService fxservice.ConversionSweepService
+	server       *asynq.Server
+}
+
+func NewTaskHandler(sweepService fxservice.ConversionSweepService, server *asynq.Server) TaskHandler {
+	if sweepService == nil || server == nil {
+		return &taskHandler{}
+	}
+	return &taskHandler{
+		sweepService: sweepService,
+		server:       server,
+	}
+}
+
+func (h *taskHandler) Start() error {
+	if h == nil || h.sweepService == nil || h.server == nil {
+		return nil
+	}
+
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(fxtask.ResumePendingConversionsTask, h.handleResumePendingConversions)
+
+	if err := h.server.Start(mux); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (h *taskHandler) Stop() error {
+	if h == nil || h.server == nil {
+		return nil
+	}
+
+	h.server.Shutdown()
+	return nil
+}
+
+func (h *taskHandler) handleResumePendingConversions(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.sweepService == nil {
+		return nil
+	}
+
+	settled, err := h.sweepService.ResumePending(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("resume pending conversions failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if settled > 0 {
+		logging.FromContext(ctx).Infow("resumed pending conversions", zap.Int("count", settled))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	repos "wechat-clone/core/modules/foreign_exchange/domain/repos"
+	domainservice "wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+// ConversionSweepService resumes conversions whose quote was consumed but
+// whose ledger posting failed, so they do not stay pending until the client
+// happens to retry.
+type ConversionSweepService interface {
+	ResumePending(ctx context.Context) (int, error)
+}
+
+type conversionSweepService struct {
+	baseRepo  repos.Repos
+	ledger    domainservice.ConversionLedger
+	grace     time.Duration
+	batchSize int
+}
+
+// NewConversionSweepService only picks conversions untouched for grace, which
+// keeps it clear of requests still posting their own legs.
+func NewConversionSweepService(
+	baseRepo repos.Repos,
+	ledger domainservice.ConversionLedger,
+	grace time.Duration,
+	batchSize int,
+) ConversionSweepService {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &conversionSweepService{
+		baseRepo:  baseRepo,
+		ledger:    ledger,
+		grace:     grace,
+		batchSize: batchSize,
+	}
+}
+
+// ResumePending settles one batch and returns how many conversions left the
+// pending state. A conversion whose posting fails again is logged and left
+// for the next run.
+func (s *conversionSweepService) ResumePending(ctx context.Context) (int, error) {
+	log := logging.FromContext(ctx).Named("ResumePendingConversions")
+	conversions, err := s.baseRepo.ConversionRepository().ListPending(ctx, time.Now().UTC().Add(-s.grace), s.batchSize)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	settled := 0
+	for _, conversion := range conversions {
+		if err := domainservice.SettleConversion(ctx, s.ledger, conversion); err != nil {
+			log.Warnw("resume pending conversion failed", zap.String("conversion_id", conversion.ID), zap.Error(err))
+			continue
+		}
+		if err := s.baseRepo.ConversionRepository().Update(ctx, conversion); err != nil {
+			return settled, stackErr.Error(err)
+		}
+		settled++
+	}
+	return settled, nil
+}
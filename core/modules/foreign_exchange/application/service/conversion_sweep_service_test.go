@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	repos "wechat-clone/core/modules/foreign_exchange/domain/repos"
+	domainservice "wechat-clone/core/modules/foreign_exchange/domain/service"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestConversionSweepServiceResumePending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	conversionRepo := repos.NewMockConversionRepository(ctrl)
+	ledger := domainservice.NewMockConversionLedger(ctrl)
+	baseRepo.EXPECT().ConversionRepository().Return(conversionRepo).AnyTimes()
+
+	pending := func(id string) *entity.Conversion {
+		return &entity.Conversion{ID: id, Status: entity.ConversionStatusPending}
+	}
+	booked, broke, outOfFunds := pending("conv-1"), pending("conv-2"), pending("conv-3")
+
+	conversionRepo.EXPECT().ListPending(gomock.Any(), gomock.Any(), 10).
+		DoAndReturn(func(_ context.Context, updatedBefore time.Time, _ int) ([]*entity.Conversion, error) {
+			if !updatedBefore.Before(time.Now().UTC().Add(-time.Minute + time.Second)) {
+				t.Fatalf("expected the grace period to be applied, got %v", updatedBefore)
+			}
+			return []*entity.Conversion{booked, broke, outOfFunds}, nil
+		})
+	ledger.EXPECT().PostConversion(gomock.Any(), booked).Return(nil)
+	ledger.EXPECT().PostConversion(gomock.Any(), broke).Return(errors.New("ledger unavailable"))
+	ledger.EXPECT().PostConversion(gomock.Any(), outOfFunds).Return(domain.ErrInsufficientFunds)
+	conversionRepo.EXPECT().Update(gomock.Any(), booked).Return(nil)
+	conversionRepo.EXPECT().Update(gomock.Any(), outOfFunds).Return(nil)
+
+	settled, err := NewConversionSweepService(baseRepo, ledger, time.Minute, 10).ResumePending(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if settled != 2 {
+		t.Fatalf("expected 2 settled conversions, got %d", settled)
+	}
+	if booked.Status != entity.ConversionStatusCompleted || outOfFunds.Status != entity.ConversionStatusFailed {
+		t.Fatalf("unexpected statuses: %s, %s", booked.Status, outOfFunds.Status)
+	}
+	if broke.Status != entity.ConversionStatusPending {
+		t.Fatalf("expected failed posting to stay pending, got %s", broke.Status)
+	}
+}
@@ -2,10 +2,52 @@ package assembly
 
 import (
 	"context"
+
 	appCtx "wechat-clone/core/context"
+	fxcommand "wechat-clone/core/modules/foreign_exchange/application/command"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	fxservice "wechat-clone/core/modules/foreign_exchange/domain/service"
+	fxledger "wechat-clone/core/modules/foreign_exchange/infra/ledger"
+	fxrepo "wechat-clone/core/modules/foreign_exchange/infra/persistent/repository"
+	fxrates "wechat-clone/core/modules/foreign_exchange/infra/rates"
+	fxserver "wechat-clone/core/modules/foreign_exchange/transport/server"
+	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
 	infrahttp "wechat-clone/core/shared/transport/http"
 )
 
-func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (infrahttp.HTTPServer, error) {
-	return nil, nil
+func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (infrahttp.HTTPServer, error) {
+	cfg := appContext.GetConfig().ForeignExchangeConfig
+	rateProvider, err := fxrates.NewRateProvider(cfg)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	spreads, err := entity.ParseSpreadSchedule(cfg.DefaultSpreadBPS, cfg.PairSpreadsBPS)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	fxRepos := fxrepo.NewRepoImpl(appContext)
+	conversionLedger := buildConversionLedger(appContext)
+	feeQuoter := fxledger.NewFeeQuoter(ledgerassembly.BuildFeeService(appContext))
+	createQuote := cqrs.NewDispatcher(fxcommand.NewCreateQuote(appContext, fxRepos, rateProvider, spreads, feeQuoter))
+	createConversion := cqrs.NewDispatcher(fxcommand.NewCreateConversion(appContext, fxRepos, conversionLedger))
+
+	server, err := fxserver.NewHTTPServer(createQuote, createConversion)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return server, nil
+}
+
+func buildConversionLedger(appContext *appCtx.AppContext) fxservice.ConversionLedger {
+	cfg := appContext.GetConfig()
+	return fxledger.NewConversionLedger(
+		ledgerassembly.BuildService(appContext),
+		cfg.ForeignExchangeConfig.HouseAccountID,
+		cfg.ForeignExchangeConfig.SpreadRevenueAccountID,
+		cfg.LedgerConfig.FeeRevenueAccountID(),
+	)
 }
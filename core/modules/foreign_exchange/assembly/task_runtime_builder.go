@@ -0,0 +1,47 @@
+package assembly
+
+import (
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	fxtask "wechat-clone/core/modules/foreign_exchange/application/scheduler/task"
+	"wechat-clone/core/modules/foreign_exchange/application/scheduler/taskhandler"
+	fxservice "wechat-clone/core/modules/foreign_exchange/application/service"
+	fxrepo "wechat-clone/core/modules/foreign_exchange/infra/persistent/repository"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/stackErr"
+	modruntime "wechat-clone/core/shared/runtime"
+
+	"github.com/hibiken/asynq"
+)
+
+func buildTaskRuntime(cfg *config.Config, appContext *appCtx.AppContext) (modruntime.Module, error) {
+	server, err := newAsynqServer(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	fxCfg := cfg.ForeignExchangeConfig
+	sweepService := fxservice.NewConversionSweepService(
+		fxrepo.NewRepoImpl(appContext),
+		buildConversionLedger(appContext),
+		time.Duration(fxCfg.PendingSweepGraceSeconds)*time.Second,
+		fxCfg.PendingSweepBatchSize,
+	)
+
+	return taskhandler.NewTaskHandler(sweepService, server), nil
+}
+
+func newAsynqServer(appContext *appCtx.AppContext) (*asynq.Server, error) {
+	redisConnOpt, err := newAsynqRedisConnOpt(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return asynq.NewServer(redisConnOpt, asynq.Config{
+		Concurrency: 1,
+		Queues: map[string]int{
+			fxtask.QueueName: 1,
+		},
+	}), nil
+}
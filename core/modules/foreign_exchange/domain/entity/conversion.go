@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ConversionStatus string
+
+func (s ConversionStatus) String() string {
+	return string(s)
+}
+
+const (
+	ConversionStatusPending   ConversionStatus = "pending"
+	ConversionStatusCompleted ConversionStatus = "completed"
+	ConversionStatusFailed    ConversionStatus = "failed"
+)
+
+// Conversion executes one consumed quote against the account's wallet. It is
+// created pending together with the quote consumption and settles once the
+// ledger legs are booked, so a retry with the same idempotency key resumes it.
+type Conversion struct {
+	ID             string
+	AccountID      string
+	QuoteID        string
+	IdempotencyKey string
+	FromCurrency   string
+	ToCurrency     string
+	FromAmount     int64
+	ToAmount       int64
+	CustomerRate   string
+	SpreadAmount   int64
+	FeeAmount      int64
+	Status         ConversionStatus
+	FailureReason  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewConversion(id, idempotencyKey string, quote *Quote, now time.Time) (*Conversion, error) {
+	idempotencyKey = strings.TrimSpace(idempotencyKey)
+	switch {
+	case id == "" || idempotencyKey == "":
+		return nil, stackErr.Error(errors.New("conversion id and idempotency key are required"))
+	case quote == nil || quote.Status != QuoteStatusConsumed:
+		return nil, stackErr.Error(errors.New("conversion requires a consumed quote"))
+	}
+
+	spread, err := quote.RealizedSpread()
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &Conversion{
+		ID:             id,
+		AccountID:      quote.AccountID,
+		QuoteID:        quote.ID,
+		IdempotencyKey: idempotencyKey,
+		FromCurrency:   quote.FromCurrency,
+		ToCurrency:     quote.ToCurrency,
+		FromAmount:     quote.FromAmount,
+		ToAmount:       quote.ToAmount,
+		CustomerRate:   quote.CustomerRate,
+		SpreadAmount:   spread,
+		FeeAmount:      quote.FeeAmount,
+		Status:         ConversionStatusPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+func (c *Conversion) IsPending() bool {
+	return c.Status == ConversionStatusPending
+}
+
+func (c *Conversion) Complete(now time.Time) {
+	c.Status = ConversionStatusCompleted
+	c.UpdatedAt = now
+}
+
+func (c *Conversion) Fail(reason string, now time.Time) {
+	c.Status = ConversionStatusFailed
+	c.FailureReason = reason
+	c.UpdatedAt = now
+}
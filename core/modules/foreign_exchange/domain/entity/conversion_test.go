@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewConversionCopiesConsumedQuote(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	quote := &Quote{
+		ID:           "quote-1",
+		AccountID:    "acc-1",
+		FromCurrency: "USD",
+		ToCurrency:   "VND",
+		FromAmount:   3969,
+		ToAmount:     1000000,
+		MidRate:      "25400",
+		CustomerRate: "25196.8",
+		FeeAmount:    40,
+		Purpose:      QuotePurposeConversion,
+		Status:       QuoteStatusOpen,
+		ExpiresAt:    now.Add(time.Minute),
+	}
+	if _, err := NewConversion("conv-1", "key-1", quote, now); err == nil {
+		t.Fatal("expected an open quote to be rejected")
+	}
+
+	if err := quote.Consume("acc-1", QuotePurposeConversion, now); err != nil {
+		t.Fatalf("Consume() error = %v", err)
+	}
+	conversion, err := NewConversion("conv-1", " key-1 ", quote, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if conversion.IdempotencyKey != "key-1" || conversion.QuoteID != "quote-1" || conversion.AccountID != "acc-1" {
+		t.Fatalf("unexpected conversion identity: %+v", conversion)
+	}
+	if conversion.FromAmount != 3969 || conversion.ToAmount != 1000000 || conversion.SpreadAmount != 8126 || conversion.FeeAmount != 40 {
+		t.Fatalf("unexpected conversion amounts: %+v", conversion)
+	}
+	if !conversion.IsPending() {
+		t.Fatalf("expected pending conversion, got %s", conversion.Status)
+	}
+
+	conversion.Complete(now.Add(time.Second))
+	if conversion.Status != ConversionStatusCompleted || !conversion.UpdatedAt.Equal(now.Add(time.Second)) {
+		t.Fatalf("unexpected completed conversion: %+v", conversion)
+	}
+}
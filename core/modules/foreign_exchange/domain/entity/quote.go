@@ -0,0 +1,199 @@
+package entity
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type QuotePurpose string
+
+func (p QuotePurpose) String() string {
+	return string(p)
+}
+
+const (
+	QuotePurposeConversion QuotePurpose = "conversion"
+	QuotePurposeTransfer   QuotePurpose = "transfer"
+	QuotePurposePayment    QuotePurpose = "payment"
+)
+
+func ParseQuotePurpose(value string) (QuotePurpose, error) {
+	switch purpose := QuotePurpose(strings.ToLower(strings.TrimSpace(value))); purpose {
+	case QuotePurposeConversion, QuotePurposeTransfer, QuotePurposePayment:
+		return purpose, nil
+	default:
+		return "", stackErr.Error(domain.ErrPurposeInvalid)
+	}
+}
+
+type QuoteStatus string
+
+func (s QuoteStatus) String() string {
+	return string(s)
+}
+
+const (
+	QuoteStatusOpen     QuoteStatus = "open"
+	QuoteStatusConsumed QuoteStatus = "consumed"
+)
+
+// Quote locks a customer rate for one amount until ExpiresAt. ToAmount is what
+// the customer receives and FromAmount what they pay, both in minor units.
+// FeeAmount is charged in FromCurrency on top of FromAmount.
+type Quote struct {
+	ID           string
+	AccountID    string
+	FromCurrency string
+	ToCurrency   string
+	FromAmount   int64
+	ToAmount     int64
+	MidRate      string
+	CustomerRate string
+	SpreadBPS    int64
+	FeeAmount    int64
+	RateSource   string
+	Purpose      QuotePurpose
+	Status       QuoteStatus
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	ConsumedAt   *time.Time
+}
+
+type QuoteParams struct {
+	ID        string
+	AccountID string
+	ToAmount  int64
+	Purpose   QuotePurpose
+	Rate      *Rate
+	SpreadBPS int64
+	Now       time.Time
+	TTL       time.Duration
+}
+
+// NewQuote prices a quote from a mid rate. The customer rate is the mid rate
+// less the spread, truncated to RateScale decimals, and FromAmount is rounded
+// up to the next minor unit, so every rounding step favours the house and
+// FromAmount can be recomputed from the stored customer rate alone.
+func NewQuote(params QuoteParams) (*Quote, error) {
+	switch {
+	case params.ID == "" || params.AccountID == "":
+		return nil, stackErr.Error(errors.New("quote id and account id are required"))
+	case params.Rate == nil || params.Rate.Value == nil || params.Rate.Value.Sign() <= 0:
+		return nil, stackErr.Error(domain.ErrRateUnavailable)
+	case params.ToAmount <= 0:
+		return nil, stackErr.Error(domain.ErrAmountInvalid)
+	case !validSpread(params.SpreadBPS):
+		return nil, stackErr.Error(domain.ErrSpreadInvalid)
+	case params.TTL <= 0:
+		return nil, stackErr.Error(errors.New("quote ttl must be greater than 0"))
+	}
+
+	from := finance.NormalizeCurrency(params.Rate.From)
+	to := finance.NormalizeCurrency(params.Rate.To)
+	if err := validateCurrencyPair(from, to); err != nil {
+		return nil, err
+	}
+
+	margin := big.NewRat(maxSpreadBPS-params.SpreadBPS, maxSpreadBPS)
+	customerRate := truncateRate(new(big.Rat).Mul(params.Rate.Value, margin))
+	if customerRate.Sign() <= 0 {
+		return nil, stackErr.Error(domain.ErrRateUnavailable)
+	}
+
+	fromAmount, err := finance.ConvertMinorAmount(params.ToAmount, to, from, new(big.Rat).Inv(customerRate), true)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &Quote{
+		ID:           params.ID,
+		AccountID:    params.AccountID,
+		FromCurrency: from,
+		ToCurrency:   to,
+		FromAmount:   fromAmount,
+		ToAmount:     params.ToAmount,
+		MidRate:      FormatRate(params.Rate.Value),
+		CustomerRate: FormatRate(customerRate),
+		SpreadBPS:    params.SpreadBPS,
+		RateSource:   params.Rate.Source,
+		Purpose:      params.Purpose,
+		Status:       QuoteStatusOpen,
+		CreatedAt:    params.Now,
+		ExpiresAt:    params.Now.Add(params.TTL),
+	}, nil
+}
+
+// ApplyFee records the fee quoted for this conversion so it is booked exactly
+// as shown, even if the fee schedule changes before execution.
+func (q *Quote) ApplyFee(feeAmount int64) error {
+	if feeAmount < 0 {
+		return stackErr.Error(domain.ErrAmountInvalid)
+	}
+	q.FeeAmount = feeAmount
+	return nil
+}
+
+func (q *Quote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+// Consume marks the quote executed. Quotes owned by another account are
+// reported as not found so their ids cannot be probed.
+func (q *Quote) Consume(accountID string, purpose QuotePurpose, now time.Time) error {
+	switch {
+	case q.AccountID != accountID:
+		return stackErr.Error(domain.ErrQuoteNotFound)
+	case q.Status == QuoteStatusConsumed:
+		return stackErr.Error(domain.ErrQuoteConsumed)
+	case q.Purpose != purpose:
+		return stackErr.Error(domain.ErrQuotePurposeInvalid)
+	case q.IsExpired(now):
+		return stackErr.Error(domain.ErrQuoteExpired)
+	}
+
+	consumedAt := now
+	q.Status = QuoteStatusConsumed
+	q.ConsumedAt = &consumedAt
+	return nil
+}
+
+// RealizedSpread is what the house keeps in the target currency: FromAmount
+// valued at the mid rate, rounded down, less the ToAmount paid out.
+func (q *Quote) RealizedSpread() (int64, error) {
+	midRate, ok := ParseRate(q.MidRate)
+	if !ok {
+		return 0, stackErr.Error(domain.ErrRateUnavailable)
+	}
+	atMid, err := finance.ConvertMinorAmount(q.FromAmount, q.FromCurrency, q.ToCurrency, midRate, false)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	if atMid <= q.ToAmount {
+		return 0, nil
+	}
+	return atMid - q.ToAmount, nil
+}
+
+// ValidateCurrencyPair checks both currencies are supported and differ.
+func ValidateCurrencyPair(from, to string) error {
+	return validateCurrencyPair(finance.NormalizeCurrency(from), finance.NormalizeCurrency(to))
+}
+
+func validateCurrencyPair(from, to string) error {
+	if _, err := finance.MinorUnitExponent(from); err != nil {
+		return stackErr.Error(domain.ErrCurrencyUnsupported)
+	}
+	if _, err := finance.MinorUnitExponent(to); err != nil {
+		return stackErr.Error(domain.ErrCurrencyUnsupported)
+	}
+	if from == to {
+		return stackErr.Error(domain.ErrSameCurrency)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+)
+
+func TestNewQuoteAppliesSpreadAndRoundsUp(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	quote, err := NewQuote(QuoteParams{
+		ID:        "quote-1",
+		AccountID: "acc-1",
+		ToAmount:  1000000,
+		Purpose:   QuotePurposeConversion,
+		Rate:      &Rate{From: "usd", To: "vnd", Value: big.NewRat(25400, 1), Source: "static"},
+		SpreadBPS: 80,
+		Now:       now,
+		TTL:       30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if quote.FromCurrency != "USD" || quote.ToCurrency != "VND" {
+		t.Fatalf("unexpected currencies: %s -> %s", quote.FromCurrency, quote.ToCurrency)
+	}
+	if quote.MidRate != "25400" || quote.CustomerRate != "25196.8" {
+		t.Fatalf("unexpected rates: mid=%s customer=%s", quote.MidRate, quote.CustomerRate)
+	}
+	// 1,000,000 VND / 25196.8 = 39.6876... USD, rounded up to the next cent.
+	if quote.FromAmount != 3969 {
+		t.Fatalf("unexpected from amount: %d", quote.FromAmount)
+	}
+	if !quote.ExpiresAt.Equal(now.Add(30*time.Second)) || quote.Status != QuoteStatusOpen {
+		t.Fatalf("unexpected quote state: %+v", quote)
+	}
+}
+
+func TestNewQuoteUsesTruncatedCustomerRate(t *testing.T) {
+	quote, err := NewQuote(QuoteParams{
+		ID:        "quote-1",
+		AccountID: "acc-1",
+		ToAmount:  100,
+		Purpose:   QuotePurposeConversion,
+		Rate:      &Rate{From: "VND", To: "USD", Value: big.NewRat(1, 25400)},
+		Now:       time.Now().UTC(),
+		TTL:       time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if quote.CustomerRate != "0.00003937" {
+		t.Fatalf("unexpected customer rate: %s", quote.CustomerRate)
+	}
+	// 1 USD / 0.00003937 = 25400.05 VND, rounded up to 25401.
+	if quote.FromAmount != 25401 {
+		t.Fatalf("unexpected from amount: %d", quote.FromAmount)
+	}
+}
+
+func TestNewQuoteRejectsInvalidInput(t *testing.T) {
+	base := QuoteParams{
+		ID:        "quote-1",
+		AccountID: "acc-1",
+		ToAmount:  100,
+		Rate:      &Rate{From: "USD", To: "EUR", Value: big.NewRat(92, 100)},
+		Now:       time.Now().UTC(),
+		TTL:       time.Minute,
+	}
+
+	sameCurrency := base
+	sameCurrency.Rate = &Rate{From: "USD", To: "usd", Value: big.NewRat(1, 1)}
+	if _, err := NewQuote(sameCurrency); !errors.Is(err, domain.ErrSameCurrency) {
+		t.Fatalf("expected same currency error, got %v", err)
+	}
+
+	unsupported := base
+	unsupported.Rate = &Rate{From: "USD", To: "XYZ", Value: big.NewRat(1, 1)}
+	if _, err := NewQuote(unsupported); !errors.Is(err, domain.ErrCurrencyUnsupported) {
+		t.Fatalf("expected unsupported currency error, got %v", err)
+	}
+
+	zeroAmount := base
+	zeroAmount.ToAmount = 0
+	if _, err := NewQuote(zeroAmount); !errors.Is(err, domain.ErrAmountInvalid) {
+		t.Fatalf("expected invalid amount error, got %v", err)
+	}
+}
+
+func TestSpreadScheduleAppliesPairInBothDirections(t *testing.T) {
+	schedule, err := ParseSpreadSchedule(50, "usd/vnd:80, EUR/USD:30")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := schedule.SpreadFor("USD", "VND"); got != 80 {
+		t.Fatalf("unexpected USD/VND spread: %d", got)
+	}
+	if got := schedule.SpreadFor("VND", "USD"); got != 80 {
+		t.Fatalf("unexpected VND/USD spread: %d", got)
+	}
+	if got := schedule.SpreadFor("USD", "JPY"); got != 50 {
+		t.Fatalf("unexpected default spread: %d", got)
+	}
+
+	if _, err := ParseSpreadSchedule(50, "USD-VND:80"); !errors.Is(err, domain.ErrSpreadInvalid) {
+		t.Fatalf("expected invalid spread error, got %v", err)
+	}
+}
+
+func TestQuoteConsume(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	newQuote := func() *Quote {
+		return &Quote{
+			ID:        "quote-1",
+			AccountID: "acc-1",
+			Purpose:   QuotePurposeConversion,
+			Status:    QuoteStatusOpen,
+			ExpiresAt: now.Add(30 * time.Second),
+		}
+	}
+
+	quote := newQuote()
+	if err := quote.Consume("acc-1", QuotePurposeConversion, now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if quote.Status != QuoteStatusConsumed || quote.ConsumedAt == nil || !quote.ConsumedAt.Equal(now) {
+		t.Fatalf("unexpected quote state: %+v", quote)
+	}
+	if err := quote.Consume("acc-1", QuotePurposeConversion, now); !errors.Is(err, domain.ErrQuoteConsumed) {
+		t.Fatalf("expected consumed error, got %v", err)
+	}
+
+	if err := newQuote().Consume("acc-2", QuotePurposeConversion, now); !errors.Is(err, domain.ErrQuoteNotFound) {
+		t.Fatalf("expected not found for another account, got %v", err)
+	}
+	if err := newQuote().Consume("acc-1", QuotePurposePayment, now); !errors.Is(err, domain.ErrQuotePurposeInvalid) {
+		t.Fatalf("expected purpose error, got %v", err)
+	}
+	if err := newQuote().Consume("acc-1", QuotePurposeConversion, now.Add(30*time.Second)); !errors.Is(err, domain.ErrQuoteExpired) {
+		t.Fatalf("expected expired error, got %v", err)
+	}
+}
+
+func TestQuoteRealizedSpread(t *testing.T) {
+	quote := &Quote{
+		FromCurrency: "USD",
+		ToCurrency:   "VND",
+		FromAmount:   3969,
+		ToAmount:     1000000,
+		MidRate:      "25400.00000000",
+	}
+
+	// 39.69 USD at 25400 is 1,008,126 VND against 1,000,000 VND paid out.
+	spread, err := quote.RealizedSpread()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if spread != 8126 {
+		t.Fatalf("unexpected spread: %d", spread)
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// RateScale is the number of decimal places rates are quoted and stored with.
+const RateScale = 8
+
+// Rate is a mid-market rate: Value units of To for one unit of From.
+type Rate struct {
+	From   string
+	To     string
+	Value  *big.Rat
+	Source string
+	AsOf   time.Time
+}
+
+// FormatRate renders a rate with RateScale decimals, dropping trailing zeros.
+func FormatRate(rate *big.Rat) string {
+	if rate == nil {
+		return ""
+	}
+	value := rate.FloatString(RateScale)
+	if strings.Contains(value, ".") {
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	}
+	return value
+}
+
+// ParseRate reads a decimal rate such as "25400.5"; it must be positive.
+func ParseRate(value string) (*big.Rat, bool) {
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok || rate.Sign() <= 0 {
+		return nil, false
+	}
+	return rate, true
+}
+
+// truncateRate cuts a rate down to RateScale decimals.
+func truncateRate(rate *big.Rat) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(RateScale), nil)
+	scaled := new(big.Int).Mul(rate.Num(), scale)
+	scaled.Quo(scaled, rate.Denom())
+	return new(big.Rat).SetFrac(scaled, scale)
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const maxSpreadBPS = 10000
+
+// SpreadSchedule is the margin, in basis points, taken from the mid rate for
+// each currency pair. A pair applies in both directions.
+type SpreadSchedule struct {
+	DefaultBPS int64
+	Pairs      map[string]int64
+}
+
+// ParseSpreadSchedule reads pair overrides written as "USD/VND:80,EUR/USD:30".
+func ParseSpreadSchedule(defaultBPS int64, spec string) (SpreadSchedule, error) {
+	schedule := SpreadSchedule{DefaultBPS: defaultBPS, Pairs: map[string]int64{}}
+	if !validSpread(defaultBPS) {
+		return SpreadSchedule{}, stackErr.Error(domain.ErrSpreadInvalid)
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pair, value, ok := strings.Cut(entry, ":")
+		from, to, pairOK := strings.Cut(pair, "/")
+		bps, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if !ok || !pairOK || err != nil || !validSpread(bps) {
+			return SpreadSchedule{}, stackErr.Error(domain.ErrSpreadInvalid)
+		}
+		schedule.Pairs[pairKey(from, to)] = bps
+	}
+	return schedule, nil
+}
+
+func (s SpreadSchedule) SpreadFor(from, to string) int64 {
+	if bps, ok := s.Pairs[pairKey(from, to)]; ok {
+		return bps
+	}
+	if bps, ok := s.Pairs[pairKey(to, from)]; ok {
+		return bps
+	}
+	return s.DefaultBPS
+}
+
+func validSpread(bps int64) bool {
+	return bps >= 0 && bps < maxSpreadBPS
+}
+
+func pairKey(from, to string) string {
+	return finance.NormalizeCurrency(from) + "/" + finance.NormalizeCurrency(to)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+var (
+	ErrCurrencyUnsupported = errors.New("currency is not supported")
+	ErrSameCurrency        = errors.New("from and to currency must differ")
+	ErrAmountInvalid       = errors.New("amount must be greater than 0")
+	ErrPurposeInvalid      = errors.New("quote purpose is invalid")
+	ErrSpreadInvalid       = errors.New("spread is invalid")
+	ErrRateUnavailable     = errors.New("exchange rate is unavailable")
+	ErrQuoteNotFound       = errors.New("quote not found")
+	ErrQuoteExpired        = errors.New("quote has expired")
+	ErrQuoteConsumed       = errors.New("quote has already been executed")
+	ErrQuotePurposeInvalid = errors.New("quote purpose does not allow this operation")
+	ErrIdempotencyKeyUsed  = errors.New("idempotency key was used for another quote")
+	ErrInsufficientFunds   = errors.New("insufficient funds")
+)
@@ -0,0 +1,18 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=conversion_repo_mock.go -source=conversion_repo.go
+type ConversionRepository interface {
+	Create(ctx context.Context, conversion *entity.Conversion) error
+	GetByIdempotencyKey(ctx context.Context, accountID, idempotencyKey string) (*entity.Conversion, error)
+	Update(ctx context.Context, conversion *entity.Conversion) error
+	// ListPending returns up to limit pending conversions last updated before
+	// updatedBefore, oldest first.
+	ListPending(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Conversion, error)
+}
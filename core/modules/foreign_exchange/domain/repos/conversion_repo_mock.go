@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: conversion_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=conversion_repo_mock.go -source=conversion_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	entity "wechat-clone/core/modules/foreign_exchange/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConversionRepository is a mock of ConversionRepository interface.
+type MockConversionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockConversionRepositoryMockRecorder is the mock recorder for MockConversionRepository.
+type MockConversionRepositoryMockRecorder struct {
+	mock *MockConversionRepository
+}
+
+// NewMockConversionRepository creates a new mock instance.
+func NewMockConversionRepository(ctrl *gomock.Controller) *MockConversionRepository {
+	mock := &MockConversionRepository{ctrl: ctrl}
+	mock.recorder = &MockConversionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversionRepository) EXPECT() *MockConversionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockConversionRepository) Create(ctx context.Context, conversion *entity.Conversion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, conversion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockConversionRepositoryMockRecorder) Create(ctx, conversion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConversionRepository)(nil).Create), ctx, conversion)
+}
+
+// GetByIdempotencyKey mocks base method.
+func (m *MockConversionRepository) GetByIdempotencyKey(ctx context.Context, accountID, idempotencyKey string) (*entity.Conversion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdempotencyKey", ctx, accountID, idempotencyKey)
+	ret0, _ := ret[0].(*entity.Conversion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdempotencyKey indicates an expected call of GetByIdempotencyKey.
+func (mr *MockConversionRepositoryMockRecorder) GetByIdempotencyKey(ctx, accountID, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdempotencyKey", reflect.TypeOf((*MockConversionRepository)(nil).GetByIdempotencyKey), ctx, accountID, idempotencyKey)
+}
+
+// ListPending mocks base method.
+func (m *MockConversionRepository) ListPending(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Conversion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, updatedBefore, limit)
+	ret0, _ := ret[0].([]*entity.Conversion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockConversionRepositoryMockRecorder) ListPending(ctx, updatedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockConversionRepository)(nil).ListPending), ctx, updatedBefore, limit)
+}
+
+// Update mocks base method.
+func (m *MockConversionRepository) Update(ctx context.Context, conversion *entity.Conversion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, conversion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockConversionRepositoryMockRecorder) Update(ctx, conversion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockConversionRepository)(nil).Update), ctx, conversion)
+}
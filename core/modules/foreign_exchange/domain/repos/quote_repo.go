@@ -0,0 +1,16 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=quote_repo_mock.go -source=quote_repo.go
+type QuoteRepository interface {
+	Create(ctx context.Context, quote *entity.Quote) error
+	// GetForUpdate locks the quote row for the surrounding transaction and
+	// returns domain.ErrQuoteNotFound when it does not exist.
+	GetForUpdate(ctx context.Context, id string) (*entity.Quote, error)
+	Update(ctx context.Context, quote *entity.Quote) error
+}
@@ -1,4 +1,13 @@
 package repos
 
+import (
+	"context"
+)
+
+//go:generate mockgen -package=repos -destination=repos_mock.go -source=repos.go
 type Repos interface {
+	QuoteRepository() QuoteRepository
+	ConversionRepository() ConversionRepository
+
+	WithTransaction(ctx context.Context, fn func(Repos) error) error
 }
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repos.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=repos_mock.go -source=repos.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepos is a mock of Repos interface.
+type MockRepos struct {
+	ctrl     *gomock.Controller
+	recorder *MockReposMockRecorder
+	isgomock struct{}
+}
+
+// MockReposMockRecorder is the mock recorder for MockRepos.
+type MockReposMockRecorder struct {
+	mock *MockRepos
+}
+
+// NewMockRepos creates a new mock instance.
+func NewMockRepos(ctrl *gomock.Controller) *MockRepos {
+	mock := &MockRepos{ctrl: ctrl}
+	mock.recorder = &MockReposMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepos) EXPECT() *MockReposMockRecorder {
+	return m.recorder
+}
+
+// ConversionRepository mocks base method.
+func (m *MockRepos) ConversionRepository() ConversionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConversionRepository")
+	ret0, _ := ret[0].(ConversionRepository)
+	return ret0
+}
+
+// ConversionRepository indicates an expected call of ConversionRepository.
+func (mr *MockReposMockRecorder) ConversionRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversionRepository", reflect.TypeOf((*MockRepos)(nil).ConversionRepository))
+}
+
+// QuoteRepository mocks base method.
+func (m *MockRepos) QuoteRepository() QuoteRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteRepository")
+	ret0, _ := ret[0].(QuoteRepository)
+	return ret0
+}
+
+// QuoteRepository indicates an expected call of QuoteRepository.
+func (mr *MockReposMockRecorder) QuoteRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteRepository", reflect.TypeOf((*MockRepos)(nil).QuoteRepository))
+}
+
+// WithTransaction mocks base method.
+func (m *MockRepos) WithTransaction(ctx context.Context, fn func(Repos) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockReposMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockRepos)(nil).WithTransaction), ctx, fn)
+}
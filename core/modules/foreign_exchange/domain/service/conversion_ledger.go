@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+//go:generate mockgen -package=service -destination=conversion_ledger_mock.go -source=conversion_ledger.go
+type ConversionLedger interface {
+	// PostConversion books the conversion legs in the wallet ledger. It is
+	// idempotent per conversion and returns domain.ErrInsufficientFunds when
+	// the account cannot cover FromAmount plus FeeAmount.
+	PostConversion(ctx context.Context, conversion *entity.Conversion) error
+}
+
+// SettleConversion books a pending conversion and marks it completed, or
+// failed when the wallet cannot cover it. Any other ledger error is returned
+// and leaves the conversion pending, so a retry or the pending sweep can
+// resume it.
+func SettleConversion(ctx context.Context, ledger ConversionLedger, conversion *entity.Conversion) error {
+	if err := ledger.PostConversion(ctx, conversion); err != nil {
+		if !errors.Is(err, domain.ErrInsufficientFunds) {
+			return stackErr.Error(err)
+		}
+		conversion.Fail(domain.ErrInsufficientFunds.Error(), time.Now().UTC())
+		return nil
+	}
+	conversion.Complete(time.Now().UTC())
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: conversion_ledger.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=conversion_ledger_mock.go -source=conversion_ledger.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/foreign_exchange/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConversionLedger is a mock of ConversionLedger interface.
+type MockConversionLedger struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversionLedgerMockRecorder
+	isgomock struct{}
+}
+
+// MockConversionLedgerMockRecorder is the mock recorder for MockConversionLedger.
+type MockConversionLedgerMockRecorder struct {
+	mock *MockConversionLedger
+}
+
+// NewMockConversionLedger creates a new mock instance.
+func NewMockConversionLedger(ctrl *gomock.Controller) *MockConversionLedger {
+	mock := &MockConversionLedger{ctrl: ctrl}
+	mock.recorder = &MockConversionLedgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversionLedger) EXPECT() *MockConversionLedgerMockRecorder {
+	return m.recorder
+}
+
+// PostConversion mocks base method.
+func (m *MockConversionLedger) PostConversion(ctx context.Context, conversion *entity.Conversion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostConversion", ctx, conversion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostConversion indicates an expected call of PostConversion.
+func (mr *MockConversionLedgerMockRecorder) PostConversion(ctx, conversion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostConversion", reflect.TypeOf((*MockConversionLedger)(nil).PostConversion), ctx, conversion)
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+)
+
+//go:generate mockgen -package=service -destination=fee_quoter_mock.go -source=fee_quoter.go
+type FeeQuoter interface {
+	// QuoteConversionFee prices the fee an account pays in currency on top of
+	// converting amount out of it.
+	QuoteConversionFee(ctx context.Context, accountID, currency string, amount int64) (int64, error)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fee_quoter.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=fee_quoter_mock.go -source=fee_quoter.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeQuoter is a mock of FeeQuoter interface.
+type MockFeeQuoter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeQuoterMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeQuoterMockRecorder is the mock recorder for MockFeeQuoter.
+type MockFeeQuoterMockRecorder struct {
+	mock *MockFeeQuoter
+}
+
+// NewMockFeeQuoter creates a new mock instance.
+func NewMockFeeQuoter(ctrl *gomock.Controller) *MockFeeQuoter {
+	mock := &MockFeeQuoter{ctrl: ctrl}
+	mock.recorder = &MockFeeQuoterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeQuoter) EXPECT() *MockFeeQuoterMockRecorder {
+	return m.recorder
+}
+
+// QuoteConversionFee mocks base method.
+func (m *MockFeeQuoter) QuoteConversionFee(ctx context.Context, accountID, currency string, amount int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteConversionFee", ctx, accountID, currency, amount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteConversionFee indicates an expected call of QuoteConversionFee.
+func (mr *MockFeeQuoterMockRecorder) QuoteConversionFee(ctx, accountID, currency, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteConversionFee", reflect.TypeOf((*MockFeeQuoter)(nil).QuoteConversionFee), ctx, accountID, currency, amount)
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+)
+
+//go:generate mockgen -package=service -destination=rate_provider_mock.go -source=rate_provider.go
+type RateProvider interface {
+	Name() string
+	// GetRate returns the mid rate for one unit of from in to, or
+	// domain.ErrRateUnavailable when the provider cannot price the pair.
+	GetRate(ctx context.Context, from, to string) (*entity.Rate, error)
+}
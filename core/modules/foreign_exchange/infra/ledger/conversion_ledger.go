@@ -0,0 +1,56 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type conversionLedger struct {
+	ledger           ledgerservice.LedgerService
+	houseAccountID   string
+	revenueAccountID string
+	feeAccountID     string
+}
+
+// NewConversionLedger posts conversions through the in-process ledger
+// service, with the FX house on both currency legs, realized spread credited
+// to revenueAccountID and the conversion fee to feeAccountID.
+func NewConversionLedger(ledger ledgerservice.LedgerService, houseAccountID, revenueAccountID, feeAccountID string) service.ConversionLedger {
+	return &conversionLedger{
+		ledger:           ledger,
+		houseAccountID:   houseAccountID,
+		revenueAccountID: revenueAccountID,
+		feeAccountID:     feeAccountID,
+	}
+}
+
+func (l *conversionLedger) PostConversion(ctx context.Context, conversion *entity.Conversion) error {
+	_, err := l.ledger.ConvertCurrency(ctx, ledgerservice.ConvertCurrencyCommand{
+		ConversionID:     conversion.ID,
+		AccountID:        conversion.AccountID,
+		HouseAccountID:   l.houseAccountID,
+		RevenueAccountID: l.revenueAccountID,
+		FeeAccountID:     l.feeAccountID,
+		FromCurrency:     conversion.FromCurrency,
+		FromAmount:       conversion.FromAmount,
+		ToCurrency:       conversion.ToCurrency,
+		ToAmount:         conversion.ToAmount,
+		SpreadAmount:     conversion.SpreadAmount,
+		FeeAmount:        conversion.FeeAmount,
+		BookedAt:         conversion.CreatedAt,
+	})
+	if err != nil {
+		if errors.Is(err, ledgerservice.ErrInsufficientFunds) {
+			return stackErr.Error(fmt.Errorf("%w: %w", domain.ErrInsufficientFunds, err))
+		}
+		return stackErr.Error(err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package ledger
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type feeQuoter struct {
+	fees ledgerservice.LedgerFeeService
+}
+
+// NewFeeQuoter prices conversion fees with the ledger fee schedule for the
+// account's tier.
+func NewFeeQuoter(fees ledgerservice.LedgerFeeService) service.FeeQuoter {
+	return &feeQuoter{fees: fees}
+}
+
+func (q *feeQuoter) QuoteConversionFee(ctx context.Context, accountID, currency string, amount int64) (int64, error) {
+	quote, err := q.fees.QuoteFee(ctx, ledgerservice.QuoteFeeCommand{
+		AccountID: accountID,
+		Operation: finance.FeeOperationConversion,
+		Currency:  currency,
+		Amount:    amount,
+	})
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return quote.FeeAmount, nil
+}
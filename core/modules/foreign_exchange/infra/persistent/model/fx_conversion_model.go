@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type FXConversionModel struct {
+	ID             string    `gorm:"column:id;type:varchar(36);primaryKey"`
+	AccountID      string    `gorm:"column:account_id;type:varchar(36);not null;uniqueIndex:uq_fx_conversions_account_idempotency,priority:1"`
+	QuoteID        string    `gorm:"column:quote_id;type:varchar(36);not null;uniqueIndex:uq_fx_conversions_quote"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;type:varchar(128);not null;uniqueIndex:uq_fx_conversions_account_idempotency,priority:2"`
+	FromCurrency   string    `gorm:"column:from_currency;type:varchar(3);not null"`
+	ToCurrency     string    `gorm:"column:to_currency;type:varchar(3);not null"`
+	FromAmount     int64     `gorm:"column:from_amount;not null"`
+	ToAmount       int64     `gorm:"column:to_amount;not null"`
+	CustomerRate   string    `gorm:"column:customer_rate;type:numeric(24,8);not null"`
+	SpreadAmount   int64     `gorm:"column:spread_amount;not null"`
+	FeeAmount      int64     `gorm:"column:fee_amount;not null;default:0"`
+	Status         string    `gorm:"column:status;type:varchar(20);not null"`
+	FailureReason  string    `gorm:"column:failure_reason;type:varchar(255);not null;default:''"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (FXConversionModel) TableName() string {
+	return "fx_conversions"
+}
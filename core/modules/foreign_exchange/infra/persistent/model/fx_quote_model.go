@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type FXQuoteModel struct {
+	ID           string     `gorm:"column:id;type:varchar(36);primaryKey"`
+	AccountID    string     `gorm:"column:account_id;type:varchar(36);not null;index:idx_fx_quotes_account_created,priority:1"`
+	FromCurrency string     `gorm:"column:from_currency;type:varchar(3);not null"`
+	ToCurrency   string     `gorm:"column:to_currency;type:varchar(3);not null"`
+	FromAmount   int64      `gorm:"column:from_amount;not null"`
+	ToAmount     int64      `gorm:"column:to_amount;not null"`
+	MidRate      string     `gorm:"column:mid_rate;type:numeric(24,8);not null"`
+	CustomerRate string     `gorm:"column:customer_rate;type:numeric(24,8);not null"`
+	SpreadBPS    int64      `gorm:"column:spread_bps;not null"`
+	FeeAmount    int64      `gorm:"column:fee_amount;not null;default:0"`
+	RateSource   string     `gorm:"column:rate_source;type:varchar(32);not null"`
+	Purpose      string     `gorm:"column:purpose;type:varchar(20);not null"`
+	Status       string     `gorm:"column:status;type:varchar(20);not null"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamptz;not null;index:idx_fx_quotes_account_created,priority:2"`
+	ExpiresAt    time.Time  `gorm:"column:expires_at;type:timestamptz;not null"`
+	ConsumedAt   *time.Time `gorm:"column:consumed_at;type:timestamptz"`
+}
+
+func (FXQuoteModel) TableName() string {
+	return "fx_quotes"
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	"wechat-clone/core/modules/foreign_exchange/domain/repos"
+	"wechat-clone/core/modules/foreign_exchange/infra/persistent/model"
+	dbinfra "wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type conversionRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewConversionRepo(db *gorm.DB) repos.ConversionRepository {
+	return &conversionRepoImpl{db: db}
+}
+
+func (r *conversionRepoImpl) Create(ctx context.Context, conversion *entity.Conversion) error {
+	if err := r.db.WithContext(ctx).Create(toConversionModel(conversion)).Error; err != nil {
+		if dbinfra.IsUniqueConstraintError(err) {
+			return stackErr.Error(domain.ErrIdempotencyKeyUsed)
+		}
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *conversionRepoImpl) GetByIdempotencyKey(ctx context.Context, accountID, idempotencyKey string) (*entity.Conversion, error) {
+	var m model.FXConversionModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND idempotency_key = ?", accountID, idempotencyKey).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toConversionEntity(&m), nil
+}
+
+func (r *conversionRepoImpl) Update(ctx context.Context, conversion *entity.Conversion) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&model.FXConversionModel{}).
+		Where("id = ?", conversion.ID).
+		Updates(map[string]interface{}{
+			"status":         conversion.Status.String(),
+			"failure_reason": conversion.FailureReason,
+			"updated_at":     conversion.UpdatedAt,
+		}).Error)
+}
+
+func (r *conversionRepoImpl) ListPending(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.Conversion, error) {
+	var rows []model.FXConversionModel
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND updated_at < ?", entity.ConversionStatusPending.String(), updatedBefore).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	conversions := make([]*entity.Conversion, 0, len(rows))
+	for idx := range rows {
+		conversions = append(conversions, toConversionEntity(&rows[idx]))
+	}
+	return conversions, nil
+}
+
+func toConversionModel(conversion *entity.Conversion) *model.FXConversionModel {
+	return &model.FXConversionModel{
+		ID:             conversion.ID,
+		AccountID:      conversion.AccountID,
+		QuoteID:        conversion.QuoteID,
+		IdempotencyKey: conversion.IdempotencyKey,
+		FromCurrency:   conversion.FromCurrency,
+		ToCurrency:     conversion.ToCurrency,
+		FromAmount:     conversion.FromAmount,
+		ToAmount:       conversion.ToAmount,
+		CustomerRate:   conversion.CustomerRate,
+		SpreadAmount:   conversion.SpreadAmount,
+		FeeAmount:      conversion.FeeAmount,
+		Status:         conversion.Status.String(),
+		FailureReason:  conversion.FailureReason,
+		CreatedAt:      conversion.CreatedAt,
+		UpdatedAt:      conversion.UpdatedAt,
+	}
+}
+
+func toConversionEntity(m *model.FXConversionModel) *entity.Conversion {
+	return &entity.Conversion{
+		ID:             m.ID,
+		AccountID:      m.AccountID,
+		QuoteID:        m.QuoteID,
+		IdempotencyKey: m.IdempotencyKey,
+		FromCurrency:   m.FromCurrency,
+		ToCurrency:     m.ToCurrency,
+		FromAmount:     m.FromAmount,
+		ToAmount:       m.ToAmount,
+		CustomerRate:   m.CustomerRate,
+		SpreadAmount:   m.SpreadAmount,
+		FeeAmount:      m.FeeAmount,
+		Status:         entity.ConversionStatus(m.Status),
+		FailureReason:  m.FailureReason,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	"wechat-clone/core/modules/foreign_exchange/domain/repos"
+	"wechat-clone/core/modules/foreign_exchange/infra/persistent/model"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type quoteRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewQuoteRepo(db *gorm.DB) repos.QuoteRepository {
+	return &quoteRepoImpl{db: db}
+}
+
+func (r *quoteRepoImpl) Create(ctx context.Context, quote *entity.Quote) error {
+	return stackErr.Error(r.db.WithContext(ctx).Create(toQuoteModel(quote)).Error)
+}
+
+func (r *quoteRepoImpl) GetForUpdate(ctx context.Context, id string) (*entity.Quote, error) {
+	var m model.FXQuoteModel
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(domain.ErrQuoteNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toQuoteEntity(&m), nil
+}
+
+func (r *quoteRepoImpl) Update(ctx context.Context, quote *entity.Quote) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&model.FXQuoteModel{}).
+		Where("id = ?", quote.ID).
+		Updates(map[string]interface{}{
+			"status":      quote.Status.String(),
+			"consumed_at": quote.ConsumedAt,
+		}).Error)
+}
+
+func toQuoteModel(quote *entity.Quote) *model.FXQuoteModel {
+	return &model.FXQuoteModel{
+		ID:           quote.ID,
+		AccountID:    quote.AccountID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		FromAmount:   quote.FromAmount,
+		ToAmount:     quote.ToAmount,
+		MidRate:      quote.MidRate,
+		CustomerRate: quote.CustomerRate,
+		SpreadBPS:    quote.SpreadBPS,
+		FeeAmount:    quote.FeeAmount,
+		RateSource:   quote.RateSource,
+		Purpose:      quote.Purpose.String(),
+		Status:       quote.Status.String(),
+		CreatedAt:    quote.CreatedAt,
+		ExpiresAt:    quote.ExpiresAt,
+		ConsumedAt:   quote.ConsumedAt,
+	}
+}
+
+func toQuoteEntity(m *model.FXQuoteModel) *entity.Quote {
+	return &entity.Quote{
+		ID:           m.ID,
+		AccountID:    m.AccountID,
+		FromCurrency: m.FromCurrency,
+		ToCurrency:   m.ToCurrency,
+		FromAmount:   m.FromAmount,
+		ToAmount:     m.ToAmount,
+		MidRate:      m.MidRate,
+		CustomerRate: m.CustomerRate,
+		SpreadBPS:    m.SpreadBPS,
+		FeeAmount:    m.FeeAmount,
+		RateSource:   m.RateSource,
+		Purpose:      entity.QuotePurpose(m.Purpose),
+		Status:       entity.QuoteStatus(m.Status),
+		CreatedAt:    m.CreatedAt,
+		ExpiresAt:    m.ExpiresAt,
+		ConsumedAt:   m.ConsumedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package rates
+
+import (
+	"fmt"
+	"strings"
+
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// NewRateProvider builds the provider named in config. The static provider
+// reads FX_RATES_FILE when it is set and the built-in dev table otherwise.
+func NewRateProvider(cfg config.ForeignExchangeConfig) (service.RateProvider, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.RateProvider)) {
+	case "", StaticProviderName:
+		if strings.TrimSpace(cfg.RatesFile) != "" {
+			return NewFileRateProvider(cfg.RatesFile)
+		}
+		return NewStaticRateProvider(DefaultStaticRates)
+	default:
+		return nil, stackErr.Error(fmt.Errorf("unknown fx rate provider %q", cfg.RateProvider))
+	}
+}
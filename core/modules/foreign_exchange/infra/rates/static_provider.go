@@ -0,0 +1,113 @@
+package rates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/foreign_exchange/domain"
+	"wechat-clone/core/modules/foreign_exchange/domain/entity"
+	"wechat-clone/core/modules/foreign_exchange/domain/service"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	StaticProviderName = "static"
+	pivotCurrency      = "USD"
+)
+
+// DefaultStaticRates is the dev rate table used when no rates file is set.
+var DefaultStaticRates = map[string]string{
+	"USD/VND": "25400",
+	"USD/EUR": "0.92",
+	"USD/GBP": "0.79",
+	"USD/CNY": "7.24",
+	"USD/JPY": "151.5",
+	"USD/KRW": "1370",
+	"USD/SGD": "1.35",
+	"USD/THB": "36.6",
+}
+
+// StaticRateProvider serves fixed mid rates keyed "FROM/TO". A pair missing in
+// one direction is served as the inverse of the other, and a pair missing in
+// both is crossed through USD.
+type StaticRateProvider struct {
+	rates map[string]*big.Rat
+	asOf  time.Time
+}
+
+func NewStaticRateProvider(table map[string]string) (*StaticRateProvider, error) {
+	rates := make(map[string]*big.Rat, len(table))
+	for pair, value := range table {
+		from, to, ok := strings.Cut(pair, "/")
+		rate, rateOK := entity.ParseRate(value)
+		if !ok || !rateOK {
+			return nil, stackErr.Error(fmt.Errorf("invalid static rate %q=%q", pair, value))
+		}
+		rates[rateKey(from, to)] = rate
+	}
+	return &StaticRateProvider{rates: rates, asOf: time.Now().UTC()}, nil
+}
+
+// NewFileRateProvider loads a JSON object of "FROM/TO": "rate" entries.
+func NewFileRateProvider(path string) (*StaticRateProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	var table map[string]string
+	if err := json.Unmarshal(content, &table); err != nil {
+		return nil, stackErr.Error(fmt.Errorf("parse rates file %s: %w", path, err))
+	}
+	return NewStaticRateProvider(table)
+}
+
+var _ service.RateProvider = (*StaticRateProvider)(nil)
+
+func (p *StaticRateProvider) Name() string {
+	return StaticProviderName
+}
+
+func (p *StaticRateProvider) GetRate(_ context.Context, from, to string) (*entity.Rate, error) {
+	from = finance.NormalizeCurrency(from)
+	to = finance.NormalizeCurrency(to)
+
+	value, ok := p.lookup(from, to)
+	if !ok && from != pivotCurrency && to != pivotCurrency {
+		fromPivot, fromOK := p.lookup(from, pivotCurrency)
+		pivotTo, toOK := p.lookup(pivotCurrency, to)
+		if fromOK && toOK {
+			value, ok = new(big.Rat).Mul(fromPivot, pivotTo), true
+		}
+	}
+	if !ok {
+		return nil, stackErr.Error(domain.ErrRateUnavailable)
+	}
+
+	return &entity.Rate{
+		From:   from,
+		To:     to,
+		Value:  value,
+		Source: p.Name(),
+		AsOf:   p.asOf,
+	}, nil
+}
+
+func (p *StaticRateProvider) lookup(from, to string) (*big.Rat, bool) {
+	if rate, ok := p.rates[rateKey(from, to)]; ok {
+		return new(big.Rat).Set(rate), true
+	}
+	if rate, ok := p.rates[rateKey(to, from)]; ok {
+		return new(big.Rat).Inv(rate), true
+	}
+	return nil, false
+}
+
+func rateKey(from, to string) string {
+	return finance.NormalizeCurrency(from) + "/" + finance.NormalizeCurrency(to)
+}
@@ -0,0 +1,53 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/foreign_exchange/application/dto/in"
+	"wechat-clone/core/modules/foreign_exchange/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type createConversionHandler struct {
+	createConversion cqrs.Dispatcher[*in.CreateConversionRequest, *out.CreateConversionResponse]
+}
+
+func NewCreateConversionHandler(
+	createConversion cqrs.Dispatcher[*in.CreateConversionRequest, *out.CreateConversionResponse],
+) *createConversionHandler {
+	return &createConversionHandler{
+		createConversion: createConversion,
+	}
+}
+
+func (h *createConversionHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.CreateConversionRequest
+	request.IdempotencyKey = c.GetHeader("Idempotency-Key")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.createConversion.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("CreateConversion failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	c.JSON(201, result)
+	return nil, nil
+}
@@ -47,5 +47,6 @@ func (h *createQuoteHandler) Handle(c *gin.Context) (interface{}, error) {
 		logger.Errorw("CreateQuote failed", zap.Error(err))
 		return nil, stackErr.Error(err)
 	}
-	return result, nil
+	c.JSON(201, result)
+	return nil, nil
 }
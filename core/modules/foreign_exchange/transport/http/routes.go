@@ -11,10 +11,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterPublicRoutes(
+func RegisterPublicRoutes(_ *gin.RouterGroup) {}
+func RegisterPrivateRoutes(
 	routes *gin.RouterGroup,
 	createQuote cqrs.Dispatcher[*in.CreateQuoteRequest, *out.CreateQuoteResponse],
+	createConversion cqrs.Dispatcher[*in.CreateConversionRequest, *out.CreateConversionResponse],
 ) {
 	routes.POST("/fx/quotes", httpx.Wrap(handler.NewCreateQuoteHandler(createQuote)))
+	routes.POST("/fx/conversions", httpx.Wrap(handler.NewCreateConversionHandler(createConversion)))
 }
-func RegisterPrivateRoutes(_ *gin.RouterGroup) {}
@@ -14,23 +14,26 @@ import (
 )
 
 type foreign_exchangeHTTPServer struct {
-	createQuote cqrs.Dispatcher[*in.CreateQuoteRequest, *out.CreateQuoteResponse]
+	createQuote      cqrs.Dispatcher[*in.CreateQuoteRequest, *out.CreateQuoteResponse]
+	createConversion cqrs.Dispatcher[*in.CreateConversionRequest, *out.CreateConversionResponse]
 }
 
 func NewHTTPServer(
 	createQuote cqrs.Dispatcher[*in.CreateQuoteRequest, *out.CreateQuoteResponse],
+	createConversion cqrs.Dispatcher[*in.CreateConversionRequest, *out.CreateConversionResponse],
 ) (infrahttp.HTTPServer, error) {
 	return &foreign_exchangeHTTPServer{
-		createQuote: createQuote,
+		createQuote:      createQuote,
+		createConversion: createConversion,
 	}, nil
 }
 
 func (s *foreign_exchangeHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
-	foreign_exchangehttp.RegisterPublicRoutes(routes, s.createQuote)
+	foreign_exchangehttp.RegisterPublicRoutes(routes)
 }
 
 func (s *foreign_exchangeHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	foreign_exchangehttp.RegisterPrivateRoutes(routes)
+	foreign_exchangehttp.RegisterPrivateRoutes(routes, s.createQuote, s.createConversion)
 }
 
 func (s *foreign_exchangeHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
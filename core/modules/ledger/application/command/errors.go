@@ -0,0 +1,46 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/ledger/domain/entity"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrUnauthorized    = apperr.New("ledger.unauthorized", "unauthorized", http.StatusUnauthorized)
+	ErrAdminRequired   = apperr.New("ledger.admin_required", "admin role is required", http.StatusForbidden)
+	ErrFeeQuoteChanged = apperr.New("ledger.fee_quote_changed", "fee quote changed, request a new quote", http.StatusConflict)
+)
+
+// mapFeeError reports a fee that no longer matches the caller's quote as a
+// conflict so clients know to re-quote.
+func mapFeeError(err error) error {
+	if errors.Is(err, finance.ErrFeeQuoteChanged) {
+		return ErrFeeQuoteChanged
+	}
+	return err
+}
+
+// mapLimitError gives limit rejections their own error codes and leaves every
+// other error untouched.
+func mapLimitError(err error) error {
+	if !errors.Is(err, ledgerservice.ErrLimitExceeded) {
+		return err
+	}
+	switch {
+	case errors.Is(err, entity.ErrLimitSingleExceeded):
+		return apperr.New("ledger.limit_single_exceeded", err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrLimitDailyExceeded):
+		return apperr.New("ledger.limit_daily_exceeded", err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrLimitMonthlyExceeded):
+		return apperr.New("ledger.limit_monthly_exceeded", err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrLimitVelocityExceeded):
+		return apperr.New("ledger.limit_velocity_exceeded", err.Error(), http.StatusTooManyRequests)
+	default:
+		return apperr.New("ledger.limit_exceeded", err.Error(), http.StatusUnprocessableEntity)
+	}
+}
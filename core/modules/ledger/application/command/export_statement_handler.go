@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"wechat-clone/core/modules/ledger/application/dto/in"
+	"wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type exportStatementHandler struct {
+	exportService ledgerservice.LedgerStatementExportService
+}
+
+func NewExportStatement(
+	exportService ledgerservice.LedgerStatementExportService,
+) cqrs.Handler[*in.ExportStatementRequest, *out.LedgerStatementExportResponse] {
+	return &exportStatementHandler{exportService: exportService}
+}
+
+func (u *exportStatementHandler) Handle(ctx context.Context, req *in.ExportStatementRequest) (*out.LedgerStatementExportResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ledgerservice.ErrUnauthorized, err))
+	}
+
+	from, to, err := ledgerservice.ParseStatementRange(req.From, req.To)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return u.exportService.ExportStatement(ctx, ledgerservice.ExportStatementCommand{
+		AccountID: accountID,
+		Currency:  req.Currency,
+		From:      from,
+		To:        to,
+		Format:    req.Format,
+	})
+}
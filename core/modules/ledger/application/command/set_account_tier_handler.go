@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/ledger/application/dto/in"
+	"wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/apperr"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type setAccountTierHandler struct {
+	limitService ledgerservice.LedgerLimitService
+	admins       accountsupport.AdminRoles
+}
+
+func NewSetAccountTier(limitService ledgerservice.LedgerLimitService, admins accountsupport.AdminRoles) cqrs.Handler[*in.SetAccountTierRequest, *out.AccountLimitsResponse] {
+	return &setAccountTierHandler{limitService: limitService, admins: admins}
+}
+
+func (u *setAccountTierHandler) Handle(ctx context.Context, req *in.SetAccountTierRequest) (*out.AccountLimitsResponse, error) {
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	res, err := u.limitService.SetAccountTier(ctx, req.AccountID, req.Tier)
+	if err != nil {
+		if errors.Is(err, ledgerservice.ErrValidation) {
+			return nil, stackErr.Error(apperr.New("ledger.validation", err.Error(), http.StatusBadRequest))
+		}
+		return nil, stackErr.Error(err)
+	}
+	return res, nil
+}
@@ -20,23 +20,21 @@ import (
 
 type transferTransactionHandler struct {
 	ledgerService ledgerservice.LedgerService
+	feeService    ledgerservice.LedgerFeeService
 	locker        lock.Lock
-	feePolicy     finance.StripeFeePolicy
 	feeAccountID  string
 }
 
 func NewTransferTransaction(
 	appCtx *appCtx.AppContext,
 	ledgerService ledgerservice.LedgerService,
+	feeService ledgerservice.LedgerFeeService,
 ) cqrs.Handler[*in.TransferTransactionRequest, *out.TransactionTransactionResponse] {
 	return &transferTransactionHandler{
 		ledgerService: ledgerService,
+		feeService:    feeService,
 		locker:        appCtx.Locker(),
-		feePolicy: finance.StripeFeePolicy{
-			RateBPS:    appCtx.GetConfig().LedgerConfig.Stripe.FeeRateBPS,
-			FlatAmount: appCtx.GetConfig().LedgerConfig.Stripe.FeeFlatAmount,
-		},
-		feeAccountID: appCtx.GetConfig().LedgerConfig.Stripe.FeeAccountID,
+		feeAccountID:  appCtx.GetConfig().LedgerConfig.FeeRevenueAccountID(),
 	}
 }
 
@@ -47,9 +45,17 @@ func (u *transferTransactionHandler) Handle(ctx context.Context, req *in.Transfe
 	}
 
 	transferFn := func() (*out.TransactionTransactionResponse, error) {
-		feeAmount, err := u.feePolicy.Compute(req.Amount)
+		quote, err := u.feeService.QuoteFee(ctx, ledgerservice.QuoteFeeCommand{
+			AccountID: fromAccountID,
+			Operation: finance.FeeOperationTransfer,
+			Currency:  req.Currency,
+			Amount:    req.Amount,
+		})
 		if err != nil {
-			return nil, stackErr.Error(fmt.Errorf("%w: %w", ledgerservice.ErrValidation, err))
+			return nil, stackErr.Error(err)
+		}
+		if err := finance.CheckQuotedFee(req.QuotedFeeAmount, quote.FeeAmount); err != nil {
+			return nil, stackErr.Error(mapFeeError(err))
 		}
 		transaction, err := u.ledgerService.TransferToAccount(ctx, ledgerservice.TransferToAccountCommand{
 			TransactionID: uuid.NewString(),
@@ -57,11 +63,11 @@ func (u *transferTransactionHandler) Handle(ctx context.Context, req *in.Transfe
 			ToAccountID:   req.ToAccountID,
 			Currency:      req.Currency,
 			Amount:        req.Amount,
-			FeeAmount:     feeAmount,
+			FeeAmount:     quote.FeeAmount,
 			FeeAccountID:  u.feeAccountID,
 		})
 		if err != nil {
-			return nil, stackErr.Error(err)
+			return nil, stackErr.Error(mapLimitError(err))
 		}
 
 		responseEntries := make([]out.LedgerEntryResponse, 0, len(transaction.Entries))
@@ -9,6 +9,7 @@ import (
 	"wechat-clone/core/modules/ledger/application/dto/in"
 	"wechat-clone/core/modules/ledger/application/service"
 	"wechat-clone/core/modules/ledger/domain/entity"
+	"wechat-clone/core/shared/finance"
 	"wechat-clone/core/shared/infra/lock"
 	"wechat-clone/core/shared/pkg/actorctx"
 
@@ -20,10 +21,12 @@ func TestTransferTransactionHandle(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
 		ledgerService := service.NewMockLedgerService(ctrl)
+		feeService := service.NewMockLedgerFeeService(ctrl)
 		locker := lock.NewMockLock(ctrl)
 
 		handler := &transferTransactionHandler{
 			ledgerService: ledgerService,
+			feeService:    feeService,
 			locker:        locker,
 		}
 
@@ -80,6 +83,9 @@ func TestTransferTransactionHandle(t *testing.T) {
 				return true, nil
 			})
 
+		feeService.EXPECT().
+			QuoteFee(gomock.Any(), service.QuoteFeeCommand{AccountID: "acc-z", Operation: finance.FeeOperationTransfer, Currency: "VND", Amount: 100}).
+			Return(finance.FeeQuote{FeeAmount: 5}, nil)
 		ledgerService.EXPECT().
 			TransferToAccount(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, cmd service.TransferToAccountCommand) (*entity.LedgerTransaction, error) {
@@ -116,6 +122,9 @@ func TestTransferTransactionHandle(t *testing.T) {
 		if capturedCommand.Amount != 100 {
 			t.Fatalf("expected amount 100, got %d", capturedCommand.Amount)
 		}
+		if capturedCommand.FeeAmount != 5 {
+			t.Fatalf("expected quoted fee 5, got %d", capturedCommand.FeeAmount)
+		}
 		if capturedCommand.TransactionID == "" {
 			t.Fatalf("expected generated transaction id")
 		}
@@ -144,10 +153,13 @@ func TestTransferTransactionHandle(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
 		ledgerService := service.NewMockLedgerService(ctrl)
+		feeService := service.NewMockLedgerFeeService(ctrl)
 		handler := &transferTransactionHandler{
 			ledgerService: ledgerService,
+			feeService:    feeService,
 		}
 
+		feeService.EXPECT().QuoteFee(gomock.Any(), gomock.Any()).Return(finance.FeeQuote{}, nil)
 		ledgerService.EXPECT().
 			TransferToAccount(gomock.Any(), gomock.Any()).
 			Return(nil, service.ErrInsufficientFunds)
@@ -164,6 +176,32 @@ func TestTransferTransactionHandle(t *testing.T) {
 	})
 }
 
+func TestTransferTransactionRejectsChangedFeeQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	ledgerService := service.NewMockLedgerService(ctrl)
+	feeService := service.NewMockLedgerFeeService(ctrl)
+	handler := &transferTransactionHandler{
+		ledgerService: ledgerService,
+		feeService:    feeService,
+	}
+
+	feeService.EXPECT().QuoteFee(gomock.Any(), gomock.Any()).Return(finance.FeeQuote{FeeAmount: 30}, nil)
+	ledgerService.EXPECT().TransferToAccount(gomock.Any(), gomock.Any()).Times(0)
+
+	quoted := int64(20)
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-from"})
+	_, err := handler.Handle(ctx, &in.TransferTransactionRequest{
+		ToAccountID:     "acc-to",
+		Currency:        "VND",
+		Amount:          1000,
+		QuotedFeeAmount: &quoted,
+	})
+	if !errors.Is(err, ErrFeeQuoteChanged) {
+		t.Fatalf("expected fee quote changed error, got %v", err)
+	}
+}
+
 func stringSlicesEqual(left []string, right []string) bool {
 	if len(left) != len(right) {
 		return false
@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type AdminAuditRequest struct {
+}
+
+func (r *AdminAuditRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,40 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ExportStatementRequest struct {
+	Currency string `json:"currency" form:"currency" binding:"required"`
+	From     string `json:"from" form:"from" binding:"required"`
+	To       string `json:"to" form:"to" binding:"required"`
+	Format   string `json:"format" form:"format" binding:"required"`
+}
+
+func (r *ExportStatementRequest) Normalize() {
+	r.Currency = strings.TrimSpace(r.Currency)
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+	r.Format = strings.TrimSpace(r.Format)
+}
+
+func (r *ExportStatementRequest) Validate() error {
+	r.Normalize()
+	if r.Currency == "" {
+		return stackErr.Error(errors.New("currency is required"))
+	}
+	if r.From == "" {
+		return stackErr.Error(errors.New("from is required"))
+	}
+	if r.To == "" {
+		return stackErr.Error(errors.New("to is required"))
+	}
+	if r.Format == "" {
+		return stackErr.Error(errors.New("format is required"))
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type GetLimitsRequest struct {
+}
+
+func (r *GetLimitsRequest) Validate() error {
+	return nil
+}
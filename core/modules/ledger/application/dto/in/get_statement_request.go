@@ -0,0 +1,35 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetStatementRequest struct {
+	Currency string `json:"currency" form:"currency" binding:"required"`
+	From     string `json:"from" form:"from" binding:"required"`
+	To       string `json:"to" form:"to" binding:"required"`
+}
+
+func (r *GetStatementRequest) Normalize() {
+	r.Currency = strings.TrimSpace(r.Currency)
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+}
+
+func (r *GetStatementRequest) Validate() error {
+	r.Normalize()
+	if r.Currency == "" {
+		return stackErr.Error(errors.New("currency is required"))
+	}
+	if r.From == "" {
+		return stackErr.Error(errors.New("from is required"))
+	}
+	if r.To == "" {
+		return stackErr.Error(errors.New("to is required"))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type QuoteFeeRequest struct {
+	Operation string `json:"operation" form:"operation" binding:"required"`
+	Provider  string `json:"provider" form:"provider"`
+	Currency  string `json:"currency" form:"currency" binding:"required"`
+	Amount    int64  `json:"amount" form:"amount" binding:"required"`
+}
+
+func (r *QuoteFeeRequest) Normalize() {
+	r.Operation = strings.TrimSpace(r.Operation)
+	r.Provider = strings.TrimSpace(r.Provider)
+	r.Currency = strings.TrimSpace(r.Currency)
+}
+
+func (r *QuoteFeeRequest) Validate() error {
+	r.Normalize()
+	if r.Operation == "" {
+		return stackErr.Error(errors.New("operation is required"))
+	}
+	if r.Currency == "" {
+		return stackErr.Error(errors.New("currency is required"))
+	}
+	if r.Amount == 0 {
+		return stackErr.Error(errors.New("amount is required"))
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SetAccountTierRequest struct {
+	AccountID string `json:"account_id" form:"account_id" binding:"required"`
+	Tier      string `json:"tier" form:"tier" binding:"required"`
+}
+
+func (r *SetAccountTierRequest) Normalize() {
+	r.AccountID = strings.TrimSpace(r.AccountID)
+	r.Tier = strings.TrimSpace(r.Tier)
+}
+
+func (r *SetAccountTierRequest) Validate() error {
+	r.Normalize()
+	if r.AccountID == "" {
+		return stackErr.Error(errors.New("account_id is required"))
+	}
+	if r.Tier == "" {
+		return stackErr.Error(errors.New("tier is required"))
+	}
+	return nil
+}
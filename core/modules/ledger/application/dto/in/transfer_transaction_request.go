@@ -9,10 +9,11 @@ import (
 )
 
 type TransferTransactionRequest struct {
-	ToAccountID string            `json:"to_account_id" form:"to_account_id" binding:"required"`
-	Currency    string            `json:"currency" form:"currency" binding:"required"`
-	Amount      int64             `json:"amount" form:"amount" binding:"required"`
-	Metadata    map[string]string `json:"metadata" form:"metadata"`
+	ToAccountID     string            `json:"to_account_id" form:"to_account_id" binding:"required"`
+	Currency        string            `json:"currency" form:"currency" binding:"required"`
+	Amount          int64             `json:"amount" form:"amount" binding:"required"`
+	QuotedFeeAmount *int64            `json:"quoted_fee_amount" form:"quoted_fee_amount"`
+	Metadata        map[string]string `json:"metadata" form:"metadata"`
 }
 
 func (r *TransferTransactionRequest) Normalize() {
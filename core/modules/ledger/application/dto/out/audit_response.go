@@ -0,0 +1,44 @@
+package out
+
+import "time"
+
+// LedgerAuditDriftResponse describes one disagreement found by the audit.
+// Expected is the value derived from replaying the event store; Actual is the
+// value read from the snapshot, projection or version table.
+type LedgerAuditDriftResponse struct {
+	Kind          string `json:"kind"`
+	AccountID     string `json:"account_id"`
+	TransactionID string `json:"transaction_id"`
+	Currency      string `json:"currency"`
+	Expected      int64  `json:"expected"`
+	Actual        int64  `json:"actual"`
+	Detail        string `json:"detail"`
+}
+
+type LedgerTrialBalanceAccountResponse struct {
+	AccountID string `json:"account_id"`
+	Balance   int64  `json:"balance"`
+}
+
+// LedgerTrialBalanceResponse lists every system account balance of one
+// currency and sums user accounts into a single line. Debit is reported as a
+// positive amount; Net must be zero for a balanced ledger.
+type LedgerTrialBalanceResponse struct {
+	Currency           string                              `json:"currency"`
+	SystemAccounts     []LedgerTrialBalanceAccountResponse `json:"system_accounts"`
+	UserAccountCount   int                                 `json:"user_account_count"`
+	UserAccountBalance int64                               `json:"user_account_balance"`
+	Debit              int64                               `json:"debit"`
+	Credit             int64                               `json:"credit"`
+	Net                int64                               `json:"net"`
+}
+
+type LedgerAuditReportResponse struct {
+	GeneratedAt         time.Time                    `json:"generated_at"`
+	Healthy             bool                         `json:"healthy"`
+	AccountsChecked     int                          `json:"accounts_checked"`
+	EventsReplayed      int                          `json:"events_replayed"`
+	TransactionsChecked int                          `json:"transactions_checked"`
+	Drifts              []LedgerAuditDriftResponse   `json:"drifts"`
+	TrialBalance        []LedgerTrialBalanceResponse `json:"trial_balance"`
+}
@@ -0,0 +1,16 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type FeeQuoteResponse struct {
+	Operation   string `json:"operation,omitempty"`
+	Provider    string `json:"provider,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	Tier        string `json:"tier,omitempty"`
+	Amount      int64  `json:"amount,omitempty"`
+	FeeAmount   int64  `json:"fee_amount,omitempty"`
+	TotalAmount int64  `json:"total_amount,omitempty"`
+	RateBps     int64  `json:"rate_bps,omitempty"`
+	FlatAmount  int64  `json:"flat_amount,omitempty"`
+	Promotional bool   `json:"promotional,omitempty"`
+	QuotedAt    string `json:"quoted_at,omitempty"`
+}
@@ -0,0 +1,28 @@
+package out
+
+// AccountLimitResponse is one operation and currency cap of the account's
+// tier with what was used in the current UTC day and month. A zero cap is
+// unlimited.
+type AccountLimitResponse struct {
+	Operation     string `json:"operation"`
+	Currency      string `json:"currency"`
+	Single        int64  `json:"single"`
+	Daily         int64  `json:"daily"`
+	Monthly       int64  `json:"monthly"`
+	UsedToday     int64  `json:"used_today"`
+	UsedThisMonth int64  `json:"used_this_month"`
+}
+
+type AccountVelocityResponse struct {
+	Operation     string `json:"operation"`
+	MaxCount      int    `json:"max_count"`
+	WindowSeconds int64  `json:"window_seconds"`
+	RecentCount   int    `json:"recent_count"`
+}
+
+type AccountLimitsResponse struct {
+	AccountID string                    `json:"account_id"`
+	Tier      string                    `json:"tier"`
+	Limits    []AccountLimitResponse    `json:"limits"`
+	Velocity  []AccountVelocityResponse `json:"velocity"`
+}
@@ -0,0 +1,41 @@
+package out
+
+import "time"
+
+type LedgerStatementEntryResponse struct {
+	ID             int64     `json:"id"`
+	TransactionID  string    `json:"transaction_id"`
+	ReferenceType  string    `json:"reference_type"`
+	ReferenceID    string    `json:"reference_id"`
+	Amount         int64     `json:"amount"`
+	RunningBalance int64     `json:"running_balance"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// LedgerStatementTotalResponse sums a statement's entries of one reference
+// type. Debit is reported as a positive amount.
+type LedgerStatementTotalResponse struct {
+	ReferenceType string `json:"reference_type"`
+	Credit        int64  `json:"credit"`
+	Debit         int64  `json:"debit"`
+	Net           int64  `json:"net"`
+	Count         int    `json:"count"`
+}
+
+type LedgerStatementResponse struct {
+	AccountID      string                         `json:"account_id"`
+	Currency       string                         `json:"currency"`
+	From           time.Time                      `json:"from"`
+	To             time.Time                      `json:"to"`
+	OpeningBalance int64                          `json:"opening_balance"`
+	ClosingBalance int64                          `json:"closing_balance"`
+	Entries        []LedgerStatementEntryResponse `json:"entries"`
+	Totals         []LedgerStatementTotalResponse `json:"totals"`
+}
+
+type LedgerStatementExportResponse struct {
+	Format      string    `json:"format"`
+	ObjectKey   string    `json:"object_key"`
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
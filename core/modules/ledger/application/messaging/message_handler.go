@@ -41,7 +41,7 @@ func NewMessageHandler(
 		consumer:      make([]infraMessaging.Consumer, 0, 1),
 		ledgerService: ledgerSvc,
 		locker:        appCtx.Locker(),
-		feeAccountID:  strings.TrimSpace(cfg.LedgerConfig.Stripe.FeeAccountID),
+		feeAccountID:  cfg.LedgerConfig.FeeRevenueAccountID(),
 	}
 
 	topic := strings.TrimSpace(cfg.KafkaConfig.KafkaLedgerConsumer.PaymentOutboxTopic)
@@ -74,14 +74,17 @@ func (h *messageHandler) handlePaymentOutboxEvent(ctx context.Context, value []b
 			return stackErr.Error(decodeErr)
 		}
 		payload.PaymentID = resolvePaymentRefundedID(event.AggregateID, payload)
+		feeShare := ledgerentity.RefundFeeShare(payload.ProviderAmount, payload.FeeAmount, payload.Amount, payload.RefundedAmount)
 		events, err = h.paymentReversedLedgerEvents(
 			payload.PaymentID,
 			payload.TransactionID,
 			payload.ClearingAccountKey,
 			payload.CreditAccountID,
+			"",
+			payload.RefundID,
 			payload.Currency,
-			payload.Amount,
-			payload.FeeAmount,
+			payload.Amount-feeShare,
+			feeShare,
 			sharedevents.EventPaymentRefunded,
 			payload.RefundedAt,
 		)
@@ -96,12 +99,26 @@ func (h *messageHandler) handlePaymentOutboxEvent(ctx context.Context, value []b
 			payload.TransactionID,
 			payload.ClearingAccountKey,
 			payload.CreditAccountID,
+			payload.DisputeID,
+			"",
 			payload.Currency,
 			payload.Amount,
 			payload.FeeAmount,
 			sharedevents.EventPaymentChargeback,
 			payload.ChargedBackAt,
 		)
+	case sharedevents.EventPaymentDisputeOpened:
+		payload, decodeErr := unmarshalPaymentDisputeOpenedPayload(event.EventData)
+		if decodeErr != nil {
+			return stackErr.Error(decodeErr)
+		}
+		events, err = paymentDisputeHeldLedgerEvents(payload)
+	case sharedevents.EventPaymentDisputeWon:
+		payload, decodeErr := unmarshalPaymentDisputeWonPayload(event.EventData)
+		if decodeErr != nil {
+			return stackErr.Error(decodeErr)
+		}
+		events, err = paymentDisputeReleasedLedgerEvents(payload)
 	default:
 		return nil
 	}
@@ -187,6 +204,22 @@ func unmarshalPaymentChargebackPayload(data json.RawMessage) (sharedevents.Payme
 	return payload, nil
 }
 
+func unmarshalPaymentDisputeOpenedPayload(data json.RawMessage) (sharedevents.PaymentDisputeOpenedEvent, error) {
+	var payload sharedevents.PaymentDisputeOpenedEvent
+	if err := contracts.UnmarshalEventData(data, &payload); err != nil {
+		return sharedevents.PaymentDisputeOpenedEvent{}, stackErr.Error(fmt.Errorf("unmarshal payment dispute opened payload failed: %w", err))
+	}
+	return payload, nil
+}
+
+func unmarshalPaymentDisputeWonPayload(data json.RawMessage) (sharedevents.PaymentDisputeWonEvent, error) {
+	var payload sharedevents.PaymentDisputeWonEvent
+	if err := contracts.UnmarshalEventData(data, &payload); err != nil {
+		return sharedevents.PaymentDisputeWonEvent{}, stackErr.Error(fmt.Errorf("unmarshal payment dispute won payload failed: %w", err))
+	}
+	return payload, nil
+}
+
 func resolvePaymentWithdrawalRequestedID(aggregateID string, payload sharedevents.PaymentWithdrawalRequestedEvent) string {
 	return utils.FirstNonEmpty(strings.TrimSpace(payload.PaymentID), strings.TrimSpace(aggregateID), strings.TrimSpace(payload.TransactionID))
 }
@@ -412,38 +445,46 @@ func (h *messageHandler) paymentFailedLedgerEvents(payload sharedevents.PaymentF
 	return events, nil
 }
 
-func (h *messageHandler) paymentReversedLedgerEvents(paymentID, transactionID, clearingAccountKey, creditAccountID, currency string, amount int64, feeAmount int64, reversalType string, bookedAt time.Time) ([]eventpkg.Event, error) {
+// paymentReversedLedgerEvents books a refund or chargeback: amount back from
+// the credited account and feeAmount back from the fee account, when one is
+// configured. A refund whose share is all fee books no credited-account leg.
+func (h *messageHandler) paymentReversedLedgerEvents(paymentID, transactionID, clearingAccountKey, creditAccountID, disputeID, refundID, currency string, amount int64, feeAmount int64, reversalType string, bookedAt time.Time) ([]eventpkg.Event, error) {
 	booking, err := ledgerentity.NewPaymentReversalBooking(ledgerentity.PaymentReversalBookingInput{
 		PaymentID:          paymentID,
 		TransactionID:      transactionID,
+		RefundID:           refundID,
 		ClearingAccountKey: clearingAccountKey,
 		CreditAccountID:    creditAccountID,
+		DisputeID:          disputeID,
 		Currency:           currency,
-		Amount:             amount,
+		Amount:             max(amount, feeAmount),
 		ReversalType:       reversalType,
 	})
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
-	events, err := paymentLedgerEventsFromBooking(
-		booking.LedgerTransactionID(),
-		booking.PaymentID,
-		booking.Currency,
-		booking.Amount,
-		booking.DebitAccountID,
-		booking.CreditAccountID,
-		debitLedgerEventNameForReversal(booking.ReversalType),
-		creditLedgerEventNameForReversal(booking.ReversalType),
-		bookedAt,
-	)
-	if err != nil {
-		return nil, stackErr.Error(err)
+	var events []eventpkg.Event
+	if amount > 0 {
+		events, err = paymentLedgerEventsFromBooking(
+			booking.LedgerTransactionID(),
+			booking.PaymentID,
+			booking.Currency,
+			amount,
+			booking.DebitAccountID,
+			booking.CreditAccountID,
+			debitLedgerEventNameForReversal(booking.ReversalType),
+			creditLedgerEventNameForReversal(booking.ReversalType),
+			bookedAt,
+		)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
 	}
 
 	if feeAmount > 0 && strings.TrimSpace(h.feeAccountID) != "" {
 		feeEvents, err := paymentLedgerEventsFromBooking(
-			fmt.Sprintf("payment:%s:%s:fee", strings.TrimSpace(booking.PaymentID), reversalSuffix(reversalType)),
+			booking.LedgerTransactionID()+":fee",
 			booking.PaymentID,
 			booking.Currency,
 			feeAmount,
@@ -462,6 +503,67 @@ func (h *messageHandler) paymentReversedLedgerEvents(paymentID, transactionID, c
 	return events, nil
 }
 
+// paymentDisputeHeldLedgerEvents moves the disputed amount from the account
+// the top-up credited into the dispute's hold account. The account may go
+// negative when the funds were already spent, as it would on a chargeback.
+func paymentDisputeHeldLedgerEvents(payload sharedevents.PaymentDisputeOpenedEvent) ([]eventpkg.Event, error) {
+	booking, err := ledgerentity.NewPaymentDisputeHoldBooking(ledgerentity.PaymentDisputeHoldBookingInput{
+		DisputeID: payload.DisputeID,
+		PaymentID: utils.FirstNonEmpty(strings.TrimSpace(payload.PaymentID), strings.TrimSpace(payload.TransactionID)),
+		AccountID: payload.AccountID,
+		Currency:  payload.Currency,
+		Amount:    payload.Amount,
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	events, err := paymentLedgerEventsFromBooking(
+		booking.HoldTransactionID(),
+		booking.DisputeID,
+		booking.Currency,
+		booking.Amount,
+		booking.AccountID,
+		booking.HoldAccountID,
+		ledgeraggregate.EventNameLedgerAccountReserveDisputeHold,
+		ledgeraggregate.EventNameLedgerAccountReceiveDisputeHold,
+		payload.OpenedAt,
+	)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return events, nil
+}
+
+func paymentDisputeReleasedLedgerEvents(payload sharedevents.PaymentDisputeWonEvent) ([]eventpkg.Event, error) {
+	booking, err := ledgerentity.NewPaymentDisputeHoldBooking(ledgerentity.PaymentDisputeHoldBookingInput{
+		DisputeID: payload.DisputeID,
+		PaymentID: utils.FirstNonEmpty(strings.TrimSpace(payload.PaymentID), strings.TrimSpace(payload.TransactionID)),
+		AccountID: payload.AccountID,
+		Currency:  payload.Currency,
+		Amount:    payload.Amount,
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	events, err := paymentLedgerEventsFromBooking(
+		booking.ReleaseTransactionID(),
+		booking.DisputeID,
+		booking.Currency,
+		booking.Amount,
+		booking.HoldAccountID,
+		booking.AccountID,
+		ledgeraggregate.EventNameLedgerAccountWithdrawReleasedDisputeHold,
+		ledgeraggregate.EventNameLedgerAccountReleaseDisputeHold,
+		payload.WonAt,
+	)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return events, nil
+}
+
 func paymentLedgerEventsFromBooking(transactionID, paymentID, currency string, amount int64, debitAccountID, creditAccountID, debitEventName, creditEventName string, bookedAt time.Time) ([]eventpkg.Event, error) {
 	if bookedAt.IsZero() {
 		bookedAt = time.Now().UTC()
@@ -612,14 +714,3 @@ func providerClearingAccountKey(provider string) string {
 	}
 	return fmt.Sprintf("provider:%s", provider)
 }
-
-func reversalSuffix(reversalType string) string {
-	switch strings.TrimSpace(reversalType) {
-	case sharedevents.EventPaymentRefunded:
-		return "refunded"
-	case sharedevents.EventPaymentChargeback:
-		return "chargeback"
-	default:
-		return "reversed"
-	}
-}
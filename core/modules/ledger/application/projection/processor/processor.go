@@ -170,6 +170,32 @@ func unmarshalLedgerAggregateEvent(eventName string, data json.RawMessage) (inte
 		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountTransferredToAccount](data, "unmarshal ledger transfer out payload failed")
 	case ledgeraggregate.EventNameLedgerAccountReceivedTransfer:
 		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReceivedTransfer](data, "unmarshal ledger transfer in payload failed")
+	case ledgeraggregate.EventNameLedgerAccountWithdrawForConversion:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountWithdrawForConversion](data, "unmarshal ledger withdraw for conversion payload failed")
+	case ledgeraggregate.EventNameLedgerAccountDepositFromConversion:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountDepositFromConversion](data, "unmarshal ledger deposit from conversion payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReceiveConversionPosition:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReceiveConversionPosition](data, "unmarshal ledger receive conversion position payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReleaseConversionPosition:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReleaseConversionPosition](data, "unmarshal ledger release conversion position payload failed")
+	case ledgeraggregate.EventNameLedgerAccountRecognizeConversionSpread:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountRecognizeConversionSpread](data, "unmarshal ledger recognize conversion spread payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReserveTransferHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReserveTransferHold](data, "unmarshal ledger reserve transfer hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReceiveTransferHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReceiveTransferHold](data, "unmarshal ledger receive transfer hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReleaseTransferHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReleaseTransferHold](data, "unmarshal ledger release transfer hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountWithdrawReleasedTransferHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountWithdrawReleasedTransferHold](data, "unmarshal ledger withdraw released transfer hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReserveDisputeHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReserveDisputeHold](data, "unmarshal ledger reserve dispute hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReceiveDisputeHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReceiveDisputeHold](data, "unmarshal ledger receive dispute hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountReleaseDisputeHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountReleaseDisputeHold](data, "unmarshal ledger release dispute hold payload failed")
+	case ledgeraggregate.EventNameLedgerAccountWithdrawReleasedDisputeHold:
+		return unmarshalLedgerEventData[ledgeraggregate.EventLedgerAccountWithdrawReleasedDisputeHold](data, "unmarshal ledger withdraw released dispute hold payload failed")
 	default:
 		return nil, stackErr.Error(fmt.Errorf("unsupported ledger event_name=%s", eventName))
 	}
@@ -15,6 +15,15 @@ type ListTransactionsFilter struct {
 	Limit               int
 }
 
+// StatementEntriesFilter selects an account's entries in one currency booked
+// in the half-open range [From, To).
+type StatementEntriesFilter struct {
+	AccountID string
+	Currency  string
+	From      time.Time
+	To        time.Time
+}
+
 // ReadRepository exposes read-side ledger views derived from canonical
 // transaction postings. Write-side persistence must go through aggregate
 // repositories to keep the posting model explicit.
@@ -26,4 +35,6 @@ type ReadRepository interface {
 	GetTransaction(ctx context.Context, transactionID string) (*entity.LedgerTransaction, error)
 	ListTransactions(ctx context.Context, filter ListTransactionsFilter) ([]*entity.LedgerTransaction, error)
 	CountTransactions(ctx context.Context, accountID, currency string) (int64, error)
+	GetBalanceBefore(ctx context.Context, accountID, currency string, before time.Time) (int64, error)
+	ListStatementEntries(ctx context.Context, filter StatementEntriesFilter) ([]*entity.LedgerEntry, error)
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: core/modules/ledger/application/projection/read_repo.go
+// Source: read_repo.go
 //
 // Generated by this command:
 //
-//	mockgen -package=projection -destination=core/modules/ledger/application/projection/read_repo_mock.go -source=core/modules/ledger/application/projection/read_repo.go
+//	mockgen -package=projection -destination=read_repo_mock.go -source=read_repo.go
 //
 
 // Package projection is a generated GoMock package.
@@ -12,6 +12,7 @@ package projection
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	entity "wechat-clone/core/modules/ledger/domain/entity"
 
 	gomock "go.uber.org/mock/gomock"
@@ -71,6 +72,21 @@ func (mr *MockReadRepositoryMockRecorder) GetBalance(ctx, accountID, currency an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockReadRepository)(nil).GetBalance), ctx, accountID, currency)
 }
 
+// GetBalanceBefore mocks base method.
+func (m *MockReadRepository) GetBalanceBefore(ctx context.Context, accountID, currency string, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceBefore", ctx, accountID, currency, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceBefore indicates an expected call of GetBalanceBefore.
+func (mr *MockReadRepositoryMockRecorder) GetBalanceBefore(ctx, accountID, currency, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceBefore", reflect.TypeOf((*MockReadRepository)(nil).GetBalanceBefore), ctx, accountID, currency, before)
+}
+
 // GetTransaction mocks base method.
 func (m *MockReadRepository) GetTransaction(ctx context.Context, transactionID string) (*entity.LedgerTransaction, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +102,21 @@ func (mr *MockReadRepositoryMockRecorder) GetTransaction(ctx, transactionID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockReadRepository)(nil).GetTransaction), ctx, transactionID)
 }
 
+// ListStatementEntries mocks base method.
+func (m *MockReadRepository) ListStatementEntries(ctx context.Context, filter StatementEntriesFilter) ([]*entity.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatementEntries", ctx, filter)
+	ret0, _ := ret[0].([]*entity.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatementEntries indicates an expected call of ListStatementEntries.
+func (mr *MockReadRepositoryMockRecorder) ListStatementEntries(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementEntries", reflect.TypeOf((*MockReadRepository)(nil).ListStatementEntries), ctx, filter)
+}
+
 // ListTransactions mocks base method.
 func (m *MockReadRepository) ListTransactions(ctx context.Context, filter ListTransactionsFilter) ([]*entity.LedgerTransaction, error) {
 	m.ctrl.T.Helper()
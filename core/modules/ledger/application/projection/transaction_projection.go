@@ -7,18 +7,31 @@ import (
 )
 
 var ledgerTransactionProjectionEventNames = map[string]struct{}{
-	ledgeraggregate.EventNameLedgerAccountDepositFromIntent:      {},
-	ledgeraggregate.EventNameLedgerAccountWithdrawFromIntent:     {},
-	ledgeraggregate.EventNameLedgerAccountDepositFromRefund:      {},
-	ledgeraggregate.EventNameLedgerAccountWithdrawFromRefund:     {},
-	ledgeraggregate.EventNameLedgerAccountDepositFromChargeback:  {},
-	ledgeraggregate.EventNameLedgerAccountWithdrawFromChargeback: {},
-	ledgeraggregate.EventNameLedgerAccountReserveWithdrawal:      {},
-	ledgeraggregate.EventNameLedgerAccountReceiveWithdrawalHold:  {},
-	ledgeraggregate.EventNameLedgerAccountReleaseWithdrawal:      {},
-	ledgeraggregate.EventNameLedgerAccountWithdrawReleasedHold:   {},
-	ledgeraggregate.EventNameLedgerAccountTransferredToAccount:   {},
-	ledgeraggregate.EventNameLedgerAccountReceivedTransfer:       {},
+	ledgeraggregate.EventNameLedgerAccountDepositFromIntent:            {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawFromIntent:           {},
+	ledgeraggregate.EventNameLedgerAccountDepositFromRefund:            {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawFromRefund:           {},
+	ledgeraggregate.EventNameLedgerAccountDepositFromChargeback:        {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawFromChargeback:       {},
+	ledgeraggregate.EventNameLedgerAccountReserveWithdrawal:            {},
+	ledgeraggregate.EventNameLedgerAccountReceiveWithdrawalHold:        {},
+	ledgeraggregate.EventNameLedgerAccountReleaseWithdrawal:            {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawReleasedHold:         {},
+	ledgeraggregate.EventNameLedgerAccountTransferredToAccount:         {},
+	ledgeraggregate.EventNameLedgerAccountReceivedTransfer:             {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawForConversion:        {},
+	ledgeraggregate.EventNameLedgerAccountDepositFromConversion:        {},
+	ledgeraggregate.EventNameLedgerAccountReceiveConversionPosition:    {},
+	ledgeraggregate.EventNameLedgerAccountReleaseConversionPosition:    {},
+	ledgeraggregate.EventNameLedgerAccountRecognizeConversionSpread:    {},
+	ledgeraggregate.EventNameLedgerAccountReserveTransferHold:          {},
+	ledgeraggregate.EventNameLedgerAccountReceiveTransferHold:          {},
+	ledgeraggregate.EventNameLedgerAccountReleaseTransferHold:          {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawReleasedTransferHold: {},
+	ledgeraggregate.EventNameLedgerAccountReserveDisputeHold:           {},
+	ledgeraggregate.EventNameLedgerAccountReceiveDisputeHold:           {},
+	ledgeraggregate.EventNameLedgerAccountReleaseDisputeHold:           {},
+	ledgeraggregate.EventNameLedgerAccountWithdrawReleasedDisputeHold:  {},
 }
 
 type LedgerTransactionEntry struct {
@@ -0,0 +1,12 @@
+package query
+
+import (
+	"net/http"
+
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrUnauthorized  = apperr.New("ledger.unauthorized", "unauthorized", http.StatusUnauthorized)
+	ErrAdminRequired = apperr.New("ledger.admin_required", "admin role is required", http.StatusForbidden)
+)
@@ -0,0 +1,28 @@
+package query
+
+import (
+	"context"
+
+	ledgerin "wechat-clone/core/modules/ledger/application/dto/in"
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getAccountLimitsHandler struct {
+	service ledgerservice.LedgerLimitService
+}
+
+func NewGetAccountLimitsHandler(service ledgerservice.LedgerLimitService) cqrs.Handler[*ledgerin.GetLimitsRequest, *ledgerout.AccountLimitsResponse] {
+	return &getAccountLimitsHandler{service: service}
+}
+
+func (h *getAccountLimitsHandler) Handle(ctx context.Context, _ *ledgerin.GetLimitsRequest) (*ledgerout.AccountLimitsResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return h.service.GetAccountLimits(ctx, accountID)
+}
@@ -0,0 +1,33 @@
+package query
+
+import (
+	"context"
+
+	ledgerin "wechat-clone/core/modules/ledger/application/dto/in"
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getStatementHandler struct {
+	service ledgerservice.LedgerQueryService
+}
+
+func NewGetStatementHandler(service ledgerservice.LedgerQueryService) cqrs.Handler[*ledgerin.GetStatementRequest, *ledgerout.LedgerStatementResponse] {
+	return &getStatementHandler{service: service}
+}
+
+func (h *getStatementHandler) Handle(ctx context.Context, req *ledgerin.GetStatementRequest) (*ledgerout.LedgerStatementResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	from, to, err := ledgerservice.ParseStatementRange(req.From, req.To)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return h.service.GetStatement(ctx, accountID, req.Currency, from, to)
+}
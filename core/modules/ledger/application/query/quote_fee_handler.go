@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+
+	ledgerin "wechat-clone/core/modules/ledger/application/dto/in"
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type quoteFeeHandler struct {
+	service ledgerservice.LedgerFeeService
+}
+
+func NewQuoteFeeHandler(service ledgerservice.LedgerFeeService) cqrs.Handler[*ledgerin.QuoteFeeRequest, *ledgerout.FeeQuoteResponse] {
+	return &quoteFeeHandler{service: service}
+}
+
+func (h *quoteFeeHandler) Handle(ctx context.Context, req *ledgerin.QuoteFeeRequest) (*ledgerout.FeeQuoteResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return h.service.GetFeeQuote(ctx, ledgerservice.QuoteFeeCommand{
+		AccountID: accountID,
+		Operation: req.Operation,
+		Provider:  req.Provider,
+		Currency:  req.Currency,
+		Amount:    req.Amount,
+	})
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	ledgerin "wechat-clone/core/modules/ledger/application/dto/in"
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type runAuditHandler struct {
+	service ledgerservice.LedgerAuditService
+	admins  accountsupport.AdminRoles
+}
+
+func NewRunAuditHandler(service ledgerservice.LedgerAuditService, admins accountsupport.AdminRoles) cqrs.Handler[*ledgerin.AdminAuditRequest, *ledgerout.LedgerAuditReportResponse] {
+	return &runAuditHandler{service: service, admins: admins}
+}
+
+func (h *runAuditHandler) Handle(ctx context.Context, _ *ledgerin.AdminAuditRequest) (*ledgerout.LedgerAuditReportResponse, error) {
+	if err := requireAdmin(ctx, h.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return h.service.RunAudit(ctx)
+}
@@ -10,4 +10,5 @@ var (
 	ErrPaymentIntentNotFound = errors.New("payment intent not found")
 	ErrUnauthorized          = errors.New("unauthorized")
 	ErrInsufficientFunds     = errors.New("insufficient funds")
+	ErrLimitExceeded         = errors.New("wallet limit exceeded")
 )
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	ledgeraggregate "wechat-clone/core/modules/ledger/domain/aggregate"
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	AuditDriftVersionMismatch       = "aggregate_version_mismatch"
+	AuditDriftEventVersionGap       = "event_version_gap"
+	AuditDriftReplayFailed          = "replay_failed"
+	AuditDriftSnapshotMismatch      = "snapshot_mismatch"
+	AuditDriftProjectionMismatch    = "projection_balance_mismatch"
+	AuditDriftUnbalancedTransaction = "unbalanced_transaction"
+	AuditDriftTrialBalance          = "trial_balance_imbalance"
+
+	auditAccountPageSize = 200
+)
+
+//go:generate mockgen -package=service -destination=ledger_audit_service_mock.go -source=ledger_audit_service.go
+type LedgerAuditService interface {
+	RunAudit(ctx context.Context) (*ledgerout.LedgerAuditReportResponse, error)
+}
+
+type ledgerAuditService struct {
+	repo ledgerrepos.LedgerAuditRepository
+	now  func() time.Time
+}
+
+// NewLedgerAuditService replays every ledger account stream and checks it
+// against snapshots, the ledger_entries projection and double-entry balance.
+// All reads share one read-only transaction, so it is safe against a live
+// database.
+func NewLedgerAuditService(repo ledgerrepos.LedgerAuditRepository) LedgerAuditService {
+	return &ledgerAuditService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+type auditBalanceKey struct {
+	accountID string
+	currency  string
+}
+
+type auditPostingKey struct {
+	transactionID string
+	currency      string
+}
+
+type ledgerAudit struct {
+	report       *ledgerout.LedgerAuditReportResponse
+	balances     map[auditBalanceKey]int64
+	postingSums  map[auditPostingKey]int64
+	transactions map[string]struct{}
+}
+
+func (s *ledgerAuditService) RunAudit(ctx context.Context) (*ledgerout.LedgerAuditReportResponse, error) {
+	audit := &ledgerAudit{
+		report: &ledgerout.LedgerAuditReportResponse{
+			GeneratedAt:  s.now().UTC(),
+			Drifts:       make([]ledgerout.LedgerAuditDriftResponse, 0),
+			TrialBalance: make([]ledgerout.LedgerTrialBalanceResponse, 0),
+		},
+		balances:     make(map[auditBalanceKey]int64),
+		postingSums:  make(map[auditPostingKey]int64),
+		transactions: make(map[string]struct{}),
+	}
+
+	err := s.repo.ReadOnly(ctx, func(repo ledgerrepos.LedgerAuditRepository) error {
+		afterAccountID := ""
+		for {
+			accounts, err := repo.ListAccounts(ctx, afterAccountID, auditAccountPageSize)
+			if err != nil {
+				return stackErr.Error(err)
+			}
+			for _, account := range accounts {
+				if err := audit.checkAccount(ctx, repo, account); err != nil {
+					return stackErr.Error(err)
+				}
+			}
+			if len(accounts) < auditAccountPageSize {
+				break
+			}
+			afterAccountID = accounts[len(accounts)-1].AccountID
+		}
+
+		projected, err := repo.ListProjectedBalances(ctx)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		audit.checkProjection(projected)
+		return nil
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	audit.checkTransactions()
+	audit.buildTrialBalance()
+	audit.report.TransactionsChecked = len(audit.transactions)
+	audit.report.Healthy = len(audit.report.Drifts) == 0
+	return audit.report, nil
+}
+
+func (a *ledgerAudit) checkAccount(ctx context.Context, repo ledgerrepos.LedgerAuditRepository, account ledgerrepos.LedgerAuditAccount) error {
+	events, err := repo.ListAccountEvents(ctx, account.AccountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	a.report.AccountsChecked++
+	a.report.EventsReplayed += len(events)
+
+	for i, evt := range events {
+		if evt.Version != i+1 {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:      AuditDriftEventVersionGap,
+				AccountID: account.AccountID,
+				Expected:  int64(i + 1),
+				Actual:    int64(evt.Version),
+				Detail:    "event versions are not contiguous",
+			})
+			break
+		}
+	}
+	lastVersion := 0
+	if len(events) > 0 {
+		lastVersion = events[len(events)-1].Version
+	}
+	if lastVersion != account.Version {
+		a.drift(ledgerout.LedgerAuditDriftResponse{
+			Kind:      AuditDriftVersionMismatch,
+			AccountID: account.AccountID,
+			Expected:  int64(lastVersion),
+			Actual:    int64(account.Version),
+			Detail:    "aggregate version does not match the last event version",
+		})
+	}
+
+	snapshot, err := repo.GetLatestSnapshot(ctx, account.AccountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	agg, err := ledgeraggregate.NewLedgerAccountAggregate(account.AccountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	for _, evt := range events {
+		if err := agg.Transition(evt); err != nil {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:      AuditDriftReplayFailed,
+				AccountID: account.AccountID,
+				Expected:  int64(evt.Version),
+				Detail:    fmt.Sprintf("replay %s: %v", evt.EventName, err),
+			})
+			return nil
+		}
+		if snapshot != nil && evt.Version == snapshot.Version {
+			a.compareSnapshot(account.AccountID, agg, snapshot)
+		}
+
+		posting, ok, err := ledgeraggregate.NewLedgerAccountPostingFromEvent(account.AccountID, evt.EventData)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if ok {
+			key := auditPostingKey{transactionID: posting.TransactionID, currency: posting.Currency}
+			a.postingSums[key] += posting.AmountDelta
+			a.transactions[posting.TransactionID] = struct{}{}
+		}
+	}
+	if snapshot != nil && snapshot.Version > lastVersion {
+		a.drift(ledgerout.LedgerAuditDriftResponse{
+			Kind:      AuditDriftSnapshotMismatch,
+			AccountID: account.AccountID,
+			Expected:  int64(lastVersion),
+			Actual:    int64(snapshot.Version),
+			Detail:    "snapshot version is ahead of the event stream",
+		})
+	}
+
+	for currency, balance := range agg.Balances {
+		a.balances[auditBalanceKey{accountID: account.AccountID, currency: currency}] = balance
+	}
+	return nil
+}
+
+func (a *ledgerAudit) compareSnapshot(accountID string, replayed *ledgeraggregate.LedgerAccountAggregate, snapshot *ledgerrepos.LedgerAuditSnapshot) {
+	for _, currency := range unionCurrencies(replayed.Balances, snapshot.Aggregate.Balances) {
+		expected, actual := replayed.Balance(currency), snapshot.Aggregate.Balance(currency)
+		if expected != actual {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:      AuditDriftSnapshotMismatch,
+				AccountID: accountID,
+				Currency:  currency,
+				Expected:  expected,
+				Actual:    actual,
+				Detail:    fmt.Sprintf("snapshot balance at version %d differs from replay", snapshot.Version),
+			})
+		}
+	}
+
+	for transactionID, posting := range replayed.PostedTransactions {
+		snapshotPosting, ok := snapshot.Aggregate.PostedTransactions[transactionID]
+		if !ok || !ledgeraggregate.SameLedgerAccountPosting(posting, snapshotPosting) {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:          AuditDriftSnapshotMismatch,
+				AccountID:     accountID,
+				TransactionID: transactionID,
+				Currency:      posting.Currency,
+				Expected:      posting.AmountDelta,
+				Actual:        snapshotPosting.AmountDelta,
+				Detail:        fmt.Sprintf("snapshot posting at version %d differs from replay", snapshot.Version),
+			})
+		}
+	}
+	for transactionID, posting := range snapshot.Aggregate.PostedTransactions {
+		if _, ok := replayed.PostedTransactions[transactionID]; !ok {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:          AuditDriftSnapshotMismatch,
+				AccountID:     accountID,
+				TransactionID: transactionID,
+				Currency:      posting.Currency,
+				Actual:        posting.AmountDelta,
+				Detail:        fmt.Sprintf("snapshot at version %d holds a posting the event stream does not", snapshot.Version),
+			})
+		}
+	}
+}
+
+func (a *ledgerAudit) checkProjection(projected []ledgerrepos.LedgerProjectedBalance) {
+	seen := make(map[auditBalanceKey]struct{}, len(projected))
+	for _, row := range projected {
+		key := auditBalanceKey{accountID: row.AccountID, currency: strings.ToUpper(strings.TrimSpace(row.Currency))}
+		seen[key] = struct{}{}
+		if expected := a.balances[key]; expected != row.Balance {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:      AuditDriftProjectionMismatch,
+				AccountID: key.accountID,
+				Currency:  key.currency,
+				Expected:  expected,
+				Actual:    row.Balance,
+				Detail:    "projected balance differs from replayed balance",
+			})
+		}
+	}
+	for _, key := range sortedBalanceKeys(a.balances) {
+		if _, ok := seen[key]; ok || a.balances[key] == 0 {
+			continue
+		}
+		a.drift(ledgerout.LedgerAuditDriftResponse{
+			Kind:      AuditDriftProjectionMismatch,
+			AccountID: key.accountID,
+			Currency:  key.currency,
+			Expected:  a.balances[key],
+			Detail:    "replayed balance has no projected entries",
+		})
+	}
+}
+
+func (a *ledgerAudit) checkTransactions() {
+	keys := make([]auditPostingKey, 0, len(a.postingSums))
+	for key := range a.postingSums {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].transactionID != keys[j].transactionID {
+			return keys[i].transactionID < keys[j].transactionID
+		}
+		return keys[i].currency < keys[j].currency
+	})
+
+	for _, key := range keys {
+		if sum := a.postingSums[key]; sum != 0 {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:          AuditDriftUnbalancedTransaction,
+				TransactionID: key.transactionID,
+				Currency:      key.currency,
+				Actual:        sum,
+				Detail:        "transaction postings do not sum to zero",
+			})
+		}
+	}
+}
+
+func (a *ledgerAudit) buildTrialBalance() {
+	byCurrency := make(map[string]*ledgerout.LedgerTrialBalanceResponse)
+	currencies := make([]string, 0)
+	for _, key := range sortedBalanceKeys(a.balances) {
+		balance := a.balances[key]
+		line, ok := byCurrency[key.currency]
+		if !ok {
+			line = &ledgerout.LedgerTrialBalanceResponse{
+				Currency:       key.currency,
+				SystemAccounts: make([]ledgerout.LedgerTrialBalanceAccountResponse, 0),
+			}
+			byCurrency[key.currency] = line
+			currencies = append(currencies, key.currency)
+		}
+
+		if entity.IsSystemAccount(key.accountID) {
+			line.SystemAccounts = append(line.SystemAccounts, ledgerout.LedgerTrialBalanceAccountResponse{
+				AccountID: key.accountID,
+				Balance:   balance,
+			})
+		} else {
+			line.UserAccountCount++
+			line.UserAccountBalance += balance
+		}
+		if balance > 0 {
+			line.Credit += balance
+		} else {
+			line.Debit -= balance
+		}
+		line.Net += balance
+	}
+
+	sort.Strings(currencies)
+	for _, currency := range currencies {
+		line := byCurrency[currency]
+		if line.Net != 0 {
+			a.drift(ledgerout.LedgerAuditDriftResponse{
+				Kind:     AuditDriftTrialBalance,
+				Currency: currency,
+				Actual:   line.Net,
+				Detail:   "trial balance does not net to zero",
+			})
+		}
+		a.report.TrialBalance = append(a.report.TrialBalance, *line)
+	}
+}
+
+func (a *ledgerAudit) drift(drift ledgerout.LedgerAuditDriftResponse) {
+	a.report.Drifts = append(a.report.Drifts, drift)
+}
+
+func sortedBalanceKeys(balances map[auditBalanceKey]int64) []auditBalanceKey {
+	keys := make([]auditBalanceKey, 0, len(balances))
+	for key := range balances {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].currency != keys[j].currency {
+			return keys[i].currency < keys[j].currency
+		}
+		return keys[i].accountID < keys[j].accountID
+	})
+	return keys
+}
+
+func unionCurrencies(left, right map[string]int64) []string {
+	seen := make(map[string]struct{}, len(left)+len(right))
+	for currency := range left {
+		seen[currency] = struct{}{}
+	}
+	for currency := range right {
+		seen[currency] = struct{}{}
+	}
+	currencies := make([]string, 0, len(seen))
+	for currency := range seen {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
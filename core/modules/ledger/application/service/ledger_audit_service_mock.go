@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_audit_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=ledger_audit_service_mock.go -source=ledger_audit_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	out "wechat-clone/core/modules/ledger/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerAuditService is a mock of LedgerAuditService interface.
+type MockLedgerAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerAuditServiceMockRecorder is the mock recorder for MockLedgerAuditService.
+type MockLedgerAuditServiceMockRecorder struct {
+	mock *MockLedgerAuditService
+}
+
+// NewMockLedgerAuditService creates a new mock instance.
+func NewMockLedgerAuditService(ctrl *gomock.Controller) *MockLedgerAuditService {
+	mock := &MockLedgerAuditService{ctrl: ctrl}
+	mock.recorder = &MockLedgerAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerAuditService) EXPECT() *MockLedgerAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// RunAudit mocks base method.
+func (m *MockLedgerAuditService) RunAudit(ctx context.Context) (*out.LedgerAuditReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAudit", ctx)
+	ret0, _ := ret[0].(*out.LedgerAuditReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAudit indicates an expected call of RunAudit.
+func (mr *MockLedgerAuditServiceMockRecorder) RunAudit(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAudit", reflect.TypeOf((*MockLedgerAuditService)(nil).RunAudit), ctx)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ledgeraggregate "wechat-clone/core/modules/ledger/domain/aggregate"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestLedgerAuditServiceRunAudit(t *testing.T) {
+	const clearingAccountID = "ledger:clearing:provider:stripe"
+	bookedAt := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	// buildStreams books a deposit from the clearing account to acc-1 and an
+	// internal transfer from acc-1 to acc-2. clearingAmount lets a case
+	// corrupt the clearing side of the deposit.
+	buildStreams := func(t *testing.T, clearingAmount int64) map[string]*ledgeraggregate.LedgerAccountAggregate {
+		t.Helper()
+		must := func(_ bool, err error) {
+			t.Helper()
+			if err != nil {
+				t.Fatalf("book posting: %v", err)
+			}
+		}
+		newAgg := func(accountID string) *ledgeraggregate.LedgerAccountAggregate {
+			agg, err := ledgeraggregate.NewLedgerAccountAggregate(accountID)
+			if err != nil {
+				t.Fatalf("NewLedgerAccountAggregate() error = %v", err)
+			}
+			return agg
+		}
+
+		clearing, acc1, acc2 := newAgg(clearingAccountID), newAgg("acc-1"), newAgg("acc-2")
+		must(clearing.BookPayment("payment:pay-1:succeeded", "pay-1", "acc-1", "USD", clearingAmount, bookedAt))
+		must(acc1.BookPayment("payment:pay-1:succeeded", "pay-1", clearingAccountID, "USD", 1000, bookedAt))
+		must(acc1.TransferToAccount("tx-1", "acc-2", "USD", 300, bookedAt))
+		must(acc2.ReceiveTransfer("tx-1", "acc-1", "USD", 300, bookedAt))
+		return map[string]*ledgeraggregate.LedgerAccountAggregate{
+			"acc-1":           acc1,
+			"acc-2":           acc2,
+			clearingAccountID: clearing,
+		}
+	}
+
+	expectRepo := func(
+		t *testing.T,
+		streams map[string]*ledgeraggregate.LedgerAccountAggregate,
+		snapshot *ledgerrepos.LedgerAuditSnapshot,
+		projected []ledgerrepos.LedgerProjectedBalance,
+	) *ledgerrepos.MockLedgerAuditRepository {
+		ctrl := gomock.NewController(t)
+		repo := ledgerrepos.NewMockLedgerAuditRepository(ctrl)
+		repo.EXPECT().ReadOnly(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(ledgerrepos.LedgerAuditRepository) error) error {
+				return fn(repo)
+			},
+		)
+
+		accounts := []ledgerrepos.LedgerAuditAccount{
+			{AccountID: "acc-1", Version: len(streams["acc-1"].Events())},
+			{AccountID: "acc-2", Version: len(streams["acc-2"].Events())},
+			{AccountID: clearingAccountID, Version: len(streams[clearingAccountID].Events())},
+		}
+		repo.EXPECT().ListAccounts(gomock.Any(), "", auditAccountPageSize).Return(accounts, nil)
+		for _, account := range accounts {
+			repo.EXPECT().ListAccountEvents(gomock.Any(), account.AccountID).
+				Return(streams[account.AccountID].Events(), nil)
+			var accountSnapshot *ledgerrepos.LedgerAuditSnapshot
+			if account.AccountID == "acc-1" {
+				accountSnapshot = snapshot
+			}
+			repo.EXPECT().GetLatestSnapshot(gomock.Any(), account.AccountID).Return(accountSnapshot, nil)
+		}
+		repo.EXPECT().ListProjectedBalances(gomock.Any()).Return(projected, nil)
+		return repo
+	}
+
+	// snapshotAfterFirstEvent replays acc-1 up to version 1, the state a
+	// snapshot taken at that version must hold.
+	snapshotAfterFirstEvent := func(t *testing.T, acc1 *ledgeraggregate.LedgerAccountAggregate) *ledgerrepos.LedgerAuditSnapshot {
+		t.Helper()
+		agg, err := ledgeraggregate.NewLedgerAccountAggregate("acc-1")
+		if err != nil {
+			t.Fatalf("NewLedgerAccountAggregate() error = %v", err)
+		}
+		if err := agg.LoadFromHistory(agg, []eventpkg.Event{acc1.Events()[0]}); err != nil {
+			t.Fatalf("LoadFromHistory() error = %v", err)
+		}
+		return &ledgerrepos.LedgerAuditSnapshot{Version: 1, Aggregate: agg}
+	}
+
+	t.Run("balanced ledger reports no drift", func(t *testing.T) {
+		streams := buildStreams(t, -1000)
+		repo := expectRepo(t, streams, snapshotAfterFirstEvent(t, streams["acc-1"]), []ledgerrepos.LedgerProjectedBalance{
+			{AccountID: "acc-1", Currency: "USD", Balance: 700},
+			{AccountID: "acc-2", Currency: "USD", Balance: 300},
+			{AccountID: clearingAccountID, Currency: "USD", Balance: -1000},
+		})
+
+		report, err := NewLedgerAuditService(repo).RunAudit(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if !report.Healthy || len(report.Drifts) != 0 {
+			t.Fatalf("expected healthy report, got drifts %+v", report.Drifts)
+		}
+		if report.AccountsChecked != 3 || report.EventsReplayed != 4 || report.TransactionsChecked != 2 {
+			t.Fatalf("unexpected counters %+v", report)
+		}
+		if len(report.TrialBalance) != 1 {
+			t.Fatalf("expected one trial balance currency, got %+v", report.TrialBalance)
+		}
+		line := report.TrialBalance[0]
+		if line.Currency != "USD" || line.UserAccountCount != 2 || line.UserAccountBalance != 1000 {
+			t.Fatalf("unexpected user account totals %+v", line)
+		}
+		if len(line.SystemAccounts) != 1 || line.SystemAccounts[0].AccountID != clearingAccountID || line.SystemAccounts[0].Balance != -1000 {
+			t.Fatalf("unexpected system accounts %+v", line.SystemAccounts)
+		}
+		if line.Debit != 1000 || line.Credit != 1000 || line.Net != 0 {
+			t.Fatalf("unexpected trial balance totals %+v", line)
+		}
+	})
+
+	t.Run("reports snapshot, projection and double-entry drift", func(t *testing.T) {
+		streams := buildStreams(t, -900)
+		snapshot := snapshotAfterFirstEvent(t, streams["acc-1"])
+		snapshot.Aggregate.Balances["USD"] = 950
+		repo := expectRepo(t, streams, snapshot, []ledgerrepos.LedgerProjectedBalance{
+			{AccountID: "acc-1", Currency: "USD", Balance: 700},
+			{AccountID: clearingAccountID, Currency: "USD", Balance: -900},
+			{AccountID: "acc-9", Currency: "USD", Balance: 50},
+		})
+
+		report, err := NewLedgerAuditService(repo).RunAudit(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if report.Healthy {
+			t.Fatal("expected unhealthy report")
+		}
+		kinds := make(map[string]int)
+		for _, drift := range report.Drifts {
+			kinds[drift.Kind]++
+		}
+		want := map[string]int{
+			AuditDriftSnapshotMismatch:      1,
+			AuditDriftProjectionMismatch:    2,
+			AuditDriftUnbalancedTransaction: 1,
+			AuditDriftTrialBalance:          1,
+		}
+		for kind, count := range want {
+			if kinds[kind] != count {
+				t.Fatalf("expected %d %s drifts, got %+v", count, kind, report.Drifts)
+			}
+		}
+		if len(report.Drifts) != 5 {
+			t.Fatalf("expected 5 drifts, got %+v", report.Drifts)
+		}
+		for _, drift := range report.Drifts {
+			if drift.Kind == AuditDriftUnbalancedTransaction && (drift.TransactionID != "payment:pay-1:succeeded" || drift.Actual != 100) {
+				t.Fatalf("unexpected unbalanced transaction drift %+v", drift)
+			}
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// QuoteFeeCommand describes an operation about to be charged to AccountID.
+// Provider is empty for transfers and conversions.
+type QuoteFeeCommand struct {
+	AccountID string
+	Operation string
+	Provider  string
+	Currency  string
+	Amount    int64
+}
+
+//go:generate mockgen -package=service -destination=ledger_fee_service_mock.go -source=ledger_fee_service.go
+type LedgerFeeService interface {
+	// QuoteFee prices an operation with the fee schedule for the account's
+	// tier. Every module charging a fee quotes it here, so a quote shown to
+	// the user and the fee booked at execution come from the same rules.
+	QuoteFee(ctx context.Context, command QuoteFeeCommand) (finance.FeeQuote, error)
+	GetFeeQuote(ctx context.Context, command QuoteFeeCommand) (*ledgerout.FeeQuoteResponse, error)
+}
+
+type ledgerFeeService struct {
+	baseRepo ledgerrepos.Repos
+	schedule finance.FeeSchedule
+	now      func() time.Time
+}
+
+func NewLedgerFeeService(baseRepo ledgerrepos.Repos, schedule finance.FeeSchedule) LedgerFeeService {
+	return &ledgerFeeService{
+		baseRepo: baseRepo,
+		schedule: schedule,
+		now:      time.Now,
+	}
+}
+
+func (s *ledgerFeeService) QuoteFee(ctx context.Context, command QuoteFeeCommand) (finance.FeeQuote, error) {
+	accountID := strings.TrimSpace(command.AccountID)
+	if accountID == "" {
+		return finance.FeeQuote{}, stackErr.Error(fmt.Errorf("%w: account_id is required", ErrValidation))
+	}
+
+	tier := entity.LimitTierUnverified
+	if !entity.IsSystemAccount(accountID) {
+		accountTier, err := s.baseRepo.LedgerLimitRepository().GetAccountTier(ctx, accountID)
+		if err != nil {
+			return finance.FeeQuote{}, stackErr.Error(err)
+		}
+		tier = accountTier
+	}
+
+	quote, err := s.schedule.Quote(finance.FeeQuery{
+		Operation: command.Operation,
+		Provider:  command.Provider,
+		Currency:  command.Currency,
+		Tier:      tier,
+		Amount:    command.Amount,
+		At:        s.now().UTC(),
+	})
+	if err != nil {
+		return finance.FeeQuote{}, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+	return quote, nil
+}
+
+func (s *ledgerFeeService) GetFeeQuote(ctx context.Context, command QuoteFeeCommand) (*ledgerout.FeeQuoteResponse, error) {
+	quote, err := s.QuoteFee(ctx, command)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &ledgerout.FeeQuoteResponse{
+		Operation:   quote.Operation,
+		Provider:    quote.Provider,
+		Currency:    quote.Currency,
+		Tier:        quote.Tier,
+		Amount:      quote.Amount,
+		FeeAmount:   quote.FeeAmount,
+		TotalAmount: quote.Amount + quote.FeeAmount,
+		RateBps:     quote.RateBPS,
+		FlatAmount:  quote.FlatAmount,
+		Promotional: quote.Promotional,
+		QuotedAt:    s.now().UTC().Format(time.RFC3339Nano),
+	}, nil
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_fee_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=ledger_fee_service_mock.go -source=ledger_fee_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	out "wechat-clone/core/modules/ledger/application/dto/out"
+	finance "wechat-clone/core/shared/finance"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerFeeService is a mock of LedgerFeeService interface.
+type MockLedgerFeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerFeeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerFeeServiceMockRecorder is the mock recorder for MockLedgerFeeService.
+type MockLedgerFeeServiceMockRecorder struct {
+	mock *MockLedgerFeeService
+}
+
+// NewMockLedgerFeeService creates a new mock instance.
+func NewMockLedgerFeeService(ctrl *gomock.Controller) *MockLedgerFeeService {
+	mock := &MockLedgerFeeService{ctrl: ctrl}
+	mock.recorder = &MockLedgerFeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerFeeService) EXPECT() *MockLedgerFeeServiceMockRecorder {
+	return m.recorder
+}
+
+// GetFeeQuote mocks base method.
+func (m *MockLedgerFeeService) GetFeeQuote(ctx context.Context, command QuoteFeeCommand) (*out.FeeQuoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeQuote", ctx, command)
+	ret0, _ := ret[0].(*out.FeeQuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeQuote indicates an expected call of GetFeeQuote.
+func (mr *MockLedgerFeeServiceMockRecorder) GetFeeQuote(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeQuote", reflect.TypeOf((*MockLedgerFeeService)(nil).GetFeeQuote), ctx, command)
+}
+
+// QuoteFee mocks base method.
+func (m *MockLedgerFeeService) QuoteFee(ctx context.Context, command QuoteFeeCommand) (finance.FeeQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteFee", ctx, command)
+	ret0, _ := ret[0].(finance.FeeQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteFee indicates an expected call of QuoteFee.
+func (mr *MockLedgerFeeServiceMockRecorder) QuoteFee(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteFee", reflect.TypeOf((*MockLedgerFeeService)(nil).QuoteFee), ctx, command)
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/shared/finance"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestLedgerFeeServiceQuoteFee(t *testing.T) {
+	now := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	schedule, err := finance.ParseFeeSchedule(
+		"transfer/*/VND/*:*@50+0:1000/0,transfer/*/VND/business:*@0+0",
+		"transfer/*/VND/verified:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z",
+	)
+	if err != nil {
+		t.Fatalf("parse fee schedule: %v", err)
+	}
+	command := QuoteFeeCommand{AccountID: "acc-1", Operation: "transfer", Currency: "vnd", Amount: 1000000}
+
+	newService := func(t *testing.T, tier string) *ledgerFeeService {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		limitRepo := ledgerrepos.NewMockLedgerLimitRepository(ctrl)
+		baseRepo.EXPECT().LedgerLimitRepository().Return(limitRepo).AnyTimes()
+		limitRepo.EXPECT().GetAccountTier(gomock.Any(), "acc-1").Return(tier, nil).AnyTimes()
+
+		svc := NewLedgerFeeService(baseRepo, schedule).(*ledgerFeeService)
+		svc.now = func() time.Time { return now }
+		return svc
+	}
+
+	t.Run("prices with the account tier", func(t *testing.T) {
+		quote, err := newService(t, entity.LimitTierUnverified).QuoteFee(context.Background(), command)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if quote.FeeAmount != 5000 || quote.Tier != entity.LimitTierUnverified || quote.Currency != "VND" {
+			t.Fatalf("unexpected quote %+v", quote)
+		}
+
+		quote, err = newService(t, entity.LimitTierBusiness).QuoteFee(context.Background(), command)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if quote.FeeAmount != 0 {
+			t.Fatalf("expected business tier to be free, got %+v", quote)
+		}
+	})
+
+	t.Run("returns a promotional quote with the total", func(t *testing.T) {
+		res, err := newService(t, entity.LimitTierVerified).GetFeeQuote(context.Background(), command)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !res.Promotional || res.FeeAmount != 0 || res.TotalAmount != 1000000 || res.QuotedAt == "" {
+			t.Fatalf("unexpected response %+v", res)
+		}
+	})
+
+	t.Run("rejects unknown operations", func(t *testing.T) {
+		invalid := command
+		invalid.Operation = "payout"
+		_, err := newService(t, entity.LimitTierUnverified).QuoteFee(context.Background(), invalid)
+		if !errors.Is(err, ErrValidation) || !errors.Is(err, finance.ErrFeeOperationInvalid) {
+			t.Fatalf("expected invalid operation error, got %v", err)
+		}
+	})
+}
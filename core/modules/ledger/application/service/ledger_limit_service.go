@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// ReserveLimitCommand counts one operation against an account's limits.
+// ReferenceID identifies the operation; reserving it again is a no-op.
+type ReserveLimitCommand struct {
+	AccountID   string
+	Operation   string
+	Currency    string
+	Amount      int64
+	ReferenceID string
+}
+
+//go:generate mockgen -package=service -destination=ledger_limit_service_mock.go -source=ledger_limit_service.go
+type LedgerLimitService interface {
+	ReserveLimit(ctx context.Context, command ReserveLimitCommand) error
+	// ReleaseLimit gives back a reservation whose operation did not go
+	// through.
+	ReleaseLimit(ctx context.Context, operation, referenceID string) error
+	GetAccountLimits(ctx context.Context, accountID string) (*ledgerout.AccountLimitsResponse, error)
+	SetAccountTier(ctx context.Context, accountID, tier string) (*ledgerout.AccountLimitsResponse, error)
+}
+
+type ledgerLimitService struct {
+	baseRepo ledgerrepos.Repos
+	policy   entity.LimitPolicy
+	now      func() time.Time
+}
+
+// NewLedgerLimitService enforces policy for operations booked outside the
+// ledger, such as provider top-ups and withdrawals, and manages account
+// tiers.
+func NewLedgerLimitService(baseRepo ledgerrepos.Repos, policy entity.LimitPolicy) LedgerLimitService {
+	return &ledgerLimitService{
+		baseRepo: baseRepo,
+		policy:   policy,
+		now:      time.Now,
+	}
+}
+
+func (s *ledgerLimitService) ReserveLimit(ctx context.Context, command ReserveLimitCommand) error {
+	operation, err := entity.NormalizeLimitOperation(command.Operation)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+	usage := entity.LimitUsage{
+		AccountID:   strings.TrimSpace(command.AccountID),
+		Operation:   operation,
+		Currency:    finance.NormalizeCurrency(command.Currency),
+		Amount:      command.Amount,
+		ReferenceID: strings.TrimSpace(command.ReferenceID),
+		CreatedAt:   s.now().UTC(),
+	}
+	if usage.AccountID == "" || usage.ReferenceID == "" || usage.Currency == "" || usage.Amount <= 0 {
+		return stackErr.Error(fmt.Errorf("%w: account_id, reference_id, currency and a positive amount are required", ErrValidation))
+	}
+
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos ledgerrepos.Repos) error {
+		return reserveLimit(ctx, txRepos.LedgerLimitRepository(), s.policy, usage)
+	}))
+}
+
+func (s *ledgerLimitService) ReleaseLimit(ctx context.Context, operation, referenceID string) error {
+	operation, err := entity.NormalizeLimitOperation(operation)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+	return stackErr.Error(s.baseRepo.LedgerLimitRepository().DeleteUsage(ctx, operation, strings.TrimSpace(referenceID)))
+}
+
+func (s *ledgerLimitService) GetAccountLimits(ctx context.Context, accountID string) (*ledgerout.AccountLimitsResponse, error) {
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		return nil, stackErr.Error(fmt.Errorf("%w: account_id is required", ErrValidation))
+	}
+
+	repo := s.baseRepo.LedgerLimitRepository()
+	tier, err := repo.GetAccountTier(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := s.now().UTC()
+	keys := make([]string, 0, len(s.policy.Tiers[tier]))
+	for key := range s.policy.Tiers[tier] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	response := &ledgerout.AccountLimitsResponse{
+		AccountID: accountID,
+		Tier:      tier,
+		Limits:    make([]ledgerout.AccountLimitResponse, 0, len(keys)),
+		Velocity:  make([]ledgerout.AccountVelocityResponse, 0, len(s.policy.Velocity)),
+	}
+	for _, key := range keys {
+		operation, currency, _ := strings.Cut(key, "/")
+		caps := s.policy.Tiers[tier][key]
+		usedToday, err := repo.SumUsage(ctx, accountID, operation, currency, entity.LimitDayStart(now))
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		usedThisMonth, err := repo.SumUsage(ctx, accountID, operation, currency, entity.LimitMonthStart(now))
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		response.Limits = append(response.Limits, ledgerout.AccountLimitResponse{
+			Operation:     operation,
+			Currency:      currency,
+			Single:        caps.Single,
+			Daily:         caps.Daily,
+			Monthly:       caps.Monthly,
+			UsedToday:     usedToday,
+			UsedThisMonth: usedThisMonth,
+		})
+	}
+	for _, rule := range s.policy.Velocity {
+		recent, err := repo.CountUsage(ctx, accountID, rule.Operation, now.Add(-rule.Window))
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		response.Velocity = append(response.Velocity, ledgerout.AccountVelocityResponse{
+			Operation:     rule.Operation,
+			MaxCount:      rule.MaxCount,
+			WindowSeconds: int64(rule.Window / time.Second),
+			RecentCount:   recent,
+		})
+	}
+	return response, nil
+}
+
+func (s *ledgerLimitService) SetAccountTier(ctx context.Context, accountID, tier string) (*ledgerout.AccountLimitsResponse, error) {
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" || entity.IsSystemAccount(accountID) {
+		return nil, stackErr.Error(fmt.Errorf("%w: account_id must be a user account", ErrValidation))
+	}
+	tier, err := entity.NormalizeLimitTier(tier)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+
+	if err := s.baseRepo.LedgerLimitRepository().SetAccountTier(ctx, accountID, tier, s.now().UTC()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return s.GetAccountLimits(ctx, accountID)
+}
+
+// reserveTransferLimit counts a transfer out of accountID against its limits
+// inside the posting transaction. System accounts are not limited.
+func (s *ledgerService) reserveTransferLimit(
+	ctx context.Context,
+	txRepos ledgerrepos.Repos,
+	accountID string,
+	currency string,
+	amount int64,
+	referenceID string,
+) error {
+	if s.limits == nil || entity.IsSystemAccount(accountID) {
+		return nil
+	}
+	return reserveLimit(ctx, txRepos.LedgerLimitRepository(), *s.limits, entity.LimitUsage{
+		AccountID:   accountID,
+		Operation:   entity.LimitOperationTransfer,
+		Currency:    finance.NormalizeCurrency(currency),
+		Amount:      amount,
+		ReferenceID: referenceID,
+		CreatedAt:   time.Now().UTC(),
+	})
+}
+
+// reserveLimit records usage and checks it against the account's tier caps
+// and the velocity rules. It must run inside a transaction: the tier row lock
+// serializes reservations for one account, and a rejected reservation is
+// rolled back with it.
+func reserveLimit(ctx context.Context, repo ledgerrepos.LedgerLimitRepository, policy entity.LimitPolicy, usage entity.LimitUsage) error {
+	tier, err := repo.LockAccountTier(ctx, usage.AccountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	recorded, err := repo.RecordUsage(ctx, usage)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !recorded {
+		return nil
+	}
+
+	if _, ok := policy.Caps(tier, usage.Operation, usage.Currency); ok {
+		daily, err := repo.SumUsage(ctx, usage.AccountID, usage.Operation, usage.Currency, entity.LimitDayStart(usage.CreatedAt))
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		monthly, err := repo.SumUsage(ctx, usage.AccountID, usage.Operation, usage.Currency, entity.LimitMonthStart(usage.CreatedAt))
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		// The sums already include usage itself.
+		if err := policy.CheckCaps(tier, usage, entity.LimitTotals{
+			Daily:   daily - usage.Amount,
+			Monthly: monthly - usage.Amount,
+		}); err != nil {
+			return stackErr.Error(fmt.Errorf("%w: %w", ErrLimitExceeded, err))
+		}
+	}
+
+	for _, rule := range policy.VelocityRules(usage.Operation) {
+		count, err := repo.CountUsage(ctx, usage.AccountID, usage.Operation, usage.CreatedAt.Add(-rule.Window))
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if count > rule.MaxCount {
+			return stackErr.Error(fmt.Errorf(
+				"%w: %w: at most %d %s operations per %s",
+				ErrLimitExceeded,
+				entity.ErrLimitVelocityExceeded,
+				rule.MaxCount,
+				usage.Operation,
+				rule.Window,
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_limit_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=ledger_limit_service_mock.go -source=ledger_limit_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	out "wechat-clone/core/modules/ledger/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerLimitService is a mock of LedgerLimitService interface.
+type MockLedgerLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerLimitServiceMockRecorder is the mock recorder for MockLedgerLimitService.
+type MockLedgerLimitServiceMockRecorder struct {
+	mock *MockLedgerLimitService
+}
+
+// NewMockLedgerLimitService creates a new mock instance.
+func NewMockLedgerLimitService(ctrl *gomock.Controller) *MockLedgerLimitService {
+	mock := &MockLedgerLimitService{ctrl: ctrl}
+	mock.recorder = &MockLedgerLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerLimitService) EXPECT() *MockLedgerLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAccountLimits mocks base method.
+func (m *MockLedgerLimitService) GetAccountLimits(ctx context.Context, accountID string) (*out.AccountLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountLimits", ctx, accountID)
+	ret0, _ := ret[0].(*out.AccountLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountLimits indicates an expected call of GetAccountLimits.
+func (mr *MockLedgerLimitServiceMockRecorder) GetAccountLimits(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLimits", reflect.TypeOf((*MockLedgerLimitService)(nil).GetAccountLimits), ctx, accountID)
+}
+
+// ReleaseLimit mocks base method.
+func (m *MockLedgerLimitService) ReleaseLimit(ctx context.Context, operation, referenceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLimit", ctx, operation, referenceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLimit indicates an expected call of ReleaseLimit.
+func (mr *MockLedgerLimitServiceMockRecorder) ReleaseLimit(ctx, operation, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLimit", reflect.TypeOf((*MockLedgerLimitService)(nil).ReleaseLimit), ctx, operation, referenceID)
+}
+
+// ReserveLimit mocks base method.
+func (m *MockLedgerLimitService) ReserveLimit(ctx context.Context, command ReserveLimitCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveLimit", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveLimit indicates an expected call of ReserveLimit.
+func (mr *MockLedgerLimitServiceMockRecorder) ReserveLimit(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveLimit", reflect.TypeOf((*MockLedgerLimitService)(nil).ReserveLimit), ctx, command)
+}
+
+// SetAccountTier mocks base method.
+func (m *MockLedgerLimitService) SetAccountTier(ctx context.Context, accountID, tier string) (*out.AccountLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountTier", ctx, accountID, tier)
+	ret0, _ := ret[0].(*out.AccountLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAccountTier indicates an expected call of SetAccountTier.
+func (mr *MockLedgerLimitServiceMockRecorder) SetAccountTier(ctx, accountID, tier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountTier", reflect.TypeOf((*MockLedgerLimitService)(nil).SetAccountTier), ctx, accountID, tier)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestLedgerLimitServiceReserveLimit(t *testing.T) {
+	now := time.Date(2026, 5, 12, 9, 30, 0, 0, time.UTC)
+	policy := entity.LimitPolicy{
+		Tiers: map[string]map[string]entity.LimitCaps{
+			entity.LimitTierUnverified: {"withdrawal/VND": {Single: 1000, Daily: 2000, Monthly: 5000}},
+		},
+		Velocity: []entity.VelocityRule{{Operation: entity.LimitOperationWithdrawal, MaxCount: 2, Window: time.Hour}},
+	}
+	command := ReserveLimitCommand{
+		AccountID:   "acc-1",
+		Operation:   "withdrawal",
+		Currency:    "vnd",
+		Amount:      800,
+		ReferenceID: "pay-1",
+	}
+
+	newService := func(t *testing.T) (*ledgerLimitService, *ledgerrepos.MockLedgerLimitRepository) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		limitRepo := ledgerrepos.NewMockLedgerLimitRepository(ctrl)
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerLimitRepository().Return(limitRepo).AnyTimes()
+		limitRepo.EXPECT().LockAccountTier(gomock.Any(), "acc-1").Return(entity.LimitTierUnverified, nil)
+
+		svc := NewLedgerLimitService(baseRepo, policy).(*ledgerLimitService)
+		svc.now = func() time.Time { return now }
+		return svc, limitRepo
+	}
+
+	t.Run("records usage within caps and velocity", func(t *testing.T) {
+		svc, limitRepo := newService(t)
+		limitRepo.EXPECT().RecordUsage(gomock.Any(), entity.LimitUsage{
+			AccountID:   "acc-1",
+			Operation:   entity.LimitOperationWithdrawal,
+			Currency:    "VND",
+			Amount:      800,
+			ReferenceID: "pay-1",
+			CreatedAt:   now,
+		}).Return(true, nil)
+		limitRepo.EXPECT().SumUsage(gomock.Any(), "acc-1", "withdrawal", "VND", entity.LimitDayStart(now)).Return(int64(1800), nil)
+		limitRepo.EXPECT().SumUsage(gomock.Any(), "acc-1", "withdrawal", "VND", entity.LimitMonthStart(now)).Return(int64(4000), nil)
+		limitRepo.EXPECT().CountUsage(gomock.Any(), "acc-1", "withdrawal", now.Add(-time.Hour)).Return(2, nil)
+
+		if err := svc.ReserveLimit(context.Background(), command); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("retried reservation is not counted twice", func(t *testing.T) {
+		svc, limitRepo := newService(t)
+		limitRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		if err := svc.ReserveLimit(context.Background(), command); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects usage over the daily cap", func(t *testing.T) {
+		svc, limitRepo := newService(t)
+		limitRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(true, nil)
+		limitRepo.EXPECT().SumUsage(gomock.Any(), "acc-1", "withdrawal", "VND", entity.LimitDayStart(now)).Return(int64(2100), nil)
+		limitRepo.EXPECT().SumUsage(gomock.Any(), "acc-1", "withdrawal", "VND", entity.LimitMonthStart(now)).Return(int64(2100), nil)
+
+		err := svc.ReserveLimit(context.Background(), command)
+		if !errors.Is(err, ErrLimitExceeded) || !errors.Is(err, entity.ErrLimitDailyExceeded) {
+			t.Fatalf("expected daily limit error, got %v", err)
+		}
+	})
+
+	t.Run("rejects bursts over the velocity rule", func(t *testing.T) {
+		svc, limitRepo := newService(t)
+		limitRepo.EXPECT().RecordUsage(gomock.Any(), gomock.Any()).Return(true, nil)
+		limitRepo.EXPECT().SumUsage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(800), nil).Times(2)
+		limitRepo.EXPECT().CountUsage(gomock.Any(), "acc-1", "withdrawal", now.Add(-time.Hour)).Return(3, nil)
+
+		err := svc.ReserveLimit(context.Background(), command)
+		if !errors.Is(err, ErrLimitExceeded) || !errors.Is(err, entity.ErrLimitVelocityExceeded) {
+			t.Fatalf("expected velocity limit error, got %v", err)
+		}
+	})
+}
+
+func TestLedgerLimitServiceSetAccountTierRejectsSystemAccounts(t *testing.T) {
+	svc := NewLedgerLimitService(nil, entity.LimitPolicy{})
+
+	_, err := svc.SetAccountTier(context.Background(), "ledger:clearing:provider:stripe", entity.LimitTierBusiness)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
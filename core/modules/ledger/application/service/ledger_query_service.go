@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,8 +19,13 @@ type LedgerQueryService interface {
 	GetAccountBalance(ctx context.Context, accountID, currency string) (*ledgerout.AccountBalanceResponse, error)
 	GetTransaction(ctx context.Context, transactionID string) (*ledgerout.TransactionResponse, error)
 	ListTransactions(ctx context.Context, accountID, cursor, currency string, limit int) (*ledgerout.ListTransactionResponse, error)
+	GetStatement(ctx context.Context, accountID, currency string, from, to time.Time) (*ledgerout.LedgerStatementResponse, error)
 }
 
+// maxStatementRange bounds a statement so a single request can't pull an
+// account's whole history into memory.
+const maxStatementRange = 366 * 24 * time.Hour
+
 type ledgerQueryService struct {
 	readRepo ledgerprojection.ReadRepository
 }
@@ -58,7 +64,7 @@ func (s *ledgerQueryService) GetTransaction(ctx context.Context, transactionID s
 
 	transaction, err := s.readRepo.GetTransaction(ctx, transactionID)
 	if errors.Is(err, ledgerrepos.ErrNotFound) {
-		return nil, stackErr.Error(fmt.Errorf("%v: %s", ErrTransactionNotFound, transactionID))
+		return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrTransactionNotFound, transactionID))
 	}
 	if err != nil {
 		return nil, stackErr.Error(err)
@@ -181,3 +187,125 @@ func (s *ledgerQueryService) ListTransactions(
 		Records:    records,
 	}, nil
 }
+
+// ParseStatementRange reads a statement's bounds from RFC 3339 timestamps or
+// YYYY-MM-DD dates. A date-only to includes that whole day.
+func ParseStatementRange(from, to string) (time.Time, time.Time, error) {
+	fromAt, _, err := parseStatementBound(from)
+	if err != nil {
+		return time.Time{}, time.Time{}, stackErr.Error(fmt.Errorf("%w: invalid from", ErrValidation))
+	}
+	toAt, dateOnly, err := parseStatementBound(to)
+	if err != nil {
+		return time.Time{}, time.Time{}, stackErr.Error(fmt.Errorf("%w: invalid to", ErrValidation))
+	}
+	if dateOnly {
+		toAt = toAt.AddDate(0, 0, 1)
+	}
+	return fromAt, toAt, nil
+}
+
+func parseStatementBound(value string) (time.Time, bool, error) {
+	value = strings.TrimSpace(value)
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed.UTC(), true, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return parsed.UTC(), false, nil
+}
+
+// GetStatement lists the account's entries booked in [from, to) oldest first.
+// Running balances start from the balance booked before from, so they match
+// what LedgerAccountAggregate reports after replaying the same postings.
+func (s *ledgerQueryService) GetStatement(
+	ctx context.Context,
+	accountID string,
+	currency string,
+	from time.Time,
+	to time.Time,
+) (*ledgerout.LedgerStatementResponse, error) {
+	accountID = strings.TrimSpace(accountID)
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	from = from.UTC()
+	to = to.UTC()
+
+	if accountID == "" {
+		return nil, stackErr.Error(fmt.Errorf("%w: account_id is required", ErrValidation))
+	}
+	if currency == "" {
+		return nil, stackErr.Error(fmt.Errorf("%w: currency is required", ErrValidation))
+	}
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		return nil, stackErr.Error(fmt.Errorf("%w: from must be before to", ErrValidation))
+	}
+	if to.Sub(from) > maxStatementRange {
+		return nil, stackErr.Error(fmt.Errorf("%w: statement range must not exceed %d days", ErrValidation, int(maxStatementRange.Hours()/24)))
+	}
+
+	openingBalance, err := s.readRepo.GetBalanceBefore(ctx, accountID, currency, from)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	entries, err := s.readRepo.ListStatementEntries(ctx, ledgerprojection.StatementEntriesFilter{
+		AccountID: accountID,
+		Currency:  currency,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	statement := &ledgerout.LedgerStatementResponse{
+		AccountID:      accountID,
+		Currency:       currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		Entries:        make([]ledgerout.LedgerStatementEntryResponse, 0, len(entries)),
+		Totals:         make([]ledgerout.LedgerStatementTotalResponse, 0),
+	}
+
+	balance := openingBalance
+	totalIndex := make(map[string]int)
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		balance += entry.Amount
+		statement.Entries = append(statement.Entries, ledgerout.LedgerStatementEntryResponse{
+			ID:             entry.ID,
+			TransactionID:  entry.TransactionID,
+			ReferenceType:  entry.ReferenceType,
+			ReferenceID:    entry.ReferenceID,
+			Amount:         entry.Amount,
+			RunningBalance: balance,
+			CreatedAt:      entry.CreatedAt,
+		})
+
+		index, ok := totalIndex[entry.ReferenceType]
+		if !ok {
+			index = len(statement.Totals)
+			totalIndex[entry.ReferenceType] = index
+			statement.Totals = append(statement.Totals, ledgerout.LedgerStatementTotalResponse{ReferenceType: entry.ReferenceType})
+		}
+		total := &statement.Totals[index]
+		if entry.Amount > 0 {
+			total.Credit += entry.Amount
+		} else {
+			total.Debit -= entry.Amount
+		}
+		total.Net += entry.Amount
+		total.Count++
+	}
+	statement.ClosingBalance = balance
+
+	sort.Slice(statement.Totals, func(i, j int) bool {
+		return statement.Totals[i].ReferenceType < statement.Totals[j].ReferenceType
+	})
+
+	return statement, nil
+}
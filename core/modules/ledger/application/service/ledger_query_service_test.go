@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	ledgerprojection "wechat-clone/core/modules/ledger/application/projection"
+	ledgeraggregate "wechat-clone/core/modules/ledger/domain/aggregate"
 	"wechat-clone/core/modules/ledger/domain/entity"
+	sharedevents "wechat-clone/core/shared/contracts/events"
 	"wechat-clone/core/shared/utils"
 
 	"go.uber.org/mock/gomock"
@@ -129,3 +132,123 @@ func TestLedgerQueryServiceGetAccountBalanceReadsProjectedBalance(t *testing.T)
 		t.Fatalf("unexpected balance: %d", response.Balance)
 	}
 }
+
+func TestLedgerQueryServiceGetStatement(t *testing.T) {
+	t.Run("running balances match the account aggregate", func(t *testing.T) {
+		day := func(d int) time.Time { return time.Date(2026, 4, d, 9, 0, 0, 0, time.UTC) }
+
+		agg, err := ledgeraggregate.NewLedgerAccountAggregate("acc-1")
+		if err != nil {
+			t.Fatalf("NewLedgerAccountAggregate() error = %v", err)
+		}
+		mustBook := func(_ bool, err error) {
+			t.Helper()
+			if err != nil {
+				t.Fatalf("book posting: %v", err)
+			}
+		}
+		mustBook(agg.BookPayment("payment:pay-1:succeeded", "pay-1", "ledger:clearing:provider:stripe", "VND", 1000, day(1)))
+		mustBook(agg.TransferToAccount("tx-transfer-1", "acc-2", "VND", 300, day(2)))
+		mustBook(agg.BookPayment("payment:pay-2:succeeded", "pay-2", "ledger:clearing:provider:stripe", "VND", 500, day(3)))
+		mustBook(agg.ReversePayment("payment:pay-2:refunded", sharedevents.EventPaymentRefunded, "pay-2", "ledger:clearing:provider:stripe", "VND", -200, day(4)))
+
+		// Project the aggregate's events the way the ledger projection does.
+		var opening int64
+		inRange := make([]*entity.LedgerEntry, 0)
+		for i, evt := range agg.Events() {
+			posting, ok, err := ledgeraggregate.NewLedgerAccountPostingFromEvent("acc-1", evt.EventData)
+			if err != nil || !ok {
+				t.Fatalf("NewLedgerAccountPostingFromEvent() ok=%v error=%v", ok, err)
+			}
+			if posting.BookedAt.Before(day(2)) {
+				opening += posting.AmountDelta
+				continue
+			}
+			inRange = append(inRange, &entity.LedgerEntry{
+				ID:            int64(i + 1),
+				TransactionID: posting.TransactionID,
+				AccountID:     "acc-1",
+				Currency:      posting.Currency,
+				Amount:        posting.AmountDelta,
+				ReferenceType: posting.ReferenceType,
+				ReferenceID:   posting.ReferenceID,
+				CreatedAt:     posting.BookedAt,
+			})
+		}
+
+		ctrl := gomock.NewController(t)
+		readRepo := ledgerprojection.NewMockReadRepository(ctrl)
+		readRepo.EXPECT().GetBalanceBefore(gomock.Any(), "acc-1", "VND", day(2)).Return(opening, nil)
+		readRepo.EXPECT().
+			ListStatementEntries(gomock.Any(), ledgerprojection.StatementEntriesFilter{
+				AccountID: "acc-1",
+				Currency:  "VND",
+				From:      day(2),
+				To:        day(5),
+			}).
+			Return(inRange, nil)
+
+		statement, err := NewLedgerQueryService(readRepo).GetStatement(context.Background(), "acc-1", "vnd", day(2), day(5))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if statement.OpeningBalance != 1000 {
+			t.Fatalf("expected opening balance 1000, got %d", statement.OpeningBalance)
+		}
+		if statement.ClosingBalance != agg.Balance("VND") {
+			t.Fatalf("expected closing balance %d to match aggregate, got %d", agg.Balance("VND"), statement.ClosingBalance)
+		}
+		wantRunning := []int64{700, 1200, 1000}
+		if len(statement.Entries) != len(wantRunning) {
+			t.Fatalf("expected %d entries, got %d", len(wantRunning), len(statement.Entries))
+		}
+		for i, want := range wantRunning {
+			if statement.Entries[i].RunningBalance != want {
+				t.Fatalf("entry %d: expected running balance %d, got %d", i, want, statement.Entries[i].RunningBalance)
+			}
+		}
+
+		totals := make(map[string]int64)
+		for _, total := range statement.Totals {
+			if total.Net != total.Credit-total.Debit {
+				t.Fatalf("expected net to equal credit minus debit for %s", total.ReferenceType)
+			}
+			totals[total.ReferenceType] = total.Net
+		}
+		if totals[entity.LedgerReferenceInternalTransfer] != -300 ||
+			totals[ledgeraggregate.EventNameLedgerAccountDepositFromIntent] != 500 ||
+			totals[ledgeraggregate.EventNameLedgerAccountWithdrawFromRefund] != -200 {
+			t.Fatalf("unexpected reference type totals %+v", statement.Totals)
+		}
+	})
+
+	t.Run("rejects ranges longer than a year", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		readRepo := ledgerprojection.NewMockReadRepository(ctrl)
+
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		_, err := NewLedgerQueryService(readRepo).GetStatement(context.Background(), "acc-1", "VND", from, from.AddDate(2, 0, 0))
+		if !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestParseStatementRangeIncludesWholeEndDate(t *testing.T) {
+	from, to, err := ParseStatementRange("2026-04-01", "2026-04-30")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !from.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected range %s - %s", from, to)
+	}
+
+	_, to, err = ParseStatementRange("2026-04-01T00:00:00Z", "2026-04-30T12:00:00+07:00")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !to.Equal(time.Date(2026, 4, 30, 5, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected RFC 3339 bound to be kept as is, got %s", to)
+	}
+}
@@ -45,6 +45,34 @@ type RecordPaymentReversedCommand struct {
 	ReversalType       string
 }
 
+// ConvertCurrencyCommand books a conversion. FeeAmount is charged in
+// FromCurrency on top of FromAmount and credited to FeeAccountID.
+type ConvertCurrencyCommand struct {
+	ConversionID     string
+	AccountID        string
+	HouseAccountID   string
+	RevenueAccountID string
+	FeeAccountID     string
+	FromCurrency     string
+	FromAmount       int64
+	ToCurrency       string
+	ToAmount         int64
+	SpreadAmount     int64
+	FeeAmount        int64
+	BookedAt         time.Time
+}
+
+// TransferHoldCommand moves Amount between an account and the hold account
+// reserved for one transfer. BookedAt should be stable across retries.
+type TransferHoldCommand struct {
+	TransferID    string
+	AccountID     string
+	HoldAccountID string
+	Currency      string
+	Amount        int64
+	BookedAt      time.Time
+}
+
 type RecordLedgerEventsCommand struct {
 	Events []eventpkg.Event
 }
@@ -68,6 +96,9 @@ const LedgerAccountLockKeyPrefix = "ledger-account"
 //go:generate mockgen -package=service -destination=ledger_service_mock.go -source=ledger_service.go
 type LedgerService interface {
 	TransferToAccount(ctx context.Context, command TransferToAccountCommand) (*entity.LedgerTransaction, error)
+	ConvertCurrency(ctx context.Context, command ConvertCurrencyCommand) ([]*entity.LedgerTransaction, error)
+	HoldTransfer(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error)
+	ReleaseTransferHold(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error)
 	RecordLedgerEvents(ctx context.Context, command RecordLedgerEventsCommand) error
 	RecordPaymentSucceeded(ctx context.Context, command RecordPaymentSucceededCommand) error
 	RecordPaymentReversed(ctx context.Context, command RecordPaymentReversedCommand) error
@@ -76,6 +107,17 @@ type LedgerService interface {
 
 type ledgerService struct {
 	baseRepo ledgerrepos.Repos
+	limits   *entity.LimitPolicy
+}
+
+type LedgerServiceOption func(*ledgerService)
+
+// WithLimitPolicy makes transfers and transfer holds out of user accounts
+// count against the account's tier caps and velocity rules.
+func WithLimitPolicy(policy entity.LimitPolicy) LedgerServiceOption {
+	return func(s *ledgerService) {
+		s.limits = &policy
+	}
 }
 
 type expectedLedgerPosting struct {
@@ -85,8 +127,12 @@ type expectedLedgerPosting struct {
 
 type loadedLedgerAccounts map[string]*ledgeraggregate.LedgerAccountAggregate
 
-func NewLedgerService(baseRepo ledgerrepos.Repos) *ledgerService {
-	return &ledgerService{baseRepo: baseRepo}
+func NewLedgerService(baseRepo ledgerrepos.Repos, opts ...LedgerServiceOption) *ledgerService {
+	s := &ledgerService{baseRepo: baseRepo}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *ledgerService) TransferToAccount(ctx context.Context, command TransferToAccountCommand) (*entity.LedgerTransaction, error) {
@@ -188,6 +234,10 @@ func (s *ledgerService) TransferToAccount(ctx context.Context, command TransferT
 	}
 
 	if err := s.baseRepo.WithTransaction(ctx, func(txRepos ledgerrepos.Repos) error {
+		if err := s.reserveTransferLimit(ctx, txRepos, booking.FromAccountID, transaction.Currency, booking.Amount, transaction.TransactionID); err != nil {
+			return stackErr.Error(err)
+		}
+
 		accountIDs := []string{booking.FromAccountID, booking.ToAccountID}
 		if feeTransaction != nil {
 			accountIDs = append(accountIDs, feeAccountID)
@@ -309,6 +359,247 @@ func (s *ledgerService) TransferToAccount(ctx context.Context, command TransferT
 	return transaction, nil
 }
 
+// ConvertCurrency books a quoted conversion as single-currency transactions
+// sharing the conversion id: the source leg moves FromAmount from the account
+// to the FX house, the target leg moves ToAmount from the house back to the
+// account, and the spread leg moves the realized spread from the house to the
+// revenue account. All legs are applied atomically and replays are no-ops.
+func (s *ledgerService) ConvertCurrency(ctx context.Context, command ConvertCurrencyCommand) ([]*entity.LedgerTransaction, error) {
+	conversionID := strings.TrimSpace(command.ConversionID)
+	accountID := strings.TrimSpace(command.AccountID)
+	houseAccountID := strings.TrimSpace(command.HouseAccountID)
+	revenueAccountID := strings.TrimSpace(command.RevenueAccountID)
+	feeAccountID := strings.TrimSpace(command.FeeAccountID)
+	switch {
+	case conversionID == "":
+		return nil, stackErr.Error(fmt.Errorf("%w: conversion_id is required", ErrValidation))
+	case accountID == "" || houseAccountID == "":
+		return nil, stackErr.Error(fmt.Errorf("%w: account_id and house_account_id are required", ErrValidation))
+	case command.SpreadAmount < 0:
+		return nil, stackErr.Error(fmt.Errorf("%w: spread_amount must be greater than or equal to 0", ErrValidation))
+	case command.SpreadAmount > 0 && revenueAccountID == "":
+		return nil, stackErr.Error(fmt.Errorf("%w: revenue_account_id is required when spread_amount > 0", ErrValidation))
+	case command.FeeAmount < 0:
+		return nil, stackErr.Error(fmt.Errorf("%w: fee_amount must be greater than or equal to 0", ErrValidation))
+	case command.FeeAmount > 0 && feeAccountID == "":
+		return nil, stackErr.Error(fmt.Errorf("%w: fee_account_id is required when fee_amount > 0", ErrValidation))
+	case strings.EqualFold(strings.TrimSpace(command.FromCurrency), strings.TrimSpace(command.ToCurrency)):
+		return nil, stackErr.Error(fmt.Errorf("%w: from_currency and to_currency must differ", ErrValidation))
+	}
+	bookedAt := command.BookedAt.UTC()
+	if bookedAt.IsZero() {
+		bookedAt = time.Now().UTC()
+	}
+
+	legs := []ledgerLeg{
+		{
+			transactionID: fmt.Sprintf("fx:conversion:%s:source", conversionID),
+			debitAccount:  accountID,
+			debitType:     ledgeraggregate.EventNameLedgerAccountWithdrawForConversion,
+			creditAccount: houseAccountID,
+			creditType:    ledgeraggregate.EventNameLedgerAccountReceiveConversionPosition,
+			currency:      command.FromCurrency,
+			amount:        command.FromAmount,
+		},
+		{
+			transactionID: fmt.Sprintf("fx:conversion:%s:target", conversionID),
+			debitAccount:  houseAccountID,
+			debitType:     ledgeraggregate.EventNameLedgerAccountReleaseConversionPosition,
+			creditAccount: accountID,
+			creditType:    ledgeraggregate.EventNameLedgerAccountDepositFromConversion,
+			currency:      command.ToCurrency,
+			amount:        command.ToAmount,
+		},
+	}
+	if command.SpreadAmount > 0 {
+		legs = append(legs, ledgerLeg{
+			transactionID: fmt.Sprintf("fx:conversion:%s:spread", conversionID),
+			debitAccount:  houseAccountID,
+			debitType:     ledgeraggregate.EventNameLedgerAccountReleaseConversionPosition,
+			creditAccount: revenueAccountID,
+			creditType:    ledgeraggregate.EventNameLedgerAccountRecognizeConversionSpread,
+			currency:      command.ToCurrency,
+			amount:        command.SpreadAmount,
+		})
+	}
+	if command.FeeAmount > 0 {
+		legs = append(legs, ledgerLeg{
+			transactionID: fmt.Sprintf("fx:conversion:%s:fee", conversionID),
+			debitAccount:  accountID,
+			debitType:     ledgeraggregate.EventNameLedgerAccountTransferredToAccount,
+			creditAccount: feeAccountID,
+			creditType:    ledgeraggregate.EventNameLedgerAccountReceivedTransfer,
+			currency:      command.FromCurrency,
+			amount:        command.FeeAmount,
+		})
+	}
+
+	for _, leg := range legs {
+		if leg.amount <= 0 {
+			return nil, stackErr.Error(fmt.Errorf("%w: conversion amounts must be greater than 0", ErrValidation))
+		}
+	}
+
+	return s.recordLedgerLegs(ctx, conversionID, bookedAt, legs)
+}
+
+// HoldTransfer moves a pending chat transfer from the sender's account into
+// its hold account. Replays with the same transfer id are no-ops.
+func (s *ledgerService) HoldTransfer(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	command, bookedAt, err := normalizeTransferHoldCommand(command)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return s.recordTransferHoldLeg(ctx, command.TransferID, bookedAt, true, ledgerLeg{
+		transactionID: fmt.Sprintf("transfer-hold:%s:hold", command.TransferID),
+		debitAccount:  command.AccountID,
+		debitType:     ledgeraggregate.EventNameLedgerAccountReserveTransferHold,
+		creditAccount: command.HoldAccountID,
+		creditType:    ledgeraggregate.EventNameLedgerAccountReceiveTransferHold,
+		currency:      command.Currency,
+		amount:        command.Amount,
+	})
+}
+
+// ReleaseTransferHold returns a held transfer to the sender's account. It
+// fails with ErrInsufficientFunds when the hold has already been paid out or
+// was never funded.
+func (s *ledgerService) ReleaseTransferHold(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	command, bookedAt, err := normalizeTransferHoldCommand(command)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return s.recordTransferHoldLeg(ctx, command.TransferID, bookedAt, false, ledgerLeg{
+		transactionID: fmt.Sprintf("transfer-hold:%s:release", command.TransferID),
+		debitAccount:  command.HoldAccountID,
+		debitType:     ledgeraggregate.EventNameLedgerAccountWithdrawReleasedTransferHold,
+		creditAccount: command.AccountID,
+		creditType:    ledgeraggregate.EventNameLedgerAccountReleaseTransferHold,
+		currency:      command.Currency,
+		amount:        command.Amount,
+	})
+}
+
+func normalizeTransferHoldCommand(command TransferHoldCommand) (TransferHoldCommand, time.Time, error) {
+	command.TransferID = strings.TrimSpace(command.TransferID)
+	command.AccountID = strings.TrimSpace(command.AccountID)
+	command.HoldAccountID = strings.TrimSpace(command.HoldAccountID)
+	switch {
+	case command.TransferID == "":
+		return command, time.Time{}, stackErr.Error(fmt.Errorf("%w: transfer_id is required", ErrValidation))
+	case command.AccountID == "" || command.HoldAccountID == "":
+		return command, time.Time{}, stackErr.Error(fmt.Errorf("%w: account_id and hold_account_id are required", ErrValidation))
+	case command.Amount <= 0:
+		return command, time.Time{}, stackErr.Error(fmt.Errorf("%w: amount must be greater than 0", ErrValidation))
+	}
+
+	bookedAt := command.BookedAt.UTC()
+	if bookedAt.IsZero() {
+		bookedAt = time.Now().UTC()
+	}
+	return command, bookedAt, nil
+}
+
+// recordTransferHoldLeg books one hold leg. When limited is set the leg's
+// debit counts as a transfer against the debit account's limits.
+func (s *ledgerService) recordTransferHoldLeg(ctx context.Context, transferID string, bookedAt time.Time, limited bool, leg ledgerLeg) (*entity.LedgerTransaction, error) {
+	var reserve func(context.Context, ledgerrepos.Repos) error
+	if limited {
+		reserve = func(ctx context.Context, txRepos ledgerrepos.Repos) error {
+			return s.reserveTransferLimit(ctx, txRepos, leg.debitAccount, leg.currency, leg.amount, leg.transactionID)
+		}
+	}
+	transactions, err := s.recordLedgerLegsWith(ctx, transferID, bookedAt, []ledgerLeg{leg}, reserve)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return transactions[0], nil
+}
+
+// recordLedgerLegs books each leg as a two-entry transaction referencing
+// referenceID and applies all postings atomically.
+func (s *ledgerService) recordLedgerLegs(
+	ctx context.Context,
+	referenceID string,
+	bookedAt time.Time,
+	legs []ledgerLeg,
+) ([]*entity.LedgerTransaction, error) {
+	return s.recordLedgerLegsWith(ctx, referenceID, bookedAt, legs, nil)
+}
+
+// recordLedgerLegsWith is recordLedgerLegs with an optional step run first
+// inside the posting transaction.
+func (s *ledgerService) recordLedgerLegsWith(
+	ctx context.Context,
+	referenceID string,
+	bookedAt time.Time,
+	legs []ledgerLeg,
+	before func(context.Context, ledgerrepos.Repos) error,
+) ([]*entity.LedgerTransaction, error) {
+	transactions := make([]*entity.LedgerTransaction, 0, len(legs))
+	postings := make([]ledgerPostingEventInput, 0, len(legs)*2)
+	for _, leg := range legs {
+		transaction, err := entity.NewLedgerTransaction(leg.transactionID, []entity.LedgerEntryInput{
+			{AccountID: leg.debitAccount, Currency: leg.currency, Amount: -leg.amount},
+			{AccountID: leg.creditAccount, Currency: leg.currency, Amount: leg.amount},
+		})
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+		transactions = append(transactions, transaction)
+
+		debitPosting, err := ledgeraggregate.NewLedgerAccountPaymentPosting(
+			valueobject.LedgerAccountPostingInput{
+				AccountID:             leg.debitAccount,
+				TransactionID:         transaction.TransactionID,
+				ReferenceType:         leg.debitType,
+				ReferenceID:           referenceID,
+				CounterpartyAccountID: leg.creditAccount,
+				Currency:              transaction.Currency,
+				AmountDelta:           -leg.amount,
+				BookedAt:              bookedAt,
+			},
+		)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		creditPosting, err := ledgeraggregate.NewLedgerAccountPaymentPosting(
+			valueobject.LedgerAccountPostingInput{
+				AccountID:             leg.creditAccount,
+				TransactionID:         transaction.TransactionID,
+				ReferenceType:         leg.creditType,
+				ReferenceID:           referenceID,
+				CounterpartyAccountID: leg.debitAccount,
+				Currency:              transaction.Currency,
+				AmountDelta:           leg.amount,
+				BookedAt:              bookedAt,
+			},
+		)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		postings = append(postings,
+			ledgerPostingEventInput{accountID: leg.debitAccount, posting: debitPosting},
+			ledgerPostingEventInput{accountID: leg.creditAccount, posting: creditPosting},
+		)
+	}
+
+	events, err := ledgerPaymentEventsFromPostings(postings)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := s.recordLedgerEvents(ctx, events, before); err != nil {
+		if errors.Is(err, ledgeraggregate.ErrLedgerAccountInsufficientFunds) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrInsufficientFunds, err))
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	return transactions, nil
+}
+
 func (s *ledgerService) RecordPaymentSucceeded(ctx context.Context, command RecordPaymentSucceededCommand) error {
 	booking, err := entity.NewPaymentSucceededBooking(entity.PaymentSucceededBookingInput{
 		PaymentID:          command.PaymentID,
@@ -494,14 +785,28 @@ func ledgerOutboxStore(baseRepo ledgerrepos.Repos) (eventpkg.Store, bool) {
 }
 
 func (s *ledgerService) RecordLedgerEvents(ctx context.Context, command RecordLedgerEventsCommand) error {
-	if len(command.Events) == 0 {
+	return s.recordLedgerEvents(ctx, command.Events, nil)
+}
+
+func (s *ledgerService) recordLedgerEvents(
+	ctx context.Context,
+	events []eventpkg.Event,
+	before func(context.Context, ledgerrepos.Repos) error,
+) error {
+	if len(events) == 0 {
 		return nil
 	}
 
 	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos ledgerrepos.Repos) error {
-		accountIDs := make([]string, 0, len(command.Events))
-		seen := make(map[string]struct{}, len(command.Events))
-		for _, evt := range command.Events {
+		if before != nil {
+			if err := before(ctx, txRepos); err != nil {
+				return stackErr.Error(err)
+			}
+		}
+
+		accountIDs := make([]string, 0, len(events))
+		seen := make(map[string]struct{}, len(events))
+		for _, evt := range events {
 			accountID := strings.TrimSpace(evt.AggregateID)
 			if accountID == "" {
 				return stackErr.Error(fmt.Errorf("ledger event aggregate_id is required"))
@@ -518,9 +823,9 @@ func (s *ledgerService) RecordLedgerEvents(ctx context.Context, command RecordLe
 			return stackErr.Error(err)
 		}
 
-		saveOrder := make([]string, 0, len(command.Events))
+		saveOrder := make([]string, 0, len(events))
 		appliedCount := 0
-		for _, evt := range command.Events {
+		for _, evt := range events {
 			accountID := strings.TrimSpace(evt.AggregateID)
 			agg := aggregates.account(accountID)
 			if agg == nil {
@@ -542,7 +847,7 @@ func (s *ledgerService) RecordLedgerEvents(ctx context.Context, command RecordLe
 		if appliedCount == 0 {
 			return nil
 		}
-		if appliedCount != len(command.Events) {
+		if appliedCount != len(events) {
 			return stackErr.Error(fmt.Errorf("ledger event application became inconsistent"))
 		}
 
@@ -558,6 +863,16 @@ func (s *ledgerService) RecordLedgerEvents(ctx context.Context, command RecordLe
 	}))
 }
 
+type ledgerLeg struct {
+	transactionID string
+	debitAccount  string
+	debitType     string
+	creditAccount string
+	creditType    string
+	currency      string
+	amount        int64
+}
+
 type ledgerPostingEventInput struct {
 	accountID string
 	posting   entity.LedgerAccountPosting
@@ -41,6 +41,36 @@ func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
 	return m.recorder
 }
 
+// ConvertCurrency mocks base method.
+func (m *MockLedgerService) ConvertCurrency(ctx context.Context, command ConvertCurrencyCommand) ([]*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertCurrency", ctx, command)
+	ret0, _ := ret[0].([]*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertCurrency indicates an expected call of ConvertCurrency.
+func (mr *MockLedgerServiceMockRecorder) ConvertCurrency(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertCurrency", reflect.TypeOf((*MockLedgerService)(nil).ConvertCurrency), ctx, command)
+}
+
+// HoldTransfer mocks base method.
+func (m *MockLedgerService) HoldTransfer(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldTransfer", ctx, command)
+	ret0, _ := ret[0].(*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldTransfer indicates an expected call of HoldTransfer.
+func (mr *MockLedgerServiceMockRecorder) HoldTransfer(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldTransfer", reflect.TypeOf((*MockLedgerService)(nil).HoldTransfer), ctx, command)
+}
+
 // RecordLedgerEvents mocks base method.
 func (m *MockLedgerService) RecordLedgerEvents(ctx context.Context, command RecordLedgerEventsCommand) error {
 	m.ctrl.T.Helper()
@@ -97,6 +127,21 @@ func (mr *MockLedgerServiceMockRecorder) RecordPaymentSucceeded(ctx, command any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPaymentSucceeded", reflect.TypeOf((*MockLedgerService)(nil).RecordPaymentSucceeded), ctx, command)
 }
 
+// ReleaseTransferHold mocks base method.
+func (m *MockLedgerService) ReleaseTransferHold(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTransferHold", ctx, command)
+	ret0, _ := ret[0].(*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTransferHold indicates an expected call of ReleaseTransferHold.
+func (mr *MockLedgerServiceMockRecorder) ReleaseTransferHold(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTransferHold", reflect.TypeOf((*MockLedgerService)(nil).ReleaseTransferHold), ctx, command)
+}
+
 // TransferToAccount mocks base method.
 func (m *MockLedgerService) TransferToAccount(ctx context.Context, command TransferToAccountCommand) (*entity.LedgerTransaction, error) {
 	m.ctrl.T.Helper()
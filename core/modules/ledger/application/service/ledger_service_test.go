@@ -7,6 +7,7 @@ import (
 	"time"
 
 	ledgeraggregate "wechat-clone/core/modules/ledger/domain/aggregate"
+	"wechat-clone/core/modules/ledger/domain/entity"
 	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
 	valueobject "wechat-clone/core/modules/ledger/domain/value_object"
 	sharedevents "wechat-clone/core/shared/contracts/events"
@@ -156,6 +157,43 @@ func TestLedgerServiceTransferToAccount(t *testing.T) {
 			t.Fatalf("expected duplicate transfer to be idempotent, got %v", err)
 		}
 	})
+	t.Run("rejects transfers over the sender's limits before posting", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		limitRepo := ledgerrepos.NewMockLedgerLimitRepository(ctrl)
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerLimitRepository().Return(limitRepo).AnyTimes()
+		limitRepo.EXPECT().LockAccountTier(gomock.Any(), "acc-from").Return(entity.LimitTierUnverified, nil)
+		limitRepo.EXPECT().
+			RecordUsage(gomock.Any(), gomock.AssignableToTypeOf(entity.LimitUsage{})).
+			DoAndReturn(func(_ context.Context, usage entity.LimitUsage) (bool, error) {
+				if usage.Operation != entity.LimitOperationTransfer || usage.ReferenceID != "ledger-tx-3" || usage.Amount != 600 {
+					t.Fatalf("unexpected usage %+v", usage)
+				}
+				return true, nil
+			})
+		limitRepo.EXPECT().SumUsage(gomock.Any(), "acc-from", "transfer", "VND", gomock.Any()).Return(int64(600), nil).Times(2)
+
+		service := NewLedgerService(baseRepo, WithLimitPolicy(entity.LimitPolicy{Tiers: map[string]map[string]entity.LimitCaps{
+			entity.LimitTierUnverified: {"transfer/VND": {Single: 500}},
+		}}))
+		_, err := service.TransferToAccount(context.Background(), TransferToAccountCommand{
+			TransactionID: "ledger-tx-3",
+			FromAccountID: "acc-from",
+			ToAccountID:   "acc-to",
+			Currency:      "VND",
+			Amount:        600,
+		})
+		if !errors.Is(err, ErrLimitExceeded) || !errors.Is(err, entity.ErrLimitSingleExceeded) {
+			t.Fatalf("expected single limit error, got %v", err)
+		}
+	})
 }
 
 func TestLedgerServiceRecordPaymentSucceeded(t *testing.T) {
@@ -358,6 +396,199 @@ func TestLedgerServiceRecordPaymentReversed(t *testing.T) {
 	})
 }
 
+func TestLedgerServiceConvertCurrency(t *testing.T) {
+	command := ConvertCurrencyCommand{
+		ConversionID:     "conv-1",
+		AccountID:        "acc-1",
+		HouseAccountID:   "ledger:fx:house",
+		RevenueAccountID: "ledger:fx:spread-revenue",
+		FromCurrency:     "USD",
+		FromAmount:       3969,
+		ToCurrency:       "VND",
+		ToAmount:         1000000,
+		SpreadAmount:     8126,
+		BookedAt:         gomockTime(),
+	}
+
+	t.Run("books balanced legs with house position and spread revenue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		accountRepo := ledgerrepos.NewMockLedgerAccountAggregateRepository(ctrl)
+
+		accountAgg, _ := ledgeraggregate.NewLedgerAccountAggregate("acc-1")
+		accountAgg.Balances["USD"] = 5000
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerAccountAggregateRepository().Return(accountRepo).AnyTimes()
+		accountRepo.EXPECT().Load(gomock.Any(), "acc-1").Return(accountAgg, nil)
+		accountRepo.EXPECT().Load(gomock.Any(), "ledger:fx:house").Return(nil, nil)
+		accountRepo.EXPECT().Load(gomock.Any(), "ledger:fx:spread-revenue").Return(nil, nil)
+		accountRepo.EXPECT().
+			Save(gomock.Any(), gomock.AssignableToTypeOf(&ledgeraggregate.LedgerAccountAggregate{})).
+			DoAndReturn(func(_ context.Context, aggregate *ledgeraggregate.LedgerAccountAggregate) error {
+				switch aggregate.AggregateID() {
+				case "acc-1":
+					if aggregate.Balance("USD") != 1031 || aggregate.Balance("VND") != 1000000 {
+						t.Fatalf("unexpected account balances: %+v", aggregate.Balances)
+					}
+				case "ledger:fx:house":
+					if aggregate.Balance("USD") != 3969 || aggregate.Balance("VND") != -1008126 {
+						t.Fatalf("unexpected house balances: %+v", aggregate.Balances)
+					}
+				case "ledger:fx:spread-revenue":
+					if aggregate.Balance("VND") != 8126 {
+						t.Fatalf("unexpected revenue balances: %+v", aggregate.Balances)
+					}
+				default:
+					t.Fatalf("unexpected aggregate saved: %s", aggregate.AggregateID())
+				}
+				return nil
+			}).Times(3)
+
+		service := NewLedgerService(baseRepo)
+		transactions, err := service.ConvertCurrency(context.Background(), command)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(transactions) != 3 {
+			t.Fatalf("expected 3 transactions, got %d", len(transactions))
+		}
+		if transactions[0].TransactionID != "fx:conversion:conv-1:source" || transactions[0].Currency != "USD" {
+			t.Fatalf("unexpected source transaction: %+v", transactions[0])
+		}
+		if transactions[2].TransactionID != "fx:conversion:conv-1:spread" || transactions[2].Currency != "VND" {
+			t.Fatalf("unexpected spread transaction: %+v", transactions[2])
+		}
+	})
+
+	t.Run("rejects conversion the account cannot cover", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		accountRepo := ledgerrepos.NewMockLedgerAccountAggregateRepository(ctrl)
+
+		accountAgg, _ := ledgeraggregate.NewLedgerAccountAggregate("acc-1")
+		accountAgg.Balances["USD"] = 100
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerAccountAggregateRepository().Return(accountRepo).AnyTimes()
+		accountRepo.EXPECT().Load(gomock.Any(), "acc-1").Return(accountAgg, nil)
+		accountRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		service := NewLedgerService(baseRepo)
+		_, err := service.ConvertCurrency(context.Background(), command)
+		if !errors.Is(err, ErrInsufficientFunds) {
+			t.Fatalf("expected insufficient funds error, got %v", err)
+		}
+	})
+
+	t.Run("requires revenue account when spread is realized", func(t *testing.T) {
+		service := NewLedgerService(nil)
+		invalid := command
+		invalid.RevenueAccountID = ""
+		_, err := service.ConvertCurrency(context.Background(), invalid)
+		if !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("requires fee account when a fee is charged", func(t *testing.T) {
+		service := NewLedgerService(nil)
+		invalid := command
+		invalid.FeeAmount = 40
+		_, err := service.ConvertCurrency(context.Background(), invalid)
+		if !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestLedgerServiceTransferHold(t *testing.T) {
+	command := TransferHoldCommand{
+		TransferID:    "transfer-1",
+		AccountID:     "acc-1",
+		HoldAccountID: "ledger:hold:transfer:transfer-1",
+		Currency:      "USD",
+		Amount:        700,
+		BookedAt:      gomockTime(),
+	}
+
+	t.Run("moves funds from the sender into the hold account", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		accountRepo := ledgerrepos.NewMockLedgerAccountAggregateRepository(ctrl)
+
+		accountAgg, _ := ledgeraggregate.NewLedgerAccountAggregate("acc-1")
+		accountAgg.Balances["USD"] = 1000
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerAccountAggregateRepository().Return(accountRepo).AnyTimes()
+		accountRepo.EXPECT().Load(gomock.Any(), "acc-1").Return(accountAgg, nil)
+		accountRepo.EXPECT().Load(gomock.Any(), "ledger:hold:transfer:transfer-1").Return(nil, nil)
+		accountRepo.EXPECT().
+			Save(gomock.Any(), gomock.AssignableToTypeOf(&ledgeraggregate.LedgerAccountAggregate{})).
+			DoAndReturn(func(_ context.Context, aggregate *ledgeraggregate.LedgerAccountAggregate) error {
+				switch aggregate.AggregateID() {
+				case "acc-1":
+					if aggregate.Balance("USD") != 300 {
+						t.Fatalf("unexpected sender balance: %+v", aggregate.Balances)
+					}
+				case "ledger:hold:transfer:transfer-1":
+					if aggregate.Balance("USD") != 700 {
+						t.Fatalf("unexpected hold balance: %+v", aggregate.Balances)
+					}
+				default:
+					t.Fatalf("unexpected aggregate saved: %s", aggregate.AggregateID())
+				}
+				return nil
+			}).Times(2)
+
+		service := NewLedgerService(baseRepo)
+		transaction, err := service.HoldTransfer(context.Background(), command)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if transaction.TransactionID != "transfer-hold:transfer-1:hold" {
+			t.Fatalf("unexpected transaction: %+v", transaction)
+		}
+	})
+
+	t.Run("refuses to release a hold that was never funded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		baseRepo := ledgerrepos.NewMockRepos(ctrl)
+		txRepos := ledgerrepos.NewMockRepos(ctrl)
+		accountRepo := ledgerrepos.NewMockLedgerAccountAggregateRepository(ctrl)
+
+		baseRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(ledgerrepos.Repos) error) error {
+				return fn(txRepos)
+			})
+		txRepos.EXPECT().LedgerAccountAggregateRepository().Return(accountRepo).AnyTimes()
+		accountRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		service := NewLedgerService(baseRepo)
+		_, err := service.ReleaseTransferHold(context.Background(), command)
+		if !errors.Is(err, ErrInsufficientFunds) {
+			t.Fatalf("expected insufficient funds error, got %v", err)
+		}
+	})
+}
+
 func gomockTime() (out time.Time) {
 	return time.Date(2026, 4, 16, 10, 0, 0, 0, time.UTC)
 }
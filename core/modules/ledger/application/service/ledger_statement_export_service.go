@@ -0,0 +1,177 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/infra/storage"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/pkg/textpdf"
+)
+
+const (
+	StatementFormatCSV = "csv"
+	StatementFormatPDF = "pdf"
+
+	statementDownloadTTL = 15 * time.Minute
+)
+
+type ExportStatementCommand struct {
+	AccountID string
+	Currency  string
+	From      time.Time
+	To        time.Time
+	Format    string
+}
+
+//go:generate mockgen -package=service -destination=ledger_statement_export_service_mock.go -source=ledger_statement_export_service.go
+type LedgerStatementExportService interface {
+	ExportStatement(ctx context.Context, command ExportStatementCommand) (*ledgerout.LedgerStatementExportResponse, error)
+}
+
+type ledgerStatementExportService struct {
+	queryService LedgerQueryService
+	storage      storage.Storage
+	now          func() time.Time
+}
+
+// NewLedgerStatementExportService renders statements built by queryService
+// and uploads them to object storage, returning a presigned download link.
+func NewLedgerStatementExportService(queryService LedgerQueryService, objectStorage storage.Storage) LedgerStatementExportService {
+	return &ledgerStatementExportService{
+		queryService: queryService,
+		storage:      objectStorage,
+		now:          time.Now,
+	}
+}
+
+func (s *ledgerStatementExportService) ExportStatement(ctx context.Context, command ExportStatementCommand) (*ledgerout.LedgerStatementExportResponse, error) {
+	format := strings.ToLower(strings.TrimSpace(command.Format))
+	if format != StatementFormatCSV && format != StatementFormatPDF {
+		return nil, stackErr.Error(fmt.Errorf("%w: format must be %s or %s", ErrValidation, StatementFormatCSV, StatementFormatPDF))
+	}
+
+	statement, err := s.queryService.GetStatement(ctx, command.AccountID, command.Currency, command.From, command.To)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var (
+		content     []byte
+		contentType string
+	)
+	switch format {
+	case StatementFormatCSV:
+		content, err = renderStatementCSV(statement)
+		contentType = "text/csv"
+	case StatementFormatPDF:
+		content = textpdf.Render(statementPDFLines(statement))
+		contentType = "application/pdf"
+	}
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := s.now().UTC()
+	objectKey := fmt.Sprintf(
+		"ledger/statements/%s/%s-%s-%s-%d.%s",
+		statement.AccountID,
+		strings.ToLower(statement.Currency),
+		statement.From.Format("20060102"),
+		statement.To.Format("20060102"),
+		now.UnixNano(),
+		format,
+	)
+	if err := s.storage.PutObject(ctx, objectKey, contentType, content); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	downloadURL, err := s.storage.PresignedGetObjectURL(ctx, objectKey, statementDownloadTTL)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &ledgerout.LedgerStatementExportResponse{
+		Format:      format,
+		ObjectKey:   objectKey,
+		DownloadURL: downloadURL,
+		ExpiresAt:   now.Add(statementDownloadTTL),
+	}, nil
+}
+
+// renderStatementCSV writes one row per entry in minor units, framed by
+// opening and closing balance rows so the file reconciles on its own.
+func renderStatementCSV(statement *ledgerout.LedgerStatementResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{"created_at", "transaction_id", "reference_type", "reference_id", "amount", "running_balance"},
+		{statement.From.Format(time.RFC3339), "", "opening_balance", "", "0", strconv.FormatInt(statement.OpeningBalance, 10)},
+	}
+	for _, entry := range statement.Entries {
+		rows = append(rows, []string{
+			entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+			entry.TransactionID,
+			entry.ReferenceType,
+			entry.ReferenceID,
+			strconv.FormatInt(entry.Amount, 10),
+			strconv.FormatInt(entry.RunningBalance, 10),
+		})
+	}
+	rows = append(rows, []string{statement.To.Format(time.RFC3339), "", "closing_balance", "", "0", strconv.FormatInt(statement.ClosingBalance, 10)})
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func statementPDFLines(statement *ledgerout.LedgerStatementResponse) []string {
+	amount := func(value int64) string {
+		formatted, err := finance.FormatMinorAmount(value, statement.Currency)
+		if err != nil {
+			return strconv.FormatInt(value, 10)
+		}
+		return formatted
+	}
+
+	lines := []string{
+		"Account statement",
+		"",
+		fmt.Sprintf("Account:  %s", statement.AccountID),
+		fmt.Sprintf("Currency: %s", statement.Currency),
+		fmt.Sprintf("Period:   %s to %s", statement.From.Format(time.RFC3339), statement.To.Format(time.RFC3339)),
+		fmt.Sprintf("Opening balance: %s", amount(statement.OpeningBalance)),
+		fmt.Sprintf("Closing balance: %s", amount(statement.ClosingBalance)),
+		"",
+		fmt.Sprintf("%-20s  %-40s  %18s  %18s", "Date", "Reference", "Amount", "Balance"),
+	}
+	for _, entry := range statement.Entries {
+		lines = append(lines, fmt.Sprintf(
+			"%-20s  %-40.40s  %18s  %18s",
+			entry.CreatedAt.UTC().Format("2006-01-02 15:04:05"),
+			entry.ReferenceType,
+			amount(entry.Amount),
+			amount(entry.RunningBalance),
+		))
+	}
+
+	lines = append(lines, "", "Totals by reference type", fmt.Sprintf("%-40s  %18s  %18s  %6s", "Reference", "Credit", "Debit", "Count"))
+	for _, total := range statement.Totals {
+		lines = append(lines, fmt.Sprintf(
+			"%-40.40s  %18s  %18s  %6d",
+			total.ReferenceType,
+			amount(total.Credit),
+			amount(total.Debit),
+			total.Count,
+		))
+	}
+	return lines
+}
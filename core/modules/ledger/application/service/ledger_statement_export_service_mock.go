@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_statement_export_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=ledger_statement_export_service_mock.go -source=ledger_statement_export_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	out "wechat-clone/core/modules/ledger/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerStatementExportService is a mock of LedgerStatementExportService interface.
+type MockLedgerStatementExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerStatementExportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerStatementExportServiceMockRecorder is the mock recorder for MockLedgerStatementExportService.
+type MockLedgerStatementExportServiceMockRecorder struct {
+	mock *MockLedgerStatementExportService
+}
+
+// NewMockLedgerStatementExportService creates a new mock instance.
+func NewMockLedgerStatementExportService(ctrl *gomock.Controller) *MockLedgerStatementExportService {
+	mock := &MockLedgerStatementExportService{ctrl: ctrl}
+	mock.recorder = &MockLedgerStatementExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerStatementExportService) EXPECT() *MockLedgerStatementExportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportStatement mocks base method.
+func (m *MockLedgerStatementExportService) ExportStatement(ctx context.Context, command ExportStatementCommand) (*out.LedgerStatementExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportStatement", ctx, command)
+	ret0, _ := ret[0].(*out.LedgerStatementExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportStatement indicates an expected call of ExportStatement.
+func (mr *MockLedgerStatementExportServiceMockRecorder) ExportStatement(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportStatement", reflect.TypeOf((*MockLedgerStatementExportService)(nil).ExportStatement), ctx, command)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ledgerprojection "wechat-clone/core/modules/ledger/application/projection"
+	"wechat-clone/core/modules/ledger/domain/entity"
+	"wechat-clone/core/shared/infra/storage"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestLedgerStatementExportService(t *testing.T) {
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+
+	newService := func(t *testing.T) (*ledgerStatementExportService, *storage.MockStorage) {
+		ctrl := gomock.NewController(t)
+		readRepo := ledgerprojection.NewMockReadRepository(ctrl)
+		readRepo.EXPECT().GetBalanceBefore(gomock.Any(), "acc-1", "USD", from).Return(int64(1000), nil).AnyTimes()
+		readRepo.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Return([]*entity.LedgerEntry{
+			{ID: 1, TransactionID: "tx-1", AccountID: "acc-1", Currency: "USD", Amount: 250, ReferenceType: "EventLedgerAccountDepositFromIntent", ReferenceID: "pay-1", CreatedAt: from.Add(time.Hour)},
+			{ID: 2, TransactionID: "tx-2", AccountID: "acc-1", Currency: "USD", Amount: -100, ReferenceType: entity.LedgerReferenceInternalTransfer, ReferenceID: "tx-2", CreatedAt: from.Add(2 * time.Hour)},
+		}, nil).AnyTimes()
+
+		objectStorage := storage.NewMockStorage(ctrl)
+		svc := NewLedgerStatementExportService(NewLedgerQueryService(readRepo), objectStorage).(*ledgerStatementExportService)
+		svc.now = func() time.Time { return now }
+		return svc, objectStorage
+	}
+
+	t.Run("csv export is uploaded and presigned", func(t *testing.T) {
+		svc, objectStorage := newService(t)
+
+		var uploaded []byte
+		objectStorage.EXPECT().
+			PutObject(gomock.Any(), gomock.Any(), "text/csv", gomock.Any()).
+			DoAndReturn(func(_ context.Context, objectKey, _ string, content []byte) error {
+				if !strings.HasPrefix(objectKey, "ledger/statements/acc-1/usd-20260401-20260501-") || !strings.HasSuffix(objectKey, ".csv") {
+					t.Fatalf("unexpected object key %s", objectKey)
+				}
+				uploaded = content
+				return nil
+			})
+		objectStorage.EXPECT().
+			PresignedGetObjectURL(gomock.Any(), gomock.Any(), statementDownloadTTL).
+			Return("https://storage.local/statement.csv", nil)
+
+		res, err := svc.ExportStatement(context.Background(), ExportStatementCommand{
+			AccountID: "acc-1",
+			Currency:  "USD",
+			From:      from,
+			To:        to,
+			Format:    "CSV",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Format != StatementFormatCSV || res.DownloadURL != "https://storage.local/statement.csv" || !res.ExpiresAt.Equal(now.Add(statementDownloadTTL)) {
+			t.Fatalf("unexpected export response %+v", res)
+		}
+
+		rows, err := csv.NewReader(bytes.NewReader(uploaded)).ReadAll()
+		if err != nil {
+			t.Fatalf("parse uploaded csv: %v", err)
+		}
+		if len(rows) != 5 {
+			t.Fatalf("expected header, opening, 2 entries and closing rows, got %d", len(rows))
+		}
+		if rows[1][2] != "opening_balance" || rows[1][5] != "1000" {
+			t.Fatalf("unexpected opening row %v", rows[1])
+		}
+		if rows[3][1] != "tx-2" || rows[3][5] != "1150" {
+			t.Fatalf("unexpected entry row %v", rows[3])
+		}
+		if rows[4][2] != "closing_balance" || rows[4][5] != "1150" {
+			t.Fatalf("unexpected closing row %v", rows[4])
+		}
+	})
+
+	t.Run("pdf export renders a pdf document", func(t *testing.T) {
+		svc, objectStorage := newService(t)
+
+		objectStorage.EXPECT().
+			PutObject(gomock.Any(), gomock.Any(), "application/pdf", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, content []byte) error {
+				if !bytes.HasPrefix(content, []byte("%PDF-")) || !bytes.Contains(content, []byte("Closing balance: 11.50")) {
+					t.Fatalf("unexpected pdf content %q", content)
+				}
+				return nil
+			})
+		objectStorage.EXPECT().PresignedGetObjectURL(gomock.Any(), gomock.Any(), statementDownloadTTL).Return("https://storage.local/statement.pdf", nil)
+
+		if _, err := svc.ExportStatement(context.Background(), ExportStatementCommand{
+			AccountID: "acc-1",
+			Currency:  "USD",
+			From:      from,
+			To:        to,
+			Format:    "pdf",
+		}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("unknown format is rejected before building the statement", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		_, err := svc.ExportStatement(context.Background(), ExportStatementCommand{
+			AccountID: "acc-1",
+			Currency:  "USD",
+			From:      from,
+			To:        to,
+			Format:    "xlsx",
+		})
+		if !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+}
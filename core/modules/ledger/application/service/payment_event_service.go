@@ -23,6 +23,8 @@ type PaymentEventService interface {
 	HandleFailed(ctx context.Context, payload sharedevents.PaymentFailedEvent) error
 	HandleRefunded(ctx context.Context, payload sharedevents.PaymentRefundedEvent) error
 	HandleChargeback(ctx context.Context, payload sharedevents.PaymentChargebackEvent) error
+	HandleDisputeOpened(ctx context.Context, payload sharedevents.PaymentDisputeOpenedEvent) error
+	HandleDisputeWon(ctx context.Context, payload sharedevents.PaymentDisputeWonEvent) error
 }
 
 type paymentEventService struct {
@@ -71,21 +73,29 @@ func (s *paymentEventService) HandleFailed(ctx context.Context, payload sharedev
 	return stackErr.Error(s.ledgerService.RecordLedgerEvents(ctx, RecordLedgerEventsCommand{Events: events}))
 }
 
+// HandleRefunded books one refund. The fee the top-up paid is refunded in
+// proportion, out of the fee account, and the rest from the credited account.
 func (s *paymentEventService) HandleRefunded(ctx context.Context, payload sharedevents.PaymentRefundedEvent) error {
+	feeShare := ledgerentity.RefundFeeShare(payload.ProviderAmount, payload.FeeAmount, payload.Amount, payload.RefundedAmount)
 	events, err := s.paymentReversedLedgerEvents(
 		resolvePaymentEventID(payload.PaymentID, payload.TransactionID),
 		payload.TransactionID,
 		payload.ClearingAccountKey,
 		payload.CreditAccountID,
+		"",
+		payload.RefundID,
 		payload.Currency,
-		payload.Amount,
-		payload.FeeAmount,
+		payload.Amount-feeShare,
+		feeShare,
 		sharedevents.EventPaymentRefunded,
 		payload.RefundedAt,
 	)
 	if err != nil {
 		return stackErr.Error(err)
 	}
+	if len(events) == 0 {
+		return nil
+	}
 	return stackErr.Error(s.ledgerService.RecordLedgerEvents(ctx, RecordLedgerEventsCommand{Events: events}))
 }
 
@@ -95,6 +105,8 @@ func (s *paymentEventService) HandleChargeback(ctx context.Context, payload shar
 		payload.TransactionID,
 		payload.ClearingAccountKey,
 		payload.CreditAccountID,
+		payload.DisputeID,
+		"",
 		payload.Currency,
 		payload.Amount,
 		payload.FeeAmount,
@@ -107,6 +119,64 @@ func (s *paymentEventService) HandleChargeback(ctx context.Context, payload shar
 	return stackErr.Error(s.ledgerService.RecordLedgerEvents(ctx, RecordLedgerEventsCommand{Events: events}))
 }
 
+func (s *paymentEventService) HandleDisputeOpened(ctx context.Context, payload sharedevents.PaymentDisputeOpenedEvent) error {
+	booking, err := ledgerentity.NewPaymentDisputeHoldBooking(ledgerentity.PaymentDisputeHoldBookingInput{
+		DisputeID: payload.DisputeID,
+		PaymentID: resolvePaymentEventID(payload.PaymentID, payload.TransactionID),
+		AccountID: payload.AccountID,
+		Currency:  payload.Currency,
+		Amount:    payload.Amount,
+	})
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	events, err := paymentLedgerEventsFromBooking(
+		booking.HoldTransactionID(),
+		booking.DisputeID,
+		booking.Currency,
+		booking.Amount,
+		booking.AccountID,
+		booking.HoldAccountID,
+		ledgeraggregate.EventNameLedgerAccountReserveDisputeHold,
+		ledgeraggregate.EventNameLedgerAccountReceiveDisputeHold,
+		payload.OpenedAt,
+	)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(s.ledgerService.RecordLedgerEvents(ctx, RecordLedgerEventsCommand{Events: events}))
+}
+
+func (s *paymentEventService) HandleDisputeWon(ctx context.Context, payload sharedevents.PaymentDisputeWonEvent) error {
+	booking, err := ledgerentity.NewPaymentDisputeHoldBooking(ledgerentity.PaymentDisputeHoldBookingInput{
+		DisputeID: payload.DisputeID,
+		PaymentID: resolvePaymentEventID(payload.PaymentID, payload.TransactionID),
+		AccountID: payload.AccountID,
+		Currency:  payload.Currency,
+		Amount:    payload.Amount,
+	})
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	events, err := paymentLedgerEventsFromBooking(
+		booking.ReleaseTransactionID(),
+		booking.DisputeID,
+		booking.Currency,
+		booking.Amount,
+		booking.HoldAccountID,
+		booking.AccountID,
+		ledgeraggregate.EventNameLedgerAccountWithdrawReleasedDisputeHold,
+		ledgeraggregate.EventNameLedgerAccountReleaseDisputeHold,
+		payload.WonAt,
+	)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(s.ledgerService.RecordLedgerEvents(ctx, RecordLedgerEventsCommand{Events: events}))
+}
+
 func (s *paymentEventService) paymentWithdrawalRequestedLedgerEvents(payload sharedevents.PaymentWithdrawalRequestedEvent) ([]eventpkg.Event, error) {
 	events := make([]eventpkg.Event, 0, 4)
 	clearingAccountID := ledgerClearingAccountID(utils.FirstNonEmpty(strings.TrimSpace(payload.ClearingAccountKey), providerClearingAccountKey(payload.Provider)))
@@ -313,11 +383,16 @@ func (s *paymentEventService) paymentFailedLedgerEvents(payload sharedevents.Pay
 	return events, nil
 }
 
+// paymentReversedLedgerEvents books a refund or chargeback: amount back from
+// the credited account and feeAmount back from the fee account, when one is
+// configured. A refund whose share is all fee books no credited-account leg.
 func (s *paymentEventService) paymentReversedLedgerEvents(
 	paymentID,
 	transactionID,
 	clearingAccountKey,
 	creditAccountID,
+	disputeID,
+	refundID,
 	currency string,
 	amount,
 	feeAmount int64,
@@ -327,34 +402,39 @@ func (s *paymentEventService) paymentReversedLedgerEvents(
 	booking, err := ledgerentity.NewPaymentReversalBooking(ledgerentity.PaymentReversalBookingInput{
 		PaymentID:          paymentID,
 		TransactionID:      transactionID,
+		RefundID:           refundID,
 		ClearingAccountKey: clearingAccountKey,
 		CreditAccountID:    creditAccountID,
+		DisputeID:          disputeID,
 		Currency:           currency,
-		Amount:             amount,
+		Amount:             max(amount, feeAmount),
 		ReversalType:       reversalType,
 	})
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
-	events, err := paymentLedgerEventsFromBooking(
-		booking.LedgerTransactionID(),
-		booking.PaymentID,
-		booking.Currency,
-		booking.Amount,
-		booking.DebitAccountID,
-		booking.CreditAccountID,
-		debitLedgerEventNameForReversal(booking.ReversalType),
-		creditLedgerEventNameForReversal(booking.ReversalType),
-		bookedAt,
-	)
-	if err != nil {
-		return nil, stackErr.Error(err)
+	var events []eventpkg.Event
+	if amount > 0 {
+		events, err = paymentLedgerEventsFromBooking(
+			booking.LedgerTransactionID(),
+			booking.PaymentID,
+			booking.Currency,
+			amount,
+			booking.DebitAccountID,
+			booking.CreditAccountID,
+			debitLedgerEventNameForReversal(booking.ReversalType),
+			creditLedgerEventNameForReversal(booking.ReversalType),
+			bookedAt,
+		)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
 	}
 
 	if feeAmount > 0 && s.feeAccountID != "" {
 		feeEvents, err := paymentLedgerEventsFromBooking(
-			fmt.Sprintf("payment:%s:%s:fee", booking.PaymentID, reversalSuffix(reversalType)),
+			booking.LedgerTransactionID()+":fee",
 			booking.PaymentID,
 			booking.Currency,
 			feeAmount,
@@ -517,17 +597,6 @@ func providerClearingAccountKey(provider string) string {
 	return fmt.Sprintf("provider:%s", provider)
 }
 
-func reversalSuffix(reversalType string) string {
-	switch strings.TrimSpace(reversalType) {
-	case sharedevents.EventPaymentRefunded:
-		return "refunded"
-	case sharedevents.EventPaymentChargeback:
-		return "chargeback"
-	default:
-		return "reversed"
-	}
-}
-
 func resolvePaymentEventID(paymentID, transactionID string) string {
 	return utils.FirstNonEmpty(strings.TrimSpace(paymentID), strings.TrimSpace(transactionID))
 }
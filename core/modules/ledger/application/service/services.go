@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	ledgerout "wechat-clone/core/modules/ledger/application/dto/out"
 	ledgerprojection "wechat-clone/core/modules/ledger/application/projection"
@@ -42,10 +43,26 @@ func (s *services) ListTransactions(ctx context.Context, accountID, cursor, curr
 	return s.ledgerQueryService.ListTransactions(ctx, accountID, cursor, currency, limit)
 }
 
+func (s *services) GetStatement(ctx context.Context, accountID, currency string, from, to time.Time) (*ledgerout.LedgerStatementResponse, error) {
+	return s.ledgerQueryService.GetStatement(ctx, accountID, currency, from, to)
+}
+
 func (s *services) TransferToAccount(ctx context.Context, command TransferToAccountCommand) (*entity.LedgerTransaction, error) {
 	return s.ledgerService.TransferToAccount(ctx, command)
 }
 
+func (s *services) ConvertCurrency(ctx context.Context, command ConvertCurrencyCommand) ([]*entity.LedgerTransaction, error) {
+	return s.ledgerService.ConvertCurrency(ctx, command)
+}
+
+func (s *services) HoldTransfer(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	return s.ledgerService.HoldTransfer(ctx, command)
+}
+
+func (s *services) ReleaseTransferHold(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	return s.ledgerService.ReleaseTransferHold(ctx, command)
+}
+
 func (s *services) RecordLedgerEvents(ctx context.Context, command RecordLedgerEventsCommand) error {
 	return s.ledgerService.RecordLedgerEvents(ctx, command)
 }
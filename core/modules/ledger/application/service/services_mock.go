@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	out "wechat-clone/core/modules/ledger/application/dto/out"
 	entity "wechat-clone/core/modules/ledger/domain/entity"
 
@@ -42,6 +43,21 @@ func (m *MockServices) EXPECT() *MockServicesMockRecorder {
 	return m.recorder
 }
 
+// ConvertCurrency mocks base method.
+func (m *MockServices) ConvertCurrency(ctx context.Context, command ConvertCurrencyCommand) ([]*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertCurrency", ctx, command)
+	ret0, _ := ret[0].([]*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertCurrency indicates an expected call of ConvertCurrency.
+func (mr *MockServicesMockRecorder) ConvertCurrency(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertCurrency", reflect.TypeOf((*MockServices)(nil).ConvertCurrency), ctx, command)
+}
+
 // GetAccountBalance mocks base method.
 func (m *MockServices) GetAccountBalance(ctx context.Context, accountID, currency string) (*out.AccountBalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,21 @@ func (mr *MockServicesMockRecorder) GetAccountBalance(ctx, accountID, currency a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockServices)(nil).GetAccountBalance), ctx, accountID, currency)
 }
 
+// GetStatement mocks base method.
+func (m *MockServices) GetStatement(ctx context.Context, accountID, currency string, from, to time.Time) (*out.LedgerStatementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, accountID, currency, from, to)
+	ret0, _ := ret[0].(*out.LedgerStatementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockServicesMockRecorder) GetStatement(ctx, accountID, currency, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockServices)(nil).GetStatement), ctx, accountID, currency, from, to)
+}
+
 // GetTransaction mocks base method.
 func (m *MockServices) GetTransaction(ctx context.Context, transactionID string) (*out.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +103,21 @@ func (mr *MockServicesMockRecorder) GetTransaction(ctx, transactionID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockServices)(nil).GetTransaction), ctx, transactionID)
 }
 
+// HoldTransfer mocks base method.
+func (m *MockServices) HoldTransfer(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldTransfer", ctx, command)
+	ret0, _ := ret[0].(*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldTransfer indicates an expected call of HoldTransfer.
+func (mr *MockServicesMockRecorder) HoldTransfer(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldTransfer", reflect.TypeOf((*MockServices)(nil).HoldTransfer), ctx, command)
+}
+
 // ListTransactions mocks base method.
 func (m *MockServices) ListTransactions(ctx context.Context, accountID, cursor, currency string, limit int) (*out.ListTransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +147,20 @@ func (mr *MockServicesMockRecorder) RecordLedgerEvents(ctx, command any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLedgerEvents", reflect.TypeOf((*MockServices)(nil).RecordLedgerEvents), ctx, command)
 }
 
+// RecordPaymentReconciliationFailed mocks base method.
+func (m *MockServices) RecordPaymentReconciliationFailed(ctx context.Context, command RecordPaymentReconciliationFailedCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPaymentReconciliationFailed", ctx, command)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPaymentReconciliationFailed indicates an expected call of RecordPaymentReconciliationFailed.
+func (mr *MockServicesMockRecorder) RecordPaymentReconciliationFailed(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPaymentReconciliationFailed", reflect.TypeOf((*MockServices)(nil).RecordPaymentReconciliationFailed), ctx, command)
+}
+
 // RecordPaymentReversed mocks base method.
 func (m *MockServices) RecordPaymentReversed(ctx context.Context, command RecordPaymentReversedCommand) error {
 	m.ctrl.T.Helper()
@@ -129,6 +189,21 @@ func (mr *MockServicesMockRecorder) RecordPaymentSucceeded(ctx, command any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPaymentSucceeded", reflect.TypeOf((*MockServices)(nil).RecordPaymentSucceeded), ctx, command)
 }
 
+// ReleaseTransferHold mocks base method.
+func (m *MockServices) ReleaseTransferHold(ctx context.Context, command TransferHoldCommand) (*entity.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTransferHold", ctx, command)
+	ret0, _ := ret[0].(*entity.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTransferHold indicates an expected call of ReleaseTransferHold.
+func (mr *MockServicesMockRecorder) ReleaseTransferHold(ctx, command any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTransferHold", reflect.TypeOf((*MockServices)(nil).ReleaseTransferHold), ctx, command)
+}
+
 // TransferToAccount mocks base method.
 func (m *MockServices) TransferToAccount(ctx context.Context, command TransferToAccountCommand) (*entity.LedgerTransaction, error) {
 	m.ctrl.T.Helper()
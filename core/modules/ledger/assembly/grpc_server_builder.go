@@ -19,13 +19,13 @@ type ledgerGRPCRegistrar struct {
 
 func buildGRPCServer(_ context.Context, appContext *appCtx.AppContext) (infragrpc.GRPCServer, error) {
 	ledgerRepos := ledgerrepo.NewRepoImpl(appContext)
-	paymentEventService := ledgerapp.NewPaymentEventService(ledgerRepos, appContext.GetConfig().LedgerConfig.Stripe.FeeAccountID)
+	paymentEventService := ledgerapp.NewPaymentEventService(ledgerRepos, appContext.GetConfig().LedgerConfig.FeeRevenueAccountID())
 
 	return &ledgerGRPCRegistrar{
 		server: ledgergrpc.NewPaymentServer(
 			appContext.Locker(),
 			paymentEventService,
-			appContext.GetConfig().LedgerConfig.Stripe.FeeAccountID,
+			appContext.GetConfig().LedgerConfig.FeeRevenueAccountID(),
 		),
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	appCtx "wechat-clone/core/context"
+	accountassembly "wechat-clone/core/modules/account/assembly"
 	ledgercommand "wechat-clone/core/modules/ledger/application/command"
 	ledgerquery "wechat-clone/core/modules/ledger/application/query"
 	ledgerserver "wechat-clone/core/modules/ledger/transport/server"
@@ -13,11 +14,20 @@ import (
 
 func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (infrahttp.HTTPServer, error) {
 	ledgerService := BuildService(appContext)
+	adminRoles := accountassembly.BuildAdminRoles(appContext)
 	ledgerQueryService := BuildQueryService(appContext)
 	getAccountBalance := cqrs.NewDispatcher(ledgerquery.NewGetAccountBalanceHandler(ledgerQueryService))
 	getTransaction := cqrs.NewDispatcher(ledgerquery.NewGetTransactionHandler(ledgerQueryService))
 	listTransaction := cqrs.NewDispatcher(ledgerquery.NewListTransactionHandler(ledgerQueryService))
-	transferTransaction := cqrs.NewDispatcher(ledgercommand.NewTransferTransaction(appContext, ledgerService))
+	feeService := BuildFeeService(appContext)
+	transferTransaction := cqrs.NewDispatcher(ledgercommand.NewTransferTransaction(appContext, ledgerService, feeService))
+	getStatement := cqrs.NewDispatcher(ledgerquery.NewGetStatementHandler(ledgerQueryService))
+	exportStatement := cqrs.NewDispatcher(ledgercommand.NewExportStatement(BuildStatementExportService(appContext, ledgerQueryService)))
+	runAudit := cqrs.NewDispatcher(ledgerquery.NewRunAuditHandler(BuildAuditService(appContext), adminRoles))
+	limitService := BuildLimitService(appContext)
+	getLimits := cqrs.NewDispatcher(ledgerquery.NewGetAccountLimitsHandler(limitService))
+	setAccountTier := cqrs.NewDispatcher(ledgercommand.NewSetAccountTier(limitService, adminRoles))
+	quoteFee := cqrs.NewDispatcher(ledgerquery.NewQuoteFeeHandler(feeService))
 
-	return ledgerserver.NewHTTPServer(getAccountBalance, getTransaction, transferTransaction, listTransaction)
+	return ledgerserver.NewHTTPServer(getAccountBalance, getTransaction, transferTransaction, listTransaction, getStatement, exportStatement, runAudit, getLimits, setAccountTier, quoteFee)
 }
@@ -3,12 +3,40 @@ package assembly
 import (
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/ledger/domain/entity"
 	ledgerrepo "wechat-clone/core/modules/ledger/infra/persistent/repository"
 	ledgerprojection "wechat-clone/core/modules/ledger/infra/projection"
+	"wechat-clone/core/shared/finance"
 )
 
 func BuildService(appContext *appCtx.AppContext) service.LedgerService {
-	return service.NewLedgerService(ledgerrepo.NewRepoImpl(appContext))
+	return service.NewLedgerService(ledgerrepo.NewRepoImpl(appContext), service.WithLimitPolicy(buildLimitPolicy(appContext)))
+}
+
+func BuildLimitService(appContext *appCtx.AppContext) service.LedgerLimitService {
+	return service.NewLedgerLimitService(ledgerrepo.NewRepoImpl(appContext), buildLimitPolicy(appContext))
+}
+
+func buildLimitPolicy(appContext *appCtx.AppContext) entity.LimitPolicy {
+	cfg := appContext.GetConfig().LedgerConfig.Limits
+	policy, err := entity.ParseLimitPolicy(cfg.Unverified, cfg.Verified, cfg.Business, cfg.Velocity)
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+func BuildFeeService(appContext *appCtx.AppContext) service.LedgerFeeService {
+	return service.NewLedgerFeeService(ledgerrepo.NewRepoImpl(appContext), buildFeeSchedule(appContext))
+}
+
+func buildFeeSchedule(appContext *appCtx.AppContext) finance.FeeSchedule {
+	cfg := appContext.GetConfig().LedgerConfig
+	schedule, err := finance.ParseFeeSchedule(cfg.FeeRules(), cfg.Fees.Promotions)
+	if err != nil {
+		panic(err)
+	}
+	return schedule
 }
 
 func BuildQueryService(appContext *appCtx.AppContext) service.LedgerQueryService {
@@ -18,3 +46,11 @@ func BuildQueryService(appContext *appCtx.AppContext) service.LedgerQueryService
 	}
 	return service.NewLedgerQueryService(readRepo)
 }
+
+func BuildStatementExportService(appContext *appCtx.AppContext, queryService service.LedgerQueryService) service.LedgerStatementExportService {
+	return service.NewLedgerStatementExportService(queryService, appContext.GetStorage())
+}
+
+func BuildAuditService(appContext *appCtx.AppContext) service.LedgerAuditService {
+	return service.NewLedgerAuditService(ledgerrepo.NewLedgerAuditRepoImpl(appContext.GetDB()))
+}
@@ -59,6 +59,19 @@ func (a *LedgerAccountAggregate) RegisterEvents(register event.RegisterEventsFun
 		&EventLedgerAccountWithdrawReleasedHold{},
 		&EventLedgerAccountTransferredToAccount{},
 		&EventLedgerAccountReceivedTransfer{},
+		&EventLedgerAccountWithdrawForConversion{},
+		&EventLedgerAccountDepositFromConversion{},
+		&EventLedgerAccountReceiveConversionPosition{},
+		&EventLedgerAccountReleaseConversionPosition{},
+		&EventLedgerAccountRecognizeConversionSpread{},
+		&EventLedgerAccountReserveTransferHold{},
+		&EventLedgerAccountReceiveTransferHold{},
+		&EventLedgerAccountReleaseTransferHold{},
+		&EventLedgerAccountWithdrawReleasedTransferHold{},
+		&EventLedgerAccountReserveDisputeHold{},
+		&EventLedgerAccountReceiveDisputeHold{},
+		&EventLedgerAccountReleaseDisputeHold{},
+		&EventLedgerAccountWithdrawReleasedDisputeHold{},
 	)
 }
 
@@ -88,6 +101,32 @@ func (a *LedgerAccountAggregate) Transition(evt event.Event) error {
 		return a.applyTransferredToAccount(evt.AggregateID, data)
 	case *EventLedgerAccountReceivedTransfer:
 		return a.applyReceivedTransfer(evt.AggregateID, data)
+	case *EventLedgerAccountWithdrawForConversion:
+		return a.applyWithdrawForConversion(evt.AggregateID, data)
+	case *EventLedgerAccountDepositFromConversion:
+		return a.applyDepositFromConversion(evt.AggregateID, data)
+	case *EventLedgerAccountReceiveConversionPosition:
+		return a.applyReceiveConversionPosition(evt.AggregateID, data)
+	case *EventLedgerAccountReleaseConversionPosition:
+		return a.applyReleaseConversionPosition(evt.AggregateID, data)
+	case *EventLedgerAccountRecognizeConversionSpread:
+		return a.applyRecognizeConversionSpread(evt.AggregateID, data)
+	case *EventLedgerAccountReserveTransferHold:
+		return a.applyReserveTransferHold(evt.AggregateID, data)
+	case *EventLedgerAccountReceiveTransferHold:
+		return a.applyReceiveTransferHold(evt.AggregateID, data)
+	case *EventLedgerAccountReleaseTransferHold:
+		return a.applyReleaseTransferHold(evt.AggregateID, data)
+	case *EventLedgerAccountWithdrawReleasedTransferHold:
+		return a.applyWithdrawReleasedTransferHold(evt.AggregateID, data)
+	case *EventLedgerAccountReserveDisputeHold:
+		return a.applyReserveDisputeHold(evt.AggregateID, data)
+	case *EventLedgerAccountReceiveDisputeHold:
+		return a.applyReceiveDisputeHold(evt.AggregateID, data)
+	case *EventLedgerAccountReleaseDisputeHold:
+		return a.applyReleaseDisputeHold(evt.AggregateID, data)
+	case *EventLedgerAccountWithdrawReleasedDisputeHold:
+		return a.applyWithdrawReleasedDisputeHold(evt.AggregateID, data)
 	default:
 		return event.ErrUnsupportedEventType
 	}
@@ -393,6 +432,58 @@ func (a *LedgerAccountAggregate) applyWithdrawReleasedHold(accountID string, dat
 	return a.applyEventPosting(accountID, data, "ledger withdraw released hold event is unsupported")
 }
 
+func (a *LedgerAccountAggregate) applyWithdrawForConversion(accountID string, data *EventLedgerAccountWithdrawForConversion) error {
+	return a.applyEventPosting(accountID, data, "ledger withdraw for conversion event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyDepositFromConversion(accountID string, data *EventLedgerAccountDepositFromConversion) error {
+	return a.applyEventPosting(accountID, data, "ledger deposit from conversion event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReceiveConversionPosition(accountID string, data *EventLedgerAccountReceiveConversionPosition) error {
+	return a.applyEventPosting(accountID, data, "ledger receive conversion position event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReleaseConversionPosition(accountID string, data *EventLedgerAccountReleaseConversionPosition) error {
+	return a.applyEventPosting(accountID, data, "ledger release conversion position event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyRecognizeConversionSpread(accountID string, data *EventLedgerAccountRecognizeConversionSpread) error {
+	return a.applyEventPosting(accountID, data, "ledger recognize conversion spread event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReserveTransferHold(accountID string, data *EventLedgerAccountReserveTransferHold) error {
+	return a.applyEventPosting(accountID, data, "ledger reserve transfer hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReceiveTransferHold(accountID string, data *EventLedgerAccountReceiveTransferHold) error {
+	return a.applyEventPosting(accountID, data, "ledger receive transfer hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReleaseTransferHold(accountID string, data *EventLedgerAccountReleaseTransferHold) error {
+	return a.applyEventPosting(accountID, data, "ledger release transfer hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyWithdrawReleasedTransferHold(accountID string, data *EventLedgerAccountWithdrawReleasedTransferHold) error {
+	return a.applyEventPosting(accountID, data, "ledger withdraw released transfer hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReserveDisputeHold(accountID string, data *EventLedgerAccountReserveDisputeHold) error {
+	return a.applyEventPosting(accountID, data, "ledger reserve dispute hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReceiveDisputeHold(accountID string, data *EventLedgerAccountReceiveDisputeHold) error {
+	return a.applyEventPosting(accountID, data, "ledger receive dispute hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyReleaseDisputeHold(accountID string, data *EventLedgerAccountReleaseDisputeHold) error {
+	return a.applyEventPosting(accountID, data, "ledger release dispute hold event is unsupported")
+}
+
+func (a *LedgerAccountAggregate) applyWithdrawReleasedDisputeHold(accountID string, data *EventLedgerAccountWithdrawReleasedDisputeHold) error {
+	return a.applyEventPosting(accountID, data, "ledger withdraw released dispute hold event is unsupported")
+}
+
 func (a *LedgerAccountAggregate) applyEventPosting(accountID string, eventData interface{}, unsupportedErr string) error {
 	posting, ok, err := previewLedgerPostingFromEvent(accountID, eventData)
 	if err != nil {
@@ -474,7 +565,16 @@ func requiresNonNegativeBalance(referenceType string) bool {
 		EventNameLedgerAccountDepositFromChargeback,
 		EventNameLedgerAccountWithdrawFromChargeback,
 		EventNameLedgerAccountReceiveWithdrawalHold,
-		EventNameLedgerAccountWithdrawReleasedHold:
+		EventNameLedgerAccountWithdrawReleasedHold,
+		EventNameLedgerAccountDepositFromConversion,
+		EventNameLedgerAccountReceiveConversionPosition,
+		EventNameLedgerAccountReleaseConversionPosition,
+		EventNameLedgerAccountRecognizeConversionSpread,
+		EventNameLedgerAccountReceiveTransferHold,
+		EventNameLedgerAccountReleaseTransferHold,
+		EventNameLedgerAccountReserveDisputeHold,
+		EventNameLedgerAccountReceiveDisputeHold,
+		EventNameLedgerAccountReleaseDisputeHold:
 		return false
 	default:
 		return true
@@ -523,6 +623,19 @@ func normalizeLedgerAccountPosting(accountID string, posting entity.LedgerAccoun
 		EventNameLedgerAccountReceiveWithdrawalHold,
 		EventNameLedgerAccountReleaseWithdrawal,
 		EventNameLedgerAccountWithdrawReleasedHold,
+		EventNameLedgerAccountWithdrawForConversion,
+		EventNameLedgerAccountDepositFromConversion,
+		EventNameLedgerAccountReceiveConversionPosition,
+		EventNameLedgerAccountReleaseConversionPosition,
+		EventNameLedgerAccountRecognizeConversionSpread,
+		EventNameLedgerAccountReserveTransferHold,
+		EventNameLedgerAccountReceiveTransferHold,
+		EventNameLedgerAccountReleaseTransferHold,
+		EventNameLedgerAccountWithdrawReleasedTransferHold,
+		EventNameLedgerAccountReserveDisputeHold,
+		EventNameLedgerAccountReceiveDisputeHold,
+		EventNameLedgerAccountReleaseDisputeHold,
+		EventNameLedgerAccountWithdrawReleasedDisputeHold,
 		entity.LedgerReferenceInternalTransfer:
 	default:
 		return "", entity.LedgerAccountPosting{}, ErrLedgerAccountReferenceTypeInvalid
@@ -646,6 +759,32 @@ func previewLedgerPostingFromEvent(accountID string, eventData interface{}) (ent
 		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReleaseWithdrawal, 1, "ledger release withdrawal event is nil")
 	case *EventLedgerAccountWithdrawReleasedHold:
 		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountWithdrawReleasedHold, -1, "ledger withdraw released hold event is nil")
+	case *EventLedgerAccountWithdrawForConversion:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountWithdrawForConversion, -1, "ledger withdraw for conversion event is nil")
+	case *EventLedgerAccountDepositFromConversion:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountDepositFromConversion, 1, "ledger deposit from conversion event is nil")
+	case *EventLedgerAccountReceiveConversionPosition:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReceiveConversionPosition, 1, "ledger receive conversion position event is nil")
+	case *EventLedgerAccountReleaseConversionPosition:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReleaseConversionPosition, -1, "ledger release conversion position event is nil")
+	case *EventLedgerAccountRecognizeConversionSpread:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountRecognizeConversionSpread, 1, "ledger recognize conversion spread event is nil")
+	case *EventLedgerAccountReserveTransferHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReserveTransferHold, -1, "ledger reserve transfer hold event is nil")
+	case *EventLedgerAccountReceiveTransferHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReceiveTransferHold, 1, "ledger receive transfer hold event is nil")
+	case *EventLedgerAccountReleaseTransferHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReleaseTransferHold, 1, "ledger release transfer hold event is nil")
+	case *EventLedgerAccountWithdrawReleasedTransferHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountWithdrawReleasedTransferHold, -1, "ledger withdraw released transfer hold event is nil")
+	case *EventLedgerAccountReserveDisputeHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReserveDisputeHold, -1, "ledger reserve dispute hold event is nil")
+	case *EventLedgerAccountReceiveDisputeHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReceiveDisputeHold, 1, "ledger receive dispute hold event is nil")
+	case *EventLedgerAccountReleaseDisputeHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountReleaseDisputeHold, 1, "ledger release dispute hold event is nil")
+	case *EventLedgerAccountWithdrawReleasedDisputeHold:
+		return newLedgerPostingFromPaymentEvent(accountID, data, EventNameLedgerAccountWithdrawReleasedDisputeHold, -1, "ledger withdraw released dispute hold event is nil")
 	case *EventLedgerAccountTransferredToAccount:
 		if data == nil {
 			return entity.LedgerAccountPosting{}, false, stackErr.Error(errors.New("ledger transfer to account event is nil"))
@@ -789,6 +928,123 @@ func newLedgerPaymentEvent(posting entity.LedgerAccountPosting) interface{} {
 			Amount:                base.amount,
 			BookedAt:              base.bookedAt,
 		}
+	case EventNameLedgerAccountWithdrawForConversion:
+		return &EventLedgerAccountWithdrawForConversion{
+			TransactionID:         base.transactionID,
+			ConversionID:          base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountDepositFromConversion:
+		return &EventLedgerAccountDepositFromConversion{
+			TransactionID:         base.transactionID,
+			ConversionID:          base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReceiveConversionPosition:
+		return &EventLedgerAccountReceiveConversionPosition{
+			TransactionID:         base.transactionID,
+			ConversionID:          base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReleaseConversionPosition:
+		return &EventLedgerAccountReleaseConversionPosition{
+			TransactionID:         base.transactionID,
+			ConversionID:          base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountRecognizeConversionSpread:
+		return &EventLedgerAccountRecognizeConversionSpread{
+			TransactionID:         base.transactionID,
+			ConversionID:          base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReserveTransferHold:
+		return &EventLedgerAccountReserveTransferHold{
+			TransactionID:         base.transactionID,
+			TransferID:            base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReceiveTransferHold:
+		return &EventLedgerAccountReceiveTransferHold{
+			TransactionID:         base.transactionID,
+			TransferID:            base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReleaseTransferHold:
+		return &EventLedgerAccountReleaseTransferHold{
+			TransactionID:         base.transactionID,
+			TransferID:            base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountWithdrawReleasedTransferHold:
+		return &EventLedgerAccountWithdrawReleasedTransferHold{
+			TransactionID:         base.transactionID,
+			TransferID:            base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReserveDisputeHold:
+		return &EventLedgerAccountReserveDisputeHold{
+			TransactionID:         base.transactionID,
+			DisputeID:             base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReceiveDisputeHold:
+		return &EventLedgerAccountReceiveDisputeHold{
+			TransactionID:         base.transactionID,
+			DisputeID:             base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountReleaseDisputeHold:
+		return &EventLedgerAccountReleaseDisputeHold{
+			TransactionID:         base.transactionID,
+			DisputeID:             base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
+	case EventNameLedgerAccountWithdrawReleasedDisputeHold:
+		return &EventLedgerAccountWithdrawReleasedDisputeHold{
+			TransactionID:         base.transactionID,
+			DisputeID:             base.paymentID,
+			CounterpartyAccountID: base.counterpartyAccountID,
+			Currency:              base.currency,
+			Amount:                base.amount,
+			BookedAt:              base.bookedAt,
+		}
 	default:
 		return nil
 	}
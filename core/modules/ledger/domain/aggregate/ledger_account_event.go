@@ -9,18 +9,31 @@ import (
 )
 
 var (
-	EventNameLedgerAccountDepositFromIntent      = event.EventName((*EventLedgerAccountDepositFromIntent)(nil))
-	EventNameLedgerAccountWithdrawFromIntent     = event.EventName((*EventLedgerAccountWithdrawFromIntent)(nil))
-	EventNameLedgerAccountDepositFromRefund      = event.EventName((*EventLedgerAccountDepositFromRefund)(nil))
-	EventNameLedgerAccountWithdrawFromRefund     = event.EventName((*EventLedgerAccountWithdrawFromRefund)(nil))
-	EventNameLedgerAccountDepositFromChargeback  = event.EventName((*EventLedgerAccountDepositFromChargeback)(nil))
-	EventNameLedgerAccountWithdrawFromChargeback = event.EventName((*EventLedgerAccountWithdrawFromChargeback)(nil))
-	EventNameLedgerAccountReserveWithdrawal      = event.EventName((*EventLedgerAccountReserveWithdrawal)(nil))
-	EventNameLedgerAccountReceiveWithdrawalHold  = event.EventName((*EventLedgerAccountReceiveWithdrawalHold)(nil))
-	EventNameLedgerAccountReleaseWithdrawal      = event.EventName((*EventLedgerAccountReleaseWithdrawal)(nil))
-	EventNameLedgerAccountWithdrawReleasedHold   = event.EventName((*EventLedgerAccountWithdrawReleasedHold)(nil))
-	EventNameLedgerAccountTransferredToAccount   = event.EventName((*EventLedgerAccountTransferredToAccount)(nil))
-	EventNameLedgerAccountReceivedTransfer       = event.EventName((*EventLedgerAccountReceivedTransfer)(nil))
+	EventNameLedgerAccountDepositFromIntent            = event.EventName((*EventLedgerAccountDepositFromIntent)(nil))
+	EventNameLedgerAccountWithdrawFromIntent           = event.EventName((*EventLedgerAccountWithdrawFromIntent)(nil))
+	EventNameLedgerAccountDepositFromRefund            = event.EventName((*EventLedgerAccountDepositFromRefund)(nil))
+	EventNameLedgerAccountWithdrawFromRefund           = event.EventName((*EventLedgerAccountWithdrawFromRefund)(nil))
+	EventNameLedgerAccountDepositFromChargeback        = event.EventName((*EventLedgerAccountDepositFromChargeback)(nil))
+	EventNameLedgerAccountWithdrawFromChargeback       = event.EventName((*EventLedgerAccountWithdrawFromChargeback)(nil))
+	EventNameLedgerAccountReserveWithdrawal            = event.EventName((*EventLedgerAccountReserveWithdrawal)(nil))
+	EventNameLedgerAccountReceiveWithdrawalHold        = event.EventName((*EventLedgerAccountReceiveWithdrawalHold)(nil))
+	EventNameLedgerAccountReleaseWithdrawal            = event.EventName((*EventLedgerAccountReleaseWithdrawal)(nil))
+	EventNameLedgerAccountWithdrawReleasedHold         = event.EventName((*EventLedgerAccountWithdrawReleasedHold)(nil))
+	EventNameLedgerAccountTransferredToAccount         = event.EventName((*EventLedgerAccountTransferredToAccount)(nil))
+	EventNameLedgerAccountReceivedTransfer             = event.EventName((*EventLedgerAccountReceivedTransfer)(nil))
+	EventNameLedgerAccountWithdrawForConversion        = event.EventName((*EventLedgerAccountWithdrawForConversion)(nil))
+	EventNameLedgerAccountDepositFromConversion        = event.EventName((*EventLedgerAccountDepositFromConversion)(nil))
+	EventNameLedgerAccountReceiveConversionPosition    = event.EventName((*EventLedgerAccountReceiveConversionPosition)(nil))
+	EventNameLedgerAccountReleaseConversionPosition    = event.EventName((*EventLedgerAccountReleaseConversionPosition)(nil))
+	EventNameLedgerAccountRecognizeConversionSpread    = event.EventName((*EventLedgerAccountRecognizeConversionSpread)(nil))
+	EventNameLedgerAccountReserveTransferHold          = event.EventName((*EventLedgerAccountReserveTransferHold)(nil))
+	EventNameLedgerAccountReceiveTransferHold          = event.EventName((*EventLedgerAccountReceiveTransferHold)(nil))
+	EventNameLedgerAccountReleaseTransferHold          = event.EventName((*EventLedgerAccountReleaseTransferHold)(nil))
+	EventNameLedgerAccountWithdrawReleasedTransferHold = event.EventName((*EventLedgerAccountWithdrawReleasedTransferHold)(nil))
+	EventNameLedgerAccountReserveDisputeHold           = event.EventName((*EventLedgerAccountReserveDisputeHold)(nil))
+	EventNameLedgerAccountReceiveDisputeHold           = event.EventName((*EventLedgerAccountReceiveDisputeHold)(nil))
+	EventNameLedgerAccountReleaseDisputeHold           = event.EventName((*EventLedgerAccountReleaseDisputeHold)(nil))
+	EventNameLedgerAccountWithdrawReleasedDisputeHold  = event.EventName((*EventLedgerAccountWithdrawReleasedDisputeHold)(nil))
 )
 
 type EventLedgerAccountDepositFromIntent struct {
@@ -113,6 +126,123 @@ type EventLedgerAccountWithdrawReleasedHold struct {
 	BookedAt              time.Time `json:"booked_at"`
 }
 
+type EventLedgerAccountWithdrawForConversion struct {
+	TransactionID         string    `json:"transaction_id"`
+	ConversionID          string    `json:"conversion_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountDepositFromConversion struct {
+	TransactionID         string    `json:"transaction_id"`
+	ConversionID          string    `json:"conversion_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReceiveConversionPosition struct {
+	TransactionID         string    `json:"transaction_id"`
+	ConversionID          string    `json:"conversion_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReleaseConversionPosition struct {
+	TransactionID         string    `json:"transaction_id"`
+	ConversionID          string    `json:"conversion_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountRecognizeConversionSpread struct {
+	TransactionID         string    `json:"transaction_id"`
+	ConversionID          string    `json:"conversion_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReserveTransferHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	TransferID            string    `json:"transfer_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReceiveTransferHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	TransferID            string    `json:"transfer_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReleaseTransferHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	TransferID            string    `json:"transfer_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountWithdrawReleasedTransferHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	TransferID            string    `json:"transfer_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReserveDisputeHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	DisputeID             string    `json:"dispute_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReceiveDisputeHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	DisputeID             string    `json:"dispute_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountReleaseDisputeHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	DisputeID             string    `json:"dispute_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
+type EventLedgerAccountWithdrawReleasedDisputeHold struct {
+	TransactionID         string    `json:"transaction_id"`
+	DisputeID             string    `json:"dispute_id"`
+	CounterpartyAccountID string    `json:"counterparty_account_id"`
+	Currency              string    `json:"currency"`
+	Amount                int64     `json:"amount"`
+	BookedAt              time.Time `json:"booked_at"`
+}
+
 func (e *EventLedgerAccountDepositFromIntent) paymentEvent() ledgerPaymentEventData {
 	return ledgerPaymentEventData{
 		transactionID:         e.TransactionID,
@@ -223,6 +353,149 @@ func (e *EventLedgerAccountWithdrawReleasedHold) paymentEvent() ledgerPaymentEve
 	}
 }
 
+func (e *EventLedgerAccountWithdrawForConversion) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.ConversionID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountDepositFromConversion) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.ConversionID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReceiveConversionPosition) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.ConversionID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReleaseConversionPosition) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.ConversionID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountRecognizeConversionSpread) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.ConversionID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReserveTransferHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.TransferID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReceiveTransferHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.TransferID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReleaseTransferHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.TransferID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountWithdrawReleasedTransferHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.TransferID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReserveDisputeHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.DisputeID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReceiveDisputeHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.DisputeID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountReleaseDisputeHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.DisputeID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
+func (e *EventLedgerAccountWithdrawReleasedDisputeHold) paymentEvent() ledgerPaymentEventData {
+	return ledgerPaymentEventData{
+		transactionID:         e.TransactionID,
+		paymentID:             e.DisputeID,
+		counterpartyAccountID: e.CounterpartyAccountID,
+		currency:              e.Currency,
+		amount:                e.Amount,
+		bookedAt:              e.BookedAt,
+	}
+}
+
 type EventLedgerAccountTransferredToAccount struct {
 	TransactionID string    `json:"transaction_id"`
 	ToAccountID   string    `json:"to_account_id"`
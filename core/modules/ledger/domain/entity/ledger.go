@@ -23,6 +23,8 @@ type LedgerEntry struct {
 	AccountID     string    `json:"account_id"`
 	Currency      string    `json:"currency"`
 	Amount        int64     `json:"amount"`
+	ReferenceType string    `json:"reference_type,omitempty"`
+	ReferenceID   string    `json:"reference_id,omitempty"`
 	CreatedAt     time.Time `json:"created_at"`
 }
 
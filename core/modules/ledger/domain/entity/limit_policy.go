@@ -0,0 +1,243 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/finance"
+)
+
+const (
+	LimitTierUnverified = "unverified"
+	LimitTierVerified   = "verified"
+	LimitTierBusiness   = "business"
+
+	LimitOperationTransfer   = "transfer"
+	LimitOperationWithdrawal = "withdrawal"
+	LimitOperationTopUp      = "top_up"
+
+	// SystemAccountPrefix marks ledger-owned accounts such as clearing, fee,
+	// hold and escrow accounts. Limits only apply to user accounts.
+	SystemAccountPrefix = "ledger:"
+)
+
+var (
+	ErrLimitTierInvalid      = errors.New("limit tier must be unverified, verified or business")
+	ErrLimitOperationInvalid = errors.New("limit operation must be transfer, withdrawal or top_up")
+	ErrLimitScheduleInvalid  = errors.New("limit schedule is invalid")
+	ErrLimitVelocityInvalid  = errors.New("velocity rules are invalid")
+
+	ErrLimitSingleExceeded   = errors.New("amount exceeds the single transaction limit")
+	ErrLimitDailyExceeded    = errors.New("daily limit exceeded")
+	ErrLimitMonthlyExceeded  = errors.New("monthly limit exceeded")
+	ErrLimitVelocityExceeded = errors.New("too many operations in a short period")
+)
+
+// LimitCaps bounds one operation in one currency. A zero cap is unlimited.
+type LimitCaps struct {
+	Single  int64
+	Daily   int64
+	Monthly int64
+}
+
+// VelocityRule allows at most MaxCount operations of one kind within any
+// Window, across currencies.
+type VelocityRule struct {
+	Operation string
+	MaxCount  int
+	Window    time.Duration
+}
+
+// LimitPolicy holds the caps of every tier, keyed by "<operation>/<currency>",
+// and the velocity rules shared by all tiers. Operations without caps for a
+// currency are unlimited.
+type LimitPolicy struct {
+	Tiers    map[string]map[string]LimitCaps
+	Velocity []VelocityRule
+}
+
+// LimitUsage is one operation counted against an account's limits.
+// ReferenceID identifies the operation so retries are counted once.
+type LimitUsage struct {
+	AccountID   string
+	Operation   string
+	Currency    string
+	Amount      int64
+	ReferenceID string
+	CreatedAt   time.Time
+}
+
+// LimitTotals is what an account has already used in the current day and
+// month for one operation and currency.
+type LimitTotals struct {
+	Daily   int64
+	Monthly int64
+}
+
+func IsSystemAccount(accountID string) bool {
+	return strings.HasPrefix(strings.TrimSpace(accountID), SystemAccountPrefix)
+}
+
+func NormalizeLimitTier(tier string) (string, error) {
+	tier = strings.ToLower(strings.TrimSpace(tier))
+	switch tier {
+	case LimitTierUnverified, LimitTierVerified, LimitTierBusiness:
+		return tier, nil
+	default:
+		return "", ErrLimitTierInvalid
+	}
+}
+
+func NormalizeLimitOperation(operation string) (string, error) {
+	operation = strings.ToLower(strings.TrimSpace(operation))
+	switch operation {
+	case LimitOperationTransfer, LimitOperationWithdrawal, LimitOperationTopUp:
+		return operation, nil
+	default:
+		return "", ErrLimitOperationInvalid
+	}
+}
+
+// ParseLimitPolicy builds a policy from one schedule per tier, in the
+// ParseLimitSchedule format, and velocity rules in the ParseVelocityRules
+// format.
+func ParseLimitPolicy(unverified, verified, business, velocity string) (LimitPolicy, error) {
+	policy := LimitPolicy{Tiers: make(map[string]map[string]LimitCaps, 3)}
+	for tier, spec := range map[string]string{
+		LimitTierUnverified: unverified,
+		LimitTierVerified:   verified,
+		LimitTierBusiness:   business,
+	} {
+		schedule, err := ParseLimitSchedule(spec)
+		if err != nil {
+			return LimitPolicy{}, fmt.Errorf("%s tier: %w", tier, err)
+		}
+		policy.Tiers[tier] = schedule
+	}
+
+	rules, err := ParseVelocityRules(velocity)
+	if err != nil {
+		return LimitPolicy{}, err
+	}
+	policy.Velocity = rules
+	return policy, nil
+}
+
+// ParseLimitSchedule reads one tier's caps written as
+// "transfer/VND:5000000/20000000/100000000,withdrawal/USD:0/500000/2000000",
+// where the amounts are the single, daily and monthly caps in minor units.
+func ParseLimitSchedule(spec string) (map[string]LimitCaps, error) {
+	schedule := make(map[string]LimitCaps)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, ":")
+		operation, currency, keyOK := strings.Cut(key, "/")
+		if !ok || !keyOK {
+			return nil, fmt.Errorf("%w: %q", ErrLimitScheduleInvalid, entry)
+		}
+		operation, err := NormalizeLimitOperation(operation)
+		if err != nil || finance.NormalizeCurrency(currency) == "" {
+			return nil, fmt.Errorf("%w: %q", ErrLimitScheduleInvalid, entry)
+		}
+
+		amounts := strings.Split(value, "/")
+		if len(amounts) != 3 {
+			return nil, fmt.Errorf("%w: %q", ErrLimitScheduleInvalid, entry)
+		}
+		caps := make([]int64, 0, len(amounts))
+		for _, amount := range amounts {
+			parsed, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("%w: %q", ErrLimitScheduleInvalid, entry)
+			}
+			caps = append(caps, parsed)
+		}
+		schedule[limitKey(operation, currency)] = LimitCaps{Single: caps[0], Daily: caps[1], Monthly: caps[2]}
+	}
+	return schedule, nil
+}
+
+// ParseVelocityRules reads rules written as "transfer:10/5m,withdrawal:3/1h".
+func ParseVelocityRules(spec string) ([]VelocityRule, error) {
+	rules := make([]VelocityRule, 0)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		operation, value, ok := strings.Cut(entry, ":")
+		count, window, valueOK := strings.Cut(value, "/")
+		if !ok || !valueOK {
+			return nil, fmt.Errorf("%w: %q", ErrLimitVelocityInvalid, entry)
+		}
+		operation, err := NormalizeLimitOperation(operation)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrLimitVelocityInvalid, entry)
+		}
+		maxCount, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || maxCount <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrLimitVelocityInvalid, entry)
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(window))
+		if err != nil || duration <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrLimitVelocityInvalid, entry)
+		}
+		rules = append(rules, VelocityRule{Operation: operation, MaxCount: maxCount, Window: duration})
+	}
+	return rules, nil
+}
+
+func (p LimitPolicy) Caps(tier, operation, currency string) (LimitCaps, bool) {
+	caps, ok := p.Tiers[tier][limitKey(operation, currency)]
+	return caps, ok
+}
+
+func (p LimitPolicy) VelocityRules(operation string) []VelocityRule {
+	rules := make([]VelocityRule, 0)
+	for _, rule := range p.Velocity {
+		if rule.Operation == operation {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// CheckCaps reports whether usage fits the tier's caps given what the
+// account already used in the current day and month.
+func (p LimitPolicy) CheckCaps(tier string, usage LimitUsage, totals LimitTotals) error {
+	caps, ok := p.Caps(tier, usage.Operation, usage.Currency)
+	if !ok {
+		return nil
+	}
+	switch {
+	case caps.Single > 0 && usage.Amount > caps.Single:
+		return fmt.Errorf("%w: %s %s limit is %d", ErrLimitSingleExceeded, usage.Operation, usage.Currency, caps.Single)
+	case caps.Daily > 0 && totals.Daily+usage.Amount > caps.Daily:
+		return fmt.Errorf("%w: %s %s limit is %d, %d already used today", ErrLimitDailyExceeded, usage.Operation, usage.Currency, caps.Daily, totals.Daily)
+	case caps.Monthly > 0 && totals.Monthly+usage.Amount > caps.Monthly:
+		return fmt.Errorf("%w: %s %s limit is %d, %d already used this month", ErrLimitMonthlyExceeded, usage.Operation, usage.Currency, caps.Monthly, totals.Monthly)
+	}
+	return nil
+}
+
+// LimitDayStart and LimitMonthStart bound the calendar periods, in UTC, that
+// daily and monthly caps count over.
+func LimitDayStart(at time.Time) time.Time {
+	at = at.UTC()
+	return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func LimitMonthStart(at time.Time) time.Time {
+	at = at.UTC()
+	return time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func limitKey(operation, currency string) string {
+	return operation + "/" + finance.NormalizeCurrency(currency)
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLimitPolicy(t *testing.T) {
+	policy, err := ParseLimitPolicy(
+		"transfer/vnd:1000/5000/20000, withdrawal/VND:0/3000/0",
+		"transfer/VND:10000/50000/200000",
+		"",
+		"transfer:10/5m,withdrawal:3/1h",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	caps, ok := policy.Caps(LimitTierUnverified, LimitOperationTransfer, "VND")
+	if !ok || caps != (LimitCaps{Single: 1000, Daily: 5000, Monthly: 20000}) {
+		t.Fatalf("unexpected unverified transfer caps %+v (found=%v)", caps, ok)
+	}
+	if _, ok := policy.Caps(LimitTierBusiness, LimitOperationTransfer, "VND"); ok {
+		t.Fatalf("expected business tier to be unlimited")
+	}
+	rules := policy.VelocityRules(LimitOperationWithdrawal)
+	if len(rules) != 1 || rules[0].MaxCount != 3 || rules[0].Window != time.Hour {
+		t.Fatalf("unexpected withdrawal velocity rules %+v", rules)
+	}
+
+	for _, spec := range []string{"transfer/VND:1/2", "payout/VND:1/2/3", "transfer:1/2/3", "transfer/VND:-1/2/3"} {
+		if _, err := ParseLimitSchedule(spec); !errors.Is(err, ErrLimitScheduleInvalid) {
+			t.Fatalf("expected schedule %q to be rejected, got %v", spec, err)
+		}
+	}
+	for _, spec := range []string{"transfer:0/5m", "transfer:10", "transfer:10/soon"} {
+		if _, err := ParseVelocityRules(spec); !errors.Is(err, ErrLimitVelocityInvalid) {
+			t.Fatalf("expected velocity rule %q to be rejected, got %v", spec, err)
+		}
+	}
+}
+
+func TestLimitPolicyCheckCaps(t *testing.T) {
+	policy := LimitPolicy{Tiers: map[string]map[string]LimitCaps{
+		LimitTierUnverified: {"transfer/VND": {Single: 1000, Daily: 2500, Monthly: 4000}},
+	}}
+	usage := func(amount int64) LimitUsage {
+		return LimitUsage{Operation: LimitOperationTransfer, Currency: "VND", Amount: amount}
+	}
+
+	tests := []struct {
+		name   string
+		usage  LimitUsage
+		totals LimitTotals
+		want   error
+	}{
+		{name: "within caps", usage: usage(1000), totals: LimitTotals{Daily: 1500, Monthly: 3000}},
+		{name: "single", usage: usage(1001), want: ErrLimitSingleExceeded},
+		{name: "daily", usage: usage(600), totals: LimitTotals{Daily: 2000, Monthly: 2000}, want: ErrLimitDailyExceeded},
+		{name: "monthly", usage: usage(600), totals: LimitTotals{Daily: 0, Monthly: 3500}, want: ErrLimitMonthlyExceeded},
+		{name: "other currency is unlimited", usage: LimitUsage{Operation: LimitOperationTransfer, Currency: "USD", Amount: 1_000_000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.CheckCaps(LimitTierUnverified, tt.usage, tt.totals)
+			if tt.want == nil && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ var (
 	ErrPaymentBookingAccountsMustDiffer    = errors.New("debit_account_id and credit_account_id must be different")
 	ErrPaymentBookingAmountInvalid         = errors.New("amount must be positive")
 	ErrPaymentBookingTypeInvalid           = errors.New("payment booking type is invalid")
+	ErrPaymentBookingDisputeIDRequired     = errors.New("dispute_id is required")
 )
 
 type PaymentSucceededBooking struct {
@@ -38,6 +39,7 @@ type PaymentSucceededBookingInput struct {
 
 type PaymentReversalBooking struct {
 	PaymentID          string
+	RefundID           string
 	ClearingAccountKey string
 	ReversalType       string
 	DebitAccountID     string
@@ -46,16 +48,42 @@ type PaymentReversalBooking struct {
 	Amount             int64
 }
 
+// PaymentReversalBookingInput describes a refund or chargeback. A chargeback
+// that closes a lost dispute sets DisputeID and is taken from the dispute's
+// hold account, where the funds already sit, instead of CreditAccountID. One
+// of several partial refunds sets RefundID, which keeps its transaction apart
+// from the others.
 type PaymentReversalBookingInput struct {
 	PaymentID          string
 	TransactionID      string
+	RefundID           string
 	ClearingAccountKey string
 	CreditAccountID    string
+	DisputeID          string
 	Currency           string
 	Amount             int64
 	ReversalType       string
 }
 
+// PaymentDisputeHoldBooking moves a disputed amount between the account the
+// top-up credited and the dispute's hold account.
+type PaymentDisputeHoldBooking struct {
+	DisputeID     string
+	PaymentID     string
+	AccountID     string
+	HoldAccountID string
+	Currency      string
+	Amount        int64
+}
+
+type PaymentDisputeHoldBookingInput struct {
+	DisputeID string
+	PaymentID string
+	AccountID string
+	Currency  string
+	Amount    int64
+}
+
 func NewPaymentSucceededBooking(input PaymentSucceededBookingInput) (*PaymentSucceededBooking, error) {
 	paymentID := strings.TrimSpace(input.PaymentID)
 	if paymentID == "" {
@@ -125,6 +153,9 @@ func NewPaymentReversalBooking(input PaymentReversalBookingInput) (*PaymentRever
 		return nil, ErrPaymentBookingClearingKeyRequired
 	}
 	debitAccountID := strings.TrimSpace(input.CreditAccountID)
+	if disputeID := strings.TrimSpace(input.DisputeID); disputeID != "" && reversalType == sharedevents.EventPaymentChargeback {
+		debitAccountID = DisputeHoldAccountID(disputeID)
+	}
 	if debitAccountID == "" {
 		return nil, ErrPaymentBookingCreditAccountRequired
 	}
@@ -142,6 +173,7 @@ func NewPaymentReversalBooking(input PaymentReversalBookingInput) (*PaymentRever
 
 	return &PaymentReversalBooking{
 		PaymentID:          paymentID,
+		RefundID:           strings.TrimSpace(input.RefundID),
 		ClearingAccountKey: clearingAccountKey,
 		ReversalType:       reversalType,
 		DebitAccountID:     debitAccountID,
@@ -159,6 +191,9 @@ func (b *PaymentReversalBooking) LedgerTransactionID() string {
 	case sharedevents.EventPaymentChargeback:
 		suffix = "chargeback"
 	}
+	if b.RefundID != "" && b.ReversalType == sharedevents.EventPaymentRefunded {
+		suffix += ":" + b.RefundID
+	}
 	return fmt.Sprintf("payment:%s:%s", strings.TrimSpace(b.PaymentID), suffix)
 }
 
@@ -169,6 +204,68 @@ func (b *PaymentReversalBooking) LedgerEntries() []LedgerEntryInput {
 	}
 }
 
+// RefundFeeShare is the part of a refund that goes back out of the fee
+// account. The fee is refunded in proportion to the provider amount, worked
+// out on running totals so the shares of several partial refunds add up to
+// exactly the fee. refundedAmount is the total refunded including this
+// refund; zero, as in events from before partial refunds, refunds it all.
+func RefundFeeShare(providerAmount, feeAmount, amount, refundedAmount int64) int64 {
+	if feeAmount <= 0 || providerAmount <= 0 || amount <= 0 {
+		return 0
+	}
+	if refundedAmount <= 0 {
+		return feeAmount
+	}
+	after := min(refundedAmount, providerAmount)
+	before := max(after-amount, 0)
+	return feeAmount*after/providerAmount - feeAmount*before/providerAmount
+}
+
+func NewPaymentDisputeHoldBooking(input PaymentDisputeHoldBookingInput) (*PaymentDisputeHoldBooking, error) {
+	disputeID := strings.TrimSpace(input.DisputeID)
+	if disputeID == "" {
+		return nil, ErrPaymentBookingDisputeIDRequired
+	}
+	paymentID := strings.TrimSpace(input.PaymentID)
+	if paymentID == "" {
+		return nil, ErrPaymentBookingIDRequired
+	}
+	accountID := strings.TrimSpace(input.AccountID)
+	if accountID == "" {
+		return nil, ErrPaymentBookingCreditAccountRequired
+	}
+	currency := strings.ToUpper(strings.TrimSpace(input.Currency))
+	if currency == "" {
+		return nil, ErrPaymentBookingCurrencyRequired
+	}
+	if input.Amount <= 0 {
+		return nil, ErrPaymentBookingAmountInvalid
+	}
+
+	return &PaymentDisputeHoldBooking{
+		DisputeID:     disputeID,
+		PaymentID:     paymentID,
+		AccountID:     accountID,
+		HoldAccountID: DisputeHoldAccountID(disputeID),
+		Currency:      currency,
+		Amount:        input.Amount,
+	}, nil
+}
+
+func (b *PaymentDisputeHoldBooking) HoldTransactionID() string {
+	return fmt.Sprintf("payment:%s:dispute:%s:hold", b.PaymentID, b.DisputeID)
+}
+
+func (b *PaymentDisputeHoldBooking) ReleaseTransactionID() string {
+	return fmt.Sprintf("payment:%s:dispute:%s:released", b.PaymentID, b.DisputeID)
+}
+
+// DisputeHoldAccountID is the system account holding a dispute's funds while
+// it is open.
+func DisputeHoldAccountID(disputeID string) string {
+	return fmt.Sprintf("ledger:hold:dispute:%s", strings.TrimSpace(disputeID))
+}
+
 func ledgerClearingAccountID(clearingAccountKey string) string {
 	return fmt.Sprintf("ledger:clearing:%s", strings.ToLower(strings.TrimSpace((clearingAccountKey))))
 }
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	sharedevents "wechat-clone/core/shared/contracts/events"
+)
+
+func TestRefundFeeShareAddsUpToTheFee(t *testing.T) {
+	// A 1000 top-up that paid a 33 fee, refunded in three parts.
+	refunds := []int64{333, 333, 367}
+	var refunded, fees int64
+	for _, amount := range refunds {
+		refunded += amount
+		share := RefundFeeShare(1033, 33, amount, refunded)
+		if share < 0 || share > amount {
+			t.Fatalf("unexpected fee share %d of refund %d", share, amount)
+		}
+		fees += share
+	}
+	if fees != 33 {
+		t.Fatalf("expected partial refunds to refund the whole fee, got %d", fees)
+	}
+
+	if share := RefundFeeShare(1033, 33, 1033, 0); share != 33 {
+		t.Fatalf("expected a refund without a running total to refund the whole fee, got %d", share)
+	}
+	if share := RefundFeeShare(1000, 0, 500, 500); share != 0 {
+		t.Fatalf("expected no fee share without a fee, got %d", share)
+	}
+}
+
+func TestPaymentReversalBookingKeepsPartialRefundsApart(t *testing.T) {
+	booking, err := NewPaymentReversalBooking(PaymentReversalBookingInput{
+		PaymentID:          "pay-1",
+		RefundID:           "re-1",
+		ClearingAccountKey: "provider:stripe",
+		CreditAccountID:    "wallet:available",
+		Currency:           "VND",
+		Amount:             100,
+		ReversalType:       sharedevents.EventPaymentRefunded,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if id := booking.LedgerTransactionID(); id != "payment:pay-1:refunded:re-1" {
+		t.Fatalf("unexpected ledger transaction id %s", id)
+	}
+}
@@ -0,0 +1,45 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/ledger/domain/aggregate"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+)
+
+// LedgerAuditAccount is a ledger account stream as recorded in the aggregate
+// version table.
+type LedgerAuditAccount struct {
+	AccountID string
+	Version   int
+}
+
+// LedgerAuditSnapshot is the latest persisted snapshot of an account stream.
+type LedgerAuditSnapshot struct {
+	Version   int
+	Aggregate *aggregate.LedgerAccountAggregate
+}
+
+// LedgerProjectedBalance is an account balance as summed by the read-side
+// ledger_entries projection.
+type LedgerProjectedBalance struct {
+	AccountID string
+	Currency  string
+	Balance   int64
+}
+
+// LedgerAuditRepository reads the event store, snapshots and projection for
+// integrity audits. It never writes.
+//
+//go:generate mockgen -package=repos -destination=ledger_audit_repo_mock.go -source=ledger_audit_repo.go
+type LedgerAuditRepository interface {
+	// ReadOnly runs fn inside a read-only, repeatable-read transaction so every
+	// read in the audit sees the same point in time.
+	ReadOnly(ctx context.Context, fn func(LedgerAuditRepository) error) error
+	// ListAccounts pages account streams ordered by account id, starting after
+	// afterAccountID.
+	ListAccounts(ctx context.Context, afterAccountID string, limit int) ([]LedgerAuditAccount, error)
+	ListAccountEvents(ctx context.Context, accountID string) ([]eventpkg.Event, error)
+	GetLatestSnapshot(ctx context.Context, accountID string) (*LedgerAuditSnapshot, error)
+	ListProjectedBalances(ctx context.Context) ([]LedgerProjectedBalance, error)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_audit_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=ledger_audit_repo_mock.go -source=ledger_audit_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	event "wechat-clone/core/shared/pkg/event"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerAuditRepository is a mock of LedgerAuditRepository interface.
+type MockLedgerAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerAuditRepositoryMockRecorder is the mock recorder for MockLedgerAuditRepository.
+type MockLedgerAuditRepositoryMockRecorder struct {
+	mock *MockLedgerAuditRepository
+}
+
+// NewMockLedgerAuditRepository creates a new mock instance.
+func NewMockLedgerAuditRepository(ctrl *gomock.Controller) *MockLedgerAuditRepository {
+	mock := &MockLedgerAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerAuditRepository) EXPECT() *MockLedgerAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetLatestSnapshot mocks base method.
+func (m *MockLedgerAuditRepository) GetLatestSnapshot(ctx context.Context, accountID string) (*LedgerAuditSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestSnapshot", ctx, accountID)
+	ret0, _ := ret[0].(*LedgerAuditSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestSnapshot indicates an expected call of GetLatestSnapshot.
+func (mr *MockLedgerAuditRepositoryMockRecorder) GetLatestSnapshot(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSnapshot", reflect.TypeOf((*MockLedgerAuditRepository)(nil).GetLatestSnapshot), ctx, accountID)
+}
+
+// ListAccountEvents mocks base method.
+func (m *MockLedgerAuditRepository) ListAccountEvents(ctx context.Context, accountID string) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountEvents", ctx, accountID)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountEvents indicates an expected call of ListAccountEvents.
+func (mr *MockLedgerAuditRepositoryMockRecorder) ListAccountEvents(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountEvents", reflect.TypeOf((*MockLedgerAuditRepository)(nil).ListAccountEvents), ctx, accountID)
+}
+
+// ListAccounts mocks base method.
+func (m *MockLedgerAuditRepository) ListAccounts(ctx context.Context, afterAccountID string, limit int) ([]LedgerAuditAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, afterAccountID, limit)
+	ret0, _ := ret[0].([]LedgerAuditAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockLedgerAuditRepositoryMockRecorder) ListAccounts(ctx, afterAccountID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockLedgerAuditRepository)(nil).ListAccounts), ctx, afterAccountID, limit)
+}
+
+// ListProjectedBalances mocks base method.
+func (m *MockLedgerAuditRepository) ListProjectedBalances(ctx context.Context) ([]LedgerProjectedBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectedBalances", ctx)
+	ret0, _ := ret[0].([]LedgerProjectedBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectedBalances indicates an expected call of ListProjectedBalances.
+func (mr *MockLedgerAuditRepositoryMockRecorder) ListProjectedBalances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectedBalances", reflect.TypeOf((*MockLedgerAuditRepository)(nil).ListProjectedBalances), ctx)
+}
+
+// ReadOnly mocks base method.
+func (m *MockLedgerAuditRepository) ReadOnly(ctx context.Context, fn func(LedgerAuditRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOnly", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadOnly indicates an expected call of ReadOnly.
+func (mr *MockLedgerAuditRepositoryMockRecorder) ReadOnly(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOnly", reflect.TypeOf((*MockLedgerAuditRepository)(nil).ReadOnly), ctx, fn)
+}
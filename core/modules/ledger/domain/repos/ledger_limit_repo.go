@@ -0,0 +1,24 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/ledger/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=ledger_limit_repo_mock.go -source=ledger_limit_repo.go
+type LedgerLimitRepository interface {
+	// LockAccountTier returns the account's tier, creating it as unverified
+	// on first use, and holds a row lock on it until the transaction ends so
+	// limit checks for one account run one at a time.
+	LockAccountTier(ctx context.Context, accountID string) (string, error)
+	GetAccountTier(ctx context.Context, accountID string) (string, error)
+	SetAccountTier(ctx context.Context, accountID, tier string, updatedAt time.Time) error
+	// RecordUsage stores usage and reports false when an operation with the
+	// same operation and reference id was already recorded.
+	RecordUsage(ctx context.Context, usage entity.LimitUsage) (bool, error)
+	DeleteUsage(ctx context.Context, operation, referenceID string) error
+	SumUsage(ctx context.Context, accountID, operation, currency string, since time.Time) (int64, error)
+	CountUsage(ctx context.Context, accountID, operation string, since time.Time) (int, error)
+}
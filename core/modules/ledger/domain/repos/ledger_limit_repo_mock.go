@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_limit_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=ledger_limit_repo_mock.go -source=ledger_limit_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	entity "wechat-clone/core/modules/ledger/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerLimitRepository is a mock of LedgerLimitRepository interface.
+type MockLedgerLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerLimitRepositoryMockRecorder is the mock recorder for MockLedgerLimitRepository.
+type MockLedgerLimitRepositoryMockRecorder struct {
+	mock *MockLedgerLimitRepository
+}
+
+// NewMockLedgerLimitRepository creates a new mock instance.
+func NewMockLedgerLimitRepository(ctrl *gomock.Controller) *MockLedgerLimitRepository {
+	mock := &MockLedgerLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerLimitRepository) EXPECT() *MockLedgerLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUsage mocks base method.
+func (m *MockLedgerLimitRepository) CountUsage(ctx context.Context, accountID, operation string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsage", ctx, accountID, operation, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsage indicates an expected call of CountUsage.
+func (mr *MockLedgerLimitRepositoryMockRecorder) CountUsage(ctx, accountID, operation, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsage", reflect.TypeOf((*MockLedgerLimitRepository)(nil).CountUsage), ctx, accountID, operation, since)
+}
+
+// DeleteUsage mocks base method.
+func (m *MockLedgerLimitRepository) DeleteUsage(ctx context.Context, operation, referenceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUsage", ctx, operation, referenceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUsage indicates an expected call of DeleteUsage.
+func (mr *MockLedgerLimitRepositoryMockRecorder) DeleteUsage(ctx, operation, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUsage", reflect.TypeOf((*MockLedgerLimitRepository)(nil).DeleteUsage), ctx, operation, referenceID)
+}
+
+// GetAccountTier mocks base method.
+func (m *MockLedgerLimitRepository) GetAccountTier(ctx context.Context, accountID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTier", ctx, accountID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTier indicates an expected call of GetAccountTier.
+func (mr *MockLedgerLimitRepositoryMockRecorder) GetAccountTier(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTier", reflect.TypeOf((*MockLedgerLimitRepository)(nil).GetAccountTier), ctx, accountID)
+}
+
+// LockAccountTier mocks base method.
+func (m *MockLedgerLimitRepository) LockAccountTier(ctx context.Context, accountID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccountTier", ctx, accountID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAccountTier indicates an expected call of LockAccountTier.
+func (mr *MockLedgerLimitRepositoryMockRecorder) LockAccountTier(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccountTier", reflect.TypeOf((*MockLedgerLimitRepository)(nil).LockAccountTier), ctx, accountID)
+}
+
+// RecordUsage mocks base method.
+func (m *MockLedgerLimitRepository) RecordUsage(ctx context.Context, usage entity.LimitUsage) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUsage", ctx, usage)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordUsage indicates an expected call of RecordUsage.
+func (mr *MockLedgerLimitRepositoryMockRecorder) RecordUsage(ctx, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUsage", reflect.TypeOf((*MockLedgerLimitRepository)(nil).RecordUsage), ctx, usage)
+}
+
+// SetAccountTier mocks base method.
+func (m *MockLedgerLimitRepository) SetAccountTier(ctx context.Context, accountID, tier string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountTier", ctx, accountID, tier, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountTier indicates an expected call of SetAccountTier.
+func (mr *MockLedgerLimitRepositoryMockRecorder) SetAccountTier(ctx, accountID, tier, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountTier", reflect.TypeOf((*MockLedgerLimitRepository)(nil).SetAccountTier), ctx, accountID, tier, updatedAt)
+}
+
+// SumUsage mocks base method.
+func (m *MockLedgerLimitRepository) SumUsage(ctx context.Context, accountID, operation, currency string, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumUsage", ctx, accountID, operation, currency, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumUsage indicates an expected call of SumUsage.
+func (mr *MockLedgerLimitRepositoryMockRecorder) SumUsage(ctx, accountID, operation, currency, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumUsage", reflect.TypeOf((*MockLedgerLimitRepository)(nil).SumUsage), ctx, accountID, operation, currency, since)
+}
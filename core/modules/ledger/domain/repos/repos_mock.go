@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: core/modules/ledger/domain/repos/repos.go
+// Source: repos.go
 //
 // Generated by this command:
 //
-//	mockgen -package=repos -destination=core/modules/ledger/domain/repos/repos_mock.go -source=core/modules/ledger/domain/repos/repos.go
+//	mockgen -package=repos -destination=repos_mock.go -source=repos.go
 //
 
 // Package repos is a generated GoMock package.
@@ -54,6 +54,20 @@ func (mr *MockReposMockRecorder) LedgerAccountAggregateRepository() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LedgerAccountAggregateRepository", reflect.TypeOf((*MockRepos)(nil).LedgerAccountAggregateRepository))
 }
 
+// LedgerLimitRepository mocks base method.
+func (m *MockRepos) LedgerLimitRepository() LedgerLimitRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LedgerLimitRepository")
+	ret0, _ := ret[0].(LedgerLimitRepository)
+	return ret0
+}
+
+// LedgerLimitRepository indicates an expected call of LedgerLimitRepository.
+func (mr *MockReposMockRecorder) LedgerLimitRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LedgerLimitRepository", reflect.TypeOf((*MockRepos)(nil).LedgerLimitRepository))
+}
+
 // WithTransaction mocks base method.
 func (m *MockRepos) WithTransaction(ctx context.Context, fn func(Repos) error) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type LedgerAccountLimitTierModel struct {
+	AccountID string    `gorm:"column:account_id;primaryKey"`
+	Tier      string    `gorm:"column:tier;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (LedgerAccountLimitTierModel) TableName() string {
+	return "ledger_account_limit_tiers"
+}
+
+type LedgerLimitUsageModel struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	AccountID   string    `gorm:"column:account_id;not null"`
+	Operation   string    `gorm:"column:operation;not null"`
+	Currency    string    `gorm:"column:currency;not null"`
+	Amount      int64     `gorm:"column:amount;not null"`
+	ReferenceID string    `gorm:"column:reference_id;not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+}
+
+func (LedgerLimitUsageModel) TableName() string {
+	return "ledger_limit_usages"
+}
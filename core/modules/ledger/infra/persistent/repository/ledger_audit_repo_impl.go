@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	ledgeraggregate "wechat-clone/core/modules/ledger/domain/aggregate"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/modules/ledger/infra/persistent/model"
+	"wechat-clone/core/modules/ledger/infra/projection/views"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type ledgerAuditRepoImpl struct {
+	db            *gorm.DB
+	eventStore    *ledgerEventStoreImpl
+	aggregateType string
+}
+
+func NewLedgerAuditRepoImpl(db *gorm.DB) ledgerrepos.LedgerAuditRepository {
+	serializer := eventpkg.NewSerializer()
+	if err := serializer.RegisterAggregate(&ledgeraggregate.LedgerAccountAggregate{}); err != nil {
+		panic(fmt.Sprintf("register ledger account aggregate serializer failed: %v", err))
+	}
+	return newLedgerAuditRepoImpl(db, serializer)
+}
+
+func newLedgerAuditRepoImpl(db *gorm.DB, serializer eventpkg.Serializer) *ledgerAuditRepoImpl {
+	return &ledgerAuditRepoImpl{
+		db:            db,
+		eventStore:    &ledgerEventStoreImpl{db: db, serializer: serializer},
+		aggregateType: eventpkg.AggregateTypeName(&ledgeraggregate.LedgerAccountAggregate{}),
+	}
+}
+
+func (r *ledgerAuditRepoImpl) ReadOnly(ctx context.Context, fn func(ledgerrepos.LedgerAuditRepository) error) error {
+	return stackErr.Error(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(newLedgerAuditRepoImpl(tx, r.eventStore.serializer))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}))
+}
+
+func (r *ledgerAuditRepoImpl) ListAccounts(ctx context.Context, afterAccountID string, limit int) ([]ledgerrepos.LedgerAuditAccount, error) {
+	query := r.db.WithContext(ctx).
+		Model(&model.LedgerAggregateModel{}).
+		Where("aggregate_type = ?", r.aggregateType)
+	if afterAccountID = strings.TrimSpace(afterAccountID); afterAccountID != "" {
+		query = query.Where("aggregate_id > ?", afterAccountID)
+	}
+
+	var aggregates []model.LedgerAggregateModel
+	if err := query.Order("aggregate_id ASC").Limit(limit).Find(&aggregates).Error; err != nil {
+		return nil, stackErr.Error(mapError(err))
+	}
+
+	accounts := make([]ledgerrepos.LedgerAuditAccount, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		accounts = append(accounts, ledgerrepos.LedgerAuditAccount{
+			AccountID: aggregate.AggregateID,
+			Version:   aggregate.Version,
+		})
+	}
+	return accounts, nil
+}
+
+func (r *ledgerAuditRepoImpl) ListAccountEvents(ctx context.Context, accountID string) ([]eventpkg.Event, error) {
+	var eventModels []model.LedgerEventModel
+	if err := r.db.WithContext(ctx).
+		Where("aggregate_id = ? AND aggregate_type = ?", accountID, r.aggregateType).
+		Order("version ASC").
+		Find(&eventModels).Error; err != nil {
+		return nil, stackErr.Error(mapError(err))
+	}
+
+	events := make([]eventpkg.Event, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		evt, err := r.eventStore.toDomainEvent(eventModel)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		events = append(events, evt)
+	}
+	return events, nil
+}
+
+func (r *ledgerAuditRepoImpl) GetLatestSnapshot(ctx context.Context, accountID string) (*ledgerrepos.LedgerAuditSnapshot, error) {
+	agg, err := ledgeraggregate.NewLedgerAccountAggregate(accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	ok, err := r.eventStore.ReadSnapshot(ctx, accountID, r.aggregateType, agg)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &ledgerrepos.LedgerAuditSnapshot{
+		Version:   agg.Root().Version(),
+		Aggregate: agg,
+	}, nil
+}
+
+func (r *ledgerAuditRepoImpl) ListProjectedBalances(ctx context.Context) ([]ledgerrepos.LedgerProjectedBalance, error) {
+	var rows []ledgerrepos.LedgerProjectedBalance
+	if err := r.db.WithContext(ctx).
+		Model(&views.LedgerEntryModel{}).
+		Select("account_id, currency, COALESCE(SUM(amount), 0) AS balance").
+		Group("account_id, currency").
+		Order("account_id ASC").
+		Order("currency ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, stackErr.Error(mapError(err))
+	}
+	return rows, nil
+}
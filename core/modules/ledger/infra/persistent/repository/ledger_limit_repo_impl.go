@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/ledger/domain/entity"
+	ledgerrepos "wechat-clone/core/modules/ledger/domain/repos"
+	"wechat-clone/core/modules/ledger/infra/persistent/model"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ledgerLimitRepoImpl struct {
+	db *gorm.DB
+}
+
+func newLedgerLimitRepoImpl(db *gorm.DB) ledgerrepos.LedgerLimitRepository {
+	return &ledgerLimitRepoImpl{db: db}
+}
+
+func (r *ledgerLimitRepoImpl) LockAccountTier(ctx context.Context, accountID string) (string, error) {
+	accountID = strings.TrimSpace(accountID)
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.LedgerAccountLimitTierModel{
+			AccountID: accountID,
+			Tier:      entity.LimitTierUnverified,
+			UpdatedAt: time.Now().UTC(),
+		}).Error; err != nil {
+		return "", stackErr.Error(mapError(err))
+	}
+
+	var tier model.LedgerAccountLimitTierModel
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("account_id = ?", accountID).
+		Take(&tier).Error; err != nil {
+		return "", stackErr.Error(mapError(err))
+	}
+	return tier.Tier, nil
+}
+
+func (r *ledgerLimitRepoImpl) GetAccountTier(ctx context.Context, accountID string) (string, error) {
+	var tier model.LedgerAccountLimitTierModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", strings.TrimSpace(accountID)).
+		Take(&tier).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.LimitTierUnverified, nil
+	}
+	if err != nil {
+		return "", stackErr.Error(mapError(err))
+	}
+	return tier.Tier, nil
+}
+
+func (r *ledgerLimitRepoImpl) SetAccountTier(ctx context.Context, accountID, tier string, updatedAt time.Time) error {
+	return stackErr.Error(mapError(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tier", "updated_at"}),
+		}).
+		Create(&model.LedgerAccountLimitTierModel{
+			AccountID: strings.TrimSpace(accountID),
+			Tier:      tier,
+			UpdatedAt: updatedAt.UTC(),
+		}).Error))
+}
+
+func (r *ledgerLimitRepoImpl) RecordUsage(ctx context.Context, usage entity.LimitUsage) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "operation"}, {Name: "reference_id"}},
+			DoNothing: true,
+		}).
+		Create(&model.LedgerLimitUsageModel{
+			AccountID:   usage.AccountID,
+			Operation:   usage.Operation,
+			Currency:    usage.Currency,
+			Amount:      usage.Amount,
+			ReferenceID: usage.ReferenceID,
+			CreatedAt:   usage.CreatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return false, stackErr.Error(mapError(result.Error))
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *ledgerLimitRepoImpl) DeleteUsage(ctx context.Context, operation, referenceID string) error {
+	return stackErr.Error(mapError(r.db.WithContext(ctx).
+		Where("operation = ? AND reference_id = ?", operation, referenceID).
+		Delete(&model.LedgerLimitUsageModel{}).Error))
+}
+
+func (r *ledgerLimitRepoImpl) SumUsage(ctx context.Context, accountID, operation, currency string, since time.Time) (int64, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.LedgerLimitUsageModel{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND operation = ? AND currency = ? AND created_at >= ?", accountID, operation, currency, since.UTC()).
+		Scan(&total).Error; err != nil {
+		return 0, stackErr.Error(mapError(err))
+	}
+	return total, nil
+}
+
+func (r *ledgerLimitRepoImpl) CountUsage(ctx context.Context, accountID, operation string, since time.Time) (int, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&model.LedgerLimitUsageModel{}).
+		Where("account_id = ? AND operation = ? AND created_at > ?", accountID, operation, since.UTC()).
+		Count(&count).Error; err != nil {
+		return 0, stackErr.Error(mapError(err))
+	}
+	return int(count), nil
+}
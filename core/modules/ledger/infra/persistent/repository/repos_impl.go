@@ -18,6 +18,7 @@ type repoImpl struct {
 	db     *gorm.DB
 
 	ledgerAccountAggregateRepo ledgerrepos.LedgerAccountAggregateRepository
+	ledgerLimitRepo            ledgerrepos.LedgerLimitRepository
 }
 
 func NewRepoImpl(appCtx *appCtx.AppContext) ledgerrepos.Repos {
@@ -30,6 +31,7 @@ func newRepoImplWithDB(appCtx *appCtx.AppContext, db *gorm.DB) ledgerrepos.Repos
 		appCtx:                     appCtx,
 		db:                         db,
 		ledgerAccountAggregateRepo: ledgerAccountRepo,
+		ledgerLimitRepo:            newLedgerLimitRepoImpl(db),
 	}
 }
 
@@ -37,6 +39,10 @@ func (r *repoImpl) LedgerAccountAggregateRepository() ledgerrepos.LedgerAccountA
 	return r.ledgerAccountAggregateRepo
 }
 
+func (r *repoImpl) LedgerLimitRepository() ledgerrepos.LedgerLimitRepository {
+	return r.ledgerLimitRepo
+}
+
 func (r *repoImpl) PaymentReconciliationFailureEventStore() eventpkg.Store {
 	return NewLedgerOutboxEventsRepoImpl(r.db)
 }
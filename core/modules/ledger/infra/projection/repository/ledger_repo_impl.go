@@ -40,6 +40,46 @@ func (r *ledgerRepoImpl) GetBalance(ctx context.Context, accountID, currency str
 	return balance, mapError(err)
 }
 
+// GetBalanceBefore sums the account's entries booked strictly before the given
+// instant, which is the opening balance of a statement starting there.
+func (r *ledgerRepoImpl) GetBalanceBefore(ctx context.Context, accountID, currency string, before time.Time) (int64, error) {
+	var balance int64
+	err := r.db.WithContext(ctx).
+		Model(&views.LedgerEntryModel{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND currency = ? AND created_at < ?", accountID, currency, before.UTC()).
+		Scan(&balance).Error
+	return balance, mapError(err)
+}
+
+func (r *ledgerRepoImpl) ListStatementEntries(ctx context.Context, filter appprojection.StatementEntriesFilter) ([]*entity.LedgerEntry, error) {
+	var entryModels []views.LedgerEntryModel
+	if err := r.db.WithContext(ctx).
+		Where("account_id = ? AND currency = ?", filter.AccountID, filter.Currency).
+		Where("created_at >= ? AND created_at < ?", filter.From.UTC(), filter.To.UTC()).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&entryModels).Error; err != nil {
+		return nil, mapError(err)
+	}
+
+	entries := make([]*entity.LedgerEntry, 0, len(entryModels))
+	for _, entryModel := range entryModels {
+		entry := entryModel
+		entries = append(entries, &entity.LedgerEntry{
+			ID:            entry.ID,
+			TransactionID: entry.TransactionID,
+			AccountID:     entry.AccountID,
+			Currency:      entry.Currency,
+			Amount:        entry.Amount,
+			ReferenceType: entry.ReferenceType,
+			ReferenceID:   entry.ReferenceID,
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+	return entries, nil
+}
+
 func (r *ledgerRepoImpl) ProjectTransaction(ctx context.Context, transaction *appprojection.LedgerTransactionProjected) error {
 	return stackErr.Error(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := upsertProjectedTransactionHeader(ctx, tx, transaction); err != nil {
@@ -274,6 +314,8 @@ func upsertProjectedTransactionEntries(
 			AccountID:     strings.TrimSpace(projectedEntry.AccountID),
 			Currency:      strings.ToUpper(strings.TrimSpace(projectedEntry.Currency)),
 			Amount:        projectedEntry.Amount,
+			ReferenceType: strings.TrimSpace(transaction.ReferenceType),
+			ReferenceID:   strings.TrimSpace(transaction.ReferenceID),
 			CreatedAt:     projectedEntry.CreatedAt,
 		}
 		if err := mapError(tx.WithContext(ctx).Create(&entryModel).Error); err != nil {
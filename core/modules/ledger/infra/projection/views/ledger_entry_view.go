@@ -24,6 +24,8 @@ type LedgerEntryModel struct {
 	AccountID     string    `gorm:"not null"`
 	Currency      string    `gorm:"not null"`
 	Amount        int64     `gorm:"not null"`
+	ReferenceType string    `gorm:"not null;default:''"`
+	ReferenceID   string    `gorm:"not null;default:''"`
 	CreatedAt     time.Time `gorm:"autoCreateTime"`
 }
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	ledgerapp "wechat-clone/core/modules/ledger/application/service"
+	ledgerentity "wechat-clone/core/modules/ledger/domain/entity"
 	"wechat-clone/core/shared/contracts"
 	sharedevents "wechat-clone/core/shared/contracts/events"
 	sharedlock "wechat-clone/core/shared/infra/lock"
@@ -90,9 +91,29 @@ func (s *ledgerPaymentGRPCServer) applyPaymentEvent(ctx context.Context, eventNa
 		if err := contracts.UnmarshalEventData(raw, &payload); err != nil {
 			return false, stackErr.Error(fmt.Errorf("decode payment chargeback payload failed: %w", err))
 		}
-		return true, stackErr.Error(s.withLedgerLocks(ctx, reversedLockKeys(payload.ClearingAccountKey, payload.CreditAccountID, payload.FeeAmount, s.feeAccountID), func() error {
+		debitAccountID := payload.CreditAccountID
+		if strings.TrimSpace(payload.DisputeID) != "" {
+			debitAccountID = ledgerentity.DisputeHoldAccountID(payload.DisputeID)
+		}
+		return true, stackErr.Error(s.withLedgerLocks(ctx, reversedLockKeys(payload.ClearingAccountKey, debitAccountID, payload.FeeAmount, s.feeAccountID), func() error {
 			return stackErr.Error(s.service.HandleChargeback(ctx, payload))
 		}))
+	case sharedevents.EventPaymentDisputeOpened:
+		var payload sharedevents.PaymentDisputeOpenedEvent
+		if err := contracts.UnmarshalEventData(raw, &payload); err != nil {
+			return false, stackErr.Error(fmt.Errorf("decode payment dispute opened payload failed: %w", err))
+		}
+		return true, stackErr.Error(s.withLedgerLocks(ctx, disputeHoldLockKeys(payload.AccountID, payload.DisputeID), func() error {
+			return stackErr.Error(s.service.HandleDisputeOpened(ctx, payload))
+		}))
+	case sharedevents.EventPaymentDisputeWon:
+		var payload sharedevents.PaymentDisputeWonEvent
+		if err := contracts.UnmarshalEventData(raw, &payload); err != nil {
+			return false, stackErr.Error(fmt.Errorf("decode payment dispute won payload failed: %w", err))
+		}
+		return true, stackErr.Error(s.withLedgerLocks(ctx, disputeHoldLockKeys(payload.AccountID, payload.DisputeID), func() error {
+			return stackErr.Error(s.service.HandleDisputeWon(ctx, payload))
+		}))
 	default:
 		return false, nil
 	}
@@ -159,6 +180,13 @@ func reversedLockKeys(clearingAccountKey, creditAccountID string, feeAmount int6
 	return normalizeLockKeys(keys)
 }
 
+func disputeHoldLockKeys(accountID, disputeID string) []string {
+	return normalizeLockKeys([]string{
+		strings.TrimSpace(accountID),
+		ledgerentity.DisputeHoldAccountID(disputeID),
+	})
+}
+
 func normalizeLockKeys(keys []string) []string {
 	items := make([]string, 0, len(keys))
 	seen := make(map[string]struct{}, len(keys))
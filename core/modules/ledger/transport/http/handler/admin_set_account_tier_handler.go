@@ -0,0 +1,52 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/ledger/application/dto/in"
+	"wechat-clone/core/modules/ledger/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type adminSetAccountTierHandler struct {
+	setAccountTier cqrs.Dispatcher[*in.SetAccountTierRequest, *out.AccountLimitsResponse]
+}
+
+func NewAdminSetAccountTierHandler(
+	setAccountTier cqrs.Dispatcher[*in.SetAccountTierRequest, *out.AccountLimitsResponse],
+) *adminSetAccountTierHandler {
+	return &adminSetAccountTierHandler{
+		setAccountTier: setAccountTier,
+	}
+}
+
+func (h *adminSetAccountTierHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.SetAccountTierRequest
+	request.AccountID = c.Param("account_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.setAccountTier.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("SetAccountTier failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/ledger/application/dto/in"
+	"wechat-clone/core/modules/ledger/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getLimitsHandler struct {
+	getAccountLimits cqrs.Dispatcher[*in.GetLimitsRequest, *out.AccountLimitsResponse]
+}
+
+func NewGetLimitsHandler(
+	getAccountLimits cqrs.Dispatcher[*in.GetLimitsRequest, *out.AccountLimitsResponse],
+) *getLimitsHandler {
+	return &getLimitsHandler{
+		getAccountLimits: getAccountLimits,
+	}
+}
+
+func (h *getLimitsHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetLimitsRequest
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getAccountLimits.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetAccountLimits failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
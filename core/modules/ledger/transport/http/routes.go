@@ -18,9 +18,21 @@ func RegisterPrivateRoutes(
 	getTransaction cqrs.Dispatcher[*in.GetTransactionRequest, *out.TransactionResponse],
 	transferTransaction cqrs.Dispatcher[*in.TransferTransactionRequest, *out.TransactionTransactionResponse],
 	listTransaction cqrs.Dispatcher[*in.ListTransactionRequest, *out.ListTransactionResponse],
+	getStatement cqrs.Dispatcher[*in.GetStatementRequest, *out.LedgerStatementResponse],
+	exportStatement cqrs.Dispatcher[*in.ExportStatementRequest, *out.LedgerStatementExportResponse],
+	runAudit cqrs.Dispatcher[*in.AdminAuditRequest, *out.LedgerAuditReportResponse],
+	getAccountLimits cqrs.Dispatcher[*in.GetLimitsRequest, *out.AccountLimitsResponse],
+	setAccountTier cqrs.Dispatcher[*in.SetAccountTierRequest, *out.AccountLimitsResponse],
+	quoteFee cqrs.Dispatcher[*in.QuoteFeeRequest, *out.FeeQuoteResponse],
 ) {
 	routes.GET("/ledger/wallet/balance", httpx.Wrap(handler.NewGetAccountBalanceHandler(getAccountBalance)))
 	routes.GET("/ledger/transactions/:transaction_id", httpx.Wrap(handler.NewGetTransactionHandler(getTransaction)))
 	routes.POST("/ledger/transfers", httpx.Wrap(handler.NewTransferTransactionHandler(transferTransaction)))
 	routes.GET("/ledger/transactions", httpx.Wrap(handler.NewListTransactionHandler(listTransaction)))
+	routes.GET("/ledger/statements", httpx.Wrap(handler.NewGetStatementHandler(getStatement)))
+	routes.POST("/ledger/statements/export", httpx.Wrap(handler.NewExportStatementHandler(exportStatement)))
+	routes.GET("/ledger/admin/audit", httpx.Wrap(handler.NewAdminAuditHandler(runAudit)))
+	routes.GET("/ledger/limits", httpx.Wrap(handler.NewGetLimitsHandler(getAccountLimits)))
+	routes.PUT("/ledger/admin/accounts/:account_id/tier", httpx.Wrap(handler.NewAdminSetAccountTierHandler(setAccountTier)))
+	routes.GET("/ledger/fees/quote", httpx.Wrap(handler.NewQuoteFeeHandler(quoteFee)))
 }
@@ -18,6 +18,12 @@ type ledgerHTTPServer struct {
 	getTransaction      cqrs.Dispatcher[*in.GetTransactionRequest, *out.TransactionResponse]
 	transferTransaction cqrs.Dispatcher[*in.TransferTransactionRequest, *out.TransactionTransactionResponse]
 	listTransaction     cqrs.Dispatcher[*in.ListTransactionRequest, *out.ListTransactionResponse]
+	getStatement        cqrs.Dispatcher[*in.GetStatementRequest, *out.LedgerStatementResponse]
+	exportStatement     cqrs.Dispatcher[*in.ExportStatementRequest, *out.LedgerStatementExportResponse]
+	runAudit            cqrs.Dispatcher[*in.AdminAuditRequest, *out.LedgerAuditReportResponse]
+	getAccountLimits    cqrs.Dispatcher[*in.GetLimitsRequest, *out.AccountLimitsResponse]
+	setAccountTier      cqrs.Dispatcher[*in.SetAccountTierRequest, *out.AccountLimitsResponse]
+	quoteFee            cqrs.Dispatcher[*in.QuoteFeeRequest, *out.FeeQuoteResponse]
 }
 
 func NewHTTPServer(
@@ -25,12 +31,24 @@ func NewHTTPServer(
 	getTransaction cqrs.Dispatcher[*in.GetTransactionRequest, *out.TransactionResponse],
 	transferTransaction cqrs.Dispatcher[*in.TransferTransactionRequest, *out.TransactionTransactionResponse],
 	listTransaction cqrs.Dispatcher[*in.ListTransactionRequest, *out.ListTransactionResponse],
+	getStatement cqrs.Dispatcher[*in.GetStatementRequest, *out.LedgerStatementResponse],
+	exportStatement cqrs.Dispatcher[*in.ExportStatementRequest, *out.LedgerStatementExportResponse],
+	runAudit cqrs.Dispatcher[*in.AdminAuditRequest, *out.LedgerAuditReportResponse],
+	getAccountLimits cqrs.Dispatcher[*in.GetLimitsRequest, *out.AccountLimitsResponse],
+	setAccountTier cqrs.Dispatcher[*in.SetAccountTierRequest, *out.AccountLimitsResponse],
+	quoteFee cqrs.Dispatcher[*in.QuoteFeeRequest, *out.FeeQuoteResponse],
 ) (infrahttp.HTTPServer, error) {
 	return &ledgerHTTPServer{
 		getAccountBalance:   getAccountBalance,
 		getTransaction:      getTransaction,
 		transferTransaction: transferTransaction,
 		listTransaction:     listTransaction,
+		getStatement:        getStatement,
+		exportStatement:     exportStatement,
+		runAudit:            runAudit,
+		getAccountLimits:    getAccountLimits,
+		setAccountTier:      setAccountTier,
+		quoteFee:            quoteFee,
 	}, nil
 }
 
@@ -39,7 +57,7 @@ func (s *ledgerHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
 }
 
 func (s *ledgerHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	ledgerhttp.RegisterPrivateRoutes(routes, s.getAccountBalance, s.getTransaction, s.transferTransaction, s.listTransaction)
+	ledgerhttp.RegisterPrivateRoutes(routes, s.getAccountBalance, s.getTransaction, s.transferTransaction, s.listTransaction, s.getStatement, s.exportStatement, s.runAudit, s.getAccountLimits, s.setAccountTier, s.quoteFee)
 }
 
 func (s *ledgerHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
@@ -11,8 +11,10 @@ import (
 	notificationrepos "wechat-clone/core/modules/notification/domain/repos"
 	notificationtypes "wechat-clone/core/modules/notification/types"
 	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/infra/smtp"
 	"wechat-clone/core/shared/pkg/logging"
 	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
 
 	"go.uber.org/zap"
 )
@@ -82,3 +84,90 @@ func (h *messageHandler) handleAccountCreatedEvent(ctx context.Context, raw json
 		"Email": payload.Email,
 	}))
 }
+
+func (h *messageHandler) handleAccountNewDeviceLoginEvent(ctx context.Context, raw json.RawMessage) error {
+	log := logging.FromContext(ctx).Named("handleAccountNewDeviceLoginEvent")
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventAccountNewDeviceLogin, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.AccountNewDeviceLoginEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventAccountNewDeviceLogin))
+	}
+
+	deviceName := utils.FirstNonEmpty(payload.DeviceName, "a new device")
+	subject := "New sign-in to your account"
+	body := fmt.Sprintf("Your account was signed in from %s (%s). If this wasn't you, revoke the session from the link in your email.", deviceName, payload.IPAddress)
+	notificationID := aggregate.NewDeviceLoginNotificationID(payload.SessionID, payload.AccountID)
+
+	notificationRepo := h.baseRepo.NotificationRepository()
+	if _, err := notificationRepo.Load(ctx, notificationID); err == nil {
+		return nil
+	} else if !errors.Is(err, notificationrepos.ErrNotificationNotFound) {
+		log.Errorw("load notification failed", zap.Error(err))
+		return stackErr.Error(fmt.Errorf("load notification failed: %w", err))
+	}
+
+	notificationAgg, err := aggregate.NewNotificationAggregate(notificationID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if err := notificationAgg.Create(
+		payload.AccountID,
+		notificationtypes.NotificationTypeNewDeviceLogin,
+		subject,
+		body,
+		payload.LoggedInAt,
+	); err != nil {
+		return stackErr.Error(err)
+	}
+
+	// The email goes out before the row is stored: the row is what marks the
+	// event as handled, so a failed send is retried on redelivery instead of
+	// being skipped. A redelivery after the send but before the save sends
+	// the alert twice, which is preferable to never sending it.
+	revokeURL, err := h.alerts.RevokeSessionURL(ctx, payload.AccountID, payload.SessionID)
+	if err != nil {
+		log.Errorw("issue revoke link failed", zap.Error(err))
+		return stackErr.Error(fmt.Errorf("issue revoke link failed: %w", err))
+	}
+	if err := h.email.SendTemplate(ctx, payload.Email, subject, smtp.Template_NewDeviceLogin, map[string]string{
+		"Name":        utils.FirstNonEmpty(payload.DisplayName, payload.Email),
+		"DeviceName":  deviceName,
+		"IPAddress":   payload.IPAddress,
+		"CountryCode": utils.FirstNonEmpty(payload.CountryCode, "-"),
+		"LoggedInAt":  payload.LoggedInAt.UTC().Format("2006-01-02 15:04:05 UTC"),
+		"RevokeURL":   revokeURL,
+	}); err != nil {
+		log.Errorw("send new device login email failed", zap.Error(err))
+		return stackErr.Error(fmt.Errorf("send new device login email failed: %w", err))
+	}
+
+	if err := notificationRepo.Save(ctx, notificationAgg); err != nil {
+		log.Errorw("create notification failed", zap.Error(err))
+		return stackErr.Error(fmt.Errorf("create notification failed: %w", err))
+	}
+
+	snapshot, err := notificationAgg.Snapshot()
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	unreadCount, err := notificationRepo.CountUnread(ctx, payload.AccountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if h.realtime != nil {
+		if emitErr := h.realtime.EmitMessage(ctx, support.NewRealtimeNotificationPayload(notificationtypes.RealtimeEventNotificationUpsert, snapshot, unreadCount)); emitErr != nil {
+			log.Warnw("emit new device login notification realtime failed", zap.Error(emitErr))
+		}
+	}
+	if h.push != nil {
+		if pushErr := h.push.SendNotification(ctx, snapshot); pushErr != nil {
+			log.Warnw("send new device login notification webpush failed", zap.Error(pushErr))
+		}
+	}
+
+	return nil
+}
@@ -38,6 +38,8 @@ func (h *messageHandler) handleRelationshipOutboxEvent(ctx context.Context, valu
 		return stackErr.Error(h.handleFriendRequestSentEvent(ctx, event.EventData))
 	case sharedevents.EventRelationshipPairFriendRequestCancelled:
 		return stackErr.Error(h.handleFriendRequestCancelledEvent(ctx, event.EventData))
+	case sharedevents.EventRelationshipPairFriendRequestExpired:
+		return stackErr.Error(h.handleFriendRequestExpiredEvent(ctx, event.EventData))
 	case sharedevents.EventRelationshipPairFriendRequestAccepted:
 		return stackErr.Error(h.handleFriendRequestAcceptedEvent(ctx, event.EventData))
 	case sharedevents.EventRelationshipPairFriendRequestRejected:
@@ -87,6 +89,29 @@ func (h *messageHandler) handleFriendRequestCancelledEvent(ctx context.Context,
 	}))
 }
 
+func (h *messageHandler) handleFriendRequestExpiredEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventRelationshipPairFriendRequestExpired, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode friend request expired payload failed: %w", err))
+	}
+	payload, ok := payloadAny.(*sharedevents.RelationshipPairFriendRequestExpiredEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventRelationshipPairFriendRequestExpired))
+	}
+	if !payload.NotifyRequester {
+		return nil
+	}
+
+	return stackErr.Error(h.createGeneralNotificationAndEmit(ctx, generalNotificationSpec{
+		NotificationID: aggregate.FriendRequestNotificationID(notificationtypes.NotificationTypeFriendRequestExpired, payload.RequestID, payload.RequesterID),
+		AccountID:      payload.RequesterID,
+		Type:           notificationtypes.NotificationTypeFriendRequestExpired,
+		Subject:        "Friend request expired",
+		Body:           "Your friend request expired without a response. You can send it again.",
+		OccurredAt:     payload.ExpiredAt,
+	}))
+}
+
 func (h *messageHandler) handleFriendRequestAcceptedEvent(ctx context.Context, raw json.RawMessage) error {
 	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventRelationshipPairFriendRequestAccepted, raw)
 	if err != nil {
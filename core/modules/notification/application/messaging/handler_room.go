@@ -37,6 +37,8 @@ func (h *messageHandler) handleRoomOutboxEvent(ctx context.Context, value []byte
 		return h.handleRoomMentionNotificationEvent(ctx, event.EventData)
 	case sharedevents.EventMessageAggregateProjectionSynced:
 		return h.handleRoomMessageProjectionEvent(ctx, event.EventData)
+	case sharedevents.EventRoomTransferUpdated:
+		return stackErr.Error(h.handleRoomTransferUpdatedEvent(ctx, event.EventData))
 	default:
 		return nil
 	}
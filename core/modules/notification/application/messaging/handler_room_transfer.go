@@ -0,0 +1,85 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"wechat-clone/core/modules/notification/domain/aggregate"
+	notificationtypes "wechat-clone/core/modules/notification/types"
+	"wechat-clone/core/shared/contracts"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type transferNotificationCopy struct {
+	Subject       string
+	SenderBody    string
+	RecipientBody string
+}
+
+// handleRoomTransferUpdatedEvent notifies both parties of a transfer each
+// time its status changes.
+func (h *messageHandler) handleRoomTransferUpdatedEvent(ctx context.Context, raw json.RawMessage) error {
+	var payload sharedevents.RoomTransferUpdatedEvent
+	if err := contracts.UnmarshalEventData(raw, &payload); err != nil {
+		return stackErr.Error(fmt.Errorf("decode room transfer updated payload failed: %w", err))
+	}
+
+	notificationType, text, ok := buildTransferNotificationCopy(&payload)
+	if !ok {
+		return nil
+	}
+
+	for _, target := range []struct {
+		accountID string
+		body      string
+	}{
+		{accountID: payload.SenderID, body: text.SenderBody},
+		{accountID: payload.RecipientID, body: text.RecipientBody},
+	} {
+		if err := h.createGeneralNotificationAndEmit(ctx, generalNotificationSpec{
+			NotificationID: aggregate.TransferNotificationID(notificationType, payload.TransferID, target.accountID),
+			AccountID:      target.accountID,
+			Type:           notificationType,
+			Subject:        text.Subject,
+			Body:           target.body,
+			OccurredAt:     payload.UpdatedAt,
+		}); err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	return nil
+}
+
+func buildTransferNotificationCopy(payload *sharedevents.RoomTransferUpdatedEvent) (notificationtypes.NotificationType, transferNotificationCopy, bool) {
+	amount := fmt.Sprintf("%d %s", payload.Amount, payload.Currency)
+	switch payload.Status {
+	case "pending":
+		return notificationtypes.NotificationTypeTransferSent, transferNotificationCopy{
+			Subject:       "Transfer sent",
+			SenderBody:    fmt.Sprintf("Your transfer of %s is waiting to be accepted.", amount),
+			RecipientBody: fmt.Sprintf("You have received a transfer of %s. Accept it to add it to your balance.", amount),
+		}, true
+	case "accepted":
+		return notificationtypes.NotificationTypeTransferAccepted, transferNotificationCopy{
+			Subject:       "Transfer accepted",
+			SenderBody:    fmt.Sprintf("Your transfer of %s was accepted.", amount),
+			RecipientBody: fmt.Sprintf("You accepted a transfer of %s.", amount),
+		}, true
+	case "declined":
+		return notificationtypes.NotificationTypeTransferDeclined, transferNotificationCopy{
+			Subject:       "Transfer declined",
+			SenderBody:    fmt.Sprintf("Your transfer of %s was declined and returned to your balance.", amount),
+			RecipientBody: fmt.Sprintf("You declined a transfer of %s.", amount),
+		}, true
+	case "returned":
+		return notificationtypes.NotificationTypeTransferReturned, transferNotificationCopy{
+			Subject:       "Transfer returned",
+			SenderBody:    fmt.Sprintf("Your transfer of %s was not accepted in time and has been returned to your balance.", amount),
+			RecipientBody: fmt.Sprintf("A transfer of %s expired and was returned to the sender.", amount),
+		}, true
+	default:
+		return "", transferNotificationCopy{}, false
+	}
+}
@@ -30,12 +30,14 @@ type messageHandler struct {
 	realtime notificationservice.RealtimeService
 	push     notificationservice.PushDeliveryService
 	email    notificationservice.EmailVerificationService
+	alerts   notificationservice.LoginAlertLinkService
 }
 
 func NewMessageHandler(
 	cfg *config.Config,
 	baseRepo repos.Repos,
 	services notificationservice.Services,
+	alerts notificationservice.LoginAlertLinkService,
 ) (MessageHandler, error) {
 	instance := &messageHandler{
 		consumer: make([]infraMessaging.Consumer, 0),
@@ -43,6 +45,7 @@ func NewMessageHandler(
 		email:    services.EmailVerificationService(),
 		realtime: services.RealtimeService(),
 		push:     services.PushDeliveryService(),
+		alerts:   alerts,
 	}
 
 	topicHandlers := map[string]infraMessaging.Handler{}
@@ -105,6 +108,10 @@ func (h *messageHandler) handleAccountEvent(ctx context.Context, value []byte) e
 		if err := h.handleAccountCreatedEvent(ctx, event.EventData); err != nil {
 			return stackErr.Error(err)
 		}
+	case sharedevents.EventAccountNewDeviceLogin:
+		if err := h.handleAccountNewDeviceLoginEvent(ctx, event.EventData); err != nil {
+			return stackErr.Error(err)
+		}
 	default:
 		return nil
 	}
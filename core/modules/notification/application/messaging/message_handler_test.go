@@ -2,6 +2,7 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -69,6 +70,55 @@ func TestHandleAccountEventSkipsExistingWelcomeNotification(t *testing.T) {
 	}
 }
 
+type failingEmailService struct {
+	notificationservice.EmailVerificationService
+	sent int
+}
+
+func (s *failingEmailService) SendTemplate(context.Context, string, string, string, any) error {
+	s.sent++
+	return errors.New("smtp unavailable")
+}
+
+func TestHandleNewDeviceLoginLeavesNotificationUnsavedWhenEmailFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationID := aggregate.NewDeviceLoginNotificationID("sess-1", "acc-4")
+	repo := notificationrepos.NewMockNotificationRepository(ctrl)
+	repo.EXPECT().Load(gomock.Any(), notificationID).Return(nil, notificationrepos.ErrNotificationNotFound)
+
+	baseRepo := notificationrepos.NewMockRepos(ctrl)
+	baseRepo.EXPECT().NotificationRepository().Return(repo).AnyTimes()
+
+	alerts := notificationservice.NewMockLoginAlertLinkService(ctrl)
+	alerts.EXPECT().RevokeSessionURL(gomock.Any(), "acc-4", "sess-1").Return("https://example.com/revoke?token=t", nil)
+
+	email := &failingEmailService{}
+	handler := &messageHandler{
+		baseRepo: baseRepo,
+		email:    email,
+		alerts:   alerts,
+	}
+
+	raw := []byte(`{
+		"id": 2,
+		"aggregate_id": "acc-4",
+		"aggregate_type": "account",
+		"version": 3,
+		"event_name": "EventAccountNewDeviceLogin",
+		"event_data": {"AccountID":"acc-4","Email":"d@example.com","SessionID":"sess-1","IPAddress":"10.0.0.1","LoggedInAt":"2026-03-03T06:05:32Z"},
+		"created_at": "2026-03-03T06:05:32Z"
+	}`)
+
+	if err := handler.handleAccountEvent(context.Background(), raw); err == nil {
+		t.Fatal("expected the email failure to be returned for redelivery")
+	}
+	if email.sent != 1 {
+		t.Fatalf("sent = %d, want 1", email.sent)
+	}
+}
+
 func TestHandleRelationshipEventCreatesFriendRequestNotification(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -183,6 +233,93 @@ func TestHandleRelationshipEventCreatesAcceptedNotificationForRequester(t *testi
 	}
 }
 
+func TestHandleRelationshipEventCreatesExpiredNotificationForRequester(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := notificationrepos.NewMockNotificationRepository(ctrl)
+	realtime := notificationservice.NewMockRealtimeService(ctrl)
+	baseRepo := notificationrepos.NewMockRepos(ctrl)
+	baseRepo.EXPECT().NotificationRepository().Return(repo).AnyTimes()
+
+	accountID := "acc-requester"
+	notificationID := aggregate.FriendRequestNotificationID(notificationtypes.NotificationTypeFriendRequestExpired, "req-3", accountID)
+
+	repo.EXPECT().Load(gomock.Any(), notificationID).Return(nil, notificationrepos.ErrNotificationNotFound)
+	repo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&aggregate.NotificationAggregate{})).DoAndReturn(func(_ context.Context, agg *aggregate.NotificationAggregate) error {
+		snapshot, err := agg.Snapshot()
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+		if snapshot.AccountID != accountID {
+			t.Fatalf("AccountID = %s, want %s", snapshot.AccountID, accountID)
+		}
+		if snapshot.Type != notificationtypes.NotificationTypeFriendRequestExpired {
+			t.Fatalf("Type = %s, want %s", snapshot.Type, notificationtypes.NotificationTypeFriendRequestExpired)
+		}
+		return nil
+	})
+	repo.EXPECT().CountUnread(gomock.Any(), accountID).Return(1, nil)
+	realtime.EXPECT().EmitMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+	handler := &messageHandler{
+		baseRepo: baseRepo,
+		realtime: realtime,
+	}
+
+	raw := []byte(`{
+		"id": 13,
+		"aggregate_id": "pair:acc-requester:acc-addressee",
+		"aggregate_type": "RelationshipPairAggregate",
+		"version": 2,
+		"event_name": "EventRelationshipPairFriendRequestExpired",
+		"event_data": {
+			"RequestID":"req-3",
+			"RequesterID":"acc-requester",
+			"AddresseeID":"acc-addressee",
+			"CreatedAt":"2026-04-09T08:00:00Z",
+			"ExpiredAt":"2026-04-23T08:05:00Z",
+			"NotifyRequester":true
+		},
+		"created_at": "2026-04-23T08:05:00Z"
+	}`)
+
+	if err := handler.handleRelationshipOutboxEvent(context.Background(), raw); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestHandleRelationshipEventSkipsExpiredNotificationWhenDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := &messageHandler{
+		baseRepo: notificationrepos.NewMockRepos(ctrl),
+		realtime: notificationservice.NewMockRealtimeService(ctrl),
+	}
+
+	raw := []byte(`{
+		"id": 14,
+		"aggregate_id": "pair:acc-requester:acc-addressee",
+		"aggregate_type": "RelationshipPairAggregate",
+		"version": 2,
+		"event_name": "EventRelationshipPairFriendRequestExpired",
+		"event_data": {
+			"RequestID":"req-4",
+			"RequesterID":"acc-requester",
+			"AddresseeID":"acc-addressee",
+			"CreatedAt":"2026-04-09T08:00:00Z",
+			"ExpiredAt":"2026-04-23T08:05:00Z",
+			"NotifyRequester":false
+		},
+		"created_at": "2026-04-23T08:05:00Z"
+	}`)
+
+	if err := handler.handleRelationshipOutboxEvent(context.Background(), raw); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
 func TestDecodeRelationshipPayloadObject(t *testing.T) {
 	raw := []byte(`{"RequestID":"req-9","RequesterID":"acc-a","AddresseeID":"acc-b","CreatedAt":"2026-04-23T08:00:00Z"}`)
 
@@ -314,3 +451,65 @@ func TestHandlePaymentEventCreatesWithdrawalRequestedNotification(t *testing.T)
 		t.Fatalf("expected no error, got %v", err)
 	}
 }
+
+func TestHandleRoomTransferUpdatedNotifiesBothParties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := notificationrepos.NewMockNotificationRepository(ctrl)
+	realtime := notificationservice.NewMockRealtimeService(ctrl)
+	baseRepo := notificationrepos.NewMockRepos(ctrl)
+	baseRepo.EXPECT().NotificationRepository().Return(repo).AnyTimes()
+
+	transferID := "transfer-1"
+	notified := map[string]bool{}
+	for _, accountID := range []string{"acc-sender", "acc-recipient"} {
+		notificationID := aggregate.TransferNotificationID(notificationtypes.NotificationTypeTransferAccepted, transferID, accountID)
+		repo.EXPECT().Load(gomock.Any(), notificationID).Return(nil, notificationrepos.ErrNotificationNotFound)
+		repo.EXPECT().CountUnread(gomock.Any(), accountID).Return(1, nil)
+	}
+	repo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&aggregate.NotificationAggregate{})).DoAndReturn(func(_ context.Context, agg *aggregate.NotificationAggregate) error {
+		snapshot, err := agg.Snapshot()
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+		if snapshot.Type != notificationtypes.NotificationTypeTransferAccepted {
+			t.Fatalf("Type = %s, want %s", snapshot.Type, notificationtypes.NotificationTypeTransferAccepted)
+		}
+		notified[snapshot.AccountID] = true
+		return nil
+	}).Times(2)
+	realtime.EXPECT().EmitMessage(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	handler := &messageHandler{
+		baseRepo: baseRepo,
+		realtime: realtime,
+	}
+
+	raw := []byte(`{
+		"id": 30,
+		"aggregate_id": "room-1",
+		"aggregate_type": "RoomAggregate",
+		"version": 4,
+		"event_name": "EventRoomTransferUpdated",
+		"event_data": {
+			"transfer_id":"transfer-1",
+			"room_id":"room-1",
+			"message_id":"msg-1",
+			"sender_id":"acc-sender",
+			"recipient_id":"acc-recipient",
+			"currency":"USD",
+			"amount":500,
+			"status":"accepted",
+			"updated_at":"2026-04-24T10:00:00Z"
+		},
+		"created_at": "2026-04-24T10:00:00Z"
+	}`)
+
+	if err := handler.handleRoomOutboxEvent(context.Background(), raw); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !notified["acc-sender"] || !notified["acc-recipient"] {
+		t.Fatalf("expected both parties to be notified, got %v", notified)
+	}
+}
@@ -14,10 +14,12 @@ import (
 
 var eventPayloadTypes = map[string]reflect.Type{
 	sharedevents.EventAccountCreated:                         reflect.TypeOf(sharedevents.AccountCreatedEvent{}),
+	sharedevents.EventAccountNewDeviceLogin:                  reflect.TypeOf(sharedevents.AccountNewDeviceLoginEvent{}),
 	sharedevents.EventRoomMessageCreated:                     reflect.TypeOf(sharedevents.RoomMessageCreatedEvent{}),
 	sharedevents.EventMessageAggregateProjectionSynced:       reflect.TypeOf(sharedevents.RoomMessageAggregateSyncedEvent{}),
 	sharedevents.EventRelationshipPairFriendRequestSent:      reflect.TypeOf(sharedevents.RelationshipPairFriendRequestSentEvent{}),
 	sharedevents.EventRelationshipPairFriendRequestCancelled: reflect.TypeOf(sharedevents.RelationshipPairFriendRequestCancelledEvent{}),
+	sharedevents.EventRelationshipPairFriendRequestExpired:   reflect.TypeOf(sharedevents.RelationshipPairFriendRequestExpiredEvent{}),
 	sharedevents.EventRelationshipPairFriendRequestAccepted:  reflect.TypeOf(sharedevents.RelationshipPairFriendRequestAcceptedEvent{}),
 	sharedevents.EventRelationshipPairFriendRequestRejected:  reflect.TypeOf(sharedevents.RelationshipPairFriendRequestRejectedEvent{}),
 }
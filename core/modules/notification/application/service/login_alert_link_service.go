@@ -0,0 +1,11 @@
+package service
+
+import "context"
+
+//go:generate mockgen -package=service -destination=login_alert_link_service_mock.go -source=login_alert_link_service.go
+type LoginAlertLinkService interface {
+	// RevokeSessionURL mints the "this wasn't me" link for a new-device alert.
+	// It is minted when the email goes out, so the token never sits in an
+	// event or on the broker.
+	RevokeSessionURL(ctx context.Context, accountID, sessionID string) (string, error)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: login_alert_link_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=login_alert_link_service_mock.go -source=login_alert_link_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginAlertLinkService is a mock of LoginAlertLinkService interface.
+type MockLoginAlertLinkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAlertLinkServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginAlertLinkServiceMockRecorder is the mock recorder for MockLoginAlertLinkService.
+type MockLoginAlertLinkServiceMockRecorder struct {
+	mock *MockLoginAlertLinkService
+}
+
+// NewMockLoginAlertLinkService creates a new mock instance.
+func NewMockLoginAlertLinkService(ctrl *gomock.Controller) *MockLoginAlertLinkService {
+	mock := &MockLoginAlertLinkService{ctrl: ctrl}
+	mock.recorder = &MockLoginAlertLinkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAlertLinkService) EXPECT() *MockLoginAlertLinkServiceMockRecorder {
+	return m.recorder
+}
+
+// RevokeSessionURL mocks base method.
+func (m *MockLoginAlertLinkService) RevokeSessionURL(ctx context.Context, accountID, sessionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionURL", ctx, accountID, sessionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessionURL indicates an expected call of RevokeSessionURL.
+func (mr *MockLoginAlertLinkServiceMockRecorder) RevokeSessionURL(ctx, accountID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionURL", reflect.TypeOf((*MockLoginAlertLinkService)(nil).RevokeSessionURL), ctx, accountID, sessionID)
+}
@@ -4,6 +4,7 @@ import (
 	appCtx "wechat-clone/core/context"
 	notificationmessaging "wechat-clone/core/modules/notification/application/messaging"
 	notificationservice "wechat-clone/core/modules/notification/application/service"
+	notificationaccount "wechat-clone/core/modules/notification/infra/account"
 	notificationrepo "wechat-clone/core/modules/notification/infra/persistent/repository"
 	"wechat-clone/core/shared/config"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -15,5 +16,6 @@ func buildMessagingHandler(cfg *config.Config, appCtx *appCtx.AppContext) (notif
 		return nil, stackErr.Error(err)
 	}
 	services := notificationservice.NewServices(appCtx, repos)
-	return notificationmessaging.NewMessageHandler(cfg, repos, services)
+	alerts := notificationaccount.NewLoginAlertLinks(cfg.AuthConfig.LoginRisk, appCtx.GetCache())
+	return notificationmessaging.NewMessageHandler(cfg, repos, services, alerts)
 }
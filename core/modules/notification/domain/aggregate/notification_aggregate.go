@@ -331,6 +331,8 @@ func normalizeNotificationType(value types.NotificationType) (types.Notification
 	switch value.Normalize() {
 	case types.NotificationTypeAccountCreated:
 		return types.NotificationTypeAccountCreated, nil
+	case types.NotificationTypeNewDeviceLogin:
+		return types.NotificationTypeNewDeviceLogin, nil
 	case types.NotificationTypeRoomMention:
 		return types.NotificationTypeRoomMention, nil
 	case types.NotificationTypeRoomMessage:
@@ -341,6 +343,8 @@ func normalizeNotificationType(value types.NotificationType) (types.Notification
 		return types.NotificationTypeFriendRequestCancelled, nil
 	case types.NotificationTypeFriendRequestAccepted:
 		return types.NotificationTypeFriendRequestAccepted, nil
+	case types.NotificationTypeFriendRequestExpired:
+		return types.NotificationTypeFriendRequestExpired, nil
 	case types.NotificationTypeFriendRequestRejected:
 		return types.NotificationTypeFriendRequestRejected, nil
 	case types.NotificationTypeWithdrawalRequested:
@@ -349,6 +353,14 @@ func normalizeNotificationType(value types.NotificationType) (types.Notification
 		return types.NotificationTypeWithdrawalSucceeded, nil
 	case types.NotificationTypeWithdrawalFailed:
 		return types.NotificationTypeWithdrawalFailed, nil
+	case types.NotificationTypeTransferSent:
+		return types.NotificationTypeTransferSent, nil
+	case types.NotificationTypeTransferAccepted:
+		return types.NotificationTypeTransferAccepted, nil
+	case types.NotificationTypeTransferDeclined:
+		return types.NotificationTypeTransferDeclined, nil
+	case types.NotificationTypeTransferReturned:
+		return types.NotificationTypeTransferReturned, nil
 	default:
 		return "", stackErr.Error(ErrNotificationTypeRequired)
 	}
@@ -401,6 +413,13 @@ func WelcomeNotificationID(accountID string) string {
 	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("notification:welcome:"+strings.TrimSpace(accountID))).String()
 }
 
+func NewDeviceLoginNotificationID(sessionID, accountID string) string {
+	return uuid.NewSHA1(
+		uuid.NameSpaceOID,
+		[]byte("notification:new-device-login:"+strings.TrimSpace(sessionID)+":"+strings.TrimSpace(accountID)),
+	).String()
+}
+
 func FriendRequestNotificationID(notificationType types.NotificationType, requestID, accountID string) string {
 	return uuid.NewSHA1(
 		uuid.NameSpaceOID,
@@ -428,3 +447,10 @@ func PaymentNotificationID(notificationType types.NotificationType, paymentID, a
 		[]byte("notification:payment:"+notificationType.String()+":"+strings.TrimSpace(paymentID)+":"+strings.TrimSpace(accountID)),
 	).String()
 }
+
+func TransferNotificationID(notificationType types.NotificationType, transferID, accountID string) string {
+	return uuid.NewSHA1(
+		uuid.NameSpaceOID,
+		[]byte("notification:transfer:"+notificationType.String()+":"+strings.TrimSpace(transferID)+":"+strings.TrimSpace(accountID)),
+	).String()
+}
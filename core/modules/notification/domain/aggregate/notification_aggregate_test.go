@@ -46,3 +46,13 @@ func TestNotificationAggregateCreateAndMarkRead(t *testing.T) {
 		t.Fatalf("expected read notification at %v, got %+v", readAt, snapshot)
 	}
 }
+
+func TestNotificationAggregateCreateAcceptsNewDeviceLogin(t *testing.T) {
+	agg, err := NewNotificationAggregate("notif-2")
+	if err != nil {
+		t.Fatalf("NewNotificationAggregate() error = %v", err)
+	}
+	if err := agg.Create("acc-1", types.NotificationTypeNewDeviceLogin, "Subject", "Body", time.Date(2026, 4, 15, 10, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package account
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/notification/application/service"
+	"wechat-clone/core/shared/config"
+	sharedcache "wechat-clone/core/shared/infra/cache"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type loginAlertLinks struct {
+	revoker accountsupport.LoginAlertRevoker
+}
+
+// NewLoginAlertLinks issues revoke links through the account module's
+// revoker, which owns the token format and consumes it on click.
+func NewLoginAlertLinks(cfg config.LoginRiskConfig, cache sharedcache.Cache) service.LoginAlertLinkService {
+	return &loginAlertLinks{revoker: accountsupport.NewLoginAlertRevoker(cfg, cache)}
+}
+
+func (l *loginAlertLinks) RevokeSessionURL(ctx context.Context, accountID, sessionID string) (string, error) {
+	link, err := l.revoker.Issue(ctx, accountID, sessionID)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	return link, nil
+}
@@ -21,15 +21,21 @@ type NotificationType string
 
 const (
 	NotificationTypeAccountCreated         NotificationType = "account.created"
+	NotificationTypeNewDeviceLogin         NotificationType = "account.login.new_device"
 	NotificationTypeRoomMention            NotificationType = "room.mention"
 	NotificationTypeRoomMessage            NotificationType = "room.message"
 	NotificationTypeFriendRequestSent      NotificationType = "relationship.friend_request.sent"
 	NotificationTypeFriendRequestCancelled NotificationType = "relationship.friend_request.cancelled"
 	NotificationTypeFriendRequestAccepted  NotificationType = "relationship.friend_request.accepted"
 	NotificationTypeFriendRequestRejected  NotificationType = "relationship.friend_request.rejected"
+	NotificationTypeFriendRequestExpired   NotificationType = "relationship.friend_request.expired"
 	NotificationTypeWithdrawalRequested    NotificationType = "payment.withdrawal.requested"
 	NotificationTypeWithdrawalSucceeded    NotificationType = "payment.withdrawal.succeeded"
 	NotificationTypeWithdrawalFailed       NotificationType = "payment.withdrawal.failed"
+	NotificationTypeTransferSent           NotificationType = "room.transfer.sent"
+	NotificationTypeTransferAccepted       NotificationType = "room.transfer.accepted"
+	NotificationTypeTransferDeclined       NotificationType = "room.transfer.declined"
+	NotificationTypeTransferReturned       NotificationType = "room.transfer.returned"
 )
 
 func (t NotificationType) String() string {
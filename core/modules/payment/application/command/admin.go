@@ -0,0 +1,26 @@
+package command
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// requireAdmin trusts the admin claim only once the account record agrees,
+// so a demoted admin cannot keep moving money until their token expires.
+func requireAdmin(ctx context.Context, admins accountsupport.AdminRoles) error {
+	actor, ok := actorctx.FromContext(ctx)
+	if !ok || actor == nil {
+		return stackErr.Error(ErrUnauthorized)
+	}
+	isAdmin, err := accountsupport.ActorIsAdmin(ctx, actor, admins)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if !isAdmin {
+		return stackErr.Error(ErrAdminRequired)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"wechat-clone/core/modules/payment/application/dto/out"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type createPaymentHandler struct {
@@ -20,5 +21,9 @@ func NewCreatePayment(paymentCommandService paymentservice.PaymentCommandService
 }
 
 func (u *createPaymentHandler) Handle(ctx context.Context, req *in.CreatePaymentRequest) (*out.CreatePaymentResponse, error) {
-	return u.paymentCommandService.CreatePayment(ctx, req)
+	res, err := u.paymentCommandService.CreatePayment(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapPaymentError(err))
+	}
+	return res, nil
 }
@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"strings"
 
 	"wechat-clone/core/modules/payment/application/dto/in"
 	"wechat-clone/core/modules/payment/application/dto/out"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type createWithdrawalHandler struct {
@@ -20,5 +22,13 @@ func NewCreateWithdrawal(paymentCommandService paymentservice.PaymentCommandServ
 }
 
 func (u *createWithdrawalHandler) Handle(ctx context.Context, req *in.CreateWithdrawalRequest) (*out.CreateWithdrawalResponse, error) {
-	return u.paymentCommandService.CreateWithdrawal(ctx, req)
+	if strings.TrimSpace(req.IdempotencyKey) == "" {
+		return nil, stackErr.Error(ErrIdempotencyKeyEmpty)
+	}
+
+	res, err := u.paymentCommandService.CreateWithdrawal(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapPaymentError(err))
+	}
+	return res, nil
 }
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/modules/payment/domain/entity"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrUnauthorized        = apperr.New("payment.unauthorized", "unauthorized", http.StatusUnauthorized)
+	ErrAdminRequired       = apperr.New("payment.admin_required", "admin role is required", http.StatusForbidden)
+	ErrIdempotencyKeyEmpty = apperr.New("payment.idempotency_key_required", "Idempotency-Key header is required", http.StatusBadRequest)
+	ErrIntentNotFound      = apperr.New("payment.intent_not_found", "payment intent not found", http.StatusNotFound)
+	ErrIdempotencyKeyUsed  = apperr.New("payment.idempotency_key_used", "idempotency key was already used for another request", http.StatusConflict)
+	ErrDuplicateRequest    = apperr.New("payment.duplicate", "payment request was already processed", http.StatusConflict)
+	ErrDiscrepancyNotFound = apperr.New("payment.discrepancy_not_found", "reconciliation discrepancy not found", http.StatusNotFound)
+	ErrDiscrepancyReviewed = apperr.New("payment.discrepancy_reviewed", "reconciliation discrepancy was already reviewed", http.StatusConflict)
+	ErrDisputeNotFound     = apperr.New("payment.dispute_not_found", "payment dispute not found", http.StatusNotFound)
+	ErrDisputeClosed       = apperr.New("payment.dispute_closed", "payment dispute is already closed", http.StatusConflict)
+	ErrDisputeEvidenceLate = apperr.New("payment.dispute_not_accepting_evidence", "payment dispute is not accepting evidence", http.StatusConflict)
+	ErrDisputeUnsupported  = apperr.New("payment.dispute_evidence_unsupported", "provider does not accept dispute evidence", http.StatusUnprocessableEntity)
+	ErrFeeQuoteChanged     = apperr.New("payment.fee_quote_changed", "fee quote changed, request a new quote", http.StatusConflict)
+
+	ErrLimitSingleExceeded   = apperr.New("payment.limit_single_exceeded", "amount exceeds the single transaction limit", http.StatusUnprocessableEntity)
+	ErrLimitDailyExceeded    = apperr.New("payment.limit_daily_exceeded", "daily limit exceeded", http.StatusUnprocessableEntity)
+	ErrLimitMonthlyExceeded  = apperr.New("payment.limit_monthly_exceeded", "monthly limit exceeded", http.StatusUnprocessableEntity)
+	ErrLimitVelocityExceeded = apperr.New("payment.limit_velocity_exceeded", "too many operations in a short period, try again later", http.StatusTooManyRequests)
+)
+
+func mapPaymentError(err error) error {
+	switch {
+	case errors.Is(err, paymentservice.ErrValidation):
+		return apperr.New("payment.validation", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, paymentservice.ErrPaymentUnauthorized):
+		return ErrUnauthorized
+	case errors.Is(err, paymentservice.ErrPaymentIntentNotFound):
+		return ErrIntentNotFound
+	case errors.Is(err, paymentservice.ErrIdempotencyKeyUsed):
+		return ErrIdempotencyKeyUsed
+	case errors.Is(err, paymentservice.ErrDiscrepancyNotFound):
+		return ErrDiscrepancyNotFound
+	case errors.Is(err, entity.ErrReconciliationAlreadyReviewed):
+		return ErrDiscrepancyReviewed
+	case errors.Is(err, paymentservice.ErrDisputeNotFound):
+		return ErrDisputeNotFound
+	case errors.Is(err, entity.ErrPaymentDisputeClosed):
+		return ErrDisputeClosed
+	case errors.Is(err, entity.ErrPaymentDisputeNotAcceptingEvidence):
+		return ErrDisputeEvidenceLate
+	case errors.Is(err, domainservice.ErrDisputeEvidenceUnsupported):
+		return ErrDisputeUnsupported
+	case errors.Is(err, finance.ErrFeeQuoteChanged):
+		return ErrFeeQuoteChanged
+	case errors.Is(err, paymentservice.ErrDuplicatePayment),
+		errors.Is(err, paymentservice.ErrDuplicateTransaction):
+		return ErrDuplicateRequest
+	case errors.Is(err, domainservice.ErrPaymentLimitSingleExceeded):
+		return ErrLimitSingleExceeded
+	case errors.Is(err, domainservice.ErrPaymentLimitDailyExceeded):
+		return ErrLimitDailyExceeded
+	case errors.Is(err, domainservice.ErrPaymentLimitMonthlyExceeded):
+		return ErrLimitMonthlyExceeded
+	case errors.Is(err, domainservice.ErrPaymentLimitVelocityExceeded):
+		return ErrLimitVelocityExceeded
+	default:
+		return err
+	}
+}
@@ -2,23 +2,42 @@ package command
 
 import (
 	"context"
+	"strings"
 
+	accountsupport "wechat-clone/core/modules/account/application/support"
 	"wechat-clone/core/modules/payment/application/dto/in"
 	"wechat-clone/core/modules/payment/application/dto/out"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type refundPaymentHandler struct {
 	paymentCommandService paymentservice.PaymentCommandService
+	admins                accountsupport.AdminRoles
 }
 
-func NewRefundPayment(paymentCommandService paymentservice.PaymentCommandService) cqrs.Handler[*in.RefundPaymentRequest, *out.RefundPaymentResponse] {
+func NewRefundPayment(
+	paymentCommandService paymentservice.PaymentCommandService,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.RefundPaymentRequest, *out.RefundPaymentResponse] {
 	return &refundPaymentHandler{
 		paymentCommandService: paymentCommandService,
+		admins:                admins,
 	}
 }
 
 func (u *refundPaymentHandler) Handle(ctx context.Context, req *in.RefundPaymentRequest) (*out.RefundPaymentResponse, error) {
-	return u.paymentCommandService.RefundPayment(ctx, req)
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.TrimSpace(req.IdempotencyKey) == "" {
+		return nil, stackErr.Error(ErrIdempotencyKeyEmpty)
+	}
+
+	res, err := u.paymentCommandService.RefundPayment(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapPaymentError(err))
+	}
+	return res, nil
 }
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type reviewReconciliationDiscrepancyHandler struct {
+	reconciliationService paymentservice.PaymentReconciliationService
+	admins                accountsupport.AdminRoles
+}
+
+func NewReviewReconciliationDiscrepancy(
+	reconciliationService paymentservice.PaymentReconciliationService,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.ReviewReconciliationDiscrepancyRequest, *out.ReconciliationDiscrepancyResponse] {
+	return &reviewReconciliationDiscrepancyHandler{
+		reconciliationService: reconciliationService,
+		admins:                admins,
+	}
+}
+
+func (u *reviewReconciliationDiscrepancyHandler) Handle(ctx context.Context, req *in.ReviewReconciliationDiscrepancyRequest) (*out.ReconciliationDiscrepancyResponse, error) {
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	res, err := u.reconciliationService.ReviewDiscrepancy(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapPaymentError(err))
+	}
+	return res, nil
+}
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type uploadDisputeEvidenceHandler struct {
+	disputeService paymentservice.PaymentDisputeService
+	admins         accountsupport.AdminRoles
+}
+
+func NewUploadDisputeEvidence(
+	disputeService paymentservice.PaymentDisputeService,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse] {
+	return &uploadDisputeEvidenceHandler{
+		disputeService: disputeService,
+		admins:         admins,
+	}
+}
+
+func (u *uploadDisputeEvidenceHandler) Handle(ctx context.Context, req *in.UploadDisputeEvidenceRequest) (*out.DisputeEvidenceResponse, error) {
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	res, err := u.disputeService.UploadDisputeEvidence(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapPaymentError(err))
+	}
+	return res, nil
+}
@@ -9,10 +9,11 @@ import (
 )
 
 type CreatePaymentRequest struct {
-	Provider string            `json:"provider" form:"provider" binding:"required"`
-	Amount   int64             `json:"amount" form:"amount" binding:"required"`
-	Currency string            `json:"currency" form:"currency" binding:"required"`
-	Metadata map[string]string `json:"metadata" form:"metadata"`
+	Provider        string            `json:"provider" form:"provider" binding:"required"`
+	Amount          int64             `json:"amount" form:"amount" binding:"required"`
+	Currency        string            `json:"currency" form:"currency" binding:"required"`
+	QuotedFeeAmount *int64            `json:"quoted_fee_amount" form:"quoted_fee_amount"`
+	Metadata        map[string]string `json:"metadata" form:"metadata"`
 }
 
 func (r *CreatePaymentRequest) Normalize() {
@@ -9,10 +9,12 @@ import (
 )
 
 type CreateWithdrawalRequest struct {
-	Provider string            `json:"provider" form:"provider" binding:"required"`
-	Amount   int64             `json:"amount" form:"amount" binding:"required"`
-	Currency string            `json:"currency" form:"currency" binding:"required"`
-	Metadata map[string]string `json:"metadata" form:"metadata"`
+	Provider        string            `json:"provider" form:"provider" binding:"required"`
+	Amount          int64             `json:"amount" form:"amount" binding:"required"`
+	Currency        string            `json:"currency" form:"currency" binding:"required"`
+	QuotedFeeAmount *int64            `json:"quoted_fee_amount" form:"quoted_fee_amount"`
+	Metadata        map[string]string `json:"metadata" form:"metadata"`
+	IdempotencyKey  string            `json:"idempotency_key" form:"idempotency_key"`
 }
 
 func (r *CreateWithdrawalRequest) Normalize() {
@@ -21,6 +23,7 @@ func (r *CreateWithdrawalRequest) Normalize() {
 	for key, value := range r.Metadata {
 		r.Metadata[key] = strings.TrimSpace(value)
 	}
+	r.IdempotencyKey = strings.TrimSpace(r.IdempotencyKey)
 }
 
 func (r *CreateWithdrawalRequest) Validate() error {
@@ -34,5 +37,8 @@ func (r *CreateWithdrawalRequest) Validate() error {
 	if r.Currency == "" {
 		return stackErr.Error(errors.New("currency is required"))
 	}
+	if r.IdempotencyKey == "" {
+		return stackErr.Error(errors.New("idempotency_key is required"))
+	}
 	return nil
 }
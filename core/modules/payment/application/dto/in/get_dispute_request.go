@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetDisputeRequest struct {
+	DisputeID string `json:"dispute_id" form:"dispute_id" binding:"required"`
+}
+
+func (r *GetDisputeRequest) Normalize() {
+	r.DisputeID = strings.TrimSpace(r.DisputeID)
+}
+
+func (r *GetDisputeRequest) Validate() error {
+	r.Normalize()
+	if r.DisputeID == "" {
+		return stackErr.Error(errors.New("dispute_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetPaymentIntentRequest struct {
+	TransactionID string `json:"transaction_id" form:"transaction_id" binding:"required"`
+}
+
+func (r *GetPaymentIntentRequest) Normalize() {
+	r.TransactionID = strings.TrimSpace(r.TransactionID)
+}
+
+func (r *GetPaymentIntentRequest) Validate() error {
+	r.Normalize()
+	if r.TransactionID == "" {
+		return stackErr.Error(errors.New("transaction_id is required"))
+	}
+	return nil
+}
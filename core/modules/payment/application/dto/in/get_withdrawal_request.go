@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetWithdrawalRequest struct {
+	TransactionID string `json:"transaction_id" form:"transaction_id" binding:"required"`
+}
+
+func (r *GetWithdrawalRequest) Normalize() {
+	r.TransactionID = strings.TrimSpace(r.TransactionID)
+}
+
+func (r *GetWithdrawalRequest) Validate() error {
+	r.Normalize()
+	if r.TransactionID == "" {
+		return stackErr.Error(errors.New("transaction_id is required"))
+	}
+	return nil
+}
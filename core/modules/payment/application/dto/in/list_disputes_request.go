@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ListDisputesRequest struct {
+	Provider string `json:"provider" form:"provider"`
+	Status   string `json:"status" form:"status"`
+	Cursor   string `json:"cursor" form:"cursor"`
+	Limit    int    `json:"limit" form:"limit"`
+}
+
+func (r *ListDisputesRequest) Normalize() {
+	r.Provider = strings.TrimSpace(r.Provider)
+	r.Status = strings.TrimSpace(r.Status)
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListDisputesRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
@@ -0,0 +1,23 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ListPaymentIntentsRequest struct {
+	Workflow string `json:"workflow" form:"workflow"`
+	Cursor   string `json:"cursor" form:"cursor"`
+	Limit    int    `json:"limit" form:"limit"`
+}
+
+func (r *ListPaymentIntentsRequest) Normalize() {
+	r.Workflow = strings.TrimSpace(r.Workflow)
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListPaymentIntentsRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
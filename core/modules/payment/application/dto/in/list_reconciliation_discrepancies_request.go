@@ -0,0 +1,27 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ListReconciliationDiscrepanciesRequest struct {
+	Provider string `json:"provider" form:"provider"`
+	Kind     string `json:"kind" form:"kind"`
+	Status   string `json:"status" form:"status"`
+	Cursor   string `json:"cursor" form:"cursor"`
+	Limit    int    `json:"limit" form:"limit"`
+}
+
+func (r *ListReconciliationDiscrepanciesRequest) Normalize() {
+	r.Provider = strings.TrimSpace(r.Provider)
+	r.Kind = strings.TrimSpace(r.Kind)
+	r.Status = strings.TrimSpace(r.Status)
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListReconciliationDiscrepanciesRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
@@ -9,14 +9,16 @@ import (
 )
 
 type ProcessWebhookRequest struct {
-	Provider  string `json:"provider" form:"provider" binding:"required"`
-	Signature string `json:"signature" form:"signature"`
-	Payload   string `json:"payload" form:"payload"`
+	Provider         string `json:"provider" form:"provider" binding:"required"`
+	Signature        string `json:"signature" form:"signature"`
+	WebhookSignature string `json:"webhook_signature" form:"webhook_signature"`
+	Payload          string `json:"payload" form:"payload"`
 }
 
 func (r *ProcessWebhookRequest) Normalize() {
 	r.Provider = strings.TrimSpace(r.Provider)
 	r.Signature = strings.TrimSpace(r.Signature)
+	r.WebhookSignature = strings.TrimSpace(r.WebhookSignature)
 }
 
 func (r *ProcessWebhookRequest) Validate() error {
@@ -5,27 +5,26 @@ package in
 import (
 	"errors"
 	"strings"
-
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type RefundPaymentRequest struct {
-	Provider      string `json:"provider" form:"provider" binding:"required"`
-	TransactionID string `json:"transaction_id" form:"transaction_id" binding:"required"`
-	Reason        string `json:"reason" form:"reason"`
+	TransactionID  string `json:"transaction_id" form:"transaction_id"`
+	Provider       string `json:"provider" form:"provider"`
+	Amount         int64  `json:"amount" form:"amount"`
+	Reason         string `json:"reason" form:"reason"`
+	IdempotencyKey string `json:"idempotency_key" form:"idempotency_key"`
 }
 
 func (r *RefundPaymentRequest) Normalize() {
-	r.Provider = strings.TrimSpace(r.Provider)
 	r.TransactionID = strings.TrimSpace(r.TransactionID)
+	r.Provider = strings.TrimSpace(r.Provider)
 	r.Reason = strings.TrimSpace(r.Reason)
+	r.IdempotencyKey = strings.TrimSpace(r.IdempotencyKey)
 }
 
 func (r *RefundPaymentRequest) Validate() error {
 	r.Normalize()
-	if r.Provider == "" {
-		return stackErr.Error(errors.New("provider is required"))
-	}
 	if r.TransactionID == "" {
 		return stackErr.Error(errors.New("transaction_id is required"))
 	}
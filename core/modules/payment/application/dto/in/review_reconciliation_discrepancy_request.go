@@ -0,0 +1,32 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ReviewReconciliationDiscrepancyRequest struct {
+	DiscrepancyID string `json:"discrepancy_id" form:"discrepancy_id" binding:"required"`
+	Status        string `json:"status" form:"status" binding:"required"`
+	Note          string `json:"note" form:"note"`
+}
+
+func (r *ReviewReconciliationDiscrepancyRequest) Normalize() {
+	r.DiscrepancyID = strings.TrimSpace(r.DiscrepancyID)
+	r.Status = strings.TrimSpace(r.Status)
+	r.Note = strings.TrimSpace(r.Note)
+}
+
+func (r *ReviewReconciliationDiscrepancyRequest) Validate() error {
+	r.Normalize()
+	if r.DiscrepancyID == "" {
+		return stackErr.Error(errors.New("discrepancy_id is required"))
+	}
+	if r.Status == "" {
+		return stackErr.Error(errors.New("status is required"))
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SubmitDisputeEvidenceRequest struct {
+	DisputeID string `json:"dispute_id" form:"dispute_id" binding:"required"`
+	Note      string `json:"note" form:"note"`
+}
+
+func (r *SubmitDisputeEvidenceRequest) Normalize() {
+	r.DisputeID = strings.TrimSpace(r.DisputeID)
+	r.Note = strings.TrimSpace(r.Note)
+}
+
+func (r *SubmitDisputeEvidenceRequest) Validate() error {
+	r.Normalize()
+	if r.DisputeID == "" {
+		return stackErr.Error(errors.New("dispute_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type UploadDisputeEvidenceRequest struct {
+	DisputeID     string `json:"dispute_id" form:"dispute_id" binding:"required"`
+	Kind          string `json:"kind" form:"kind"`
+	FileName      string `json:"file_name" form:"file_name" binding:"required"`
+	ContentType   string `json:"content_type" form:"content_type"`
+	ContentBase64 string `json:"content_base64" form:"content_base64" binding:"required"`
+}
+
+func (r *UploadDisputeEvidenceRequest) Normalize() {
+	r.DisputeID = strings.TrimSpace(r.DisputeID)
+	r.Kind = strings.TrimSpace(r.Kind)
+	r.FileName = strings.TrimSpace(r.FileName)
+	r.ContentType = strings.TrimSpace(r.ContentType)
+	r.ContentBase64 = strings.TrimSpace(r.ContentBase64)
+}
+
+func (r *UploadDisputeEvidenceRequest) Validate() error {
+	r.Normalize()
+	if r.DisputeID == "" {
+		return stackErr.Error(errors.New("dispute_id is required"))
+	}
+	if r.FileName == "" {
+		return stackErr.Error(errors.New("file_name is required"))
+	}
+	if r.ContentBase64 == "" {
+		return stackErr.Error(errors.New("content_base64 is required"))
+	}
+	return nil
+}
@@ -10,4 +10,5 @@ type CreateWithdrawalResponse struct {
 	FeeAmount      int64  `json:"fee_amount,omitempty"`
 	ProviderAmount int64  `json:"provider_amount,omitempty"`
 	Status         string `json:"status,omitempty"`
+	Duplicate      bool   `json:"duplicate,omitempty"`
 }
@@ -0,0 +1,13 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type DisputeEvidenceResponse struct {
+	ID          string `json:"id,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	FileName    string `json:"file_name,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	UploadedBy  string `json:"uploaded_by,omitempty"`
+	SubmittedAt string `json:"submitted_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
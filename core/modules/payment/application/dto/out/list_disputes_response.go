@@ -0,0 +1,28 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListDisputesResponse struct {
+	Records    []DisputeResponse `json:"records,omitempty"`
+	Limit      int               `json:"limit,omitempty"`
+	Size       int               `json:"size,omitempty"`
+	HasMore    bool              `json:"has_more,omitempty"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
+
+type DisputeResponse struct {
+	ID                  string                    `json:"id,omitempty"`
+	Provider            string                    `json:"provider,omitempty"`
+	ProviderDisputeID   string                    `json:"provider_dispute_id,omitempty"`
+	TransactionID       string                    `json:"transaction_id,omitempty"`
+	AccountID           string                    `json:"account_id,omitempty"`
+	Amount              int64                     `json:"amount,omitempty"`
+	Currency            string                    `json:"currency,omitempty"`
+	Reason              string                    `json:"reason,omitempty"`
+	Status              string                    `json:"status,omitempty"`
+	EvidenceDueBy       string                    `json:"evidence_due_by,omitempty"`
+	EvidenceSubmittedAt string                    `json:"evidence_submitted_at,omitempty"`
+	OpenedAt            string                    `json:"opened_at,omitempty"`
+	UpdatedAt           string                    `json:"updated_at,omitempty"`
+	ClosedAt            string                    `json:"closed_at,omitempty"`
+	Evidence            []DisputeEvidenceResponse `json:"evidence,omitempty"`
+}
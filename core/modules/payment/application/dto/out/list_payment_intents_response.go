@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListPaymentIntentsResponse struct {
+	Records    []PaymentIntentResponse `json:"records,omitempty"`
+	Limit      int                     `json:"limit,omitempty"`
+	Size       int                     `json:"size,omitempty"`
+	HasMore    bool                    `json:"has_more,omitempty"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+type PaymentIntentResponse struct {
+	Provider       string                        `json:"provider,omitempty"`
+	Workflow       string                        `json:"workflow,omitempty"`
+	TransactionID  string                        `json:"transaction_id,omitempty"`
+	ExternalRef    string                        `json:"external_ref,omitempty"`
+	Amount         int64                         `json:"amount,omitempty"`
+	FeeAmount      int64                         `json:"fee_amount,omitempty"`
+	ProviderAmount int64                         `json:"provider_amount,omitempty"`
+	Currency       string                        `json:"currency,omitempty"`
+	Status         string                        `json:"status,omitempty"`
+	CreatedAt      string                        `json:"created_at,omitempty"`
+	UpdatedAt      string                        `json:"updated_at,omitempty"`
+	History        []PaymentStatusChangeResponse `json:"history,omitempty"`
+}
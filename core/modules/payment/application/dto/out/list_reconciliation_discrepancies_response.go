@@ -0,0 +1,29 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListReconciliationDiscrepanciesResponse struct {
+	Records    []ReconciliationDiscrepancyResponse `json:"records,omitempty"`
+	Limit      int                                 `json:"limit,omitempty"`
+	Size       int                                 `json:"size,omitempty"`
+	HasMore    bool                                `json:"has_more,omitempty"`
+	NextCursor string                              `json:"next_cursor,omitempty"`
+}
+
+type ReconciliationDiscrepancyResponse struct {
+	ID             string `json:"id,omitempty"`
+	Provider       string `json:"provider,omitempty"`
+	Kind           string `json:"kind,omitempty"`
+	TransactionID  string `json:"transaction_id,omitempty"`
+	ExternalRef    string `json:"external_ref,omitempty"`
+	Workflow       string `json:"workflow,omitempty"`
+	ExpectedAmount int64  `json:"expected_amount,omitempty"`
+	ActualAmount   int64  `json:"actual_amount,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	Detail         string `json:"detail,omitempty"`
+	Status         string `json:"status,omitempty"`
+	ReviewNote     string `json:"review_note,omitempty"`
+	ReviewedBy     string `json:"reviewed_by,omitempty"`
+	ReviewedAt     string `json:"reviewed_at,omitempty"`
+	DetectedAt     string `json:"detected_at,omitempty"`
+	LastDetectedAt string `json:"last_detected_at,omitempty"`
+}
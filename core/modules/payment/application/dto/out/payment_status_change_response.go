@@ -0,0 +1,8 @@
+package out
+
+type PaymentStatusChangeResponse struct {
+	Event      string `json:"event,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Amount     int64  `json:"amount,omitempty"`
+	OccurredAt string `json:"occurred_at,omitempty"`
+}
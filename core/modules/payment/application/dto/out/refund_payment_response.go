@@ -5,6 +5,7 @@ type RefundPaymentResponse struct {
 	Provider      string                    `json:"provider,omitempty"`
 	TransactionID string                    `json:"transaction_id,omitempty"`
 	ExternalRef   string                    `json:"external_ref,omitempty"`
+	Amount        int64                     `json:"amount,omitempty"`
 	Status        string                    `json:"status,omitempty"`
 	Duplicate     bool                      `json:"duplicate,omitempty"`
 	Events        []PaymentIntegrationEvent `json:"events,omitempty"`
@@ -0,0 +1,31 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrUnauthorized    = apperr.New("payment.unauthorized", "unauthorized", http.StatusUnauthorized)
+	ErrAdminRequired   = apperr.New("payment.admin_required", "admin role is required", http.StatusForbidden)
+	ErrIntentNotFound  = apperr.New("payment.intent_not_found", "payment intent not found", http.StatusNotFound)
+	ErrDisputeNotFound = apperr.New("payment.dispute_not_found", "payment dispute not found", http.StatusNotFound)
+)
+
+func mapQueryError(err error) error {
+	switch {
+	case errors.Is(err, paymentservice.ErrValidation):
+		return apperr.New("payment.validation", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, paymentservice.ErrPaymentUnauthorized):
+		return ErrUnauthorized
+	case errors.Is(err, paymentservice.ErrPaymentIntentNotFound):
+		return ErrIntentNotFound
+	case errors.Is(err, paymentservice.ErrDisputeNotFound):
+		return ErrDisputeNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getDisputeHandler struct {
+	disputeService paymentservice.PaymentDisputeService
+	admins         accountsupport.AdminRoles
+}
+
+func NewGetDispute(
+	disputeService paymentservice.PaymentDisputeService,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.GetDisputeRequest, *out.DisputeResponse] {
+	return &getDisputeHandler{
+		disputeService: disputeService,
+		admins:         admins,
+	}
+}
+
+func (u *getDisputeHandler) Handle(ctx context.Context, req *in.GetDisputeRequest) (*out.DisputeResponse, error) {
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	res, err := u.disputeService.GetDispute(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapQueryError(err))
+	}
+	return res, nil
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getPaymentIntentHandler struct {
+	paymentQueryService paymentservice.PaymentQueryService
+}
+
+func NewGetPaymentIntent(paymentQueryService paymentservice.PaymentQueryService) cqrs.Handler[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse] {
+	return &getPaymentIntentHandler{
+		paymentQueryService: paymentQueryService,
+	}
+}
+
+func (u *getPaymentIntentHandler) Handle(ctx context.Context, req *in.GetPaymentIntentRequest) (*out.PaymentIntentResponse, error) {
+	res, err := u.paymentQueryService.GetPaymentIntent(ctx, req.TransactionID, "")
+	if err != nil {
+		return nil, stackErr.Error(mapQueryError(err))
+	}
+	return res, nil
+}
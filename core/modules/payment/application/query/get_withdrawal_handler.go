@@ -0,0 +1,30 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getWithdrawalHandler struct {
+	paymentQueryService paymentservice.PaymentQueryService
+}
+
+func NewGetWithdrawal(paymentQueryService paymentservice.PaymentQueryService) cqrs.Handler[*in.GetWithdrawalRequest, *out.PaymentIntentResponse] {
+	return &getWithdrawalHandler{
+		paymentQueryService: paymentQueryService,
+	}
+}
+
+func (u *getWithdrawalHandler) Handle(ctx context.Context, req *in.GetWithdrawalRequest) (*out.PaymentIntentResponse, error) {
+	res, err := u.paymentQueryService.GetPaymentIntent(ctx, req.TransactionID, entity.PaymentWorkflowWithdrawal)
+	if err != nil {
+		return nil, stackErr.Error(mapQueryError(err))
+	}
+	return res, nil
+}
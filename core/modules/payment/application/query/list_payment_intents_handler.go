@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listPaymentIntentsHandler struct {
+	paymentQueryService paymentservice.PaymentQueryService
+}
+
+func NewListPaymentIntents(paymentQueryService paymentservice.PaymentQueryService) cqrs.Handler[*in.ListPaymentIntentsRequest, *out.ListPaymentIntentsResponse] {
+	return &listPaymentIntentsHandler{
+		paymentQueryService: paymentQueryService,
+	}
+}
+
+func (u *listPaymentIntentsHandler) Handle(ctx context.Context, req *in.ListPaymentIntentsRequest) (*out.ListPaymentIntentsResponse, error) {
+	res, err := u.paymentQueryService.ListPaymentIntents(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapQueryError(err))
+	}
+	return res, nil
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentservice "wechat-clone/core/modules/payment/application/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listReconciliationDiscrepanciesHandler struct {
+	reconciliationService paymentservice.PaymentReconciliationService
+	admins                accountsupport.AdminRoles
+}
+
+func NewListReconciliationDiscrepancies(
+	reconciliationService paymentservice.PaymentReconciliationService,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse] {
+	return &listReconciliationDiscrepanciesHandler{
+		reconciliationService: reconciliationService,
+		admins:                admins,
+	}
+}
+
+func (u *listReconciliationDiscrepanciesHandler) Handle(ctx context.Context, req *in.ListReconciliationDiscrepanciesRequest) (*out.ListReconciliationDiscrepanciesResponse, error) {
+	if err := requireAdmin(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	res, err := u.reconciliationService.ListDiscrepancies(ctx, req)
+	if err != nil {
+		return nil, stackErr.Error(mapQueryError(err))
+	}
+	return res, nil
+}
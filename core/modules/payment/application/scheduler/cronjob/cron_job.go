@@ -18,7 +18,7 @@ type cronJob struct {
 	scheduler *asynq.Scheduler
 }
 
-func NewCronJob(scheduler *asynq.Scheduler, interval time.Duration) (CronJob, error) {
+func NewCronJob(scheduler *asynq.Scheduler, interval, reconciliationInterval time.Duration) (CronJob, error) {
 	if scheduler == nil {
 		return &cronJob{}, nil
 	}
@@ -34,6 +34,17 @@ func NewCronJob(scheduler *asynq.Scheduler, interval time.Duration) (CronJob, er
 		return nil, stackErr.Error(err)
 	}
 
+	reconcileTask := asynq.NewTask(paymenttask.ReconcileProviderSettlementTask, nil)
+	if _, err := scheduler.Register(
+		paymenttask.PeriodicSpec(reconciliationInterval),
+		reconcileTask,
+		asynq.Queue(paymenttask.QueueName),
+		asynq.MaxRetry(0),
+		asynq.Unique(reconciliationInterval),
+	); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	return &cronJob{scheduler: scheduler}, nil
 }
 
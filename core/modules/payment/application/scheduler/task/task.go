@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	ProcessPendingWithdrawalsTask = "payment:withdrawal:process-pending"
-	QueueName                     = "payment:scheduler"
+	ProcessPendingWithdrawalsTask   = "payment:withdrawal:process-pending"
+	ReconcileProviderSettlementTask = "payment:reconciliation:run"
+	QueueName                       = "payment:scheduler"
 )
 
 func PeriodicSpec(interval time.Duration) string {
@@ -18,17 +18,23 @@ type TaskHandler interface {
 }
 
 type taskHandler struct {
-	service paymentservice.PaymentCommandService
-	server  *asynq.Server
+	service               paymentservice.PaymentCommandService
+	reconciliationService paymentservice.PaymentReconciliationService
+	server                *asynq.Server
 }
 
-func NewTaskHandler(service paymentservice.PaymentCommandService, server *asynq.Server) TaskHandler {
+func NewTaskHandler(
+	service paymentservice.PaymentCommandService,
+	reconciliationService paymentservice.PaymentReconciliationService,
+	server *asynq.Server,
+) TaskHandler {
 	if service == nil || server == nil {
 		return &taskHandler{}
 	}
 	return &taskHandler{
-		service: service,
-		server:  server,
+		service:               service,
+		reconciliationService: reconciliationService,
+		server:                server,
 	}
 }
 
@@ -39,6 +45,7 @@ func (h *taskHandler) Start() error {
 
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(paymenttask.ProcessPendingWithdrawalsTask, h.handleProcessPendingWithdrawals)
+	mux.HandleFunc(paymenttask.ReconcileProviderSettlementTask, h.handleReconcileProviderSettlement)
 
 	if err := h.server.Start(mux); err != nil {
 		return stackErr.Error(err)
@@ -68,3 +75,16 @@ func (h *taskHandler) handleProcessPendingWithdrawals(ctx context.Context, _ *as
 
 	return nil
 }
+
+func (h *taskHandler) handleReconcileProviderSettlement(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.reconciliationService == nil {
+		return nil
+	}
+
+	if err := h.reconciliationService.RunReconciliation(ctx); err != nil {
+		logging.FromContext(ctx).Warnw("payment reconciliation failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
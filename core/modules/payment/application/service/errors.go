@@ -9,4 +9,7 @@ var (
 	ErrDuplicatePayment      = errors.New("payment already exists")
 	ErrPaymentIntentNotFound = errors.New("payment intent not found")
 	ErrPaymentUnauthorized   = errors.New("unauthorized")
+	ErrIdempotencyKeyUsed    = errors.New("idempotency key was already used for another request")
+	ErrDiscrepancyNotFound   = errors.New("reconciliation discrepancy not found")
+	ErrDisputeNotFound       = errors.New("payment dispute not found")
 )
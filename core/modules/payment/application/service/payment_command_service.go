@@ -38,7 +38,8 @@ type paymentCommandService struct {
 	baseRepo            repos.Repos
 	locker              lock.Lock
 	providerRegistry    domainservice.PaymentProviderRegistry
-	feePolicy           finance.StripeFeePolicy
+	limits              domainservice.PaymentLimitPolicy
+	fees                domainservice.PaymentFeePolicy
 	withdrawalBatchSize int
 }
 
@@ -51,15 +52,15 @@ func NewPaymentCommandService(
 	appCtx *appCtx.AppContext,
 	baseRepo repos.Repos,
 	providerRegistry domainservice.PaymentProviderRegistry,
+	limits domainservice.PaymentLimitPolicy,
+	fees domainservice.PaymentFeePolicy,
 ) PaymentCommandService {
 	return &paymentCommandService{
-		baseRepo:         baseRepo,
-		providerRegistry: providerRegistry,
-		locker:           appCtx.Locker(),
-		feePolicy: finance.StripeFeePolicy{
-			RateBPS:    appCtx.GetConfig().LedgerConfig.Stripe.FeeRateBPS,
-			FlatAmount: appCtx.GetConfig().LedgerConfig.Stripe.FeeFlatAmount,
-		},
+		baseRepo:            baseRepo,
+		providerRegistry:    providerRegistry,
+		limits:              limits,
+		fees:                fees,
+		locker:              appCtx.Locker(),
 		withdrawalBatchSize: appCtx.GetConfig().LedgerConfig.Stripe.WithdrawalBatchSize,
 	}
 }
@@ -73,9 +74,11 @@ func (s *paymentCommandService) CreatePayment(
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	feeAmount, err := s.computeStripeFee(req.Amount)
+	feeAmount, err := s.quoteFee(req.QuotedFeeAmount, func() (int64, error) {
+		return s.fees.QuoteTopUpFee(ctx, req.Provider, creditAccountID, req.Currency, req.Amount)
+	})
 	if err != nil {
-		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		return nil, stackErr.Error(err)
 	}
 
 	now := time.Now().UTC()
@@ -98,16 +101,21 @@ func (s *paymentCommandService) CreatePayment(
 		return nil, stackErr.Error(err)
 	}
 
+	intentSnapshot := paymentAggregate.Snapshot()
+	if err := s.reserveLimit(ctx, intentSnapshot); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	if err := s.baseRepo.WithTransaction(ctx, func(tx repos.Repos) error {
 		return stackErr.Error(tx.PaymentIntentAggregateRepository().Save(ctx, paymentAggregate))
 	}); err != nil {
+		s.releaseLimit(ctx, intentSnapshot)
 		if errors.Is(err, repos.ErrProviderPaymentDuplicateIntent) {
 			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrDuplicatePayment, paymentAggregate.TransactionID()))
 		}
 		return nil, stackErr.Error(err)
 	}
 
-	intentSnapshot := paymentAggregate.Snapshot()
 	creation, err := provider.CreatePayment(ctx, intentSnapshot, req.Metadata)
 	if err != nil {
 		log.Errorw("provider create payment failed", "provider", provider.Name(), "transaction_id", paymentAggregate.TransactionID(), zap.Error(err))
@@ -143,9 +151,8 @@ func (s *paymentCommandService) CreateWithdrawal(
 		return nil, stackErr.Error(ErrPaymentUnauthorized)
 	}
 
-	feeAmount, err := s.computeStripeFee(req.Amount)
-	if err != nil {
-		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	if req.IdempotencyKey == "" {
+		return nil, stackErr.Error(fmt.Errorf("%w: idempotency key is required", ErrValidation))
 	}
 
 	metadata := cloneMetadata(req.Metadata)
@@ -153,6 +160,20 @@ func (s *paymentCommandService) CreateWithdrawal(
 		return nil, stackErr.Error(fmt.Errorf("%w: metadata.destination_account is required", ErrValidation))
 	}
 
+	// A retry answers with the withdrawal it already created, even when the
+	// fee schedule has changed since it was quoted.
+	replayed, err := s.replayWithdrawal(ctx, debitAccountID, req)
+	if err != nil || replayed != nil {
+		return replayed, stackErr.Error(err)
+	}
+
+	feeAmount, err := s.quoteFee(req.QuotedFeeAmount, func() (int64, error) {
+		return s.fees.QuoteWithdrawalFee(ctx, req.Provider, debitAccountID, req.Currency, req.Amount)
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	now := time.Now().UTC()
 	paymentAggregate, err := paymentaggregate.NewProviderWithdrawalAggregate(
 		uuid.New().String(),
@@ -169,26 +190,66 @@ func (s *paymentCommandService) CreateWithdrawal(
 		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
 	}
 
+	intentSnapshot := paymentAggregate.Snapshot()
+	if err := s.reserveLimit(ctx, intentSnapshot); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	if err := s.baseRepo.WithTransaction(ctx, func(tx repos.Repos) error {
-		return stackErr.Error(tx.PaymentIntentAggregateRepository().Save(ctx, paymentAggregate))
+		if err := tx.PaymentIntentAggregateRepository().Save(ctx, paymentAggregate); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(tx.PaymentIdempotencyKeyRepository().Create(ctx, &entity.PaymentIdempotencyKey{
+			AccountID:     debitAccountID,
+			Scope:         entity.PaymentIdempotencyScopeWithdrawal,
+			Key:           req.IdempotencyKey,
+			TransactionID: paymentAggregate.TransactionID(),
+			CreatedAt:     now,
+		}))
 	}); err != nil {
+		s.releaseLimit(ctx, intentSnapshot)
+		if errors.Is(err, repos.ErrPaymentIdempotencyKeyExists) {
+			// A concurrent request with the same key won the insert; answer
+			// with the withdrawal it created.
+			replayed, replayErr := s.replayWithdrawal(ctx, debitAccountID, req)
+			if replayErr != nil || replayed != nil {
+				return replayed, stackErr.Error(replayErr)
+			}
+		}
 		if errors.Is(err, repos.ErrProviderPaymentDuplicateIntent) {
 			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrDuplicatePayment, paymentAggregate.TransactionID()))
 		}
 		return nil, stackErr.Error(err)
 	}
 
+	return toCreateWithdrawalResponse(paymentAggregate.Snapshot(), false), nil
+}
+
+// replayWithdrawal returns the withdrawal an earlier request with the same
+// idempotency key created, or nil when the key is unused. Reusing a key for a
+// different withdrawal is rejected.
+func (s *paymentCommandService) replayWithdrawal(
+	ctx context.Context,
+	accountID string,
+	req *in.CreateWithdrawalRequest,
+) (*out.CreateWithdrawalResponse, error) {
+	key, err := s.baseRepo.PaymentIdempotencyKeyRepository().Get(ctx, accountID, entity.PaymentIdempotencyScopeWithdrawal, req.IdempotencyKey)
+	if err != nil || key == nil {
+		return nil, stackErr.Error(err)
+	}
+
+	paymentAggregate, err := s.baseRepo.PaymentIntentAggregateRepository().GetByTransactionID(ctx, key.TransactionID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 	snapshot := paymentAggregate.Snapshot()
-	return &out.CreateWithdrawalResponse{
-		Provider:       snapshot.Provider,
-		Workflow:       snapshot.Workflow,
-		TransactionID:  snapshot.TransactionID,
-		ExternalRef:    snapshot.ExternalRef,
-		Amount:         snapshot.Amount,
-		FeeAmount:      snapshot.FeeAmount,
-		ProviderAmount: snapshot.ProviderAmount,
-		Status:         snapshot.Status,
-	}, nil
+	if !strings.EqualFold(snapshot.Provider, req.Provider) ||
+		snapshot.Amount != req.Amount ||
+		!strings.EqualFold(snapshot.Currency, req.Currency) {
+		return nil, stackErr.Error(ErrIdempotencyKeyUsed)
+	}
+
+	return toCreateWithdrawalResponse(snapshot, true), nil
 }
 
 func (s *paymentCommandService) ProcessPendingWithdrawals(ctx context.Context) error {
@@ -217,13 +278,18 @@ func (s *paymentCommandService) ProcessWebhook(
 	ctx context.Context,
 	req *in.ProcessWebhookRequest,
 ) (*out.ProcessWebhookResponse, error) {
-	log := logging.FromContext(ctx).Named("ProcessWebhook")
 	provider, err := s.providerRegistry.Get(req.Provider)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
-	webhook, err := provider.ParseWebhook(ctx, []byte(req.Payload), req.Signature)
+	// Stripe signs with Stripe-Signature, the other gateways with
+	// X-Webhook-Signature; a request only carries one of them.
+	signature := req.Signature
+	if signature == "" {
+		signature = req.WebhookSignature
+	}
+	webhook, err := provider.ParseWebhook(ctx, []byte(req.Payload), signature)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -239,30 +305,19 @@ func (s *paymentCommandService) ProcessWebhook(
 		return nil, stackErr.Error(err)
 	}
 
-	lockKey := fmt.Sprintf("payment:%s", paymentAggregate.TransactionID())
-	lockValue := uuid.NewString()
-	locked, err := s.locker.AcquireLock(ctx, lockKey, lockValue, 30*time.Second, 100*time.Millisecond, 3*time.Second)
+	release, err := s.lockPayment(ctx, paymentAggregate.TransactionID())
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	if !locked {
-		return nil, stackErr.Error(fmt.Errorf("acquire payment lock failed: transaction_id=%s", paymentAggregate.TransactionID()))
-	}
-	defer func() {
-		released, err := s.locker.ReleaseLock(ctx, lockKey, lockValue)
-		if err != nil {
-			log.Errorw("failed to release payment lock", zap.String("transaction_id", paymentAggregate.TransactionID()), zap.String("lock_key", lockKey), zap.Error(err))
-			return
-		}
-		if !released {
-			log.Warnw("payment lock was not released", zap.String("transaction_id", paymentAggregate.TransactionID()), zap.String("lock_key", lockKey))
-		}
-	}()
+	defer release()
 
 	paymentAggregate, err = s.baseRepo.PaymentIntentAggregateRepository().GetByTransactionID(ctx, paymentAggregate.TransactionID())
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	if webhook.Dispute != nil {
+		return s.applyDisputeUpdate(ctx, paymentAggregate, *webhook.Dispute)
+	}
 
 	outcome, err := s.applyProviderOutcome(ctx, paymentAggregate, webhook.Result, "", false)
 	if err != nil {
@@ -294,34 +349,28 @@ func (s *paymentCommandService) RefundPayment(
 		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
 	}
 
-	log := logging.FromContext(ctx).Named("RefundPayment")
-	lockKey := fmt.Sprintf("payment:%s", req.TransactionID)
-	lockValue := uuid.NewString()
-	locked, err := s.locker.AcquireLock(ctx, lockKey, lockValue, 30*time.Second, 100*time.Millisecond, 3*time.Second)
+	release, err := s.lockPayment(ctx, req.TransactionID)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	if !locked {
-		return nil, stackErr.Error(fmt.Errorf("acquire payment lock failed: transaction_id=%s", req.TransactionID))
-	}
-	defer func() {
-		released, releaseErr := s.locker.ReleaseLock(ctx, lockKey, lockValue)
-		if releaseErr != nil {
-			log.Errorw("failed to release payment lock", zap.String("transaction_id", req.TransactionID), zap.String("lock_key", lockKey), zap.Error(releaseErr))
-			return
-		}
-		if !released {
-			log.Warnw("payment lock was not released", zap.String("transaction_id", req.TransactionID), zap.String("lock_key", lockKey))
-		}
-	}()
+	defer release()
 
 	paymentAggregate, err := s.baseRepo.PaymentIntentAggregateRepository().GetByTransactionID(ctx, req.TransactionID)
 	if err != nil {
+		if errors.Is(err, repos.ErrProviderPaymentNotFound) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrPaymentIntentNotFound, req.TransactionID))
+		}
 		return nil, stackErr.Error(err)
 	}
-	if !strings.EqualFold(paymentAggregate.Provider(), req.Provider) {
+	if req.Provider != "" && !strings.EqualFold(paymentAggregate.Provider(), req.Provider) {
 		return nil, stackErr.Error(fmt.Errorf("%w: provider mismatch", ErrValidation))
 	}
+	if req.IdempotencyKey != "" {
+		replayed, err := s.reserveRefundKey(ctx, req, paymentAggregate)
+		if err != nil || replayed != nil {
+			return replayed, stackErr.Error(err)
+		}
+	}
 	if paymentAggregate.Status() == entity.PaymentStatusRefunded {
 		return &out.RefundPaymentResponse{
 			Provider:      paymentAggregate.Provider(),
@@ -334,13 +383,17 @@ func (s *paymentCommandService) RefundPayment(
 	if paymentAggregate.Status() != entity.PaymentStatusSuccess {
 		return nil, stackErr.Error(fmt.Errorf("%w: payment must be successful before refund", ErrValidation))
 	}
+	amount, err := paymentAggregate.Snapshot().RefundAmount(req.Amount)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
 
 	provider, err := s.providerRegistry.Get(paymentAggregate.Provider())
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
-	refund, err := provider.RefundPayment(ctx, paymentAggregate.Snapshot(), req.Reason)
+	refund, err := provider.RefundPayment(ctx, paymentAggregate.Snapshot(), amount, req.Reason, req.IdempotencyKey)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -353,16 +406,93 @@ func (s *paymentCommandService) RefundPayment(
 		return nil, stackErr.Error(err)
 	}
 
+	refunded := refund.Result.Amount
+	if refunded == 0 {
+		refunded = amount
+	}
+	if req.IdempotencyKey != "" {
+		if err := s.recordRefundResult(ctx, req, refunded); err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+
 	return &out.RefundPaymentResponse{
 		Provider:      paymentAggregate.Provider(),
 		TransactionID: paymentAggregate.TransactionID(),
 		ExternalRef:   paymentAggregate.ExternalRef(),
+		Amount:        refunded,
 		Status:        paymentAggregate.Status(),
 		Duplicate:     outcome.Duplicate,
 		Events:        outcome.Events,
 	}, nil
 }
 
+// reserveRefundKey binds the caller's idempotency key to the payment being
+// refunded and returns the refund an earlier request with the key completed,
+// or nil when there is none. A key whose refund did not complete runs again;
+// the provider sees the same key, so it does not refund twice. Reusing a key
+// on another payment or for another amount is rejected.
+func (s *paymentCommandService) reserveRefundKey(
+	ctx context.Context,
+	req *in.RefundPaymentRequest,
+	paymentAggregate *paymentaggregate.PaymentIntentAggregate,
+) (*out.RefundPaymentResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(ErrPaymentUnauthorized)
+	}
+
+	keys := s.baseRepo.PaymentIdempotencyKeyRepository()
+	key, err := keys.Get(ctx, accountID, entity.PaymentIdempotencyScopeRefund, req.IdempotencyKey)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if key == nil {
+		err = keys.Create(ctx, &entity.PaymentIdempotencyKey{
+			AccountID:     accountID,
+			Scope:         entity.PaymentIdempotencyScopeRefund,
+			Key:           req.IdempotencyKey,
+			TransactionID: req.TransactionID,
+			CreatedAt:     time.Now().UTC(),
+		})
+		if !errors.Is(err, repos.ErrPaymentIdempotencyKeyExists) {
+			return nil, stackErr.Error(err)
+		}
+		if key, err = keys.Get(ctx, accountID, entity.PaymentIdempotencyScopeRefund, req.IdempotencyKey); err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+	if key == nil {
+		return nil, nil
+	}
+	if key.TransactionID != req.TransactionID ||
+		(key.ResultAmount != 0 && req.Amount != 0 && key.ResultAmount != req.Amount) {
+		return nil, stackErr.Error(ErrIdempotencyKeyUsed)
+	}
+	if key.ResultAmount == 0 {
+		return nil, nil
+	}
+
+	return &out.RefundPaymentResponse{
+		Provider:      paymentAggregate.Provider(),
+		TransactionID: paymentAggregate.TransactionID(),
+		ExternalRef:   paymentAggregate.ExternalRef(),
+		Amount:        key.ResultAmount,
+		Status:        paymentAggregate.Status(),
+		Duplicate:     true,
+	}, nil
+}
+
+// recordRefundResult stores the refunded amount against the caller's key so
+// a retry is answered with it instead of refunding again.
+func (s *paymentCommandService) recordRefundResult(ctx context.Context, req *in.RefundPaymentRequest, amount int64) error {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return stackErr.Error(ErrPaymentUnauthorized)
+	}
+	return stackErr.Error(s.baseRepo.PaymentIdempotencyKeyRepository().RecordResult(ctx, accountID, entity.PaymentIdempotencyScopeRefund, req.IdempotencyKey, amount))
+}
+
 func (s *paymentCommandService) processPendingWithdrawal(ctx context.Context, transactionID string) error {
 	log := logging.FromContext(ctx).Named("ProcessPendingWithdrawal")
 	lockKey := fmt.Sprintf("payment:%s", strings.TrimSpace(transactionID))
@@ -423,6 +553,7 @@ func (s *paymentCommandService) applyProviderOutcome(
 	checkoutURL string,
 	emitCheckoutEvent bool,
 ) (paymentProviderOutcome, error) {
+	previousStatus := paymentAggregate.Status()
 	mutation, err := paymentAggregate.ApplyProviderOutcome(result, checkoutURL, emitCheckoutEvent, time.Now().UTC())
 	if err != nil {
 		return paymentProviderOutcome{}, stackErr.Error(err)
@@ -450,6 +581,9 @@ func (s *paymentCommandService) applyProviderOutcome(
 		}
 		return paymentProviderOutcome{}, stackErr.Error(persistErr)
 	}
+	if previousStatus != paymentAggregate.Status() {
+		s.releaseLimitIfClosed(ctx, paymentAggregate)
+	}
 
 	return paymentProviderOutcome{
 		Duplicate: mutation.Duplicate,
@@ -457,6 +591,115 @@ func (s *paymentCommandService) applyProviderOutcome(
 	}, nil
 }
 
+// applyDisputeUpdate opens or advances the dispute behind a provider
+// webhook. A lost dispute charges the payment back in the same transaction,
+// with the chargeback pointing at the dispute so the ledger settles it from
+// the dispute hold.
+func (s *paymentCommandService) applyDisputeUpdate(
+	ctx context.Context,
+	paymentAggregate *paymentaggregate.PaymentIntentAggregate,
+	update entity.PaymentDisputeUpdate,
+) (*out.ProcessWebhookResponse, error) {
+	if strings.TrimSpace(update.ProviderDisputeID) == "" {
+		return nil, stackErr.Error(fmt.Errorf("%w: provider dispute id is required", ErrValidation))
+	}
+
+	now := time.Now().UTC()
+	changed := true
+	disputeAggregate, err := s.baseRepo.PaymentDisputeRepository().GetByProviderDisputeID(ctx, paymentAggregate.Provider(), update.ProviderDisputeID)
+	switch {
+	case errors.Is(err, repos.ErrPaymentDisputeNotFound):
+		disputeAggregate, err = paymentaggregate.NewPaymentDisputeAggregate(uuid.NewString(), paymentAggregate.Snapshot(), update, now)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+	case err != nil:
+		return nil, stackErr.Error(err)
+	default:
+		if changed, err = disputeAggregate.ApplyProviderUpdate(update, now); err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+	}
+
+	response := &out.ProcessWebhookResponse{
+		Provider:      paymentAggregate.Provider(),
+		TransactionID: paymentAggregate.TransactionID(),
+		ExternalRef:   paymentAggregate.ExternalRef(),
+		Status:        paymentAggregate.Status(),
+		Duplicate:     !changed,
+	}
+	if !changed {
+		return response, nil
+	}
+
+	var chargeback paymentaggregate.PaymentIntentMutation
+	if disputeAggregate.Status() == entity.PaymentDisputeStatusLost {
+		dispute := disputeAggregate.Snapshot()
+		chargeback, err = paymentAggregate.ApplyProviderOutcome(entity.PaymentProviderResult{
+			TransactionID: paymentAggregate.TransactionID(),
+			EventID:       update.EventID,
+			EventType:     update.EventType,
+			Status:        entity.PaymentStatusChargeback,
+			Amount:        dispute.Amount,
+			Currency:      dispute.Currency,
+			DisputeID:     dispute.ID,
+		}, "", false, now)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+	}
+
+	pending := disputeAggregate.PendingOutboxEvents()
+	if chargeback.Persist {
+		pending = append(pending, paymentAggregate.PendingOutboxEvents()...)
+	}
+	events, err := paymentIntegrationEventsFromDomainEvents(pending)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if err := s.baseRepo.WithTransaction(ctx, func(tx repos.Repos) error {
+		if err := tx.PaymentDisputeRepository().Save(ctx, disputeAggregate); err != nil {
+			return stackErr.Error(err)
+		}
+		if !chargeback.Persist {
+			return nil
+		}
+		return stackErr.Error(tx.PaymentIntentAggregateRepository().Save(ctx, paymentAggregate))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	response.Status = paymentAggregate.Status()
+	response.Events = events
+	return response, nil
+}
+
+// lockPayment serialises work on one payment across instances. The returned
+// func releases the lock.
+func (s *paymentCommandService) lockPayment(ctx context.Context, transactionID string) (func(), error) {
+	log := logging.FromContext(ctx).Named("PaymentLock")
+	lockKey := fmt.Sprintf("payment:%s", transactionID)
+	lockValue := uuid.NewString()
+	locked, err := s.locker.AcquireLock(ctx, lockKey, lockValue, 30*time.Second, 100*time.Millisecond, 3*time.Second)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !locked {
+		return nil, stackErr.Error(fmt.Errorf("acquire payment lock failed: transaction_id=%s", transactionID))
+	}
+	return func() {
+		released, err := s.locker.ReleaseLock(ctx, lockKey, lockValue)
+		if err != nil {
+			log.Errorw("failed to release payment lock", zap.String("transaction_id", transactionID), zap.String("lock_key", lockKey), zap.Error(err))
+			return
+		}
+		if !released {
+			log.Warnw("payment lock was not released", zap.String("transaction_id", transactionID), zap.String("lock_key", lockKey))
+		}
+	}, nil
+}
+
 func paymentIntegrationEventsFromDomainEvents(events []eventpkg.Event) ([]out.PaymentIntegrationEvent, error) {
 	if len(events) == 0 {
 		return nil, nil
@@ -525,11 +768,59 @@ func (s *paymentCommandService) markCreateFailed(ctx context.Context, paymentAgg
 	}); err != nil {
 		return stackErr.Error(err)
 	}
+	s.releaseLimitIfClosed(ctx, paymentAggregate)
 	return nil
 }
 
-func (s *paymentCommandService) computeStripeFee(amount int64) (int64, error) {
-	return s.feePolicy.Compute(amount)
+// reserveLimit counts a new intent against its account's wallet limits
+// before it is stored, so a rejected intent never reaches the provider.
+func (s *paymentCommandService) reserveLimit(ctx context.Context, intent *entity.PaymentIntent) error {
+	if s.limits == nil {
+		return nil
+	}
+	return stackErr.Error(s.limits.Reserve(ctx, intent))
+}
+
+// releaseLimit gives back the reservation of an intent that was not stored
+// or did not complete.
+// A failed release only over-counts the account until the period rolls over,
+// so it is logged rather than returned.
+func (s *paymentCommandService) releaseLimit(ctx context.Context, intent *entity.PaymentIntent) {
+	if s.limits == nil {
+		return
+	}
+	if err := s.limits.Release(ctx, intent); err != nil {
+		logging.FromContext(ctx).Named("ReleaseLimit").Errorw("failed to release wallet limit reservation", "transaction_id", intent.TransactionID, zap.Error(err))
+	}
+}
+
+// releaseLimitIfClosed gives back the reservation of an intent that has just
+// been stored as failed or cancelled, since no money moved for it. Releasing
+// is idempotent, so an intent closed twice is not under-counted.
+func (s *paymentCommandService) releaseLimitIfClosed(ctx context.Context, paymentAggregate *paymentaggregate.PaymentIntentAggregate) {
+	switch paymentAggregate.Status() {
+	case entity.PaymentStatusFailed, entity.PaymentStatusCancelled:
+		s.releaseLimit(ctx, paymentAggregate.Snapshot())
+	}
+}
+
+// quoteFee prices a new intent and, when the caller was shown a quote, makes
+// sure the intent is not created with a different fee.
+func (s *paymentCommandService) quoteFee(quoted *int64, quote func() (int64, error)) (int64, error) {
+	if s.fees == nil {
+		return 0, stackErr.Error(finance.CheckQuotedFee(quoted, 0))
+	}
+	feeAmount, err := quote()
+	if errors.Is(err, domainservice.ErrPaymentFeeInvalid) {
+		return 0, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	if err := finance.CheckQuotedFee(quoted, feeAmount); err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return feeAmount, nil
 }
 
 func (s *paymentCommandService) effectiveWithdrawalBatchSize() int {
@@ -569,6 +860,20 @@ func isPaymentValidationError(err error) bool {
 		errors.Is(err, paymentaggregate.ErrPaymentIntentOccurredAtRequired)
 }
 
+func toCreateWithdrawalResponse(snapshot *entity.PaymentIntent, duplicate bool) *out.CreateWithdrawalResponse {
+	return &out.CreateWithdrawalResponse{
+		Provider:       snapshot.Provider,
+		Workflow:       snapshot.Workflow,
+		TransactionID:  snapshot.TransactionID,
+		ExternalRef:    snapshot.ExternalRef,
+		Amount:         snapshot.Amount,
+		FeeAmount:      snapshot.FeeAmount,
+		ProviderAmount: snapshot.ProviderAmount,
+		Status:         snapshot.Status,
+		Duplicate:      duplicate,
+	}
+}
+
 func cloneMetadata(metadata map[string]string) map[string]string {
 	if len(metadata) == 0 {
 		return map[string]string{}
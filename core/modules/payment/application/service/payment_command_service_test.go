@@ -12,7 +12,9 @@ import (
 	repos "wechat-clone/core/modules/payment/domain/repos"
 	domainservice "wechat-clone/core/modules/payment/domain/service"
 	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/finance"
 	sharedlock "wechat-clone/core/shared/infra/lock"
+	"wechat-clone/core/shared/pkg/actorctx"
 
 	"go.uber.org/mock/gomock"
 )
@@ -175,6 +177,153 @@ func TestApplyProviderOutcomeFinalizesSuccessOnlyOncePerPayment(t *testing.T) {
 	}
 }
 
+func TestCreateWithdrawalRejectedByLimitsIsNotStored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	keys := repos.NewMockPaymentIdempotencyKeyRepo(ctrl)
+	limits := domainservice.NewMockPaymentLimitPolicy(ctrl)
+	baseRepo.EXPECT().PaymentIdempotencyKeyRepository().Return(keys)
+	keys.EXPECT().Get(gomock.Any(), "acc-1", entity.PaymentIdempotencyScopeWithdrawal, "key-1").Return(nil, nil)
+	limits.EXPECT().
+		Reserve(gomock.Any(), gomock.AssignableToTypeOf(&entity.PaymentIntent{})).
+		DoAndReturn(func(_ context.Context, intent *entity.PaymentIntent) error {
+			if intent.DebitAccountID != "acc-1" || intent.Amount != 5000 {
+				t.Fatalf("unexpected reserved intent %+v", intent)
+			}
+			return domainservice.ErrPaymentLimitDailyExceeded
+		})
+
+	svc := &paymentCommandService{baseRepo: baseRepo, limits: limits}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	_, err := svc.CreateWithdrawal(ctx, &in.CreateWithdrawalRequest{
+		Provider:       "stripe",
+		Amount:         5000,
+		Currency:       "VND",
+		Metadata:       map[string]string{"destination_account": "acct_1"},
+		IdempotencyKey: "key-1",
+	})
+	if !errors.Is(err, domainservice.ErrPaymentLimitDailyExceeded) {
+		t.Fatalf("expected daily limit error, got %v", err)
+	}
+}
+
+func TestCreateWithdrawalRequiresIdempotencyKey(t *testing.T) {
+	svc := &paymentCommandService{}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	_, err := svc.CreateWithdrawal(ctx, &in.CreateWithdrawalRequest{
+		Provider: "stripe",
+		Amount:   5000,
+		Currency: "VND",
+		Metadata: map[string]string{"destination_account": "acct_1"},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestCreateWithdrawalReplaysBeforeQuotingFee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+	keys := repos.NewMockPaymentIdempotencyKeyRepo(ctrl)
+	fees := domainservice.NewMockPaymentFeePolicy(ctrl)
+
+	withdrawal, err := paymentaggregate.NewProviderWithdrawalAggregate(
+		"txn-1", "stripe", 5000, 50, "VND", "acct_1", "acc-1",
+		map[string]string{"destination_account": "acct_1"}, time.Now().UTC(),
+	)
+	if err != nil {
+		t.Fatalf("new withdrawal aggregate: %v", err)
+	}
+	baseRepo.EXPECT().PaymentIdempotencyKeyRepository().Return(keys)
+	baseRepo.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo)
+	keys.EXPECT().Get(gomock.Any(), "acc-1", entity.PaymentIdempotencyScopeWithdrawal, "key-1").Return(&entity.PaymentIdempotencyKey{
+		AccountID:     "acc-1",
+		Scope:         entity.PaymentIdempotencyScopeWithdrawal,
+		Key:           "key-1",
+		TransactionID: "txn-1",
+	}, nil)
+	aggregateRepo.EXPECT().GetByTransactionID(gomock.Any(), "txn-1").Return(withdrawal, nil)
+	// The fee schedule changed since the quote; the retry must not see it.
+	fees.EXPECT().QuoteWithdrawalFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	quoted := int64(50)
+	svc := &paymentCommandService{baseRepo: baseRepo, fees: fees}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	response, err := svc.CreateWithdrawal(ctx, &in.CreateWithdrawalRequest{
+		Provider:        "stripe",
+		Amount:          5000,
+		Currency:        "VND",
+		QuotedFeeAmount: &quoted,
+		Metadata:        map[string]string{"destination_account": "acct_1"},
+		IdempotencyKey:  "key-1",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !response.Duplicate || response.TransactionID != "txn-1" {
+		t.Fatalf("expected replayed withdrawal txn-1, got %+v", response)
+	}
+}
+
+func TestCreatePaymentReleasesLimitWhenIntentIsNotStored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	providerRegistry := domainservice.NewMockPaymentProviderRegistry(ctrl)
+	limits := domainservice.NewMockPaymentLimitPolicy(ctrl)
+	storeErr := errors.New("database unavailable")
+
+	providerRegistry.EXPECT().Get("stripe").Return(domainservice.NewMockPaymentProvider(ctrl), nil)
+	baseRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).Return(storeErr)
+	var reserved *entity.PaymentIntent
+	limits.EXPECT().
+		Reserve(gomock.Any(), gomock.AssignableToTypeOf(&entity.PaymentIntent{})).
+		DoAndReturn(func(_ context.Context, intent *entity.PaymentIntent) error {
+			reserved = intent
+			return nil
+		})
+	limits.EXPECT().
+		Release(gomock.Any(), gomock.AssignableToTypeOf(&entity.PaymentIntent{})).
+		DoAndReturn(func(_ context.Context, intent *entity.PaymentIntent) error {
+			if intent.TransactionID != reserved.TransactionID {
+				t.Fatalf("expected release of %s, got %s", reserved.TransactionID, intent.TransactionID)
+			}
+			return nil
+		})
+
+	svc := &paymentCommandService{baseRepo: baseRepo, providerRegistry: providerRegistry, limits: limits}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	_, err := svc.CreatePayment(ctx, &in.CreatePaymentRequest{
+		Provider: "stripe",
+		Amount:   5000,
+		Currency: "VND",
+	})
+	if !errors.Is(err, storeErr) {
+		t.Fatalf("expected store error, got %v", err)
+	}
+}
+
+func TestCreatePaymentRejectsChangedFeeQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	fees := domainservice.NewMockPaymentFeePolicy(ctrl)
+	limits := domainservice.NewMockPaymentLimitPolicy(ctrl)
+	fees.EXPECT().QuoteTopUpFee(gomock.Any(), "stripe", "acc-1", "USD", int64(1000)).Return(int64(59), nil)
+	limits.EXPECT().Reserve(gomock.Any(), gomock.Any()).Times(0)
+
+	quoted := int64(0)
+	svc := &paymentCommandService{limits: limits, fees: fees}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	_, err := svc.CreatePayment(ctx, &in.CreatePaymentRequest{
+		Provider:        "stripe",
+		Amount:          1000,
+		Currency:        "USD",
+		QuotedFeeAmount: &quoted,
+	})
+	if !errors.Is(err, finance.ErrFeeQuoteChanged) {
+		t.Fatalf("expected fee quote changed error, got %v", err)
+	}
+}
+
 func TestApplyProviderOutcomeIgnoresFailAfterSuccessWithoutPersist(t *testing.T) {
 	paymentAggregate := mustRehydratePaymentAggregate(t, "txn-1", "stripe", 100, "VND", "wallet:available")
 	_, err := paymentAggregate.ApplyProviderOutcome(entity.PaymentProviderResult{
@@ -273,6 +422,93 @@ func TestApplyProviderOutcomeFinalizesRefundAsReversal(t *testing.T) {
 	}
 }
 
+func TestApplyProviderOutcomeReleasesLimitWhenPaymentFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	txRepos := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+	limits := domainservice.NewMockPaymentLimitPolicy(ctrl)
+
+	paymentAggregate := mustRehydratePaymentAggregate(t, "txn-1", "stripe", 100, "VND", "wallet:available")
+	baseRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(repos.Repos) error) error {
+		return fn(txRepos)
+	})
+	txRepos.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo).AnyTimes()
+	aggregateRepo.EXPECT().Save(gomock.Any(), paymentAggregate).Return(nil)
+	limits.EXPECT().
+		Release(gomock.Any(), gomock.AssignableToTypeOf(&entity.PaymentIntent{})).
+		DoAndReturn(func(_ context.Context, intent *entity.PaymentIntent) error {
+			if intent.TransactionID != "txn-1" {
+				t.Fatalf("expected release of txn-1, got %s", intent.TransactionID)
+			}
+			return nil
+		})
+
+	svc := &paymentCommandService{baseRepo: baseRepo, limits: limits}
+	_, err := svc.applyProviderOutcome(context.Background(), paymentAggregate, entity.PaymentProviderResult{
+		TransactionID: "txn-1",
+		EventID:       "evt-payment-failed",
+		EventType:     "payment_intent.payment_failed",
+		Status:        entity.PaymentStatusFailed,
+		Amount:        100,
+		Currency:      "VND",
+		ExternalRef:   "cs-1",
+	}, "", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if paymentAggregate.Status() != entity.PaymentStatusFailed {
+		t.Fatalf("expected failed status, got %s", paymentAggregate.Status())
+	}
+}
+
+func TestRefundPaymentReplaysRefundRecordedForKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+	keys := repos.NewMockPaymentIdempotencyKeyRepo(ctrl)
+	locker := sharedlock.NewMockLock(ctrl)
+
+	paymentAggregate := mustRehydratePaymentAggregate(t, "txn-1", "stripe", 100, "VND", "wallet:available")
+	if _, err := paymentAggregate.ApplyProviderOutcome(entity.PaymentProviderResult{
+		Status:   entity.PaymentStatusSuccess,
+		Amount:   100,
+		Currency: "VND",
+	}, "", false, time.Now().UTC()); err != nil {
+		t.Fatalf("apply initial success: %v", err)
+	}
+	paymentAggregate.MarkPersisted()
+
+	locker.EXPECT().AcquireLock(gomock.Any(), "payment:txn-1", gomock.Any(), 30*time.Second, 100*time.Millisecond, 3*time.Second).Return(true, nil)
+	locker.EXPECT().ReleaseLock(gomock.Any(), "payment:txn-1", gomock.Any()).Return(true, nil)
+	baseRepo.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo).AnyTimes()
+	baseRepo.EXPECT().PaymentIdempotencyKeyRepository().Return(keys).AnyTimes()
+	aggregateRepo.EXPECT().GetByTransactionID(gomock.Any(), "txn-1").Return(paymentAggregate, nil)
+	keys.EXPECT().Get(gomock.Any(), "admin-1", entity.PaymentIdempotencyScopeRefund, "key-1").Return(&entity.PaymentIdempotencyKey{
+		AccountID:     "admin-1",
+		Scope:         entity.PaymentIdempotencyScopeRefund,
+		Key:           "key-1",
+		TransactionID: "txn-1",
+		ResultAmount:  40,
+	}, nil).Times(2)
+
+	// No provider registry: a replay must not reach the provider.
+	svc := &paymentCommandService{baseRepo: baseRepo, locker: locker}
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "admin-1"})
+	response, err := svc.RefundPayment(ctx, &in.RefundPaymentRequest{TransactionID: "txn-1", Amount: 40, IdempotencyKey: "key-1"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !response.Duplicate || response.Amount != 40 || response.Status != entity.PaymentStatusSuccess {
+		t.Fatalf("expected replayed partial refund of 40, got %+v", response)
+	}
+
+	_, err = svc.reserveRefundKey(ctx, &in.RefundPaymentRequest{TransactionID: "txn-1", Amount: 50, IdempotencyKey: "key-1"}, paymentAggregate)
+	if !errors.Is(err, ErrIdempotencyKeyUsed) {
+		t.Fatalf("expected key reused for another amount to be rejected, got %v", err)
+	}
+}
+
 func mustRehydratePaymentAggregate(
 	t *testing.T,
 	transactionID string,
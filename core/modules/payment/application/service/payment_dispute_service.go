@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/infra/storage"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxDisputeEvidenceSize      = 5 << 20
+	disputeEvidenceObjectPrefix = "payment/disputes"
+)
+
+//go:generate mockgen -package=service -destination=payment_dispute_service_mock.go -source=payment_dispute_service.go
+type PaymentDisputeService interface {
+	ListDisputes(ctx context.Context, req *in.ListDisputesRequest) (*out.ListDisputesResponse, error)
+	GetDispute(ctx context.Context, req *in.GetDisputeRequest) (*out.DisputeResponse, error)
+	// UploadDisputeEvidence stores one evidence file for a dispute. Files are
+	// kept until SubmitDisputeEvidence sends them to the provider together.
+	UploadDisputeEvidence(ctx context.Context, req *in.UploadDisputeEvidenceRequest) (*out.DisputeEvidenceResponse, error)
+	SubmitDisputeEvidence(ctx context.Context, req *in.SubmitDisputeEvidenceRequest) (*out.DisputeResponse, error)
+}
+
+type paymentDisputeService struct {
+	baseRepo         repos.Repos
+	providerRegistry domainservice.PaymentProviderRegistry
+	storage          storage.Storage
+	now              func() time.Time
+}
+
+func NewPaymentDisputeService(
+	baseRepo repos.Repos,
+	providerRegistry domainservice.PaymentProviderRegistry,
+	objectStorage storage.Storage,
+) PaymentDisputeService {
+	return &paymentDisputeService{
+		baseRepo:         baseRepo,
+		providerRegistry: providerRegistry,
+		storage:          objectStorage,
+		now:              time.Now,
+	}
+}
+
+func (s *paymentDisputeService) ListDisputes(ctx context.Context, req *in.ListDisputesRequest) (*out.ListDisputesResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPaymentIntentPageSize
+	}
+	if limit > maxPaymentIntentPageSize {
+		limit = maxPaymentIntentPageSize
+	}
+
+	filter := repos.PaymentDisputeFilter{
+		Provider: strings.ToLower(req.Provider),
+		Limit:    limit + 1,
+	}
+	if req.Status != "" {
+		status, err := entity.NormalizePaymentDisputeStatus(req.Status)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+		filter.Status = status
+	}
+	if req.Cursor != "" {
+		openedAt, id, err := utils.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: invalid cursor", ErrValidation))
+		}
+		filter.CursorOpened = &openedAt
+		filter.CursorID = id
+	}
+
+	items, err := s.baseRepo.PaymentDisputeRepository().List(ctx, filter)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	records := make([]out.DisputeResponse, 0, len(items))
+	nextCursor := ""
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		records = append(records, *toDisputeResponse(item, nil))
+		if hasMore {
+			nextCursor = utils.EncodeCursor(item.OpenedAt.UTC().Format(time.RFC3339Nano), item.ID)
+		}
+	}
+
+	return &out.ListDisputesResponse{
+		Records:    records,
+		Limit:      limit,
+		Size:       len(records),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *paymentDisputeService) GetDispute(ctx context.Context, req *in.GetDisputeRequest) (*out.DisputeResponse, error) {
+	disputeAggregate, err := s.getDispute(ctx, req.DisputeID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	evidence, err := s.baseRepo.PaymentDisputeRepository().ListEvidence(ctx, disputeAggregate.ID())
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return toDisputeResponse(disputeAggregate.Snapshot(), evidence), nil
+}
+
+func (s *paymentDisputeService) UploadDisputeEvidence(ctx context.Context, req *in.UploadDisputeEvidenceRequest) (*out.DisputeEvidenceResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(ErrPaymentUnauthorized)
+	}
+
+	kind, err := entity.NormalizePaymentDisputeEvidenceKind(req.Kind)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+	}
+	fileName := path.Base(strings.ReplaceAll(req.FileName, "\\", "/"))
+	content, err := base64.StdEncoding.DecodeString(req.ContentBase64)
+	if err != nil || len(content) == 0 || fileName == "." || fileName == "/" {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, entity.ErrPaymentDisputeEvidenceFileInvalid))
+	}
+	if len(content) > maxDisputeEvidenceSize {
+		return nil, stackErr.Error(fmt.Errorf("%w: evidence file exceeds %d bytes", ErrValidation, maxDisputeEvidenceSize))
+	}
+
+	disputeAggregate, err := s.getDispute(ctx, req.DisputeID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	now := s.now().UTC()
+	if err := disputeAggregate.Snapshot().AcceptsEvidence(now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	evidence := &entity.PaymentDisputeEvidence{
+		ID:          uuid.NewString(),
+		DisputeID:   disputeAggregate.ID(),
+		Kind:        kind,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		UploadedBy:  accountID,
+		CreatedAt:   now,
+	}
+	evidence.ObjectKey = path.Join(disputeEvidenceObjectPrefix, evidence.DisputeID, evidence.ID+"-"+fileName)
+
+	if err := s.storage.PutObject(ctx, evidence.ObjectKey, evidence.ContentType, content); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := s.baseRepo.PaymentDisputeRepository().CreateEvidence(ctx, evidence); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return toDisputeEvidenceResponse(evidence), nil
+}
+
+// SubmitDisputeEvidence sends every evidence file not yet submitted to the
+// provider in one call; providers only take a single response per dispute.
+func (s *paymentDisputeService) SubmitDisputeEvidence(ctx context.Context, req *in.SubmitDisputeEvidenceRequest) (*out.DisputeResponse, error) {
+	disputeAggregate, err := s.getDispute(ctx, req.DisputeID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	dispute := disputeAggregate.Snapshot()
+	now := s.now().UTC()
+	if err := dispute.AcceptsEvidence(now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	evidence, err := s.baseRepo.PaymentDisputeRepository().ListEvidence(ctx, dispute.ID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	files := make([]domainservice.PaymentDisputeEvidenceFile, 0, len(evidence))
+	evidenceIDs := make([]string, 0, len(evidence))
+	for _, item := range evidence {
+		if item == nil || item.SubmittedAt != nil {
+			continue
+		}
+		content, err := s.storage.GetObject(ctx, item.ObjectKey)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		files = append(files, domainservice.PaymentDisputeEvidenceFile{
+			Kind:        item.Kind,
+			FileName:    item.FileName,
+			ContentType: item.ContentType,
+			Content:     content,
+		})
+		evidenceIDs = append(evidenceIDs, item.ID)
+	}
+	if len(files) == 0 {
+		return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, entity.ErrPaymentDisputeEvidenceRequired))
+	}
+
+	submitter, err := s.providerRegistry.DisputeEvidenceSubmitter(dispute.Provider)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := submitter.SubmitDisputeEvidence(ctx, dispute, files, req.Note); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if err := disputeAggregate.MarkEvidenceSubmitted(evidenceIDs, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := s.baseRepo.WithTransaction(ctx, func(tx repos.Repos) error {
+		if err := tx.PaymentDisputeRepository().Save(ctx, disputeAggregate); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(tx.PaymentDisputeRepository().MarkEvidenceSubmitted(ctx, evidenceIDs, now))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	for _, item := range evidence {
+		if item != nil && item.SubmittedAt == nil {
+			item.SubmittedAt = &now
+		}
+	}
+	return toDisputeResponse(disputeAggregate.Snapshot(), evidence), nil
+}
+
+func (s *paymentDisputeService) getDispute(ctx context.Context, disputeID string) (*paymentaggregate.PaymentDisputeAggregate, error) {
+	disputeAggregate, err := s.baseRepo.PaymentDisputeRepository().GetByID(ctx, disputeID)
+	if err != nil {
+		if errors.Is(err, repos.ErrPaymentDisputeNotFound) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrDisputeNotFound, disputeID))
+		}
+		return nil, stackErr.Error(err)
+	}
+	return disputeAggregate, nil
+}
+
+func toDisputeResponse(dispute *entity.PaymentDispute, evidence []*entity.PaymentDisputeEvidence) *out.DisputeResponse {
+	response := &out.DisputeResponse{
+		ID:                dispute.ID,
+		Provider:          dispute.Provider,
+		ProviderDisputeID: dispute.ProviderDisputeID,
+		TransactionID:     dispute.TransactionID,
+		AccountID:         dispute.AccountID,
+		Amount:            dispute.Amount,
+		Currency:          dispute.Currency,
+		Reason:            dispute.Reason,
+		Status:            dispute.Status,
+		OpenedAt:          dispute.OpenedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:         dispute.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if dispute.EvidenceDueBy != nil {
+		response.EvidenceDueBy = dispute.EvidenceDueBy.UTC().Format(time.RFC3339Nano)
+	}
+	if dispute.EvidenceSubmittedAt != nil {
+		response.EvidenceSubmittedAt = dispute.EvidenceSubmittedAt.UTC().Format(time.RFC3339Nano)
+	}
+	if dispute.ClosedAt != nil {
+		response.ClosedAt = dispute.ClosedAt.UTC().Format(time.RFC3339Nano)
+	}
+	for _, item := range evidence {
+		if item == nil {
+			continue
+		}
+		response.Evidence = append(response.Evidence, *toDisputeEvidenceResponse(item))
+	}
+	return response
+}
+
+func toDisputeEvidenceResponse(evidence *entity.PaymentDisputeEvidence) *out.DisputeEvidenceResponse {
+	response := &out.DisputeEvidenceResponse{
+		ID:          evidence.ID,
+		Kind:        evidence.Kind,
+		FileName:    evidence.FileName,
+		ContentType: evidence.ContentType,
+		Size:        evidence.Size,
+		UploadedBy:  evidence.UploadedBy,
+		CreatedAt:   evidence.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if evidence.SubmittedAt != nil {
+		response.SubmittedAt = evidence.SubmittedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return response
+}
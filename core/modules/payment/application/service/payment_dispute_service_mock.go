@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_dispute_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_dispute_service_mock.go -source=payment_dispute_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	in "wechat-clone/core/modules/payment/application/dto/in"
+	out "wechat-clone/core/modules/payment/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentDisputeService is a mock of PaymentDisputeService interface.
+type MockPaymentDisputeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentDisputeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentDisputeServiceMockRecorder is the mock recorder for MockPaymentDisputeService.
+type MockPaymentDisputeServiceMockRecorder struct {
+	mock *MockPaymentDisputeService
+}
+
+// NewMockPaymentDisputeService creates a new mock instance.
+func NewMockPaymentDisputeService(ctrl *gomock.Controller) *MockPaymentDisputeService {
+	mock := &MockPaymentDisputeService{ctrl: ctrl}
+	mock.recorder = &MockPaymentDisputeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentDisputeService) EXPECT() *MockPaymentDisputeServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDispute mocks base method.
+func (m *MockPaymentDisputeService) GetDispute(ctx context.Context, req *in.GetDisputeRequest) (*out.DisputeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispute", ctx, req)
+	ret0, _ := ret[0].(*out.DisputeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispute indicates an expected call of GetDispute.
+func (mr *MockPaymentDisputeServiceMockRecorder) GetDispute(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispute", reflect.TypeOf((*MockPaymentDisputeService)(nil).GetDispute), ctx, req)
+}
+
+// ListDisputes mocks base method.
+func (m *MockPaymentDisputeService) ListDisputes(ctx context.Context, req *in.ListDisputesRequest) (*out.ListDisputesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputes", ctx, req)
+	ret0, _ := ret[0].(*out.ListDisputesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputes indicates an expected call of ListDisputes.
+func (mr *MockPaymentDisputeServiceMockRecorder) ListDisputes(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputes", reflect.TypeOf((*MockPaymentDisputeService)(nil).ListDisputes), ctx, req)
+}
+
+// SubmitDisputeEvidence mocks base method.
+func (m *MockPaymentDisputeService) SubmitDisputeEvidence(ctx context.Context, req *in.SubmitDisputeEvidenceRequest) (*out.DisputeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDisputeEvidence", ctx, req)
+	ret0, _ := ret[0].(*out.DisputeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitDisputeEvidence indicates an expected call of SubmitDisputeEvidence.
+func (mr *MockPaymentDisputeServiceMockRecorder) SubmitDisputeEvidence(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDisputeEvidence", reflect.TypeOf((*MockPaymentDisputeService)(nil).SubmitDisputeEvidence), ctx, req)
+}
+
+// UploadDisputeEvidence mocks base method.
+func (m *MockPaymentDisputeService) UploadDisputeEvidence(ctx context.Context, req *in.UploadDisputeEvidenceRequest) (*out.DisputeEvidenceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadDisputeEvidence", ctx, req)
+	ret0, _ := ret[0].(*out.DisputeEvidenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadDisputeEvidence indicates an expected call of UploadDisputeEvidence.
+func (mr *MockPaymentDisputeServiceMockRecorder) UploadDisputeEvidence(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDisputeEvidence", reflect.TypeOf((*MockPaymentDisputeService)(nil).UploadDisputeEvidence), ctx, req)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/infra/storage"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubmitDisputeEvidenceSendsPendingFilesAndMarksSubmitted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	txRepos := repos.NewMockRepos(ctrl)
+	disputeRepo := repos.NewMockPaymentDisputeRepo(ctrl)
+	providerRegistry := domainservice.NewMockPaymentProviderRegistry(ctrl)
+	submitter := domainservice.NewMockPaymentDisputeEvidenceSubmitter(ctrl)
+	objectStorage := storage.NewMockStorage(ctrl)
+
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	submittedAt := now.Add(-time.Hour)
+	disputeAggregate := mustRestoreDispute(t, entity.PaymentDisputeStatusNeedsEvidence)
+
+	baseRepo.EXPECT().PaymentDisputeRepository().Return(disputeRepo).AnyTimes()
+	txRepos.EXPECT().PaymentDisputeRepository().Return(disputeRepo).AnyTimes()
+	disputeRepo.EXPECT().GetByID(gomock.Any(), "dp-1").Return(disputeAggregate, nil).Times(1)
+	disputeRepo.EXPECT().ListEvidence(gomock.Any(), "dp-1").Return([]*entity.PaymentDisputeEvidence{
+		{ID: "ev-1", DisputeID: "dp-1", Kind: entity.PaymentDisputeEvidenceReceipt, FileName: "old.pdf", ObjectKey: "k-1", SubmittedAt: &submittedAt},
+		{ID: "ev-2", DisputeID: "dp-1", Kind: entity.PaymentDisputeEvidenceReceipt, FileName: "receipt.pdf", ContentType: "application/pdf", ObjectKey: "k-2"},
+	}, nil).Times(1)
+	objectStorage.EXPECT().GetObject(gomock.Any(), "k-2").Return([]byte("pdf"), nil).Times(1)
+	providerRegistry.EXPECT().DisputeEvidenceSubmitter("stripe").Return(submitter, nil).Times(1)
+	submitter.EXPECT().SubmitDisputeEvidence(gomock.Any(), gomock.Any(), gomock.Any(), "see receipt").
+		DoAndReturn(func(_ context.Context, dispute *entity.PaymentDispute, files []domainservice.PaymentDisputeEvidenceFile, _ string) error {
+			if dispute.ProviderDisputeID != "du_1" {
+				t.Fatalf("unexpected dispute %+v", dispute)
+			}
+			if len(files) != 1 || files[0].FileName != "receipt.pdf" || string(files[0].Content) != "pdf" {
+				t.Fatalf("expected only the pending file, got %+v", files)
+			}
+			return nil
+		}).Times(1)
+	baseRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(repos.Repos) error) error {
+		return fn(txRepos)
+	}).Times(1)
+	disputeRepo.EXPECT().Save(gomock.Any(), disputeAggregate).Return(nil).Times(1)
+	disputeRepo.EXPECT().MarkEvidenceSubmitted(gomock.Any(), []string{"ev-2"}, now).Return(nil).Times(1)
+
+	svc := NewPaymentDisputeService(baseRepo, providerRegistry, objectStorage).(*paymentDisputeService)
+	svc.now = func() time.Time { return now }
+	res, err := svc.SubmitDisputeEvidence(context.Background(), &in.SubmitDisputeEvidenceRequest{DisputeID: "dp-1", Note: "see receipt"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Status != entity.PaymentDisputeStatusUnderReview || res.EvidenceSubmittedAt == "" {
+		t.Fatalf("unexpected response %+v", res)
+	}
+}
+
+func TestSubmitDisputeEvidenceRejectsClosedDispute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	disputeRepo := repos.NewMockPaymentDisputeRepo(ctrl)
+
+	baseRepo.EXPECT().PaymentDisputeRepository().Return(disputeRepo).AnyTimes()
+	disputeRepo.EXPECT().GetByID(gomock.Any(), "dp-1").Return(mustRestoreDispute(t, entity.PaymentDisputeStatusLost), nil).Times(1)
+	disputeRepo.EXPECT().ListEvidence(gomock.Any(), gomock.Any()).Times(0)
+
+	svc := NewPaymentDisputeService(baseRepo, nil, nil)
+	_, err := svc.SubmitDisputeEvidence(context.Background(), &in.SubmitDisputeEvidenceRequest{DisputeID: "dp-1"})
+	if !errors.Is(err, entity.ErrPaymentDisputeClosed) {
+		t.Fatalf("expected closed dispute error, got %v", err)
+	}
+}
+
+func mustRestoreDispute(t *testing.T, status string) *paymentaggregate.PaymentDisputeAggregate {
+	t.Helper()
+
+	disputeAggregate, err := paymentaggregate.RestorePaymentDisputeAggregate(&entity.PaymentDispute{
+		ID:                "dp-1",
+		Provider:          "stripe",
+		ProviderDisputeID: "du_1",
+		TransactionID:     "txn-1",
+		AccountID:         "acc-1",
+		Currency:          "VND",
+		Amount:            100,
+		Status:            status,
+		OpenedAt:          time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC),
+	}, 1)
+	if err != nil {
+		t.Fatalf("restore dispute aggregate: %v", err)
+	}
+	return disputeAggregate
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/modules/payment/domain/entity"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+)
+
+const (
+	defaultPaymentIntentPageSize = 20
+	maxPaymentIntentPageSize     = 100
+)
+
+//go:generate mockgen -package=service -destination=payment_query_service_mock.go -source=payment_query_service.go
+type PaymentQueryService interface {
+	// GetPaymentIntent returns one of the caller's intents with its status
+	// history. A non-empty workflow also requires the intent to be of that
+	// workflow; intents the caller does not own are reported as not found.
+	GetPaymentIntent(ctx context.Context, transactionID, workflow string) (*out.PaymentIntentResponse, error)
+	ListPaymentIntents(ctx context.Context, req *in.ListPaymentIntentsRequest) (*out.ListPaymentIntentsResponse, error)
+}
+
+type paymentQueryService struct {
+	baseRepo repos.Repos
+}
+
+func NewPaymentQueryService(baseRepo repos.Repos) PaymentQueryService {
+	return &paymentQueryService{baseRepo: baseRepo}
+}
+
+func (s *paymentQueryService) GetPaymentIntent(ctx context.Context, transactionID, workflow string) (*out.PaymentIntentResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(ErrPaymentUnauthorized)
+	}
+
+	transactionID = strings.TrimSpace(transactionID)
+	paymentAggregate, err := s.baseRepo.PaymentIntentAggregateRepository().GetByTransactionID(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, repos.ErrProviderPaymentNotFound) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrPaymentIntentNotFound, transactionID))
+		}
+		return nil, stackErr.Error(err)
+	}
+	intent := paymentAggregate.Snapshot()
+	if !intent.IsOwnedBy(accountID) || (workflow != "" && intent.Workflow != workflow) {
+		return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrPaymentIntentNotFound, transactionID))
+	}
+
+	history, err := s.baseRepo.PaymentIntentAggregateRepository().ListStatusHistory(ctx, intent.TransactionID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	response := toPaymentIntentResponse(intent)
+	response.History = make([]out.PaymentStatusChangeResponse, 0, len(history))
+	for _, change := range history {
+		response.History = append(response.History, out.PaymentStatusChangeResponse{
+			Event:      change.EventName,
+			Status:     change.Status,
+			Amount:     change.Amount,
+			OccurredAt: change.OccurredAt.UTC().Format(time.RFC3339Nano),
+		})
+	}
+	return response, nil
+}
+
+func (s *paymentQueryService) ListPaymentIntents(ctx context.Context, req *in.ListPaymentIntentsRequest) (*out.ListPaymentIntentsResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(ErrPaymentUnauthorized)
+	}
+
+	workflow := ""
+	if req.Workflow != "" {
+		if workflow = entity.NormalizePaymentWorkflow(req.Workflow); workflow == "" {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, entity.ErrPaymentWorkflowInvalid))
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPaymentIntentPageSize
+	}
+	if limit > maxPaymentIntentPageSize {
+		limit = maxPaymentIntentPageSize
+	}
+
+	filter := repos.PaymentIntentListFilter{
+		AccountID: accountID,
+		Workflow:  workflow,
+		Limit:     limit + 1,
+	}
+	if req.Cursor != "" {
+		createdAt, transactionID, err := utils.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: invalid cursor", ErrValidation))
+		}
+		filter.CursorCreatedAt = &createdAt
+		filter.CursorTransactionID = transactionID
+	}
+
+	items, err := s.baseRepo.PaymentIntentAggregateRepository().ListByAccount(ctx, filter)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	records := make([]out.PaymentIntentResponse, 0, len(items))
+	nextCursor := ""
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		intent := item.Snapshot()
+		records = append(records, *toPaymentIntentResponse(intent))
+		if hasMore {
+			nextCursor = utils.EncodeCursor(intent.CreatedAt.UTC().Format(time.RFC3339Nano), intent.TransactionID)
+		}
+	}
+
+	return &out.ListPaymentIntentsResponse{
+		Records:    records,
+		Limit:      limit,
+		Size:       len(records),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func toPaymentIntentResponse(intent *entity.PaymentIntent) *out.PaymentIntentResponse {
+	return &out.PaymentIntentResponse{
+		Provider:       intent.Provider,
+		Workflow:       intent.Workflow,
+		TransactionID:  intent.TransactionID,
+		ExternalRef:    intent.ExternalRef,
+		Amount:         intent.Amount,
+		FeeAmount:      intent.FeeAmount,
+		ProviderAmount: intent.ProviderAmount,
+		Currency:       intent.Currency,
+		Status:         intent.Status,
+		CreatedAt:      intent.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:      intent.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
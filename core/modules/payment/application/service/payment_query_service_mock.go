@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_query_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_query_service_mock.go -source=payment_query_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	in "wechat-clone/core/modules/payment/application/dto/in"
+	out "wechat-clone/core/modules/payment/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentQueryService is a mock of PaymentQueryService interface.
+type MockPaymentQueryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentQueryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentQueryServiceMockRecorder is the mock recorder for MockPaymentQueryService.
+type MockPaymentQueryServiceMockRecorder struct {
+	mock *MockPaymentQueryService
+}
+
+// NewMockPaymentQueryService creates a new mock instance.
+func NewMockPaymentQueryService(ctrl *gomock.Controller) *MockPaymentQueryService {
+	mock := &MockPaymentQueryService{ctrl: ctrl}
+	mock.recorder = &MockPaymentQueryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentQueryService) EXPECT() *MockPaymentQueryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPaymentIntent mocks base method.
+func (m *MockPaymentQueryService) GetPaymentIntent(ctx context.Context, transactionID, workflow string) (*out.PaymentIntentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentIntent", ctx, transactionID, workflow)
+	ret0, _ := ret[0].(*out.PaymentIntentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentIntent indicates an expected call of GetPaymentIntent.
+func (mr *MockPaymentQueryServiceMockRecorder) GetPaymentIntent(ctx, transactionID, workflow any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentIntent", reflect.TypeOf((*MockPaymentQueryService)(nil).GetPaymentIntent), ctx, transactionID, workflow)
+}
+
+// ListPaymentIntents mocks base method.
+func (m *MockPaymentQueryService) ListPaymentIntents(ctx context.Context, req *in.ListPaymentIntentsRequest) (*out.ListPaymentIntentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentIntents", ctx, req)
+	ret0, _ := ret[0].(*out.ListPaymentIntentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentIntents indicates an expected call of ListPaymentIntents.
+func (mr *MockPaymentQueryServiceMockRecorder) ListPaymentIntents(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentIntents", reflect.TypeOf((*MockPaymentQueryService)(nil).ListPaymentIntents), ctx, req)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	"wechat-clone/core/shared/pkg/actorctx"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPaymentIntentHidesIntentsOwnedByOthers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+
+	paymentAggregate := mustRehydratePaymentAggregate(t, "txn-1", "stripe", 100, "VND", "acc-owner")
+	baseRepo.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo).AnyTimes()
+	aggregateRepo.EXPECT().GetByTransactionID(gomock.Any(), "txn-1").Return(paymentAggregate, nil).Times(1)
+	aggregateRepo.EXPECT().ListStatusHistory(gomock.Any(), gomock.Any()).Times(0)
+
+	svc := NewPaymentQueryService(baseRepo)
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-other"})
+	_, err := svc.GetPaymentIntent(ctx, "txn-1", "")
+	if !errors.Is(err, ErrPaymentIntentNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestListPaymentIntentsReturnsCursorWhenMoreRecordsExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+
+	items := []*paymentaggregate.PaymentIntentAggregate{
+		mustRehydratePaymentAggregate(t, "txn-3", "stripe", 300, "VND", "acc-1"),
+		mustRehydratePaymentAggregate(t, "txn-2", "stripe", 200, "VND", "acc-1"),
+		mustRehydratePaymentAggregate(t, "txn-1", "stripe", 100, "VND", "acc-1"),
+	}
+	baseRepo.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo).AnyTimes()
+	aggregateRepo.EXPECT().ListByAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter repos.PaymentIntentListFilter) ([]*paymentaggregate.PaymentIntentAggregate, error) {
+		if filter.AccountID != "acc-1" {
+			t.Fatalf("expected filter by actor account, got %q", filter.AccountID)
+		}
+		if filter.Limit != 3 {
+			t.Fatalf("expected one extra row to detect more pages, got limit %d", filter.Limit)
+		}
+		return items, nil
+	}).Times(1)
+
+	svc := NewPaymentQueryService(baseRepo)
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "acc-1"})
+	res, err := svc.ListPaymentIntents(ctx, &in.ListPaymentIntentsRequest{Limit: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Size != 2 || len(res.Records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(res.Records))
+	}
+	if !res.HasMore || res.NextCursor == "" {
+		t.Fatalf("expected a next cursor when more records exist")
+	}
+	if res.Records[1].TransactionID != "txn-2" {
+		t.Fatalf("expected page to end at txn-2, got %s", res.Records[1].TransactionID)
+	}
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/modules/payment/domain/entity"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReconciliationLookback    = 24 * time.Hour
+	defaultReconciliationSettleDelay = 15 * time.Minute
+)
+
+//go:generate mockgen -package=service -destination=payment_reconciliation_service_mock.go -source=payment_reconciliation_service.go
+type PaymentReconciliationService interface {
+	// RunReconciliation compares every provider that exposes a settlement
+	// report with the payment intents and clearing account postings of the
+	// lookback window, and records the discrepancies it finds.
+	RunReconciliation(ctx context.Context) error
+	ListDiscrepancies(ctx context.Context, req *in.ListReconciliationDiscrepanciesRequest) (*out.ListReconciliationDiscrepanciesResponse, error)
+	ReviewDiscrepancy(ctx context.Context, req *in.ReviewReconciliationDiscrepancyRequest) (*out.ReconciliationDiscrepancyResponse, error)
+}
+
+type paymentReconciliationService struct {
+	baseRepo         repos.Repos
+	providerRegistry domainservice.PaymentProviderRegistry
+	clearingLedger   domainservice.PaymentClearingLedger
+	lookback         time.Duration
+	settleDelay      time.Duration
+	now              func() time.Time
+}
+
+// NewPaymentReconciliationService builds the reconciliation service. Intents
+// younger than settleDelay are left out of the run because their webhook or
+// ledger posting may still be in flight.
+func NewPaymentReconciliationService(
+	baseRepo repos.Repos,
+	providerRegistry domainservice.PaymentProviderRegistry,
+	clearingLedger domainservice.PaymentClearingLedger,
+	lookback time.Duration,
+	settleDelay time.Duration,
+) PaymentReconciliationService {
+	if lookback <= 0 {
+		lookback = defaultReconciliationLookback
+	}
+	if settleDelay < 0 || settleDelay >= lookback {
+		settleDelay = defaultReconciliationSettleDelay
+	}
+	return &paymentReconciliationService{
+		baseRepo:         baseRepo,
+		providerRegistry: providerRegistry,
+		clearingLedger:   clearingLedger,
+		lookback:         lookback,
+		settleDelay:      settleDelay,
+		now:              time.Now,
+	}
+}
+
+func (s *paymentReconciliationService) RunReconciliation(ctx context.Context) error {
+	now := s.now().UTC()
+	from := now.Add(-s.lookback)
+	settledBefore := now.Add(-s.settleDelay)
+
+	var errs []error
+	for _, reporter := range s.providerRegistry.SettlementReporters() {
+		if err := s.reconcileProvider(ctx, reporter, from, settledBefore, now); err != nil {
+			if errors.Is(err, domainservice.ErrSettlementReportUnavailable) {
+				logging.FromContext(ctx).Infow("skip payment reconciliation without settlement report", zap.String("provider", reporter.Name()))
+				continue
+			}
+			logging.FromContext(ctx).Warnw("payment reconciliation failed", zap.String("provider", reporter.Name()), zap.Error(err))
+			errs = append(errs, fmt.Errorf("%s: %w", reporter.Name(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return stackErr.Error(errors.Join(errs...))
+	}
+	return nil
+}
+
+func (s *paymentReconciliationService) reconcileProvider(
+	ctx context.Context,
+	reporter domainservice.PaymentSettlementReporter,
+	from time.Time,
+	settledBefore time.Time,
+	now time.Time,
+) error {
+	settlements, err := reporter.ListSettlements(ctx, from, now)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	aggregates, err := s.baseRepo.PaymentIntentAggregateRepository().ListCreatedBetween(ctx, reporter.Name(), from, settledBefore)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	windowIntents := make([]*entity.PaymentIntent, 0, len(aggregates))
+	known := make(map[string]struct{}, len(aggregates))
+	for _, item := range aggregates {
+		if item == nil {
+			continue
+		}
+		intent := item.Snapshot()
+		windowIntents = append(windowIntents, intent)
+		known[intent.TransactionID] = struct{}{}
+	}
+
+	// The provider may settle an intent created just before the window or
+	// inside the settle delay; load those so they are not reported missing.
+	intents := append([]*entity.PaymentIntent(nil), windowIntents...)
+	for _, settlement := range settlements {
+		transactionID := strings.TrimSpace(settlement.TransactionID)
+		if transactionID == "" || !entity.IsSettledPaymentStatus(settlement.Status) {
+			continue
+		}
+		if _, ok := known[transactionID]; ok {
+			continue
+		}
+		known[transactionID] = struct{}{}
+		item, err := s.baseRepo.PaymentIntentAggregateRepository().GetByTransactionID(ctx, transactionID)
+		if err != nil {
+			if errors.Is(err, repos.ErrProviderPaymentNotFound) {
+				continue
+			}
+			return stackErr.Error(err)
+		}
+		if intent := item.Snapshot(); intent.Provider == reporter.Name() {
+			intents = append(intents, intent)
+		}
+	}
+
+	discrepancies := entity.ReconcileSettlements(reporter.Name(), intents, settlements, now)
+	for _, intent := range windowIntents {
+		if !entity.IsSettledPaymentStatus(intent.Status) {
+			continue
+		}
+		postedAmount, posted, err := s.clearingLedger.ClearingPosting(ctx, intent)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if discrepancy := entity.ReconcileLedgerPosting(intent, postedAmount, posted, now); discrepancy != nil {
+			discrepancies = append(discrepancies, discrepancy)
+		}
+	}
+
+	for _, discrepancy := range discrepancies {
+		discrepancy.ID = uuid.NewString()
+		if err := s.baseRepo.PaymentReconciliationRepository().Record(ctx, discrepancy); err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	if len(discrepancies) > 0 {
+		logging.FromContext(ctx).Warnw("payment reconciliation found discrepancies",
+			zap.String("provider", reporter.Name()),
+			zap.Int("count", len(discrepancies)),
+		)
+	}
+	return nil
+}
+
+func (s *paymentReconciliationService) ListDiscrepancies(ctx context.Context, req *in.ListReconciliationDiscrepanciesRequest) (*out.ListReconciliationDiscrepanciesResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPaymentIntentPageSize
+	}
+	if limit > maxPaymentIntentPageSize {
+		limit = maxPaymentIntentPageSize
+	}
+
+	filter := repos.PaymentReconciliationFilter{
+		Provider: strings.ToLower(req.Provider),
+		Kind:     strings.ToUpper(req.Kind),
+		Status:   strings.ToUpper(req.Status),
+		Limit:    limit + 1,
+	}
+	if req.Cursor != "" {
+		detectedAt, id, err := utils.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("%w: invalid cursor", ErrValidation))
+		}
+		filter.CursorDetected = &detectedAt
+		filter.CursorID = id
+	}
+
+	items, err := s.baseRepo.PaymentReconciliationRepository().List(ctx, filter)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	records := make([]out.ReconciliationDiscrepancyResponse, 0, len(items))
+	nextCursor := ""
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		records = append(records, *toReconciliationDiscrepancyResponse(item))
+		if hasMore {
+			nextCursor = utils.EncodeCursor(item.LastDetectedAt.UTC().Format(time.RFC3339Nano), item.ID)
+		}
+	}
+
+	return &out.ListReconciliationDiscrepanciesResponse{
+		Records:    records,
+		Limit:      limit,
+		Size:       len(records),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *paymentReconciliationService) ReviewDiscrepancy(ctx context.Context, req *in.ReviewReconciliationDiscrepancyRequest) (*out.ReconciliationDiscrepancyResponse, error) {
+	accountID, err := actorctx.AccountIDFromContext(ctx)
+	if err != nil {
+		return nil, stackErr.Error(ErrPaymentUnauthorized)
+	}
+
+	discrepancy, err := s.baseRepo.PaymentReconciliationRepository().GetByID(ctx, req.DiscrepancyID)
+	if err != nil {
+		if errors.Is(err, repos.ErrPaymentReconciliationNotFound) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %s", ErrDiscrepancyNotFound, req.DiscrepancyID))
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	if err := discrepancy.Review(req.Status, req.Note, accountID, s.now()); err != nil {
+		if errors.Is(err, entity.ErrReconciliationReviewStatusInvalid) {
+			return nil, stackErr.Error(fmt.Errorf("%w: %w", ErrValidation, err))
+		}
+		return nil, stackErr.Error(err)
+	}
+	if err := s.baseRepo.PaymentReconciliationRepository().UpdateReview(ctx, discrepancy); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return toReconciliationDiscrepancyResponse(discrepancy), nil
+}
+
+func toReconciliationDiscrepancyResponse(discrepancy *entity.PaymentReconciliationDiscrepancy) *out.ReconciliationDiscrepancyResponse {
+	response := &out.ReconciliationDiscrepancyResponse{
+		ID:             discrepancy.ID,
+		Provider:       discrepancy.Provider,
+		Kind:           discrepancy.Kind,
+		TransactionID:  discrepancy.TransactionID,
+		ExternalRef:    discrepancy.ExternalRef,
+		Workflow:       discrepancy.Workflow,
+		ExpectedAmount: discrepancy.ExpectedAmount,
+		ActualAmount:   discrepancy.ActualAmount,
+		Currency:       discrepancy.Currency,
+		Detail:         discrepancy.Detail,
+		Status:         discrepancy.Status,
+		ReviewNote:     discrepancy.ReviewNote,
+		ReviewedBy:     discrepancy.ReviewedBy,
+		DetectedAt:     discrepancy.DetectedAt.UTC().Format(time.RFC3339Nano),
+		LastDetectedAt: discrepancy.LastDetectedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if discrepancy.ReviewedAt != nil {
+		response.ReviewedAt = discrepancy.ReviewedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return response
+}
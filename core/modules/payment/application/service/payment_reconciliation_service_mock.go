@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_reconciliation_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_reconciliation_service_mock.go -source=payment_reconciliation_service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	in "wechat-clone/core/modules/payment/application/dto/in"
+	out "wechat-clone/core/modules/payment/application/dto/out"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentReconciliationService is a mock of PaymentReconciliationService interface.
+type MockPaymentReconciliationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReconciliationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentReconciliationServiceMockRecorder is the mock recorder for MockPaymentReconciliationService.
+type MockPaymentReconciliationServiceMockRecorder struct {
+	mock *MockPaymentReconciliationService
+}
+
+// NewMockPaymentReconciliationService creates a new mock instance.
+func NewMockPaymentReconciliationService(ctrl *gomock.Controller) *MockPaymentReconciliationService {
+	mock := &MockPaymentReconciliationService{ctrl: ctrl}
+	mock.recorder = &MockPaymentReconciliationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReconciliationService) EXPECT() *MockPaymentReconciliationServiceMockRecorder {
+	return m.recorder
+}
+
+// ListDiscrepancies mocks base method.
+func (m *MockPaymentReconciliationService) ListDiscrepancies(ctx context.Context, req *in.ListReconciliationDiscrepanciesRequest) (*out.ListReconciliationDiscrepanciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscrepancies", ctx, req)
+	ret0, _ := ret[0].(*out.ListReconciliationDiscrepanciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiscrepancies indicates an expected call of ListDiscrepancies.
+func (mr *MockPaymentReconciliationServiceMockRecorder) ListDiscrepancies(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscrepancies", reflect.TypeOf((*MockPaymentReconciliationService)(nil).ListDiscrepancies), ctx, req)
+}
+
+// ReviewDiscrepancy mocks base method.
+func (m *MockPaymentReconciliationService) ReviewDiscrepancy(ctx context.Context, req *in.ReviewReconciliationDiscrepancyRequest) (*out.ReconciliationDiscrepancyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewDiscrepancy", ctx, req)
+	ret0, _ := ret[0].(*out.ReconciliationDiscrepancyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewDiscrepancy indicates an expected call of ReviewDiscrepancy.
+func (mr *MockPaymentReconciliationServiceMockRecorder) ReviewDiscrepancy(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewDiscrepancy", reflect.TypeOf((*MockPaymentReconciliationService)(nil).ReviewDiscrepancy), ctx, req)
+}
+
+// RunReconciliation mocks base method.
+func (m *MockPaymentReconciliationService) RunReconciliation(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunReconciliation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunReconciliation indicates an expected call of RunReconciliation.
+func (mr *MockPaymentReconciliationServiceMockRecorder) RunReconciliation(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReconciliation", reflect.TypeOf((*MockPaymentReconciliationService)(nil).RunReconciliation), ctx)
+}
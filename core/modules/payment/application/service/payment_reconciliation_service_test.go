@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+	repos "wechat-clone/core/modules/payment/domain/repos"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/pkg/actorctx"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunReconciliationRecordsUnknownProviderSettlement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	aggregateRepo := repos.NewMockPaymentIntentAggregateRepo(ctrl)
+	reconciliationRepo := repos.NewMockPaymentReconciliationRepo(ctrl)
+	providerRegistry := domainservice.NewMockPaymentProviderRegistry(ctrl)
+	stripeReporter := domainservice.NewMockPaymentSettlementReporter(ctrl)
+	mockReporter := domainservice.NewMockPaymentSettlementReporter(ctrl)
+	clearingLedger := domainservice.NewMockPaymentClearingLedger(ctrl)
+
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	settled := mustRehydrateSettledPaymentAggregate(t, "txn-1", "stripe", 100, "VND", "acc-1")
+
+	baseRepo.EXPECT().PaymentIntentAggregateRepository().Return(aggregateRepo).AnyTimes()
+	baseRepo.EXPECT().PaymentReconciliationRepository().Return(reconciliationRepo).AnyTimes()
+	providerRegistry.EXPECT().SettlementReporters().Return([]domainservice.PaymentSettlementReporter{mockReporter, stripeReporter}).Times(1)
+
+	mockReporter.EXPECT().Name().Return("mock").AnyTimes()
+	mockReporter.EXPECT().ListSettlements(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domainservice.ErrSettlementReportUnavailable).Times(1)
+
+	stripeReporter.EXPECT().Name().Return("stripe").AnyTimes()
+	stripeReporter.EXPECT().ListSettlements(gomock.Any(), now.Add(-24*time.Hour), now).Return([]entity.PaymentSettlement{
+		{Provider: "stripe", TransactionID: "txn-1", ExternalRef: "ch_1", Status: entity.PaymentStatusSuccess, Amount: 100, Currency: "VND"},
+		{Provider: "stripe", TransactionID: "txn-9", ExternalRef: "ch_9", Status: entity.PaymentStatusSuccess, Amount: 300, Currency: "VND"},
+	}, nil).Times(1)
+	aggregateRepo.EXPECT().ListCreatedBetween(gomock.Any(), "stripe", now.Add(-24*time.Hour), now.Add(-15*time.Minute)).
+		Return([]*paymentaggregate.PaymentIntentAggregate{settled}, nil).Times(1)
+	aggregateRepo.EXPECT().GetByTransactionID(gomock.Any(), "txn-9").Return(nil, repos.ErrProviderPaymentNotFound).Times(1)
+	clearingLedger.EXPECT().ClearingPosting(gomock.Any(), gomock.Any()).Return(int64(100), true, nil).Times(1)
+
+	reconciliationRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error {
+		if discrepancy.Kind != entity.ReconciliationKindMissingIntent || discrepancy.TransactionID != "txn-9" {
+			t.Fatalf("unexpected discrepancy %+v", discrepancy)
+		}
+		if discrepancy.ID == "" {
+			t.Fatalf("expected discrepancy id to be assigned")
+		}
+		return nil
+	}).Times(1)
+
+	svc := NewPaymentReconciliationService(baseRepo, providerRegistry, clearingLedger, 24*time.Hour, 15*time.Minute).(*paymentReconciliationService)
+	svc.now = func() time.Time { return now }
+	if err := svc.RunReconciliation(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestReviewDiscrepancyRejectsAlreadyReviewedDiscrepancy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseRepo := repos.NewMockRepos(ctrl)
+	reconciliationRepo := repos.NewMockPaymentReconciliationRepo(ctrl)
+
+	reviewedAt := time.Now().UTC()
+	baseRepo.EXPECT().PaymentReconciliationRepository().Return(reconciliationRepo).AnyTimes()
+	reconciliationRepo.EXPECT().GetByID(gomock.Any(), "disc-1").Return(&entity.PaymentReconciliationDiscrepancy{
+		ID:         "disc-1",
+		Status:     entity.ReconciliationStatusIgnored,
+		ReviewedAt: &reviewedAt,
+	}, nil).Times(1)
+	reconciliationRepo.EXPECT().UpdateReview(gomock.Any(), gomock.Any()).Times(0)
+
+	svc := NewPaymentReconciliationService(baseRepo, nil, nil, 0, 0)
+	ctx := actorctx.WithActor(context.Background(), actorctx.Actor{AccountID: "admin-1"})
+	_, err := svc.ReviewDiscrepancy(ctx, &in.ReviewReconciliationDiscrepancyRequest{DiscrepancyID: "disc-1", Status: "RESOLVED"})
+	if !errors.Is(err, entity.ErrReconciliationAlreadyReviewed) {
+		t.Fatalf("expected already reviewed error, got %v", err)
+	}
+}
+
+func mustRehydrateSettledPaymentAggregate(
+	t *testing.T,
+	transactionID string,
+	provider string,
+	amount int64,
+	currency string,
+	creditAccountID string,
+) *paymentaggregate.PaymentIntentAggregate {
+	t.Helper()
+
+	intent, err := entity.NewProviderTopUpIntent(transactionID, provider, amount, 0, currency, creditAccountID, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("new provider top up intent: %v", err)
+	}
+	intent.Status = entity.PaymentStatusSuccess
+	paymentAggregate, err := paymentaggregate.RestorePaymentIntentAggregate(intent)
+	if err != nil {
+		t.Fatalf("rehydrate payment aggregate: %v", err)
+	}
+	return paymentAggregate
+}
@@ -9,23 +9,32 @@ import (
 //go:generate mockgen -package=service -destination=services_mock.go -source=services.go
 type Services interface {
 	PaymentCommandService() PaymentCommandService
+	PaymentQueryService() PaymentQueryService
 }
 
 type services struct {
 	paymentCommandService PaymentCommandService
+	paymentQueryService   PaymentQueryService
 }
 
 func NewServices(
 	appCtx *appCtx.AppContext,
 	baseRepo repos.Repos,
 	providerRegistry domainservice.PaymentProviderRegistry,
+	limits domainservice.PaymentLimitPolicy,
+	fees domainservice.PaymentFeePolicy,
 ) Services {
-	paymentCommandService := NewPaymentCommandService(appCtx, baseRepo, providerRegistry)
+	paymentCommandService := NewPaymentCommandService(appCtx, baseRepo, providerRegistry, limits, fees)
 	return &services{
 		paymentCommandService: paymentCommandService,
+		paymentQueryService:   NewPaymentQueryService(baseRepo),
 	}
 }
 
 func (s *services) PaymentCommandService() PaymentCommandService {
 	return s.paymentCommandService
 }
+
+func (s *services) PaymentQueryService() PaymentQueryService {
+	return s.paymentQueryService
+}
@@ -52,3 +52,17 @@ func (mr *MockServicesMockRecorder) PaymentCommandService() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentCommandService", reflect.TypeOf((*MockServices)(nil).PaymentCommandService))
 }
+
+// PaymentQueryService mocks base method.
+func (m *MockServices) PaymentQueryService() PaymentQueryService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentQueryService")
+	ret0, _ := ret[0].(PaymentQueryService)
+	return ret0
+}
+
+// PaymentQueryService indicates an expected call of PaymentQueryService.
+func (mr *MockServicesMockRecorder) PaymentQueryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentQueryService", reflect.TypeOf((*MockServices)(nil).PaymentQueryService))
+}
@@ -19,7 +19,8 @@ func buildCronRuntime(cfg *config.Config, appContext *appCtx.AppContext) (modrun
 	}
 
 	interval := time.Duration(cfg.LedgerConfig.Stripe.WithdrawalScheduleIntervalSecond) * time.Second
-	job, err := cronjob.NewCronJob(scheduler, interval)
+	reconciliationInterval := time.Duration(cfg.LedgerConfig.Reconciliation.IntervalSecond) * time.Second
+	job, err := cronjob.NewCronJob(scheduler, interval, reconciliationInterval)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
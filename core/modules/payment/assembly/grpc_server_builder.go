@@ -4,13 +4,10 @@ import (
 	"context"
 
 	appCtx "wechat-clone/core/context"
+	accountassembly "wechat-clone/core/modules/account/assembly"
 	paymentcommand "wechat-clone/core/modules/payment/application/command"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	paymentrepo "wechat-clone/core/modules/payment/infra/persistent/repository"
-	provideradapter "wechat-clone/core/modules/payment/infra/provider"
-	"wechat-clone/core/modules/payment/providers"
-	mockprovider "wechat-clone/core/modules/payment/providers/mock"
-	stripeprovider "wechat-clone/core/modules/payment/providers/stripe"
 	paymentgrpc "wechat-clone/core/modules/payment/transport/grpc"
 	"wechat-clone/core/shared/pkg/cqrs"
 	infragrpc "wechat-clone/core/shared/transport/grpc"
@@ -25,19 +22,17 @@ type paymentGRPCRegistrar struct {
 
 func buildGRPCServer(_ context.Context, appContext *appCtx.AppContext) (infragrpc.GRPCServer, error) {
 	paymentRepos := paymentrepo.NewRepoImpl(appContext)
-	providerRegistry := providers.NewProviderRegistry()
-	providerRegistry.Register(mockprovider.NewProvider(appContext.GetConfig().LedgerConfig.MockWebhookSecret))
-	if stripe := stripeprovider.NewProvider(appContext.GetConfig().LedgerConfig.Stripe); stripe.Enabled() {
-		providerRegistry.Register(stripe)
-	}
-	paymentCommandService := paymentservice.NewPaymentCommandService(appContext, paymentRepos, provideradapter.NewPaymentProviderRegistry(providerRegistry))
+	providerRegistry := buildPaymentProviderRegistry(appContext)
+	adminRoles := accountassembly.BuildAdminRoles(appContext)
+	paymentCommandService := paymentservice.NewPaymentCommandService(appContext, paymentRepos, providerRegistry, buildPaymentLimitPolicy(appContext), buildPaymentFeePolicy(appContext))
 
 	createPayment := cqrs.NewDispatcher(paymentcommand.NewCreatePayment(paymentCommandService))
 	processWebhook := cqrs.NewDispatcher(paymentcommand.NewProcessWebhook(paymentCommandService))
-	refundPayment := cqrs.NewDispatcher(paymentcommand.NewRefundPayment(paymentCommandService))
+	createWithdrawal := cqrs.NewDispatcher(paymentcommand.NewCreateWithdrawal(paymentCommandService))
+	refundPayment := cqrs.NewDispatcher(paymentcommand.NewRefundPayment(paymentCommandService, adminRoles))
 
 	return &paymentGRPCRegistrar{
-		server: paymentgrpc.NewServer(createPayment, processWebhook, refundPayment),
+		server: paymentgrpc.NewServer(createPayment, processWebhook, createWithdrawal, refundPayment),
 	}, nil
 }
 
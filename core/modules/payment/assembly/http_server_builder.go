@@ -4,13 +4,11 @@ import (
 	"context"
 
 	appCtx "wechat-clone/core/context"
+	accountassembly "wechat-clone/core/modules/account/assembly"
 	paymentcommand "wechat-clone/core/modules/payment/application/command"
+	paymentquery "wechat-clone/core/modules/payment/application/query"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	paymentrepo "wechat-clone/core/modules/payment/infra/persistent/repository"
-	provideradapter "wechat-clone/core/modules/payment/infra/provider"
-	"wechat-clone/core/modules/payment/providers"
-	mockprovider "wechat-clone/core/modules/payment/providers/mock"
-	stripeprovider "wechat-clone/core/modules/payment/providers/stripe"
 	paymentserver "wechat-clone/core/modules/payment/transport/server"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -19,17 +17,45 @@ import (
 
 func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (infrahttp.HTTPServer, error) {
 	paymentRepos := paymentrepo.NewRepoImpl(appContext)
-	providerRegistry := providers.NewProviderRegistry()
-	providerRegistry.Register(mockprovider.NewProvider(appContext.GetConfig().LedgerConfig.MockWebhookSecret))
-	if stripe := stripeprovider.NewProvider(appContext.GetConfig().LedgerConfig.Stripe); stripe.Enabled() {
-		providerRegistry.Register(stripe)
-	}
+	providerRegistry := buildPaymentProviderRegistry(appContext)
+	adminRoles := accountassembly.BuildAdminRoles(appContext)
 
-	paymentCommandService := paymentservice.NewPaymentCommandService(appContext, paymentRepos, provideradapter.NewPaymentProviderRegistry(providerRegistry))
+	paymentCommandService := paymentservice.NewPaymentCommandService(appContext, paymentRepos, providerRegistry, buildPaymentLimitPolicy(appContext), buildPaymentFeePolicy(appContext))
 	createPayment := cqrs.NewDispatcher(paymentcommand.NewCreatePayment(paymentCommandService))
 	processWebhook := cqrs.NewDispatcher(paymentcommand.NewProcessWebhook(paymentCommandService))
+	createWithdrawal := cqrs.NewDispatcher(paymentcommand.NewCreateWithdrawal(paymentCommandService))
+	refundPayment := cqrs.NewDispatcher(paymentcommand.NewRefundPayment(paymentCommandService, adminRoles))
+
+	paymentQueryService := paymentservice.NewPaymentQueryService(paymentRepos)
+	listPaymentIntents := cqrs.NewDispatcher(paymentquery.NewListPaymentIntents(paymentQueryService))
+	getPaymentIntent := cqrs.NewDispatcher(paymentquery.NewGetPaymentIntent(paymentQueryService))
+	getWithdrawal := cqrs.NewDispatcher(paymentquery.NewGetWithdrawal(paymentQueryService))
+
+	reconciliationService := buildPaymentReconciliationService(appContext)
+	listReconciliationDiscrepancies := cqrs.NewDispatcher(paymentquery.NewListReconciliationDiscrepancies(reconciliationService, adminRoles))
+	reviewReconciliationDiscrepancy := cqrs.NewDispatcher(paymentcommand.NewReviewReconciliationDiscrepancy(reconciliationService, adminRoles))
+
+	disputeService := paymentservice.NewPaymentDisputeService(paymentRepos, providerRegistry, appContext.GetStorage())
+	listDisputes := cqrs.NewDispatcher(paymentquery.NewListDisputes(disputeService, adminRoles))
+	getDispute := cqrs.NewDispatcher(paymentquery.NewGetDispute(disputeService, adminRoles))
+	uploadDisputeEvidence := cqrs.NewDispatcher(paymentcommand.NewUploadDisputeEvidence(disputeService, adminRoles))
+	submitDisputeEvidence := cqrs.NewDispatcher(paymentcommand.NewSubmitDisputeEvidence(disputeService, adminRoles))
 
-	server, err := paymentserver.NewHTTPServer(createPayment, processWebhook)
+	server, err := paymentserver.NewHTTPServer(
+		createPayment,
+		processWebhook,
+		listPaymentIntents,
+		getPaymentIntent,
+		createWithdrawal,
+		getWithdrawal,
+		refundPayment,
+		listReconciliationDiscrepancies,
+		reviewReconciliationDiscrepancy,
+		listDisputes,
+		getDispute,
+		uploadDisputeEvidence,
+		submitDisputeEvidence,
+	)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -0,0 +1,23 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	provideradapter "wechat-clone/core/modules/payment/infra/provider"
+	"wechat-clone/core/modules/payment/providers"
+	banktransferprovider "wechat-clone/core/modules/payment/providers/banktransfer"
+	mockprovider "wechat-clone/core/modules/payment/providers/mock"
+	redirectcheckoutprovider "wechat-clone/core/modules/payment/providers/redirectcheckout"
+	stripeprovider "wechat-clone/core/modules/payment/providers/stripe"
+)
+
+func buildPaymentProviderRegistry(appContext *appCtx.AppContext) domainservice.PaymentProviderRegistry {
+	registry := providers.NewProviderRegistryFromConfig(
+		appContext.GetConfig().LedgerConfig,
+		mockprovider.FromConfig,
+		stripeprovider.FromConfig,
+		redirectcheckoutprovider.FromConfig,
+		banktransferprovider.FromConfig,
+	)
+	return provideradapter.NewPaymentProviderRegistry(registry)
+}
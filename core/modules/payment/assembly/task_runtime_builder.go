@@ -1,15 +1,16 @@
 package assembly
 
 import (
+	"time"
+
 	appCtx "wechat-clone/core/context"
+	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
 	paymenttask "wechat-clone/core/modules/payment/application/scheduler/task"
 	"wechat-clone/core/modules/payment/application/scheduler/taskhandler"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
+	domainservice "wechat-clone/core/modules/payment/domain/service"
+	paymentledger "wechat-clone/core/modules/payment/infra/ledger"
 	paymentrepo "wechat-clone/core/modules/payment/infra/persistent/repository"
-	provideradapter "wechat-clone/core/modules/payment/infra/provider"
-	"wechat-clone/core/modules/payment/providers"
-	mockprovider "wechat-clone/core/modules/payment/providers/mock"
-	stripeprovider "wechat-clone/core/modules/payment/providers/stripe"
 	"wechat-clone/core/shared/config"
 	"wechat-clone/core/shared/pkg/stackErr"
 	modruntime "wechat-clone/core/shared/runtime"
@@ -28,24 +29,43 @@ func buildTaskRuntime(_ *config.Config, appContext *appCtx.AppContext) (modrunti
 		return nil, stackErr.Error(err)
 	}
 
-	return taskhandler.NewTaskHandler(commandService, server), nil
+	return taskhandler.NewTaskHandler(commandService, buildPaymentReconciliationService(appContext), server), nil
 }
 
 func buildPaymentCommandService(appContext *appCtx.AppContext) (paymentservice.PaymentCommandService, error) {
 	paymentRepos := paymentrepo.NewRepoImpl(appContext)
-	providerRegistry := providers.NewProviderRegistry()
-	providerRegistry.Register(mockprovider.NewProvider(appContext.GetConfig().LedgerConfig.MockWebhookSecret))
-	if stripe := stripeprovider.NewProvider(appContext.GetConfig().LedgerConfig.Stripe); stripe.Enabled() {
-		providerRegistry.Register(stripe)
-	}
+	providerRegistry := buildPaymentProviderRegistry(appContext)
 
 	return paymentservice.NewPaymentCommandService(
 		appContext,
 		paymentRepos,
-		provideradapter.NewPaymentProviderRegistry(providerRegistry),
+		providerRegistry,
+		buildPaymentLimitPolicy(appContext),
+		buildPaymentFeePolicy(appContext),
 	), nil
 }
 
+func buildPaymentLimitPolicy(appContext *appCtx.AppContext) domainservice.PaymentLimitPolicy {
+	return paymentledger.NewLimitPolicy(ledgerassembly.BuildLimitService(appContext))
+}
+
+func buildPaymentFeePolicy(appContext *appCtx.AppContext) domainservice.PaymentFeePolicy {
+	return paymentledger.NewFeePolicy(ledgerassembly.BuildFeeService(appContext))
+}
+
+func buildPaymentReconciliationService(appContext *appCtx.AppContext) paymentservice.PaymentReconciliationService {
+	ledgerConfig := appContext.GetConfig().LedgerConfig
+	providerRegistry := buildPaymentProviderRegistry(appContext)
+
+	return paymentservice.NewPaymentReconciliationService(
+		paymentrepo.NewRepoImpl(appContext),
+		providerRegistry,
+		paymentledger.NewClearingLedger(ledgerassembly.BuildQueryService(appContext)),
+		time.Duration(ledgerConfig.Reconciliation.LookbackSecond)*time.Second,
+		time.Duration(ledgerConfig.Reconciliation.SettleDelaySecond)*time.Second,
+	)
+}
+
 func newAsynqServer(appContext *appCtx.AppContext) (*asynq.Server, error) {
 	redisConnOpt, err := newAsynqRedisConnOpt(appContext)
 	if err != nil {
@@ -0,0 +1,311 @@
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	paymententity "wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var AggregateTypePaymentDispute = event.AggregateTypeName((*PaymentDisputeAggregate)(nil))
+
+type PaymentDisputeAggregate struct {
+	event.AggregateRoot
+
+	dispute *paymententity.PaymentDispute
+}
+
+// NewPaymentDisputeAggregate opens a dispute against a successful top-up and
+// moves it straight to the provider's current status, so a dispute first
+// seen already closed still holds and then settles the funds in order.
+func NewPaymentDisputeAggregate(
+	id string,
+	intent *paymententity.PaymentIntent,
+	update paymententity.PaymentDisputeUpdate,
+	now time.Time,
+) (*PaymentDisputeAggregate, error) {
+	if err := intent.CanDispute(); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	now, err := normalizePaymentIntentOccurredAt(now)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	amount := intent.Amount
+	if update.Amount > 0 && update.Amount < amount {
+		amount = update.Amount
+	}
+	dispute := &paymententity.PaymentDispute{
+		ID:                strings.TrimSpace(id),
+		Provider:          intent.Provider,
+		ProviderDisputeID: strings.TrimSpace(update.ProviderDisputeID),
+		TransactionID:     intent.TransactionID,
+		AccountID:         intent.CreditAccountID,
+		Currency:          intent.Currency,
+		Amount:            amount,
+	}
+
+	agg := &PaymentDisputeAggregate{dispute: dispute}
+	if err := event.InitAggregate(&agg.AggregateRoot, agg, dispute.ID); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := agg.applyChangeAt(&PaymentDisputeOpenedEvent{
+		DisputeID:         dispute.ID,
+		PaymentID:         intent.TransactionID,
+		TransactionID:     intent.TransactionID,
+		Provider:          intent.Provider,
+		ProviderDisputeID: dispute.ProviderDisputeID,
+		AccountID:         dispute.AccountID,
+		Amount:            dispute.Amount,
+		Currency:          dispute.Currency,
+		Reason:            strings.TrimSpace(update.Reason),
+		Status:            paymententity.PaymentDisputeStatusOpened,
+		EvidenceDueBy:     normalizeOptionalTime(update.EvidenceDueBy),
+		OpenedAt:          now,
+	}, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if _, err := agg.ApplyProviderUpdate(update, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return agg, nil
+}
+
+func RestorePaymentDisputeAggregate(dispute *paymententity.PaymentDispute, version int) (*PaymentDisputeAggregate, error) {
+	if dispute == nil || strings.TrimSpace(dispute.ID) == "" {
+		return nil, stackErr.Error(event.ErrIDEmpty)
+	}
+	clone := *dispute
+	if version < 0 {
+		version = 0
+	}
+	agg := &PaymentDisputeAggregate{dispute: &clone}
+	agg.SetAggregateType(AggregateTypePaymentDispute)
+	agg.SetInternal(clone.ID, version, version)
+	return agg, nil
+}
+
+func (a *PaymentDisputeAggregate) RegisterEvents(register event.RegisterEventsFunc) error {
+	return register(
+		&PaymentDisputeOpenedEvent{},
+		&PaymentDisputeStatusChangedEvent{},
+		&PaymentDisputeEvidenceSubmittedEvent{},
+		&PaymentDisputeWonEvent{},
+		&PaymentDisputeLostEvent{},
+	)
+}
+
+func (a *PaymentDisputeAggregate) Transition(e event.Event) error {
+	switch data := e.EventData.(type) {
+	case *PaymentDisputeOpenedEvent:
+		return a.applyDisputeOpened(data)
+	case PaymentDisputeOpenedEvent:
+		return a.applyDisputeOpened(&data)
+	case *PaymentDisputeStatusChangedEvent:
+		return a.applyDisputeStatusChanged(data)
+	case PaymentDisputeStatusChangedEvent:
+		return a.applyDisputeStatusChanged(&data)
+	case *PaymentDisputeEvidenceSubmittedEvent:
+		return a.applyDisputeEvidenceSubmitted(data)
+	case PaymentDisputeEvidenceSubmittedEvent:
+		return a.applyDisputeEvidenceSubmitted(&data)
+	case *PaymentDisputeWonEvent:
+		return a.applyDisputeClosed(paymententity.PaymentDisputeStatusWon, data.WonAt)
+	case PaymentDisputeWonEvent:
+		return a.applyDisputeClosed(paymententity.PaymentDisputeStatusWon, data.WonAt)
+	case *PaymentDisputeLostEvent:
+		return a.applyDisputeClosed(paymententity.PaymentDisputeStatusLost, data.LostAt)
+	case PaymentDisputeLostEvent:
+		return a.applyDisputeClosed(paymententity.PaymentDisputeStatusLost, data.LostAt)
+	default:
+		return event.ErrUnsupportedEventType
+	}
+}
+
+func (a *PaymentDisputeAggregate) Snapshot() *paymententity.PaymentDispute {
+	if a == nil || a.dispute == nil {
+		return nil
+	}
+	clone := *a.dispute
+	return &clone
+}
+
+func (a *PaymentDisputeAggregate) ID() string {
+	if a == nil || a.dispute == nil {
+		return ""
+	}
+	return a.dispute.ID
+}
+
+func (a *PaymentDisputeAggregate) TransactionID() string {
+	if a == nil || a.dispute == nil {
+		return ""
+	}
+	return a.dispute.TransactionID
+}
+
+func (a *PaymentDisputeAggregate) Status() string {
+	if a == nil || a.dispute == nil {
+		return ""
+	}
+	return a.dispute.Status
+}
+
+func (a *PaymentDisputeAggregate) PendingOutboxEvents() []event.Event {
+	if a == nil {
+		return nil
+	}
+	return a.CloneEvents()
+}
+
+// ApplyProviderUpdate moves the dispute to the provider's status. It reports
+// whether anything changed; stale or repeated updates are ignored.
+func (a *PaymentDisputeAggregate) ApplyProviderUpdate(update paymententity.PaymentDisputeUpdate, occurredAt time.Time) (bool, error) {
+	if a == nil || a.dispute == nil {
+		return false, stackErr.Error(event.ErrAggregateNil)
+	}
+	occurredAt, err := normalizePaymentIntentOccurredAt(occurredAt)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	status, err := paymententity.NormalizePaymentDisputeStatus(update.Status)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	if !a.dispute.AdvancesTo(status) {
+		return false, nil
+	}
+
+	switch status {
+	case paymententity.PaymentDisputeStatusWon:
+		err = a.applyChangeAt(&PaymentDisputeWonEvent{
+			DisputeID:     a.dispute.ID,
+			PaymentID:     a.dispute.TransactionID,
+			TransactionID: a.dispute.TransactionID,
+			Provider:      a.dispute.Provider,
+			AccountID:     a.dispute.AccountID,
+			Amount:        a.dispute.Amount,
+			Currency:      a.dispute.Currency,
+			WonAt:         occurredAt,
+		}, occurredAt)
+	case paymententity.PaymentDisputeStatusLost:
+		err = a.applyChangeAt(&PaymentDisputeLostEvent{
+			DisputeID:     a.dispute.ID,
+			PaymentID:     a.dispute.TransactionID,
+			TransactionID: a.dispute.TransactionID,
+			Provider:      a.dispute.Provider,
+			AccountID:     a.dispute.AccountID,
+			Amount:        a.dispute.Amount,
+			Currency:      a.dispute.Currency,
+			LostAt:        occurredAt,
+		}, occurredAt)
+	default:
+		err = a.applyChangeAt(&PaymentDisputeStatusChangedEvent{
+			DisputeID:         a.dispute.ID,
+			TransactionID:     a.dispute.TransactionID,
+			PreviousStatus:    a.dispute.Status,
+			Status:            status,
+			ProviderEventID:   strings.TrimSpace(update.EventID),
+			ProviderEventType: strings.TrimSpace(update.EventType),
+			EvidenceDueBy:     normalizeOptionalTime(update.EvidenceDueBy),
+			ChangedAt:         occurredAt,
+		}, occurredAt)
+	}
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return true, nil
+}
+
+// MarkEvidenceSubmitted records that evidence went to the provider, which
+// puts the dispute under review.
+func (a *PaymentDisputeAggregate) MarkEvidenceSubmitted(evidenceIDs []string, occurredAt time.Time) error {
+	if a == nil || a.dispute == nil {
+		return stackErr.Error(event.ErrAggregateNil)
+	}
+	if len(evidenceIDs) == 0 {
+		return stackErr.Error(paymententity.ErrPaymentDisputeEvidenceRequired)
+	}
+	occurredAt, err := normalizePaymentIntentOccurredAt(occurredAt)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if err := a.dispute.AcceptsEvidence(occurredAt); err != nil {
+		return stackErr.Error(err)
+	}
+	return a.applyChangeAt(&PaymentDisputeEvidenceSubmittedEvent{
+		DisputeID:     a.dispute.ID,
+		TransactionID: a.dispute.TransactionID,
+		EvidenceIDs:   append([]string(nil), evidenceIDs...),
+		SubmittedAt:   occurredAt,
+	}, occurredAt)
+}
+
+func (a *PaymentDisputeAggregate) applyChangeAt(data interface{}, occurredAt time.Time) error {
+	if err := a.ApplyChange(a, data); err != nil {
+		return stackErr.Error(err)
+	}
+	events := a.Events()
+	if len(events) == 0 {
+		return nil
+	}
+	events[len(events)-1].CreatedAt = occurredAt.Unix()
+	return nil
+}
+
+func (a *PaymentDisputeAggregate) applyDisputeOpened(data *PaymentDisputeOpenedEvent) error {
+	if data == nil || a.dispute == nil {
+		return nil
+	}
+	a.dispute.Reason = data.Reason
+	a.dispute.Status = paymententity.PaymentDisputeStatusOpened
+	a.dispute.EvidenceDueBy = data.EvidenceDueBy
+	a.dispute.OpenedAt = normalizePaymentTime(data.OpenedAt)
+	a.dispute.UpdatedAt = a.dispute.OpenedAt
+	return nil
+}
+
+func (a *PaymentDisputeAggregate) applyDisputeStatusChanged(data *PaymentDisputeStatusChangedEvent) error {
+	if data == nil || a.dispute == nil {
+		return nil
+	}
+	a.dispute.Status = data.Status
+	if data.EvidenceDueBy != nil {
+		a.dispute.EvidenceDueBy = data.EvidenceDueBy
+	}
+	a.dispute.UpdatedAt = normalizePaymentTime(data.ChangedAt)
+	return nil
+}
+
+func (a *PaymentDisputeAggregate) applyDisputeEvidenceSubmitted(data *PaymentDisputeEvidenceSubmittedEvent) error {
+	if data == nil || a.dispute == nil {
+		return nil
+	}
+	submittedAt := normalizePaymentTime(data.SubmittedAt)
+	a.dispute.Status = paymententity.PaymentDisputeStatusUnderReview
+	a.dispute.EvidenceSubmittedAt = &submittedAt
+	a.dispute.UpdatedAt = submittedAt
+	return nil
+}
+
+func (a *PaymentDisputeAggregate) applyDisputeClosed(status string, closedAt time.Time) error {
+	if a.dispute == nil {
+		return nil
+	}
+	closedAt = normalizePaymentTime(closedAt)
+	a.dispute.Status = status
+	a.dispute.ClosedAt = &closedAt
+	a.dispute.UpdatedAt = closedAt
+	return nil
+}
+
+func normalizeOptionalTime(value *time.Time) *time.Time {
+	if value == nil || value.IsZero() {
+		return nil
+	}
+	normalized := value.UTC()
+	return &normalized
+}
@@ -0,0 +1,37 @@
+package aggregate
+
+import (
+	"time"
+
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/pkg/event"
+)
+
+type PaymentDisputeOpenedEvent = sharedevents.PaymentDisputeOpenedEvent
+
+type PaymentDisputeWonEvent = sharedevents.PaymentDisputeWonEvent
+
+type PaymentDisputeLostEvent = sharedevents.PaymentDisputeLostEvent
+
+type PaymentDisputeStatusChangedEvent struct {
+	DisputeID         string     `json:"dispute_id"`
+	TransactionID     string     `json:"transaction_id"`
+	PreviousStatus    string     `json:"previous_status"`
+	Status            string     `json:"status"`
+	ProviderEventID   string     `json:"provider_event_id"`
+	ProviderEventType string     `json:"provider_event_type"`
+	EvidenceDueBy     *time.Time `json:"evidence_due_by,omitempty"`
+	ChangedAt         time.Time  `json:"changed_at"`
+}
+
+type PaymentDisputeEvidenceSubmittedEvent struct {
+	DisputeID     string    `json:"dispute_id"`
+	TransactionID string    `json:"transaction_id"`
+	EvidenceIDs   []string  `json:"evidence_ids"`
+	SubmittedAt   time.Time `json:"submitted_at"`
+}
+
+var (
+	EventPaymentDisputeStatusChanged     = event.EventName((*PaymentDisputeStatusChangedEvent)(nil))
+	EventPaymentDisputeEvidenceSubmitted = event.EventName((*PaymentDisputeEvidenceSubmittedEvent)(nil))
+)
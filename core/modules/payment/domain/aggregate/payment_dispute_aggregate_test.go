@@ -0,0 +1,114 @@
+package aggregate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+)
+
+func TestNewPaymentDisputeAggregateFirstSeenLostHoldsThenSettles(t *testing.T) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	intent := newDisputableTopUp(t, now)
+
+	agg, err := NewPaymentDisputeAggregate("dp-1", intent, entity.PaymentDisputeUpdate{
+		ProviderDisputeID: "du_1",
+		Status:            entity.PaymentDisputeStatusLost,
+		Reason:            "fraudulent",
+		Amount:            500,
+	}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	outbox := agg.PendingOutboxEvents()
+	if len(outbox) != 2 {
+		t.Fatalf("expected 2 outbox events, got %d", len(outbox))
+	}
+	if outbox[0].EventName != sharedevents.EventPaymentDisputeOpened || outbox[1].EventName != sharedevents.EventPaymentDisputeLost {
+		t.Fatalf("unexpected event order: %s, %s", outbox[0].EventName, outbox[1].EventName)
+	}
+	opened, ok := outbox[0].EventData.(*sharedevents.PaymentDisputeOpenedEvent)
+	if !ok {
+		t.Fatalf("unexpected payload type: %T", outbox[0].EventData)
+	}
+	if opened.Amount != 100 || opened.AccountID != "wallet:available" {
+		t.Fatalf("expected dispute capped at the top-up amount, got %+v", opened)
+	}
+
+	dispute := agg.Snapshot()
+	if dispute.Status != entity.PaymentDisputeStatusLost || dispute.ClosedAt == nil {
+		t.Fatalf("expected closed lost dispute, got %+v", dispute)
+	}
+}
+
+func TestPaymentDisputeAggregateIgnoresStaleProviderUpdate(t *testing.T) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	agg, err := NewPaymentDisputeAggregate("dp-1", newDisputableTopUp(t, now), entity.PaymentDisputeUpdate{
+		ProviderDisputeID: "du_1",
+		Status:            entity.PaymentDisputeStatusUnderReview,
+	}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	changed, err := agg.ApplyProviderUpdate(entity.PaymentDisputeUpdate{Status: entity.PaymentDisputeStatusNeedsEvidence}, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if changed || agg.Status() != entity.PaymentDisputeStatusUnderReview {
+		t.Fatalf("expected stale update to be ignored, status %s", agg.Status())
+	}
+}
+
+func TestPaymentDisputeAggregateMarkEvidenceSubmittedRejectsPastDeadline(t *testing.T) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	dueBy := now.Add(time.Hour)
+	agg, err := NewPaymentDisputeAggregate("dp-1", newDisputableTopUp(t, now), entity.PaymentDisputeUpdate{
+		ProviderDisputeID: "du_1",
+		Status:            entity.PaymentDisputeStatusNeedsEvidence,
+		EvidenceDueBy:     &dueBy,
+	}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err = agg.MarkEvidenceSubmitted([]string{"ev-1"}, dueBy)
+	if !errors.Is(err, entity.ErrPaymentDisputeNotAcceptingEvidence) {
+		t.Fatalf("expected not accepting evidence error, got %v", err)
+	}
+
+	if err := agg.MarkEvidenceSubmitted([]string{"ev-1"}, now.Add(time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if agg.Status() != entity.PaymentDisputeStatusUnderReview {
+		t.Fatalf("unexpected status: %s", agg.Status())
+	}
+}
+
+func TestNewPaymentDisputeAggregateRejectsPendingTopUp(t *testing.T) {
+	now := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	intent := newDisputableTopUp(t, now)
+	intent.Status = entity.PaymentStatusPending
+
+	_, err := NewPaymentDisputeAggregate("dp-1", intent, entity.PaymentDisputeUpdate{
+		ProviderDisputeID: "du_1",
+		Status:            entity.PaymentDisputeStatusOpened,
+	}, now)
+	if !errors.Is(err, entity.ErrPaymentDisputeIntentNotDisputable) {
+		t.Fatalf("expected not disputable error, got %v", err)
+	}
+}
+
+func newDisputableTopUp(t *testing.T, now time.Time) *entity.PaymentIntent {
+	t.Helper()
+
+	intent, err := entity.NewProviderTopUpIntent("txn-1", "stripe", 100, 0, "VND", "wallet:available", now)
+	if err != nil {
+		t.Fatalf("new provider top up intent: %v", err)
+	}
+	intent.Status = entity.PaymentStatusSuccess
+	return intent
+}
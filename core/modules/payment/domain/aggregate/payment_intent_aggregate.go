@@ -268,7 +268,9 @@ func (a *PaymentIntentAggregate) ApplyProviderOutcome(
 	switch paymententity.NormalizePaymentStatus(result.Status) {
 	case paymententity.PaymentStatusSuccess:
 		return a.applySuccessfulOutcome(result, checkoutURL, emitCheckoutEvent, occurredAt)
-	case paymententity.PaymentStatusRefunded, paymententity.PaymentStatusChargeback:
+	case paymententity.PaymentStatusRefunded:
+		return a.applyRefundOutcome(result, occurredAt)
+	case paymententity.PaymentStatusChargeback:
 		return a.applyReversedOutcome(result, checkoutURL, emitCheckoutEvent, occurredAt)
 	default:
 		return a.applyNonFinalOutcome(result, checkoutURL, emitCheckoutEvent, occurredAt)
@@ -369,6 +371,49 @@ func (a *PaymentIntentAggregate) applySuccessfulOutcome(
 	return PaymentIntentMutation{Persist: true}, nil
 }
 
+// applyRefundOutcome records one refund of a successful payment. A refund
+// with an id refunds its own amount, once per id; one without settles what is
+// left, as providers report a payment refunded once nothing remains. The
+// intent only turns REFUNDED when the whole provider amount is refunded.
+func (a *PaymentIntentAggregate) applyRefundOutcome(
+	result paymententity.PaymentProviderResult,
+	occurredAt time.Time,
+) (PaymentIntentMutation, error) {
+	normalizePaymentIntentSnapshot(a.intent)
+	if err := a.validateProviderResultForStatus(paymententity.PaymentStatusRefunded, result.Amount, result.Currency); err != nil {
+		return PaymentIntentMutation{}, stackErr.Error(err)
+	}
+	remaining := a.intent.RefundableAmount()
+	if a.intent.Status != paymententity.PaymentStatusSuccess || remaining <= 0 {
+		return PaymentIntentMutation{Duplicate: true}, nil
+	}
+
+	refund := a.currentProviderResult(result)
+	refund.Status = paymententity.PaymentStatusRefunded
+	refund.Amount = remaining
+	if refund.RefundID != "" && result.Amount != 0 {
+		if result.Amount > remaining {
+			return PaymentIntentMutation{}, stackErr.Error(paymententity.ErrPaymentRefundAmountInvalid)
+		}
+		refund.Amount = result.Amount
+	}
+
+	processedEvent, err := paymententity.NewProcessedPaymentEvent(
+		a.intent.Provider,
+		a.intent.RefundIdempotencyKey(refund.RefundID),
+		a.intent.TransactionID,
+		occurredAt,
+	)
+	if err != nil {
+		return PaymentIntentMutation{}, stackErr.Error(err)
+	}
+	a.recordProcessedEvent(processedEvent)
+	if err := a.recordPaymentRefunded(refund, occurredAt); err != nil {
+		return PaymentIntentMutation{}, stackErr.Error(err)
+	}
+	return PaymentIntentMutation{Persist: true}, nil
+}
+
 func (a *PaymentIntentAggregate) applyReversedOutcome(
 	result paymententity.PaymentProviderResult,
 	checkoutURL string,
@@ -385,15 +430,6 @@ func (a *PaymentIntentAggregate) applyReversedOutcome(
 
 	var processedEvent *paymententity.ProcessedPaymentEvent
 	switch transition.Type {
-	case paymententity.PaymentTransitionRefunded:
-		processedEvent, err = a.newProcessedTransitionEvent(sharedevents.EventPaymentRefunded, occurredAt)
-		if err != nil {
-			return PaymentIntentMutation{}, stackErr.Error(err)
-		}
-		a.recordProcessedEvent(processedEvent)
-		if err := a.recordPaymentRefunded(a.currentProviderResult(result), occurredAt); err != nil {
-			return PaymentIntentMutation{}, stackErr.Error(err)
-		}
 	case paymententity.PaymentTransitionChargeback:
 		processedEvent, err = a.newProcessedTransitionEvent(sharedevents.EventPaymentChargeback, occurredAt)
 		if err != nil {
@@ -551,12 +587,14 @@ func (a *PaymentIntentAggregate) recordPaymentRefunded(result paymententity.Paym
 		ProviderEventID:    strings.TrimSpace(result.EventID),
 		ProviderEventType:  strings.TrimSpace(result.EventType),
 		ProviderPaymentRef: coalescePaymentValue(result.ExternalRef, a.intent.ExternalRef),
-		Amount:             paymentResultAmountOrDefault(result.Amount, a.intent.Amount),
+		RefundID:           result.RefundID,
+		Amount:             result.Amount,
+		RefundedAmount:     a.intent.RefundedAmount + result.Amount,
 		FeeAmount:          a.intent.FeeAmount,
 		ProviderAmount:     a.intent.ProviderAmount,
 		Currency:           a.intent.Currency,
 		CreditAccountID:    a.intent.CreditAccountID,
-		IdempotencyKey:     a.transitionIdempotencyKey(sharedevents.EventPaymentRefunded),
+		IdempotencyKey:     a.intent.RefundIdempotencyKey(result.RefundID),
 		RefundedAt:         occurredAt,
 	}, occurredAt)
 }
@@ -577,6 +615,7 @@ func (a *PaymentIntentAggregate) recordPaymentChargeback(result paymententity.Pa
 		ProviderAmount:     a.intent.ProviderAmount,
 		Currency:           a.intent.Currency,
 		CreditAccountID:    a.intent.CreditAccountID,
+		DisputeID:          strings.TrimSpace(result.DisputeID),
 		IdempotencyKey:     a.transitionIdempotencyKey(sharedevents.EventPaymentChargeback),
 		ChargedBackAt:      occurredAt,
 	}, occurredAt)
@@ -686,7 +725,12 @@ func (a *PaymentIntentAggregate) applyPaymentRefunded(data *PaymentRefundedEvent
 	if data == nil || a.intent == nil {
 		return nil
 	}
-	a.intent.Status = paymententity.PaymentStatusRefunded
+	// Events from before partial refunds carry no total and refund it all.
+	a.intent.RefundedAmount = data.RefundedAmount
+	if a.intent.RefundedAmount <= 0 || a.intent.RefundedAmount >= a.intent.ProviderAmount {
+		a.intent.RefundedAmount = a.intent.ProviderAmount
+		a.intent.Status = paymententity.PaymentStatusRefunded
+	}
 	if ref := strings.TrimSpace(data.ProviderPaymentRef); ref != "" {
 		a.intent.ExternalRef = ref
 	}
@@ -878,6 +922,8 @@ func (a *PaymentIntentAggregate) currentProviderResult(source paymententity.Paym
 		Amount:        amount,
 		Currency:      coalescePaymentValue(source.Currency, a.intent.Currency),
 		ExternalRef:   coalescePaymentValue(source.ExternalRef, a.intent.ExternalRef),
+		DisputeID:     strings.TrimSpace(source.DisputeID),
+		RefundID:      strings.TrimSpace(source.RefundID),
 	}
 }
 
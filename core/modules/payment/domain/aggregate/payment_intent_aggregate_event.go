@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/pkg/event"
 )
 
 type PaymentCreatedEvent = sharedevents.PaymentCreatedEvent
@@ -34,3 +35,5 @@ type PaymentProviderStateChangedEvent struct {
 	StateChanged       bool      `json:"state_changed"`
 	ExternalRefChanged bool      `json:"external_ref_changed"`
 }
+
+var EventPaymentProviderStateChanged = event.EventName((*PaymentProviderStateChangedEvent)(nil))
@@ -262,3 +262,66 @@ func TestPaymentIntentAggregateIgnoresLateFailureAfterSuccess(t *testing.T) {
 		t.Fatalf("unexpected status: %s", agg.Status())
 	}
 }
+
+func TestPaymentIntentAggregatePartialRefundsStaySuccessfulUntilFullyRefunded(t *testing.T) {
+	intent, err := entity.NewProviderTopUpIntent("txn-1", "stripe", 100, 10, "VND", "wallet:available", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	agg, err := RestorePaymentIntentAggregate(intent)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := agg.ApplyProviderOutcome(entity.PaymentProviderResult{
+		Status:   entity.PaymentStatusSuccess,
+		Amount:   110,
+		Currency: "VND",
+	}, "", false, time.Now().UTC()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	agg.MarkPersisted()
+
+	refund := entity.PaymentProviderResult{Status: entity.PaymentStatusRefunded, Amount: 40, Currency: "VND", RefundID: "re-1"}
+	mutation, err := agg.ApplyProviderOutcome(refund, "", false, time.Now().UTC())
+	if err != nil || !mutation.Persist {
+		t.Fatalf("expected partial refund to persist, got %+v, %v", mutation, err)
+	}
+	if agg.Status() != entity.PaymentStatusSuccess || agg.Snapshot().RefundedAmount != 40 {
+		t.Fatalf("expected successful intent with 40 refunded, got %s with %d", agg.Status(), agg.Snapshot().RefundedAmount)
+	}
+	processed := agg.PendingProcessedEvents()
+	if len(processed) != 1 || processed[0].IdempotencyKey != sharedevents.EventPaymentRefunded+":txn-1:re-1" {
+		t.Fatalf("expected refund to be keyed by its id, got %+v", processed)
+	}
+	outbox := agg.PendingOutboxEvents()
+	payload, ok := outbox[len(outbox)-1].EventData.(*sharedevents.PaymentRefundedEvent)
+	if !ok || payload.Amount != 40 || payload.RefundedAmount != 40 || payload.RefundID != "re-1" {
+		t.Fatalf("unexpected refund event: %+v", outbox[len(outbox)-1].EventData)
+	}
+	agg.MarkPersisted()
+
+	// The provider reports the payment refunded once nothing is left, which
+	// settles the remainder whatever total it carries.
+	mutation, err = agg.ApplyProviderOutcome(entity.PaymentProviderResult{
+		Status:   entity.PaymentStatusRefunded,
+		Amount:   110,
+		Currency: "VND",
+	}, "", false, time.Now().UTC())
+	if err != nil || !mutation.Persist {
+		t.Fatalf("expected final refund to persist, got %+v, %v", mutation, err)
+	}
+	if agg.Status() != entity.PaymentStatusRefunded || agg.Snapshot().RefundedAmount != 110 {
+		t.Fatalf("expected refunded intent, got %s with %d", agg.Status(), agg.Snapshot().RefundedAmount)
+	}
+	outbox = agg.PendingOutboxEvents()
+	payload = outbox[len(outbox)-1].EventData.(*sharedevents.PaymentRefundedEvent)
+	if payload.Amount != 70 || payload.RefundedAmount != 110 {
+		t.Fatalf("expected remaining 70 to be refunded, got %+v", payload)
+	}
+	agg.MarkPersisted()
+
+	mutation, err = agg.ApplyProviderOutcome(refund, "", false, time.Now().UTC())
+	if err != nil || !mutation.Duplicate || mutation.Persist {
+		t.Fatalf("expected refund of a refunded intent to be a duplicate, got %+v, %v", mutation, err)
+	}
+}
@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	PaymentDisputeStatusOpened        = "OPENED"
+	PaymentDisputeStatusNeedsEvidence = "NEEDS_EVIDENCE"
+	PaymentDisputeStatusUnderReview   = "UNDER_REVIEW"
+	PaymentDisputeStatusWon           = "WON"
+	PaymentDisputeStatusLost          = "LOST"
+
+	PaymentDisputeEvidenceReceipt               = "RECEIPT"
+	PaymentDisputeEvidenceCustomerCommunication = "CUSTOMER_COMMUNICATION"
+	PaymentDisputeEvidenceServiceDocumentation  = "SERVICE_DOCUMENTATION"
+	PaymentDisputeEvidenceRefundPolicy          = "REFUND_POLICY"
+	PaymentDisputeEvidenceUncategorized         = "UNCATEGORIZED"
+)
+
+var (
+	ErrPaymentDisputeStatusInvalid        = errors.New("dispute status is invalid")
+	ErrPaymentDisputeClosed               = errors.New("dispute is already closed")
+	ErrPaymentDisputeNotAcceptingEvidence = errors.New("dispute is not accepting evidence")
+	ErrPaymentDisputeEvidenceRequired     = errors.New("at least one evidence file is required")
+	ErrPaymentDisputeEvidenceKindInvalid  = errors.New("evidence kind is invalid")
+	ErrPaymentDisputeEvidenceFileInvalid  = errors.New("evidence file_name and content are required")
+	ErrPaymentDisputeIntentNotDisputable  = errors.New("only successful top-ups can be disputed")
+)
+
+// PaymentDispute is a cardholder's challenge of a settled top-up. While it is
+// open the ledger holds the disputed amount away from the account; a won
+// dispute releases the hold and a lost one turns it into a chargeback.
+type PaymentDispute struct {
+	ID                  string
+	Provider            string
+	ProviderDisputeID   string
+	TransactionID       string
+	AccountID           string
+	Currency            string
+	Amount              int64
+	Reason              string
+	Status              string
+	EvidenceDueBy       *time.Time
+	EvidenceSubmittedAt *time.Time
+	OpenedAt            time.Time
+	UpdatedAt           time.Time
+	ClosedAt            *time.Time
+}
+
+// PaymentDisputeEvidence is one file uploaded for a dispute. SubmittedAt is
+// set once the file was sent to the provider.
+type PaymentDisputeEvidence struct {
+	ID          string
+	DisputeID   string
+	Kind        string
+	FileName    string
+	ContentType string
+	ObjectKey   string
+	Size        int64
+	UploadedBy  string
+	SubmittedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// PaymentDisputeUpdate is a provider's view of a dispute taken from a
+// webhook. TransactionID or ExternalRef locate the disputed payment.
+type PaymentDisputeUpdate struct {
+	ProviderDisputeID string
+	TransactionID     string
+	ExternalRef       string
+	EventID           string
+	EventType         string
+	Status            string
+	Reason            string
+	Amount            int64
+	Currency          string
+	EvidenceDueBy     *time.Time
+}
+
+func NormalizePaymentDisputeStatus(status string) (string, error) {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	switch status {
+	case PaymentDisputeStatusOpened,
+		PaymentDisputeStatusNeedsEvidence,
+		PaymentDisputeStatusUnderReview,
+		PaymentDisputeStatusWon,
+		PaymentDisputeStatusLost:
+		return status, nil
+	default:
+		return "", ErrPaymentDisputeStatusInvalid
+	}
+}
+
+func NormalizePaymentDisputeEvidenceKind(kind string) (string, error) {
+	kind = strings.ToUpper(strings.TrimSpace(kind))
+	switch kind {
+	case "":
+		return PaymentDisputeEvidenceUncategorized, nil
+	case PaymentDisputeEvidenceReceipt,
+		PaymentDisputeEvidenceCustomerCommunication,
+		PaymentDisputeEvidenceServiceDocumentation,
+		PaymentDisputeEvidenceRefundPolicy,
+		PaymentDisputeEvidenceUncategorized:
+		return kind, nil
+	default:
+		return "", ErrPaymentDisputeEvidenceKindInvalid
+	}
+}
+
+// CanDispute reports whether a dispute can be opened against the intent.
+// Only credited top-ups hold funds the ledger can set aside.
+func (p *PaymentIntent) CanDispute() error {
+	if p == nil || !p.IsTopUp() || !p.IsSucceeded() {
+		return ErrPaymentDisputeIntentNotDisputable
+	}
+	return nil
+}
+
+func (d *PaymentDispute) IsClosed() bool {
+	return d.Status == PaymentDisputeStatusWon || d.Status == PaymentDisputeStatusLost
+}
+
+// AcceptsEvidence reports whether evidence can still be submitted: the
+// provider has not started its review and the deadline, if any, has not
+// passed.
+func (d *PaymentDispute) AcceptsEvidence(now time.Time) error {
+	if d.IsClosed() {
+		return ErrPaymentDisputeClosed
+	}
+	if d.Status != PaymentDisputeStatusOpened && d.Status != PaymentDisputeStatusNeedsEvidence {
+		return ErrPaymentDisputeNotAcceptingEvidence
+	}
+	if d.EvidenceDueBy != nil && !now.Before(*d.EvidenceDueBy) {
+		return ErrPaymentDisputeNotAcceptingEvidence
+	}
+	return nil
+}
+
+// AdvancesTo reports whether moving to status moves the dispute forward.
+// Providers deliver webhooks out of order, so a status at or behind the
+// current stage is ignored, and closed disputes never change.
+func (d *PaymentDispute) AdvancesTo(status string) bool {
+	if d.IsClosed() {
+		return false
+	}
+	return paymentDisputeStage(status) > paymentDisputeStage(d.Status)
+}
+
+func paymentDisputeStage(status string) int {
+	switch status {
+	case PaymentDisputeStatusNeedsEvidence:
+		return 1
+	case PaymentDisputeStatusUnderReview:
+		return 2
+	case PaymentDisputeStatusWon, PaymentDisputeStatusLost:
+		return 3
+	default:
+		return 0
+	}
+}
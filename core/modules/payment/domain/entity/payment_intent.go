@@ -43,6 +43,9 @@ type PaymentTransition struct {
 	Ignored            bool
 }
 
+// PaymentIntent is one payment through a provider. RefundedAmount is how much
+// of ProviderAmount has been refunded so far; the intent stays SUCCESS until
+// all of it has been.
 type PaymentIntent struct {
 	Workflow             string
 	TransactionID        string
@@ -52,6 +55,7 @@ type PaymentIntent struct {
 	Amount               int64
 	FeeAmount            int64
 	ProviderAmount       int64
+	RefundedAmount       int64
 	Currency             string
 	ClearingAccountKey   string
 	DebitAccountID       string
@@ -69,6 +73,11 @@ type PaymentProviderResult struct {
 	Amount        int64
 	Currency      string
 	ExternalRef   string
+	// DisputeID links a chargeback to the lost dispute whose hold it settles.
+	DisputeID string
+	// RefundID identifies one refund of a payment that may be refunded in
+	// parts. A refund without one settles whatever has not been refunded yet.
+	RefundID string
 }
 
 type ProcessedPaymentEvent struct {
@@ -77,3 +86,30 @@ type ProcessedPaymentEvent struct {
 	TransactionID  string
 	CreatedAt      time.Time
 }
+
+const (
+	PaymentIdempotencyScopeWithdrawal = "withdrawal"
+	PaymentIdempotencyScopeRefund     = "refund"
+)
+
+// PaymentIdempotencyKey binds a client-supplied key to the intent the first
+// request with that key acted on, per account and scope.
+type PaymentIdempotencyKey struct {
+	AccountID     string
+	Scope         string
+	Key           string
+	TransactionID string
+	// ResultAmount is what the request settled, recorded once it completes;
+	// zero while it has not.
+	ResultAmount int64
+	CreatedAt    time.Time
+}
+
+// PaymentStatusChange is one step of an intent's status history, rebuilt from
+// the events the intent published.
+type PaymentStatusChange struct {
+	EventName  string
+	Status     string
+	Amount     int64
+	OccurredAt time.Time
+}
@@ -29,6 +29,7 @@ var (
 	ErrPaymentProcessedProviderRequired  = errors.New("provider is required")
 	ErrPaymentProcessedKeyRequired       = errors.New("idempotency_key is required")
 	ErrPaymentProcessedTxnRequired       = errors.New("transaction_id is required")
+	ErrPaymentRefundAmountInvalid        = errors.New("refund amount must not exceed what is left to refund")
 )
 
 const (
@@ -181,6 +182,58 @@ func NormalizePaymentStatusOrPending(status string) string {
 	return PaymentStatusPending
 }
 
+// IsOwnedBy reports whether accountID is the customer side of the intent: the
+// credited account of a top-up or the debited account of a withdrawal.
+func (p *PaymentIntent) IsOwnedBy(accountID string) bool {
+	if p == nil {
+		return false
+	}
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		return false
+	}
+	switch p.Workflow {
+	case PaymentWorkflowWithdrawal:
+		return p.DebitAccountID == accountID
+	default:
+		return p.CreditAccountID == accountID
+	}
+}
+
+// RefundAmount resolves a requested refund against what the provider
+// collected and has not refunded yet; zero asks for all of it.
+func (p *PaymentIntent) RefundAmount(requested int64) (int64, error) {
+	if p == nil {
+		return 0, ErrPaymentTransactionIDRequired
+	}
+	remaining := p.RefundableAmount()
+	switch {
+	case remaining <= 0 || requested < 0 || requested > remaining:
+		return 0, ErrPaymentRefundAmountInvalid
+	case requested == 0:
+		return remaining, nil
+	}
+	return requested, nil
+}
+
+// RefundableAmount is the part of the provider amount not refunded yet.
+func (p *PaymentIntent) RefundableAmount() int64 {
+	if p == nil || p.RefundedAmount >= p.ProviderAmount {
+		return 0
+	}
+	return p.ProviderAmount - p.RefundedAmount
+}
+
+// RefundIdempotencyKey keys the processed event of one refund. A refund
+// without an id settles the remainder, so it keeps the per-payment key.
+func (p *PaymentIntent) RefundIdempotencyKey(refundID string) string {
+	key := p.TransitionIdempotencyKey(sharedevents.EventPaymentRefunded)
+	if refundID = strings.TrimSpace(refundID); refundID != "" {
+		key += ":" + refundID
+	}
+	return key
+}
+
 func (p *PaymentIntent) SetProviderState(externalRef, status string, updatedAt time.Time) error {
 	_, err := p.transitionProviderState(externalRef, status, updatedAt)
 	return err
@@ -522,6 +575,10 @@ func (p *PaymentIntent) BuildRefundedEventData(result PaymentProviderResult, occ
 	p.ensureWorkflowDefaults()
 	eventTime := normalizePaymentTime(occurredAt)
 	current := p.CurrentProviderResult(result)
+	amount := p.RefundableAmount()
+	if current.RefundID != "" && result.Amount != 0 {
+		amount = result.Amount
+	}
 	return sharedevents.PaymentRefundedEvent{
 		Workflow:           p.Workflow,
 		PaymentID:          p.TransactionID,
@@ -532,12 +589,14 @@ func (p *PaymentIntent) BuildRefundedEventData(result PaymentProviderResult, occ
 		ProviderEventID:    strings.TrimSpace(current.EventID),
 		ProviderEventType:  strings.TrimSpace(current.EventType),
 		ProviderPaymentRef: coalescePaymentValue(current.ExternalRef, p.ExternalRef),
-		Amount:             paymentResultAmountOrDefault(current.Amount, p.Amount),
+		RefundID:           current.RefundID,
+		Amount:             amount,
+		RefundedAmount:     p.RefundedAmount + amount,
 		FeeAmount:          p.FeeAmount,
 		ProviderAmount:     p.ProviderAmount,
 		Currency:           p.Currency,
 		CreditAccountID:    p.CreditAccountID,
-		IdempotencyKey:     p.TransitionIdempotencyKey(sharedevents.EventPaymentRefunded),
+		IdempotencyKey:     p.RefundIdempotencyKey(current.RefundID),
 		RefundedAt:         eventTime,
 	}
 }
@@ -675,5 +734,6 @@ func (p *PaymentIntent) CurrentProviderResult(source PaymentProviderResult) Paym
 		Amount:        amount,
 		Currency:      coalescePaymentValue(source.Currency, p.Currency),
 		ExternalRef:   coalescePaymentValue(source.ExternalRef, p.ExternalRef),
+		RefundID:      strings.TrimSpace(source.RefundID),
 	}
 }
@@ -311,3 +311,53 @@ func TestPaymentIntentBuildsEventData(t *testing.T) {
 		t.Fatalf("unexpected event replay idempotency key: %s", legacyProcessedEvent.IdempotencyKey)
 	}
 }
+
+func TestPaymentIntentOwnershipFollowsWorkflow(t *testing.T) {
+	now := time.Now().UTC()
+	topUp, err := NewProviderTopUpIntent("txn-1", "stripe", 100, 10, "USD", "acc-1", now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	withdrawal, err := NewProviderWithdrawalIntent("txn-2", "stripe", 100, 10, "USD", "acct_dest", "acc-1", now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !topUp.IsOwnedBy("acc-1") || topUp.IsOwnedBy("acc-2") || topUp.IsOwnedBy("") {
+		t.Fatalf("expected top-up to belong to its credited account only")
+	}
+	if !withdrawal.IsOwnedBy("acc-1") || withdrawal.IsOwnedBy("acct_dest") {
+		t.Fatalf("expected withdrawal to belong to its debited account only")
+	}
+}
+
+func TestPaymentIntentRefundAmount(t *testing.T) {
+	intent, err := NewProviderTopUpIntent("txn-1", "stripe", 100, 10, "USD", "acc-1", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if amount, err := intent.RefundAmount(0); err != nil || amount != 110 {
+		t.Fatalf("expected full refund of 110, got %d, %v", amount, err)
+	}
+	if amount, err := intent.RefundAmount(40); err != nil || amount != 40 {
+		t.Fatalf("expected partial refund of 40, got %d, %v", amount, err)
+	}
+	for _, requested := range []int64{-1, 111} {
+		if _, err := intent.RefundAmount(requested); !errors.Is(err, ErrPaymentRefundAmountInvalid) {
+			t.Fatalf("expected invalid refund amount for %d, got %v", requested, err)
+		}
+	}
+
+	intent.RefundedAmount = 40
+	if amount, err := intent.RefundAmount(0); err != nil || amount != 70 {
+		t.Fatalf("expected remaining refund of 70, got %d, %v", amount, err)
+	}
+	if _, err := intent.RefundAmount(71); !errors.Is(err, ErrPaymentRefundAmountInvalid) {
+		t.Fatalf("expected refund beyond the remainder to be rejected, got %v", err)
+	}
+	intent.RefundedAmount = 110
+	if _, err := intent.RefundAmount(0); !errors.Is(err, ErrPaymentRefundAmountInvalid) {
+		t.Fatalf("expected fully refunded intent to reject refunds, got %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ReconciliationKindMissingAtProvider    = "MISSING_AT_PROVIDER"
+	ReconciliationKindMissingIntent        = "MISSING_INTENT"
+	ReconciliationKindMissingLedgerPosting = "MISSING_LEDGER_POSTING"
+	ReconciliationKindDuplicate            = "DUPLICATE"
+	ReconciliationKindAmountMismatch       = "AMOUNT_MISMATCH"
+	ReconciliationKindLedgerAmountMismatch = "LEDGER_AMOUNT_MISMATCH"
+
+	ReconciliationStatusOpen     = "OPEN"
+	ReconciliationStatusResolved = "RESOLVED"
+	ReconciliationStatusIgnored  = "IGNORED"
+)
+
+var (
+	ErrReconciliationReviewStatusInvalid = errors.New("review status must be RESOLVED or IGNORED")
+	ErrReconciliationAlreadyReviewed     = errors.New("discrepancy was already reviewed")
+)
+
+// PaymentSettlement is a provider-side money movement taken from the
+// provider's settlement report.
+type PaymentSettlement struct {
+	Provider      string
+	TransactionID string
+	ExternalRef   string
+	Workflow      string
+	Status        string
+	Amount        int64
+	Currency      string
+	CreatedAt     time.Time
+}
+
+type PaymentReconciliationDiscrepancy struct {
+	ID             string
+	Provider       string
+	Kind           string
+	TransactionID  string
+	ExternalRef    string
+	Workflow       string
+	ExpectedAmount int64
+	ActualAmount   int64
+	Currency       string
+	Detail         string
+	Status         string
+	ReviewNote     string
+	ReviewedBy     string
+	ReviewedAt     *time.Time
+	DetectedAt     time.Time
+	LastDetectedAt time.Time
+	UpdatedAt      time.Time
+}
+
+// Key identifies the same discrepancy across reconciliation runs so a
+// re-run refreshes the existing row instead of reporting it again.
+func (d *PaymentReconciliationDiscrepancy) Key() string {
+	reference := d.TransactionID
+	if reference == "" {
+		reference = d.ExternalRef
+	}
+	return fmt.Sprintf("%s:%s:%s", d.Kind, d.Provider, reference)
+}
+
+func (d *PaymentReconciliationDiscrepancy) Review(status, note, reviewerID string, now time.Time) error {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	if status != ReconciliationStatusResolved && status != ReconciliationStatusIgnored {
+		return ErrReconciliationReviewStatusInvalid
+	}
+	if d.Status != ReconciliationStatusOpen {
+		return ErrReconciliationAlreadyReviewed
+	}
+
+	reviewedAt := now.UTC()
+	d.Status = status
+	d.ReviewNote = strings.TrimSpace(note)
+	d.ReviewedBy = strings.TrimSpace(reviewerID)
+	d.ReviewedAt = &reviewedAt
+	d.UpdatedAt = reviewedAt
+	return nil
+}
+
+func IsSettledPaymentStatus(status string) bool {
+	switch NormalizePaymentStatus(status) {
+	case PaymentStatusSuccess, PaymentStatusRefunded, PaymentStatusChargeback:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReconcileSettlements matches a provider's settlement report against the
+// payment intents it should cover. Settled intents need exactly one settled
+// provider record with the same amount and currency; settled provider records
+// need a settled intent.
+func ReconcileSettlements(provider string, intents []*PaymentIntent, settlements []PaymentSettlement, now time.Time) []*PaymentReconciliationDiscrepancy {
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	now = now.UTC()
+
+	intentsByID := make(map[string]*PaymentIntent, len(intents))
+	for _, intent := range intents {
+		if intent != nil {
+			intentsByID[intent.TransactionID] = intent
+		}
+	}
+
+	settledByID := make(map[string][]PaymentSettlement)
+	discrepancies := make([]*PaymentReconciliationDiscrepancy, 0)
+	for _, settlement := range settlements {
+		if !IsSettledPaymentStatus(settlement.Status) {
+			continue
+		}
+		transactionID := strings.TrimSpace(settlement.TransactionID)
+		if transactionID == "" {
+			discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindMissingIntent, nil, &settlement, 0, settlement.Amount,
+				"provider record has no transaction_id", now))
+			continue
+		}
+		settledByID[transactionID] = append(settledByID[transactionID], settlement)
+	}
+
+	transactionIDs := make([]string, 0, len(settledByID))
+	for transactionID := range settledByID {
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+	sort.Strings(transactionIDs)
+
+	for _, transactionID := range transactionIDs {
+		records := settledByID[transactionID]
+		intent := intentsByID[transactionID]
+		switch {
+		case intent == nil:
+			discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindMissingIntent, nil, &records[0], 0, sumSettlements(records),
+				"no payment intent exists for the provider record", now))
+		case !IsSettledPaymentStatus(intent.Status):
+			discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindMissingIntent, intent, &records[0], intent.ProviderAmount, sumSettlements(records),
+				fmt.Sprintf("provider settled the payment but the intent is %s", intent.Status), now))
+		case len(records) > 1:
+			discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindDuplicate, intent, &records[0], intent.ProviderAmount, sumSettlements(records),
+				fmt.Sprintf("provider reported %d settled records", len(records)), now))
+		case records[0].Amount != intent.ProviderAmount || !strings.EqualFold(records[0].Currency, intent.Currency):
+			discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindAmountMismatch, intent, &records[0], intent.ProviderAmount, records[0].Amount,
+				fmt.Sprintf("provider settled %d %s", records[0].Amount, strings.ToUpper(records[0].Currency)), now))
+		}
+	}
+
+	sortedIntents := make([]*PaymentIntent, 0, len(intentsByID))
+	for _, intent := range intentsByID {
+		sortedIntents = append(sortedIntents, intent)
+	}
+	sort.Slice(sortedIntents, func(i, j int) bool {
+		return sortedIntents[i].TransactionID < sortedIntents[j].TransactionID
+	})
+	for _, intent := range sortedIntents {
+		if !IsSettledPaymentStatus(intent.Status) || len(settledByID[intent.TransactionID]) > 0 {
+			continue
+		}
+		discrepancies = append(discrepancies, newDiscrepancy(provider, ReconciliationKindMissingAtProvider, intent, nil, intent.ProviderAmount, 0,
+			fmt.Sprintf("intent is %s but the provider has no settled record", intent.Status), now))
+	}
+
+	return discrepancies
+}
+
+// ReconcileLedgerPosting compares a settled intent with the principal posted
+// to its provider clearing account. It returns nil when they agree.
+func ReconcileLedgerPosting(intent *PaymentIntent, postedAmount int64, posted bool, now time.Time) *PaymentReconciliationDiscrepancy {
+	if intent == nil || !IsSettledPaymentStatus(intent.Status) {
+		return nil
+	}
+	if !posted {
+		return newDiscrepancy(intent.Provider, ReconciliationKindMissingLedgerPosting, intent, nil, intent.Amount, 0,
+			fmt.Sprintf("no posting on clearing account %s", intent.ClearingAccountKey), now.UTC())
+	}
+	if postedAmount != intent.Amount {
+		return newDiscrepancy(intent.Provider, ReconciliationKindLedgerAmountMismatch, intent, nil, intent.Amount, postedAmount,
+			fmt.Sprintf("clearing account %s posted %d", intent.ClearingAccountKey, postedAmount), now.UTC())
+	}
+	return nil
+}
+
+func newDiscrepancy(
+	provider string,
+	kind string,
+	intent *PaymentIntent,
+	settlement *PaymentSettlement,
+	expectedAmount int64,
+	actualAmount int64,
+	detail string,
+	now time.Time,
+) *PaymentReconciliationDiscrepancy {
+	discrepancy := &PaymentReconciliationDiscrepancy{
+		Provider:       provider,
+		Kind:           kind,
+		ExpectedAmount: expectedAmount,
+		ActualAmount:   actualAmount,
+		Detail:         detail,
+		Status:         ReconciliationStatusOpen,
+		DetectedAt:     now,
+		LastDetectedAt: now,
+		UpdatedAt:      now,
+	}
+	if settlement != nil {
+		discrepancy.TransactionID = strings.TrimSpace(settlement.TransactionID)
+		discrepancy.ExternalRef = strings.TrimSpace(settlement.ExternalRef)
+		discrepancy.Workflow = NormalizePaymentWorkflow(settlement.Workflow)
+		discrepancy.Currency = strings.ToUpper(strings.TrimSpace(settlement.Currency))
+	}
+	if intent != nil {
+		discrepancy.TransactionID = intent.TransactionID
+		discrepancy.Workflow = intent.Workflow
+		discrepancy.Currency = intent.Currency
+		if discrepancy.ExternalRef == "" {
+			discrepancy.ExternalRef = intent.ExternalRef
+		}
+	}
+	return discrepancy
+}
+
+func sumSettlements(records []PaymentSettlement) int64 {
+	var total int64
+	for _, record := range records {
+		total += record.Amount
+	}
+	return total
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconcileSettlementsReportsEachDiscrepancyKind(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	settledIntent := func(transactionID string, amount int64) *PaymentIntent {
+		intent, err := NewProviderTopUpIntent(transactionID, "mock", amount, 0, "VND", "acc-1", now)
+		if err != nil {
+			t.Fatalf("new intent: %v", err)
+		}
+		intent.Status = PaymentStatusSuccess
+		return intent
+	}
+
+	pending := settledIntent("txn-pending", 100)
+	pending.Status = PaymentStatusPending
+	intents := []*PaymentIntent{
+		settledIntent("txn-ok", 100),
+		settledIntent("txn-missing", 100),
+		settledIntent("txn-dup", 100),
+		settledIntent("txn-amount", 100),
+		pending,
+	}
+	settlements := []PaymentSettlement{
+		{TransactionID: "txn-ok", Status: PaymentStatusSuccess, Amount: 100, Currency: "vnd"},
+		{TransactionID: "txn-dup", ExternalRef: "ch_1", Status: PaymentStatusSuccess, Amount: 100, Currency: "VND"},
+		{TransactionID: "txn-dup", ExternalRef: "ch_2", Status: PaymentStatusSuccess, Amount: 100, Currency: "VND"},
+		{TransactionID: "txn-amount", Status: PaymentStatusSuccess, Amount: 90, Currency: "VND"},
+		{TransactionID: "txn-pending", Status: PaymentStatusSuccess, Amount: 100, Currency: "VND"},
+		{TransactionID: "txn-unknown", Status: PaymentStatusSuccess, Amount: 50, Currency: "VND"},
+		{TransactionID: "txn-failed", Status: PaymentStatusFailed, Amount: 70, Currency: "VND"},
+	}
+
+	discrepancies := ReconcileSettlements("MOCK", intents, settlements, now)
+
+	got := make(map[string]*PaymentReconciliationDiscrepancy, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		got[discrepancy.TransactionID] = discrepancy
+	}
+	expected := map[string]string{
+		"txn-missing": ReconciliationKindMissingAtProvider,
+		"txn-dup":     ReconciliationKindDuplicate,
+		"txn-amount":  ReconciliationKindAmountMismatch,
+		"txn-pending": ReconciliationKindMissingIntent,
+		"txn-unknown": ReconciliationKindMissingIntent,
+	}
+	if len(discrepancies) != len(expected) {
+		t.Fatalf("expected %d discrepancies, got %d", len(expected), len(discrepancies))
+	}
+	for transactionID, kind := range expected {
+		discrepancy, ok := got[transactionID]
+		if !ok {
+			t.Fatalf("expected discrepancy for %s", transactionID)
+		}
+		if discrepancy.Kind != kind {
+			t.Fatalf("expected %s for %s, got %s", kind, transactionID, discrepancy.Kind)
+		}
+		if discrepancy.Provider != "mock" || discrepancy.Status != ReconciliationStatusOpen {
+			t.Fatalf("unexpected provider or status for %s: %s %s", transactionID, discrepancy.Provider, discrepancy.Status)
+		}
+	}
+	if got["txn-dup"].ActualAmount != 200 {
+		t.Fatalf("expected duplicate to sum provider amounts, got %d", got["txn-dup"].ActualAmount)
+	}
+	if got["txn-amount"].ExpectedAmount != 100 || got["txn-amount"].ActualAmount != 90 {
+		t.Fatalf("unexpected amounts for mismatch: %d vs %d", got["txn-amount"].ExpectedAmount, got["txn-amount"].ActualAmount)
+	}
+}
+
+func TestReconcileLedgerPostingComparesPrincipal(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	intent, err := NewProviderTopUpIntent("txn-1", "mock", 100, 5, "VND", "acc-1", now)
+	if err != nil {
+		t.Fatalf("new intent: %v", err)
+	}
+	intent.Status = PaymentStatusSuccess
+
+	if discrepancy := ReconcileLedgerPosting(intent, 100, true, now); discrepancy != nil {
+		t.Fatalf("expected matching posting to reconcile, got %s", discrepancy.Kind)
+	}
+	if discrepancy := ReconcileLedgerPosting(intent, 0, false, now); discrepancy == nil || discrepancy.Kind != ReconciliationKindMissingLedgerPosting {
+		t.Fatalf("expected missing ledger posting")
+	}
+	if discrepancy := ReconcileLedgerPosting(intent, 80, true, now); discrepancy == nil || discrepancy.Kind != ReconciliationKindLedgerAmountMismatch {
+		t.Fatalf("expected ledger amount mismatch")
+	}
+}
+
+func TestReviewDiscrepancyOnlyOnce(t *testing.T) {
+	discrepancy := &PaymentReconciliationDiscrepancy{Status: ReconciliationStatusOpen}
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := discrepancy.Review("open", "", "admin-1", now); err != ErrReconciliationReviewStatusInvalid {
+		t.Fatalf("expected invalid review status, got %v", err)
+	}
+	if err := discrepancy.Review("resolved", " refunded manually ", "admin-1", now); err != nil {
+		t.Fatalf("expected review to succeed, got %v", err)
+	}
+	if discrepancy.Status != ReconciliationStatusResolved || discrepancy.ReviewNote != "refunded manually" || discrepancy.ReviewedAt == nil {
+		t.Fatalf("unexpected reviewed discrepancy: %+v", discrepancy)
+	}
+	if err := discrepancy.Review("ignored", "", "admin-2", now); err != ErrReconciliationAlreadyReviewed {
+		t.Fatalf("expected already reviewed error, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	aggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+)
+
+// PaymentDisputeFilter pages disputes most recently opened first; the cursor
+// is the opened_at and id of the last row returned.
+type PaymentDisputeFilter struct {
+	Provider     string
+	Status       string
+	CursorOpened *time.Time
+	CursorID     string
+	Limit        int
+}
+
+//go:generate mockgen -package=repos -destination=payment_dispute_repo_mock.go -source=payment_dispute_repo.go
+type PaymentDisputeRepo interface {
+	// Save stores the dispute and appends its pending events to the payment
+	// outbox.
+	Save(ctx context.Context, dispute *aggregate.PaymentDisputeAggregate) error
+	GetByID(ctx context.Context, id string) (*aggregate.PaymentDisputeAggregate, error)
+	GetByProviderDisputeID(ctx context.Context, provider, providerDisputeID string) (*aggregate.PaymentDisputeAggregate, error)
+	List(ctx context.Context, filter PaymentDisputeFilter) ([]*entity.PaymentDispute, error)
+
+	CreateEvidence(ctx context.Context, evidence *entity.PaymentDisputeEvidence) error
+	ListEvidence(ctx context.Context, disputeID string) ([]*entity.PaymentDisputeEvidence, error)
+	MarkEvidenceSubmitted(ctx context.Context, evidenceIDs []string, submittedAt time.Time) error
+}
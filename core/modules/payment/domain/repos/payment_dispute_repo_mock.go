@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_dispute_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=payment_dispute_repo_mock.go -source=payment_dispute_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	aggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	entity "wechat-clone/core/modules/payment/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentDisputeRepo is a mock of PaymentDisputeRepo interface.
+type MockPaymentDisputeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentDisputeRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentDisputeRepoMockRecorder is the mock recorder for MockPaymentDisputeRepo.
+type MockPaymentDisputeRepoMockRecorder struct {
+	mock *MockPaymentDisputeRepo
+}
+
+// NewMockPaymentDisputeRepo creates a new mock instance.
+func NewMockPaymentDisputeRepo(ctrl *gomock.Controller) *MockPaymentDisputeRepo {
+	mock := &MockPaymentDisputeRepo{ctrl: ctrl}
+	mock.recorder = &MockPaymentDisputeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentDisputeRepo) EXPECT() *MockPaymentDisputeRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateEvidence mocks base method.
+func (m *MockPaymentDisputeRepo) CreateEvidence(ctx context.Context, evidence *entity.PaymentDisputeEvidence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvidence", ctx, evidence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEvidence indicates an expected call of CreateEvidence.
+func (mr *MockPaymentDisputeRepoMockRecorder) CreateEvidence(ctx, evidence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvidence", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).CreateEvidence), ctx, evidence)
+}
+
+// GetByID mocks base method.
+func (m *MockPaymentDisputeRepo) GetByID(ctx context.Context, id string) (*aggregate.PaymentDisputeAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*aggregate.PaymentDisputeAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPaymentDisputeRepoMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).GetByID), ctx, id)
+}
+
+// GetByProviderDisputeID mocks base method.
+func (m *MockPaymentDisputeRepo) GetByProviderDisputeID(ctx context.Context, provider, providerDisputeID string) (*aggregate.PaymentDisputeAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProviderDisputeID", ctx, provider, providerDisputeID)
+	ret0, _ := ret[0].(*aggregate.PaymentDisputeAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProviderDisputeID indicates an expected call of GetByProviderDisputeID.
+func (mr *MockPaymentDisputeRepoMockRecorder) GetByProviderDisputeID(ctx, provider, providerDisputeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProviderDisputeID", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).GetByProviderDisputeID), ctx, provider, providerDisputeID)
+}
+
+// List mocks base method.
+func (m *MockPaymentDisputeRepo) List(ctx context.Context, filter PaymentDisputeFilter) ([]*entity.PaymentDispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entity.PaymentDispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPaymentDisputeRepoMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).List), ctx, filter)
+}
+
+// ListEvidence mocks base method.
+func (m *MockPaymentDisputeRepo) ListEvidence(ctx context.Context, disputeID string) ([]*entity.PaymentDisputeEvidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvidence", ctx, disputeID)
+	ret0, _ := ret[0].([]*entity.PaymentDisputeEvidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvidence indicates an expected call of ListEvidence.
+func (mr *MockPaymentDisputeRepoMockRecorder) ListEvidence(ctx, disputeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvidence", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).ListEvidence), ctx, disputeID)
+}
+
+// MarkEvidenceSubmitted mocks base method.
+func (m *MockPaymentDisputeRepo) MarkEvidenceSubmitted(ctx context.Context, evidenceIDs []string, submittedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEvidenceSubmitted", ctx, evidenceIDs, submittedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEvidenceSubmitted indicates an expected call of MarkEvidenceSubmitted.
+func (mr *MockPaymentDisputeRepoMockRecorder) MarkEvidenceSubmitted(ctx, evidenceIDs, submittedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEvidenceSubmitted", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).MarkEvidenceSubmitted), ctx, evidenceIDs, submittedAt)
+}
+
+// Save mocks base method.
+func (m *MockPaymentDisputeRepo) Save(ctx context.Context, dispute *aggregate.PaymentDisputeAggregate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, dispute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPaymentDisputeRepoMockRecorder) Save(ctx, dispute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPaymentDisputeRepo)(nil).Save), ctx, dispute)
+}
@@ -0,0 +1,17 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=payment_idempotency_key_repo_mock.go -source=payment_idempotency_key_repo.go
+type PaymentIdempotencyKeyRepo interface {
+	// Get returns nil when the account has not used the key in scope.
+	Get(ctx context.Context, accountID, scope, key string) (*entity.PaymentIdempotencyKey, error)
+	// Create returns ErrPaymentIdempotencyKeyExists when the key is taken.
+	Create(ctx context.Context, key *entity.PaymentIdempotencyKey) error
+	// RecordResult stores what the request the key belongs to settled.
+	RecordResult(ctx context.Context, accountID, scope, key string, amount int64) error
+}
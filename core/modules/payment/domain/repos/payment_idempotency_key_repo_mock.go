@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_idempotency_key_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=payment_idempotency_key_repo_mock.go -source=payment_idempotency_key_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/payment/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentIdempotencyKeyRepo is a mock of PaymentIdempotencyKeyRepo interface.
+type MockPaymentIdempotencyKeyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentIdempotencyKeyRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentIdempotencyKeyRepoMockRecorder is the mock recorder for MockPaymentIdempotencyKeyRepo.
+type MockPaymentIdempotencyKeyRepoMockRecorder struct {
+	mock *MockPaymentIdempotencyKeyRepo
+}
+
+// NewMockPaymentIdempotencyKeyRepo creates a new mock instance.
+func NewMockPaymentIdempotencyKeyRepo(ctrl *gomock.Controller) *MockPaymentIdempotencyKeyRepo {
+	mock := &MockPaymentIdempotencyKeyRepo{ctrl: ctrl}
+	mock.recorder = &MockPaymentIdempotencyKeyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentIdempotencyKeyRepo) EXPECT() *MockPaymentIdempotencyKeyRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentIdempotencyKeyRepo) Create(ctx context.Context, key *entity.PaymentIdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentIdempotencyKeyRepoMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentIdempotencyKeyRepo)(nil).Create), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockPaymentIdempotencyKeyRepo) Get(ctx context.Context, accountID, scope, key string) (*entity.PaymentIdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, accountID, scope, key)
+	ret0, _ := ret[0].(*entity.PaymentIdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPaymentIdempotencyKeyRepoMockRecorder) Get(ctx, accountID, scope, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPaymentIdempotencyKeyRepo)(nil).Get), ctx, accountID, scope, key)
+}
+
+// RecordResult mocks base method.
+func (m *MockPaymentIdempotencyKeyRepo) RecordResult(ctx context.Context, accountID, scope, key string, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordResult", ctx, accountID, scope, key, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordResult indicates an expected call of RecordResult.
+func (mr *MockPaymentIdempotencyKeyRepoMockRecorder) RecordResult(ctx, accountID, scope, key, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordResult", reflect.TypeOf((*MockPaymentIdempotencyKeyRepo)(nil).RecordResult), ctx, accountID, scope, key, amount)
+}
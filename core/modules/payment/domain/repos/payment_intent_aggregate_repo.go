@@ -2,13 +2,30 @@ package repos
 
 import (
 	"context"
+	"time"
+
 	aggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
 )
 
+// PaymentIntentListFilter pages an account's intents newest first; the cursor
+// is the created_at and transaction_id of the last intent already returned.
+type PaymentIntentListFilter struct {
+	AccountID           string
+	Workflow            string
+	CursorCreatedAt     *time.Time
+	CursorTransactionID string
+	Limit               int
+}
+
 //go:generate mockgen -package=repos -destination=payment_intent_aggregate_repo_mock.go -source=payment_intent_aggregate_repo.go
 type PaymentIntentAggregateRepo interface {
 	Save(ctx context.Context, aggregate *aggregate.PaymentIntentAggregate) error
 	GetByTransactionID(ctx context.Context, transactionID string) (*aggregate.PaymentIntentAggregate, error)
 	GetByExternalRef(ctx context.Context, provider, externalRef string) (*aggregate.PaymentIntentAggregate, error)
 	ListPendingWithdrawals(ctx context.Context, limit int) ([]*aggregate.PaymentIntentAggregate, error)
+	ListByAccount(ctx context.Context, filter PaymentIntentListFilter) ([]*aggregate.PaymentIntentAggregate, error)
+	ListStatusHistory(ctx context.Context, transactionID string) ([]entity.PaymentStatusChange, error)
+	// ListCreatedBetween returns the provider's intents created in [from, to).
+	ListCreatedBetween(ctx context.Context, provider string, from, to time.Time) ([]*aggregate.PaymentIntentAggregate, error)
 }
@@ -0,0 +1,29 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+)
+
+// PaymentReconciliationFilter pages discrepancies most recently detected
+// first; the cursor is the last_detected_at and id of the last row returned.
+type PaymentReconciliationFilter struct {
+	Provider       string
+	Kind           string
+	Status         string
+	CursorDetected *time.Time
+	CursorID       string
+	Limit          int
+}
+
+//go:generate mockgen -package=repos -destination=payment_reconciliation_repo_mock.go -source=payment_reconciliation_repo.go
+type PaymentReconciliationRepo interface {
+	// Record inserts the discrepancy, or refreshes the amounts and detection
+	// time of the row with the same key while keeping its review state.
+	Record(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error
+	GetByID(ctx context.Context, id string) (*entity.PaymentReconciliationDiscrepancy, error)
+	List(ctx context.Context, filter PaymentReconciliationFilter) ([]*entity.PaymentReconciliationDiscrepancy, error)
+	UpdateReview(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error
+}
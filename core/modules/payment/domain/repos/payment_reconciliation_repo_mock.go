@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_reconciliation_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=payment_reconciliation_repo_mock.go -source=payment_reconciliation_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/payment/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentReconciliationRepo is a mock of PaymentReconciliationRepo interface.
+type MockPaymentReconciliationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReconciliationRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentReconciliationRepoMockRecorder is the mock recorder for MockPaymentReconciliationRepo.
+type MockPaymentReconciliationRepoMockRecorder struct {
+	mock *MockPaymentReconciliationRepo
+}
+
+// NewMockPaymentReconciliationRepo creates a new mock instance.
+func NewMockPaymentReconciliationRepo(ctrl *gomock.Controller) *MockPaymentReconciliationRepo {
+	mock := &MockPaymentReconciliationRepo{ctrl: ctrl}
+	mock.recorder = &MockPaymentReconciliationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReconciliationRepo) EXPECT() *MockPaymentReconciliationRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockPaymentReconciliationRepo) GetByID(ctx context.Context, id string) (*entity.PaymentReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PaymentReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPaymentReconciliationRepoMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPaymentReconciliationRepo)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockPaymentReconciliationRepo) List(ctx context.Context, filter PaymentReconciliationFilter) ([]*entity.PaymentReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entity.PaymentReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPaymentReconciliationRepoMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPaymentReconciliationRepo)(nil).List), ctx, filter)
+}
+
+// Record mocks base method.
+func (m *MockPaymentReconciliationRepo) Record(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, discrepancy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockPaymentReconciliationRepoMockRecorder) Record(ctx, discrepancy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockPaymentReconciliationRepo)(nil).Record), ctx, discrepancy)
+}
+
+// UpdateReview mocks base method.
+func (m *MockPaymentReconciliationRepo) UpdateReview(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, discrepancy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockPaymentReconciliationRepoMockRecorder) UpdateReview(ctx, discrepancy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockPaymentReconciliationRepo)(nil).UpdateReview), ctx, discrepancy)
+}
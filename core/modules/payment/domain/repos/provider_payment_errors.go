@@ -6,4 +6,8 @@ var (
 	ErrProviderPaymentNotFound           = errors.New("provider payment not found")
 	ErrProviderPaymentDuplicateIntent    = errors.New("provider payment duplicate intent")
 	ErrProviderPaymentDuplicateProcessed = errors.New("provider payment duplicate processed event")
+	ErrPaymentIdempotencyKeyExists       = errors.New("payment idempotency key already exists")
+	ErrPaymentReconciliationNotFound     = errors.New("payment reconciliation discrepancy not found")
+	ErrPaymentDisputeNotFound            = errors.New("payment dispute not found")
+	ErrPaymentDisputeConcurrentUpdate    = errors.New("payment dispute was updated concurrently")
 )
@@ -2,8 +2,10 @@ package repos
 
 import (
 	"context"
+	"time"
 
 	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
 )
 
 //go:generate mockgen -package=repos -destination=provider_payment_repo_mock.go -source=provider_payment_repo.go
@@ -12,4 +14,8 @@ type ProviderPaymentRepository interface {
 	GetByTransactionID(ctx context.Context, transactionID string) (*paymentaggregate.PaymentIntentAggregate, error)
 	GetByExternalRef(ctx context.Context, provider, externalRef string) (*paymentaggregate.PaymentIntentAggregate, error)
 	ListPendingWithdrawals(ctx context.Context, limit int) ([]*paymentaggregate.PaymentIntentAggregate, error)
+	ListByAccount(ctx context.Context, filter PaymentIntentListFilter) ([]*paymentaggregate.PaymentIntentAggregate, error)
+	ListStatusHistory(ctx context.Context, transactionID string) ([]entity.PaymentStatusChange, error)
+	// ListCreatedBetween returns the provider's intents created in [from, to).
+	ListCreatedBetween(ctx context.Context, provider string, from, to time.Time) ([]*paymentaggregate.PaymentIntentAggregate, error)
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: core/modules/payment/domain/repos/provider_payment_repo.go
+// Source: provider_payment_repo.go
 //
 // Generated by this command:
 //
-//	mockgen -package=repos -destination=core/modules/payment/domain/repos/provider_payment_repo_mock.go -source=core/modules/payment/domain/repos/provider_payment_repo.go
+//	mockgen -package=repos -destination=provider_payment_repo_mock.go -source=provider_payment_repo.go
 //
 
 // Package repos is a generated GoMock package.
@@ -12,7 +12,9 @@ package repos
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	aggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	entity "wechat-clone/core/modules/payment/domain/entity"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -71,6 +73,36 @@ func (mr *MockProviderPaymentRepositoryMockRecorder) GetByTransactionID(ctx, tra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionID", reflect.TypeOf((*MockProviderPaymentRepository)(nil).GetByTransactionID), ctx, transactionID)
 }
 
+// ListByAccount mocks base method.
+func (m *MockProviderPaymentRepository) ListByAccount(ctx context.Context, filter PaymentIntentListFilter) ([]*aggregate.PaymentIntentAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", ctx, filter)
+	ret0, _ := ret[0].([]*aggregate.PaymentIntentAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockProviderPaymentRepositoryMockRecorder) ListByAccount(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockProviderPaymentRepository)(nil).ListByAccount), ctx, filter)
+}
+
+// ListCreatedBetween mocks base method.
+func (m *MockProviderPaymentRepository) ListCreatedBetween(ctx context.Context, provider string, from, to time.Time) ([]*aggregate.PaymentIntentAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCreatedBetween", ctx, provider, from, to)
+	ret0, _ := ret[0].([]*aggregate.PaymentIntentAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCreatedBetween indicates an expected call of ListCreatedBetween.
+func (mr *MockProviderPaymentRepositoryMockRecorder) ListCreatedBetween(ctx, provider, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCreatedBetween", reflect.TypeOf((*MockProviderPaymentRepository)(nil).ListCreatedBetween), ctx, provider, from, to)
+}
+
 // ListPendingWithdrawals mocks base method.
 func (m *MockProviderPaymentRepository) ListPendingWithdrawals(ctx context.Context, limit int) ([]*aggregate.PaymentIntentAggregate, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +118,21 @@ func (mr *MockProviderPaymentRepositoryMockRecorder) ListPendingWithdrawals(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingWithdrawals", reflect.TypeOf((*MockProviderPaymentRepository)(nil).ListPendingWithdrawals), ctx, limit)
 }
 
+// ListStatusHistory mocks base method.
+func (m *MockProviderPaymentRepository) ListStatusHistory(ctx context.Context, transactionID string) ([]entity.PaymentStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusHistory", ctx, transactionID)
+	ret0, _ := ret[0].([]entity.PaymentStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusHistory indicates an expected call of ListStatusHistory.
+func (mr *MockProviderPaymentRepositoryMockRecorder) ListStatusHistory(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusHistory", reflect.TypeOf((*MockProviderPaymentRepository)(nil).ListStatusHistory), ctx, transactionID)
+}
+
 // Save mocks base method.
 func (m *MockProviderPaymentRepository) Save(ctx context.Context, arg1 *aggregate.PaymentIntentAggregate) error {
 	m.ctrl.T.Helper()
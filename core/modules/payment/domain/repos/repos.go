@@ -7,6 +7,9 @@ import (
 //go:generate mockgen -package=repos -destination=repos_mock.go -source=repos.go
 type Repos interface {
 	PaymentIntentAggregateRepository() PaymentIntentAggregateRepo
+	PaymentIdempotencyKeyRepository() PaymentIdempotencyKeyRepo
+	PaymentReconciliationRepository() PaymentReconciliationRepo
+	PaymentDisputeRepository() PaymentDisputeRepo
 
 	WithTransaction(ctx context.Context, fn func(Repos) error) error
 }
@@ -40,6 +40,34 @@ func (m *MockRepos) EXPECT() *MockReposMockRecorder {
 	return m.recorder
 }
 
+// PaymentDisputeRepository mocks base method.
+func (m *MockRepos) PaymentDisputeRepository() PaymentDisputeRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentDisputeRepository")
+	ret0, _ := ret[0].(PaymentDisputeRepo)
+	return ret0
+}
+
+// PaymentDisputeRepository indicates an expected call of PaymentDisputeRepository.
+func (mr *MockReposMockRecorder) PaymentDisputeRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentDisputeRepository", reflect.TypeOf((*MockRepos)(nil).PaymentDisputeRepository))
+}
+
+// PaymentIdempotencyKeyRepository mocks base method.
+func (m *MockRepos) PaymentIdempotencyKeyRepository() PaymentIdempotencyKeyRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentIdempotencyKeyRepository")
+	ret0, _ := ret[0].(PaymentIdempotencyKeyRepo)
+	return ret0
+}
+
+// PaymentIdempotencyKeyRepository indicates an expected call of PaymentIdempotencyKeyRepository.
+func (mr *MockReposMockRecorder) PaymentIdempotencyKeyRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentIdempotencyKeyRepository", reflect.TypeOf((*MockRepos)(nil).PaymentIdempotencyKeyRepository))
+}
+
 // PaymentIntentAggregateRepository mocks base method.
 func (m *MockRepos) PaymentIntentAggregateRepository() PaymentIntentAggregateRepo {
 	m.ctrl.T.Helper()
@@ -54,6 +82,20 @@ func (mr *MockReposMockRecorder) PaymentIntentAggregateRepository() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentIntentAggregateRepository", reflect.TypeOf((*MockRepos)(nil).PaymentIntentAggregateRepository))
 }
 
+// PaymentReconciliationRepository mocks base method.
+func (m *MockRepos) PaymentReconciliationRepository() PaymentReconciliationRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentReconciliationRepository")
+	ret0, _ := ret[0].(PaymentReconciliationRepo)
+	return ret0
+}
+
+// PaymentReconciliationRepository indicates an expected call of PaymentReconciliationRepository.
+func (mr *MockReposMockRecorder) PaymentReconciliationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentReconciliationRepository", reflect.TypeOf((*MockRepos)(nil).PaymentReconciliationRepository))
+}
+
 // WithTransaction mocks base method.
 func (m *MockRepos) WithTransaction(ctx context.Context, fn func(Repos) error) error {
 	m.ctrl.T.Helper()
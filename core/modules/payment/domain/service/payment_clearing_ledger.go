@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+)
+
+//go:generate mockgen -package=service -destination=payment_clearing_ledger_mock.go -source=payment_clearing_ledger.go
+type PaymentClearingLedger interface {
+	// ClearingPosting returns the principal the intent moved through its
+	// provider clearing account, with posted=false when nothing was booked.
+	ClearingPosting(ctx context.Context, intent *entity.PaymentIntent) (amount int64, posted bool, err error)
+}
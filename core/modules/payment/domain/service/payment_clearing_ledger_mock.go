@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_clearing_ledger.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_clearing_ledger_mock.go -source=payment_clearing_ledger.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/payment/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentClearingLedger is a mock of PaymentClearingLedger interface.
+type MockPaymentClearingLedger struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentClearingLedgerMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentClearingLedgerMockRecorder is the mock recorder for MockPaymentClearingLedger.
+type MockPaymentClearingLedgerMockRecorder struct {
+	mock *MockPaymentClearingLedger
+}
+
+// NewMockPaymentClearingLedger creates a new mock instance.
+func NewMockPaymentClearingLedger(ctrl *gomock.Controller) *MockPaymentClearingLedger {
+	mock := &MockPaymentClearingLedger{ctrl: ctrl}
+	mock.recorder = &MockPaymentClearingLedgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentClearingLedger) EXPECT() *MockPaymentClearingLedgerMockRecorder {
+	return m.recorder
+}
+
+// ClearingPosting mocks base method.
+func (m *MockPaymentClearingLedger) ClearingPosting(ctx context.Context, intent *entity.PaymentIntent) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearingPosting", ctx, intent)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ClearingPosting indicates an expected call of ClearingPosting.
+func (mr *MockPaymentClearingLedgerMockRecorder) ClearingPosting(ctx, intent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearingPosting", reflect.TypeOf((*MockPaymentClearingLedger)(nil).ClearingPosting), ctx, intent)
+}
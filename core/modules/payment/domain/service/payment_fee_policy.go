@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPaymentFeeInvalid reports an operation the fee schedule cannot price,
+// such as a non-positive amount.
+var ErrPaymentFeeInvalid = errors.New("fee cannot be quoted")
+
+//go:generate mockgen -package=service -destination=payment_fee_policy_mock.go -source=payment_fee_policy.go
+type PaymentFeePolicy interface {
+	// QuoteTopUpFee prices the fee charged on top of a provider top-up
+	// credited to accountID.
+	QuoteTopUpFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error)
+	// QuoteWithdrawalFee prices the fee debited from accountID along with a
+	// provider withdrawal.
+	QuoteWithdrawalFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_fee_policy.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_fee_policy_mock.go -source=payment_fee_policy.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentFeePolicy is a mock of PaymentFeePolicy interface.
+type MockPaymentFeePolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentFeePolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentFeePolicyMockRecorder is the mock recorder for MockPaymentFeePolicy.
+type MockPaymentFeePolicyMockRecorder struct {
+	mock *MockPaymentFeePolicy
+}
+
+// NewMockPaymentFeePolicy creates a new mock instance.
+func NewMockPaymentFeePolicy(ctrl *gomock.Controller) *MockPaymentFeePolicy {
+	mock := &MockPaymentFeePolicy{ctrl: ctrl}
+	mock.recorder = &MockPaymentFeePolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentFeePolicy) EXPECT() *MockPaymentFeePolicyMockRecorder {
+	return m.recorder
+}
+
+// QuoteTopUpFee mocks base method.
+func (m *MockPaymentFeePolicy) QuoteTopUpFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteTopUpFee", ctx, provider, accountID, currency, amount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteTopUpFee indicates an expected call of QuoteTopUpFee.
+func (mr *MockPaymentFeePolicyMockRecorder) QuoteTopUpFee(ctx, provider, accountID, currency, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteTopUpFee", reflect.TypeOf((*MockPaymentFeePolicy)(nil).QuoteTopUpFee), ctx, provider, accountID, currency, amount)
+}
+
+// QuoteWithdrawalFee mocks base method.
+func (m *MockPaymentFeePolicy) QuoteWithdrawalFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteWithdrawalFee", ctx, provider, accountID, currency, amount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteWithdrawalFee indicates an expected call of QuoteWithdrawalFee.
+func (mr *MockPaymentFeePolicyMockRecorder) QuoteWithdrawalFee(ctx, provider, accountID, currency, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteWithdrawalFee", reflect.TypeOf((*MockPaymentFeePolicy)(nil).QuoteWithdrawalFee), ctx, provider, accountID, currency, amount)
+}
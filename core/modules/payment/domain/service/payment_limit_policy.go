@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+)
+
+var (
+	ErrPaymentLimitSingleExceeded   = errors.New("amount exceeds the single transaction limit")
+	ErrPaymentLimitDailyExceeded    = errors.New("daily limit exceeded")
+	ErrPaymentLimitMonthlyExceeded  = errors.New("monthly limit exceeded")
+	ErrPaymentLimitVelocityExceeded = errors.New("too many operations in a short period")
+)
+
+//go:generate mockgen -package=service -destination=payment_limit_policy_mock.go -source=payment_limit_policy.go
+type PaymentLimitPolicy interface {
+	// Reserve counts a new top-up or withdrawal against its account's wallet
+	// limits. Reserving the same intent again is a no-op.
+	Reserve(ctx context.Context, intent *entity.PaymentIntent) error
+	// Release gives back the reservation of an intent that was never created
+	// at the provider.
+	Release(ctx context.Context, intent *entity.PaymentIntent) error
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_limit_policy.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=payment_limit_policy_mock.go -source=payment_limit_policy.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/payment/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentLimitPolicy is a mock of PaymentLimitPolicy interface.
+type MockPaymentLimitPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentLimitPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentLimitPolicyMockRecorder is the mock recorder for MockPaymentLimitPolicy.
+type MockPaymentLimitPolicyMockRecorder struct {
+	mock *MockPaymentLimitPolicy
+}
+
+// NewMockPaymentLimitPolicy creates a new mock instance.
+func NewMockPaymentLimitPolicy(ctrl *gomock.Controller) *MockPaymentLimitPolicy {
+	mock := &MockPaymentLimitPolicy{ctrl: ctrl}
+	mock.recorder = &MockPaymentLimitPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentLimitPolicy) EXPECT() *MockPaymentLimitPolicyMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method.
+func (m *MockPaymentLimitPolicy) Release(ctx context.Context, intent *entity.PaymentIntent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, intent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPaymentLimitPolicyMockRecorder) Release(ctx, intent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPaymentLimitPolicy)(nil).Release), ctx, intent)
+}
+
+// Reserve mocks base method.
+func (m *MockPaymentLimitPolicy) Reserve(ctx context.Context, intent *entity.PaymentIntent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, intent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockPaymentLimitPolicyMockRecorder) Reserve(ctx, intent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockPaymentLimitPolicy)(nil).Reserve), ctx, intent)
+}
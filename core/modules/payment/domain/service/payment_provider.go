@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"wechat-clone/core/modules/payment/domain/entity"
 )
@@ -11,13 +13,44 @@ type PaymentProvider interface {
 	Name() string
 	CreatePayment(ctx context.Context, intent *entity.PaymentIntent, metadata map[string]string) (*PaymentCreation, error)
 	CreateWithdrawal(ctx context.Context, intent *entity.PaymentIntent, metadata map[string]string) (*PaymentCreation, error)
-	RefundPayment(ctx context.Context, intent *entity.PaymentIntent, reason string) (*PaymentRefund, error)
+	RefundPayment(ctx context.Context, intent *entity.PaymentIntent, amount int64, reason, idempotencyKey string) (*PaymentRefund, error)
 	ParseWebhook(ctx context.Context, payload []byte, signature string) (*PaymentWebhook, error)
 }
 
+// ErrDisputeEvidenceUnsupported is returned for providers that cannot take
+// dispute evidence through the API.
+var ErrDisputeEvidenceUnsupported = errors.New("provider does not accept dispute evidence")
+
+// ErrSettlementReportUnavailable is returned by providers that support
+// settlement reports but have none configured; reconciliation skips them.
+var ErrSettlementReportUnavailable = errors.New("settlement report is unavailable")
+
+// PaymentSettlementReporter is the optional provider capability used by
+// reconciliation.
+type PaymentSettlementReporter interface {
+	Name() string
+	ListSettlements(ctx context.Context, from, to time.Time) ([]entity.PaymentSettlement, error)
+}
+
+// PaymentDisputeEvidenceFile is one evidence document sent to a provider.
+type PaymentDisputeEvidenceFile struct {
+	Kind        string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// PaymentDisputeEvidenceSubmitter is the optional provider capability used to
+// answer disputes.
+type PaymentDisputeEvidenceSubmitter interface {
+	SubmitDisputeEvidence(ctx context.Context, dispute *entity.PaymentDispute, files []PaymentDisputeEvidenceFile, note string) error
+}
+
 //go:generate mockgen -package=service -destination=payment_provider_mock.go -source=payment_provider.go
 type PaymentProviderRegistry interface {
 	Get(name string) (PaymentProvider, error)
+	SettlementReporters() []PaymentSettlementReporter
+	DisputeEvidenceSubmitter(name string) (PaymentDisputeEvidenceSubmitter, error)
 }
 
 type PaymentCreation struct {
@@ -30,6 +63,9 @@ type PaymentWebhook struct {
 	Provider string
 	Ignored  bool
 	Result   entity.PaymentProviderResult
+	// Dispute is set for dispute events; Result then only locates the
+	// disputed payment.
+	Dispute *entity.PaymentDisputeUpdate
 }
 
 type PaymentRefund struct {
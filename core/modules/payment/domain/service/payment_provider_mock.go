@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	entity "wechat-clone/core/modules/payment/domain/entity"
 
 	gomock "go.uber.org/mock/gomock"
@@ -85,34 +86,125 @@ func (mr *MockPaymentProviderMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentProvider)(nil).Name))
 }
 
+// ParseWebhook mocks base method.
+func (m *MockPaymentProvider) ParseWebhook(ctx context.Context, payload []byte, signature string) (*PaymentWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWebhook", ctx, payload, signature)
+	ret0, _ := ret[0].(*PaymentWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWebhook indicates an expected call of ParseWebhook.
+func (mr *MockPaymentProviderMockRecorder) ParseWebhook(ctx, payload, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWebhook", reflect.TypeOf((*MockPaymentProvider)(nil).ParseWebhook), ctx, payload, signature)
+}
+
 // RefundPayment mocks base method.
-func (m *MockPaymentProvider) RefundPayment(ctx context.Context, intent *entity.PaymentIntent, reason string) (*PaymentRefund, error) {
+func (m *MockPaymentProvider) RefundPayment(ctx context.Context, intent *entity.PaymentIntent, amount int64, reason, idempotencyKey string) (*PaymentRefund, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefundPayment", ctx, intent, reason)
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, intent, amount, reason, idempotencyKey)
 	ret0, _ := ret[0].(*PaymentRefund)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RefundPayment indicates an expected call of RefundPayment.
-func (mr *MockPaymentProviderMockRecorder) RefundPayment(ctx, intent, reason any) *gomock.Call {
+func (mr *MockPaymentProviderMockRecorder) RefundPayment(ctx, intent, amount, reason, idempotencyKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentProvider)(nil).RefundPayment), ctx, intent, reason)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentProvider)(nil).RefundPayment), ctx, intent, amount, reason, idempotencyKey)
 }
 
-// ParseWebhook mocks base method.
-func (m *MockPaymentProvider) ParseWebhook(ctx context.Context, payload []byte, signature string) (*PaymentWebhook, error) {
+// MockPaymentSettlementReporter is a mock of PaymentSettlementReporter interface.
+type MockPaymentSettlementReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentSettlementReporterMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentSettlementReporterMockRecorder is the mock recorder for MockPaymentSettlementReporter.
+type MockPaymentSettlementReporterMockRecorder struct {
+	mock *MockPaymentSettlementReporter
+}
+
+// NewMockPaymentSettlementReporter creates a new mock instance.
+func NewMockPaymentSettlementReporter(ctrl *gomock.Controller) *MockPaymentSettlementReporter {
+	mock := &MockPaymentSettlementReporter{ctrl: ctrl}
+	mock.recorder = &MockPaymentSettlementReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentSettlementReporter) EXPECT() *MockPaymentSettlementReporterMockRecorder {
+	return m.recorder
+}
+
+// ListSettlements mocks base method.
+func (m *MockPaymentSettlementReporter) ListSettlements(ctx context.Context, from, to time.Time) ([]entity.PaymentSettlement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseWebhook", ctx, payload, signature)
-	ret0, _ := ret[0].(*PaymentWebhook)
+	ret := m.ctrl.Call(m, "ListSettlements", ctx, from, to)
+	ret0, _ := ret[0].([]entity.PaymentSettlement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ParseWebhook indicates an expected call of ParseWebhook.
-func (mr *MockPaymentProviderMockRecorder) ParseWebhook(ctx, payload, signature any) *gomock.Call {
+// ListSettlements indicates an expected call of ListSettlements.
+func (mr *MockPaymentSettlementReporterMockRecorder) ListSettlements(ctx, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWebhook", reflect.TypeOf((*MockPaymentProvider)(nil).ParseWebhook), ctx, payload, signature)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSettlements", reflect.TypeOf((*MockPaymentSettlementReporter)(nil).ListSettlements), ctx, from, to)
+}
+
+// Name mocks base method.
+func (m *MockPaymentSettlementReporter) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentSettlementReporterMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentSettlementReporter)(nil).Name))
+}
+
+// MockPaymentDisputeEvidenceSubmitter is a mock of PaymentDisputeEvidenceSubmitter interface.
+type MockPaymentDisputeEvidenceSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentDisputeEvidenceSubmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentDisputeEvidenceSubmitterMockRecorder is the mock recorder for MockPaymentDisputeEvidenceSubmitter.
+type MockPaymentDisputeEvidenceSubmitterMockRecorder struct {
+	mock *MockPaymentDisputeEvidenceSubmitter
+}
+
+// NewMockPaymentDisputeEvidenceSubmitter creates a new mock instance.
+func NewMockPaymentDisputeEvidenceSubmitter(ctrl *gomock.Controller) *MockPaymentDisputeEvidenceSubmitter {
+	mock := &MockPaymentDisputeEvidenceSubmitter{ctrl: ctrl}
+	mock.recorder = &MockPaymentDisputeEvidenceSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentDisputeEvidenceSubmitter) EXPECT() *MockPaymentDisputeEvidenceSubmitterMockRecorder {
+	return m.recorder
+}
+
+// SubmitDisputeEvidence mocks base method.
+func (m *MockPaymentDisputeEvidenceSubmitter) SubmitDisputeEvidence(ctx context.Context, dispute *entity.PaymentDispute, files []PaymentDisputeEvidenceFile, note string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDisputeEvidence", ctx, dispute, files, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitDisputeEvidence indicates an expected call of SubmitDisputeEvidence.
+func (mr *MockPaymentDisputeEvidenceSubmitterMockRecorder) SubmitDisputeEvidence(ctx, dispute, files, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDisputeEvidence", reflect.TypeOf((*MockPaymentDisputeEvidenceSubmitter)(nil).SubmitDisputeEvidence), ctx, dispute, files, note)
 }
 
 // MockPaymentProviderRegistry is a mock of PaymentProviderRegistry interface.
@@ -139,6 +231,21 @@ func (m *MockPaymentProviderRegistry) EXPECT() *MockPaymentProviderRegistryMockR
 	return m.recorder
 }
 
+// DisputeEvidenceSubmitter mocks base method.
+func (m *MockPaymentProviderRegistry) DisputeEvidenceSubmitter(name string) (PaymentDisputeEvidenceSubmitter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputeEvidenceSubmitter", name)
+	ret0, _ := ret[0].(PaymentDisputeEvidenceSubmitter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputeEvidenceSubmitter indicates an expected call of DisputeEvidenceSubmitter.
+func (mr *MockPaymentProviderRegistryMockRecorder) DisputeEvidenceSubmitter(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeEvidenceSubmitter", reflect.TypeOf((*MockPaymentProviderRegistry)(nil).DisputeEvidenceSubmitter), name)
+}
+
 // Get mocks base method.
 func (m *MockPaymentProviderRegistry) Get(name string) (PaymentProvider, error) {
 	m.ctrl.T.Helper()
@@ -153,3 +260,17 @@ func (mr *MockPaymentProviderRegistryMockRecorder) Get(name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPaymentProviderRegistry)(nil).Get), name)
 }
+
+// SettlementReporters mocks base method.
+func (m *MockPaymentProviderRegistry) SettlementReporters() []PaymentSettlementReporter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettlementReporters")
+	ret0, _ := ret[0].([]PaymentSettlementReporter)
+	return ret0
+}
+
+// SettlementReporters indicates an expected call of SettlementReporters.
+func (mr *MockPaymentProviderRegistryMockRecorder) SettlementReporters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettlementReporters", reflect.TypeOf((*MockPaymentProviderRegistry)(nil).SettlementReporters))
+}
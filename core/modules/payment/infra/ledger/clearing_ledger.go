@@ -0,0 +1,55 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type clearingLedger struct {
+	ledger ledgerservice.LedgerQueryService
+}
+
+// NewClearingLedger reads clearing account postings from the ledger read
+// model. Top-ups are booked under payment:<id>:succeeded and withdrawals
+// under payment:<id>:withdrawal:principal, as the ledger consumer names them.
+func NewClearingLedger(ledger ledgerservice.LedgerQueryService) service.PaymentClearingLedger {
+	return &clearingLedger{ledger: ledger}
+}
+
+func (l *clearingLedger) ClearingPosting(ctx context.Context, intent *entity.PaymentIntent) (int64, bool, error) {
+	ledgerTransactionID := fmt.Sprintf("payment:%s:succeeded", intent.TransactionID)
+	if intent.IsWithdrawal() {
+		ledgerTransactionID = fmt.Sprintf("payment:%s:withdrawal:principal", intent.TransactionID)
+	}
+
+	transaction, err := l.ledger.GetTransaction(ctx, ledgerTransactionID)
+	if err != nil {
+		if errors.Is(err, ledgerservice.ErrTransactionNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, stackErr.Error(err)
+	}
+
+	clearingAccountID := fmt.Sprintf("ledger:clearing:%s", strings.ToLower(strings.TrimSpace(intent.ClearingAccountKey)))
+	var amount int64
+	posted := false
+	for _, entry := range transaction.Entries {
+		if entry.AccountID != clearingAccountID {
+			continue
+		}
+		posted = true
+		if entry.Amount < 0 {
+			amount -= entry.Amount
+		} else {
+			amount += entry.Amount
+		}
+	}
+	return amount, posted, nil
+}
@@ -0,0 +1,47 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type feePolicy struct {
+	fees ledgerservice.LedgerFeeService
+}
+
+// NewFeePolicy prices top-ups and withdrawals with the ledger fee schedule,
+// so the fee stored on an intent is the one the ledger later books.
+func NewFeePolicy(fees ledgerservice.LedgerFeeService) service.PaymentFeePolicy {
+	return &feePolicy{fees: fees}
+}
+
+func (p *feePolicy) QuoteTopUpFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error) {
+	return p.quote(ctx, finance.FeeOperationTopUp, provider, accountID, currency, amount)
+}
+
+func (p *feePolicy) QuoteWithdrawalFee(ctx context.Context, provider, accountID, currency string, amount int64) (int64, error) {
+	return p.quote(ctx, finance.FeeOperationWithdrawal, provider, accountID, currency, amount)
+}
+
+func (p *feePolicy) quote(ctx context.Context, operation, provider, accountID, currency string, amount int64) (int64, error) {
+	quote, err := p.fees.QuoteFee(ctx, ledgerservice.QuoteFeeCommand{
+		AccountID: accountID,
+		Operation: operation,
+		Provider:  provider,
+		Currency:  currency,
+		Amount:    amount,
+	})
+	if errors.Is(err, ledgerservice.ErrValidation) {
+		return 0, fmt.Errorf("%w: %w", service.ErrPaymentFeeInvalid, err)
+	}
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return quote.FeeAmount, nil
+}
@@ -0,0 +1,63 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	ledgerentity "wechat-clone/core/modules/ledger/domain/entity"
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/domain/service"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type limitPolicy struct {
+	limits ledgerservice.LedgerLimitService
+}
+
+// NewLimitPolicy counts top-ups against the credited account's top_up limits
+// and withdrawals against the debited account's withdrawal limits, keyed by
+// the intent's transaction id.
+func NewLimitPolicy(limits ledgerservice.LedgerLimitService) service.PaymentLimitPolicy {
+	return &limitPolicy{limits: limits}
+}
+
+func (p *limitPolicy) Reserve(ctx context.Context, intent *entity.PaymentIntent) error {
+	operation, accountID := limitOperation(intent)
+	err := p.limits.ReserveLimit(ctx, ledgerservice.ReserveLimitCommand{
+		AccountID:   accountID,
+		Operation:   operation,
+		Currency:    intent.Currency,
+		Amount:      intent.Amount,
+		ReferenceID: intent.TransactionID,
+	})
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, ledgerentity.ErrLimitSingleExceeded):
+		return fmt.Errorf("%w: %w", service.ErrPaymentLimitSingleExceeded, err)
+	case errors.Is(err, ledgerentity.ErrLimitDailyExceeded):
+		return fmt.Errorf("%w: %w", service.ErrPaymentLimitDailyExceeded, err)
+	case errors.Is(err, ledgerentity.ErrLimitMonthlyExceeded):
+		return fmt.Errorf("%w: %w", service.ErrPaymentLimitMonthlyExceeded, err)
+	case errors.Is(err, ledgerentity.ErrLimitVelocityExceeded):
+		return fmt.Errorf("%w: %w", service.ErrPaymentLimitVelocityExceeded, err)
+	default:
+		return stackErr.Error(err)
+	}
+}
+
+func (p *limitPolicy) Release(ctx context.Context, intent *entity.PaymentIntent) error {
+	operation, _ := limitOperation(intent)
+	return stackErr.Error(p.limits.ReleaseLimit(ctx, operation, intent.TransactionID))
+}
+
+func limitOperation(intent *entity.PaymentIntent) (operation, accountID string) {
+	if intent.IsWithdrawal() {
+		return ledgerentity.LimitOperationWithdrawal, intent.DebitAccountID
+	}
+	return ledgerentity.LimitOperationTopUp, intent.CreditAccountID
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+type PaymentDisputeModel struct {
+	ID                  string     `gorm:"column:id;primaryKey"`
+	Provider            string     `gorm:"column:provider;not null"`
+	ProviderDisputeID   string     `gorm:"column:provider_dispute_id;not null"`
+	TransactionID       string     `gorm:"column:transaction_id;not null"`
+	AccountID           string     `gorm:"column:account_id;not null"`
+	Currency            string     `gorm:"column:currency;not null"`
+	Amount              int64      `gorm:"column:amount;not null"`
+	Reason              string     `gorm:"column:reason;not null"`
+	Status              string     `gorm:"column:status;not null"`
+	EvidenceDueBy       *time.Time `gorm:"column:evidence_due_by"`
+	EvidenceSubmittedAt *time.Time `gorm:"column:evidence_submitted_at"`
+	Version             int        `gorm:"column:version;not null"`
+	OpenedAt            time.Time  `gorm:"column:opened_at;not null"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;not null"`
+	ClosedAt            *time.Time `gorm:"column:closed_at"`
+}
+
+func (PaymentDisputeModel) TableName() string {
+	return "payment_disputes"
+}
+
+type PaymentDisputeEvidenceModel struct {
+	ID          string     `gorm:"column:id;primaryKey"`
+	DisputeID   string     `gorm:"column:dispute_id;not null"`
+	Kind        string     `gorm:"column:kind;not null"`
+	FileName    string     `gorm:"column:file_name;not null"`
+	ContentType string     `gorm:"column:content_type;not null"`
+	ObjectKey   string     `gorm:"column:object_key;not null"`
+	Size        int64      `gorm:"column:size;not null"`
+	UploadedBy  string     `gorm:"column:uploaded_by;not null"`
+	SubmittedAt *time.Time `gorm:"column:submitted_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at;not null"`
+}
+
+func (PaymentDisputeEvidenceModel) TableName() string {
+	return "payment_dispute_evidence"
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type PaymentIdempotencyKeyModel struct {
+	AccountID      string    `gorm:"column:account_id;primaryKey"`
+	Scope          string    `gorm:"column:scope;primaryKey"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;primaryKey"`
+	TransactionID  string    `gorm:"column:transaction_id;not null"`
+	ResultAmount   int64     `gorm:"column:result_amount;not null;default:0"`
+	CreatedAt      time.Time `gorm:"column:created_at;not null"`
+}
+
+func (PaymentIdempotencyKeyModel) TableName() string {
+	return "payment_idempotency_keys"
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type PaymentReconciliationDiscrepancyModel struct {
+	ID             string     `gorm:"column:id;primaryKey"`
+	DiscrepancyKey string     `gorm:"column:discrepancy_key;not null"`
+	Provider       string     `gorm:"column:provider;not null"`
+	Kind           string     `gorm:"column:kind;not null"`
+	TransactionID  string     `gorm:"column:transaction_id;not null"`
+	ExternalRef    string     `gorm:"column:external_ref;not null"`
+	Workflow       string     `gorm:"column:workflow;not null"`
+	ExpectedAmount int64      `gorm:"column:expected_amount;not null"`
+	ActualAmount   int64      `gorm:"column:actual_amount;not null"`
+	Currency       string     `gorm:"column:currency;not null"`
+	Detail         string     `gorm:"column:detail;not null"`
+	Status         string     `gorm:"column:status;not null"`
+	ReviewNote     string     `gorm:"column:review_note;not null"`
+	ReviewedBy     string     `gorm:"column:reviewed_by;not null"`
+	ReviewedAt     *time.Time `gorm:"column:reviewed_at"`
+	DetectedAt     time.Time  `gorm:"column:detected_at;not null"`
+	LastDetectedAt time.Time  `gorm:"column:last_detected_at;not null"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;not null"`
+}
+
+func (PaymentReconciliationDiscrepancyModel) TableName() string {
+	return "payment_reconciliation_discrepancies"
+}
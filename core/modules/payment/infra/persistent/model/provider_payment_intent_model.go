@@ -11,6 +11,7 @@ type ProviderPaymentIntentModel struct {
 	Amount               int64     `gorm:"not null"`
 	FeeAmount            int64     `gorm:"not null;default:0"`
 	ProviderAmount       int64     `gorm:"column:provider_amount;not null;default:0"`
+	RefundedAmount       int64     `gorm:"column:refunded_amount;not null;default:0"`
 	Currency             string    `gorm:"not null"`
 	ClearingAccountKey   string    `gorm:"column:clearing_account_key;not null"`
 	DebitAccountID       *string   `gorm:"column:debit_account_id"`
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/domain/repos"
+	"wechat-clone/core/modules/payment/infra/persistent/model"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type paymentDisputeRepoImpl struct {
+	db              *gorm.DB
+	outboxPublisher eventpkg.Publisher
+}
+
+func NewPaymentDisputeRepo(db *gorm.DB) repos.PaymentDisputeRepo {
+	return &paymentDisputeRepoImpl{
+		db: db,
+		outboxPublisher: eventpkg.NewPublisher(&paymentOutboxEventStore{
+			db:         db,
+			serializer: eventpkg.NewSerializer(),
+		}),
+	}
+}
+
+func (r *paymentDisputeRepoImpl) Save(ctx context.Context, aggregate *paymentaggregate.PaymentDisputeAggregate) error {
+	dispute := aggregate.Snapshot()
+	if dispute == nil {
+		return stackErr.Error(repos.ErrPaymentDisputeNotFound)
+	}
+
+	m := toPaymentDisputeModel(dispute, aggregate.Version())
+	if aggregate.Root().BaseVersion() == 0 {
+		if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+			return stackErr.Error(err)
+		}
+	} else {
+		result := r.db.WithContext(ctx).
+			Model(&model.PaymentDisputeModel{}).
+			Where("id = ? AND version = ?", m.ID, aggregate.Root().BaseVersion()).
+			Updates(map[string]interface{}{
+				"reason":                m.Reason,
+				"status":                m.Status,
+				"evidence_due_by":       m.EvidenceDueBy,
+				"evidence_submitted_at": m.EvidenceSubmittedAt,
+				"version":               m.Version,
+				"updated_at":            m.UpdatedAt,
+				"closed_at":             m.ClosedAt,
+			})
+		if result.Error != nil {
+			return stackErr.Error(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return stackErr.Error(repos.ErrPaymentDisputeConcurrentUpdate)
+		}
+	}
+
+	if events := aggregate.PendingOutboxEvents(); len(events) > 0 {
+		if err := r.outboxPublisher.Publish(ctx, events...); err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	aggregate.MarkPersisted()
+	return nil
+}
+
+func (r *paymentDisputeRepoImpl) GetByID(ctx context.Context, id string) (*paymentaggregate.PaymentDisputeAggregate, error) {
+	return r.first(r.db.WithContext(ctx).Where("id = ?", id))
+}
+
+func (r *paymentDisputeRepoImpl) GetByProviderDisputeID(ctx context.Context, provider, providerDisputeID string) (*paymentaggregate.PaymentDisputeAggregate, error) {
+	return r.first(r.db.WithContext(ctx).Where("provider = ? AND provider_dispute_id = ?", provider, providerDisputeID))
+}
+
+func (r *paymentDisputeRepoImpl) List(ctx context.Context, filter repos.PaymentDisputeFilter) ([]*entity.PaymentDispute, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+
+	query := r.db.WithContext(ctx).Model(&model.PaymentDisputeModel{})
+	if filter.Provider != "" {
+		query = query.Where("provider = ?", filter.Provider)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CursorOpened != nil {
+		query = query.Where(
+			"(opened_at < ? OR (opened_at = ? AND id < ?))",
+			*filter.CursorOpened, *filter.CursorOpened, filter.CursorID,
+		)
+	}
+
+	var rows []model.PaymentDisputeModel
+	if err := query.
+		Order("opened_at DESC").
+		Order("id DESC").
+		Limit(filter.Limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]*entity.PaymentDispute, 0, len(rows))
+	for idx := range rows {
+		items = append(items, fromPaymentDisputeModel(&rows[idx]))
+	}
+	return items, nil
+}
+
+func (r *paymentDisputeRepoImpl) CreateEvidence(ctx context.Context, evidence *entity.PaymentDisputeEvidence) error {
+	return stackErr.Error(r.db.WithContext(ctx).Create(&model.PaymentDisputeEvidenceModel{
+		ID:          evidence.ID,
+		DisputeID:   evidence.DisputeID,
+		Kind:        evidence.Kind,
+		FileName:    evidence.FileName,
+		ContentType: evidence.ContentType,
+		ObjectKey:   evidence.ObjectKey,
+		Size:        evidence.Size,
+		UploadedBy:  evidence.UploadedBy,
+		SubmittedAt: evidence.SubmittedAt,
+		CreatedAt:   evidence.CreatedAt.UTC(),
+	}).Error)
+}
+
+func (r *paymentDisputeRepoImpl) ListEvidence(ctx context.Context, disputeID string) ([]*entity.PaymentDisputeEvidence, error) {
+	var rows []model.PaymentDisputeEvidenceModel
+	if err := r.db.WithContext(ctx).
+		Where("dispute_id = ?", disputeID).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]*entity.PaymentDisputeEvidence, 0, len(rows))
+	for idx := range rows {
+		row := &rows[idx]
+		evidence := &entity.PaymentDisputeEvidence{
+			ID:          row.ID,
+			DisputeID:   row.DisputeID,
+			Kind:        row.Kind,
+			FileName:    row.FileName,
+			ContentType: row.ContentType,
+			ObjectKey:   row.ObjectKey,
+			Size:        row.Size,
+			UploadedBy:  row.UploadedBy,
+			SubmittedAt: utcTimePtr(row.SubmittedAt),
+			CreatedAt:   row.CreatedAt.UTC(),
+		}
+		items = append(items, evidence)
+	}
+	return items, nil
+}
+
+func (r *paymentDisputeRepoImpl) MarkEvidenceSubmitted(ctx context.Context, evidenceIDs []string, submittedAt time.Time) error {
+	if len(evidenceIDs) == 0 {
+		return nil
+	}
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&model.PaymentDisputeEvidenceModel{}).
+		Where("id IN ? AND submitted_at IS NULL", evidenceIDs).
+		Update("submitted_at", submittedAt.UTC()).Error)
+}
+
+func (r *paymentDisputeRepoImpl) first(query *gorm.DB) (*paymentaggregate.PaymentDisputeAggregate, error) {
+	var m model.PaymentDisputeModel
+	if err := query.First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(repos.ErrPaymentDisputeNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	agg, err := paymentaggregate.RestorePaymentDisputeAggregate(fromPaymentDisputeModel(&m), m.Version)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return agg, nil
+}
+
+func toPaymentDisputeModel(dispute *entity.PaymentDispute, version int) *model.PaymentDisputeModel {
+	return &model.PaymentDisputeModel{
+		ID:                  dispute.ID,
+		Provider:            dispute.Provider,
+		ProviderDisputeID:   dispute.ProviderDisputeID,
+		TransactionID:       dispute.TransactionID,
+		AccountID:           dispute.AccountID,
+		Currency:            dispute.Currency,
+		Amount:              dispute.Amount,
+		Reason:              dispute.Reason,
+		Status:              dispute.Status,
+		EvidenceDueBy:       utcTimePtr(dispute.EvidenceDueBy),
+		EvidenceSubmittedAt: utcTimePtr(dispute.EvidenceSubmittedAt),
+		Version:             version,
+		OpenedAt:            dispute.OpenedAt.UTC(),
+		UpdatedAt:           dispute.UpdatedAt.UTC(),
+		ClosedAt:            utcTimePtr(dispute.ClosedAt),
+	}
+}
+
+func fromPaymentDisputeModel(m *model.PaymentDisputeModel) *entity.PaymentDispute {
+	return &entity.PaymentDispute{
+		ID:                  m.ID,
+		Provider:            m.Provider,
+		ProviderDisputeID:   m.ProviderDisputeID,
+		TransactionID:       m.TransactionID,
+		AccountID:           m.AccountID,
+		Currency:            m.Currency,
+		Amount:              m.Amount,
+		Reason:              m.Reason,
+		Status:              m.Status,
+		EvidenceDueBy:       utcTimePtr(m.EvidenceDueBy),
+		EvidenceSubmittedAt: utcTimePtr(m.EvidenceSubmittedAt),
+		OpenedAt:            m.OpenedAt.UTC(),
+		UpdatedAt:           m.UpdatedAt.UTC(),
+		ClosedAt:            utcTimePtr(m.ClosedAt),
+	}
+}
+
+func utcTimePtr(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+	utc := value.UTC()
+	return &utc
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/domain/repos"
+	"wechat-clone/core/modules/payment/infra/persistent/model"
+	shareddb "wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type paymentIdempotencyKeyRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewPaymentIdempotencyKeyRepo(db *gorm.DB) repos.PaymentIdempotencyKeyRepo {
+	return &paymentIdempotencyKeyRepoImpl{db: db}
+}
+
+func (r *paymentIdempotencyKeyRepoImpl) Get(ctx context.Context, accountID, scope, key string) (*entity.PaymentIdempotencyKey, error) {
+	var m model.PaymentIdempotencyKeyModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND scope = ? AND idempotency_key = ?", accountID, scope, key).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return &entity.PaymentIdempotencyKey{
+		AccountID:     m.AccountID,
+		Scope:         m.Scope,
+		Key:           m.IdempotencyKey,
+		TransactionID: m.TransactionID,
+		ResultAmount:  m.ResultAmount,
+		CreatedAt:     m.CreatedAt.UTC(),
+	}, nil
+}
+
+func (r *paymentIdempotencyKeyRepoImpl) Create(ctx context.Context, key *entity.PaymentIdempotencyKey) error {
+	err := r.db.WithContext(ctx).Create(&model.PaymentIdempotencyKeyModel{
+		AccountID:      key.AccountID,
+		Scope:          key.Scope,
+		IdempotencyKey: key.Key,
+		TransactionID:  key.TransactionID,
+		ResultAmount:   key.ResultAmount,
+		CreatedAt:      key.CreatedAt,
+	}).Error
+	if shareddb.IsUniqueConstraintError(err) {
+		return stackErr.Error(repos.ErrPaymentIdempotencyKeyExists)
+	}
+	return stackErr.Error(err)
+}
+
+func (r *paymentIdempotencyKeyRepoImpl) RecordResult(ctx context.Context, accountID, scope, key string, amount int64) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&model.PaymentIdempotencyKeyModel{}).
+		Where("account_id = ? AND scope = ? AND idempotency_key = ?", accountID, scope, key).
+		Update("result_amount", amount).Error)
+}
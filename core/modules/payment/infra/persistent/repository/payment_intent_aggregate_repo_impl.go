@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
 	"wechat-clone/core/modules/payment/domain/repos"
 	"wechat-clone/core/shared/pkg/stackErr"
 
@@ -54,3 +56,27 @@ func (r *paymentIntentAggregateRepoImpl) ListPendingWithdrawals(ctx context.Cont
 	}
 	return paymentAggregates, nil
 }
+
+func (r *paymentIntentAggregateRepoImpl) ListByAccount(ctx context.Context, filter repos.PaymentIntentListFilter) ([]*aggregate.PaymentIntentAggregate, error) {
+	paymentAggregates, err := r.providerPaymentRepo.ListByAccount(ctx, filter)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return paymentAggregates, nil
+}
+
+func (r *paymentIntentAggregateRepoImpl) ListStatusHistory(ctx context.Context, transactionID string) ([]entity.PaymentStatusChange, error) {
+	history, err := r.providerPaymentRepo.ListStatusHistory(ctx, transactionID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return history, nil
+}
+
+func (r *paymentIntentAggregateRepoImpl) ListCreatedBetween(ctx context.Context, provider string, from, to time.Time) ([]*aggregate.PaymentIntentAggregate, error) {
+	paymentAggregates, err := r.providerPaymentRepo.ListCreatedBetween(ctx, provider, from, to)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return paymentAggregates, nil
+}
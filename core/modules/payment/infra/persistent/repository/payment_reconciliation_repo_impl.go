@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/domain/repos"
+	"wechat-clone/core/modules/payment/infra/persistent/model"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentReconciliationRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewPaymentReconciliationRepo(db *gorm.DB) repos.PaymentReconciliationRepo {
+	return &paymentReconciliationRepoImpl{db: db}
+}
+
+func (r *paymentReconciliationRepoImpl) Record(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "discrepancy_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"external_ref",
+				"expected_amount",
+				"actual_amount",
+				"currency",
+				"detail",
+				"last_detected_at",
+				"updated_at",
+			}),
+		}).
+		Create(toPaymentReconciliationModel(discrepancy)).Error)
+}
+
+func (r *paymentReconciliationRepoImpl) GetByID(ctx context.Context, id string) (*entity.PaymentReconciliationDiscrepancy, error) {
+	var m model.PaymentReconciliationDiscrepancyModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(repos.ErrPaymentReconciliationNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return fromPaymentReconciliationModel(&m), nil
+}
+
+func (r *paymentReconciliationRepoImpl) List(ctx context.Context, filter repos.PaymentReconciliationFilter) ([]*entity.PaymentReconciliationDiscrepancy, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+
+	query := r.db.WithContext(ctx).Model(&model.PaymentReconciliationDiscrepancyModel{})
+	if filter.Provider != "" {
+		query = query.Where("provider = ?", filter.Provider)
+	}
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CursorDetected != nil {
+		query = query.Where(
+			"(last_detected_at < ? OR (last_detected_at = ? AND id < ?))",
+			*filter.CursorDetected, *filter.CursorDetected, filter.CursorID,
+		)
+	}
+
+	var rows []model.PaymentReconciliationDiscrepancyModel
+	if err := query.
+		Order("last_detected_at DESC").
+		Order("id DESC").
+		Limit(filter.Limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]*entity.PaymentReconciliationDiscrepancy, 0, len(rows))
+	for idx := range rows {
+		items = append(items, fromPaymentReconciliationModel(&rows[idx]))
+	}
+	return items, nil
+}
+
+func (r *paymentReconciliationRepoImpl) UpdateReview(ctx context.Context, discrepancy *entity.PaymentReconciliationDiscrepancy) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.PaymentReconciliationDiscrepancyModel{}).
+		Where("id = ? AND status = ?", discrepancy.ID, entity.ReconciliationStatusOpen).
+		Updates(map[string]interface{}{
+			"status":      discrepancy.Status,
+			"review_note": discrepancy.ReviewNote,
+			"reviewed_by": discrepancy.ReviewedBy,
+			"reviewed_at": discrepancy.ReviewedAt,
+			"updated_at":  discrepancy.UpdatedAt,
+		})
+	if result.Error != nil {
+		return stackErr.Error(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return stackErr.Error(entity.ErrReconciliationAlreadyReviewed)
+	}
+	return nil
+}
+
+func toPaymentReconciliationModel(discrepancy *entity.PaymentReconciliationDiscrepancy) *model.PaymentReconciliationDiscrepancyModel {
+	return &model.PaymentReconciliationDiscrepancyModel{
+		ID:             discrepancy.ID,
+		DiscrepancyKey: discrepancy.Key(),
+		Provider:       discrepancy.Provider,
+		Kind:           discrepancy.Kind,
+		TransactionID:  discrepancy.TransactionID,
+		ExternalRef:    discrepancy.ExternalRef,
+		Workflow:       discrepancy.Workflow,
+		ExpectedAmount: discrepancy.ExpectedAmount,
+		ActualAmount:   discrepancy.ActualAmount,
+		Currency:       discrepancy.Currency,
+		Detail:         discrepancy.Detail,
+		Status:         discrepancy.Status,
+		ReviewNote:     discrepancy.ReviewNote,
+		ReviewedBy:     discrepancy.ReviewedBy,
+		ReviewedAt:     discrepancy.ReviewedAt,
+		DetectedAt:     discrepancy.DetectedAt.UTC(),
+		LastDetectedAt: discrepancy.LastDetectedAt.UTC(),
+		UpdatedAt:      discrepancy.UpdatedAt.UTC(),
+	}
+}
+
+func fromPaymentReconciliationModel(m *model.PaymentReconciliationDiscrepancyModel) *entity.PaymentReconciliationDiscrepancy {
+	discrepancy := &entity.PaymentReconciliationDiscrepancy{
+		ID:             m.ID,
+		Provider:       m.Provider,
+		Kind:           m.Kind,
+		TransactionID:  m.TransactionID,
+		ExternalRef:    m.ExternalRef,
+		Workflow:       m.Workflow,
+		ExpectedAmount: m.ExpectedAmount,
+		ActualAmount:   m.ActualAmount,
+		Currency:       m.Currency,
+		Detail:         m.Detail,
+		Status:         m.Status,
+		ReviewNote:     m.ReviewNote,
+		ReviewedBy:     m.ReviewedBy,
+		DetectedAt:     m.DetectedAt.UTC(),
+		LastDetectedAt: m.LastDetectedAt.UTC(),
+		UpdatedAt:      m.UpdatedAt.UTC(),
+	}
+	if m.ReviewedAt != nil {
+		reviewedAt := m.ReviewedAt.UTC()
+		discrepancy.ReviewedAt = &reviewedAt
+	}
+	return discrepancy
+}
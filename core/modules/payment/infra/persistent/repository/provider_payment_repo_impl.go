@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"wechat-clone/core/modules/payment/domain/entity"
 	paymentrepos "wechat-clone/core/modules/payment/domain/repos"
 	"wechat-clone/core/modules/payment/infra/persistent/model"
+	sharedevents "wechat-clone/core/shared/contracts/events"
 	shareddb "wechat-clone/core/shared/infra/db"
 	eventpkg "wechat-clone/core/shared/pkg/event"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -100,6 +102,85 @@ func (r *providerPaymentRepoImpl) ListPendingWithdrawals(ctx context.Context, li
 	return items, nil
 }
 
+func (r *providerPaymentRepoImpl) ListByAccount(ctx context.Context, filter paymentrepos.PaymentIntentListFilter) ([]*paymentaggregate.PaymentIntentAggregate, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+
+	query := r.db.WithContext(ctx).Model(&model.ProviderPaymentIntentModel{})
+	switch workflow := entity.NormalizePaymentWorkflow(filter.Workflow); workflow {
+	case entity.PaymentWorkflowWithdrawal:
+		query = query.Where("workflow = ? AND debit_account_id = ?", workflow, filter.AccountID)
+	case entity.PaymentWorkflowTopUp:
+		query = query.Where("workflow = ? AND credit_account_id = ?", workflow, filter.AccountID)
+	default:
+		query = query.Where(
+			"(workflow = ? AND debit_account_id = ?) OR (workflow <> ? AND credit_account_id = ?)",
+			entity.PaymentWorkflowWithdrawal, filter.AccountID,
+			entity.PaymentWorkflowWithdrawal, filter.AccountID,
+		)
+	}
+	if filter.CursorCreatedAt != nil {
+		query = query.Where(
+			"(created_at < ? OR (created_at = ? AND transaction_id < ?))",
+			*filter.CursorCreatedAt, *filter.CursorCreatedAt, filter.CursorTransactionID,
+		)
+	}
+
+	var paymentIntents []model.ProviderPaymentIntentModel
+	if err := query.
+		Order("created_at DESC").
+		Order("transaction_id DESC").
+		Limit(filter.Limit).
+		Find(&paymentIntents).Error; err != nil {
+		return nil, mapError(err)
+	}
+
+	items := make([]*paymentaggregate.PaymentIntentAggregate, 0, len(paymentIntents))
+	for idx := range paymentIntents {
+		agg, err := toProviderPaymentAggregate(&paymentIntents[idx])
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		items = append(items, agg)
+	}
+
+	return items, nil
+}
+
+func (r *providerPaymentRepoImpl) ListCreatedBetween(ctx context.Context, provider string, from, to time.Time) ([]*paymentaggregate.PaymentIntentAggregate, error) {
+	var paymentIntents []model.ProviderPaymentIntentModel
+	if err := r.db.WithContext(ctx).
+		Where("provider = ? AND created_at >= ? AND created_at < ?", strings.ToLower(strings.TrimSpace(provider)), from.UTC(), to.UTC()).
+		Order("created_at ASC").
+		Find(&paymentIntents).Error; err != nil {
+		return nil, mapError(err)
+	}
+
+	items := make([]*paymentaggregate.PaymentIntentAggregate, 0, len(paymentIntents))
+	for idx := range paymentIntents {
+		agg, err := toProviderPaymentAggregate(&paymentIntents[idx])
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		items = append(items, agg)
+	}
+
+	return items, nil
+}
+
+func (r *providerPaymentRepoImpl) ListStatusHistory(ctx context.Context, transactionID string) ([]entity.PaymentStatusChange, error) {
+	var events []model.PaymentOutboxEventModel
+	if err := r.db.WithContext(ctx).
+		Where("aggregate_id = ? AND aggregate_type = ?", transactionID, paymentaggregate.AggregateTypePaymentIntent).
+		Order("version ASC").
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, mapError(err)
+	}
+	return paymentStatusHistory(events), nil
+}
+
 func (r *providerPaymentRepoImpl) GetByExternalRef(ctx context.Context, provider, externalRef string) (*paymentaggregate.PaymentIntentAggregate, error) {
 	var paymentIntent model.ProviderPaymentIntentModel
 	if err := r.db.WithContext(ctx).
@@ -120,6 +201,7 @@ func (r *providerPaymentRepoImpl) createIntent(ctx context.Context, intent *enti
 		Amount:               intent.Amount,
 		FeeAmount:            intent.FeeAmount,
 		ProviderAmount:       intent.ProviderAmount,
+		RefundedAmount:       intent.RefundedAmount,
 		Currency:             intent.Currency,
 		ClearingAccountKey:   intent.ClearingAccountKey,
 		DebitAccountID:       toNullableString(intent.DebitAccountID),
@@ -144,6 +226,7 @@ func (r *providerPaymentRepoImpl) updateIntent(ctx context.Context, intent *enti
 			"amount":                 intent.Amount,
 			"fee_amount":             intent.FeeAmount,
 			"provider_amount":        intent.ProviderAmount,
+			"refunded_amount":        intent.RefundedAmount,
 			"currency":               intent.Currency,
 			"clearing_account_key":   intent.ClearingAccountKey,
 			"debit_account_id":       toNullableString(intent.DebitAccountID),
@@ -213,6 +296,55 @@ func (s *paymentOutboxEventStore) Append(ctx context.Context, evt eventpkg.Event
 	}).Error)
 }
 
+// paymentStatusHistory keeps the outbox events that moved an intent's status,
+// collapsing repeats such as a provider state change followed by the matching
+// failure event.
+func paymentStatusHistory(events []model.PaymentOutboxEventModel) []entity.PaymentStatusChange {
+	history := make([]entity.PaymentStatusChange, 0, len(events))
+	for _, evt := range events {
+		var data struct {
+			Status         string `json:"status"`
+			Amount         int64  `json:"amount"`
+			RefundedAmount int64  `json:"refunded_amount"`
+			ProviderAmount int64  `json:"provider_amount"`
+		}
+		if err := json.Unmarshal([]byte(evt.EventData), &data); err != nil {
+			continue
+		}
+
+		status := ""
+		switch evt.EventName {
+		case sharedevents.EventPaymentCreated, paymentaggregate.EventPaymentProviderStateChanged:
+			status = entity.NormalizePaymentStatus(data.Status)
+		case sharedevents.EventPaymentSucceeded:
+			status = entity.PaymentStatusSuccess
+		case sharedevents.EventPaymentFailed:
+			status = entity.PaymentStatusFailed
+		case sharedevents.EventPaymentRefunded:
+			// A partial refund leaves the payment successful.
+			if data.RefundedAmount == 0 || data.RefundedAmount >= data.ProviderAmount {
+				status = entity.PaymentStatusRefunded
+			}
+		case sharedevents.EventPaymentChargeback:
+			status = entity.PaymentStatusChargeback
+		}
+		if status == "" {
+			continue
+		}
+		if n := len(history); n > 0 && history[n-1].Status == status {
+			continue
+		}
+
+		history = append(history, entity.PaymentStatusChange{
+			EventName:  evt.EventName,
+			Status:     status,
+			Amount:     data.Amount,
+			OccurredAt: evt.CreatedAt.UTC(),
+		})
+	}
+	return history
+}
+
 func toProviderPaymentAggregate(modelIntent *model.ProviderPaymentIntentModel) (*paymentaggregate.PaymentIntentAggregate, error) {
 	externalRef := ""
 	if modelIntent.ExternalRef != nil {
@@ -227,6 +359,7 @@ func toProviderPaymentAggregate(modelIntent *model.ProviderPaymentIntentModel) (
 		Amount:               modelIntent.Amount,
 		FeeAmount:            modelIntent.FeeAmount,
 		ProviderAmount:       modelIntent.ProviderAmount,
+		RefundedAmount:       modelIntent.RefundedAmount,
 		Currency:             modelIntent.Currency,
 		ClearingAccountKey:   modelIntent.ClearingAccountKey,
 		DebitAccountID:       fromNullableString(modelIntent.DebitAccountID),
@@ -1,6 +1,14 @@
 package repository
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	paymentaggregate "wechat-clone/core/modules/payment/domain/aggregate"
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/infra/persistent/model"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+)
 
 func TestStorageExternalRefPlaceholderRoundTrip(t *testing.T) {
 	stored := toStorageExternalRef(" stripe ", " txn-1 ", "")
@@ -31,3 +39,41 @@ func TestStorageExternalRefKeepsRealValue(t *testing.T) {
 		t.Fatalf("unexpected restored value: %q", got)
 	}
 }
+
+func TestPaymentStatusHistoryKeepsStatusChanges(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	events := []model.PaymentOutboxEventModel{
+		{EventName: sharedevents.EventPaymentCreated, EventData: `{"status":"CREATING","amount":100}`, CreatedAt: now},
+		{EventName: paymentaggregate.EventPaymentProviderStateChanged, EventData: `{"previous_status":"CREATING","status":"PENDING","amount":110}`, CreatedAt: now.Add(time.Second)},
+		{EventName: sharedevents.EventPaymentCheckoutSessionCreated, EventData: `{"status":"PENDING"}`, CreatedAt: now.Add(time.Second)},
+		{EventName: sharedevents.EventPaymentSucceeded, EventData: `{"amount":100}`, CreatedAt: now.Add(2 * time.Second)},
+		{EventName: sharedevents.EventPaymentRefunded, EventData: `{"amount":40}`, CreatedAt: now.Add(3 * time.Second)},
+	}
+
+	history := paymentStatusHistory(events)
+	want := []string{entity.PaymentStatusCreating, entity.PaymentStatusPending, entity.PaymentStatusSuccess, entity.PaymentStatusRefunded}
+	if len(history) != len(want) {
+		t.Fatalf("expected %d history entries, got %+v", len(want), history)
+	}
+	for idx, status := range want {
+		if history[idx].Status != status {
+			t.Fatalf("entry %d: expected %s, got %s", idx, status, history[idx].Status)
+		}
+	}
+	if history[3].Amount != 40 || !history[3].OccurredAt.Equal(now.Add(3*time.Second)) {
+		t.Fatalf("unexpected refund entry: %+v", history[3])
+	}
+}
+
+func TestPaymentStatusHistoryCollapsesRepeatedStatus(t *testing.T) {
+	events := []model.PaymentOutboxEventModel{
+		{EventName: sharedevents.EventPaymentCreated, EventData: `{"status":"PENDING"}`},
+		{EventName: paymentaggregate.EventPaymentProviderStateChanged, EventData: `{"status":"FAILED"}`},
+		{EventName: sharedevents.EventPaymentFailed, EventData: `{"status":"FAILED"}`},
+	}
+
+	history := paymentStatusHistory(events)
+	if len(history) != 2 || history[1].Status != entity.PaymentStatusFailed {
+		t.Fatalf("expected failure to be recorded once, got %+v", history)
+	}
+}
@@ -16,6 +16,9 @@ type repoImpl struct {
 	db     *gorm.DB
 
 	paymentIntentAggregateRepo repos.PaymentIntentAggregateRepo
+	paymentIdempotencyKeyRepo  repos.PaymentIdempotencyKeyRepo
+	paymentReconciliationRepo  repos.PaymentReconciliationRepo
+	paymentDisputeRepo         repos.PaymentDisputeRepo
 }
 
 func NewRepoImpl(appCtx *appCtx.AppContext) repos.Repos {
@@ -28,6 +31,9 @@ func newRepoImplWithDB(appCtx *appCtx.AppContext, db *gorm.DB) repos.Repos {
 		db:     db,
 
 		paymentIntentAggregateRepo: NewPaymentIntentAggregateRepo(db),
+		paymentIdempotencyKeyRepo:  NewPaymentIdempotencyKeyRepo(db),
+		paymentReconciliationRepo:  NewPaymentReconciliationRepo(db),
+		paymentDisputeRepo:         NewPaymentDisputeRepo(db),
 	}
 }
 
@@ -35,6 +41,18 @@ func (r *repoImpl) PaymentIntentAggregateRepository() repos.PaymentIntentAggrega
 	return r.paymentIntentAggregateRepo
 }
 
+func (r *repoImpl) PaymentIdempotencyKeyRepository() repos.PaymentIdempotencyKeyRepo {
+	return r.paymentIdempotencyKeyRepo
+}
+
+func (r *repoImpl) PaymentReconciliationRepository() repos.PaymentReconciliationRepo {
+	return r.paymentReconciliationRepo
+}
+
+func (r *repoImpl) PaymentDisputeRepository() repos.PaymentDisputeRepo {
+	return r.paymentDisputeRepo
+}
+
 func (r *repoImpl) WithTransaction(ctx context.Context, fn func(repos.Repos) error) (err error) {
 	log := logging.FromContext(ctx).Named("StartPaymentTransaction")
 	tx := r.db.WithContext(ctx).Begin()
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"wechat-clone/core/modules/payment/domain/entity"
 	domainservice "wechat-clone/core/modules/payment/domain/service"
@@ -20,6 +21,15 @@ type paymentProviderAdapter struct {
 	provider providers.PaymentProvider
 }
 
+type settlementReporterAdapter struct {
+	name     string
+	reporter providers.SettlementReporter
+}
+
+type disputeEvidenceSubmitterAdapter struct {
+	submitter providers.DisputeEvidenceSubmitter
+}
+
 func NewPaymentProviderRegistry(registry *providers.ProviderRegistry) domainservice.PaymentProviderRegistry {
 	return &paymentProviderRegistry{registry: registry}
 }
@@ -32,6 +42,85 @@ func (r *paymentProviderRegistry) Get(name string) (domainservice.PaymentProvide
 	return &paymentProviderAdapter{provider: provider}, nil
 }
 
+func (r *paymentProviderRegistry) SettlementReporters() []domainservice.PaymentSettlementReporter {
+	items := make([]domainservice.PaymentSettlementReporter, 0)
+	for _, provider := range r.registry.List() {
+		reporter, ok := provider.(providers.SettlementReporter)
+		if !ok {
+			continue
+		}
+		items = append(items, &settlementReporterAdapter{
+			name:     strings.ToLower(strings.TrimSpace(provider.Name())),
+			reporter: reporter,
+		})
+	}
+	return items
+}
+
+func (r *paymentProviderRegistry) DisputeEvidenceSubmitter(name string) (domainservice.PaymentDisputeEvidenceSubmitter, error) {
+	provider, err := r.registry.Get(name)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	submitter, ok := provider.(providers.DisputeEvidenceSubmitter)
+	if !ok {
+		return nil, stackErr.Error(domainservice.ErrDisputeEvidenceUnsupported)
+	}
+	return &disputeEvidenceSubmitterAdapter{submitter: submitter}, nil
+}
+
+func (a *disputeEvidenceSubmitterAdapter) SubmitDisputeEvidence(
+	ctx context.Context,
+	dispute *entity.PaymentDispute,
+	files []domainservice.PaymentDisputeEvidenceFile,
+	note string,
+) error {
+	items := make([]providers.DisputeEvidenceFile, 0, len(files))
+	for _, file := range files {
+		items = append(items, providers.DisputeEvidenceFile{
+			Kind:        file.Kind,
+			FileName:    file.FileName,
+			ContentType: file.ContentType,
+			Content:     file.Content,
+		})
+	}
+	return stackErr.Error(a.submitter.SubmitDisputeEvidence(ctx, providers.SubmitDisputeEvidenceRequest{
+		DisputeID:     dispute.ProviderDisputeID,
+		TransactionID: dispute.TransactionID,
+		Files:         items,
+		Note:          strings.TrimSpace(note),
+	}))
+}
+
+func (a *settlementReporterAdapter) Name() string {
+	return a.name
+}
+
+func (a *settlementReporterAdapter) ListSettlements(ctx context.Context, from, to time.Time) ([]entity.PaymentSettlement, error) {
+	records, err := a.reporter.ListSettlements(ctx, providers.ListSettlementsRequest{From: from, To: to})
+	if err != nil {
+		if errors.Is(err, providers.ErrSettlementReportMissing) {
+			return nil, stackErr.Error(domainservice.ErrSettlementReportUnavailable)
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]entity.PaymentSettlement, 0, len(records))
+	for _, record := range records {
+		items = append(items, entity.PaymentSettlement{
+			Provider:      a.name,
+			TransactionID: strings.TrimSpace(record.TransactionID),
+			ExternalRef:   strings.TrimSpace(record.ExternalRef),
+			Workflow:      entity.NormalizePaymentWorkflow(record.Workflow),
+			Status:        entity.NormalizePaymentStatusOrPending(record.Status),
+			Amount:        record.Amount,
+			Currency:      strings.ToUpper(strings.TrimSpace(record.Currency)),
+			CreatedAt:     record.CreatedAt.UTC(),
+		})
+	}
+	return items, nil
+}
+
 func (a *paymentProviderAdapter) Name() string {
 	return strings.ToLower(strings.TrimSpace(a.provider.Name()))
 }
@@ -87,29 +176,39 @@ func (a *paymentProviderAdapter) CreateWithdrawal(
 func (a *paymentProviderAdapter) RefundPayment(
 	ctx context.Context,
 	intent *entity.PaymentIntent,
+	amount int64,
 	reason string,
+	idempotencyKey string,
 ) (*domainservice.PaymentRefund, error) {
 	response, err := a.provider.RefundPayment(ctx, providers.RefundPaymentRequest{
-		TransactionID: intent.TransactionID,
-		ExternalRef:   intent.ExternalRef,
-		Amount:        intent.ProviderAmount,
-		Currency:      intent.Currency,
-		Reason:        reason,
+		TransactionID:  intent.TransactionID,
+		ExternalRef:    intent.ExternalRef,
+		Amount:         amount,
+		Currency:       intent.Currency,
+		Reason:         reason,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
+	transactionID := coalesceProviderValue(response.TransactionID, intent.TransactionID)
+	refundID := coalesceProviderValue(response.RefundID, idempotencyKey)
+	eventID := fmt.Sprintf("refund:%s", transactionID)
+	if refundID != "" {
+		eventID += ":" + refundID
+	}
 	return &domainservice.PaymentRefund{
 		Provider: a.Name(),
 		Result: entity.PaymentProviderResult{
-			TransactionID: coalesceProviderValue(response.TransactionID, intent.TransactionID),
-			EventID:       fmt.Sprintf("refund:%s", coalesceProviderValue(response.TransactionID, intent.TransactionID)),
+			TransactionID: transactionID,
+			EventID:       eventID,
 			EventType:     "payment.refunded",
 			Status:        entity.NormalizePaymentStatusOrPending(response.Status),
 			Amount:        response.Amount,
 			Currency:      coalesceProviderValue(response.Currency, intent.Currency),
 			ExternalRef:   strings.TrimSpace(response.ExternalRef),
+			RefundID:      refundID,
 		},
 	}, nil
 }
@@ -135,6 +234,28 @@ func (a *paymentProviderAdapter) ParseWebhook(
 		return nil, stackErr.Error(err)
 	}
 
+	if result.Dispute != nil {
+		return &domainservice.PaymentWebhook{
+			Provider: a.Name(),
+			Result: entity.PaymentProviderResult{
+				TransactionID: strings.TrimSpace(result.TransactionID),
+				ExternalRef:   strings.TrimSpace(result.ExternalRef),
+			},
+			Dispute: &entity.PaymentDisputeUpdate{
+				ProviderDisputeID: strings.TrimSpace(result.Dispute.DisputeID),
+				TransactionID:     strings.TrimSpace(result.TransactionID),
+				ExternalRef:       strings.TrimSpace(result.ExternalRef),
+				EventID:           strings.TrimSpace(result.EventID),
+				EventType:         strings.TrimSpace(result.EventType),
+				Status:            strings.ToUpper(strings.TrimSpace(result.Dispute.Status)),
+				Reason:            strings.TrimSpace(result.Dispute.Reason),
+				Amount:            result.Amount,
+				Currency:          strings.ToUpper(strings.TrimSpace(result.Currency)),
+				EvidenceDueBy:     result.Dispute.EvidenceDueBy,
+			},
+		}, nil
+	}
+
 	return &domainservice.PaymentWebhook{
 		Provider: a.Name(),
 		Result: entity.PaymentProviderResult{
@@ -145,6 +266,7 @@ func (a *paymentProviderAdapter) ParseWebhook(
 			Amount:        result.Amount,
 			Currency:      strings.TrimSpace(result.Currency),
 			ExternalRef:   strings.TrimSpace(result.ExternalRef),
+			RefundID:      strings.TrimSpace(result.RefundID),
 		},
 	}, nil
 }
@@ -0,0 +1,379 @@
+package banktransfer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/infra/xtracer"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var _ providers.PaymentProvider = (*Provider)(nil)
+
+const ProviderName = "bank_transfer"
+
+const (
+	eventVirtualAccountPaid    = "virtual_account.paid"
+	eventVirtualAccountExpired = "virtual_account.expired"
+	eventDisbursementCompleted = "disbursement.completed"
+	eventDisbursementFailed    = "disbursement.failed"
+)
+
+const (
+	defaultAccountTTL = 24 * time.Hour
+	maxErrorBodyBytes = 4096
+)
+
+// Provider collects top-ups through per-payment virtual bank accounts and pays
+// withdrawals out as bank disbursements. Creating a payment only opens the
+// virtual account; the payer's transfer is confirmed later by webhook, so
+// every flow starts out PENDING. Amounts cross the API in minor units.
+type Provider struct {
+	apiBaseURL    string
+	apiKey        string
+	webhookSecret string
+	bankCode      string
+	accountName   string
+	accountTTL    time.Duration
+	httpClient    *http.Client
+	now           func() time.Time
+}
+
+func NewProvider(cfg config.LedgerBankTransferConfig) *Provider {
+	accountTTL := time.Duration(cfg.AccountTTLSeconds) * time.Second
+	if accountTTL <= 0 {
+		accountTTL = defaultAccountTTL
+	}
+
+	return &Provider{
+		apiBaseURL:    strings.TrimRight(strings.TrimSpace(cfg.APIBaseURL), "/"),
+		apiKey:        strings.TrimSpace(cfg.APIKey),
+		webhookSecret: strings.TrimSpace(cfg.WebhookSecret),
+		bankCode:      strings.TrimSpace(cfg.BankCode),
+		accountName:   strings.TrimSpace(cfg.AccountName),
+		accountTTL:    accountTTL,
+		httpClient: &http.Client{
+			Timeout:   15 * time.Second,
+			Transport: xtracer.NewTransport(),
+		},
+		now: time.Now,
+	}
+}
+
+// FromConfig is the bank transfer registry factory; the provider is
+// registered only when its API endpoint and key are set.
+func FromConfig(cfg config.LedgerConfig) providers.PaymentProvider {
+	provider := NewProvider(cfg.BankTransfer)
+	if !provider.Enabled() {
+		return nil
+	}
+	return provider
+}
+
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+func (p *Provider) Enabled() bool {
+	return p.apiBaseURL != "" && p.apiKey != ""
+}
+
+func (p *Provider) CreatePayment(ctx context.Context, req providers.CreatePaymentRequest) (*providers.CreatePaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("bank transfer provider is not configured")
+	}
+
+	body := virtualAccountRequest{
+		Reference:   req.TransactionID,
+		BankCode:    firstNonEmpty(req.Metadata["bank_code"], p.bankCode),
+		AccountName: firstNonEmpty(req.Metadata["account_name"], p.accountName),
+		Amount:      req.Amount,
+		Currency:    strings.ToUpper(req.Currency),
+		ExpiresAt:   p.now().UTC().Add(p.accountTTL).Format(time.RFC3339),
+	}
+
+	var account virtualAccount
+	if err := p.doJSON(ctx, http.MethodPost, "/v1/virtual-accounts", fmt.Sprintf("payment-create:%s", req.TransactionID), body, &account); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.TrimSpace(account.ID) == "" {
+		return nil, fmt.Errorf("bank transfer virtual account response missing id")
+	}
+
+	return &providers.CreatePaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: req.TransactionID,
+		ExternalRef:   account.ID,
+		Status:        entity.PaymentStatusPending,
+		CheckoutURL:   account.InstructionsURL,
+	}, nil
+}
+
+// CreateWithdrawal disburses to the intent's destination, written as
+// "<bank_code>:<account_number>".
+func (p *Provider) CreateWithdrawal(ctx context.Context, intent *entity.PaymentIntent, _ map[string]string) (*providers.CreatePaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("bank transfer provider is not configured")
+	}
+	if intent == nil {
+		return nil, fmt.Errorf("payment intent is required")
+	}
+	bankCode, accountNumber, ok := strings.Cut(strings.TrimSpace(intent.DestinationAccountID), ":")
+	if !ok || strings.TrimSpace(bankCode) == "" || strings.TrimSpace(accountNumber) == "" {
+		return nil, fmt.Errorf("bank transfer destination must be <bank_code>:<account_number>")
+	}
+
+	body := disbursementRequest{
+		Reference:     intent.TransactionID,
+		BankCode:      strings.TrimSpace(bankCode),
+		AccountNumber: strings.TrimSpace(accountNumber),
+		Amount:        intent.ProviderAmount,
+		Currency:      strings.ToUpper(intent.Currency),
+		Description:   "Wallet withdrawal",
+	}
+
+	var created disbursement
+	if err := p.doJSON(ctx, http.MethodPost, "/v1/disbursements", fmt.Sprintf("payment-withdrawal:%s", intent.TransactionID), body, &created); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.TrimSpace(created.ID) == "" {
+		return nil, fmt.Errorf("bank transfer disbursement response missing id")
+	}
+
+	return &providers.CreatePaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: intent.TransactionID,
+		ExternalRef:   created.ID,
+		Status:        disbursementStatus(created.Status),
+	}, nil
+}
+
+// RefundPayment sends the received funds back to the account they came from.
+// The gateway accepts the refund and settles it by bank transfer later; an
+// accepted refund is reported as refunded.
+func (p *Provider) RefundPayment(ctx context.Context, req providers.RefundPaymentRequest) (*providers.RefundPaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("bank transfer provider is not configured")
+	}
+	transactionID := strings.TrimSpace(req.TransactionID)
+	if transactionID == "" {
+		return nil, fmt.Errorf("transaction_id is required")
+	}
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("refund amount must be positive")
+	}
+	accountID := strings.TrimSpace(req.ExternalRef)
+	if accountID == "" {
+		return nil, fmt.Errorf("provider payment reference is required for refund")
+	}
+
+	body := refundRequest{
+		Reference:        transactionID,
+		VirtualAccountID: accountID,
+		Amount:           req.Amount,
+		Currency:         strings.ToUpper(req.Currency),
+		Reason:           strings.TrimSpace(req.Reason),
+	}
+
+	var created refund
+	if err := p.doJSON(ctx, http.MethodPost, "/v1/refunds", req.ProviderIdempotencyKey(), body, &created); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.EqualFold(strings.TrimSpace(created.Status), "FAILED") {
+		return nil, fmt.Errorf("bank transfer refund %s failed", created.ID)
+	}
+
+	amount := req.Amount
+	if created.Amount > 0 {
+		amount = created.Amount
+	}
+	return &providers.RefundPaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: transactionID,
+		ExternalRef:   accountID,
+		RefundID:      created.ID,
+		Status:        entity.PaymentStatusRefunded,
+		Amount:        amount,
+		Currency:      firstNonEmpty(created.Currency, req.Currency),
+	}, nil
+}
+
+func (p *Provider) VerifyWebhook(_ context.Context, payload []byte, signature string) (*providers.WebhookEvent, error) {
+	if err := providers.VerifyWebhookPayload(payload, signature, p.webhookSecret, providers.DefaultWebhookTolerance, p.now()); err != nil {
+		return nil, err
+	}
+
+	var envelope webhookEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("decode bank transfer webhook payload: %w", err)
+	}
+
+	return &providers.WebhookEvent{
+		Provider:  ProviderName,
+		EventID:   envelope.ID,
+		EventType: envelope.Type,
+		Attributes: map[string]string{
+			"data": string(envelope.Data),
+		},
+	}, nil
+}
+
+func (p *Provider) ParseEvent(_ context.Context, event *providers.WebhookEvent) (*providers.PaymentResult, error) {
+	var status string
+	switch event.EventType {
+	case eventVirtualAccountPaid, eventDisbursementCompleted:
+		status = entity.PaymentStatusSuccess
+	case eventVirtualAccountExpired:
+		status = entity.PaymentStatusCancelled
+	case eventDisbursementFailed:
+		status = entity.PaymentStatusFailed
+	default:
+		return nil, providers.ErrWebhookEventIgnored
+	}
+
+	var data webhookData
+	if err := json.Unmarshal([]byte(event.Attributes["data"]), &data); err != nil {
+		return nil, fmt.Errorf("decode bank transfer %s event: %w", event.EventType, err)
+	}
+
+	return &providers.PaymentResult{
+		TransactionID: strings.TrimSpace(data.Reference),
+		EventID:       event.EventID,
+		EventType:     event.EventType,
+		Status:        status,
+		Amount:        data.Amount,
+		Currency:      strings.ToUpper(strings.TrimSpace(data.Currency)),
+		ExternalRef:   firstNonEmpty(data.VirtualAccountID, data.ID),
+	}, nil
+}
+
+func (p *Provider) doJSON(ctx context.Context, method, path, idempotencyKey string, body, out any) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encode bank transfer request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.apiBaseURL+path, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyBytes))
+		return fmt.Errorf("bank transfer %s %s: status %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode bank transfer %s response: %w", path, err)
+	}
+	return nil
+}
+
+func disbursementStatus(status string) string {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "COMPLETED":
+		return entity.PaymentStatusSuccess
+	case "FAILED":
+		return entity.PaymentStatusFailed
+	default:
+		return entity.PaymentStatusPending
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+type virtualAccountRequest struct {
+	Reference   string `json:"reference"`
+	BankCode    string `json:"bank_code,omitempty"`
+	AccountName string `json:"account_name,omitempty"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+type virtualAccount struct {
+	ID              string `json:"id"`
+	Reference       string `json:"reference"`
+	BankCode        string `json:"bank_code"`
+	AccountNumber   string `json:"account_number"`
+	AccountName     string `json:"account_name"`
+	Amount          int64  `json:"amount"`
+	Currency        string `json:"currency"`
+	Status          string `json:"status"`
+	ExpiresAt       string `json:"expires_at"`
+	InstructionsURL string `json:"instructions_url"`
+}
+
+type disbursementRequest struct {
+	Reference     string `json:"reference"`
+	BankCode      string `json:"bank_code"`
+	AccountNumber string `json:"account_number"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	Description   string `json:"description,omitempty"`
+}
+
+type disbursement struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type refundRequest struct {
+	Reference        string `json:"reference"`
+	VirtualAccountID string `json:"virtual_account_id"`
+	Amount           int64  `json:"amount"`
+	Currency         string `json:"currency"`
+	Reason           string `json:"reason,omitempty"`
+}
+
+type refund struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type webhookEnvelope struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// webhookData covers both virtual account and disbursement events; reference
+// is always the payment transaction id.
+type webhookData struct {
+	ID                string `json:"id"`
+	VirtualAccountID  string `json:"virtual_account_id"`
+	Reference         string `json:"reference"`
+	Amount            int64  `json:"amount"`
+	Currency          string `json:"currency"`
+	BankTransactionID string `json:"bank_transaction_id"`
+}
@@ -0,0 +1,154 @@
+package banktransfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/modules/payment/providers/providertest"
+	"wechat-clone/core/shared/config"
+)
+
+const testWebhookSecret = "whsec_bank_test"
+
+func TestProviderContract(t *testing.T) {
+	providertest.Run(t, providertest.Harness{
+		NewProvider: func(_ *testing.T, baseURL string) providers.PaymentProvider {
+			return newTestProvider(baseURL)
+		},
+		Stub:         newGatewayStub(t),
+		Currency:     "VND",
+		Destination:  "VCB:0011001234567",
+		Sign:         signWebhook,
+		Webhook:      renderWebhook,
+		PayloadBound: true,
+	})
+}
+
+func TestCreateWithdrawalRejectsMalformedDestination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected gateway call %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	_, err := newTestProvider(server.URL).CreateWithdrawal(context.Background(), &entity.PaymentIntent{
+		TransactionID:        "txn-1",
+		ProviderAmount:       1000,
+		Currency:             "VND",
+		DestinationAccountID: "0011001234567",
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), "<bank_code>:<account_number>") {
+		t.Fatalf("expected destination format error, got %v", err)
+	}
+}
+
+func newTestProvider(baseURL string) *Provider {
+	return NewProvider(config.LedgerBankTransferConfig{
+		APIBaseURL:    baseURL,
+		APIKey:        "key-1",
+		WebhookSecret: testWebhookSecret,
+		BankCode:      "VCB",
+		AccountName:   "WECHAT CLONE",
+	})
+}
+
+// newGatewayStub plays the gateway's virtual account, disbursement and refund
+// API.
+func newGatewayStub(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/virtual-accounts", requireAPIKey(t, func(w http.ResponseWriter, r *http.Request) {
+		var body virtualAccountRequest
+		decodeJSON(t, r, &body)
+		if body.BankCode != "VCB" || body.Amount != 1250 || body.ExpiresAt == "" {
+			t.Errorf("unexpected virtual account request %+v", body)
+		}
+		writeJSON(w, map[string]any{
+			"id":               "va_1",
+			"reference":        body.Reference,
+			"bank_code":        body.BankCode,
+			"account_number":   "9704000000001",
+			"account_name":     body.AccountName,
+			"amount":           body.Amount,
+			"currency":         body.Currency,
+			"status":           "ACTIVE",
+			"expires_at":       body.ExpiresAt,
+			"instructions_url": "https://gateway.local/va/va_1",
+		})
+	}))
+	mux.HandleFunc("POST /v1/disbursements", requireAPIKey(t, func(w http.ResponseWriter, r *http.Request) {
+		var body disbursementRequest
+		decodeJSON(t, r, &body)
+		if body.BankCode != "VCB" || body.AccountNumber != "0011001234567" {
+			t.Errorf("unexpected disbursement destination %+v", body)
+		}
+		writeJSON(w, map[string]any{"id": "disb_1", "status": "PROCESSING"})
+	}))
+	mux.HandleFunc("POST /v1/refunds", requireAPIKey(t, func(w http.ResponseWriter, r *http.Request) {
+		var body refundRequest
+		decodeJSON(t, r, &body)
+		if body.VirtualAccountID != "va_1" {
+			t.Errorf("expected refund against va_1, got %q", body.VirtualAccountID)
+		}
+		writeJSON(w, map[string]any{"id": "rf_1", "status": "PROCESSING", "amount": body.Amount, "currency": body.Currency})
+	}))
+	return mux
+}
+
+func requireAPIKey(t *testing.T, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key-1" {
+			t.Errorf("expected api key on %s", r.URL.Path)
+			http.Error(w, `{"error":"unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("Idempotency-Key") == "" {
+			t.Errorf("expected idempotency key on %s", r.URL.Path)
+		}
+		next(w, r)
+	}
+}
+
+func signWebhook(_ *testing.T, payload []byte) string {
+	return providers.SignWebhookPayload(payload, testWebhookSecret, time.Now())
+}
+
+func renderWebhook(_ *testing.T, scenario providertest.WebhookScenario) []byte {
+	eventType := eventVirtualAccountPaid
+	switch scenario.Outcome {
+	case providertest.OutcomeFailed:
+		eventType = eventVirtualAccountExpired
+	case providertest.OutcomeIgnored:
+		eventType = "virtual_account.created"
+	}
+
+	return []byte(fmt.Sprintf(`{
+		"id":%q,
+		"type":%q,
+		"created_at":"2026-03-01T00:00:00Z",
+		"data":{
+			"virtual_account_id":%q,
+			"reference":%q,
+			"amount":%d,
+			"currency":%q,
+			"bank_transaction_id":"FT2606000001"
+		}
+	}`, scenario.EventID, eventType, scenario.ExternalRef, scenario.TransactionID, scenario.Amount, scenario.Currency))
+}
+
+func decodeJSON(t *testing.T, r *http.Request, out any) {
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		t.Errorf("decode %s body: %v", r.URL.Path, err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -4,22 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"wechat-clone/core/modules/payment/domain/entity"
 	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/shared/config"
 )
 
-var _ providers.PaymentProvider = (*Provider)(nil)
+var (
+	_ providers.PaymentProvider    = (*Provider)(nil)
+	_ providers.SettlementReporter = (*Provider)(nil)
+)
 
 const ProviderName = "mock"
 
 type Provider struct {
-	webhookSecret string
+	webhookSecret        string
+	settlementReportFile string
+}
+
+// NewProvider builds the mock provider. settlementReportFile points to a JSON
+// array of settlement records standing in for the provider's report; when it
+// is empty the provider is skipped by reconciliation.
+func NewProvider(webhookSecret, settlementReportFile string) *Provider {
+	return &Provider{
+		webhookSecret:        webhookSecret,
+		settlementReportFile: strings.TrimSpace(settlementReportFile),
+	}
 }
 
-func NewProvider(webhookSecret string) *Provider {
-	return &Provider{webhookSecret: webhookSecret}
+// FromConfig is the mock provider's registry factory; the mock provider is
+// always registered.
+func FromConfig(cfg config.LedgerConfig) providers.PaymentProvider {
+	return NewProvider(cfg.MockWebhookSecret, cfg.MockSettlementReportFile)
 }
 
 func (p *Provider) Name() string {
@@ -53,6 +72,7 @@ func (p *Provider) RefundPayment(_ context.Context, req providers.RefundPaymentR
 		Provider:      ProviderName,
 		TransactionID: transactionID,
 		ExternalRef:   firstNonEmpty(req.ExternalRef, externalRef),
+		RefundID:      "mock_" + req.ProviderIdempotencyKey(),
 		Status:        entity.PaymentStatusRefunded,
 		Amount:        req.Amount,
 		Currency:      req.Currency,
@@ -119,6 +139,38 @@ func (p *Provider) ParseEvent(_ context.Context, event *providers.WebhookEvent)
 	}, nil
 }
 
+func (p *Provider) ListSettlements(_ context.Context, req providers.ListSettlementsRequest) ([]providers.SettlementTransaction, error) {
+	if p.settlementReportFile == "" {
+		return nil, providers.ErrSettlementReportMissing
+	}
+
+	raw, err := os.ReadFile(p.settlementReportFile)
+	if err != nil {
+		return nil, fmt.Errorf("read mock settlement report: %w", err)
+	}
+	var records []settlementRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, fmt.Errorf("decode mock settlement report: %w", err)
+	}
+
+	items := make([]providers.SettlementTransaction, 0, len(records))
+	for _, record := range records {
+		if record.CreatedAt.Before(req.From) || !record.CreatedAt.Before(req.To) {
+			continue
+		}
+		items = append(items, providers.SettlementTransaction{
+			TransactionID: strings.TrimSpace(record.TransactionID),
+			ExternalRef:   strings.TrimSpace(record.ExternalRef),
+			Workflow:      firstNonEmpty(record.Workflow, entity.PaymentWorkflowTopUp),
+			Status:        firstNonEmpty(record.Status, entity.PaymentStatusSuccess),
+			Amount:        record.Amount,
+			Currency:      strings.TrimSpace(record.Currency),
+			CreatedAt:     record.CreatedAt.UTC(),
+		})
+	}
+	return items, nil
+}
+
 func firstNonEmpty(values ...string) string {
 	for _, value := range values {
 		if value = strings.TrimSpace(value); value != "" {
@@ -137,3 +189,13 @@ type webhookPayload struct {
 	Amount        int64  `json:"amount"`
 	Currency      string `json:"currency"`
 }
+
+type settlementRecord struct {
+	TransactionID string    `json:"transaction_id"`
+	ExternalRef   string    `json:"external_ref"`
+	Workflow      string    `json:"workflow"`
+	Status        string    `json:"status"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	CreatedAt     time.Time `json:"created_at"`
+}
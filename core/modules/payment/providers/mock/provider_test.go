@@ -0,0 +1,48 @@
+package mock
+
+import (
+	"fmt"
+	"testing"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/modules/payment/providers/providertest"
+)
+
+const testWebhookSecret = "mock-secret"
+
+func TestProviderContract(t *testing.T) {
+	providertest.Run(t, providertest.Harness{
+		NewProvider: func(_ *testing.T, _ string) providers.PaymentProvider {
+			return NewProvider(testWebhookSecret, "")
+		},
+		Currency:    "VND",
+		Destination: "acct_contract",
+		Sign: func(_ *testing.T, _ []byte) string {
+			return testWebhookSecret
+		},
+		Webhook: renderWebhook,
+	})
+}
+
+// renderWebhook renders the mock's flat webhook body. The mock forwards any
+// event it receives, so it has no ignored event type.
+func renderWebhook(_ *testing.T, scenario providertest.WebhookScenario) []byte {
+	status := entity.PaymentStatusSuccess
+	switch scenario.Outcome {
+	case providertest.OutcomeFailed:
+		status = entity.PaymentStatusFailed
+	case providertest.OutcomeIgnored:
+		return nil
+	}
+
+	return []byte(fmt.Sprintf(`{
+		"event_id":%q,
+		"event_type":"payment.updated",
+		"transaction_id":%q,
+		"external_ref":%q,
+		"status":%q,
+		"amount":%d,
+		"currency":%q
+	}`, scenario.EventID, scenario.TransactionID, scenario.ExternalRef, status, scenario.Amount, scenario.Currency))
+}
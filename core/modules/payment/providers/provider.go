@@ -3,6 +3,9 @@ package providers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"wechat-clone/core/modules/payment/domain/entity"
 )
@@ -11,6 +14,7 @@ var (
 	ErrProviderNotFound        = errors.New("provider not found")
 	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
 	ErrWebhookEventIgnored     = errors.New("webhook event ignored")
+	ErrSettlementReportMissing = errors.New("settlement report is not configured")
 )
 
 type CreatePaymentRequest struct {
@@ -20,12 +24,27 @@ type CreatePaymentRequest struct {
 	Metadata      map[string]string
 }
 
+// RefundPaymentRequest asks for one refund of a payment. IdempotencyKey is
+// the caller's key for this refund; a retry with the same key must return the
+// same refund rather than refund again.
 type RefundPaymentRequest struct {
-	TransactionID string
-	ExternalRef   string
-	Amount        int64
-	Currency      string
-	Reason        string
+	TransactionID  string
+	ExternalRef    string
+	Amount         int64
+	Currency       string
+	Reason         string
+	IdempotencyKey string
+}
+
+// ProviderIdempotencyKey is the key sent to the provider for the refund. It is
+// scoped to the caller's key so separate partial refunds of one payment are
+// not collapsed into the first.
+func (r RefundPaymentRequest) ProviderIdempotencyKey() string {
+	key := fmt.Sprintf("payment-refund:%s", strings.TrimSpace(r.TransactionID))
+	if idempotencyKey := strings.TrimSpace(r.IdempotencyKey); idempotencyKey != "" {
+		key += ":" + idempotencyKey
+	}
+	return key
 }
 
 type CreatePaymentResponse struct {
@@ -40,6 +59,7 @@ type RefundPaymentResponse struct {
 	Provider      string `json:"provider"`
 	TransactionID string `json:"transaction_id"`
 	ExternalRef   string `json:"external_ref,omitempty"`
+	RefundID      string `json:"refund_id,omitempty"`
 	Status        string `json:"status"`
 	Amount        int64  `json:"amount,omitempty"`
 	Currency      string `json:"currency,omitempty"`
@@ -60,6 +80,52 @@ type PaymentResult struct {
 	Amount        int64
 	Currency      string
 	ExternalRef   string
+	// RefundID names the refund a refund event is about, when the provider
+	// reports refunds one by one.
+	RefundID string
+	// Dispute is set when the event is about a dispute rather than the
+	// payment itself.
+	Dispute *DisputeResult
+}
+
+// DisputeResult identifies a dispute and its provider-side state. Status is
+// one of OPENED, NEEDS_EVIDENCE, UNDER_REVIEW, WON or LOST.
+type DisputeResult struct {
+	DisputeID     string
+	Status        string
+	Reason        string
+	EvidenceDueBy *time.Time
+}
+
+type DisputeEvidenceFile struct {
+	Kind        string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type SubmitDisputeEvidenceRequest struct {
+	DisputeID     string
+	TransactionID string
+	Files         []DisputeEvidenceFile
+	Note          string
+}
+
+type ListSettlementsRequest struct {
+	From time.Time
+	To   time.Time
+}
+
+// SettlementTransaction is one money movement as the provider reports it.
+// Workflow tells top-up charges apart from withdrawal transfers.
+type SettlementTransaction struct {
+	TransactionID string
+	ExternalRef   string
+	Workflow      string
+	Status        string
+	Amount        int64
+	Currency      string
+	CreatedAt     time.Time
 }
 
 //go:generate mockgen -package=providers -destination=provider_mock.go -source=provider.go
@@ -71,3 +137,15 @@ type PaymentProvider interface {
 	VerifyWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error)
 	ParseEvent(ctx context.Context, event *WebhookEvent) (*PaymentResult, error)
 }
+
+// SettlementReporter is an optional capability: providers implementing it
+// take part in scheduled reconciliation.
+type SettlementReporter interface {
+	ListSettlements(ctx context.Context, req ListSettlementsRequest) ([]SettlementTransaction, error)
+}
+
+// DisputeEvidenceSubmitter is an optional capability: providers implementing
+// it accept evidence files for their disputes.
+type DisputeEvidenceSubmitter interface {
+	SubmitDisputeEvidence(ctx context.Context, req SubmitDisputeEvidenceRequest) error
+}
@@ -71,21 +71,6 @@ func (mr *MockPaymentProviderMockRecorder) CreateWithdrawal(ctx, intent, metadat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithdrawal", reflect.TypeOf((*MockPaymentProvider)(nil).CreateWithdrawal), ctx, intent, metadata)
 }
 
-// RefundPayment mocks base method.
-func (m *MockPaymentProvider) RefundPayment(ctx context.Context, req RefundPaymentRequest) (*RefundPaymentResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefundPayment", ctx, req)
-	ret0, _ := ret[0].(*RefundPaymentResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// RefundPayment indicates an expected call of RefundPayment.
-func (mr *MockPaymentProviderMockRecorder) RefundPayment(ctx, req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentProvider)(nil).RefundPayment), ctx, req)
-}
-
 // Name mocks base method.
 func (m *MockPaymentProvider) Name() string {
 	m.ctrl.T.Helper()
@@ -115,6 +100,21 @@ func (mr *MockPaymentProviderMockRecorder) ParseEvent(ctx, event any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseEvent", reflect.TypeOf((*MockPaymentProvider)(nil).ParseEvent), ctx, event)
 }
 
+// RefundPayment mocks base method.
+func (m *MockPaymentProvider) RefundPayment(ctx context.Context, req RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, req)
+	ret0, _ := ret[0].(*RefundPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockPaymentProviderMockRecorder) RefundPayment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentProvider)(nil).RefundPayment), ctx, req)
+}
+
 // VerifyWebhook mocks base method.
 func (m *MockPaymentProvider) VerifyWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error) {
 	m.ctrl.T.Helper()
@@ -129,3 +129,80 @@ func (mr *MockPaymentProviderMockRecorder) VerifyWebhook(ctx, payload, signature
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWebhook", reflect.TypeOf((*MockPaymentProvider)(nil).VerifyWebhook), ctx, payload, signature)
 }
+
+// MockSettlementReporter is a mock of SettlementReporter interface.
+type MockSettlementReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementReporterMockRecorder
+	isgomock struct{}
+}
+
+// MockSettlementReporterMockRecorder is the mock recorder for MockSettlementReporter.
+type MockSettlementReporterMockRecorder struct {
+	mock *MockSettlementReporter
+}
+
+// NewMockSettlementReporter creates a new mock instance.
+func NewMockSettlementReporter(ctrl *gomock.Controller) *MockSettlementReporter {
+	mock := &MockSettlementReporter{ctrl: ctrl}
+	mock.recorder = &MockSettlementReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementReporter) EXPECT() *MockSettlementReporterMockRecorder {
+	return m.recorder
+}
+
+// ListSettlements mocks base method.
+func (m *MockSettlementReporter) ListSettlements(ctx context.Context, req ListSettlementsRequest) ([]SettlementTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSettlements", ctx, req)
+	ret0, _ := ret[0].([]SettlementTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSettlements indicates an expected call of ListSettlements.
+func (mr *MockSettlementReporterMockRecorder) ListSettlements(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSettlements", reflect.TypeOf((*MockSettlementReporter)(nil).ListSettlements), ctx, req)
+}
+
+// MockDisputeEvidenceSubmitter is a mock of DisputeEvidenceSubmitter interface.
+type MockDisputeEvidenceSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisputeEvidenceSubmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockDisputeEvidenceSubmitterMockRecorder is the mock recorder for MockDisputeEvidenceSubmitter.
+type MockDisputeEvidenceSubmitterMockRecorder struct {
+	mock *MockDisputeEvidenceSubmitter
+}
+
+// NewMockDisputeEvidenceSubmitter creates a new mock instance.
+func NewMockDisputeEvidenceSubmitter(ctrl *gomock.Controller) *MockDisputeEvidenceSubmitter {
+	mock := &MockDisputeEvidenceSubmitter{ctrl: ctrl}
+	mock.recorder = &MockDisputeEvidenceSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisputeEvidenceSubmitter) EXPECT() *MockDisputeEvidenceSubmitterMockRecorder {
+	return m.recorder
+}
+
+// SubmitDisputeEvidence mocks base method.
+func (m *MockDisputeEvidenceSubmitter) SubmitDisputeEvidence(ctx context.Context, req SubmitDisputeEvidenceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDisputeEvidence", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitDisputeEvidence indicates an expected call of SubmitDisputeEvidence.
+func (mr *MockDisputeEvidenceSubmitterMockRecorder) SubmitDisputeEvidence(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDisputeEvidence", reflect.TypeOf((*MockDisputeEvidenceSubmitter)(nil).SubmitDisputeEvidence), ctx, req)
+}
@@ -0,0 +1,262 @@
+// Package providertest holds the contract every providers.PaymentProvider
+// adapter must satisfy. Each adapter's tests run Run against a local HTTP stub
+// of its gateway, so the adapters are checked by the same assertions.
+package providertest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/providers"
+)
+
+type WebhookOutcome string
+
+const (
+	OutcomeSucceeded WebhookOutcome = "succeeded"
+	OutcomeFailed    WebhookOutcome = "failed"
+	OutcomeIgnored   WebhookOutcome = "ignored"
+)
+
+// WebhookScenario describes a gateway notification the harness must render
+// in the provider's wire format.
+type WebhookScenario struct {
+	Outcome       WebhookOutcome
+	EventID       string
+	TransactionID string
+	ExternalRef   string
+	Amount        int64
+	Currency      string
+}
+
+type Harness struct {
+	// NewProvider builds the adapter against the stub gateway at baseURL.
+	NewProvider func(t *testing.T, baseURL string) providers.PaymentProvider
+	// Stub plays the gateway API. It may be nil for adapters that make no
+	// outbound calls.
+	Stub http.Handler
+	// Currency is used for every amount in the suite.
+	Currency string
+	// Destination is a withdrawal destination in the provider's format.
+	Destination string
+	// Sign returns the signature header value for a webhook payload.
+	Sign func(t *testing.T, payload []byte) string
+	// Webhook renders the scenario, or returns nil when the provider has no
+	// equivalent notification.
+	Webhook func(t *testing.T, scenario WebhookScenario) []byte
+	// PayloadBound reports whether the signature covers the payload, so a
+	// tampered body must be rejected.
+	PayloadBound bool
+}
+
+const (
+	contractTransactionID = "txn-contract-1"
+	contractAmount        = int64(1250)
+)
+
+// Run runs the shared contract suite against the harness's adapter.
+func Run(t *testing.T, h Harness) {
+	t.Helper()
+
+	baseURL := ""
+	if h.Stub != nil {
+		server := httptest.NewServer(h.Stub)
+		t.Cleanup(server.Close)
+		baseURL = server.URL
+	}
+	ctx := context.Background()
+
+	t.Run("name is a lowercase registry key", func(t *testing.T) {
+		name := h.NewProvider(t, baseURL).Name()
+		if name == "" || name != strings.ToLower(strings.TrimSpace(name)) {
+			t.Fatalf("expected lowercase provider name, got %q", name)
+		}
+	})
+
+	t.Run("create payment returns a checkout reference", func(t *testing.T) {
+		provider := h.NewProvider(t, baseURL)
+		res := createPayment(t, ctx, provider, h.Currency)
+		if res.Provider != provider.Name() {
+			t.Fatalf("expected provider %q, got %q", provider.Name(), res.Provider)
+		}
+		if res.TransactionID != contractTransactionID {
+			t.Fatalf("expected transaction_id %s, got %s", contractTransactionID, res.TransactionID)
+		}
+		if res.ExternalRef == "" {
+			t.Fatalf("expected external_ref")
+		}
+		if res.CheckoutURL == "" {
+			t.Fatalf("expected checkout_url")
+		}
+		assertStatusIn(t, res.Status, entity.PaymentStatusPending, entity.PaymentStatusSuccess)
+	})
+
+	t.Run("create withdrawal returns a transfer reference", func(t *testing.T) {
+		provider := h.NewProvider(t, baseURL)
+		res, err := provider.CreateWithdrawal(ctx, &entity.PaymentIntent{
+			TransactionID:        contractTransactionID,
+			Provider:             provider.Name(),
+			Workflow:             entity.PaymentWorkflowWithdrawal,
+			Amount:               contractAmount,
+			ProviderAmount:       contractAmount,
+			Currency:             h.Currency,
+			DestinationAccountID: h.Destination,
+		}, nil)
+		if err != nil {
+			t.Fatalf("create withdrawal: %v", err)
+		}
+		if res.TransactionID != contractTransactionID || res.ExternalRef == "" {
+			t.Fatalf("unexpected withdrawal response %+v", res)
+		}
+		assertStatusIn(t, res.Status, entity.PaymentStatusPending, entity.PaymentStatusSuccess)
+	})
+
+	t.Run("refund payment reports the refunded amount", func(t *testing.T) {
+		provider := h.NewProvider(t, baseURL)
+		created := createPayment(t, ctx, provider, h.Currency)
+		res, err := provider.RefundPayment(ctx, providers.RefundPaymentRequest{
+			TransactionID: contractTransactionID,
+			ExternalRef:   created.ExternalRef,
+			Amount:        contractAmount / 2,
+			Currency:      h.Currency,
+			Reason:        "contract",
+		})
+		if err != nil {
+			t.Fatalf("refund payment: %v", err)
+		}
+		if res.Status != entity.PaymentStatusRefunded {
+			t.Fatalf("expected refunded status, got %s", res.Status)
+		}
+		if res.Amount != contractAmount/2 || !strings.EqualFold(res.Currency, h.Currency) {
+			t.Fatalf("expected refund of %d %s, got %d %s", contractAmount/2, h.Currency, res.Amount, res.Currency)
+		}
+	})
+
+	t.Run("webhook with invalid signature is rejected", func(t *testing.T) {
+		provider := h.NewProvider(t, baseURL)
+		payload := webhook(t, h, OutcomeSucceeded)
+		if _, err := provider.VerifyWebhook(ctx, payload, "t=1,v1=invalid"); !errors.Is(err, providers.ErrInvalidWebhookSignature) {
+			t.Fatalf("expected invalid signature error, got %v", err)
+		}
+	})
+
+	t.Run("webhook with tampered payload is rejected", func(t *testing.T) {
+		if !h.PayloadBound {
+			t.Skip("provider signature does not cover the payload")
+		}
+		provider := h.NewProvider(t, baseURL)
+		payload := webhook(t, h, OutcomeSucceeded)
+		signature := h.Sign(t, payload)
+		tampered := []byte(strings.Replace(string(payload), contractTransactionID, "txn-contract-2", 1))
+		if _, err := provider.VerifyWebhook(ctx, tampered, signature); !errors.Is(err, providers.ErrInvalidWebhookSignature) {
+			t.Fatalf("expected invalid signature error, got %v", err)
+		}
+	})
+
+	t.Run("succeeded webhook parses to a successful result", func(t *testing.T) {
+		result := parseWebhook(t, ctx, h, baseURL, OutcomeSucceeded)
+		if result.Status != entity.PaymentStatusSuccess {
+			t.Fatalf("expected success status, got %s", result.Status)
+		}
+		if result.Amount != contractAmount || !strings.EqualFold(result.Currency, h.Currency) {
+			t.Fatalf("expected %d %s, got %d %s", contractAmount, h.Currency, result.Amount, result.Currency)
+		}
+	})
+
+	t.Run("failed webhook parses to a terminal failure", func(t *testing.T) {
+		result := parseWebhook(t, ctx, h, baseURL, OutcomeFailed)
+		assertStatusIn(t, result.Status, entity.PaymentStatusFailed, entity.PaymentStatusCancelled)
+	})
+
+	t.Run("unknown webhook event is ignored", func(t *testing.T) {
+		payload := h.Webhook(t, scenario(h, OutcomeIgnored))
+		if payload == nil {
+			t.Skip("provider has no ignored event type")
+		}
+		provider := h.NewProvider(t, baseURL)
+		event, err := provider.VerifyWebhook(ctx, payload, h.Sign(t, payload))
+		if err != nil {
+			t.Fatalf("verify webhook: %v", err)
+		}
+		if _, err := provider.ParseEvent(ctx, event); !errors.Is(err, providers.ErrWebhookEventIgnored) {
+			t.Fatalf("expected ignored event error, got %v", err)
+		}
+	})
+}
+
+func createPayment(t *testing.T, ctx context.Context, provider providers.PaymentProvider, currency string) *providers.CreatePaymentResponse {
+	t.Helper()
+
+	res, err := provider.CreatePayment(ctx, providers.CreatePaymentRequest{
+		TransactionID: contractTransactionID,
+		Amount:        contractAmount,
+		Currency:      currency,
+		Metadata: map[string]string{
+			"success_url": "https://app.local/payment/success",
+			"cancel_url":  "https://app.local/payment/failure",
+		},
+	})
+	if err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+	return res
+}
+
+func parseWebhook(t *testing.T, ctx context.Context, h Harness, baseURL string, outcome WebhookOutcome) *providers.PaymentResult {
+	t.Helper()
+
+	provider := h.NewProvider(t, baseURL)
+	payload := webhook(t, h, outcome)
+	event, err := provider.VerifyWebhook(ctx, payload, h.Sign(t, payload))
+	if err != nil {
+		t.Fatalf("verify webhook: %v", err)
+	}
+	result, err := provider.ParseEvent(ctx, event)
+	if err != nil {
+		t.Fatalf("parse webhook: %v", err)
+	}
+	if result.TransactionID != contractTransactionID {
+		t.Fatalf("expected transaction_id %s, got %s", contractTransactionID, result.TransactionID)
+	}
+	if result.EventID != "evt-contract-"+string(outcome) {
+		t.Fatalf("expected event id to round-trip, got %s", result.EventID)
+	}
+	return result
+}
+
+func webhook(t *testing.T, h Harness, outcome WebhookOutcome) []byte {
+	t.Helper()
+
+	payload := h.Webhook(t, scenario(h, outcome))
+	if payload == nil {
+		t.Fatalf("harness must render a %s webhook", outcome)
+	}
+	return payload
+}
+
+func scenario(h Harness, outcome WebhookOutcome) WebhookScenario {
+	return WebhookScenario{
+		Outcome:       outcome,
+		EventID:       "evt-contract-" + string(outcome),
+		TransactionID: contractTransactionID,
+		ExternalRef:   "ref-contract-1",
+		Amount:        contractAmount,
+		Currency:      h.Currency,
+	}
+}
+
+func assertStatusIn(t *testing.T, status string, allowed ...string) {
+	t.Helper()
+
+	for _, item := range allowed {
+		if status == item {
+			return
+		}
+	}
+	t.Fatalf("expected status in %v, got %s", allowed, status)
+}
@@ -0,0 +1,627 @@
+package redirectcheckout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"wechat-clone/core/modules/payment/domain/entity"
+	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/finance"
+	"wechat-clone/core/shared/infra/xtracer"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+var _ providers.PaymentProvider = (*Provider)(nil)
+
+const ProviderName = "redirect_checkout"
+
+const (
+	eventOrderVoided         = "CHECKOUT.ORDER.VOIDED"
+	eventCaptureCompleted    = "PAYMENT.CAPTURE.COMPLETED"
+	eventCaptureDenied       = "PAYMENT.CAPTURE.DENIED"
+	eventCaptureDeclined     = "PAYMENT.CAPTURE.DECLINED"
+	eventCaptureRefunded     = "PAYMENT.CAPTURE.REFUNDED"
+	eventPayoutItemSucceeded = "PAYMENT.PAYOUTS-ITEM.SUCCEEDED"
+	eventPayoutItemFailed    = "PAYMENT.PAYOUTS-ITEM.FAILED"
+	eventPayoutItemDenied    = "PAYMENT.PAYOUTS-ITEM.DENIED"
+	eventPayoutItemReturned  = "PAYMENT.PAYOUTS-ITEM.RETURNED"
+	eventPayoutItemBlocked   = "PAYMENT.PAYOUTS-ITEM.BLOCKED"
+	eventPayoutItemCanceled  = "PAYMENT.PAYOUTS-ITEM.CANCELED"
+)
+
+const (
+	defaultTokenLifetime = 5 * time.Minute
+	tokenExpiryLeeway    = time.Minute
+	maxErrorBodyBytes    = 4096
+)
+
+// Provider talks to a PayPal-style hosted checkout: the payer is redirected
+// to approve an order, the gateway captures it on approval and confirms the
+// capture by webhook. Withdrawals are sent as payouts. Amounts cross the API
+// as decimal strings in the currency's major unit.
+type Provider struct {
+	apiBaseURL    string
+	clientID      string
+	clientSecret  string
+	webhookSecret string
+	returnURL     string
+	cancelURL     string
+	httpClient    *http.Client
+	now           func() time.Time
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+func NewProvider(cfg config.LedgerRedirectCheckoutConfig) *Provider {
+	return &Provider{
+		apiBaseURL:    strings.TrimRight(strings.TrimSpace(cfg.APIBaseURL), "/"),
+		clientID:      strings.TrimSpace(cfg.ClientID),
+		clientSecret:  strings.TrimSpace(cfg.ClientSecret),
+		webhookSecret: strings.TrimSpace(cfg.WebhookSecret),
+		returnURL:     strings.TrimSpace(cfg.ReturnURL),
+		cancelURL:     strings.TrimSpace(cfg.CancelURL),
+		httpClient: &http.Client{
+			Timeout:   15 * time.Second,
+			Transport: xtracer.NewTransport(),
+		},
+		now: time.Now,
+	}
+}
+
+// FromConfig is the redirect checkout registry factory; the provider is
+// registered only when its API endpoint and client credentials are set.
+func FromConfig(cfg config.LedgerConfig) providers.PaymentProvider {
+	provider := NewProvider(cfg.RedirectCheckout)
+	if !provider.Enabled() {
+		return nil
+	}
+	return provider
+}
+
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+func (p *Provider) Enabled() bool {
+	return p.apiBaseURL != "" && p.clientID != "" && p.clientSecret != ""
+}
+
+func (p *Provider) CreatePayment(ctx context.Context, req providers.CreatePaymentRequest) (*providers.CreatePaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("redirect checkout provider is not configured")
+	}
+
+	returnURL := firstNonEmpty(req.Metadata["success_url"], p.returnURL)
+	cancelURL := firstNonEmpty(req.Metadata["cancel_url"], p.cancelURL)
+	if returnURL == "" || cancelURL == "" {
+		return nil, fmt.Errorf("redirect checkout return_url and cancel_url are required")
+	}
+	value, err := finance.FormatMinorAmount(req.Amount, req.Currency)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	body := orderRequest{
+		Intent:                "CAPTURE",
+		ProcessingInstruction: "ORDER_COMPLETE_ON_PAYMENT_APPROVAL",
+		PurchaseUnits: []purchaseUnitRequest{
+			{
+				ReferenceID: req.TransactionID,
+				CustomID:    req.TransactionID,
+				Description: firstNonEmpty(req.Metadata["product_name"], "Wallet deposit"),
+				Amount:      money{CurrencyCode: strings.ToUpper(req.Currency), Value: value},
+			},
+		},
+		ApplicationContext: applicationContext{
+			ReturnURL:  returnURL,
+			CancelURL:  cancelURL,
+			UserAction: "PAY_NOW",
+		},
+	}
+
+	var created order
+	if err := p.doJSON(ctx, http.MethodPost, "/v2/checkout/orders", fmt.Sprintf("payment-create:%s", req.TransactionID), body, &created); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.TrimSpace(created.ID) == "" {
+		return nil, fmt.Errorf("redirect checkout order response missing id")
+	}
+	approveURL := created.link("approve", "payer-action")
+	if approveURL == "" {
+		return nil, fmt.Errorf("redirect checkout order response missing approve link")
+	}
+
+	return &providers.CreatePaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: req.TransactionID,
+		ExternalRef:   created.ID,
+		Status:        entity.PaymentStatusPending,
+		CheckoutURL:   approveURL,
+	}, nil
+}
+
+func (p *Provider) CreateWithdrawal(ctx context.Context, intent *entity.PaymentIntent, _ map[string]string) (*providers.CreatePaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("redirect checkout provider is not configured")
+	}
+	if intent == nil {
+		return nil, fmt.Errorf("payment intent is required")
+	}
+	receiver := strings.TrimSpace(intent.DestinationAccountID)
+	if receiver == "" {
+		return nil, fmt.Errorf("redirect checkout payout receiver is required for withdrawal")
+	}
+	value, err := finance.FormatMinorAmount(intent.ProviderAmount, intent.Currency)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	recipientType := "PAYPAL_ID"
+	if strings.Contains(receiver, "@") {
+		recipientType = "EMAIL"
+	}
+	body := payoutRequest{
+		SenderBatchHeader: payoutBatchHeaderRequest{
+			SenderBatchID: intent.TransactionID,
+			EmailSubject:  "Wallet withdrawal",
+		},
+		Items: []payoutItemRequest{
+			{
+				RecipientType: recipientType,
+				Receiver:      receiver,
+				SenderItemID:  intent.TransactionID,
+				Amount:        payoutAmount{Currency: strings.ToUpper(intent.Currency), Value: value},
+			},
+		},
+	}
+
+	var created payoutResponse
+	if err := p.doJSON(ctx, http.MethodPost, "/v1/payments/payouts", fmt.Sprintf("payment-withdrawal:%s", intent.TransactionID), body, &created); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if strings.TrimSpace(created.BatchHeader.PayoutBatchID) == "" {
+		return nil, fmt.Errorf("redirect checkout payout response missing payout_batch_id")
+	}
+
+	return &providers.CreatePaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: intent.TransactionID,
+		ExternalRef:   created.BatchHeader.PayoutBatchID,
+		Status:        payoutBatchStatus(created.BatchHeader.BatchStatus),
+	}, nil
+}
+
+// RefundPayment refunds the order's capture. The intent keeps the order id as
+// its external reference, so the capture is looked up from the order first.
+func (p *Provider) RefundPayment(ctx context.Context, req providers.RefundPaymentRequest) (*providers.RefundPaymentResponse, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("redirect checkout provider is not configured")
+	}
+	transactionID := strings.TrimSpace(req.TransactionID)
+	if transactionID == "" {
+		return nil, fmt.Errorf("transaction_id is required")
+	}
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("refund amount must be positive")
+	}
+	orderID := strings.TrimSpace(req.ExternalRef)
+	if orderID == "" {
+		return nil, fmt.Errorf("provider payment reference is required for refund")
+	}
+
+	var existing order
+	if err := p.doJSON(ctx, http.MethodGet, "/v2/checkout/orders/"+url.PathEscape(orderID), "", nil, &existing); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	captureID := existing.captureID()
+	if captureID == "" {
+		return nil, fmt.Errorf("redirect checkout order %s has no capture to refund", orderID)
+	}
+
+	value, err := finance.FormatMinorAmount(req.Amount, req.Currency)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	body := refundRequest{
+		Amount:      money{CurrencyCode: strings.ToUpper(req.Currency), Value: value},
+		InvoiceID:   transactionID,
+		NoteToPayer: strings.TrimSpace(req.Reason),
+	}
+
+	var refunded refund
+	if err := p.doJSON(ctx, http.MethodPost, "/v2/payments/captures/"+url.PathEscape(captureID)+"/refund", req.ProviderIdempotencyKey(), body, &refunded); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	switch strings.ToUpper(strings.TrimSpace(refunded.Status)) {
+	case "CANCELLED", "FAILED":
+		return nil, fmt.Errorf("redirect checkout refund %s is %s", refunded.ID, refunded.Status)
+	}
+
+	amount := req.Amount
+	currency := req.Currency
+	if refunded.Amount.Value != "" {
+		currency = firstNonEmpty(refunded.Amount.CurrencyCode, req.Currency)
+		if amount, err = finance.ParseMajorAmount(refunded.Amount.Value, currency); err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+
+	return &providers.RefundPaymentResponse{
+		Provider:      ProviderName,
+		TransactionID: transactionID,
+		ExternalRef:   orderID,
+		RefundID:      refunded.ID,
+		Status:        entity.PaymentStatusRefunded,
+		Amount:        amount,
+		Currency:      currency,
+	}, nil
+}
+
+func (p *Provider) VerifyWebhook(_ context.Context, payload []byte, signature string) (*providers.WebhookEvent, error) {
+	if err := providers.VerifyWebhookPayload(payload, signature, p.webhookSecret, providers.DefaultWebhookTolerance, p.now()); err != nil {
+		return nil, err
+	}
+
+	var envelope webhookEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("decode redirect checkout webhook payload: %w", err)
+	}
+
+	return &providers.WebhookEvent{
+		Provider:  ProviderName,
+		EventID:   envelope.ID,
+		EventType: envelope.EventType,
+		Attributes: map[string]string{
+			"resource_type": envelope.ResourceType,
+			"resource":      string(envelope.Resource),
+		},
+	}, nil
+}
+
+func (p *Provider) ParseEvent(_ context.Context, event *providers.WebhookEvent) (*providers.PaymentResult, error) {
+	switch event.EventType {
+	case eventCaptureCompleted, eventCaptureDenied, eventCaptureDeclined:
+		var resource capture
+		if err := json.Unmarshal([]byte(event.Attributes["resource"]), &resource); err != nil {
+			return nil, fmt.Errorf("decode redirect checkout capture event: %w", err)
+		}
+		status := entity.PaymentStatusSuccess
+		if event.EventType != eventCaptureCompleted {
+			status = entity.PaymentStatusFailed
+		}
+		return newPaymentResult(event, resource.CustomID, firstNonEmpty(resource.SupplementaryData.RelatedIDs.OrderID, resource.ID), status, resource.Amount.Value, resource.Amount.CurrencyCode)
+	case eventCaptureRefunded:
+		var resource refund
+		if err := json.Unmarshal([]byte(event.Attributes["resource"]), &resource); err != nil {
+			return nil, fmt.Errorf("decode redirect checkout refund event: %w", err)
+		}
+		result, err := newPaymentResult(event, firstNonEmpty(resource.CustomID, resource.InvoiceID), "", entity.PaymentStatusRefunded, resource.Amount.Value, resource.Amount.CurrencyCode)
+		if err != nil {
+			return nil, err
+		}
+		// Each refund of a capture is its own event, carrying that refund's
+		// amount; its id matches the one returned when the refund was made.
+		result.RefundID = strings.TrimSpace(resource.ID)
+		return result, nil
+	case eventOrderVoided:
+		var resource order
+		if err := json.Unmarshal([]byte(event.Attributes["resource"]), &resource); err != nil {
+			return nil, fmt.Errorf("decode redirect checkout order event: %w", err)
+		}
+		unit := resource.purchaseUnit()
+		return newPaymentResult(event, unit.CustomID, resource.ID, entity.PaymentStatusCancelled, unit.Amount.Value, unit.Amount.CurrencyCode)
+	case eventPayoutItemSucceeded, eventPayoutItemFailed, eventPayoutItemDenied,
+		eventPayoutItemReturned, eventPayoutItemBlocked, eventPayoutItemCanceled:
+		var resource payoutItem
+		if err := json.Unmarshal([]byte(event.Attributes["resource"]), &resource); err != nil {
+			return nil, fmt.Errorf("decode redirect checkout payout event: %w", err)
+		}
+		return newPaymentResult(event, resource.PayoutItem.SenderItemID, resource.PayoutBatchID, payoutItemStatus(event.EventType), resource.PayoutItem.Amount.Value, resource.PayoutItem.Amount.Currency)
+	default:
+		return nil, providers.ErrWebhookEventIgnored
+	}
+}
+
+func newPaymentResult(event *providers.WebhookEvent, transactionID, externalRef, status, value, currency string) (*providers.PaymentResult, error) {
+	amount := int64(0)
+	if strings.TrimSpace(value) != "" {
+		parsed, err := finance.ParseMajorAmount(value, currency)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		amount = parsed
+	}
+
+	return &providers.PaymentResult{
+		TransactionID: strings.TrimSpace(transactionID),
+		EventID:       event.EventID,
+		EventType:     event.EventType,
+		Status:        status,
+		Amount:        amount,
+		Currency:      strings.ToUpper(strings.TrimSpace(currency)),
+		ExternalRef:   strings.TrimSpace(externalRef),
+	}, nil
+}
+
+func (p *Provider) doJSON(ctx context.Context, method, path, idempotencyKey string, body, out any) error {
+	token, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode redirect checkout request: %w", err)
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.apiBaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	return p.send(req, out)
+}
+
+// token returns the cached OAuth client-credentials token, fetching a new one
+// shortly before the current one expires.
+func (p *Provider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.accessToken != "" && now.Before(p.tokenExpiry) {
+		return p.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": []string{"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBaseURL+"/v1/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(p.clientID, p.clientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token accessTokenResponse
+	if err := p.send(req, &token); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(token.AccessToken) == "" {
+		return "", fmt.Errorf("redirect checkout token response missing access_token")
+	}
+
+	lifetime := time.Duration(token.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	p.accessToken = token.AccessToken
+	p.tokenExpiry = now.Add(lifetime - tokenExpiryLeeway)
+	return p.accessToken, nil
+}
+
+func (p *Provider) send(req *http.Request, out any) error {
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyBytes))
+		return fmt.Errorf("redirect checkout %s %s: status %d: %s", req.Method, req.URL.Path, res.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode redirect checkout %s response: %w", req.URL.Path, err)
+	}
+	return nil
+}
+
+func payoutBatchStatus(status string) string {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "SUCCESS":
+		return entity.PaymentStatusSuccess
+	case "DENIED":
+		return entity.PaymentStatusFailed
+	case "CANCELED":
+		return entity.PaymentStatusCancelled
+	default:
+		return entity.PaymentStatusPending
+	}
+}
+
+func payoutItemStatus(eventType string) string {
+	switch eventType {
+	case eventPayoutItemSucceeded:
+		return entity.PaymentStatusSuccess
+	case eventPayoutItemCanceled:
+		return entity.PaymentStatusCancelled
+	default:
+		return entity.PaymentStatusFailed
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+type money struct {
+	CurrencyCode string `json:"currency_code"`
+	Value        string `json:"value"`
+}
+
+type orderRequest struct {
+	Intent                string                `json:"intent"`
+	ProcessingInstruction string                `json:"processing_instruction,omitempty"`
+	PurchaseUnits         []purchaseUnitRequest `json:"purchase_units"`
+	ApplicationContext    applicationContext    `json:"application_context"`
+}
+
+type purchaseUnitRequest struct {
+	ReferenceID string `json:"reference_id"`
+	CustomID    string `json:"custom_id"`
+	Description string `json:"description,omitempty"`
+	Amount      money  `json:"amount"`
+}
+
+type applicationContext struct {
+	ReturnURL  string `json:"return_url"`
+	CancelURL  string `json:"cancel_url"`
+	UserAction string `json:"user_action,omitempty"`
+}
+
+type order struct {
+	ID            string         `json:"id"`
+	Status        string         `json:"status"`
+	PurchaseUnits []purchaseUnit `json:"purchase_units"`
+	Links         []link         `json:"links"`
+}
+
+type purchaseUnit struct {
+	ReferenceID string `json:"reference_id"`
+	CustomID    string `json:"custom_id"`
+	Amount      money  `json:"amount"`
+	Payments    struct {
+		Captures []capture `json:"captures"`
+	} `json:"payments"`
+}
+
+type link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+func (o *order) link(rels ...string) string {
+	for _, rel := range rels {
+		for _, item := range o.Links {
+			if strings.EqualFold(item.Rel, rel) && strings.TrimSpace(item.Href) != "" {
+				return strings.TrimSpace(item.Href)
+			}
+		}
+	}
+	return ""
+}
+
+func (o *order) purchaseUnit() purchaseUnit {
+	if len(o.PurchaseUnits) == 0 {
+		return purchaseUnit{}
+	}
+	return o.PurchaseUnits[0]
+}
+
+func (o *order) captureID() string {
+	for _, item := range o.purchaseUnit().Payments.Captures {
+		if strings.EqualFold(item.Status, "COMPLETED") || strings.EqualFold(item.Status, "PARTIALLY_REFUNDED") {
+			return strings.TrimSpace(item.ID)
+		}
+	}
+	return ""
+}
+
+type capture struct {
+	ID                string `json:"id"`
+	Status            string `json:"status"`
+	CustomID          string `json:"custom_id"`
+	Amount            money  `json:"amount"`
+	SupplementaryData struct {
+		RelatedIDs struct {
+			OrderID string `json:"order_id"`
+		} `json:"related_ids"`
+	} `json:"supplementary_data"`
+}
+
+type refundRequest struct {
+	Amount      money  `json:"amount"`
+	InvoiceID   string `json:"invoice_id,omitempty"`
+	NoteToPayer string `json:"note_to_payer,omitempty"`
+}
+
+type refund struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	CustomID  string `json:"custom_id"`
+	InvoiceID string `json:"invoice_id"`
+	Amount    money  `json:"amount"`
+}
+
+type payoutAmount struct {
+	Currency string `json:"currency"`
+	Value    string `json:"value"`
+}
+
+type payoutRequest struct {
+	SenderBatchHeader payoutBatchHeaderRequest `json:"sender_batch_header"`
+	Items             []payoutItemRequest      `json:"items"`
+}
+
+type payoutBatchHeaderRequest struct {
+	SenderBatchID string `json:"sender_batch_id"`
+	EmailSubject  string `json:"email_subject,omitempty"`
+}
+
+type payoutItemRequest struct {
+	RecipientType string       `json:"recipient_type"`
+	Receiver      string       `json:"receiver"`
+	SenderItemID  string       `json:"sender_item_id"`
+	Amount        payoutAmount `json:"amount"`
+}
+
+type payoutResponse struct {
+	BatchHeader struct {
+		PayoutBatchID string `json:"payout_batch_id"`
+		BatchStatus   string `json:"batch_status"`
+	} `json:"batch_header"`
+}
+
+type payoutItem struct {
+	PayoutItemID      string `json:"payout_item_id"`
+	PayoutBatchID     string `json:"payout_batch_id"`
+	TransactionStatus string `json:"transaction_status"`
+	PayoutItem        struct {
+		SenderItemID string       `json:"sender_item_id"`
+		Amount       payoutAmount `json:"amount"`
+	} `json:"payout_item"`
+}
+
+type accessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type webhookEnvelope struct {
+	ID           string          `json:"id"`
+	EventType    string          `json:"event_type"`
+	ResourceType string          `json:"resource_type"`
+	Resource     json.RawMessage `json:"resource"`
+}
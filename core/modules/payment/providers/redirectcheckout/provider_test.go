@@ -0,0 +1,187 @@
+package redirectcheckout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/modules/payment/providers/providertest"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/finance"
+)
+
+const testWebhookSecret = "whsec_redirect_test"
+
+func TestProviderContract(t *testing.T) {
+	providertest.Run(t, providertest.Harness{
+		NewProvider: func(_ *testing.T, baseURL string) providers.PaymentProvider {
+			return newTestProvider(baseURL)
+		},
+		Stub:         newGatewayStub(t, nil),
+		Currency:     "USD",
+		Destination:  "payee@example.com",
+		Sign:         signWebhook,
+		Webhook:      renderWebhook,
+		PayloadBound: true,
+	})
+}
+
+func TestAccessTokenIsReusedUntilExpiry(t *testing.T) {
+	var tokenRequests atomic.Int32
+	server := newStubServer(t, newGatewayStub(t, &tokenRequests))
+	provider := newTestProvider(server)
+
+	for i := 0; i < 3; i++ {
+		if _, err := provider.token(context.Background()); err != nil {
+			t.Fatalf("token: %v", err)
+		}
+	}
+	if got := tokenRequests.Load(); got != 1 {
+		t.Fatalf("expected a single token request, got %d", got)
+	}
+
+	provider.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := provider.token(context.Background()); err != nil {
+		t.Fatalf("token: %v", err)
+	}
+	if got := tokenRequests.Load(); got != 2 {
+		t.Fatalf("expected the expired token to be refreshed, got %d requests", got)
+	}
+}
+
+func newTestProvider(baseURL string) *Provider {
+	return NewProvider(config.LedgerRedirectCheckoutConfig{
+		APIBaseURL:    baseURL,
+		ClientID:      "client-id",
+		ClientSecret:  "client-secret",
+		WebhookSecret: testWebhookSecret,
+	})
+}
+
+func newStubServer(t *testing.T, handler http.Handler) string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newGatewayStub plays the gateway's token, orders, refunds and payouts API.
+func newGatewayStub(t *testing.T, tokenRequests *atomic.Int32) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "client-id" || clientSecret != "client-secret" {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		if tokenRequests != nil {
+			tokenRequests.Add(1)
+		}
+		writeJSON(w, map[string]any{"access_token": "token-1", "token_type": "Bearer", "expires_in": 3600})
+	})
+	mux.HandleFunc("POST /v2/checkout/orders", requireToken(t, func(w http.ResponseWriter, r *http.Request) {
+		var body orderRequest
+		decodeJSON(t, r, &body)
+		if len(body.PurchaseUnits) != 1 || body.PurchaseUnits[0].Amount.Value != "12.50" {
+			t.Errorf("expected a single 12.50 purchase unit, got %+v", body.PurchaseUnits)
+		}
+		if r.Header.Get("Idempotency-Key") == "" {
+			t.Errorf("expected idempotency key on order creation")
+		}
+		writeJSON(w, map[string]any{
+			"id":     "ORDER-1",
+			"status": "PAYER_ACTION_REQUIRED",
+			"links": []map[string]string{
+				{"href": "https://gateway.local/v2/checkout/orders/ORDER-1", "rel": "self"},
+				{"href": "https://gateway.local/checkoutnow?token=ORDER-1", "rel": "payer-action"},
+			},
+		})
+	}))
+	mux.HandleFunc("GET /v2/checkout/orders/ORDER-1", requireToken(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"id":     "ORDER-1",
+			"status": "COMPLETED",
+			"purchase_units": []map[string]any{{
+				"custom_id": "txn-contract-1",
+				"payments": map[string]any{
+					"captures": []map[string]any{{"id": "CAPTURE-1", "status": "COMPLETED"}},
+				},
+			}},
+		})
+	}))
+	mux.HandleFunc("POST /v2/payments/captures/CAPTURE-1/refund", requireToken(t, func(w http.ResponseWriter, r *http.Request) {
+		var body refundRequest
+		decodeJSON(t, r, &body)
+		writeJSON(w, map[string]any{"id": "REFUND-1", "status": "COMPLETED", "amount": body.Amount})
+	}))
+	mux.HandleFunc("POST /v1/payments/payouts", requireToken(t, func(w http.ResponseWriter, r *http.Request) {
+		var body payoutRequest
+		decodeJSON(t, r, &body)
+		if len(body.Items) != 1 || body.Items[0].RecipientType != "EMAIL" {
+			t.Errorf("expected a single email payout item, got %+v", body.Items)
+		}
+		writeJSON(w, map[string]any{"batch_header": map[string]string{"payout_batch_id": "BATCH-1", "batch_status": "PENDING"}})
+	}))
+	return mux
+}
+
+func requireToken(t *testing.T, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			t.Errorf("expected bearer token on %s", r.URL.Path)
+			http.Error(w, `{"name":"AUTHENTICATION_FAILURE"}`, http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func signWebhook(_ *testing.T, payload []byte) string {
+	return providers.SignWebhookPayload(payload, testWebhookSecret, time.Now())
+}
+
+func renderWebhook(t *testing.T, scenario providertest.WebhookScenario) []byte {
+	value, err := finance.FormatMinorAmount(scenario.Amount, scenario.Currency)
+	if err != nil {
+		t.Fatalf("format amount: %v", err)
+	}
+
+	eventType := eventCaptureCompleted
+	switch scenario.Outcome {
+	case providertest.OutcomeFailed:
+		eventType = eventCaptureDenied
+	case providertest.OutcomeIgnored:
+		eventType = "CHECKOUT.ORDER.APPROVED"
+	}
+
+	return []byte(fmt.Sprintf(`{
+		"id":%q,
+		"event_type":%q,
+		"resource_type":"capture",
+		"resource":{
+			"id":"CAPTURE-1",
+			"status":"COMPLETED",
+			"custom_id":%q,
+			"amount":{"currency_code":%q,"value":%q},
+			"supplementary_data":{"related_ids":{"order_id":%q}}
+		}
+	}`, scenario.EventID, eventType, scenario.TransactionID, scenario.Currency, value, scenario.ExternalRef))
+}
+
+func decodeJSON(t *testing.T, r *http.Request, out any) {
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		t.Errorf("decode %s body: %v", r.URL.Path, err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
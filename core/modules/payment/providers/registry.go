@@ -2,10 +2,17 @@ package providers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+
+	"wechat-clone/core/shared/config"
 )
 
+// ProviderFactory builds a provider from the ledger config. It returns nil
+// when the provider is not configured so it stays out of the registry.
+type ProviderFactory func(cfg config.LedgerConfig) PaymentProvider
+
 type ProviderRegistry struct {
 	mu        sync.RWMutex
 	providers map[string]PaymentProvider
@@ -17,6 +24,18 @@ func NewProviderRegistry() *ProviderRegistry {
 	}
 }
 
+// NewProviderRegistryFromConfig registers every configured provider built by
+// factories.
+func NewProviderRegistryFromConfig(cfg config.LedgerConfig, factories ...ProviderFactory) *ProviderRegistry {
+	registry := NewProviderRegistry()
+	for _, factory := range factories {
+		if provider := factory(cfg); provider != nil {
+			registry.Register(provider)
+		}
+	}
+	return registry
+}
+
 func (r *ProviderRegistry) Register(provider PaymentProvider) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -33,3 +52,20 @@ func (r *ProviderRegistry) Get(name string) (PaymentProvider, error) {
 	}
 	return provider, nil
 }
+
+func (r *ProviderRegistry) List() []PaymentProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]PaymentProvider, 0, len(names))
+	for _, name := range names {
+		items = append(items, r.providers[name])
+	}
+	return items
+}
@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -21,7 +22,11 @@ import (
 	stripewebhook "github.com/stripe/stripe-go/v75/webhook"
 )
 
-var _ providers.PaymentProvider = (*Provider)(nil)
+var (
+	_ providers.PaymentProvider          = (*Provider)(nil)
+	_ providers.SettlementReporter       = (*Provider)(nil)
+	_ providers.DisputeEvidenceSubmitter = (*Provider)(nil)
+)
 
 const (
 	ProviderName = "stripe"
@@ -50,7 +55,7 @@ func NewProvider(cfg config.LedgerStripeConfig) *Provider {
 		successURL:    strings.TrimSpace(cfg.SuccessURL),
 		cancelURL:     strings.TrimSpace(cfg.CancelURL),
 		httpClient:    httpClient,
-		apiBaseURL:    stripe.APIURL,
+		apiBaseURL:    firstNonEmpty(cfg.APIBaseURL, stripe.APIURL),
 	}
 
 	provider.client = func(secretKey string, httpClient *http.Client, apiBaseURL string) *stripeclient.API {
@@ -78,6 +83,16 @@ func NewProvider(cfg config.LedgerStripeConfig) *Provider {
 	return provider
 }
 
+// FromConfig is the Stripe registry factory; Stripe is registered only when a
+// secret key is configured.
+func FromConfig(cfg config.LedgerConfig) providers.PaymentProvider {
+	provider := NewProvider(cfg.Stripe)
+	if !provider.Enabled() {
+		return nil
+	}
+	return provider
+}
+
 func (p *Provider) Name() string {
 	return ProviderName
 }
@@ -269,7 +284,7 @@ func (p *Provider) RefundPayment(ctx context.Context, req providers.RefundPaymen
 	} else {
 		params.PaymentIntent = stripe.String(paymentRef)
 	}
-	params.SetIdempotencyKey(fmt.Sprintf("payment-refund:%s", transactionID))
+	params.SetIdempotencyKey(req.ProviderIdempotencyKey())
 
 	refund, err := p.stripeClient().Refunds.New(params)
 	if err != nil {
@@ -283,6 +298,7 @@ func (p *Provider) RefundPayment(ctx context.Context, req providers.RefundPaymen
 		Provider:      ProviderName,
 		TransactionID: transactionID,
 		ExternalRef:   firstNonEmpty(refund.Charge.ID, paymentRef, req.ExternalRef),
+		RefundID:      refund.ID,
 		Status:        entity.PaymentStatusRefunded,
 		Amount:        refund.Amount,
 		Currency:      firstNonEmpty(string(refund.Currency), req.Currency),
@@ -378,7 +394,11 @@ func (p *Provider) ParseEvent(_ context.Context, event *providers.WebhookEvent)
 			Currency:      string(charge.Currency),
 			ExternalRef:   charge.ID,
 		}, nil
-	case stripe.EventTypeChargeDisputeFundsWithdrawn:
+	case stripe.EventTypeChargeDisputeCreated,
+		stripe.EventTypeChargeDisputeUpdated,
+		stripe.EventTypeChargeDisputeClosed,
+		stripe.EventTypeChargeDisputeFundsWithdrawn,
+		stripe.EventTypeChargeDisputeFundsReinstated:
 		var dispute stripe.Dispute
 		if err := json.Unmarshal([]byte(event.Attributes["object"]), &dispute); err != nil {
 			return nil, fmt.Errorf("decode stripe dispute event: %w", err)
@@ -388,16 +408,139 @@ func (p *Provider) ParseEvent(_ context.Context, event *providers.WebhookEvent)
 			TransactionID: stripeDisputeTransactionID(&dispute),
 			EventID:       event.EventID,
 			EventType:     event.EventType,
-			Status:        entity.PaymentStatusChargeback,
 			Amount:        dispute.Amount,
 			Currency:      string(dispute.Currency),
 			ExternalRef:   stripeDisputeExternalRef(&dispute),
+			Dispute: &providers.DisputeResult{
+				DisputeID:     strings.TrimSpace(dispute.ID),
+				Status:        stripeDisputeStatus(dispute.Status),
+				Reason:        string(dispute.Reason),
+				EvidenceDueBy: stripeDisputeEvidenceDueBy(&dispute),
+			},
 		}, nil
 	default:
 		return nil, providers.ErrWebhookEventIgnored
 	}
 }
 
+// ListSettlements reports charges as top-ups and transfers as withdrawals,
+// both keyed by the transaction_id metadata set when they were created.
+func (p *Provider) ListSettlements(ctx context.Context, req providers.ListSettlementsRequest) ([]providers.SettlementTransaction, error) {
+	if !p.Enabled() {
+		return nil, fmt.Errorf("stripe provider is not configured")
+	}
+
+	created := &stripe.RangeQueryParams{
+		GreaterThanOrEqual: req.From.Unix(),
+		LesserThan:         req.To.Unix(),
+	}
+	items := make([]providers.SettlementTransaction, 0)
+
+	chargeParams := &stripe.ChargeListParams{
+		ListParams:   stripe.ListParams{Context: ctx},
+		CreatedRange: created,
+	}
+	chargeParams.AddExpand("data.payment_intent")
+	charges := p.stripeClient().Charges.List(chargeParams)
+	for charges.Next() {
+		charge := charges.Charge()
+		items = append(items, providers.SettlementTransaction{
+			TransactionID: stripeChargeTransactionID(charge),
+			ExternalRef:   charge.ID,
+			Workflow:      entity.PaymentWorkflowTopUp,
+			Status:        stripeChargeStatus("", string(charge.Status), charge.Paid, charge.Refunded),
+			Amount:        charge.Amount,
+			Currency:      strings.ToUpper(string(charge.Currency)),
+			CreatedAt:     time.Unix(charge.Created, 0).UTC(),
+		})
+	}
+	if err := charges.Err(); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	transfers := p.stripeClient().Transfers.List(&stripe.TransferListParams{
+		ListParams:   stripe.ListParams{Context: ctx},
+		CreatedRange: created,
+	})
+	for transfers.Next() {
+		transfer := transfers.Transfer()
+		status := entity.PaymentStatusSuccess
+		if transfer.Reversed {
+			status = entity.PaymentStatusCancelled
+		}
+		items = append(items, providers.SettlementTransaction{
+			TransactionID: firstNonEmpty(transfer.Metadata["transaction_id"], transfer.TransferGroup),
+			ExternalRef:   transfer.ID,
+			Workflow:      entity.PaymentWorkflowWithdrawal,
+			Status:        status,
+			Amount:        transfer.Amount,
+			Currency:      strings.ToUpper(string(transfer.Currency)),
+			CreatedAt:     time.Unix(transfer.Created, 0).UTC(),
+		})
+	}
+	if err := transfers.Err(); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return items, nil
+}
+
+// SubmitDisputeEvidence uploads each file to Stripe and submits them on the
+// dispute. Stripe has one slot per evidence kind, so a second file of the
+// same kind goes to the uncategorized slot.
+func (p *Provider) SubmitDisputeEvidence(ctx context.Context, req providers.SubmitDisputeEvidenceRequest) error {
+	if !p.Enabled() {
+		return fmt.Errorf("stripe provider is not configured")
+	}
+	disputeID := strings.TrimSpace(req.DisputeID)
+	if disputeID == "" {
+		return fmt.Errorf("dispute_id is required")
+	}
+
+	evidence := &stripe.DisputeEvidenceParams{}
+	if note := strings.TrimSpace(req.Note); note != "" {
+		evidence.UncategorizedText = stripe.String(note)
+	}
+	for _, file := range req.Files {
+		slot := stripeDisputeEvidenceSlot(evidence, file.Kind)
+		if *slot != nil {
+			slot = &evidence.UncategorizedFile
+		}
+		if *slot != nil {
+			return fmt.Errorf("stripe accepts one evidence file per kind: %s", file.FileName)
+		}
+
+		uploaded, err := p.stripeClient().Files.New(&stripe.FileParams{
+			Params:     stripe.Params{Context: ctx},
+			FileReader: bytes.NewReader(file.Content),
+			Filename:   stripe.String(file.FileName),
+			Purpose:    stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+		})
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		*slot = stripe.String(uploaded.ID)
+	}
+
+	params := &stripe.DisputeParams{
+		Params: stripe.Params{
+			Context: ctx,
+			Headers: http.Header{
+				"Stripe-Version": []string{apiVersion},
+			},
+		},
+		Evidence: evidence,
+		Submit:   stripe.Bool(true),
+	}
+	if transactionID := strings.TrimSpace(req.TransactionID); transactionID != "" {
+		params.AddMetadata("transaction_id", transactionID)
+	}
+	if _, err := p.stripeClient().Disputes.Update(disputeID, params); err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
 func (p *Provider) stripeClient() *stripeclient.API {
 	return p.client
 }
@@ -517,6 +660,44 @@ func stripeDisputeExternalRef(dispute *stripe.Dispute) string {
 	return strings.TrimSpace(dispute.ID)
 }
 
+func stripeDisputeStatus(status stripe.DisputeStatus) string {
+	switch status {
+	case stripe.DisputeStatusNeedsResponse, stripe.DisputeStatusWarningNeedsResponse:
+		return entity.PaymentDisputeStatusNeedsEvidence
+	case stripe.DisputeStatusUnderReview, stripe.DisputeStatusWarningUnderReview:
+		return entity.PaymentDisputeStatusUnderReview
+	case stripe.DisputeStatusWon, stripe.DisputeStatusWarningClosed:
+		return entity.PaymentDisputeStatusWon
+	case stripe.DisputeStatusLost:
+		return entity.PaymentDisputeStatusLost
+	default:
+		return entity.PaymentDisputeStatusOpened
+	}
+}
+
+func stripeDisputeEvidenceDueBy(dispute *stripe.Dispute) *time.Time {
+	if dispute == nil || dispute.EvidenceDetails == nil || dispute.EvidenceDetails.DueBy <= 0 {
+		return nil
+	}
+	dueBy := time.Unix(dispute.EvidenceDetails.DueBy, 0).UTC()
+	return &dueBy
+}
+
+func stripeDisputeEvidenceSlot(evidence *stripe.DisputeEvidenceParams, kind string) **string {
+	switch kind {
+	case entity.PaymentDisputeEvidenceReceipt:
+		return &evidence.Receipt
+	case entity.PaymentDisputeEvidenceCustomerCommunication:
+		return &evidence.CustomerCommunication
+	case entity.PaymentDisputeEvidenceServiceDocumentation:
+		return &evidence.ServiceDocumentation
+	case entity.PaymentDisputeEvidenceRefundPolicy:
+		return &evidence.RefundPolicy
+	default:
+		return &evidence.UncategorizedFile
+	}
+}
+
 func firstNonEmpty(values ...string) string {
 	for _, value := range values {
 		if strings.TrimSpace(value) != "" {
@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 	"time"
 	"wechat-clone/core/modules/payment/domain/entity"
 	"wechat-clone/core/modules/payment/providers"
+	"wechat-clone/core/modules/payment/providers/providertest"
+	"wechat-clone/core/shared/config"
 
 	stripe "github.com/stripe/stripe-go/v75"
 	stripeclient "github.com/stripe/stripe-go/v75/client"
@@ -304,7 +307,7 @@ func TestParseChargeRefundedEvent(t *testing.T) {
 	}
 }
 
-func TestParseChargeDisputeFundsWithdrawnEvent(t *testing.T) {
+func TestParseChargeDisputeEvents(t *testing.T) {
 	provider := &Provider{}
 
 	event := &providers.WebhookEvent{
@@ -317,6 +320,9 @@ func TestParseChargeDisputeFundsWithdrawnEvent(t *testing.T) {
 				"object":"dispute",
 				"amount":5000,
 				"currency":"usd",
+				"status":"needs_response",
+				"reason":"fraudulent",
+				"evidence_details":{"due_by":1772409600},
 				"metadata":{
 					"transaction_id":"tx_123"
 				},
@@ -334,8 +340,14 @@ func TestParseChargeDisputeFundsWithdrawnEvent(t *testing.T) {
 	if result.TransactionID != "tx_123" {
 		t.Fatalf("expected transaction_id tx_123, got %s", result.TransactionID)
 	}
-	if result.Status != entity.PaymentStatusChargeback {
-		t.Fatalf("expected chargeback status, got %s", result.Status)
+	if result.Dispute == nil || result.Dispute.DisputeID != "dp_test_123" {
+		t.Fatalf("expected dispute dp_test_123, got %+v", result.Dispute)
+	}
+	if result.Dispute.Status != entity.PaymentDisputeStatusNeedsEvidence || result.Dispute.Reason != "fraudulent" {
+		t.Fatalf("unexpected dispute state %+v", result.Dispute)
+	}
+	if result.Dispute.EvidenceDueBy == nil || !result.Dispute.EvidenceDueBy.Equal(time.Unix(1772409600, 0)) {
+		t.Fatalf("unexpected evidence due date %v", result.Dispute.EvidenceDueBy)
 	}
 	if result.Amount != 5000 {
 		t.Fatalf("expected amount 5000, got %d", result.Amount)
@@ -343,6 +355,19 @@ func TestParseChargeDisputeFundsWithdrawnEvent(t *testing.T) {
 	if result.ExternalRef != "ch_test_123" {
 		t.Fatalf("expected external_ref ch_test_123, got %s", result.ExternalRef)
 	}
+
+	closed := *event
+	closed.EventType = "charge.dispute.closed"
+	closed.Attributes = map[string]string{
+		"object": `{"id":"dp_test_123","object":"dispute","amount":5000,"currency":"usd","status":"lost","metadata":{"transaction_id":"tx_123"}}`,
+	}
+	result, err = provider.ParseEvent(context.Background(), &closed)
+	if err != nil {
+		t.Fatalf("expected no parse error, got %v", err)
+	}
+	if result.Dispute == nil || result.Dispute.Status != entity.PaymentDisputeStatusLost {
+		t.Fatalf("expected lost dispute, got %+v", result.Dispute)
+	}
 }
 
 func TestVerifyWebhookRejectsInvalidSignature(t *testing.T) {
@@ -369,3 +394,134 @@ func TestParseUnsupportedStripeEventIgnored(t *testing.T) {
 		t.Fatalf("expected ignored webhook event error, got %v", err)
 	}
 }
+
+func TestListSettlementsReadsChargesAndTransfersFromStubServer(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET request, got %s", r.Method)
+		}
+		query := r.URL.Query()
+		if query.Get("created[gte]") != fmt.Sprint(from.Unix()) || query.Get("created[lt]") != fmt.Sprint(to.Unix()) {
+			t.Fatalf("unexpected created range %v", query)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/charges":
+			_, _ = io.WriteString(w, `{"object":"list","url":"/v1/charges","has_more":false,"data":[
+				{"id":"ch_1","object":"charge","amount":1200,"currency":"usd","status":"succeeded","paid":true,"created":1772323200,
+				 "payment_intent":{"id":"pi_1","object":"payment_intent","metadata":{"transaction_id":"txn-1"}}},
+				{"id":"ch_2","object":"charge","amount":500,"currency":"usd","status":"failed","paid":false,"created":1772323300,
+				 "metadata":{"transaction_id":"txn-2"}}
+			]}`)
+		case "/v1/transfers":
+			_, _ = io.WriteString(w, `{"object":"list","url":"/v1/transfers","has_more":false,"data":[
+				{"id":"tr_1","object":"transfer","amount":900,"currency":"usd","created":1772323400,"transfer_group":"txn-3","reversed":false}
+			]}`)
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewProvider(config.LedgerStripeConfig{SecretKey: "sk_test_123", APIBaseURL: server.URL})
+	items, err := provider.ListSettlements(context.Background(), providers.ListSettlementsRequest{From: from, To: to})
+	if err != nil {
+		t.Fatalf("list settlements: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 settlement records, got %d", len(items))
+	}
+
+	if items[0].TransactionID != "txn-1" || items[0].Status != entity.PaymentStatusSuccess || items[0].Amount != 1200 || items[0].Currency != "USD" {
+		t.Fatalf("unexpected charge record %+v", items[0])
+	}
+	if items[1].TransactionID != "txn-2" || items[1].Status == entity.PaymentStatusSuccess {
+		t.Fatalf("expected failed charge to stay unsettled, got %+v", items[1])
+	}
+	if items[2].TransactionID != "txn-3" || items[2].Workflow != entity.PaymentWorkflowWithdrawal || items[2].ExternalRef != "tr_1" {
+		t.Fatalf("unexpected transfer record %+v", items[2])
+	}
+}
+
+func TestProviderContract(t *testing.T) {
+	const webhookSecret = "whsec_contract"
+
+	providertest.Run(t, providertest.Harness{
+		NewProvider: func(_ *testing.T, baseURL string) providers.PaymentProvider {
+			return NewProvider(config.LedgerStripeConfig{
+				SecretKey:     "sk_test_123",
+				WebhookSecret: webhookSecret,
+				APIBaseURL:    baseURL,
+			})
+		},
+		Stub:        newContractStub(t),
+		Currency:    "USD",
+		Destination: "acct_contract",
+		Sign: func(_ *testing.T, payload []byte) string {
+			now := time.Now().UTC()
+			return fmt.Sprintf("t=%d,v1=%s", now.Unix(), hex.EncodeToString(stripewebhook.ComputeSignature(now, payload, webhookSecret)))
+		},
+		Webhook:      renderContractWebhook,
+		PayloadBound: true,
+	})
+}
+
+// newContractStub plays the checkout session, refund and transfer endpoints
+// the contract suite exercises.
+func newContractStub(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/checkout/sessions", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"cs_test_1","object":"checkout.session","url":"https://checkout.stripe.com/c/pay/cs_test_1"}`)
+	})
+	mux.HandleFunc("GET /v1/checkout/sessions/cs_test_1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"cs_test_1","object":"checkout.session","payment_intent":{"id":"pi_1","object":"payment_intent"}}`)
+	})
+	mux.HandleFunc("POST /v1/refunds", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse refund form: %v", err)
+		}
+		if r.PostForm.Get("payment_intent") != "pi_1" {
+			t.Errorf("expected refund against pi_1, got %q", r.PostForm.Get("payment_intent"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":"re_1","object":"refund","amount":%s,"currency":"usd","charge":"ch_1"}`, r.PostForm.Get("amount"))
+	})
+	mux.HandleFunc("POST /v1/transfers", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"tr_1","object":"transfer"}`)
+	})
+	return mux
+}
+
+func renderContractWebhook(_ *testing.T, scenario providertest.WebhookScenario) []byte {
+	eventType := "checkout.session.completed"
+	switch scenario.Outcome {
+	case providertest.OutcomeFailed:
+		eventType = "checkout.session.async_payment_failed"
+	case providertest.OutcomeIgnored:
+		eventType = "payment_intent.created"
+	}
+
+	return []byte(fmt.Sprintf(`{
+		"id":%q,
+		"object":"event",
+		"api_version":"2026-02-25.clover",
+		"type":%q,
+		"data":{
+			"object":{
+				"id":%q,
+				"object":"checkout.session",
+				"client_reference_id":%q,
+				"payment_status":"paid",
+				"amount_total":%d,
+				"currency":%q
+			}
+		}
+	}`, scenario.EventID, eventType, scenario.ExternalRef, scenario.TransactionID, scenario.Amount, strings.ToLower(scenario.Currency)))
+}
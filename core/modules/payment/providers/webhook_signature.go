@@ -0,0 +1,76 @@
+package providers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultWebhookTolerance bounds how old a signed webhook may be before it is
+// treated as a replay.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// SignWebhookPayload signs payload with the "t=<unix>,v1=<hex>" scheme used by
+// the redirect checkout and bank transfer gateways: v1 is the HMAC-SHA256 of
+// "<unix>.<payload>" keyed by the webhook secret.
+func SignWebhookPayload(payload []byte, secret string, at time.Time) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, webhookDigest(payload, secret, timestamp))
+}
+
+// VerifyWebhookPayload checks a SignWebhookPayload header. Any v1 entry may
+// match so gateways can sign with an old and a new secret while rotating.
+func VerifyWebhookPayload(payload []byte, header, secret string, tolerance time.Duration, now time.Time) error {
+	if strings.TrimSpace(secret) == "" {
+		return fmt.Errorf("webhook secret is not configured")
+	}
+
+	timestamp := ""
+	signatures := make([]string, 0, 1)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(signedAt, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrInvalidWebhookSignature
+		}
+	}
+
+	expected := webhookDigest(payload, secret, timestamp)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
+
+func webhookDigest(payload []byte, secret, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -3,11 +3,14 @@ package grpc
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
 
 	"wechat-clone/core/modules/payment/application/dto/in"
 	"wechat-clone/core/modules/payment/application/dto/out"
 	paymentservice "wechat-clone/core/modules/payment/application/service"
 	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/apperr"
 	"wechat-clone/core/shared/pkg/cqrs"
 	paymentv1 "wechat-clone/core/shared/transport/grpc/gen/payment/v1"
 
@@ -24,20 +27,23 @@ const (
 
 type paymentGRPCServer struct {
 	paymentv1.PaymentServiceServer
-	createPayment  cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse]
-	processWebhook cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse]
-	refundPayment  cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse]
+	createPayment    cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse]
+	processWebhook   cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse]
+	createWithdrawal cqrs.Dispatcher[*in.CreateWithdrawalRequest, *out.CreateWithdrawalResponse]
+	refundPayment    cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse]
 }
 
 func NewServer(
 	createPayment cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse],
 	processWebhook cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse],
+	createWithdrawal cqrs.Dispatcher[*in.CreateWithdrawalRequest, *out.CreateWithdrawalResponse],
 	refundPayment cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse],
 ) paymentv1.PaymentServiceServer {
 	return &paymentGRPCServer{
-		createPayment:  createPayment,
-		processWebhook: processWebhook,
-		refundPayment:  refundPayment,
+		createPayment:    createPayment,
+		processWebhook:   processWebhook,
+		createWithdrawal: createWithdrawal,
+		refundPayment:    refundPayment,
 	}
 }
 
@@ -88,11 +94,45 @@ func (s *paymentGRPCServer) ProcessProviderWebhook(ctx context.Context, req *pay
 	}, nil
 }
 
+func (s *paymentGRPCServer) CreateWithdrawal(ctx context.Context, req *paymentv1.CreateWithdrawalRequest) (*paymentv1.CreateWithdrawalResponse, error) {
+	ctx = withActorFromMetadata(ctx)
+	if strings.TrimSpace(req.GetIdempotencyKey()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "idempotency_key is required")
+	}
+
+	response, err := s.createWithdrawal.Dispatch(ctx, &in.CreateWithdrawalRequest{
+		Provider:       req.GetProvider(),
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		Metadata:       req.GetMetadata(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return nil, mapGRPCError(err)
+	}
+
+	return &paymentv1.CreateWithdrawalResponse{
+		Provider:       response.Provider,
+		Workflow:       response.Workflow,
+		TransactionId:  response.TransactionID,
+		ExternalRef:    response.ExternalRef,
+		Amount:         response.Amount,
+		FeeAmount:      response.FeeAmount,
+		ProviderAmount: response.ProviderAmount,
+		Status:         response.Status,
+		Duplicate:      response.Duplicate,
+	}, nil
+}
+
 func (s *paymentGRPCServer) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.RefundPaymentResponse, error) {
+	ctx = withActorFromMetadata(ctx)
+
 	response, err := s.refundPayment.Dispatch(ctx, &in.RefundPaymentRequest{
-		Provider:      req.GetProvider(),
-		TransactionID: req.GetTransactionId(),
-		Reason:        req.GetReason(),
+		Provider:       req.GetProvider(),
+		TransactionID:  req.GetTransactionId(),
+		Reason:         req.GetReason(),
+		Amount:         req.GetAmount(),
+		IdempotencyKey: req.GetIdempotencyKey(),
 	})
 	if err != nil {
 		return nil, mapGRPCError(err)
@@ -105,6 +145,7 @@ func (s *paymentGRPCServer) RefundPayment(ctx context.Context, req *paymentv1.Re
 		Status:        response.Status,
 		Duplicate:     response.Duplicate,
 		Events:        paymentIntegrationEvents(response.Events),
+		Amount:        response.Amount,
 	}, nil
 }
 
@@ -150,6 +191,11 @@ func firstMetadata(md metadata.MD, key string) string {
 }
 
 func mapGRPCError(err error) error {
+	var appErr *apperr.Error
+	if errors.As(err, &appErr) {
+		return status.Error(grpcCodeFromHTTPStatus(appErr.HTTPStatus()), appErr.Message())
+	}
+
 	switch {
 	case errors.Is(err, paymentservice.ErrValidation):
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -163,3 +209,24 @@ func mapGRPCError(err error) error {
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+func grpcCodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getDisputeHandler struct {
+	getDispute cqrs.Dispatcher[*in.GetDisputeRequest, *out.DisputeResponse]
+}
+
+func NewGetDisputeHandler(
+	getDispute cqrs.Dispatcher[*in.GetDisputeRequest, *out.DisputeResponse],
+) *getDisputeHandler {
+	return &getDisputeHandler{
+		getDispute: getDispute,
+	}
+}
+
+func (h *getDisputeHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetDisputeRequest
+	request.DisputeID = c.Param("dispute_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getDispute.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetDispute failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
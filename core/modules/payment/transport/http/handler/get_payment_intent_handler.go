@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getPaymentIntentHandler struct {
+	getPaymentIntent cqrs.Dispatcher[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse]
+}
+
+func NewGetPaymentIntentHandler(
+	getPaymentIntent cqrs.Dispatcher[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse],
+) *getPaymentIntentHandler {
+	return &getPaymentIntentHandler{
+		getPaymentIntent: getPaymentIntent,
+	}
+}
+
+func (h *getPaymentIntentHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetPaymentIntentRequest
+	request.TransactionID = c.Param("transaction_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getPaymentIntent.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetPaymentIntent failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
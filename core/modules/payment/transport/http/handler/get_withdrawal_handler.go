@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getWithdrawalHandler struct {
+	getWithdrawal cqrs.Dispatcher[*in.GetWithdrawalRequest, *out.PaymentIntentResponse]
+}
+
+func NewGetWithdrawalHandler(
+	getWithdrawal cqrs.Dispatcher[*in.GetWithdrawalRequest, *out.PaymentIntentResponse],
+) *getWithdrawalHandler {
+	return &getWithdrawalHandler{
+		getWithdrawal: getWithdrawal,
+	}
+}
+
+func (h *getWithdrawalHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetWithdrawalRequest
+	request.TransactionID = c.Param("transaction_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getWithdrawal.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetWithdrawal failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
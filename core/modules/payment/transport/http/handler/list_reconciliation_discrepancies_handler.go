@@ -0,0 +1,51 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type listReconciliationDiscrepanciesHandler struct {
+	listReconciliationDiscrepancies cqrs.Dispatcher[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse]
+}
+
+func NewListReconciliationDiscrepanciesHandler(
+	listReconciliationDiscrepancies cqrs.Dispatcher[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse],
+) *listReconciliationDiscrepanciesHandler {
+	return &listReconciliationDiscrepanciesHandler{
+		listReconciliationDiscrepancies: listReconciliationDiscrepancies,
+	}
+}
+
+func (h *listReconciliationDiscrepanciesHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.ListReconciliationDiscrepanciesRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.listReconciliationDiscrepancies.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("ListReconciliationDiscrepancies failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -33,6 +33,7 @@ func (h *processWebhookHandler) Handle(c *gin.Context) (interface{}, error) {
 	var request in.ProcessWebhookRequest
 	request.Provider = c.Param("provider")
 	request.Signature = c.GetHeader("Stripe-Signature")
+	request.WebhookSignature = c.GetHeader("X-Webhook-Signature")
 
 	payload, err := io.ReadAll(c.Request.Body)
 	if err != nil {
@@ -0,0 +1,54 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type refundPaymentHandler struct {
+	refundPayment cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse]
+}
+
+func NewRefundPaymentHandler(
+	refundPayment cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse],
+) *refundPaymentHandler {
+	return &refundPaymentHandler{
+		refundPayment: refundPayment,
+	}
+}
+
+func (h *refundPaymentHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.RefundPaymentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+	// The path and header win over anything the body carries.
+	request.TransactionID = c.Param("transaction_id")
+	request.IdempotencyKey = c.GetHeader("Idempotency-Key")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.refundPayment.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("RefundPayment failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type captureRefund struct {
+	request *in.RefundPaymentRequest
+}
+
+func (c *captureRefund) Handle(_ context.Context, req *in.RefundPaymentRequest) (*out.RefundPaymentResponse, error) {
+	c.request = req
+	return &out.RefundPaymentResponse{TransactionID: req.TransactionID}, nil
+}
+
+func TestRefundPaymentHandlerPathAndHeaderWinOverBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/payment/admin/intents/txn-path/refunds", strings.NewReader(
+		`{"transaction_id":"txn-body","idempotency_key":"key-body","amount":40}`,
+	))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Idempotency-Key", "key-header")
+	c.Params = gin.Params{{Key: "transaction_id", Value: "txn-path"}}
+
+	refunds := &captureRefund{}
+	if _, err := NewRefundPaymentHandler(cqrs.NewDispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse](refunds)).Handle(c); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if refunds.request == nil {
+		t.Fatalf("expected the refund to be dispatched")
+	}
+	if refunds.request.TransactionID != "txn-path" || refunds.request.IdempotencyKey != "key-header" {
+		t.Fatalf("expected path transaction and header key, got %+v", refunds.request)
+	}
+	if refunds.request.Amount != 40 {
+		t.Fatalf("expected amount from the body, got %d", refunds.request.Amount)
+	}
+}
@@ -0,0 +1,52 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/payment/application/dto/in"
+	"wechat-clone/core/modules/payment/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type uploadDisputeEvidenceHandler struct {
+	uploadDisputeEvidence cqrs.Dispatcher[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse]
+}
+
+func NewUploadDisputeEvidenceHandler(
+	uploadDisputeEvidence cqrs.Dispatcher[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse],
+) *uploadDisputeEvidenceHandler {
+	return &uploadDisputeEvidenceHandler{
+		uploadDisputeEvidence: uploadDisputeEvidence,
+	}
+}
+
+func (h *uploadDisputeEvidenceHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.UploadDisputeEvidenceRequest
+	request.DisputeID = c.Param("dispute_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.uploadDisputeEvidence.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("UploadDisputeEvidence failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -20,6 +20,28 @@ func RegisterPublicRoutes(
 func RegisterPrivateRoutes(
 	routes *gin.RouterGroup,
 	createPayment cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse],
+	listPaymentIntents cqrs.Dispatcher[*in.ListPaymentIntentsRequest, *out.ListPaymentIntentsResponse],
+	getPaymentIntent cqrs.Dispatcher[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse],
+	createWithdrawal cqrs.Dispatcher[*in.CreateWithdrawalRequest, *out.CreateWithdrawalResponse],
+	getWithdrawal cqrs.Dispatcher[*in.GetWithdrawalRequest, *out.PaymentIntentResponse],
+	refundPayment cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse],
+	listReconciliationDiscrepancies cqrs.Dispatcher[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse],
+	reviewReconciliationDiscrepancy cqrs.Dispatcher[*in.ReviewReconciliationDiscrepancyRequest, *out.ReconciliationDiscrepancyResponse],
+	listDisputes cqrs.Dispatcher[*in.ListDisputesRequest, *out.ListDisputesResponse],
+	getDispute cqrs.Dispatcher[*in.GetDisputeRequest, *out.DisputeResponse],
+	uploadDisputeEvidence cqrs.Dispatcher[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse],
+	submitDisputeEvidence cqrs.Dispatcher[*in.SubmitDisputeEvidenceRequest, *out.DisputeResponse],
 ) {
 	routes.POST("/payment/intents", httpx.Wrap(handler.NewCreatePaymentHandler(createPayment)))
+	routes.GET("/payment/intents", httpx.Wrap(handler.NewListPaymentIntentsHandler(listPaymentIntents)))
+	routes.GET("/payment/intents/:transaction_id", httpx.Wrap(handler.NewGetPaymentIntentHandler(getPaymentIntent)))
+	routes.POST("/payment/withdrawals", httpx.Wrap(handler.NewCreateWithdrawalHandler(createWithdrawal)))
+	routes.GET("/payment/withdrawals/:transaction_id", httpx.Wrap(handler.NewGetWithdrawalHandler(getWithdrawal)))
+	routes.POST("/payment/admin/intents/:transaction_id/refunds", httpx.Wrap(handler.NewRefundPaymentHandler(refundPayment)))
+	routes.GET("/payment/admin/reconciliation/discrepancies", httpx.Wrap(handler.NewListReconciliationDiscrepanciesHandler(listReconciliationDiscrepancies)))
+	routes.POST("/payment/admin/reconciliation/discrepancies/:discrepancy_id/review", httpx.Wrap(handler.NewReviewReconciliationDiscrepancyHandler(reviewReconciliationDiscrepancy)))
+	routes.GET("/payment/admin/disputes", httpx.Wrap(handler.NewListDisputesHandler(listDisputes)))
+	routes.GET("/payment/admin/disputes/:dispute_id", httpx.Wrap(handler.NewGetDisputeHandler(getDispute)))
+	routes.POST("/payment/admin/disputes/:dispute_id/evidence", httpx.Wrap(handler.NewUploadDisputeEvidenceHandler(uploadDisputeEvidence)))
+	routes.POST("/payment/admin/disputes/:dispute_id/submit", httpx.Wrap(handler.NewSubmitDisputeEvidenceHandler(submitDisputeEvidence)))
 }
@@ -14,17 +14,50 @@ import (
 )
 
 type paymentHTTPServer struct {
-	createPayment  cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse]
-	processWebhook cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse]
+	createPayment                   cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse]
+	processWebhook                  cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse]
+	listPaymentIntents              cqrs.Dispatcher[*in.ListPaymentIntentsRequest, *out.ListPaymentIntentsResponse]
+	getPaymentIntent                cqrs.Dispatcher[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse]
+	createWithdrawal                cqrs.Dispatcher[*in.CreateWithdrawalRequest, *out.CreateWithdrawalResponse]
+	getWithdrawal                   cqrs.Dispatcher[*in.GetWithdrawalRequest, *out.PaymentIntentResponse]
+	refundPayment                   cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse]
+	listReconciliationDiscrepancies cqrs.Dispatcher[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse]
+	reviewReconciliationDiscrepancy cqrs.Dispatcher[*in.ReviewReconciliationDiscrepancyRequest, *out.ReconciliationDiscrepancyResponse]
+	listDisputes                    cqrs.Dispatcher[*in.ListDisputesRequest, *out.ListDisputesResponse]
+	getDispute                      cqrs.Dispatcher[*in.GetDisputeRequest, *out.DisputeResponse]
+	uploadDisputeEvidence           cqrs.Dispatcher[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse]
+	submitDisputeEvidence           cqrs.Dispatcher[*in.SubmitDisputeEvidenceRequest, *out.DisputeResponse]
 }
 
 func NewHTTPServer(
 	createPayment cqrs.Dispatcher[*in.CreatePaymentRequest, *out.CreatePaymentResponse],
 	processWebhook cqrs.Dispatcher[*in.ProcessWebhookRequest, *out.ProcessWebhookResponse],
+	listPaymentIntents cqrs.Dispatcher[*in.ListPaymentIntentsRequest, *out.ListPaymentIntentsResponse],
+	getPaymentIntent cqrs.Dispatcher[*in.GetPaymentIntentRequest, *out.PaymentIntentResponse],
+	createWithdrawal cqrs.Dispatcher[*in.CreateWithdrawalRequest, *out.CreateWithdrawalResponse],
+	getWithdrawal cqrs.Dispatcher[*in.GetWithdrawalRequest, *out.PaymentIntentResponse],
+	refundPayment cqrs.Dispatcher[*in.RefundPaymentRequest, *out.RefundPaymentResponse],
+	listReconciliationDiscrepancies cqrs.Dispatcher[*in.ListReconciliationDiscrepanciesRequest, *out.ListReconciliationDiscrepanciesResponse],
+	reviewReconciliationDiscrepancy cqrs.Dispatcher[*in.ReviewReconciliationDiscrepancyRequest, *out.ReconciliationDiscrepancyResponse],
+	listDisputes cqrs.Dispatcher[*in.ListDisputesRequest, *out.ListDisputesResponse],
+	getDispute cqrs.Dispatcher[*in.GetDisputeRequest, *out.DisputeResponse],
+	uploadDisputeEvidence cqrs.Dispatcher[*in.UploadDisputeEvidenceRequest, *out.DisputeEvidenceResponse],
+	submitDisputeEvidence cqrs.Dispatcher[*in.SubmitDisputeEvidenceRequest, *out.DisputeResponse],
 ) (infrahttp.HTTPServer, error) {
 	return &paymentHTTPServer{
-		createPayment:  createPayment,
-		processWebhook: processWebhook,
+		createPayment:                   createPayment,
+		processWebhook:                  processWebhook,
+		listPaymentIntents:              listPaymentIntents,
+		getPaymentIntent:                getPaymentIntent,
+		createWithdrawal:                createWithdrawal,
+		getWithdrawal:                   getWithdrawal,
+		refundPayment:                   refundPayment,
+		listReconciliationDiscrepancies: listReconciliationDiscrepancies,
+		reviewReconciliationDiscrepancy: reviewReconciliationDiscrepancy,
+		listDisputes:                    listDisputes,
+		getDispute:                      getDispute,
+		uploadDisputeEvidence:           uploadDisputeEvidence,
+		submitDisputeEvidence:           submitDisputeEvidence,
 	}, nil
 }
 
@@ -33,7 +66,7 @@ func (s *paymentHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
 }
 
 func (s *paymentHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	paymenthttp.RegisterPrivateRoutes(routes, s.createPayment)
+	paymenthttp.RegisterPrivateRoutes(routes, s.createPayment, s.listPaymentIntents, s.getPaymentIntent, s.createWithdrawal, s.getWithdrawal, s.refundPayment, s.listReconciliationDiscrepancies, s.reviewReconciliationDiscrepancy, s.listDisputes, s.getDispute, s.uploadDisputeEvidence, s.submitDisputeEvidence)
 }
 
 func (s *paymentHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
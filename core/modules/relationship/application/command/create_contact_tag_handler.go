@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+type createContactTagHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewCreateContactTag(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.CreateContactTagRequest, *out.ContactTagResponse] {
+	return &createContactTagHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *createContactTagHandler) Handle(ctx context.Context, req *in.CreateContactTagRequest) (*out.ContactTagResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	tag, err := entity.NewContactTag(uuid.NewString(), accountID, req.Name, nowUTC())
+	if err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if err := txRepos.ContactTagRepository().LockOwner(ctx, accountID); err != nil {
+			return stackErr.Error(err)
+		}
+		count, err := txRepos.ContactTagRepository().CountByOwner(ctx, accountID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if count >= entity.MaxContactTagsPerOwner {
+			return stackErr.Error(domain.ErrContactTagLimitReached)
+		}
+		return stackErr.Error(txRepos.ContactTagRepository().Create(ctx, tag))
+	}); err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	response := support.ToContactTagResponse(tag)
+	return &response, nil
+}
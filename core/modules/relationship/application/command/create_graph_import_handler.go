@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	appCtx "wechat-clone/core/context"
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	appsupport "wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/modules/relationship/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+type createGraphImportHandler struct {
+	baseRepo repos.Repos
+	admins   accountsupport.AdminRoles
+}
+
+func NewCreateGraphImport(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.CreateGraphImportRequest, *out.GraphImportJobResponse] {
+	return &createGraphImportHandler{baseRepo: baseRepo, admins: admins}
+}
+
+// Handle only stores the parsed rows; the edges are applied by the graph
+// import task, which picks the job up on its next run.
+func (u *createGraphImportHandler) Handle(ctx context.Context, req *in.CreateGraphImportRequest) (*out.GraphImportJobResponse, error) {
+	admin, err := support.AdminFromAccounts(ctx, u.admins)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	format, err := entity.ParseGraphFormat(req.Format)
+	if err != nil {
+		return nil, stackErr.Error(mapGraphImportError(err))
+	}
+	rows, err := entity.ParseGraphImportRows(format, req.Content)
+	if err != nil {
+		return nil, stackErr.Error(mapGraphImportError(err))
+	}
+	job, err := entity.NewGraphImportJob(uuid.NewString(), admin.AccountID, format, len(rows), nowUTC())
+	if err != nil {
+		return nil, stackErr.Error(mapGraphImportError(err))
+	}
+
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		return stackErr.Error(txRepos.GraphImportJobRepository().Create(ctx, job, rows))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return appsupport.ToGraphImportJobResponse(job), nil
+}
+
+func mapGraphImportError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrGraphFormatInvalid):
+		return ErrGraphFormatInvalid
+	case errors.Is(err, domain.ErrGraphImportEmpty):
+		return ErrGraphImportEmpty
+	case errors.Is(err, domain.ErrGraphImportTooLarge):
+		return ErrGraphImportTooLarge
+	default:
+		return err
+	}
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type deleteContactTagHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewDeleteContactTag(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse] {
+	return &deleteContactTagHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *deleteContactTagHandler) Handle(ctx context.Context, req *in.DeleteContactTagRequest) (*out.DeleteContactTagResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var deleted bool
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		deleted, err = txRepos.ContactTagRepository().Delete(ctx, accountID, req.TagID)
+		return stackErr.Error(err)
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !deleted {
+		return nil, stackErr.Error(ErrContactTagNotFound)
+	}
+
+	return &out.DeleteContactTagResponse{Success: true}, nil
+}
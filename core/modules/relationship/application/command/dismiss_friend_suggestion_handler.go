@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type dismissFriendSuggestionHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewDismissFriendSuggestion(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.DismissFriendSuggestionRequest, *out.DismissFriendSuggestionResponse] {
+	return &dismissFriendSuggestionHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *dismissFriendSuggestionHandler) Handle(ctx context.Context, req *in.DismissFriendSuggestionRequest) (*out.DismissFriendSuggestionResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if req.TargetUserID == accountID {
+		return nil, stackErr.Error(ErrSuggestionTargetInvalid)
+	}
+
+	if err := u.baseRepo.FriendSuggestionRepository().Dismiss(ctx, accountID, req.TargetUserID, nowUTC()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.DismissFriendSuggestionResponse{Success: true}, nil
+}
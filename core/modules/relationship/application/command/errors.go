@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrFriendRequestSourceInvalid   = apperr.New("relationship.friend_request_source_invalid", "friend request source is invalid", http.StatusBadRequest)
+	ErrContactCardInvalid           = apperr.New("relationship.contact_card_invalid", "contact card does not match the target user", http.StatusBadRequest)
+	ErrFriendRequestResendLimited   = apperr.New("relationship.friend_request_resend_limited", "too many friend requests sent to this user, try again later", http.StatusTooManyRequests)
+	ErrFriendRemarkInvalid          = apperr.New("relationship.friend_remark_invalid", "friend remark is invalid", http.StatusBadRequest)
+	ErrFriendTagsInvalid            = apperr.New("relationship.friend_tags_invalid", "friend tags are invalid", http.StatusBadRequest)
+	ErrNotFriends                   = apperr.New("relationship.not_friends", "target user is not your friend", http.StatusForbidden)
+	ErrContactTagNameInvalid        = apperr.New("relationship.contact_tag_name_invalid", "contact tag name is invalid", http.StatusBadRequest)
+	ErrContactTagNameTaken          = apperr.New("relationship.contact_tag_name_taken", "contact tag name already exists", http.StatusConflict)
+	ErrContactTagNotFound           = apperr.New("relationship.contact_tag_not_found", "contact tag not found", http.StatusNotFound)
+	ErrContactTagLimitReached       = apperr.New("relationship.contact_tag_limit_reached", "contact tag limit reached", http.StatusConflict)
+	ErrFriendRequestNotAllowed      = apperr.New("relationship.friend_request_not_allowed", "this user does not accept friend requests from you", http.StatusForbidden)
+	ErrFriendRequestAudienceInvalid = apperr.New("relationship.friend_request_audience_invalid", "allow_requests_from must be everyone, friends_of_friends or nobody", http.StatusBadRequest)
+	ErrAudienceListInvalid          = apperr.New("relationship.audience_list_invalid", "audience list must be close_friends, restricted or empty", http.StatusBadRequest)
+	ErrSuggestionTargetInvalid      = apperr.New("relationship.suggestion_target_invalid", "cannot dismiss yourself as a suggestion", http.StatusBadRequest)
+	ErrGraphFormatInvalid           = apperr.New("relationship.graph_format_invalid", "format must be csv or jsonl, and csv needs a user_id,target_id,relation header", http.StatusBadRequest)
+	ErrGraphImportEmpty             = apperr.New("relationship.graph_import_empty", "import file has no rows", http.StatusBadRequest)
+	ErrGraphImportTooLarge          = apperr.New("relationship.graph_import_too_large", "import file has too many rows", http.StatusRequestEntityTooLarge)
+)
+
+func mapContactError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrFriendRemarkInvalid):
+		return ErrFriendRemarkInvalid
+	case errors.Is(err, domain.ErrFriendTagsInvalid):
+		return ErrFriendTagsInvalid
+	case errors.Is(err, domain.ErrFriendshipNotFound):
+		return ErrNotFriends
+	case errors.Is(err, domain.ErrContactTagNameInvalid):
+		return ErrContactTagNameInvalid
+	case errors.Is(err, domain.ErrContactTagNameTaken):
+		return ErrContactTagNameTaken
+	case errors.Is(err, domain.ErrContactTagNotFound):
+		return ErrContactTagNotFound
+	case errors.Is(err, domain.ErrContactTagLimitReached):
+		return ErrContactTagLimitReached
+	case errors.Is(err, domain.ErrAudienceListInvalid):
+		return ErrAudienceListInvalid
+	default:
+		return err
+	}
+}
@@ -2,27 +2,59 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/relationship/application/dto/in"
 	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
 	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/infra/ratelimit"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// ContactCardAccountReader returns the projected account, which carries the
+// nonce of its current contact card.
+type ContactCardAccountReader interface {
+	GetByID(ctx context.Context, accountID string) (*entity.AccountProjection, error)
+}
+
 type sendFriendRequestHandler struct {
-	baseRepo repos.Repos
+	baseRepo      repos.Repos
+	accounts      ContactCardAccountReader
+	contactCards  contactcard.Signer
+	resendLimiter *ratelimit.SlidingWindowLimiter
 }
 
 func NewSendFriendRequest(
 	appCtx *appCtx.AppContext,
 	baseRepo repos.Repos,
+	accounts ContactCardAccountReader,
+	contactCards contactcard.Signer,
 ) cqrs.Handler[*in.SendFriendRequestRequest, *out.SendFriendRequestResponse] {
-	return &sendFriendRequestHandler{
-		baseRepo: baseRepo,
+	handler := &sendFriendRequestHandler{
+		baseRepo:     baseRepo,
+		accounts:     accounts,
+		contactCards: contactCards,
 	}
+	if appCtx != nil && appCtx.GetConfig() != nil {
+		cfg := appCtx.GetConfig().RelationshipConfig.FriendRequest
+		if cfg.ResendLimit > 0 && cfg.ResendWindowSeconds > 0 {
+			handler.resendLimiter = ratelimit.NewSlidingWindowLimiter(
+				appCtx.GetCache(),
+				int64(cfg.ResendLimit),
+				time.Duration(cfg.ResendWindowSeconds)*time.Second,
+			)
+		}
+	}
+	return handler
 }
 
 func (u *sendFriendRequestHandler) Handle(ctx context.Context, req *in.SendFriendRequestRequest) (*out.SendFriendRequestResponse, error) {
@@ -31,6 +63,19 @@ func (u *sendFriendRequestHandler) Handle(ctx context.Context, req *in.SendFrien
 		return nil, stackErr.Error(err)
 	}
 
+	source, sharedByID, err := u.resolveSource(ctx, accountID, req)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	allowed, err := u.resendLimiter.Allow(ctx, fmt.Sprintf("relationship:friend_request:%s:%s", accountID, req.TargetUserID))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !allowed {
+		return nil, stackErr.Error(ErrFriendRequestResendLimited)
+	}
+
 	requestID := uuid.NewString()
 	now := nowUTC()
 
@@ -40,7 +85,7 @@ func (u *sendFriendRequestHandler) Handle(ctx context.Context, req *in.SendFrien
 		if err != nil {
 			return stackErr.Error(err)
 		}
-		if err := pairAgg.SendFriendRequest(requestID, now); err != nil {
+		if err := pairAgg.SendFriendRequest(requestID, source, sharedByID, now); err != nil {
 			return stackErr.Error(err)
 		}
 		friendRequest := pairAgg.FriendRequest()
@@ -48,14 +93,92 @@ func (u *sendFriendRequestHandler) Handle(ctx context.Context, req *in.SendFrien
 		response.RequesterID = friendRequest.RequesterID
 		response.AddresseeID = friendRequest.AddresseeID
 		response.Status = friendRequest.Status.String()
+		response.Source = friendRequest.Source.String()
+		response.SharedByID = friendRequest.SharedByID
 
 		if err := txRepos.RelationshipPairAggregateRepository().Save(ctx, pairAgg); err != nil {
 			return stackErr.Error(err)
 		}
 		return nil
 	}); err != nil {
+		if errors.Is(err, domain.ErrFriendRequestNotAllowed) {
+			return nil, stackErr.Error(ErrFriendRequestNotAllowed)
+		}
 		return nil, stackErr.Error(err)
 	}
 
 	return response, nil
 }
+
+// resolveSource derives the source from what the caller presents instead of
+// taking the requested one on trust. A contact card signed for the target
+// decides it: a QR card (which must still carry the target's current nonce,
+// so resetting the card revokes codes already handed out), a card shared in
+// chat (which names its sharer, returned for "card_share"), or a lookup card
+// handed out by a username or email search run by the caller. Shared and
+// lookup cards expire; Parse rejects them once they have. Without a card
+// the request is "direct", or "group" when asked for, which the pair policy
+// checks against shared rooms. A requested source that disagrees with the
+// card is rejected.
+func (u *sendFriendRequestHandler) resolveSource(ctx context.Context, accountID string, req *in.SendFriendRequestRequest) (entity.FriendRequestSource, string, error) {
+	requested, err := entity.ParseFriendRequestSource(req.Source)
+	if err != nil {
+		return "", "", stackErr.Error(ErrFriendRequestSourceInvalid)
+	}
+
+	if req.ContactCardToken == "" {
+		switch requested {
+		case entity.FriendRequestSourceDirect, entity.FriendRequestSourceGroup:
+			return requested, "", nil
+		default:
+			return "", "", stackErr.Error(ErrContactCardInvalid)
+		}
+	}
+
+	card, err := u.contactCards.Parse(contactcard.ExtractToken(req.ContactCardToken))
+	if err != nil || card.AccountID != req.TargetUserID {
+		return "", "", stackErr.Error(ErrContactCardInvalid)
+	}
+
+	// Parse only accepts cards of exactly one kind, so the fields checked below
+	// are the kind's own: a lookup has Via, a share has SharedBy and an expiry,
+	// a QR card has a nonce.
+	var source entity.FriendRequestSource
+	sharedByID := ""
+	switch {
+	case card.Via != "":
+		source, err = entity.ParseFriendRequestSource(card.Via)
+		if err != nil || card.IssuedTo != accountID ||
+			(source != entity.FriendRequestSourceSearchUsername && source != entity.FriendRequestSourceSearchEmail) {
+			return "", "", stackErr.Error(ErrContactCardInvalid)
+		}
+	case card.SharedBy != "":
+		source = entity.FriendRequestSourceCardShare
+		if card.Nonce != "" || card.ExpiresAt.IsZero() {
+			return "", "", stackErr.Error(ErrContactCardInvalid)
+		}
+		if card.SharedBy != accountID {
+			sharedByID = card.SharedBy
+		}
+	case card.Nonce != "":
+		source = entity.FriendRequestSourceQR
+		target, err := u.accounts.GetByID(ctx, req.TargetUserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", "", stackErr.Error(ErrContactCardInvalid)
+			}
+			return "", "", stackErr.Error(err)
+		}
+		if card.Nonce != target.ContactCardNonce {
+			return "", "", stackErr.Error(ErrContactCardInvalid)
+		}
+	default:
+		return "", "", stackErr.Error(ErrContactCardInvalid)
+	}
+
+	// An empty source means the client left it to the card.
+	if req.Source != "" && requested != source {
+		return "", "", stackErr.Error(ErrFriendRequestSourceInvalid)
+	}
+	return source, sharedByID, nil
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type setFriendAudienceListHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewSetFriendAudienceList(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse] {
+	return &setFriendAudienceListHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *setFriendAudienceListHandler) Handle(ctx context.Context, req *in.SetFriendAudienceListRequest) (*out.FriendAudienceListResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	list, err := entity.ParseAudienceList(req.AudienceList)
+	if err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	var response *out.FriendAudienceListResponse
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		contactAgg, err := txRepos.FriendContactAggregateRepository().Load(ctx, accountID, req.TargetUserID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := contactAgg.SetAudienceList(list, nowUTC()); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.FriendContactAggregateRepository().Save(ctx, contactAgg); err != nil {
+			return stackErr.Error(err)
+		}
+		response = support.ToFriendAudienceListResponse(req.TargetUserID, contactAgg.Contact())
+		return nil
+	}); err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	return response, nil
+}
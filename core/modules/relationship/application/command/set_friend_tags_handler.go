@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type setFriendTagsHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewSetFriendTags(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.SetFriendTagsRequest, *out.FriendRemarkResponse] {
+	return &setFriendTagsHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *setFriendTagsHandler) Handle(ctx context.Context, req *in.SetFriendTagsRequest) (*out.FriendRemarkResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	tagIDs, err := entity.NormalizeFriendTagIDs(req.TagIDs)
+	if err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	now := nowUTC()
+	var response *out.FriendRemarkResponse
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		owned, err := txRepos.ContactTagRepository().FilterOwned(ctx, accountID, tagIDs)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if len(owned) != len(tagIDs) {
+			return stackErr.Error(domain.ErrFriendTagsInvalid)
+		}
+
+		contactAgg, err := txRepos.FriendContactAggregateRepository().Load(ctx, accountID, req.TargetUserID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := contactAgg.SetTags(tagIDs, now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.FriendContactAggregateRepository().Save(ctx, contactAgg); err != nil {
+			return stackErr.Error(err)
+		}
+		response = support.ToFriendRemarkResponse(req.TargetUserID, contactAgg.Contact())
+		return nil
+	}); err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type updateContactTagHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewUpdateContactTag(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.UpdateContactTagRequest, *out.ContactTagResponse] {
+	return &updateContactTagHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *updateContactTagHandler) Handle(ctx context.Context, req *in.UpdateContactTagRequest) (*out.ContactTagResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var tag *entity.ContactTag
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		tag, err = txRepos.ContactTagRepository().Get(ctx, accountID, req.TagID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := tag.Rename(req.Name, nowUTC()); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.ContactTagRepository().Update(ctx, tag))
+	}); err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	response := support.ToContactTagResponse(tag)
+	return &response, nil
+}
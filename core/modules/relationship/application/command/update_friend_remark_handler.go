@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type updateFriendRemarkHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewUpdateFriendRemark(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.UpdateFriendRemarkRequest, *out.FriendRemarkResponse] {
+	return &updateFriendRemarkHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *updateFriendRemarkHandler) Handle(ctx context.Context, req *in.UpdateFriendRemarkRequest) (*out.FriendRemarkResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := nowUTC()
+	var response *out.FriendRemarkResponse
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		contactAgg, err := txRepos.FriendContactAggregateRepository().Load(ctx, accountID, req.TargetUserID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := contactAgg.UpdateRemark(req.RemarkName, req.Description, req.PhoneNotes, now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.FriendContactAggregateRepository().Save(ctx, contactAgg); err != nil {
+			return stackErr.Error(err)
+		}
+		response = support.ToFriendRemarkResponse(req.TargetUserID, contactAgg.Contact())
+		return nil
+	}); err != nil {
+		return nil, stackErr.Error(mapContactError(err))
+	}
+
+	return response, nil
+}
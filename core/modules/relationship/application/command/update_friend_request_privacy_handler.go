@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type updateFriendRequestPrivacyHandler struct {
+	baseRepo repos.Repos
+}
+
+func NewUpdateFriendRequestPrivacy(
+	appCtx *appCtx.AppContext,
+	baseRepo repos.Repos,
+) cqrs.Handler[*in.UpdateFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse] {
+	return &updateFriendRequestPrivacyHandler{
+		baseRepo: baseRepo,
+	}
+}
+
+func (u *updateFriendRequestPrivacyHandler) Handle(ctx context.Context, req *in.UpdateFriendRequestPrivacyRequest) (*out.FriendRequestPrivacyResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	audience, err := entity.ParseFriendRequestAudience(req.AllowRequestsFrom)
+	if err != nil {
+		return nil, stackErr.Error(ErrFriendRequestAudienceInvalid)
+	}
+
+	var privacy *entity.FriendRequestPrivacy
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		privacyRepo := txRepos.FriendRequestPrivacyRepository()
+		current, err := privacyRepo.Get(ctx, accountID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		current.Update(audience, req.FindableByUsername, req.FindableByEmail, req.FindableByQr, req.AllowFromSharedGroups, nowUTC())
+		privacy = current
+		return stackErr.Error(privacyRepo.Save(ctx, current))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return support.ToFriendRequestPrivacyResponse(privacy), nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type CreateContactTagRequest struct {
+	Name string `json:"name" form:"name" binding:"required"`
+}
+
+func (r *CreateContactTagRequest) Normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+}
+
+func (r *CreateContactTagRequest) Validate() error {
+	r.Normalize()
+	if r.Name == "" {
+		return stackErr.Error(errors.New("name is required"))
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type CreateGraphImportRequest struct {
+	Format  string `json:"format" form:"format"`
+	Content string `json:"content" form:"content" binding:"required"`
+}
+
+func (r *CreateGraphImportRequest) Normalize() {
+	r.Format = strings.TrimSpace(r.Format)
+	r.Content = strings.TrimSpace(r.Content)
+}
+
+func (r *CreateGraphImportRequest) Validate() error {
+	r.Normalize()
+	if r.Content == "" {
+		return stackErr.Error(errors.New("content is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type DeleteContactTagRequest struct {
+	TagID string `json:"tag_id" form:"tag_id" binding:"required"`
+}
+
+func (r *DeleteContactTagRequest) Normalize() {
+	r.TagID = strings.TrimSpace(r.TagID)
+}
+
+func (r *DeleteContactTagRequest) Validate() error {
+	r.Normalize()
+	if r.TagID == "" {
+		return stackErr.Error(errors.New("tag_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ExportRelationshipGraphRequest struct {
+	Format string `json:"format" form:"format"`
+}
+
+func (r *ExportRelationshipGraphRequest) Normalize() {
+	r.Format = strings.TrimSpace(r.Format)
+}
+
+func (r *ExportRelationshipGraphRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type GetFriendRequestPrivacyRequest struct {
+}
+
+func (r *GetFriendRequestPrivacyRequest) Validate() error {
+	return nil
+}
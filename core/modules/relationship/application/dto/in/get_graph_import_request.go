@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetGraphImportRequest struct {
+	JobID string `json:"job_id" form:"job_id" binding:"required"`
+}
+
+func (r *GetGraphImportRequest) Normalize() {
+	r.JobID = strings.TrimSpace(r.JobID)
+}
+
+func (r *GetGraphImportRequest) Validate() error {
+	r.Normalize()
+	if r.JobID == "" {
+		return stackErr.Error(errors.New("job_id is required"))
+	}
+	return nil
+}
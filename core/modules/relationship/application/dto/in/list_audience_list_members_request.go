@@ -0,0 +1,28 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ListAudienceListMembersRequest struct {
+	AudienceList string `json:"audience_list" form:"audience_list" binding:"required"`
+	Cursor       string `json:"cursor" form:"cursor"`
+	Limit        int    `json:"limit" form:"limit"`
+}
+
+func (r *ListAudienceListMembersRequest) Normalize() {
+	r.AudienceList = strings.TrimSpace(r.AudienceList)
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListAudienceListMembersRequest) Validate() error {
+	r.Normalize()
+	if r.AudienceList == "" {
+		return stackErr.Error(errors.New("audience_list is required"))
+	}
+	return nil
+}
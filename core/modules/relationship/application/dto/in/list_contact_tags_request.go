@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+type ListContactTagsRequest struct {
+}
+
+func (r *ListContactTagsRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,21 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"strings"
+)
+
+type ListFriendSuggestionsRequest struct {
+	Cursor string `json:"cursor" form:"cursor"`
+	Limit  int    `json:"limit" form:"limit"`
+}
+
+func (r *ListFriendSuggestionsRequest) Normalize() {
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListFriendSuggestionsRequest) Validate() error {
+	r.Normalize()
+	return nil
+}
@@ -8,12 +8,14 @@ import (
 
 type ListFriendsRequest struct {
 	UserID string `json:"user_id" form:"user_id"`
+	TagID  string `json:"tag_id" form:"tag_id"`
 	Cursor string `json:"cursor" form:"cursor"`
 	Limit  int    `json:"limit" form:"limit"`
 }
 
 func (r *ListFriendsRequest) Normalize() {
 	r.UserID = strings.TrimSpace(r.UserID)
+	r.TagID = strings.TrimSpace(r.TagID)
 	r.Cursor = strings.TrimSpace(r.Cursor)
 }
 
@@ -0,0 +1,30 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ListGraphImportRowsRequest struct {
+	JobID  string `json:"job_id" form:"job_id" binding:"required"`
+	Status string `json:"status" form:"status"`
+	Cursor string `json:"cursor" form:"cursor"`
+	Limit  int    `json:"limit" form:"limit"`
+}
+
+func (r *ListGraphImportRowsRequest) Normalize() {
+	r.JobID = strings.TrimSpace(r.JobID)
+	r.Status = strings.TrimSpace(r.Status)
+	r.Cursor = strings.TrimSpace(r.Cursor)
+}
+
+func (r *ListGraphImportRowsRequest) Validate() error {
+	r.Normalize()
+	if r.JobID == "" {
+		return stackErr.Error(errors.New("job_id is required"))
+	}
+	return nil
+}
@@ -9,11 +9,15 @@ import (
 )
 
 type SendFriendRequestRequest struct {
-	TargetUserID string `json:"target_user_id" form:"target_user_id" binding:"required"`
+	TargetUserID     string `json:"target_user_id" form:"target_user_id" binding:"required"`
+	Source           string `json:"source" form:"source"`
+	ContactCardToken string `json:"contact_card_token" form:"contact_card_token"`
 }
 
 func (r *SendFriendRequestRequest) Normalize() {
 	r.TargetUserID = strings.TrimSpace(r.TargetUserID)
+	r.Source = strings.TrimSpace(r.Source)
+	r.ContactCardToken = strings.TrimSpace(r.ContactCardToken)
 }
 
 func (r *SendFriendRequestRequest) Validate() error {
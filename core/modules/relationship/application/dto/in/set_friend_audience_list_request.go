@@ -0,0 +1,27 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SetFriendAudienceListRequest struct {
+	TargetUserID string `json:"target_user_id" form:"target_user_id" binding:"required"`
+	AudienceList string `json:"audience_list" form:"audience_list"`
+}
+
+func (r *SetFriendAudienceListRequest) Normalize() {
+	r.TargetUserID = strings.TrimSpace(r.TargetUserID)
+	r.AudienceList = strings.TrimSpace(r.AudienceList)
+}
+
+func (r *SetFriendAudienceListRequest) Validate() error {
+	r.Normalize()
+	if r.TargetUserID == "" {
+		return stackErr.Error(errors.New("target_user_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SetFriendTagsRequest struct {
+	TargetUserID string   `json:"target_user_id" form:"target_user_id" binding:"required"`
+	TagIDs       []string `json:"tag_ids" form:"tag_ids"`
+}
+
+func (r *SetFriendTagsRequest) Normalize() {
+	r.TargetUserID = strings.TrimSpace(r.TargetUserID)
+	for i := range r.TagIDs {
+		r.TagIDs[i] = strings.TrimSpace(r.TagIDs[i])
+	}
+}
+
+func (r *SetFriendTagsRequest) Validate() error {
+	r.Normalize()
+	if r.TargetUserID == "" {
+		return stackErr.Error(errors.New("target_user_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type UpdateContactTagRequest struct {
+	TagID string `json:"tag_id" form:"tag_id" binding:"required"`
+	Name  string `json:"name" form:"name" binding:"required"`
+}
+
+func (r *UpdateContactTagRequest) Normalize() {
+	r.TagID = strings.TrimSpace(r.TagID)
+	r.Name = strings.TrimSpace(r.Name)
+}
+
+func (r *UpdateContactTagRequest) Validate() error {
+	r.Normalize()
+	if r.TagID == "" {
+		return stackErr.Error(errors.New("tag_id is required"))
+	}
+	if r.Name == "" {
+		return stackErr.Error(errors.New("name is required"))
+	}
+	return nil
+}
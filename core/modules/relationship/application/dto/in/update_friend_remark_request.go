@@ -0,0 +1,33 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type UpdateFriendRemarkRequest struct {
+	TargetUserID string   `json:"target_user_id" form:"target_user_id" binding:"required"`
+	RemarkName   string   `json:"remark_name" form:"remark_name"`
+	Description  string   `json:"description" form:"description"`
+	PhoneNotes   []string `json:"phone_notes" form:"phone_notes"`
+}
+
+func (r *UpdateFriendRemarkRequest) Normalize() {
+	r.TargetUserID = strings.TrimSpace(r.TargetUserID)
+	r.RemarkName = strings.TrimSpace(r.RemarkName)
+	r.Description = strings.TrimSpace(r.Description)
+	for i := range r.PhoneNotes {
+		r.PhoneNotes[i] = strings.TrimSpace(r.PhoneNotes[i])
+	}
+}
+
+func (r *UpdateFriendRemarkRequest) Validate() error {
+	r.Normalize()
+	if r.TargetUserID == "" {
+		return stackErr.Error(errors.New("target_user_id is required"))
+	}
+	return nil
+}
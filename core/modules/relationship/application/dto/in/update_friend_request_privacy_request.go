@@ -0,0 +1,29 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type UpdateFriendRequestPrivacyRequest struct {
+	AllowRequestsFrom     string `json:"allow_requests_from" form:"allow_requests_from" binding:"required"`
+	FindableByUsername    bool   `json:"findable_by_username" form:"findable_by_username"`
+	FindableByEmail       bool   `json:"findable_by_email" form:"findable_by_email"`
+	FindableByQr          bool   `json:"findable_by_qr" form:"findable_by_qr"`
+	AllowFromSharedGroups bool   `json:"allow_from_shared_groups" form:"allow_from_shared_groups"`
+}
+
+func (r *UpdateFriendRequestPrivacyRequest) Normalize() {
+	r.AllowRequestsFrom = strings.TrimSpace(r.AllowRequestsFrom)
+}
+
+func (r *UpdateFriendRequestPrivacyRequest) Validate() error {
+	r.Normalize()
+	if r.AllowRequestsFrom == "" {
+		return stackErr.Error(errors.New("allow_requests_from is required"))
+	}
+	return nil
+}
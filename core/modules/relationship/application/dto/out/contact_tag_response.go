@@ -0,0 +1,10 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ContactTagResponse struct {
+	TagID       string `json:"tag_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	MemberCount int64  `json:"member_count,omitempty"`
+	CreatedAt   int64  `json:"created_at,omitempty"`
+	UpdatedAt   int64  `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,6 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type DismissFriendSuggestionResponse struct {
+	Success bool `json:"success,omitempty"`
+}
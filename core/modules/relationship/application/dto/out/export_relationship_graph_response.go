@@ -0,0 +1,9 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ExportRelationshipGraphResponse struct {
+	Format      string `json:"format,omitempty"`
+	Content     string `json:"content,omitempty"`
+	RowCount    int    `json:"row_count,omitempty"`
+	GeneratedAt int64  `json:"generated_at,omitempty"`
+}
@@ -0,0 +1,8 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type FriendAudienceListResponse struct {
+	FriendID     string `json:"friend_id,omitempty"`
+	AudienceList string `json:"audience_list,omitempty"`
+	UpdatedAt    int64  `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,11 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type FriendRemarkResponse struct {
+	FriendID    string   `json:"friend_id,omitempty"`
+	RemarkName  string   `json:"remark_name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	PhoneNotes  []string `json:"phone_notes,omitempty"`
+	TagIDs      []string `json:"tag_ids,omitempty"`
+	UpdatedAt   int64    `json:"updated_at,omitempty"`
+}
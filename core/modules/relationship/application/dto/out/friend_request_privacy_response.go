@@ -0,0 +1,11 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type FriendRequestPrivacyResponse struct {
+	AllowRequestsFrom     string `json:"allow_requests_from,omitempty"`
+	FindableByUsername    bool   `json:"findable_by_username"`
+	FindableByEmail       bool   `json:"findable_by_email"`
+	FindableByQr          bool   `json:"findable_by_qr"`
+	AllowFromSharedGroups bool   `json:"allow_from_shared_groups"`
+	UpdatedAt             int64  `json:"updated_at,omitempty"`
+}
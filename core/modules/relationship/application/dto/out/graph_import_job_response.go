@@ -0,0 +1,15 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type GraphImportJobResponse struct {
+	JobID        string `json:"job_id,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Format       string `json:"format,omitempty"`
+	TotalRows    int    `json:"total_rows,omitempty"`
+	NextRow      int    `json:"next_row,omitempty"`
+	ImportedRows int    `json:"imported_rows,omitempty"`
+	SkippedRows  int    `json:"skipped_rows,omitempty"`
+	FailedRows   int    `json:"failed_rows,omitempty"`
+	CreatedAt    int64  `json:"created_at,omitempty"`
+	CompletedAt  int64  `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,7 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListAudienceListMembersResponse struct {
+	Items      []RelationshipAccountSummaryResponse `json:"items,omitempty"`
+	NextCursor string                               `json:"next_cursor,omitempty"`
+}
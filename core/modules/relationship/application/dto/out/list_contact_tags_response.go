@@ -0,0 +1,6 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListContactTagsResponse struct {
+	Items []ContactTagResponse `json:"items,omitempty"`
+}
@@ -0,0 +1,17 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListFriendSuggestionsResponse struct {
+	Items      []FriendSuggestionResponse `json:"items,omitempty"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+}
+
+type FriendSuggestionResponse struct {
+	AccountID         string `json:"account_id,omitempty"`
+	DisplayName       string `json:"display_name,omitempty"`
+	Username          string `json:"username,omitempty"`
+	AvatarObjectKey   string `json:"avatar_object_key,omitempty"`
+	MutualFriendCount int64  `json:"mutual_friend_count,omitempty"`
+	SharedRoomCount   int64  `json:"shared_room_count,omitempty"`
+	RecentlyViewed    bool   `json:"recently_viewed,omitempty"`
+}
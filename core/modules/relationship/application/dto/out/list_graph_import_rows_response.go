@@ -0,0 +1,16 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ListGraphImportRowsResponse struct {
+	Items      []GraphImportRowResponse `json:"items,omitempty"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+type GraphImportRowResponse struct {
+	RowNumber int    `json:"row_number,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	TargetID  string `json:"target_id,omitempty"`
+	Relation  string `json:"relation,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
@@ -2,13 +2,15 @@
 package out
 
 type ListIncomingFriendRequestsResponse struct {
-	Items      []RelationshipAccountSummaryResponse `json:"items,omitempty"`
-	NextCursor string                               `json:"next_cursor,omitempty"`
+	Items      []IncomingFriendRequestResponse `json:"items,omitempty"`
+	NextCursor string                          `json:"next_cursor,omitempty"`
 }
 
-type RelationshipAccountSummaryResponse struct {
+type IncomingFriendRequestResponse struct {
 	AccountID       string `json:"account_id,omitempty"`
 	DisplayName     string `json:"display_name,omitempty"`
 	Username        string `json:"username,omitempty"`
 	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
+	Source          string `json:"source,omitempty"`
+	SharedByID      string `json:"shared_by_id,omitempty"`
 }
@@ -6,4 +6,6 @@ type SendFriendRequestResponse struct {
 	RequesterID string `json:"requester_id,omitempty"`
 	AddresseeID string `json:"addressee_id,omitempty"`
 	Status      string `json:"status,omitempty"`
+	Source      string `json:"source,omitempty"`
+	SharedByID  string `json:"shared_by_id,omitempty"`
 }
@@ -57,6 +57,45 @@ func (h *messageHandler) handleAccountUpdatedEvent(ctx context.Context, raw json
 	return stackErr.Error(h.accountRepo.ProjectAccount(ctx, account))
 }
 
+func (h *messageHandler) handleAccountUsernameChangedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventAccountUsernameChanged, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.AccountUsernameChangedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventAccountUsernameChanged))
+	}
+
+	account, err := h.accountRepo.GetByID(ctx, payload.AccountID)
+	if err != nil {
+		account = &entity.AccountProjection{
+			AccountID: payload.AccountID,
+			CreatedAt: payload.ChangedAt,
+		}
+	}
+
+	account.Username = strings.TrimSpace(payload.Username)
+	account.UpdatedAt = payload.ChangedAt
+
+	return stackErr.Error(h.accountRepo.ProjectAccount(ctx, account))
+}
+
+func (h *messageHandler) handleAccountContactCardRotatedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventAccountContactCardRotated, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.AccountContactCardRotatedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventAccountContactCardRotated))
+	}
+
+	return stackErr.Error(h.accountRepo.ProjectContactCard(ctx, payload.AccountID, payload.Nonce, payload.RotatedAt))
+}
+
 func resolveAccountCreatedDisplayName(payload *sharedevents.AccountCreatedEvent) string {
 	if payload == nil {
 		return ""
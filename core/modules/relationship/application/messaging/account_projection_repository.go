@@ -2,6 +2,7 @@ package messaging
 
 import (
 	"context"
+	"time"
 
 	"wechat-clone/core/modules/relationship/domain/entity"
 )
@@ -10,4 +11,5 @@ import (
 type AccountProjectionRepository interface {
 	ProjectAccount(ctx context.Context, account *entity.AccountProjection) error
 	GetByID(ctx context.Context, accountID string) (*entity.AccountProjection, error)
+	ProjectContactCard(ctx context.Context, accountID, nonce string, rotatedAt time.Time) error
 }
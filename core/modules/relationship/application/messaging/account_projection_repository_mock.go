@@ -12,6 +12,7 @@ package messaging
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	entity "wechat-clone/core/modules/relationship/domain/entity"
 
 	gomock "go.uber.org/mock/gomock"
@@ -69,3 +70,17 @@ func (mr *MockAccountProjectionRepositoryMockRecorder) ProjectAccount(ctx, accou
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectAccount", reflect.TypeOf((*MockAccountProjectionRepository)(nil).ProjectAccount), ctx, account)
 }
+
+// ProjectContactCard mocks base method.
+func (m *MockAccountProjectionRepository) ProjectContactCard(ctx context.Context, accountID, nonce string, rotatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectContactCard", ctx, accountID, nonce, rotatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectContactCard indicates an expected call of ProjectContactCard.
+func (mr *MockAccountProjectionRepositoryMockRecorder) ProjectContactCard(ctx, accountID, nonce, rotatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectContactCard", reflect.TypeOf((*MockAccountProjectionRepository)(nil).ProjectContactCard), ctx, accountID, nonce, rotatedAt)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	relationshipservice "wechat-clone/core/modules/relationship/application/service"
 	"wechat-clone/core/shared/config"
 	"wechat-clone/core/shared/contracts"
 	sharedevents "wechat-clone/core/shared/contracts/events"
@@ -24,37 +25,58 @@ type MessageHandler interface {
 type messageHandler struct {
 	consumer    []infraMessaging.Consumer
 	accountRepo AccountProjectionRepository
+	suggestions relationshipservice.FriendSuggestionService
 }
 
-func NewMessageHandler(cfg *config.Config, accountRepo AccountProjectionRepository) (MessageHandler, error) {
+func NewMessageHandler(
+	cfg *config.Config,
+	accountRepo AccountProjectionRepository,
+	suggestions relationshipservice.FriendSuggestionService,
+) (MessageHandler, error) {
 	instance := &messageHandler{
-		consumer:    make([]infraMessaging.Consumer, 0, 1),
+		consumer:    make([]infraMessaging.Consumer, 0, 3),
 		accountRepo: accountRepo,
+		suggestions: suggestions,
 	}
+	consumerCfg := cfg.KafkaConfig.KafkaRelationshipConsumer
 
-	accountTopic := strings.TrimSpace(cfg.KafkaConfig.KafkaRelationshipConsumer.AccountTopic)
-	if accountTopic == "" {
+	if err := instance.addConsumer(cfg, consumerCfg.RelationshipProjectionGroup, consumerCfg.AccountTopic, instance.handleAccountEvent); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if suggestions == nil {
 		return instance, nil
 	}
+	if err := instance.addConsumer(cfg, consumerCfg.RelationshipSuggestionGroup, consumerCfg.RelationshipOutboxTopic, instance.handleRelationshipEvent); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := instance.addConsumer(cfg, consumerCfg.RelationshipSuggestionGroup, consumerCfg.RoomOutboxTopic, instance.handleRoomEvent); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return instance, nil
+}
+
+func (h *messageHandler) addConsumer(cfg *config.Config, group, topic string, handle func(context.Context, []byte) error) error {
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return nil
+	}
 
 	consumer, err := infraMessaging.NewConsumer(&infraMessaging.Config{
 		Servers:      cfg.KafkaConfig.KafkaServers,
-		Group:        cfg.KafkaConfig.KafkaRelationshipConsumer.RelationshipProjectionGroup,
+		Group:        group,
 		OffsetReset:  cfg.KafkaConfig.KafkaOffsetReset,
-		ConsumeTopic: []string{accountTopic},
-		HandlerName:  fmt.Sprintf("relationship-%s-handler", strings.ToLower(accountTopic)),
+		ConsumeTopic: []string{topic},
+		HandlerName:  fmt.Sprintf("relationship-%s-handler", strings.ToLower(topic)),
 		DLQ:          true,
 	})
 	if err != nil {
-		return nil, stackErr.Error(err)
+		return stackErr.Error(err)
 	}
 
-	consumer.SetHandler(func(ctx context.Context, value []byte) error {
-		return instance.handleAccountEvent(ctx, value)
-	})
-	instance.consumer = append(instance.consumer, consumer)
-
-	return instance, nil
+	consumer.SetHandler(handle)
+	h.consumer = append(h.consumer, consumer)
+	return nil
 }
 
 func (h *messageHandler) Start() error {
@@ -84,6 +106,12 @@ func (h *messageHandler) handleAccountEvent(ctx context.Context, value []byte) e
 		return stackErr.Error(h.handleAccountCreatedEvent(ctx, event.EventData))
 	case sharedevents.EventAccountProfileUpdated:
 		return stackErr.Error(h.handleAccountUpdatedEvent(ctx, event.EventData))
+	case sharedevents.EventAccountUsernameChanged:
+		return stackErr.Error(h.handleAccountUsernameChangedEvent(ctx, event.EventData))
+	case sharedevents.EventAccountProfileViewed:
+		return stackErr.Error(h.handleAccountProfileViewedEvent(ctx, event.EventData))
+	case sharedevents.EventAccountContactCardRotated:
+		return stackErr.Error(h.handleAccountContactCardRotatedEvent(ctx, event.EventData))
 	default:
 		return nil
 	}
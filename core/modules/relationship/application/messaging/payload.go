@@ -13,8 +13,18 @@ import (
 )
 
 var eventPayloadTypes = map[string]reflect.Type{
-	sharedevents.EventAccountCreated:        reflect.TypeOf(sharedevents.AccountCreatedEvent{}),
-	sharedevents.EventAccountProfileUpdated: reflect.TypeOf(sharedevents.AccountProfileUpdatedEvent{}),
+	sharedevents.EventAccountCreated:            reflect.TypeOf(sharedevents.AccountCreatedEvent{}),
+	sharedevents.EventAccountProfileUpdated:     reflect.TypeOf(sharedevents.AccountProfileUpdatedEvent{}),
+	sharedevents.EventAccountUsernameChanged:    reflect.TypeOf(sharedevents.AccountUsernameChangedEvent{}),
+	sharedevents.EventAccountProfileViewed:      reflect.TypeOf(sharedevents.AccountProfileViewedEvent{}),
+	sharedevents.EventAccountContactCardRotated: reflect.TypeOf(sharedevents.AccountContactCardRotatedEvent{}),
+
+	sharedevents.EventRelationshipPairFriendRequestAccepted: reflect.TypeOf(sharedevents.RelationshipPairFriendRequestAcceptedEvent{}),
+	sharedevents.EventRelationshipPairUnfriended:            reflect.TypeOf(sharedevents.RelationshipPairUnfriendedEvent{}),
+	sharedevents.EventRelationshipPairBlocked:               reflect.TypeOf(sharedevents.RelationshipPairBlockedEvent{}),
+
+	sharedevents.EventRoomAggregateProjectionSynced:  reflect.TypeOf(sharedevents.RoomAggregateProjectionSyncedEvent{}),
+	sharedevents.EventRoomAggregateProjectionDeleted: reflect.TypeOf(sharedevents.RoomAggregateProjectionDeletedEvent{}),
 }
 
 func decodeEventPayload(ctx context.Context, eventName string, raw []byte) (interface{}, error) {
@@ -0,0 +1,126 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"wechat-clone/core/shared/contracts"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+func (h *messageHandler) handleRelationshipEvent(ctx context.Context, value []byte) error {
+	log := logging.FromContext(ctx).Named("RelationshipHandleSuggestionEvent")
+
+	var event contracts.OutboxMessage
+	if err := json.Unmarshal(value, &event); err != nil {
+		return stackErr.Error(fmt.Errorf("unmarshal relationship outbox event failed: %w", err))
+	}
+
+	switch event.EventName {
+	case sharedevents.EventRelationshipPairFriendRequestAccepted:
+		log.Infow("handle relationship suggestion event", zap.String("event_name", event.EventName))
+		payloadAny, err := decodeEventPayload(ctx, event.EventName, event.EventData)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+		}
+		payload, ok := payloadAny.(*sharedevents.RelationshipPairFriendRequestAcceptedEvent)
+		if !ok {
+			return stackErr.Error(fmt.Errorf("invalid payload type for event %s", event.EventName))
+		}
+		return stackErr.Error(h.suggestions.RefreshFriendship(ctx, payload.RequesterID, payload.AddresseeID))
+	case sharedevents.EventRelationshipPairUnfriended:
+		log.Infow("handle relationship suggestion event", zap.String("event_name", event.EventName))
+		payloadAny, err := decodeEventPayload(ctx, event.EventName, event.EventData)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+		}
+		payload, ok := payloadAny.(*sharedevents.RelationshipPairUnfriendedEvent)
+		if !ok {
+			return stackErr.Error(fmt.Errorf("invalid payload type for event %s", event.EventName))
+		}
+		return stackErr.Error(h.suggestions.RefreshFriendship(ctx, payload.UserID, payload.FriendID))
+	case sharedevents.EventRelationshipPairBlocked:
+		// A block also ends any friendship between the pair.
+		log.Infow("handle relationship suggestion event", zap.String("event_name", event.EventName))
+		payloadAny, err := decodeEventPayload(ctx, event.EventName, event.EventData)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+		}
+		payload, ok := payloadAny.(*sharedevents.RelationshipPairBlockedEvent)
+		if !ok {
+			return stackErr.Error(fmt.Errorf("invalid payload type for event %s", event.EventName))
+		}
+		return stackErr.Error(h.suggestions.RefreshFriendship(ctx, payload.BlockerID, payload.BlockedID))
+	default:
+		return nil
+	}
+}
+
+func (h *messageHandler) handleRoomEvent(ctx context.Context, value []byte) error {
+	log := logging.FromContext(ctx).Named("RelationshipHandleRoomEvent")
+
+	var event contracts.OutboxMessage
+	if err := json.Unmarshal(value, &event); err != nil {
+		return stackErr.Error(fmt.Errorf("unmarshal room outbox event failed: %w", err))
+	}
+
+	switch event.EventName {
+	case sharedevents.EventRoomAggregateProjectionSynced:
+		payloadAny, err := decodeEventPayload(ctx, event.EventName, event.EventData)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+		}
+		payload, ok := payloadAny.(*sharedevents.RoomAggregateProjectionSyncedEvent)
+		if !ok {
+			return stackErr.Error(fmt.Errorf("invalid payload type for event %s", event.EventName))
+		}
+		// Direct rooms already mean the pair knows each other; only shared
+		// group rooms feed suggestions.
+		if payload.Room == nil || strings.EqualFold(strings.TrimSpace(payload.Room.RoomType), "direct") {
+			return nil
+		}
+		log.Infow("handle room suggestion event", zap.String("event_name", event.EventName), zap.String("room_id", payload.Room.RoomID))
+		memberIDs := make([]string, 0, len(payload.Members))
+		for _, member := range payload.Members {
+			memberIDs = append(memberIDs, member.AccountID)
+		}
+		return stackErr.Error(h.suggestions.SyncGroupRoom(ctx, payload.Room.RoomID, memberIDs))
+	case sharedevents.EventRoomAggregateProjectionDeleted:
+		payloadAny, err := decodeEventPayload(ctx, event.EventName, event.EventData)
+		if err != nil {
+			return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+		}
+		payload, ok := payloadAny.(*sharedevents.RoomAggregateProjectionDeletedEvent)
+		if !ok {
+			return stackErr.Error(fmt.Errorf("invalid payload type for event %s", event.EventName))
+		}
+		log.Infow("handle room suggestion event", zap.String("event_name", event.EventName), zap.String("room_id", payload.RoomID))
+		return stackErr.Error(h.suggestions.SyncGroupRoom(ctx, payload.RoomID, nil))
+	default:
+		return nil
+	}
+}
+
+func (h *messageHandler) handleAccountProfileViewedEvent(ctx context.Context, raw json.RawMessage) error {
+	if h.suggestions == nil {
+		return nil
+	}
+
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventAccountProfileViewed, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.AccountProfileViewedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventAccountProfileViewed))
+	}
+
+	return stackErr.Error(h.suggestions.RecordProfileView(ctx, payload.ViewerID, payload.OwnerID, payload.ViewedAt))
+}
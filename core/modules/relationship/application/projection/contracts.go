@@ -5,6 +5,7 @@ import sharedevents "wechat-clone/core/shared/contracts/events"
 const (
 	EventRelationshipPairFriendRequestSent      = sharedevents.EventRelationshipPairFriendRequestSent
 	EventRelationshipPairFriendRequestCancelled = sharedevents.EventRelationshipPairFriendRequestCancelled
+	EventRelationshipPairFriendRequestExpired   = sharedevents.EventRelationshipPairFriendRequestExpired
 	EventRelationshipPairFriendRequestAccepted  = sharedevents.EventRelationshipPairFriendRequestAccepted
 	EventRelationshipPairFriendRequestRejected  = sharedevents.EventRelationshipPairFriendRequestRejected
 	EventRelationshipPairFollowed               = sharedevents.EventRelationshipPairFollowed
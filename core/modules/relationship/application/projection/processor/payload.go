@@ -13,6 +13,7 @@ import (
 var projectionEventPayloadTypes = map[string]reflect.Type{
 	relationshipprojection.EventRelationshipPairFriendRequestSent:      reflect.TypeOf(relationshipaggregate.EventRelationshipPairFriendRequestSent{}),
 	relationshipprojection.EventRelationshipPairFriendRequestCancelled: reflect.TypeOf(relationshipaggregate.EventRelationshipPairFriendRequestCancelled{}),
+	relationshipprojection.EventRelationshipPairFriendRequestExpired:   reflect.TypeOf(relationshipaggregate.EventRelationshipPairFriendRequestExpired{}),
 	relationshipprojection.EventRelationshipPairFriendRequestAccepted:  reflect.TypeOf(relationshipaggregate.EventRelationshipPairFriendRequestAccepted{}),
 	relationshipprojection.EventRelationshipPairFriendRequestRejected:  reflect.TypeOf(relationshipaggregate.EventRelationshipPairFriendRequestRejected{}),
 	relationshipprojection.EventRelationshipPairFollowed:               reflect.TypeOf(relationshipaggregate.EventRelationshipPairFollowed{}),
@@ -89,6 +89,8 @@ func (p *processor) handleRelationshipOutboxEvent(ctx context.Context, value []b
 		return p.projectFriendRequestSent(ctx, event.EventData)
 	case relationshipprojection.EventRelationshipPairFriendRequestCancelled:
 		return p.projectFriendRequestCancelled(ctx, event.EventData)
+	case relationshipprojection.EventRelationshipPairFriendRequestExpired:
+		return p.projectFriendRequestExpired(ctx, event.EventData)
 	case relationshipprojection.EventRelationshipPairFriendRequestRejected:
 		return p.projectFriendRequestRejected(ctx, event.EventData)
 	case relationshipprojection.EventRelationshipPairFriendRequestAccepted:
@@ -261,6 +263,26 @@ func (p *processor) projectFriendRequestCancelled(ctx context.Context, raw json.
 	return stackErr.Error(p.projRepo.SavePair(ctx, projection))
 }
 
+func (p *processor) projectFriendRequestExpired(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, relationshipprojection.EventRelationshipPairFriendRequestExpired, raw)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	payload, ok := payloadAny.(*relationshipaggregate.EventRelationshipPairFriendRequestExpired)
+	if !ok || payload == nil {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", relationshipprojection.EventRelationshipPairFriendRequestExpired))
+	}
+	projection, err := p.loadProjection(ctx, payload.RequesterID, payload.AddresseeID, payload.ExpiredAt)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if projection.PendingRequestID == payload.RequestID {
+		clearPendingRequest(projection)
+	}
+	projection.UpdatedAt = payload.ExpiredAt.UTC()
+	return stackErr.Error(p.projRepo.SavePair(ctx, projection))
+}
+
 func (p *processor) projectFriendRequestRejected(ctx context.Context, raw json.RawMessage) error {
 	payloadAny, err := decodeEventPayload(ctx, relationshipprojection.EventRelationshipPairFriendRequestRejected, raw)
 	if err != nil {
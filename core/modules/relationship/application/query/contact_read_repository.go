@@ -0,0 +1,18 @@
+package query
+
+import (
+	"context"
+
+	relationshipprojection "wechat-clone/core/modules/relationship/application/projection"
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+//go:generate mockgen -package=query -destination=contact_read_repository_mock.go -source=contact_read_repository.go
+type ContactReadRepository interface {
+	AreFriends(ctx context.Context, userA, userB string) (bool, error)
+	GetFriendContact(ctx context.Context, ownerID, friendID string) (*entity.FriendContact, error)
+	GetContactTag(ctx context.Context, ownerID, tagID string) (*entity.ContactTag, error)
+	ListContactTags(ctx context.Context, ownerID string) ([]*entity.ContactTag, error)
+	ListFriendsByTag(ctx context.Context, ownerID, tagID, cursor string, limit int) (*relationshipprojection.RelationshipListResult, error)
+	ListFriendsByAudienceList(ctx context.Context, ownerID string, list entity.AudienceList, cursor string, limit int) (*relationshipprojection.RelationshipListResult, error)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contact_read_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -package=query -destination=contact_read_repository_mock.go -source=contact_read_repository.go
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+	projection "wechat-clone/core/modules/relationship/application/projection"
+	entity "wechat-clone/core/modules/relationship/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContactReadRepository is a mock of ContactReadRepository interface.
+type MockContactReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockContactReadRepositoryMockRecorder is the mock recorder for MockContactReadRepository.
+type MockContactReadRepositoryMockRecorder struct {
+	mock *MockContactReadRepository
+}
+
+// NewMockContactReadRepository creates a new mock instance.
+func NewMockContactReadRepository(ctrl *gomock.Controller) *MockContactReadRepository {
+	mock := &MockContactReadRepository{ctrl: ctrl}
+	mock.recorder = &MockContactReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactReadRepository) EXPECT() *MockContactReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AreFriends mocks base method.
+func (m *MockContactReadRepository) AreFriends(ctx context.Context, userA, userB string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreFriends", ctx, userA, userB)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreFriends indicates an expected call of AreFriends.
+func (mr *MockContactReadRepositoryMockRecorder) AreFriends(ctx, userA, userB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreFriends", reflect.TypeOf((*MockContactReadRepository)(nil).AreFriends), ctx, userA, userB)
+}
+
+// GetContactTag mocks base method.
+func (m *MockContactReadRepository) GetContactTag(ctx context.Context, ownerID, tagID string) (*entity.ContactTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactTag", ctx, ownerID, tagID)
+	ret0, _ := ret[0].(*entity.ContactTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactTag indicates an expected call of GetContactTag.
+func (mr *MockContactReadRepositoryMockRecorder) GetContactTag(ctx, ownerID, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactTag", reflect.TypeOf((*MockContactReadRepository)(nil).GetContactTag), ctx, ownerID, tagID)
+}
+
+// GetFriendContact mocks base method.
+func (m *MockContactReadRepository) GetFriendContact(ctx context.Context, ownerID, friendID string) (*entity.FriendContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriendContact", ctx, ownerID, friendID)
+	ret0, _ := ret[0].(*entity.FriendContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriendContact indicates an expected call of GetFriendContact.
+func (mr *MockContactReadRepositoryMockRecorder) GetFriendContact(ctx, ownerID, friendID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendContact", reflect.TypeOf((*MockContactReadRepository)(nil).GetFriendContact), ctx, ownerID, friendID)
+}
+
+// ListContactTags mocks base method.
+func (m *MockContactReadRepository) ListContactTags(ctx context.Context, ownerID string) ([]*entity.ContactTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContactTags", ctx, ownerID)
+	ret0, _ := ret[0].([]*entity.ContactTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContactTags indicates an expected call of ListContactTags.
+func (mr *MockContactReadRepositoryMockRecorder) ListContactTags(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContactTags", reflect.TypeOf((*MockContactReadRepository)(nil).ListContactTags), ctx, ownerID)
+}
+
+// ListFriendsByAudienceList mocks base method.
+func (m *MockContactReadRepository) ListFriendsByAudienceList(ctx context.Context, ownerID string, list entity.AudienceList, cursor string, limit int) (*projection.RelationshipListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFriendsByAudienceList", ctx, ownerID, list, cursor, limit)
+	ret0, _ := ret[0].(*projection.RelationshipListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFriendsByAudienceList indicates an expected call of ListFriendsByAudienceList.
+func (mr *MockContactReadRepositoryMockRecorder) ListFriendsByAudienceList(ctx, ownerID, list, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFriendsByAudienceList", reflect.TypeOf((*MockContactReadRepository)(nil).ListFriendsByAudienceList), ctx, ownerID, list, cursor, limit)
+}
+
+// ListFriendsByTag mocks base method.
+func (m *MockContactReadRepository) ListFriendsByTag(ctx context.Context, ownerID, tagID, cursor string, limit int) (*projection.RelationshipListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFriendsByTag", ctx, ownerID, tagID, cursor, limit)
+	ret0, _ := ret[0].(*projection.RelationshipListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFriendsByTag indicates an expected call of ListFriendsByTag.
+func (mr *MockContactReadRepositoryMockRecorder) ListFriendsByTag(ctx, ownerID, tagID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFriendsByTag", reflect.TypeOf((*MockContactReadRepository)(nil).ListFriendsByTag), ctx, ownerID, tagID, cursor, limit)
+}
@@ -0,0 +1,17 @@
+package query
+
+import (
+	"net/http"
+
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrNotFriends            = apperr.New("relationship.not_friends", "target user is not your friend", http.StatusForbidden)
+	ErrContactTagNotFound    = apperr.New("relationship.contact_tag_not_found", "contact tag not found", http.StatusNotFound)
+	ErrAudienceListInvalid   = apperr.New("relationship.audience_list_invalid", "audience list must be close_friends or restricted", http.StatusBadRequest)
+	ErrTagFilterNotOwned     = apperr.New("relationship.tag_filter_not_owned", "tags can only filter your own friend list", http.StatusBadRequest)
+	ErrGraphFormatInvalid    = apperr.New("relationship.graph_format_invalid", "format must be csv or jsonl", http.StatusBadRequest)
+	ErrGraphImportNotFound   = apperr.New("relationship.graph_import_not_found", "graph import job not found", http.StatusNotFound)
+	ErrGraphRowStatusInvalid = apperr.New("relationship.graph_import_row_status_invalid", "status must be pending, imported, skipped or failed", http.StatusBadRequest)
+)
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type exportRelationshipGraphHandler struct {
+	graphRepo GraphReadRepository
+}
+
+func NewExportRelationshipGraph(
+	appCtx *appCtx.AppContext,
+	graphRepo GraphReadRepository,
+) cqrs.Handler[*in.ExportRelationshipGraphRequest, *out.ExportRelationshipGraphResponse] {
+	return &exportRelationshipGraphHandler{graphRepo: graphRepo}
+}
+
+// Handle exports the caller's own edges in the import format, so an admin can
+// feed an export straight back into a graph import.
+func (u *exportRelationshipGraphHandler) Handle(ctx context.Context, req *in.ExportRelationshipGraphRequest) (*out.ExportRelationshipGraphResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	format, err := entity.ParseGraphFormat(req.Format)
+	if err != nil {
+		return nil, stackErr.Error(ErrGraphFormatInvalid)
+	}
+	edges, err := u.graphRepo.ListAccountEdges(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	content, err := entity.EncodeGraphEdges(format, edges)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.ExportRelationshipGraphResponse{
+		Format:      format.String(),
+		Content:     content,
+		RowCount:    len(edges),
+		GeneratedAt: time.Now().UTC().Unix(),
+	}, nil
+}
@@ -0,0 +1,16 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+//go:generate mockgen -package=query -destination=friend_request_read_repository_mock.go -source=friend_request_read_repository.go
+type FriendRequestReadRepository interface {
+	GetFriendRequestPrivacy(ctx context.Context, accountID string) (*entity.FriendRequestPrivacy, error)
+	// ListPendingRequests maps each requester to their pending request to
+	// addresseeID; requesters without one are left out. Only the source and
+	// sharer are loaded.
+	ListPendingRequests(ctx context.Context, addresseeID string, requesterIDs []string) (map[string]*entity.FriendRequest, error)
+}
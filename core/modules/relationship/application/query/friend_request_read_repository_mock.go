@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: friend_request_read_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -package=query -destination=friend_request_read_repository_mock.go -source=friend_request_read_repository.go
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/relationship/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFriendRequestReadRepository is a mock of FriendRequestReadRepository interface.
+type MockFriendRequestReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFriendRequestReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFriendRequestReadRepositoryMockRecorder is the mock recorder for MockFriendRequestReadRepository.
+type MockFriendRequestReadRepositoryMockRecorder struct {
+	mock *MockFriendRequestReadRepository
+}
+
+// NewMockFriendRequestReadRepository creates a new mock instance.
+func NewMockFriendRequestReadRepository(ctrl *gomock.Controller) *MockFriendRequestReadRepository {
+	mock := &MockFriendRequestReadRepository{ctrl: ctrl}
+	mock.recorder = &MockFriendRequestReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFriendRequestReadRepository) EXPECT() *MockFriendRequestReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetFriendRequestPrivacy mocks base method.
+func (m *MockFriendRequestReadRepository) GetFriendRequestPrivacy(ctx context.Context, accountID string) (*entity.FriendRequestPrivacy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriendRequestPrivacy", ctx, accountID)
+	ret0, _ := ret[0].(*entity.FriendRequestPrivacy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriendRequestPrivacy indicates an expected call of GetFriendRequestPrivacy.
+func (mr *MockFriendRequestReadRepositoryMockRecorder) GetFriendRequestPrivacy(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendRequestPrivacy", reflect.TypeOf((*MockFriendRequestReadRepository)(nil).GetFriendRequestPrivacy), ctx, accountID)
+}
+
+// ListPendingRequests mocks base method.
+func (m *MockFriendRequestReadRepository) ListPendingRequests(ctx context.Context, addresseeID string, requesterIDs []string) (map[string]*entity.FriendRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingRequests", ctx, addresseeID, requesterIDs)
+	ret0, _ := ret[0].(map[string]*entity.FriendRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingRequests indicates an expected call of ListPendingRequests.
+func (mr *MockFriendRequestReadRepositoryMockRecorder) ListPendingRequests(ctx, addresseeID, requesterIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingRequests", reflect.TypeOf((*MockFriendRequestReadRepository)(nil).ListPendingRequests), ctx, addresseeID, requesterIDs)
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getFriendRemarkHandler struct {
+	contactRepo ContactReadRepository
+}
+
+func NewGetFriendRemark(
+	appCtx *appCtx.AppContext,
+	contactRepo ContactReadRepository,
+) cqrs.Handler[*in.GetFriendRemarkRequest, *out.FriendRemarkResponse] {
+	return &getFriendRemarkHandler{contactRepo: contactRepo}
+}
+
+func (u *getFriendRemarkHandler) Handle(ctx context.Context, req *in.GetFriendRemarkRequest) (*out.FriendRemarkResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	isFriend, err := u.contactRepo.AreFriends(ctx, accountID, req.TargetUserID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !isFriend {
+		return nil, stackErr.Error(ErrNotFriends)
+	}
+
+	contact, err := u.contactRepo.GetFriendContact(ctx, accountID, req.TargetUserID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return support.ToFriendRemarkResponse(req.TargetUserID, contact), nil
+}
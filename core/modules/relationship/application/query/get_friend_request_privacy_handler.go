@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getFriendRequestPrivacyHandler struct {
+	requestRepo FriendRequestReadRepository
+}
+
+func NewGetFriendRequestPrivacy(
+	appCtx *appCtx.AppContext,
+	requestRepo FriendRequestReadRepository,
+) cqrs.Handler[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse] {
+	return &getFriendRequestPrivacyHandler{requestRepo: requestRepo}
+}
+
+func (u *getFriendRequestPrivacyHandler) Handle(ctx context.Context, req *in.GetFriendRequestPrivacyRequest) (*out.FriendRequestPrivacyResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	privacy, err := u.requestRepo.GetFriendRequestPrivacy(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return support.ToFriendRequestPrivacyResponse(privacy), nil
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	appCtx "wechat-clone/core/context"
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	appsupport "wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getGraphImportHandler struct {
+	graphRepo GraphReadRepository
+	admins    accountsupport.AdminRoles
+}
+
+func NewGetGraphImport(
+	appCtx *appCtx.AppContext,
+	graphRepo GraphReadRepository,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.GetGraphImportRequest, *out.GraphImportJobResponse] {
+	return &getGraphImportHandler{graphRepo: graphRepo, admins: admins}
+}
+
+func (u *getGraphImportHandler) Handle(ctx context.Context, req *in.GetGraphImportRequest) (*out.GraphImportJobResponse, error) {
+	if _, err := support.AdminFromAccounts(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	job, err := u.graphRepo.GetImportJob(ctx, req.JobID)
+	if err != nil {
+		if errors.Is(err, domain.ErrGraphImportJobNotFound) {
+			return nil, stackErr.Error(ErrGraphImportNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return appsupport.ToGraphImportJobResponse(job), nil
+}
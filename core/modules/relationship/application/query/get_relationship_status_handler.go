@@ -11,14 +11,16 @@ import (
 )
 
 type getRelationshipStatusHandler struct {
-	projRepo relationshipprojection.ReadRepository
+	projRepo    relationshipprojection.ReadRepository
+	contactRepo ContactReadRepository
 }
 
 func NewGetRelationshipStatus(
 	appCtx *appCtx.AppContext,
 	projRepo relationshipprojection.ReadRepository,
+	contactRepo ContactReadRepository,
 ) cqrs.Handler[*in.GetRelationshipStatusRequest, *out.GetRelationshipStatusResponse] {
-	return &getRelationshipStatusHandler{projRepo: projRepo}
+	return &getRelationshipStatusHandler{projRepo: projRepo, contactRepo: contactRepo}
 }
 
 func (u *getRelationshipStatusHandler) Handle(ctx context.Context, req *in.GetRelationshipStatusRequest) (*out.GetRelationshipStatusResponse, error) {
@@ -30,5 +32,18 @@ func (u *getRelationshipStatusHandler) Handle(ctx context.Context, req *in.GetRe
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	return buildRelationshipStatusResponse(accountID, req.TargetUserID, pair), nil
+	response := buildRelationshipStatusResponse(accountID, req.TargetUserID, pair)
+
+	// The audience list is the caller's own classification of the target, so
+	// it is only ever shown to the owner.
+	if response.IsFriend {
+		contact, err := u.contactRepo.GetFriendContact(ctx, accountID, req.TargetUserID)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		if contact != nil {
+			response.AudienceList = contact.AudienceList.String()
+		}
+	}
+	return response, nil
 }
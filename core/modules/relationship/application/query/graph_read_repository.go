@@ -0,0 +1,18 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+//go:generate mockgen -package=query -destination=graph_read_repository_mock.go -source=graph_read_repository.go
+type GraphReadRepository interface {
+	// ListAccountEdges returns the account's friends, follows in both
+	// directions and the accounts it blocked, as import rows.
+	ListAccountEdges(ctx context.Context, accountID string) ([]entity.GraphEdge, error)
+	GetImportJob(ctx context.Context, jobID string) (*entity.GraphImportJob, error)
+	// ListImportRows pages a job's rows after row number afterRow; an empty
+	// status returns every row.
+	ListImportRows(ctx context.Context, jobID string, status entity.GraphImportRowStatus, afterRow, limit int) ([]entity.GraphImportRow, error)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: graph_read_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -package=query -destination=graph_read_repository_mock.go -source=graph_read_repository.go
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/relationship/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGraphReadRepository is a mock of GraphReadRepository interface.
+type MockGraphReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGraphReadRepositoryMockRecorder is the mock recorder for MockGraphReadRepository.
+type MockGraphReadRepositoryMockRecorder struct {
+	mock *MockGraphReadRepository
+}
+
+// NewMockGraphReadRepository creates a new mock instance.
+func NewMockGraphReadRepository(ctrl *gomock.Controller) *MockGraphReadRepository {
+	mock := &MockGraphReadRepository{ctrl: ctrl}
+	mock.recorder = &MockGraphReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphReadRepository) EXPECT() *MockGraphReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetImportJob mocks base method.
+func (m *MockGraphReadRepository) GetImportJob(ctx context.Context, jobID string) (*entity.GraphImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportJob", ctx, jobID)
+	ret0, _ := ret[0].(*entity.GraphImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportJob indicates an expected call of GetImportJob.
+func (mr *MockGraphReadRepositoryMockRecorder) GetImportJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportJob", reflect.TypeOf((*MockGraphReadRepository)(nil).GetImportJob), ctx, jobID)
+}
+
+// ListAccountEdges mocks base method.
+func (m *MockGraphReadRepository) ListAccountEdges(ctx context.Context, accountID string) ([]entity.GraphEdge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountEdges", ctx, accountID)
+	ret0, _ := ret[0].([]entity.GraphEdge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountEdges indicates an expected call of ListAccountEdges.
+func (mr *MockGraphReadRepositoryMockRecorder) ListAccountEdges(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountEdges", reflect.TypeOf((*MockGraphReadRepository)(nil).ListAccountEdges), ctx, accountID)
+}
+
+// ListImportRows mocks base method.
+func (m *MockGraphReadRepository) ListImportRows(ctx context.Context, jobID string, status entity.GraphImportRowStatus, afterRow, limit int) ([]entity.GraphImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportRows", ctx, jobID, status, afterRow, limit)
+	ret0, _ := ret[0].([]entity.GraphImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportRows indicates an expected call of ListImportRows.
+func (mr *MockGraphReadRepositoryMockRecorder) ListImportRows(ctx, jobID, status, afterRow, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportRows", reflect.TypeOf((*MockGraphReadRepository)(nil).ListImportRows), ctx, jobID, status, afterRow, limit)
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listAudienceListMembersHandler struct {
+	contactRepo ContactReadRepository
+	accountRepo AccountReadRepository
+}
+
+func NewListAudienceListMembers(
+	appCtx *appCtx.AppContext,
+	contactRepo ContactReadRepository,
+	accountRepo AccountReadRepository,
+) cqrs.Handler[*in.ListAudienceListMembersRequest, *out.ListAudienceListMembersResponse] {
+	return &listAudienceListMembersHandler{contactRepo: contactRepo, accountRepo: accountRepo}
+}
+
+func (u *listAudienceListMembersHandler) Handle(ctx context.Context, req *in.ListAudienceListMembersRequest) (*out.ListAudienceListMembersResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	list, err := entity.ParseAudienceList(req.AudienceList)
+	if err != nil || list == entity.AudienceListNone {
+		return nil, stackErr.Error(ErrAudienceListInvalid)
+	}
+
+	result, err := u.contactRepo.ListFriendsByAudienceList(ctx, accountID, list, req.Cursor, normalizeLimit(req.Limit))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if result == nil {
+		result = emptyListResult()
+	}
+	items, err := mapRelationshipAccountSummaries(ctx, u.accountRepo, result.Items)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &out.ListAudienceListMembersResponse{Items: items, NextCursor: result.NextCursor}, nil
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/application/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listContactTagsHandler struct {
+	contactRepo ContactReadRepository
+}
+
+func NewListContactTags(
+	appCtx *appCtx.AppContext,
+	contactRepo ContactReadRepository,
+) cqrs.Handler[*in.ListContactTagsRequest, *out.ListContactTagsResponse] {
+	return &listContactTagsHandler{contactRepo: contactRepo}
+}
+
+func (u *listContactTagsHandler) Handle(ctx context.Context, _ *in.ListContactTagsRequest) (*out.ListContactTagsResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	tags, err := u.contactRepo.ListContactTags(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]out.ContactTagResponse, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, support.ToContactTagResponse(tag))
+	}
+	return &out.ListContactTagsResponse{Items: items}, nil
+}
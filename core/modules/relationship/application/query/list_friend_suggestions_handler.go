@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listFriendSuggestionsHandler struct {
+	suggestionRepo SuggestionReadRepository
+	accountRepo    AccountReadRepository
+}
+
+func NewListFriendSuggestions(
+	appCtx *appCtx.AppContext,
+	suggestionRepo SuggestionReadRepository,
+	accountRepo AccountReadRepository,
+) cqrs.Handler[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse] {
+	return &listFriendSuggestionsHandler{suggestionRepo: suggestionRepo, accountRepo: accountRepo}
+}
+
+func (u *listFriendSuggestionsHandler) Handle(ctx context.Context, req *in.ListFriendSuggestionsRequest) (*out.ListFriendSuggestionsResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	// Suggestions are ranked by score, so the cursor is a plain offset into
+	// that ranking rather than a key.
+	offset, _ := strconv.Atoi(req.Cursor)
+	offset = max(offset, 0)
+	limit := normalizeLimit(req.Limit)
+
+	suggestions, err := u.suggestionRepo.ListSuggestions(ctx, accountID, offset, limit+1)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	nextCursor := ""
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+		nextCursor = strconv.Itoa(offset + limit)
+	}
+
+	now := time.Now().UTC()
+	items := make([]out.FriendSuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		summary, err := loadRelationshipAccountSummary(ctx, u.accountRepo, suggestion.CandidateID)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		items = append(items, out.FriendSuggestionResponse{
+			AccountID:         summary.AccountID,
+			DisplayName:       summary.DisplayName,
+			Username:          summary.Username,
+			AvatarObjectKey:   summary.AvatarObjectKey,
+			MutualFriendCount: int64(suggestion.MutualFriendCount),
+			SharedRoomCount:   int64(suggestion.SharedRoomCount),
+			RecentlyViewed:    suggestion.RecentlyViewed(now),
+		})
+	}
+
+	return &out.ListFriendSuggestionsResponse{Items: items, NextCursor: nextCursor}, nil
+}
@@ -2,10 +2,13 @@ package query
 
 import (
 	"context"
+	"errors"
+
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/relationship/application/dto/in"
 	"wechat-clone/core/modules/relationship/application/dto/out"
 	relationshipprojection "wechat-clone/core/modules/relationship/application/projection"
+	"wechat-clone/core/modules/relationship/domain"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
@@ -13,14 +16,16 @@ import (
 type listFriendsHandler struct {
 	projRepo    relationshipprojection.ReadRepository
 	accountRepo AccountReadRepository
+	contactRepo ContactReadRepository
 }
 
 func NewListFriends(
 	appCtx *appCtx.AppContext,
 	projRepo relationshipprojection.ReadRepository,
 	accountRepo AccountReadRepository,
+	contactRepo ContactReadRepository,
 ) cqrs.Handler[*in.ListFriendsRequest, *out.ListFriendsResponse] {
-	return &listFriendsHandler{projRepo: projRepo, accountRepo: accountRepo}
+	return &listFriendsHandler{projRepo: projRepo, accountRepo: accountRepo, contactRepo: contactRepo}
 }
 
 func (u *listFriendsHandler) Handle(ctx context.Context, req *in.ListFriendsRequest) (*out.ListFriendsResponse, error) {
@@ -28,7 +33,7 @@ func (u *listFriendsHandler) Handle(ctx context.Context, req *in.ListFriendsRequ
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	result, err := u.projRepo.ListFriends(ctx, normalizeListTarget(accountID, req.UserID), req.Cursor, normalizeLimit(req.Limit))
+	result, err := u.listFriendIDs(ctx, accountID, req)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -41,3 +46,22 @@ func (u *listFriendsHandler) Handle(ctx context.Context, req *in.ListFriendsRequ
 	}
 	return &out.ListFriendsResponse{Items: items, NextCursor: result.NextCursor, Total: result.Total}, nil
 }
+
+// listFriendIDs reads the Cassandra friend list unless a tag filter is given.
+// Tags are private, so filtering only works on the caller's own list.
+func (u *listFriendsHandler) listFriendIDs(ctx context.Context, accountID string, req *in.ListFriendsRequest) (*relationshipprojection.RelationshipListResult, error) {
+	targetID := normalizeListTarget(accountID, req.UserID)
+	if req.TagID == "" {
+		return u.projRepo.ListFriends(ctx, targetID, req.Cursor, normalizeLimit(req.Limit))
+	}
+	if targetID != accountID {
+		return nil, stackErr.Error(ErrTagFilterNotOwned)
+	}
+	if _, err := u.contactRepo.GetContactTag(ctx, accountID, req.TagID); err != nil {
+		if errors.Is(err, domain.ErrContactTagNotFound) {
+			return nil, stackErr.Error(ErrContactTagNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return u.contactRepo.ListFriendsByTag(ctx, accountID, req.TagID, req.Cursor, normalizeLimit(req.Limit))
+}
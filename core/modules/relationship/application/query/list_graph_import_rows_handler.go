@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	appCtx "wechat-clone/core/context"
+	accountsupport "wechat-clone/core/modules/account/application/support"
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/support"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type listGraphImportRowsHandler struct {
+	graphRepo GraphReadRepository
+	admins    accountsupport.AdminRoles
+}
+
+func NewListGraphImportRows(
+	appCtx *appCtx.AppContext,
+	graphRepo GraphReadRepository,
+	admins accountsupport.AdminRoles,
+) cqrs.Handler[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse] {
+	return &listGraphImportRowsHandler{graphRepo: graphRepo, admins: admins}
+}
+
+// Handle pages the per-row report; the cursor is the last row number seen, so
+// filtering by status (e.g. only failed rows) pages correctly too.
+func (u *listGraphImportRowsHandler) Handle(ctx context.Context, req *in.ListGraphImportRowsRequest) (*out.ListGraphImportRowsResponse, error) {
+	if _, err := support.AdminFromAccounts(ctx, u.admins); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var status entity.GraphImportRowStatus
+	if req.Status != "" {
+		parsed, err := entity.ParseGraphImportRowStatus(req.Status)
+		if err != nil {
+			return nil, stackErr.Error(ErrGraphRowStatusInvalid)
+		}
+		status = parsed
+	}
+	if _, err := u.graphRepo.GetImportJob(ctx, req.JobID); err != nil {
+		if errors.Is(err, domain.ErrGraphImportJobNotFound) {
+			return nil, stackErr.Error(ErrGraphImportNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	afterRow, _ := strconv.Atoi(req.Cursor)
+	limit := normalizeLimit(req.Limit)
+	rows, err := u.graphRepo.ListImportRows(ctx, req.JobID, status, afterRow, limit)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]out.GraphImportRowResponse, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, out.GraphImportRowResponse{
+			RowNumber: row.RowNumber,
+			UserID:    row.Edge.UserID,
+			TargetID:  row.Edge.TargetID,
+			Relation:  row.Edge.Relation.String(),
+			Status:    row.Status.String(),
+			Error:     row.Error,
+		})
+	}
+	nextCursor := ""
+	if len(rows) == limit {
+		nextCursor = strconv.Itoa(rows[len(rows)-1].RowNumber)
+	}
+	return &out.ListGraphImportRowsResponse{Items: items, NextCursor: nextCursor}, nil
+}
@@ -13,14 +13,16 @@ import (
 type listIncomingFriendRequestsHandler struct {
 	projRepo    relationshipprojection.ReadRepository
 	accountRepo AccountReadRepository
+	requestRepo FriendRequestReadRepository
 }
 
 func NewListIncomingFriendRequests(
 	appCtx *appCtx.AppContext,
 	projRepo relationshipprojection.ReadRepository,
 	accountRepo AccountReadRepository,
+	requestRepo FriendRequestReadRepository,
 ) cqrs.Handler[*in.ListIncomingFriendRequestsRequest, *out.ListIncomingFriendRequestsResponse] {
-	return &listIncomingFriendRequestsHandler{projRepo: projRepo, accountRepo: accountRepo}
+	return &listIncomingFriendRequestsHandler{projRepo: projRepo, accountRepo: accountRepo, requestRepo: requestRepo}
 }
 
 func (u *listIncomingFriendRequestsHandler) Handle(ctx context.Context, req *in.ListIncomingFriendRequestsRequest) (*out.ListIncomingFriendRequestsResponse, error) {
@@ -35,9 +37,28 @@ func (u *listIncomingFriendRequestsHandler) Handle(ctx context.Context, req *in.
 	if result == nil {
 		result = emptyListResult()
 	}
-	items, err := mapRelationshipAccountSummaries(ctx, u.accountRepo, result.Items)
+	summaries, err := mapRelationshipAccountSummaries(ctx, u.accountRepo, result.Items)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	requests, err := u.requestRepo.ListPendingRequests(ctx, accountID, result.Items)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]out.IncomingFriendRequestResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		item := out.IncomingFriendRequestResponse{
+			AccountID:       summary.AccountID,
+			DisplayName:     summary.DisplayName,
+			Username:        summary.Username,
+			AvatarObjectKey: summary.AvatarObjectKey,
+		}
+		if request := requests[summary.AccountID]; request != nil {
+			item.Source = request.Source.String()
+			item.SharedByID = request.SharedByID
+		}
+		items = append(items, item)
+	}
 	return &out.ListIncomingFriendRequestsResponse{Items: items, NextCursor: result.NextCursor}, nil
 }
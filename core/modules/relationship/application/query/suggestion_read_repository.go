@@ -0,0 +1,14 @@
+package query
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+//go:generate mockgen -package=query -destination=suggestion_read_repository_mock.go -source=suggestion_read_repository.go
+type SuggestionReadRepository interface {
+	// ListSuggestions returns ranked candidates for userID, already excluding
+	// friends, blocks in either direction, pending requests and dismissals.
+	ListSuggestions(ctx context.Context, userID string, offset, limit int) ([]*entity.FriendSuggestion, error)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: suggestion_read_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -package=query -destination=suggestion_read_repository_mock.go -source=suggestion_read_repository.go
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/relationship/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSuggestionReadRepository is a mock of SuggestionReadRepository interface.
+type MockSuggestionReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestionReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSuggestionReadRepositoryMockRecorder is the mock recorder for MockSuggestionReadRepository.
+type MockSuggestionReadRepositoryMockRecorder struct {
+	mock *MockSuggestionReadRepository
+}
+
+// NewMockSuggestionReadRepository creates a new mock instance.
+func NewMockSuggestionReadRepository(ctrl *gomock.Controller) *MockSuggestionReadRepository {
+	mock := &MockSuggestionReadRepository{ctrl: ctrl}
+	mock.recorder = &MockSuggestionReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestionReadRepository) EXPECT() *MockSuggestionReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListSuggestions mocks base method.
+func (m *MockSuggestionReadRepository) ListSuggestions(ctx context.Context, userID string, offset, limit int) ([]*entity.FriendSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSuggestions", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]*entity.FriendSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSuggestions indicates an expected call of ListSuggestions.
+func (mr *MockSuggestionReadRepositoryMockRecorder) ListSuggestions(ctx, userID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuggestions", reflect.TypeOf((*MockSuggestionReadRepository)(nil).ListSuggestions), ctx, userID, offset, limit)
+}
@@ -0,0 +1,72 @@
+package cronjob
+
+import (
+	"time"
+
+	relationshiptask "wechat-clone/core/modules/relationship/application/scheduler/task"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+)
+
+type CronJob interface {
+	Start() error
+	Stop() error
+}
+
+type cronJob struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewCronJob(scheduler *asynq.Scheduler, expiryInterval, graphImportInterval, viewExpiryInterval time.Duration) (CronJob, error) {
+	if scheduler == nil {
+		return &cronJob{}, nil
+	}
+
+	if err := registerPeriodic(scheduler, relationshiptask.ExpireFriendRequestsTask, expiryInterval); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := registerPeriodic(scheduler, relationshiptask.ProcessGraphImportsTask, graphImportInterval); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := registerPeriodic(scheduler, relationshiptask.ExpireProfileViewsTask, viewExpiryInterval); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &cronJob{scheduler: scheduler}, nil
+}
+
+func registerPeriodic(scheduler *asynq.Scheduler, taskType string, interval time.Duration) error {
+	task := asynq.NewTask(taskType, nil)
+	if _, err := scheduler.Register(
+		relationshiptask.PeriodicSpec(interval),
+		task,
+		asynq.Queue(relationshiptask.QueueName),
+		asynq.MaxRetry(0),
+		asynq.Unique(interval),
+	); err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (j *cronJob) Start() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	if err := j.scheduler.Start(); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (j *cronJob) Stop() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	j.scheduler.Shutdown()
+	return nil
+}
@@ -0,0 +1,21 @@
+package task
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ExpireFriendRequestsTask = "relationship:friend-request:expire-stale"
+	ProcessGraphImportsTask  = "relationship:graph-import:process"
+	ExpireProfileViewsTask   = "relationship:friend-suggestion:expire-views"
+	QueueName                = "relationship:scheduler"
+)
+
+func PeriodicSpec(interval time.Duration) string {
+	seconds := int(interval / time.Second)
+	if seconds <= 0 {
+		seconds = 300
+	}
+	return fmt.Sprintf("@every %ds", seconds)
+}
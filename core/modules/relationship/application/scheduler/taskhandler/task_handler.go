@@ -0,0 +1,119 @@
+package taskhandler
+
+import (
+	"context"
+
+	relationshiptask "wechat-clone/core/modules/relationship/application/scheduler/task"
+	relationshipservice "wechat-clone/core/modules/relationship/application/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+)
+
+type TaskHandler interface {
+	Start() error
+	Stop() error
+}
+
+type taskHandler struct {
+	service           relationshipservice.FriendRequestExpiryService
+	importService     relationshipservice.GraphImportService
+	suggestionService relationshipservice.FriendSuggestionService
+	server            *asynq.Server
+}
+
+func NewTaskHandler(
+	service relationshipservice.FriendRequestExpiryService,
+	importService relationshipservice.GraphImportService,
+	suggestionService relationshipservice.FriendSuggestionService,
+	server *asynq.Server,
+) TaskHandler {
+	if service == nil || server == nil {
+		return &taskHandler{}
+	}
+	return &taskHandler{
+		service:           service,
+		importService:     importService,
+		suggestionService: suggestionService,
+		server:            server,
+	}
+}
+
+func (h *taskHandler) Start() error {
+	if h == nil || h.service == nil || h.server == nil {
+		return nil
+	}
+
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(relationshiptask.ExpireFriendRequestsTask, h.handleExpireFriendRequests)
+	mux.HandleFunc(relationshiptask.ProcessGraphImportsTask, h.handleProcessGraphImports)
+	mux.HandleFunc(relationshiptask.ExpireProfileViewsTask, h.handleExpireProfileViews)
+
+	if err := h.server.Start(mux); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (h *taskHandler) Stop() error {
+	if h == nil || h.server == nil {
+		return nil
+	}
+
+	h.server.Shutdown()
+	return nil
+}
+
+func (h *taskHandler) handleExpireFriendRequests(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.service == nil {
+		return nil
+	}
+
+	expired, err := h.service.ExpireStaleRequests(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("expire stale friend requests failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if expired > 0 {
+		logging.FromContext(ctx).Infow("expired stale friend requests", zap.Int("count", expired))
+	}
+
+	return nil
+}
+
+func (h *taskHandler) handleProcessGraphImports(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.importService == nil {
+		return nil
+	}
+
+	processed, err := h.importService.ProcessImports(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("process relationship graph imports failed", zap.Int("processed", processed), zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if processed > 0 {
+		logging.FromContext(ctx).Infow("processed relationship graph import rows", zap.Int("count", processed))
+	}
+
+	return nil
+}
+
+func (h *taskHandler) handleExpireProfileViews(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.suggestionService == nil {
+		return nil
+	}
+
+	expired, err := h.suggestionService.ExpireProfileViews(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("expire friend suggestion profile views failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if expired > 0 {
+		logging.FromContext(ctx).Infow("expired friend suggestion profile views", zap.Int("count", expired))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/domain"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultFriendRequestTTL             = 14 * 24 * time.Hour
+	defaultFriendRequestExpiryBatchSize = 200
+)
+
+type FriendRequestExpiryService interface {
+	ExpireStaleRequests(ctx context.Context) (int, error)
+}
+
+type friendRequestExpiryService struct {
+	baseRepo        repos.Repos
+	ttl             time.Duration
+	batchSize       int
+	notifyRequester bool
+}
+
+func NewFriendRequestExpiryService(appContext *appCtx.AppContext, baseRepo repos.Repos) FriendRequestExpiryService {
+	service := &friendRequestExpiryService{
+		baseRepo:  baseRepo,
+		ttl:       defaultFriendRequestTTL,
+		batchSize: defaultFriendRequestExpiryBatchSize,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		cfg := appContext.GetConfig().RelationshipConfig.FriendRequest
+		if cfg.TTLSeconds > 0 {
+			service.ttl = time.Duration(cfg.TTLSeconds) * time.Second
+		}
+		if cfg.ExpirySweepBatchSize > 0 {
+			service.batchSize = cfg.ExpirySweepBatchSize
+		}
+		service.notifyRequester = cfg.NotifyRequesterOnExpiry
+	}
+	return service
+}
+
+// ExpireStaleRequests moves one batch of pending requests older than the TTL
+// into the expired state and returns how many were expired.
+func (s *friendRequestExpiryService) ExpireStaleRequests(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	items, err := s.baseRepo.FriendRequestAggregateRepository().ListStalePending(ctx, now.Add(-s.ttl), s.batchSize)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	expired := 0
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if err := s.expireRequest(ctx, item.RequesterID, item.AddresseeID, now); err != nil {
+			if errors.Is(err, domain.ErrFriendRequestNotFound) || errors.Is(err, domain.ErrFriendRequestNotStale) {
+				continue
+			}
+			logging.FromContext(ctx).Warnw(
+				"expire friend request failed",
+				"friend_request_id", item.ID,
+				zap.Error(err),
+			)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+func (s *friendRequestExpiryService) expireRequest(ctx context.Context, requesterID, addresseeID string, now time.Time) error {
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		pairAgg, err := txRepos.RelationshipPairAggregateRepository().LoadForUpdate(ctx, requesterID, addresseeID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := pairAgg.ExpireFriendRequest(s.ttl, s.notifyRequester, now); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RelationshipPairAggregateRepository().Save(ctx, pairAgg))
+	}))
+}
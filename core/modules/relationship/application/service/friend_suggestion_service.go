@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/samber/lo"
+)
+
+// FriendSuggestionService keeps "people you may know" scores current as
+// relationship, room and profile view events arrive, so reads never have to
+// walk the social graph.
+type FriendSuggestionService interface {
+	// RefreshFriendship is called when a friendship between the two users
+	// starts or ends; it rescores every pair that gains or loses them as a
+	// mutual friend.
+	RefreshFriendship(ctx context.Context, userA, userB string) error
+	// SyncGroupRoom replaces a group room's membership and rescores the pairs
+	// that joined or left it. A nil member list removes the room.
+	SyncGroupRoom(ctx context.Context, roomID string, memberIDs []string) error
+	RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error
+	// ExpireProfileViews takes the profile view bonus out of one batch of
+	// scores whose last view fell out of the view window and returns how many
+	// were updated.
+	ExpireProfileViews(ctx context.Context) (int, error)
+}
+
+const defaultProfileViewExpiryBatchSize = 500
+
+type friendSuggestionService struct {
+	baseRepo            repos.Repos
+	viewExpiryBatchSize int
+}
+
+func NewFriendSuggestionService(appContext *appCtx.AppContext, baseRepo repos.Repos) FriendSuggestionService {
+	service := &friendSuggestionService{
+		baseRepo:            baseRepo,
+		viewExpiryBatchSize: defaultProfileViewExpiryBatchSize,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if size := appContext.GetConfig().RelationshipConfig.Suggestion.ViewExpiryBatchSize; size > 0 {
+			service.viewExpiryBatchSize = size
+		}
+	}
+	return service
+}
+
+func (s *friendSuggestionService) RefreshFriendship(ctx context.Context, userA, userB string) error {
+	suggestionRepo := s.baseRepo.FriendSuggestionRepository()
+	friendsOfA, err := suggestionRepo.ListFriendIDs(ctx, userA)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	friendsOfB, err := suggestionRepo.ListFriendIDs(ctx, userB)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	pairs := appendSymmetricPairs(nil, userA, userB)
+	for _, friendID := range friendsOfA {
+		pairs = appendSymmetricPairs(pairs, userB, friendID)
+	}
+	for _, friendID := range friendsOfB {
+		pairs = appendSymmetricPairs(pairs, userA, friendID)
+	}
+	return stackErr.Error(suggestionRepo.RefreshPairs(ctx, pairs, time.Now().UTC()))
+}
+
+func (s *friendSuggestionService) SyncGroupRoom(ctx context.Context, roomID string, memberIDs []string) error {
+	roomID = strings.TrimSpace(roomID)
+	if roomID == "" {
+		return nil
+	}
+
+	suggestionRepo := s.baseRepo.FriendSuggestionRepository()
+	previous, err := suggestionRepo.ListGroupRoomMembers(ctx, roomID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	current := toIDSet(memberIDs)
+	before := toIDSet(previous)
+	joined := lo.OmitByKeys(current, lo.Keys(before))
+	left := lo.OmitByKeys(before, lo.Keys(current))
+	if len(joined) == 0 && len(left) == 0 {
+		return nil
+	}
+	if err := suggestionRepo.ReplaceGroupRoomMembers(ctx, roomID, lo.Keys(current)); err != nil {
+		return stackErr.Error(err)
+	}
+
+	// Every group room is mirrored so friend request privacy can see shared
+	// groups, but only rooms up to the size cap count towards suggestions. A
+	// room crossing the cap changes every pair in its smaller membership.
+	countedBefore := len(before) <= entity.MaxSuggestionGroupRoomSize
+	countedNow := len(current) <= entity.MaxSuggestionGroupRoomSize
+	var pairs []entity.SuggestionPair
+	switch {
+	case countedBefore && countedNow:
+		pairs = appendRoomPairs(pairs, joined, current)
+		pairs = appendRoomPairs(pairs, left, before)
+	case countedNow:
+		pairs = appendRoomPairs(pairs, current, current)
+	case countedBefore:
+		pairs = appendRoomPairs(pairs, before, before)
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	return stackErr.Error(suggestionRepo.RefreshPairs(ctx, pairs, time.Now().UTC()))
+}
+
+func (s *friendSuggestionService) RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error {
+	viewerID = strings.TrimSpace(viewerID)
+	ownerID = strings.TrimSpace(ownerID)
+	if viewerID == "" || ownerID == "" || viewerID == ownerID {
+		return nil
+	}
+
+	suggestionRepo := s.baseRepo.FriendSuggestionRepository()
+	if err := suggestionRepo.RecordProfileView(ctx, viewerID, ownerID, viewedAt); err != nil {
+		return stackErr.Error(err)
+	}
+	pairs := []entity.SuggestionPair{{UserID: viewerID, CandidateID: ownerID}}
+	return stackErr.Error(suggestionRepo.RefreshPairs(ctx, pairs, time.Now().UTC()))
+}
+
+func (s *friendSuggestionService) ExpireProfileViews(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	expired, err := s.baseRepo.FriendSuggestionRepository().ExpireProfileViews(
+		ctx,
+		now.Add(-entity.SuggestionProfileViewWindow),
+		now,
+		s.viewExpiryBatchSize,
+	)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return expired, nil
+}
+
+func appendSymmetricPairs(pairs []entity.SuggestionPair, userA, userB string) []entity.SuggestionPair {
+	if userA == userB {
+		return pairs
+	}
+	return append(pairs,
+		entity.SuggestionPair{UserID: userA, CandidateID: userB},
+		entity.SuggestionPair{UserID: userB, CandidateID: userA},
+	)
+}
+
+func appendRoomPairs(pairs []entity.SuggestionPair, changed, members map[string]struct{}) []entity.SuggestionPair {
+	for memberID := range changed {
+		for otherID := range members {
+			pairs = appendSymmetricPairs(pairs, memberID, otherID)
+		}
+	}
+	return pairs
+}
+
+func toIDSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			set[id] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	repos "wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultGraphImportBatchSize = 500
+	graphImportRunnableJobLimit = 10
+)
+
+type GraphImportService interface {
+	// ProcessImports works through up to one batch of rows across the
+	// unfinished import jobs and returns how many rows it processed.
+	ProcessImports(ctx context.Context) (int, error)
+}
+
+// GraphImportAccountChecker confirms the user side of a row exists; the pair
+// aggregate only checks the target, since its actor is normally the caller.
+type GraphImportAccountChecker interface {
+	Exists(ctx context.Context, accountID string) (bool, error)
+}
+
+type graphImportService struct {
+	baseRepo  repos.Repos
+	accounts  GraphImportAccountChecker
+	batchSize int
+}
+
+func NewGraphImportService(appContext *appCtx.AppContext, baseRepo repos.Repos, accounts GraphImportAccountChecker) GraphImportService {
+	service := &graphImportService{
+		baseRepo:  baseRepo,
+		accounts:  accounts,
+		batchSize: defaultGraphImportBatchSize,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if size := appContext.GetConfig().RelationshipConfig.GraphImport.ProcessBatchSize; size > 0 {
+			service.batchSize = size
+		}
+	}
+	return service
+}
+
+func (s *graphImportService) ProcessImports(ctx context.Context) (int, error) {
+	jobs, err := s.baseRepo.GraphImportJobRepository().ListRunnable(ctx, graphImportRunnableJobLimit)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	processed := 0
+	for _, job := range jobs {
+		if processed >= s.batchSize {
+			break
+		}
+		count, err := s.processJob(ctx, job, s.batchSize-processed)
+		processed += count
+		if err != nil {
+			return processed, stackErr.Error(err)
+		}
+	}
+	return processed, nil
+}
+
+func (s *graphImportService) processJob(ctx context.Context, job *entity.GraphImportJob, limit int) (int, error) {
+	rows, err := s.baseRepo.GraphImportJobRepository().ListRows(ctx, job.ID, job.NextRow, limit)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	processed := 0
+	for _, row := range rows {
+		if row.RowNumber != job.NextRow {
+			return processed, stackErr.Error(errors.New("graph import rows are out of order"))
+		}
+		if err := s.processRow(ctx, job, row); err != nil {
+			return processed, stackErr.Error(err)
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// processRow applies one row and records its result in the same transaction,
+// so the job cursor never runs ahead of or behind the graph. A row the domain
+// rejects is recorded as failed; any other error stops the run and the row is
+// retried on the next tick.
+func (s *graphImportService) processRow(ctx context.Context, job *entity.GraphImportJob, row entity.GraphImportRow) error {
+	now := time.Now().UTC()
+	if row.Status == entity.GraphImportRowStatusFailed {
+		return stackErr.Error(s.recordRow(ctx, job, row, now))
+	}
+
+	next := *job
+	err := s.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		exists, err := s.accounts.Exists(ctx, row.Edge.UserID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if !exists {
+			return stackErr.Error(domain.ErrGraphImportUserNotFound)
+		}
+		status, err := applyGraphEdge(ctx, txRepos, row.Edge, now)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		row.Status = status
+		if err := next.RecordRow(status, now); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.GraphImportJobRepository().SaveRowResult(ctx, &next, row))
+	})
+	if err == nil {
+		*job = next
+		return nil
+	}
+	if !isGraphRowRejected(err) {
+		return stackErr.Error(err)
+	}
+
+	logging.FromContext(ctx).Debugw("graph import row rejected", "job_id", job.ID, "row", row.RowNumber, zap.Error(err))
+	row.Status = entity.GraphImportRowStatusFailed
+	row.Error = rejectedGraphRowMessage(err)
+	return stackErr.Error(s.recordRow(ctx, job, row, now))
+}
+
+func (s *graphImportService) recordRow(ctx context.Context, job *entity.GraphImportJob, row entity.GraphImportRow, now time.Time) error {
+	next := *job
+	if err := next.RecordRow(row.Status, now); err != nil {
+		return stackErr.Error(err)
+	}
+	if err := s.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		return stackErr.Error(txRepos.GraphImportJobRepository().SaveRowResult(ctx, &next, row))
+	}); err != nil {
+		return stackErr.Error(err)
+	}
+	*job = next
+	return nil
+}
+
+// applyGraphEdge replays one edge through the pair aggregate, exactly as the
+// users would have created it. A friend row opens an import request from
+// user to target and accepts it as target; a request already pending in
+// either direction is accepted instead. Edges that already exist are skipped,
+// which also makes a re-run row harmless.
+func applyGraphEdge(ctx context.Context, txRepos repos.Repos, edge entity.GraphEdge, now time.Time) (entity.GraphImportRowStatus, error) {
+	pairRepo := txRepos.RelationshipPairAggregateRepository()
+	pairAgg, err := pairRepo.LoadForUpdate(ctx, edge.UserID, edge.TargetID)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+
+	switch edge.Relation {
+	case entity.GraphRelationFollow:
+		err = pairAgg.Follow(uuid.NewString(), now)
+	case entity.GraphRelationBlock:
+		err = pairAgg.Block(uuid.NewString(), nil, now)
+	case entity.GraphRelationFriend:
+		err = pairAgg.SendFriendRequest(uuid.NewString(), entity.FriendRequestSourceImport, "", now)
+		if errors.Is(err, domain.ErrFriendRequestAlreadyOpen) {
+			err = nil
+		}
+	default:
+		return "", stackErr.Error(domain.ErrInvalidData)
+	}
+	switch {
+	case errors.Is(err, domain.ErrFollowAlreadyExists),
+		errors.Is(err, domain.ErrBlockAlreadyExists),
+		errors.Is(err, domain.ErrFriendshipAlreadyExists):
+		return entity.GraphImportRowStatusSkipped, nil
+	case err != nil:
+		return "", stackErr.Error(err)
+	}
+	if err := pairRepo.Save(ctx, pairAgg); err != nil {
+		return "", stackErr.Error(err)
+	}
+	if edge.Relation != entity.GraphRelationFriend {
+		return entity.GraphImportRowStatusImported, nil
+	}
+
+	request := pairAgg.FriendRequest()
+	if request == nil || request.FriendRequest == nil {
+		return "", stackErr.Error(domain.ErrFriendRequestNotFound)
+	}
+	acceptAgg, err := pairRepo.LoadForUpdate(ctx, request.AddresseeID, request.RequesterID)
+	if err != nil {
+		return "", stackErr.Error(err)
+	}
+	if err := acceptAgg.AcceptFriendRequest(uuid.NewString(), now); err != nil {
+		return "", stackErr.Error(err)
+	}
+	if err := pairRepo.Save(ctx, acceptAgg); err != nil {
+		return "", stackErr.Error(err)
+	}
+	return entity.GraphImportRowStatusImported, nil
+}
+
+var graphRowRejections = []error{
+	domain.ErrGraphImportUserNotFound,
+	domain.ErrTargetAccountNotFound,
+	domain.ErrRelationshipBlocked,
+	domain.ErrFriendRequestNotFound,
+	domain.ErrInvalidData,
+	domain.ErrEmpty,
+}
+
+func isGraphRowRejected(err error) bool {
+	for _, rejection := range graphRowRejections {
+		if errors.Is(err, rejection) {
+			return true
+		}
+	}
+	return false
+}
+
+func rejectedGraphRowMessage(err error) string {
+	for _, rejection := range graphRowRejections {
+		if errors.Is(err, rejection) {
+			return rejection.Error()
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,66 @@
+package support
+
+import (
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+func ToFriendRemarkResponse(friendID string, contact *entity.FriendContact) *out.FriendRemarkResponse {
+	if contact == nil {
+		return &out.FriendRemarkResponse{FriendID: friendID}
+	}
+
+	response := &out.FriendRemarkResponse{
+		FriendID:    contact.FriendID,
+		RemarkName:  contact.RemarkName,
+		Description: contact.Description,
+		PhoneNotes:  contact.PhoneNotes,
+		TagIDs:      contact.TagIDs,
+	}
+	if !contact.UpdatedAt.IsZero() {
+		response.UpdatedAt = contact.UpdatedAt.Unix()
+	}
+	return response
+}
+
+func ToFriendAudienceListResponse(friendID string, contact *entity.FriendContact) *out.FriendAudienceListResponse {
+	if contact == nil {
+		return &out.FriendAudienceListResponse{FriendID: friendID}
+	}
+
+	response := &out.FriendAudienceListResponse{
+		FriendID:     contact.FriendID,
+		AudienceList: contact.AudienceList.String(),
+	}
+	if !contact.UpdatedAt.IsZero() {
+		response.UpdatedAt = contact.UpdatedAt.Unix()
+	}
+	return response
+}
+
+func ToContactTagResponse(tag *entity.ContactTag) out.ContactTagResponse {
+	if tag == nil {
+		return out.ContactTagResponse{}
+	}
+	return out.ContactTagResponse{
+		TagID:       tag.ID,
+		Name:        tag.Name,
+		MemberCount: tag.MemberCount,
+		CreatedAt:   tag.CreatedAt.Unix(),
+		UpdatedAt:   tag.UpdatedAt.Unix(),
+	}
+}
+
+func ToFriendRequestPrivacyResponse(privacy *entity.FriendRequestPrivacy) *out.FriendRequestPrivacyResponse {
+	response := &out.FriendRequestPrivacyResponse{
+		AllowRequestsFrom:     privacy.AllowRequestsFrom.String(),
+		FindableByUsername:    privacy.FindableByUsername,
+		FindableByEmail:       privacy.FindableByEmail,
+		FindableByQr:          privacy.FindableByQR,
+		AllowFromSharedGroups: privacy.AllowFromSharedGroups,
+	}
+	if !privacy.UpdatedAt.IsZero() {
+		response.UpdatedAt = privacy.UpdatedAt.Unix()
+	}
+	return response
+}
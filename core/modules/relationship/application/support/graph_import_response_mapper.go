@@ -0,0 +1,28 @@
+package support
+
+import (
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+func ToGraphImportJobResponse(job *entity.GraphImportJob) *out.GraphImportJobResponse {
+	if job == nil {
+		return nil
+	}
+
+	response := &out.GraphImportJobResponse{
+		JobID:        job.ID,
+		Status:       job.Status.String(),
+		Format:       job.Format.String(),
+		TotalRows:    job.TotalRows,
+		NextRow:      job.NextRow,
+		ImportedRows: job.ImportedRows,
+		SkippedRows:  job.SkippedRows,
+		FailedRows:   job.FailedRows,
+		CreatedAt:    job.CreatedAt.Unix(),
+	}
+	if job.CompletedAt != nil {
+		response.CompletedAt = job.CompletedAt.Unix()
+	}
+	return response
+}
@@ -3,6 +3,7 @@ package assembly
 import (
 	appCtx "wechat-clone/core/context"
 	relationshipmessaging "wechat-clone/core/modules/relationship/application/messaging"
+	relationshipservice "wechat-clone/core/modules/relationship/application/service"
 	relationshiprepo "wechat-clone/core/modules/relationship/infra/persistent/repository"
 	"wechat-clone/core/shared/config"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -10,7 +11,8 @@ import (
 
 func buildMessagingHandler(cfg *config.Config, appCtx *appCtx.AppContext) (relationshipmessaging.MessageHandler, error) {
 	accountRepo := relationshiprepo.NewRelationshipAccountRepo(appCtx.GetDB())
-	handler, err := relationshipmessaging.NewMessageHandler(cfg, accountRepo)
+	suggestionService := relationshipservice.NewFriendSuggestionService(appCtx, relationshiprepo.NewRepoImpl(appCtx))
+	handler, err := relationshipmessaging.NewMessageHandler(cfg, accountRepo, suggestionService)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	appCtx "wechat-clone/core/context"
+	accountassembly "wechat-clone/core/modules/account/assembly"
 	relationshipcommand "wechat-clone/core/modules/relationship/application/command"
 	relationshipquery "wechat-clone/core/modules/relationship/application/query"
 	relationshiprepo "wechat-clone/core/modules/relationship/infra/persistent/repository"
 	relationshipReadRepos "wechat-clone/core/modules/relationship/infra/projection/cassandra"
 	relationshipserver "wechat-clone/core/modules/relationship/transport/server"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 	"wechat-clone/core/shared/transport/http"
@@ -21,14 +23,23 @@ func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (http.HTT
 		return nil, stackErr.Error(err)
 	}
 	relationshipAccountRepo := relationshiprepo.NewRelationshipAccountRepo(appContext.GetDB())
-	sendFriendRequest := cqrs.NewDispatcher(relationshipcommand.NewSendFriendRequest(appContext, relationshipRepos))
+	relationshipContactRepo := relationshiprepo.NewRelationshipContactReadRepo(appContext.GetDB())
+	relationshipSuggestionRepo := relationshiprepo.NewRelationshipSuggestionReadRepo(appContext.GetDB())
+	relationshipFriendRequestRepo := relationshiprepo.NewRelationshipFriendRequestReadRepo(appContext.GetDB())
+	relationshipGraphRepo := relationshiprepo.NewRelationshipGraphReadRepo(appContext.GetDB())
+	adminRoles := accountassembly.BuildAdminRoles(appContext)
+	contactCardSigner, err := contactcard.NewHMACSigner(appContext.GetConfig().AuthConfig.ContactCard.Secret)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	sendFriendRequest := cqrs.NewDispatcher(relationshipcommand.NewSendFriendRequest(appContext, relationshipRepos, relationshipAccountRepo, contactCardSigner))
 	cancelFriendRequest := cqrs.NewDispatcher(relationshipcommand.NewCancelFriendRequest(appContext, relationshipRepos))
 	acceptFriendRequest := cqrs.NewDispatcher(relationshipcommand.NewAcceptFriendRequest(appContext, relationshipRepos))
 	rejectFriendRequest := cqrs.NewDispatcher(relationshipcommand.NewRejectFriendRequest(appContext, relationshipRepos))
-	listIncomingFriendRequests := cqrs.NewDispatcher(relationshipquery.NewListIncomingFriendRequests(appContext, relationshipReadRepos, relationshipAccountRepo))
+	listIncomingFriendRequests := cqrs.NewDispatcher(relationshipquery.NewListIncomingFriendRequests(appContext, relationshipReadRepos, relationshipAccountRepo, relationshipFriendRequestRepo))
 	listOutgoingFriendRequests := cqrs.NewDispatcher(relationshipquery.NewListOutgoingFriendRequests(appContext, relationshipReadRepos, relationshipAccountRepo))
 	unfriendUser := cqrs.NewDispatcher(relationshipcommand.NewUnfriendUser(appContext, relationshipRepos))
-	listFriends := cqrs.NewDispatcher(relationshipquery.NewListFriends(appContext, relationshipReadRepos, relationshipAccountRepo))
+	listFriends := cqrs.NewDispatcher(relationshipquery.NewListFriends(appContext, relationshipReadRepos, relationshipAccountRepo, relationshipContactRepo))
 	followUser := cqrs.NewDispatcher(relationshipcommand.NewFollowUser(appContext, relationshipRepos))
 	unfollowUser := cqrs.NewDispatcher(relationshipcommand.NewUnfollowUser(appContext, relationshipRepos))
 	listFollowers := cqrs.NewDispatcher(relationshipquery.NewListFollowers(appContext, relationshipReadRepos, relationshipAccountRepo))
@@ -36,9 +47,26 @@ func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (http.HTT
 	blockUser := cqrs.NewDispatcher(relationshipcommand.NewBlockUser(appContext, relationshipRepos))
 	unblockUser := cqrs.NewDispatcher(relationshipcommand.NewUnblockUser(appContext, relationshipRepos))
 	listBlockedUsers := cqrs.NewDispatcher(relationshipquery.NewListBlockedUsers(appContext, relationshipReadRepos, relationshipAccountRepo))
-	getRelationshipStatus := cqrs.NewDispatcher(relationshipquery.NewGetRelationshipStatus(appContext, relationshipReadRepos))
+	getRelationshipStatus := cqrs.NewDispatcher(relationshipquery.NewGetRelationshipStatus(appContext, relationshipReadRepos, relationshipContactRepo))
 	getMutualFriends := cqrs.NewDispatcher(relationshipquery.NewGetMutualFriends(appContext, relationshipReadRepos, relationshipAccountRepo))
 	getRelationshipSummary := cqrs.NewDispatcher(relationshipquery.NewGetRelationshipSummary(appContext, relationshipReadRepos))
+	getFriendRemark := cqrs.NewDispatcher(relationshipquery.NewGetFriendRemark(appContext, relationshipContactRepo))
+	updateFriendRemark := cqrs.NewDispatcher(relationshipcommand.NewUpdateFriendRemark(appContext, relationshipRepos))
+	setFriendTags := cqrs.NewDispatcher(relationshipcommand.NewSetFriendTags(appContext, relationshipRepos))
+	createContactTag := cqrs.NewDispatcher(relationshipcommand.NewCreateContactTag(appContext, relationshipRepos))
+	listContactTags := cqrs.NewDispatcher(relationshipquery.NewListContactTags(appContext, relationshipContactRepo))
+	updateContactTag := cqrs.NewDispatcher(relationshipcommand.NewUpdateContactTag(appContext, relationshipRepos))
+	deleteContactTag := cqrs.NewDispatcher(relationshipcommand.NewDeleteContactTag(appContext, relationshipRepos))
+	listFriendSuggestions := cqrs.NewDispatcher(relationshipquery.NewListFriendSuggestions(appContext, relationshipSuggestionRepo, relationshipAccountRepo))
+	dismissFriendSuggestion := cqrs.NewDispatcher(relationshipcommand.NewDismissFriendSuggestion(appContext, relationshipRepos))
+	getFriendRequestPrivacy := cqrs.NewDispatcher(relationshipquery.NewGetFriendRequestPrivacy(appContext, relationshipFriendRequestRepo))
+	updateFriendRequestPrivacy := cqrs.NewDispatcher(relationshipcommand.NewUpdateFriendRequestPrivacy(appContext, relationshipRepos))
+	setFriendAudienceList := cqrs.NewDispatcher(relationshipcommand.NewSetFriendAudienceList(appContext, relationshipRepos))
+	listAudienceListMembers := cqrs.NewDispatcher(relationshipquery.NewListAudienceListMembers(appContext, relationshipContactRepo, relationshipAccountRepo))
+	createGraphImport := cqrs.NewDispatcher(relationshipcommand.NewCreateGraphImport(appContext, relationshipRepos, adminRoles))
+	getGraphImport := cqrs.NewDispatcher(relationshipquery.NewGetGraphImport(appContext, relationshipGraphRepo, adminRoles))
+	listGraphImportRows := cqrs.NewDispatcher(relationshipquery.NewListGraphImportRows(appContext, relationshipGraphRepo, adminRoles))
+	exportRelationshipGraph := cqrs.NewDispatcher(relationshipquery.NewExportRelationshipGraph(appContext, relationshipGraphRepo))
 
 	server, err := relationshipserver.NewHTTPServer(
 		sendFriendRequest,
@@ -59,6 +87,23 @@ func buildHTTPServer(_ context.Context, appContext *appCtx.AppContext) (http.HTT
 		getRelationshipStatus,
 		getMutualFriends,
 		getRelationshipSummary,
+		getFriendRemark,
+		updateFriendRemark,
+		setFriendTags,
+		createContactTag,
+		listContactTags,
+		updateContactTag,
+		deleteContactTag,
+		listFriendSuggestions,
+		dismissFriendSuggestion,
+		getFriendRequestPrivacy,
+		updateFriendRequestPrivacy,
+		setFriendAudienceList,
+		listAudienceListMembers,
+		createGraphImport,
+		getGraphImport,
+		listGraphImportRows,
+		exportRelationshipGraph,
 	)
 	if err != nil {
 		return nil, stackErr.Error(err)
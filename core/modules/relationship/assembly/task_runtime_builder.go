@@ -0,0 +1,46 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	relationshiptask "wechat-clone/core/modules/relationship/application/scheduler/task"
+	"wechat-clone/core/modules/relationship/application/scheduler/taskhandler"
+	relationshipservice "wechat-clone/core/modules/relationship/application/service"
+	relationshiprepo "wechat-clone/core/modules/relationship/infra/persistent/repository"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/stackErr"
+	modruntime "wechat-clone/core/shared/runtime"
+
+	"github.com/hibiken/asynq"
+)
+
+func buildTaskRuntime(_ *config.Config, appContext *appCtx.AppContext) (modruntime.Module, error) {
+	relationshipRepos := relationshiprepo.NewRepoImpl(appContext)
+	expiryService := relationshipservice.NewFriendRequestExpiryService(appContext, relationshipRepos)
+	importService := relationshipservice.NewGraphImportService(
+		appContext,
+		relationshipRepos,
+		relationshiprepo.NewRelationshipAccountRepo(appContext.GetDB()),
+	)
+	suggestionService := relationshipservice.NewFriendSuggestionService(appContext, relationshipRepos)
+
+	server, err := newAsynqServer(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return taskhandler.NewTaskHandler(expiryService, importService, suggestionService, server), nil
+}
+
+func newAsynqServer(appContext *appCtx.AppContext) (*asynq.Server, error) {
+	redisConnOpt, err := newAsynqRedisConnOpt(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return asynq.NewServer(redisConnOpt, asynq.Config{
+		Concurrency: 1,
+		Queues: map[string]int{
+			relationshiptask.QueueName: 1,
+		},
+	}), nil
+}
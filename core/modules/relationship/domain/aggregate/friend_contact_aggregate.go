@@ -0,0 +1,160 @@
+package aggregate
+
+import (
+	"slices"
+	"time"
+
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type FriendContactSnapshot struct {
+	OwnerID          string
+	FriendID         string
+	IsFriend         bool
+	Contact          *entity.FriendContact
+	AggregateVersion int
+}
+
+// FriendContactAggregate owns the remark, tag assignments and audience list
+// one user keeps about a friend. It is directional: A's remark for B is unrelated to B's
+// remark for A.
+type FriendContactAggregate struct {
+	event.AggregateRoot
+
+	contact  *entity.FriendContact
+	isFriend bool
+}
+
+func NewFriendContact(snapshot FriendContactSnapshot) (*FriendContactAggregate, error) {
+	if snapshot.OwnerID == "" || snapshot.FriendID == "" {
+		return nil, stackErr.Error(domain.ErrEmpty)
+	}
+
+	agg := &FriendContactAggregate{
+		contact:  snapshot.Contact,
+		isFriend: snapshot.IsFriend,
+	}
+	if agg.contact == nil {
+		contact, err := entity.NewFriendContact(snapshot.OwnerID, snapshot.FriendID, time.Time{})
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		agg.contact = contact
+	}
+
+	aggregateID := FriendContactAggregateID(snapshot.OwnerID, snapshot.FriendID)
+	if err := event.InitAggregate(&agg.AggregateRoot, agg, aggregateID); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	agg.Root().SetInternal(aggregateID, snapshot.AggregateVersion, snapshot.AggregateVersion)
+	return agg, nil
+}
+
+func (a *FriendContactAggregate) RegisterEvents(register event.RegisterEventsFunc) error {
+	return register(
+		&EventFriendContactRemarkUpdated{},
+		&EventFriendContactTagsUpdated{},
+		&EventFriendContactAudienceListUpdated{},
+	)
+}
+
+func (a *FriendContactAggregate) Transition(e event.Event) error {
+	switch data := e.EventData.(type) {
+	case *EventFriendContactRemarkUpdated:
+		a.touch(data.UpdatedAt)
+		a.contact.RemarkName = data.RemarkName
+		return nil
+	case *EventFriendContactTagsUpdated:
+		a.touch(data.UpdatedAt)
+		a.contact.TagIDs = data.TagIDs
+		return nil
+	case *EventFriendContactAudienceListUpdated:
+		a.touch(data.UpdatedAt)
+		a.contact.AudienceList = entity.AudienceList(data.AudienceList)
+		return nil
+	default:
+		return event.ErrUnsupportedEventType
+	}
+}
+
+func (a *FriendContactAggregate) UpdateRemark(remarkName, description string, phoneNotes []string, now time.Time) error {
+	if !a.isFriend {
+		return stackErr.Error(domain.ErrFriendshipNotFound)
+	}
+	remarkName, description, phoneNotes, err := entity.NormalizeFriendRemark(remarkName, description, phoneNotes)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if remarkName == a.contact.RemarkName &&
+		description == a.contact.Description &&
+		slices.Equal(phoneNotes, a.contact.PhoneNotes) {
+		return nil
+	}
+
+	a.contact.Description = description
+	a.contact.PhoneNotes = phoneNotes
+	return stackErr.Error(a.ApplyChange(a, &EventFriendContactRemarkUpdated{
+		OwnerID:    a.contact.OwnerID,
+		FriendID:   a.contact.FriendID,
+		RemarkName: remarkName,
+		UpdatedAt:  now,
+	}))
+}
+
+// SetTags replaces the tag assignment. Callers must already have checked that
+// every tag belongs to the owner.
+func (a *FriendContactAggregate) SetTags(tagIDs []string, now time.Time) error {
+	if !a.isFriend {
+		return stackErr.Error(domain.ErrFriendshipNotFound)
+	}
+	tagIDs, err := entity.NormalizeFriendTagIDs(tagIDs)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if slices.Equal(tagIDs, a.contact.TagIDs) {
+		return nil
+	}
+
+	return stackErr.Error(a.ApplyChange(a, &EventFriendContactTagsUpdated{
+		OwnerID:   a.contact.OwnerID,
+		FriendID:  a.contact.FriendID,
+		TagIDs:    tagIDs,
+		UpdatedAt: now,
+	}))
+}
+
+// SetAudienceList moves the friend into list, replacing any list they were
+// in before; AudienceListNone takes them out.
+func (a *FriendContactAggregate) SetAudienceList(list entity.AudienceList, now time.Time) error {
+	if !a.isFriend {
+		return stackErr.Error(domain.ErrFriendshipNotFound)
+	}
+	if list == a.contact.AudienceList {
+		return nil
+	}
+
+	return stackErr.Error(a.ApplyChange(a, &EventFriendContactAudienceListUpdated{
+		OwnerID:      a.contact.OwnerID,
+		FriendID:     a.contact.FriendID,
+		AudienceList: list.String(),
+		UpdatedAt:    now,
+	}))
+}
+
+func (a *FriendContactAggregate) Contact() *entity.FriendContact {
+	return a.contact
+}
+
+func (a *FriendContactAggregate) touch(now time.Time) {
+	if a.contact.CreatedAt.IsZero() {
+		a.contact.CreatedAt = now
+	}
+	a.contact.UpdatedAt = now
+}
+
+func FriendContactAggregateID(ownerID, friendID string) string {
+	return "contact:" + ownerID + ":" + friendID
+}
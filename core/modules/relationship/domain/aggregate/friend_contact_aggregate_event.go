@@ -0,0 +1,27 @@
+package aggregate
+
+import "time"
+
+// EventFriendContactRemarkUpdated is published to other modules, so it only
+// carries the remark name. The description and phone notes are private to
+// the owner and are stored on the contact without leaving this module.
+type EventFriendContactRemarkUpdated struct {
+	OwnerID    string
+	FriendID   string
+	RemarkName string
+	UpdatedAt  time.Time
+}
+
+type EventFriendContactTagsUpdated struct {
+	OwnerID   string
+	FriendID  string
+	TagIDs    []string
+	UpdatedAt time.Time
+}
+
+type EventFriendContactAudienceListUpdated struct {
+	OwnerID      string
+	FriendID     string
+	AudienceList string
+	UpdatedAt    time.Time
+}
@@ -33,6 +33,7 @@ func (agg *FriendRequestAggregate) RegisterEvents(register event.RegisterEventsF
 		&EventFriendRequestAccept{},
 		&EventFriendRequestReject{},
 		&EventFriendRequestCancel{},
+		&EventFriendRequestExpire{},
 	)
 }
 
@@ -49,6 +50,11 @@ func (a *FriendRequestAggregate) Transition(e event.Event) error {
 		a.FriendRequest.AddresseeID = data.AddresseeID
 		a.FriendRequest.Status = entity.FriendRequestStatusPending
 		a.FriendRequest.Message = utils.NullableString(data.Message)
+		a.FriendRequest.Source = entity.FriendRequestSource(data.Source)
+		if a.FriendRequest.Source == "" {
+			a.FriendRequest.Source = entity.FriendRequestSourceDirect
+		}
+		a.FriendRequest.SharedByID = data.SharedByID
 		a.FriendRequest.CreatedAt = data.CreatedAt
 
 		return nil
@@ -67,6 +73,11 @@ func (a *FriendRequestAggregate) Transition(e event.Event) error {
 			return stackErr.Error(err)
 		}
 		return nil
+	case *EventFriendRequestExpire:
+		if err := a.Expire(data.ExpiredAt); err != nil {
+			return stackErr.Error(err)
+		}
+		return nil
 	default:
 		return event.ErrUnsupportedEventType
 	}
@@ -76,6 +87,8 @@ func (a *FriendRequestAggregate) Create(
 	requesterID string,
 	addresseeID string,
 	message *string,
+	source entity.FriendRequestSource,
+	sharedByID string,
 	now time.Time,
 ) error {
 	msg := ""
@@ -87,6 +100,8 @@ func (a *FriendRequestAggregate) Create(
 		RequesterID: requesterID,
 		AddresseeID: addresseeID,
 		Message:     msg,
+		Source:      source.String(),
+		SharedByID:  sharedByID,
 		CreatedAt:   now,
 	}))
 }
@@ -119,6 +134,15 @@ func (a *FriendRequestAggregate) CancelRequest(now time.Time) error {
 	}))
 }
 
+func (a *FriendRequestAggregate) ExpireRequest(now time.Time) error {
+	if a.FriendRequest == nil {
+		return stackErr.Error(fmt.Errorf("friend request entity is required"))
+	}
+	return stackErr.Error(a.ApplyChange(a, &EventFriendRequestExpire{
+		ExpiredAt: now,
+	}))
+}
+
 func (a *FriendRequestAggregate) SetFriendRequest(friendRequest *entity.FriendRequest) error {
 	if friendRequest == nil {
 		return stackErr.Error(domain.ErrEmpty)
@@ -8,6 +8,8 @@ type EventFriendRequestCreated struct {
 	RequesterID string
 	AddresseeID string
 	Message     string
+	Source      string
+	SharedByID  string
 	CreatedAt   time.Time
 }
 
@@ -23,3 +25,7 @@ type EventFriendRequestReject struct {
 type EventFriendRequestCancel struct {
 	CancelAt time.Time
 }
+
+type EventFriendRequestExpire struct {
+	ExpiredAt time.Time
+}
@@ -39,6 +39,7 @@ func (a *RelationshipPairAggregate) RegisterEvents(register event.RegisterEvents
 	return register(
 		&EventRelationshipPairFriendRequestSent{},
 		&EventRelationshipPairFriendRequestCancelled{},
+		&EventRelationshipPairFriendRequestExpired{},
 		&EventRelationshipPairFriendRequestAccepted{},
 		&EventRelationshipPairFriendRequestRejected{},
 		&EventRelationshipPairFollowed{},
@@ -55,6 +56,8 @@ func (a *RelationshipPairAggregate) Transition(e event.Event) error {
 		return stackErr.Error(a.applyFriendRequestSent(data))
 	case *EventRelationshipPairFriendRequestCancelled:
 		return stackErr.Error(a.applyFriendRequestCancelled(data))
+	case *EventRelationshipPairFriendRequestExpired:
+		return stackErr.Error(a.applyFriendRequestExpired(data))
 	case *EventRelationshipPairFriendRequestAccepted:
 		return stackErr.Error(a.applyFriendRequestAccepted(data))
 	case *EventRelationshipPairFriendRequestRejected:
@@ -74,8 +77,10 @@ func (a *RelationshipPairAggregate) Transition(e event.Event) error {
 	}
 }
 
-func (a *RelationshipPairAggregate) SendFriendRequest(requestID string, now time.Time) error {
-	if err := a.state.toPolicyState().EnsureCanSendFriendRequest(); err != nil {
+// SendFriendRequest opens a pending request from the actor to the target.
+// sharedByID credits the account whose contact card led to the request.
+func (a *RelationshipPairAggregate) SendFriendRequest(requestID string, source entity.FriendRequestSource, sharedByID string, now time.Time) error {
+	if err := a.state.toPolicyState().EnsureCanSendFriendRequest(source); err != nil {
 		return stackErr.Error(err)
 	}
 
@@ -83,7 +88,7 @@ func (a *RelationshipPairAggregate) SendFriendRequest(requestID string, now time
 	if err != nil {
 		return stackErr.Error(err)
 	}
-	if err := friendRequest.Create(a.state.actorID, a.state.targetID, nil, now); err != nil {
+	if err := friendRequest.Create(a.state.actorID, a.state.targetID, nil, source, sharedByID, now); err != nil {
 		return stackErr.Error(err)
 	}
 	a.state.friendRequest = friendRequest
@@ -92,6 +97,8 @@ func (a *RelationshipPairAggregate) SendFriendRequest(requestID string, now time
 		RequestID:   requestID,
 		RequesterID: a.state.actorID,
 		AddresseeID: a.state.targetID,
+		Source:      source.String(),
+		SharedByID:  sharedByID,
 		CreatedAt:   now,
 	}))
 }
@@ -116,6 +123,32 @@ func (a *RelationshipPairAggregate) CancelFriendRequest(now time.Time) error {
 	}))
 }
 
+// ExpireFriendRequest closes the actor's outgoing request once it has been
+// pending for at least ttl. The pair must be loaded from the requester's side.
+func (a *RelationshipPairAggregate) ExpireFriendRequest(ttl time.Duration, notifyRequester bool, now time.Time) error {
+	if err := a.state.toPolicyState().EnsureCanExpireFriendRequest(); err != nil {
+		return stackErr.Error(err)
+	}
+	if a.state.friendRequest == nil {
+		return stackErr.Error(fmt.Errorf("friend request aggregate is required"))
+	}
+	if now.Before(a.state.friendRequest.CreatedAt.Add(ttl)) {
+		return stackErr.Error(domain.ErrFriendRequestNotStale)
+	}
+	if err := a.state.friendRequest.ExpireRequest(now); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return stackErr.Error(a.ApplyChange(a, &EventRelationshipPairFriendRequestExpired{
+		RequestID:       a.state.friendRequest.AggregateID(),
+		RequesterID:     a.state.friendRequest.RequesterID,
+		AddresseeID:     a.state.friendRequest.AddresseeID,
+		CreatedAt:       a.state.friendRequest.CreatedAt,
+		ExpiredAt:       now,
+		NotifyRequester: notifyRequester,
+	}))
+}
+
 func (a *RelationshipPairAggregate) AcceptFriendRequest(friendshipID string, now time.Time) error {
 	if err := a.state.toPolicyState().EnsureCanAcceptFriendRequest(); err != nil {
 		return stackErr.Error(err)
@@ -275,6 +308,10 @@ func (a *RelationshipPairAggregate) applyFriendRequestSent(data *EventRelationsh
 		if err != nil {
 			return stackErr.Error(err)
 		}
+		if data.Source != "" {
+			friendRequest.Source = entity.FriendRequestSource(data.Source)
+		}
+		friendRequest.SharedByID = data.SharedByID
 		a.state.friendRequest = friendRequest
 	}
 
@@ -306,6 +343,28 @@ func (a *RelationshipPairAggregate) applyFriendRequestCancelled(data *EventRelat
 	return nil
 }
 
+func (a *RelationshipPairAggregate) applyFriendRequestExpired(data *EventRelationshipPairFriendRequestExpired) error {
+	friendRequest, err := a.ensureFriendRequestAggregate(
+		data.RequestID,
+		data.RequesterID,
+		data.AddresseeID,
+		entity.FriendRequestStatusExpired,
+		data.CreatedAt,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+
+	a.state.friendRequest = friendRequest
+	a.changes.trackFriendRequest(friendRequest)
+	a.changes.addCounterDelta(a.state.actorID, entity.UserRelationshipCounterDelta{PendingOutCount: -1})
+	a.changes.addCounterDelta(a.state.targetID, entity.UserRelationshipCounterDelta{PendingInCount: -1})
+	return nil
+}
+
 func (a *RelationshipPairAggregate) applyFriendRequestAccepted(data *EventRelationshipPairFriendRequestAccepted) error {
 	friendRequest, err := a.ensureFriendRequestAggregate(
 		data.RequestID,
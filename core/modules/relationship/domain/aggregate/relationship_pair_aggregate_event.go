@@ -6,6 +6,8 @@ type EventRelationshipPairFriendRequestSent struct {
 	RequestID   string
 	RequesterID string
 	AddresseeID string
+	Source      string
+	SharedByID  string
 	CreatedAt   time.Time
 }
 
@@ -17,6 +19,15 @@ type EventRelationshipPairFriendRequestCancelled struct {
 	CancelledAt time.Time
 }
 
+type EventRelationshipPairFriendRequestExpired struct {
+	RequestID       string
+	RequesterID     string
+	AddresseeID     string
+	CreatedAt       time.Time
+	ExpiredAt       time.Time
+	NotifyRequester bool
+}
+
 type EventRelationshipPairFriendRequestAccepted struct {
 	RequestID    string
 	RequesterID  string
@@ -17,6 +17,9 @@ type RelationshipPairSnapshot struct {
 	FollowedBy       *entity.FollowRelation
 	Blocking         *entity.BlockRelation
 	BlockedBy        *entity.BlockRelation
+	TargetPrivacy    *entity.FriendRequestPrivacy
+	SharesFriend     bool
+	SharesGroupRoom  bool
 	AggregateVersion int
 }
 
@@ -72,6 +75,10 @@ type relationshipPairState struct {
 	followedBy    *entity.FollowRelation
 	blocking      *entity.BlockRelation
 	blockedBy     *entity.BlockRelation
+
+	targetPrivacy   *entity.FriendRequestPrivacy
+	sharesFriend    bool
+	sharesGroupRoom bool
 }
 
 func newRelationshipPairState(snapshot RelationshipPairSnapshot) relationshipPairState {
@@ -85,6 +92,10 @@ func newRelationshipPairState(snapshot RelationshipPairSnapshot) relationshipPai
 		followedBy:    snapshot.FollowedBy,
 		blocking:      snapshot.Blocking,
 		blockedBy:     snapshot.BlockedBy,
+
+		targetPrivacy:   snapshot.TargetPrivacy,
+		sharesFriend:    snapshot.SharesFriend,
+		sharesGroupRoom: snapshot.SharesGroupRoom,
 	}
 }
 
@@ -105,6 +116,9 @@ func (s relationshipPairState) toPolicyState() policy.PairState {
 		HasIncomingRequest:  s.hasPendingIncomingRequest(),
 		HasBlockingRelation: s.blocking != nil || s.blockedBy != nil,
 		HasBlockedTarget:    s.blocking != nil,
+		TargetPrivacy:       s.targetPrivacy,
+		SharesFriend:        s.sharesFriend,
+		SharesGroupRoom:     s.sharesGroupRoom,
 	}
 }
 
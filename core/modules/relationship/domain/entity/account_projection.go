@@ -7,6 +7,10 @@ type AccountProjection struct {
 	DisplayName     string
 	Username        string
 	AvatarObjectKey string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	// ContactCardNonce is the nonce of the account's current contact card;
+	// QR codes signed with any other nonce have been reset.
+	ContactCardNonce     string
+	ContactCardRotatedAt *time.Time
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
 }
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"wechat-clone/core/modules/relationship/domain"
+)
+
+const (
+	MaxContactTagNameLength = 32
+	MaxContactTagsPerOwner  = 100
+)
+
+// ContactTag groups an owner's friends under a label such as "Family".
+// MemberCount is filled in by reads and is never persisted.
+type ContactTag struct {
+	ID          string
+	OwnerID     string
+	Name        string
+	MemberCount int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewContactTag(id, ownerID, name string, now time.Time) (*ContactTag, error) {
+	if id == "" || ownerID == "" {
+		return nil, fmt.Errorf("contact tag id and owner are required")
+	}
+	name, err := NormalizeContactTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &ContactTag{
+		ID:        id,
+		OwnerID:   ownerID,
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (t *ContactTag) Rename(name string, now time.Time) error {
+	name, err := NormalizeContactTagName(name)
+	if err != nil {
+		return err
+	}
+	t.Name = name
+	t.UpdatedAt = now
+	return nil
+}
+
+func NormalizeContactTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxContactTagNameLength {
+		return "", domain.ErrContactTagNameInvalid
+	}
+	return name, nil
+}
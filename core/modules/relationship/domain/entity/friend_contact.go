@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"wechat-clone/core/modules/relationship/domain"
+)
+
+const (
+	MaxFriendRemarkNameLength  = 64
+	MaxFriendDescriptionLength = 500
+	MaxFriendPhoneNotes        = 5
+	MaxFriendPhoneNoteLength   = 32
+	MaxFriendTags              = 20
+)
+
+// AudienceList places a friend in one of the owner's named audiences. A
+// friend is in at most one list; the zero value means no list.
+type AudienceList string
+
+func (l AudienceList) String() string {
+	return string(l)
+}
+
+const (
+	AudienceListNone         AudienceList = ""
+	AudienceListCloseFriends AudienceList = "close_friends"
+	// AudienceListRestricted keeps the friendship but shows the friend only
+	// public profile fields and hides presence from them.
+	AudienceListRestricted AudienceList = "restricted"
+)
+
+func ParseAudienceList(value string) (AudienceList, error) {
+	switch list := AudienceList(strings.ToLower(strings.TrimSpace(value))); list {
+	case AudienceListNone, AudienceListCloseFriends, AudienceListRestricted:
+		return list, nil
+	default:
+		return "", domain.ErrAudienceListInvalid
+	}
+}
+
+// FriendContact is the private metadata an owner keeps about one of their
+// friends. Nothing in it is visible to the friend.
+type FriendContact struct {
+	OwnerID      string
+	FriendID     string
+	RemarkName   string
+	Description  string
+	PhoneNotes   []string
+	TagIDs       []string
+	AudienceList AudienceList
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewFriendContact(ownerID, friendID string, now time.Time) (*FriendContact, error) {
+	if ownerID == "" || friendID == "" {
+		return nil, fmt.Errorf("friend contact users are required")
+	}
+	if ownerID == friendID {
+		return nil, fmt.Errorf("cannot keep contact metadata for self")
+	}
+	return &FriendContact{
+		OwnerID:   ownerID,
+		FriendID:  friendID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func NormalizeFriendRemark(remarkName, description string, phoneNotes []string) (string, string, []string, error) {
+	remarkName = strings.TrimSpace(remarkName)
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(remarkName) > MaxFriendRemarkNameLength ||
+		utf8.RuneCountInString(description) > MaxFriendDescriptionLength {
+		return "", "", nil, domain.ErrFriendRemarkInvalid
+	}
+
+	notes := make([]string, 0, len(phoneNotes))
+	for _, note := range phoneNotes {
+		note = strings.TrimSpace(note)
+		if note == "" {
+			continue
+		}
+		if utf8.RuneCountInString(note) > MaxFriendPhoneNoteLength {
+			return "", "", nil, domain.ErrFriendRemarkInvalid
+		}
+		notes = append(notes, note)
+	}
+	if len(notes) > MaxFriendPhoneNotes {
+		return "", "", nil, domain.ErrFriendRemarkInvalid
+	}
+	return remarkName, description, notes, nil
+}
+
+func NormalizeFriendTagIDs(tagIDs []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tagIDs))
+	normalized := make([]string, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tagID = strings.TrimSpace(tagID)
+		if tagID == "" {
+			continue
+		}
+		if _, ok := seen[tagID]; ok {
+			continue
+		}
+		seen[tagID] = struct{}{}
+		normalized = append(normalized, tagID)
+	}
+	if len(normalized) > MaxFriendTags {
+		return nil, domain.ErrFriendTagsInvalid
+	}
+	return normalized, nil
+}
@@ -20,12 +20,53 @@ const (
 	FriendRequestStatusExpired   FriendRequestStatus = "EXPIRED"
 )
 
+// FriendRequestSource records how the requester found the addressee.
+type FriendRequestSource string
+
+func (s FriendRequestSource) String() string {
+	return string(s)
+}
+
+const (
+	FriendRequestSourceDirect         FriendRequestSource = "direct"
+	FriendRequestSourceSearchUsername FriendRequestSource = "search_username"
+	FriendRequestSourceSearchEmail    FriendRequestSource = "search_email"
+	FriendRequestSourceGroup          FriendRequestSource = "group"
+	FriendRequestSourceQR             FriendRequestSource = "qr"
+	FriendRequestSourceCardShare      FriendRequestSource = "card_share"
+	// FriendRequestSourceImport marks requests opened by an admin graph
+	// import. It is never accepted from the API.
+	FriendRequestSourceImport FriendRequestSource = "import"
+)
+
+// ParseFriendRequestSource maps the API value to a source; empty means the
+// request was sent directly from a profile.
+func ParseFriendRequestSource(value string) (FriendRequestSource, error) {
+	switch source := FriendRequestSource(value); source {
+	case "":
+		return FriendRequestSourceDirect, nil
+	case FriendRequestSourceDirect,
+		FriendRequestSourceSearchUsername,
+		FriendRequestSourceSearchEmail,
+		FriendRequestSourceGroup,
+		FriendRequestSourceQR,
+		FriendRequestSourceCardShare:
+		return source, nil
+	default:
+		return "", fmt.Errorf("unknown friend request source %q", value)
+	}
+}
+
 type FriendRequest struct {
-	ID             string
-	RequesterID    string
-	AddresseeID    string
-	Status         FriendRequestStatus
-	Message        *string
+	ID          string
+	RequesterID string
+	AddresseeID string
+	Status      FriendRequestStatus
+	Message     *string
+	Source      FriendRequestSource
+	// SharedByID is the account whose shared contact card the requester used;
+	// empty unless Source is FriendRequestSourceCardShare.
+	SharedByID     string
 	CreatedAt      time.Time
 	RespondedAt    *time.Time
 	ExpiredAt      *time.Time
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FriendRequestAudience decides who may send the account a friend request.
+type FriendRequestAudience string
+
+func (a FriendRequestAudience) String() string {
+	return string(a)
+}
+
+const (
+	FriendRequestAudienceEveryone         FriendRequestAudience = "everyone"
+	FriendRequestAudienceFriendsOfFriends FriendRequestAudience = "friends_of_friends"
+	FriendRequestAudienceNobody           FriendRequestAudience = "nobody"
+)
+
+func ParseFriendRequestAudience(value string) (FriendRequestAudience, error) {
+	switch audience := FriendRequestAudience(strings.ToLower(strings.TrimSpace(value))); audience {
+	case FriendRequestAudienceEveryone, FriendRequestAudienceFriendsOfFriends, FriendRequestAudienceNobody:
+		return audience, nil
+	default:
+		return "", fmt.Errorf("unknown friend request audience %q", value)
+	}
+}
+
+// FriendRequestPrivacy holds an account's add-me settings. Accounts that
+// never saved settings get DefaultFriendRequestPrivacy, which keeps the
+// behaviour from before the settings existed.
+type FriendRequestPrivacy struct {
+	AccountID             string
+	AllowRequestsFrom     FriendRequestAudience
+	FindableByUsername    bool
+	FindableByEmail       bool
+	FindableByQR          bool
+	AllowFromSharedGroups bool
+	UpdatedAt             time.Time
+}
+
+func DefaultFriendRequestPrivacy(accountID string) *FriendRequestPrivacy {
+	return &FriendRequestPrivacy{
+		AccountID:             accountID,
+		AllowRequestsFrom:     FriendRequestAudienceEveryone,
+		FindableByUsername:    true,
+		FindableByEmail:       true,
+		FindableByQR:          true,
+		AllowFromSharedGroups: true,
+	}
+}
+
+func (p *FriendRequestPrivacy) Update(
+	audience FriendRequestAudience,
+	findableByUsername bool,
+	findableByEmail bool,
+	findableByQR bool,
+	allowFromSharedGroups bool,
+	now time.Time,
+) {
+	p.AllowRequestsFrom = audience
+	p.FindableByUsername = findableByUsername
+	p.FindableByEmail = findableByEmail
+	p.FindableByQR = findableByQR
+	p.AllowFromSharedGroups = allowFromSharedGroups
+	p.UpdatedAt = now
+}
+
+// AcceptsSource reports whether the add-by channel is switched on. Sources
+// without their own switch (direct, card share) are governed by the audience
+// alone.
+func (p *FriendRequestPrivacy) AcceptsSource(source FriendRequestSource) bool {
+	switch source {
+	case FriendRequestSourceSearchUsername:
+		return p.FindableByUsername
+	case FriendRequestSourceSearchEmail:
+		return p.FindableByEmail
+	case FriendRequestSourceQR:
+		return p.FindableByQR
+	case FriendRequestSourceGroup:
+		return p.AllowFromSharedGroups
+	default:
+		return true
+	}
+}
@@ -0,0 +1,82 @@
+package entity
+
+import "time"
+
+const (
+	SuggestionMutualFriendWeight = 10.0
+	SuggestionSharedRoomWeight   = 4.0
+	SuggestionProfileViewWeight  = 3.0
+	// SuggestionMaxCountedViews caps how many profile views add to a score so
+	// repeated visits cannot outrank real social overlap.
+	SuggestionMaxCountedViews = 5
+	// SuggestionProfileViewWindow is how long a profile view keeps counting.
+	SuggestionProfileViewWindow = 30 * 24 * time.Hour
+	// MaxSuggestionGroupRoomSize bounds which group rooms feed suggestions;
+	// large rooms are a weak signal and would fan out to too many pairs.
+	MaxSuggestionGroupRoomSize = 100
+)
+
+// FriendSuggestion is the precomputed "people you may know" signal for
+// UserID looking at CandidateID. Mutual friends and shared rooms are
+// symmetric; profile views only count for the viewer's own suggestions.
+type FriendSuggestion struct {
+	UserID              string
+	CandidateID         string
+	MutualFriendCount   int
+	SharedRoomCount     int
+	ProfileViewCount    int
+	LastProfileViewedAt *time.Time
+	Score               float64
+	UpdatedAt           time.Time
+}
+
+// SuggestionPair names one directed suggestion row to recompute.
+type SuggestionPair struct {
+	UserID      string
+	CandidateID string
+}
+
+func NewFriendSuggestion(userID, candidateID string, now time.Time) *FriendSuggestion {
+	return &FriendSuggestion{
+		UserID:      userID,
+		CandidateID: candidateID,
+		UpdatedAt:   now,
+	}
+}
+
+// Rescore stores the full ranking score, profile view bonus included, so the
+// list can be read straight off the (user_id, score) index. Bonuses that age
+// out of the view window are cleared by the profile view expiry sweep.
+func (s *FriendSuggestion) Rescore(now time.Time) {
+	s.Score = float64(s.MutualFriendCount)*SuggestionMutualFriendWeight +
+		float64(s.SharedRoomCount)*SuggestionSharedRoomWeight +
+		s.ProfileViewBonus(now)
+	s.UpdatedAt = now
+}
+
+// RecordProfileView counts a view of the candidate's profile and rescores
+// the suggestion so the view moves it up the list.
+func (s *FriendSuggestion) RecordProfileView(viewedAt time.Time) {
+	viewedAt = viewedAt.UTC()
+	s.ProfileViewCount++
+	if s.LastProfileViewedAt == nil || viewedAt.After(*s.LastProfileViewedAt) {
+		s.LastProfileViewedAt = &viewedAt
+	}
+	s.Rescore(viewedAt)
+}
+
+func (s *FriendSuggestion) RecentlyViewed(now time.Time) bool {
+	return s.LastProfileViewedAt != nil && now.Sub(*s.LastProfileViewedAt) <= SuggestionProfileViewWindow
+}
+
+func (s *FriendSuggestion) ProfileViewBonus(now time.Time) float64 {
+	if !s.RecentlyViewed(now) {
+		return 0
+	}
+	return float64(min(s.ProfileViewCount, SuggestionMaxCountedViews)) * SuggestionProfileViewWeight
+}
+
+// IsEmpty reports whether the row carries no signal and can be dropped.
+func (s *FriendSuggestion) IsEmpty() bool {
+	return s.MutualFriendCount == 0 && s.SharedRoomCount == 0 && s.ProfileViewCount == 0
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFriendSuggestionRecordProfileViewRaisesRanking(t *testing.T) {
+	now := time.Now().UTC()
+
+	viewed := NewFriendSuggestion("user-1", "candidate-a", now)
+	viewed.RecordProfileView(now)
+	if viewed.Score != SuggestionProfileViewWeight {
+		t.Fatalf("expected a new row to be scored for its view, got %v", viewed.Score)
+	}
+
+	shared := NewFriendSuggestion("user-1", "candidate-b", now)
+	shared.SharedRoomCount = 1
+	shared.Rescore(now)
+	if viewed.Score >= shared.Score {
+		t.Fatalf("expected one view to rank below a shared room, got %v >= %v", viewed.Score, shared.Score)
+	}
+
+	viewed.RecordProfileView(now.Add(time.Minute))
+	if viewed.Score <= shared.Score {
+		t.Fatalf("expected a second view to move the candidate ahead, got %v <= %v", viewed.Score, shared.Score)
+	}
+
+	for range SuggestionMaxCountedViews + 3 {
+		viewed.RecordProfileView(now.Add(2 * time.Minute))
+	}
+	if want := float64(SuggestionMaxCountedViews) * SuggestionProfileViewWeight; viewed.Score != want {
+		t.Fatalf("expected the view bonus to cap at %v, got %v", want, viewed.Score)
+	}
+}
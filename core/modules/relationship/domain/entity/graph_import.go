@@ -0,0 +1,369 @@
+package entity
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	MaxGraphImportRows   = 50000
+	maxGraphAccountIDLen = 36
+)
+
+// GraphRelation is the kind of edge carried by one import or export row.
+// Friend rows are symmetric; follow and block rows point from user to target.
+type GraphRelation string
+
+func (r GraphRelation) String() string {
+	return string(r)
+}
+
+const (
+	GraphRelationFriend GraphRelation = "friend"
+	GraphRelationFollow GraphRelation = "follow"
+	GraphRelationBlock  GraphRelation = "block"
+)
+
+func ParseGraphRelation(value string) (GraphRelation, error) {
+	switch relation := GraphRelation(strings.ToLower(strings.TrimSpace(value))); relation {
+	case GraphRelationFriend, GraphRelationFollow, GraphRelationBlock:
+		return relation, nil
+	default:
+		return "", fmt.Errorf("unknown relation %q", value)
+	}
+}
+
+// GraphFormat is the file format of an import or export. Both formats carry
+// the same user_id, target_id, relation columns, so an export can be imported
+// as-is.
+type GraphFormat string
+
+func (f GraphFormat) String() string {
+	return string(f)
+}
+
+const (
+	GraphFormatCSV   GraphFormat = "csv"
+	GraphFormatJSONL GraphFormat = "jsonl"
+)
+
+// ParseGraphFormat maps the API value to a format; empty means CSV.
+func ParseGraphFormat(value string) (GraphFormat, error) {
+	switch format := GraphFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return GraphFormatCSV, nil
+	case GraphFormatCSV, GraphFormatJSONL:
+		return format, nil
+	default:
+		return "", stackErr.Error(domain.ErrGraphFormatInvalid)
+	}
+}
+
+type GraphEdge struct {
+	UserID   string        `json:"user_id"`
+	TargetID string        `json:"target_id"`
+	Relation GraphRelation `json:"relation"`
+}
+
+func (e GraphEdge) validate() error {
+	switch {
+	case e.UserID == "" || e.TargetID == "":
+		return fmt.Errorf("user_id and target_id are required")
+	case len(e.UserID) > maxGraphAccountIDLen || len(e.TargetID) > maxGraphAccountIDLen:
+		return fmt.Errorf("user_id and target_id must be account ids")
+	case e.UserID == e.TargetID:
+		return fmt.Errorf("user_id and target_id must differ")
+	}
+	return nil
+}
+
+type GraphImportJobStatus string
+
+func (s GraphImportJobStatus) String() string {
+	return string(s)
+}
+
+const (
+	GraphImportJobStatusPending   GraphImportJobStatus = "pending"
+	GraphImportJobStatusRunning   GraphImportJobStatus = "running"
+	GraphImportJobStatusCompleted GraphImportJobStatus = "completed"
+)
+
+type GraphImportRowStatus string
+
+func (s GraphImportRowStatus) String() string {
+	return string(s)
+}
+
+const (
+	GraphImportRowStatusPending  GraphImportRowStatus = "pending"
+	GraphImportRowStatusImported GraphImportRowStatus = "imported"
+	GraphImportRowStatusSkipped  GraphImportRowStatus = "skipped"
+	GraphImportRowStatusFailed   GraphImportRowStatus = "failed"
+)
+
+func ParseGraphImportRowStatus(value string) (GraphImportRowStatus, error) {
+	switch status := GraphImportRowStatus(strings.ToLower(strings.TrimSpace(value))); status {
+	case GraphImportRowStatusPending, GraphImportRowStatusImported, GraphImportRowStatusSkipped, GraphImportRowStatusFailed:
+		return status, nil
+	default:
+		return "", fmt.Errorf("unknown import row status %q", value)
+	}
+}
+
+// GraphImportRow is one line of an import file and, once processed, its
+// result. Rows that could not be parsed are created already failed.
+type GraphImportRow struct {
+	JobID     string
+	RowNumber int
+	Edge      GraphEdge
+	Status    GraphImportRowStatus
+	Error     string
+}
+
+// GraphImportJob tracks a bulk import. NextRow is the number of the first row
+// that has not been processed yet, which is where a restarted worker resumes.
+type GraphImportJob struct {
+	ID           string
+	RequestedBy  string
+	Format       GraphFormat
+	Status       GraphImportJobStatus
+	TotalRows    int
+	NextRow      int
+	ImportedRows int
+	SkippedRows  int
+	FailedRows   int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	StartedAt    *time.Time
+	CompletedAt  *time.Time
+}
+
+func NewGraphImportJob(id, requestedBy string, format GraphFormat, totalRows int, now time.Time) (*GraphImportJob, error) {
+	if id == "" || requestedBy == "" {
+		return nil, fmt.Errorf("import job id and requester are required")
+	}
+	if totalRows == 0 {
+		return nil, stackErr.Error(domain.ErrGraphImportEmpty)
+	}
+	return &GraphImportJob{
+		ID:          id,
+		RequestedBy: requestedBy,
+		Format:      format,
+		Status:      GraphImportJobStatusPending,
+		TotalRows:   totalRows,
+		NextRow:     1,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (j *GraphImportJob) IsCompleted() bool {
+	return j.Status == GraphImportJobStatusCompleted
+}
+
+// RecordRow counts the result of row NextRow and moves the cursor past it,
+// completing the job after the last row.
+func (j *GraphImportJob) RecordRow(status GraphImportRowStatus, now time.Time) error {
+	if j.IsCompleted() {
+		return fmt.Errorf("import job %s is already completed", j.ID)
+	}
+
+	switch status {
+	case GraphImportRowStatusImported:
+		j.ImportedRows++
+	case GraphImportRowStatusSkipped:
+		j.SkippedRows++
+	case GraphImportRowStatusFailed:
+		j.FailedRows++
+	default:
+		return fmt.Errorf("row result %q is not final", status)
+	}
+
+	if j.StartedAt == nil {
+		j.StartedAt = &now
+	}
+	j.Status = GraphImportJobStatusRunning
+	j.NextRow++
+	j.UpdatedAt = now
+	if j.NextRow > j.TotalRows {
+		j.Status = GraphImportJobStatusCompleted
+		j.CompletedAt = &now
+	}
+	return nil
+}
+
+// ParseGraphImportRows reads an import file into numbered rows. A malformed
+// line becomes a failed row instead of rejecting the whole file, so the
+// report points at every bad line at once.
+func ParseGraphImportRows(format GraphFormat, content string) ([]GraphImportRow, error) {
+	var (
+		rows []GraphImportRow
+		err  error
+	)
+	switch format {
+	case GraphFormatCSV:
+		rows, err = parseGraphCSV(content)
+	case GraphFormatJSONL:
+		rows, err = parseGraphJSONL(content)
+	default:
+		return nil, stackErr.Error(domain.ErrGraphFormatInvalid)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, stackErr.Error(domain.ErrGraphImportEmpty)
+	}
+	if len(rows) > MaxGraphImportRows {
+		return nil, stackErr.Error(domain.ErrGraphImportTooLarge)
+	}
+	return rows, nil
+}
+
+func parseGraphCSV(content string) ([]GraphImportRow, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stackErr.Error(domain.ErrGraphFormatInvalid)
+	}
+	columns := map[string]int{}
+	for idx, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = idx
+	}
+	for _, name := range []string{"user_id", "target_id", "relation"} {
+		if _, ok := columns[name]; !ok {
+			return nil, stackErr.Error(domain.ErrGraphFormatInvalid)
+		}
+	}
+
+	rows := make([]GraphImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row := GraphImportRow{RowNumber: len(rows) + 1}
+		if err != nil {
+			rows = append(rows, failGraphRow(row, err))
+			continue
+		}
+		row.Edge = GraphEdge{
+			UserID:   csvField(record, columns["user_id"]),
+			TargetID: csvField(record, columns["target_id"]),
+			Relation: GraphRelation(csvField(record, columns["relation"])),
+		}
+		rows = append(rows, checkGraphRow(row))
+	}
+	return rows, nil
+}
+
+func parseGraphJSONL(content string) ([]GraphImportRow, error) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	rows := make([]GraphImportRow, 0)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		row := GraphImportRow{RowNumber: len(rows) + 1}
+		if err := json.Unmarshal(line, &row.Edge); err != nil {
+			rows = append(rows, failGraphRow(row, err))
+			continue
+		}
+		row.Edge.UserID = strings.TrimSpace(row.Edge.UserID)
+		row.Edge.TargetID = strings.TrimSpace(row.Edge.TargetID)
+		rows = append(rows, checkGraphRow(row))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stackErr.Error(domain.ErrGraphFormatInvalid)
+	}
+	return rows, nil
+}
+
+func checkGraphRow(row GraphImportRow) GraphImportRow {
+	relation, err := ParseGraphRelation(row.Edge.Relation.String())
+	if err != nil {
+		return failGraphRow(row, err)
+	}
+	row.Edge.Relation = relation
+	if err := row.Edge.validate(); err != nil {
+		return failGraphRow(row, err)
+	}
+	row.Status = GraphImportRowStatusPending
+	return row
+}
+
+// failGraphRow marks a row failed, clipping its fields so the report can be
+// stored whatever the input held.
+func failGraphRow(row GraphImportRow, err error) GraphImportRow {
+	row.Edge.UserID = clipGraphField(row.Edge.UserID, maxGraphAccountIDLen)
+	row.Edge.TargetID = clipGraphField(row.Edge.TargetID, maxGraphAccountIDLen)
+	row.Edge.Relation = GraphRelation(clipGraphField(row.Edge.Relation.String(), 20))
+	row.Status = GraphImportRowStatusFailed
+	row.Error = clipGraphField(err.Error(), 500)
+	return row
+}
+
+func clipGraphField(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	return strings.ToValidUTF8(value[:limit], "")
+}
+
+func csvField(record []string, idx int) string {
+	if idx >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[idx])
+}
+
+// EncodeGraphEdges renders edges in the import format.
+func EncodeGraphEdges(format GraphFormat, edges []GraphEdge) (string, error) {
+	var buf bytes.Buffer
+	switch format {
+	case GraphFormatCSV:
+		writer := csv.NewWriter(&buf)
+		if err := writer.Write([]string{"user_id", "target_id", "relation"}); err != nil {
+			return "", err
+		}
+		for _, edge := range edges {
+			if err := writer.Write([]string{edge.UserID, edge.TargetID, edge.Relation.String()}); err != nil {
+				return "", err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return "", err
+		}
+	case GraphFormatJSONL:
+		encoder := json.NewEncoder(&buf)
+		for _, edge := range edges {
+			if err := encoder.Encode(edge); err != nil {
+				return "", err
+			}
+		}
+	default:
+		return "", stackErr.Error(domain.ErrGraphFormatInvalid)
+	}
+	return buf.String(), nil
+}
@@ -11,10 +11,24 @@ var (
 	ErrRelationshipBlocked      = errors.New("relationship blocked")
 	ErrFriendRequestNotFound    = errors.New("friend request not found")
 	ErrFriendRequestAlreadyOpen = errors.New("friend request already pending")
+	ErrFriendRequestNotStale    = errors.New("friend request has not reached its expiry")
+	ErrFriendRequestNotAllowed  = errors.New("target does not accept friend requests from this source")
 	ErrFriendshipAlreadyExists  = errors.New("friendship already exists")
 	ErrFriendshipNotFound       = errors.New("friendship not found")
 	ErrFollowAlreadyExists      = errors.New("follow relation already exists")
 	ErrFollowNotFound           = errors.New("follow relation not found")
 	ErrBlockAlreadyExists       = errors.New("block relation already exists")
 	ErrBlockNotFound            = errors.New("block relation not found")
+	ErrFriendRemarkInvalid      = errors.New("friend remark is invalid")
+	ErrFriendTagsInvalid        = errors.New("friend tags are invalid")
+	ErrContactTagNameInvalid    = errors.New("contact tag name is invalid")
+	ErrContactTagNameTaken      = errors.New("contact tag name already exists")
+	ErrContactTagNotFound       = errors.New("contact tag not found")
+	ErrContactTagLimitReached   = errors.New("contact tag limit reached")
+	ErrAudienceListInvalid      = errors.New("audience list is invalid")
+	ErrGraphFormatInvalid       = errors.New("graph file format is invalid")
+	ErrGraphImportEmpty         = errors.New("graph import has no rows")
+	ErrGraphImportTooLarge      = errors.New("graph import has too many rows")
+	ErrGraphImportJobNotFound   = errors.New("graph import job not found")
+	ErrGraphImportUserNotFound  = errors.New("user account not found")
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
@@ -17,9 +18,16 @@ type PairState struct {
 	HasIncomingRequest  bool
 	HasBlockingRelation bool
 	HasBlockedTarget    bool
+
+	// TargetPrivacy is the target's add-me settings; the shared-* flags
+	// describe what connects the pair and are only loaded when the settings
+	// need them.
+	TargetPrivacy   *entity.FriendRequestPrivacy
+	SharesFriend    bool
+	SharesGroupRoom bool
 }
 
-func (s PairState) EnsureCanSendFriendRequest() error {
+func (s PairState) EnsureCanSendFriendRequest(source entity.FriendRequestSource) error {
 	if err := s.ensureTargetExists(); err != nil {
 		return stackErr.Error(err)
 	}
@@ -35,6 +43,9 @@ func (s PairState) EnsureCanSendFriendRequest() error {
 	if s.HasOutgoingRequest || s.HasIncomingRequest {
 		return stackErr.Error(domain.ErrFriendRequestAlreadyOpen)
 	}
+	if err := s.ensureTargetAcceptsRequest(source); err != nil {
+		return stackErr.Error(err)
+	}
 	return nil
 }
 
@@ -48,6 +59,13 @@ func (s PairState) EnsureCanCancelFriendRequest() error {
 	return nil
 }
 
+func (s PairState) EnsureCanExpireFriendRequest() error {
+	if !s.HasOutgoingRequest {
+		return stackErr.Error(domain.ErrFriendRequestNotFound)
+	}
+	return nil
+}
+
 func (s PairState) EnsureCanAcceptFriendRequest() error {
 	if err := s.ensureNotSelf("accept friend request from"); err != nil {
 		return stackErr.Error(err)
@@ -147,6 +165,36 @@ func (s PairState) ensureNotSelf(action string) error {
 	return nil
 }
 
+// ensureTargetAcceptsRequest applies the target's privacy settings. A group
+// source only counts when the pair really shares a group room, and it is the
+// one way past a friends-of-friends audience without a mutual friend. Admin
+// imports are not subject to the settings.
+func (s PairState) ensureTargetAcceptsRequest(source entity.FriendRequestSource) error {
+	if source == entity.FriendRequestSourceImport {
+		return nil
+	}
+	privacy := s.TargetPrivacy
+	if privacy == nil {
+		privacy = entity.DefaultFriendRequestPrivacy(s.TargetID)
+	}
+	if !privacy.AcceptsSource(source) {
+		return stackErr.Error(domain.ErrFriendRequestNotAllowed)
+	}
+	if source == entity.FriendRequestSourceGroup && !s.SharesGroupRoom {
+		return stackErr.Error(domain.ErrFriendRequestNotAllowed)
+	}
+
+	switch privacy.AllowRequestsFrom {
+	case entity.FriendRequestAudienceEveryone:
+		return nil
+	case entity.FriendRequestAudienceFriendsOfFriends:
+		if s.SharesFriend || source == entity.FriendRequestSourceGroup {
+			return nil
+		}
+	}
+	return stackErr.Error(domain.ErrFriendRequestNotAllowed)
+}
+
 func (s PairState) ensureNotBlocked() error {
 	if s.HasBlockingRelation {
 		return stackErr.Error(domain.ErrRelationshipBlocked)
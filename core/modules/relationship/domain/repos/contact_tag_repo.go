@@ -0,0 +1,19 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+type ContactTagRepository interface {
+	Get(ctx context.Context, ownerID, tagID string) (*entity.ContactTag, error)
+	// LockOwner serializes tag creation for one owner until the transaction
+	// ends, so the count checked against the cap cannot go stale.
+	LockOwner(ctx context.Context, ownerID string) error
+	CountByOwner(ctx context.Context, ownerID string) (int64, error)
+	FilterOwned(ctx context.Context, ownerID string, tagIDs []string) ([]string, error)
+	Create(ctx context.Context, tag *entity.ContactTag) error
+	Update(ctx context.Context, tag *entity.ContactTag) error
+	Delete(ctx context.Context, ownerID, tagID string) (bool, error)
+}
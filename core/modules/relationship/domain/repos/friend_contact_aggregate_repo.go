@@ -0,0 +1,12 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/aggregate"
+)
+
+type FriendContactAggregateRepository interface {
+	Load(ctx context.Context, ownerID, friendID string) (*aggregate.FriendContactAggregate, error)
+	Save(ctx context.Context, agg *aggregate.FriendContactAggregate) error
+}
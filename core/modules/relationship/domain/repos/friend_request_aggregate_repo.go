@@ -2,12 +2,15 @@ package repos
 
 import (
 	"context"
+	"time"
 	"wechat-clone/core/modules/relationship/domain/aggregate"
+	"wechat-clone/core/modules/relationship/domain/entity"
 )
 
 type FriendRequestAggregateRepository interface {
 	Load(ctx context.Context, friendRequestID string) (*aggregate.FriendRequestAggregate, error)
 	LoadPendingByUsers(ctx context.Context, requesterID, addresseeID string) (*aggregate.FriendRequestAggregate, error)
 	LoadPendingBetween(ctx context.Context, userA, userB string) (*aggregate.FriendRequestAggregate, error)
+	ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.FriendRequest, error)
 	Save(ctx context.Context, agg *aggregate.FriendRequestAggregate) error
 }
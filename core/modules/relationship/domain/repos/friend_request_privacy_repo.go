@@ -0,0 +1,14 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+type FriendRequestPrivacyRepository interface {
+	// Get returns the stored settings, or the defaults when the account has
+	// never saved any.
+	Get(ctx context.Context, accountID string) (*entity.FriendRequestPrivacy, error)
+	Save(ctx context.Context, privacy *entity.FriendRequestPrivacy) error
+}
@@ -0,0 +1,27 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+// FriendSuggestionRepository keeps the precomputed "people you may know"
+// rows together with the group room membership mirror that feeds them.
+type FriendSuggestionRepository interface {
+	ListFriendIDs(ctx context.Context, userID string) ([]string, error)
+	ListGroupRoomMembers(ctx context.Context, roomID string) ([]string, error)
+	ReplaceGroupRoomMembers(ctx context.Context, roomID string, accountIDs []string) error
+	// RefreshPairs recomputes mutual friend and shared room counts for each
+	// pair from the current tables and drops rows left without any signal.
+	RefreshPairs(ctx context.Context, pairs []entity.SuggestionPair, now time.Time) error
+	// RecordProfileView counts a view newer than the last one recorded for the
+	// pair; older or repeated views are ignored.
+	RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error
+	// ExpireProfileViews drops the profile view bonus from up to limit rows
+	// last viewed before viewedBefore, deletes rows left without any signal
+	// and returns how many rows lost their bonus.
+	ExpireProfileViews(ctx context.Context, viewedBefore, now time.Time, limit int) (int, error)
+	Dismiss(ctx context.Context, userID, candidateID string, dismissedAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+)
+
+// GraphImportJobRepository stores admin graph imports and the per-row results
+// the background worker fills in.
+type GraphImportJobRepository interface {
+	Create(ctx context.Context, job *entity.GraphImportJob, rows []entity.GraphImportRow) error
+	// ListRunnable returns jobs that still have rows to process, oldest first.
+	ListRunnable(ctx context.Context, limit int) ([]*entity.GraphImportJob, error)
+	// ListRows returns up to limit rows starting at row number fromRow.
+	ListRows(ctx context.Context, jobID string, fromRow, limit int) ([]entity.GraphImportRow, error)
+	// SaveRowResult stores the result of one row together with the job's
+	// counters and cursor.
+	SaveRowResult(ctx context.Context, job *entity.GraphImportJob, row entity.GraphImportRow) error
+}
@@ -6,6 +6,11 @@ import "context"
 type Repos interface {
 	FriendRequestAggregateRepository() FriendRequestAggregateRepository
 	RelationshipPairAggregateRepository() RelationshipPairAggregateRepository
+	FriendContactAggregateRepository() FriendContactAggregateRepository
+	ContactTagRepository() ContactTagRepository
+	FriendSuggestionRepository() FriendSuggestionRepository
+	FriendRequestPrivacyRepository() FriendRequestPrivacyRepository
+	GraphImportJobRepository() GraphImportJobRepository
 
 	WithTransaction(ctx context.Context, fn func(Repos) error) error
 }
@@ -40,6 +40,34 @@ func (m *MockRepos) EXPECT() *MockReposMockRecorder {
 	return m.recorder
 }
 
+// ContactTagRepository mocks base method.
+func (m *MockRepos) ContactTagRepository() ContactTagRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContactTagRepository")
+	ret0, _ := ret[0].(ContactTagRepository)
+	return ret0
+}
+
+// ContactTagRepository indicates an expected call of ContactTagRepository.
+func (mr *MockReposMockRecorder) ContactTagRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactTagRepository", reflect.TypeOf((*MockRepos)(nil).ContactTagRepository))
+}
+
+// FriendContactAggregateRepository mocks base method.
+func (m *MockRepos) FriendContactAggregateRepository() FriendContactAggregateRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FriendContactAggregateRepository")
+	ret0, _ := ret[0].(FriendContactAggregateRepository)
+	return ret0
+}
+
+// FriendContactAggregateRepository indicates an expected call of FriendContactAggregateRepository.
+func (mr *MockReposMockRecorder) FriendContactAggregateRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FriendContactAggregateRepository", reflect.TypeOf((*MockRepos)(nil).FriendContactAggregateRepository))
+}
+
 // FriendRequestAggregateRepository mocks base method.
 func (m *MockRepos) FriendRequestAggregateRepository() FriendRequestAggregateRepository {
 	m.ctrl.T.Helper()
@@ -54,6 +82,48 @@ func (mr *MockReposMockRecorder) FriendRequestAggregateRepository() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FriendRequestAggregateRepository", reflect.TypeOf((*MockRepos)(nil).FriendRequestAggregateRepository))
 }
 
+// FriendRequestPrivacyRepository mocks base method.
+func (m *MockRepos) FriendRequestPrivacyRepository() FriendRequestPrivacyRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FriendRequestPrivacyRepository")
+	ret0, _ := ret[0].(FriendRequestPrivacyRepository)
+	return ret0
+}
+
+// FriendRequestPrivacyRepository indicates an expected call of FriendRequestPrivacyRepository.
+func (mr *MockReposMockRecorder) FriendRequestPrivacyRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FriendRequestPrivacyRepository", reflect.TypeOf((*MockRepos)(nil).FriendRequestPrivacyRepository))
+}
+
+// FriendSuggestionRepository mocks base method.
+func (m *MockRepos) FriendSuggestionRepository() FriendSuggestionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FriendSuggestionRepository")
+	ret0, _ := ret[0].(FriendSuggestionRepository)
+	return ret0
+}
+
+// FriendSuggestionRepository indicates an expected call of FriendSuggestionRepository.
+func (mr *MockReposMockRecorder) FriendSuggestionRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FriendSuggestionRepository", reflect.TypeOf((*MockRepos)(nil).FriendSuggestionRepository))
+}
+
+// GraphImportJobRepository mocks base method.
+func (m *MockRepos) GraphImportJobRepository() GraphImportJobRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphImportJobRepository")
+	ret0, _ := ret[0].(GraphImportJobRepository)
+	return ret0
+}
+
+// GraphImportJobRepository indicates an expected call of GraphImportJobRepository.
+func (mr *MockReposMockRecorder) GraphImportJobRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphImportJobRepository", reflect.TypeOf((*MockRepos)(nil).GraphImportJobRepository))
+}
+
 // RelationshipPairAggregateRepository mocks base method.
 func (m *MockRepos) RelationshipPairAggregateRepository() RelationshipPairAggregateRepository {
 	m.ctrl.T.Helper()
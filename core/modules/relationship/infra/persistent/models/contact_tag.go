@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+type ContactTag struct {
+	ID        string    `gorm:"column:id;type:varchar(36);primaryKey"`
+	OwnerID   string    `gorm:"column:owner_id;type:varchar(36);not null;index:idx_contact_tags_owner_created,priority:1"`
+	Name      string    `gorm:"column:name;type:varchar(32);not null"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamptz;not null;index:idx_contact_tags_owner_created,priority:2"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (ContactTag) TableName() string {
+	return "relationship_contact_tags"
+}
+
+type ContactTagMember struct {
+	TagID     string    `gorm:"column:tag_id;type:varchar(36);primaryKey"`
+	FriendID  string    `gorm:"column:friend_id;type:varchar(36);primaryKey"`
+	OwnerID   string    `gorm:"column:owner_id;type:varchar(36);not null;index:idx_contact_tag_members_owner_friend,priority:1"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (ContactTagMember) TableName() string {
+	return "relationship_contact_tag_members"
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type FriendContact struct {
+	OwnerID        string    `gorm:"column:owner_id;type:varchar(36);primaryKey"`
+	FriendID       string    `gorm:"column:friend_id;type:varchar(36);primaryKey"`
+	RemarkName     string    `gorm:"column:remark_name;type:varchar(64);not null;default:''"`
+	Description    string    `gorm:"column:description;type:varchar(500);not null;default:''"`
+	PhoneNotesJSON string    `gorm:"column:phone_notes_json;type:text;not null;default:'[]'"`
+	AudienceList   string    `gorm:"column:audience_list;type:varchar(32);not null;default:''"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (FriendContact) TableName() string {
+	return "relationship_friend_contacts"
+}
@@ -18,6 +18,8 @@ type FriendRequest struct {
 	AddresseeID    string              `gorm:"column:addressee_id;type:varchar(36);not null;index:idx_friend_requests_addressee_status_created,priority:1"`
 	Status         FriendRequestStatus `gorm:"column:status;type:varchar(20);not null;index:idx_friend_requests_requester_status_created,priority:2;index:idx_friend_requests_addressee_status_created,priority:2"`
 	Message        *string             `gorm:"column:message;type:varchar(500)"`
+	Source         string              `gorm:"column:source;type:varchar(20);not null;default:direct"`
+	SharedByID     *string             `gorm:"column:shared_by_id;type:varchar(36)"`
 	CreatedAt      time.Time           `gorm:"column:created_at;type:timestamptz;not null;autoCreateTime;index:idx_friend_requests_requester_status_created,priority:3,sort:desc;index:idx_friend_requests_addressee_status_created,priority:3,sort:desc"`
 	RespondedAt    *time.Time          `gorm:"column:responded_at;type:timestamptz"`
 	ExpiredAt      *time.Time          `gorm:"column:expired_at;type:timestamptz"`
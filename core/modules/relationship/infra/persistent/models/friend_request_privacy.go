@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type FriendRequestPrivacy struct {
+	AccountID             string    `gorm:"column:account_id;type:varchar(36);primaryKey"`
+	AllowRequestsFrom     string    `gorm:"column:allow_requests_from;type:varchar(32);not null"`
+	FindableByUsername    bool      `gorm:"column:findable_by_username;not null"`
+	FindableByEmail       bool      `gorm:"column:findable_by_email;not null"`
+	FindableByQR          bool      `gorm:"column:findable_by_qr;not null"`
+	AllowFromSharedGroups bool      `gorm:"column:allow_from_shared_groups;not null"`
+	UpdatedAt             time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (FriendRequestPrivacy) TableName() string {
+	return "relationship_friend_request_privacy"
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+type FriendSuggestion struct {
+	UserID              string     `gorm:"column:user_id;type:varchar(36);primaryKey"`
+	CandidateID         string     `gorm:"column:candidate_id;type:varchar(36);primaryKey"`
+	MutualFriendCount   int        `gorm:"column:mutual_friend_count;not null;default:0"`
+	SharedRoomCount     int        `gorm:"column:shared_room_count;not null;default:0"`
+	ProfileViewCount    int        `gorm:"column:profile_view_count;not null;default:0"`
+	LastProfileViewedAt *time.Time `gorm:"column:last_profile_viewed_at;type:timestamptz"`
+	Score               float64    `gorm:"column:score;not null;default:0"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (FriendSuggestion) TableName() string {
+	return "relationship_friend_suggestions"
+}
+
+type FriendSuggestionDismissal struct {
+	UserID      string    `gorm:"column:user_id;type:varchar(36);primaryKey"`
+	CandidateID string    `gorm:"column:candidate_id;type:varchar(36);primaryKey"`
+	DismissedAt time.Time `gorm:"column:dismissed_at;type:timestamptz;not null"`
+}
+
+func (FriendSuggestionDismissal) TableName() string {
+	return "relationship_friend_suggestion_dismissals"
+}
+
+// SuggestionRoomMember mirrors group room membership from the room module so
+// shared rooms can be counted, and friend request privacy can check for a
+// shared group, without calling across modules.
+type SuggestionRoomMember struct {
+	RoomID    string `gorm:"column:room_id;type:varchar(36);primaryKey"`
+	AccountID string `gorm:"column:account_id;type:varchar(36);primaryKey"`
+}
+
+func (SuggestionRoomMember) TableName() string {
+	return "relationship_suggestion_room_members"
+}
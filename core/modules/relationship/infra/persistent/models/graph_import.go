@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type GraphImportJob struct {
+	ID           string     `gorm:"column:id;type:varchar(36);primaryKey"`
+	RequestedBy  string     `gorm:"column:requested_by;type:varchar(36);not null"`
+	Format       string     `gorm:"column:format;type:varchar(10);not null"`
+	Status       string     `gorm:"column:status;type:varchar(20);not null;index:idx_graph_import_jobs_status_created,priority:1"`
+	TotalRows    int        `gorm:"column:total_rows;not null"`
+	NextRow      int        `gorm:"column:next_row;not null"`
+	ImportedRows int        `gorm:"column:imported_rows;not null;default:0"`
+	SkippedRows  int        `gorm:"column:skipped_rows;not null;default:0"`
+	FailedRows   int        `gorm:"column:failed_rows;not null;default:0"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamptz;not null;index:idx_graph_import_jobs_status_created,priority:2"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;type:timestamptz;not null"`
+	StartedAt    *time.Time `gorm:"column:started_at;type:timestamptz"`
+	CompletedAt  *time.Time `gorm:"column:completed_at;type:timestamptz"`
+}
+
+func (GraphImportJob) TableName() string {
+	return "relationship_graph_import_jobs"
+}
+
+type GraphImportRow struct {
+	JobID     string `gorm:"column:job_id;type:varchar(36);primaryKey"`
+	RowNumber int    `gorm:"column:row_number;primaryKey"`
+	UserID    string `gorm:"column:user_id;type:varchar(36);not null"`
+	TargetID  string `gorm:"column:target_id;type:varchar(36);not null"`
+	Relation  string `gorm:"column:relation;type:varchar(20);not null"`
+	Status    string `gorm:"column:status;type:varchar(20);not null"`
+	Error     string `gorm:"column:error;type:varchar(500);not null;default:''"`
+}
+
+func (GraphImportRow) TableName() string {
+	return "relationship_graph_import_rows"
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"wechat-clone/core/modules/relationship/domain/entity"
 	"wechat-clone/core/modules/relationship/infra/persistent/models"
@@ -29,13 +30,17 @@ func (r *RelationshipAccountRepo) ProjectAccount(ctx context.Context, account *e
 		return stackErr.Error(errors.New("account projection is required"))
 	}
 
+	// The contact card is left to ProjectContactCard on conflict, so a late
+	// profile event cannot roll the card back.
 	model := &models.RelationshipAccount{
-		AccountID:       account.AccountID,
-		DisplayName:     account.DisplayName,
-		Username:        account.Username,
-		AvatarObjectKey: account.AvatarObjectKey,
-		CreatedAt:       account.CreatedAt,
-		UpdatedAt:       account.UpdatedAt,
+		AccountID:            account.AccountID,
+		DisplayName:          account.DisplayName,
+		Username:             account.Username,
+		AvatarObjectKey:      account.AvatarObjectKey,
+		ContactCardNonce:     account.ContactCardNonce,
+		ContactCardRotatedAt: account.ContactCardRotatedAt,
+		CreatedAt:            account.CreatedAt,
+		UpdatedAt:            account.UpdatedAt,
 	}
 
 	if err := r.db.WithContext(ctx).
@@ -64,15 +69,43 @@ func (r *RelationshipAccountRepo) GetByID(ctx context.Context, accountID string)
 	}
 
 	return &entity.AccountProjection{
-		AccountID:       model.AccountID,
-		DisplayName:     model.DisplayName,
-		Username:        model.Username,
-		AvatarObjectKey: model.AvatarObjectKey,
-		CreatedAt:       model.CreatedAt,
-		UpdatedAt:       model.UpdatedAt,
+		AccountID:            model.AccountID,
+		DisplayName:          model.DisplayName,
+		Username:             model.Username,
+		AvatarObjectKey:      model.AvatarObjectKey,
+		ContactCardNonce:     model.ContactCardNonce,
+		ContactCardRotatedAt: model.ContactCardRotatedAt,
+		CreatedAt:            model.CreatedAt,
+		UpdatedAt:            model.UpdatedAt,
 	}, nil
 }
 
+// ProjectContactCard stores the account's current contact-card nonce. An
+// older rotation delivered late never replaces a newer one.
+func (r *RelationshipAccountRepo) ProjectContactCard(ctx context.Context, accountID, nonce string, rotatedAt time.Time) error {
+	model := &models.RelationshipAccount{
+		AccountID:            accountID,
+		ContactCardNonce:     nonce,
+		ContactCardRotatedAt: &rotatedAt,
+		CreatedAt:            rotatedAt,
+		UpdatedAt:            rotatedAt,
+	}
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}},
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "relationship_accounts.contact_card_rotated_at IS NULL OR relationship_accounts.contact_card_rotated_at <= excluded.contact_card_rotated_at"},
+			}},
+			DoUpdates: clause.AssignmentColumns([]string{"contact_card_nonce", "contact_card_rotated_at"}),
+		}).
+		Create(model).Error; err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
 func (r *RelationshipAccountRepo) Exists(ctx context.Context, accountID string) (bool, error) {
 	var count int64
 	if err := r.db.WithContext(ctx).
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+
+	relationshipprojection "wechat-clone/core/modules/relationship/application/projection"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+// RelationshipContactReadRepo serves remark and tag reads straight from the
+// relational tables; the data is private to its owner so it has no Cassandra
+// projection.
+type RelationshipContactReadRepo struct {
+	db             *gorm.DB
+	friendshipRepo friendshipStore
+	tagRepo        *contactTagRepo
+}
+
+func NewRelationshipContactReadRepo(db *gorm.DB) *RelationshipContactReadRepo {
+	return &RelationshipContactReadRepo{
+		db:             db,
+		friendshipRepo: newFriendshipRepo(db),
+		tagRepo:        &contactTagRepo{db: db},
+	}
+}
+
+func (r *RelationshipContactReadRepo) AreFriends(ctx context.Context, userA, userB string) (bool, error) {
+	exists, err := r.friendshipRepo.ExistsBetween(ctx, userA, userB)
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return exists, nil
+}
+
+func (r *RelationshipContactReadRepo) GetFriendContact(ctx context.Context, ownerID, friendID string) (*entity.FriendContact, error) {
+	contact, err := loadFriendContact(ctx, r.db, ownerID, friendID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return contact, nil
+}
+
+func (r *RelationshipContactReadRepo) GetContactTag(ctx context.Context, ownerID, tagID string) (*entity.ContactTag, error) {
+	tag, err := r.tagRepo.Get(ctx, ownerID, tagID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return tag, nil
+}
+
+func (r *RelationshipContactReadRepo) ListContactTags(ctx context.Context, ownerID string) ([]*entity.ContactTag, error) {
+	var rows []struct {
+		models.ContactTag
+		MemberCount int64 `gorm:"column:member_count"`
+	}
+	err := r.db.WithContext(ctx).
+		Table(models.ContactTag{}.TableName()+" AS t").
+		Select("t.*, COUNT(m.friend_id) AS member_count").
+		Joins("LEFT JOIN "+models.ContactTagMember{}.TableName()+" AS m ON m.tag_id = t.id").
+		Where("t.owner_id = ?", ownerID).
+		Group("t.id").
+		Order("t.created_at ASC, t.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	tags := make([]*entity.ContactTag, 0, len(rows))
+	for idx := range rows {
+		tag := toContactTagEntity(&rows[idx].ContactTag)
+		tag.MemberCount = rows[idx].MemberCount
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// ListFriendsByTag pages through a tag's members ordered by friend id; the
+// cursor is the last friend id of the previous page.
+func (r *RelationshipContactReadRepo) ListFriendsByTag(
+	ctx context.Context,
+	ownerID string,
+	tagID string,
+	cursor string,
+	limit int,
+) (*relationshipprojection.RelationshipListResult, error) {
+	base := r.db.WithContext(ctx).
+		Model(&models.ContactTagMember{}).
+		Where("owner_id = ? AND tag_id = ?", ownerID, tagID)
+	return pageFriendIDs(base, cursor, limit)
+}
+
+// ListFriendsByAudienceList pages through the friends the owner placed in
+// list, ordered by friend id like ListFriendsByTag.
+func (r *RelationshipContactReadRepo) ListFriendsByAudienceList(
+	ctx context.Context,
+	ownerID string,
+	list entity.AudienceList,
+	cursor string,
+	limit int,
+) (*relationshipprojection.RelationshipListResult, error) {
+	base := r.db.WithContext(ctx).
+		Model(&models.FriendContact{}).
+		Where("owner_id = ? AND audience_list = ?", ownerID, list.String())
+	return pageFriendIDs(base, cursor, limit)
+}
+
+func pageFriendIDs(base *gorm.DB, cursor string, limit int) (*relationshipprojection.RelationshipListResult, error) {
+	var total int64
+	if err := base.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	page := base.Session(&gorm.Session{})
+	if cursor != "" {
+		page = page.Where("friend_id > ?", cursor)
+	}
+	var friendIDs []string
+	if err := page.
+		Order("friend_id ASC").
+		Limit(limit+1).
+		Pluck("friend_id", &friendIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	result := &relationshipprojection.RelationshipListResult{Items: friendIDs, Total: total}
+	if len(friendIDs) > limit {
+		result.Items = friendIDs[:limit]
+		result.NextCursor = friendIDs[limit-1]
+	}
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	dbinfra "wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type contactTagRepo struct {
+	db *gorm.DB
+}
+
+func newContactTagRepo(db *gorm.DB) repos.ContactTagRepository {
+	return &contactTagRepo{db: db}
+}
+
+func (r *contactTagRepo) Get(ctx context.Context, ownerID, tagID string) (*entity.ContactTag, error) {
+	var model models.ContactTag
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND owner_id = ?", tagID, ownerID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(domain.ErrContactTagNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toContactTagEntity(&model), nil
+}
+
+// LockOwner takes a transaction-scoped advisory lock. Locking the owner's
+// existing tag rows would not stop a concurrent insert of a new one.
+func (r *contactTagRepo) LockOwner(ctx context.Context, ownerID string) error {
+	if err := r.db.WithContext(ctx).
+		Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "relationship:contact_tags:"+ownerID).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *contactTagRepo) CountByOwner(ctx context.Context, ownerID string) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.ContactTag{}).
+		Where("owner_id = ?", ownerID).
+		Count(&count).Error; err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return count, nil
+}
+
+func (r *contactTagRepo) FilterOwned(ctx context.Context, ownerID string, tagIDs []string) ([]string, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	var owned []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.ContactTag{}).
+		Where("owner_id = ? AND id IN ?", ownerID, tagIDs).
+		Pluck("id", &owned).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return owned, nil
+}
+
+func (r *contactTagRepo) Create(ctx context.Context, tag *entity.ContactTag) error {
+	if tag == nil {
+		return stackErr.Error(errors.New("contact tag is required"))
+	}
+	if err := r.db.WithContext(ctx).Create(toContactTagModel(tag)).Error; err != nil {
+		if dbinfra.IsUniqueConstraintError(err) {
+			return stackErr.Error(domain.ErrContactTagNameTaken)
+		}
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *contactTagRepo) Update(ctx context.Context, tag *entity.ContactTag) error {
+	if tag == nil {
+		return stackErr.Error(errors.New("contact tag is required"))
+	}
+	result := r.db.WithContext(ctx).
+		Model(&models.ContactTag{}).
+		Where("id = ? AND owner_id = ?", tag.ID, tag.OwnerID).
+		Updates(map[string]interface{}{
+			"name":       tag.Name,
+			"updated_at": tag.UpdatedAt,
+		})
+	if result.Error != nil {
+		if dbinfra.IsUniqueConstraintError(result.Error) {
+			return stackErr.Error(domain.ErrContactTagNameTaken)
+		}
+		return stackErr.Error(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return stackErr.Error(domain.ErrContactTagNotFound)
+	}
+	return nil
+}
+
+func (r *contactTagRepo) Delete(ctx context.Context, ownerID, tagID string) (bool, error) {
+	if err := r.db.WithContext(ctx).
+		Where("tag_id = ? AND owner_id = ?", tagID, ownerID).
+		Delete(&models.ContactTagMember{}).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND owner_id = ?", tagID, ownerID).
+		Delete(&models.ContactTag{})
+	if result.Error != nil {
+		return false, stackErr.Error(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func toContactTagModel(tag *entity.ContactTag) *models.ContactTag {
+	return &models.ContactTag{
+		ID:        tag.ID,
+		OwnerID:   tag.OwnerID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func toContactTagEntity(model *models.ContactTag) *entity.ContactTag {
+	return &entity.ContactTag{
+		ID:        model.ID,
+		OwnerID:   model.OwnerID,
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"wechat-clone/core/modules/relationship/domain/aggregate"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type friendContactAggregateRepo struct {
+	db              *gorm.DB
+	outboxPublisher eventpkg.Publisher
+	friendshipRepo  friendshipStore
+}
+
+func newFriendContactAggregateRepo(db *gorm.DB) *friendContactAggregateRepo {
+	return &friendContactAggregateRepo{
+		db: db,
+		outboxPublisher: eventpkg.NewPublisher(&relationOutboxEventStore{
+			db:         db,
+			serializer: eventpkg.NewSerializer(),
+		}),
+		friendshipRepo: newFriendshipRepo(db),
+	}
+}
+
+func (r *friendContactAggregateRepo) Load(ctx context.Context, ownerID, friendID string) (*aggregate.FriendContactAggregate, error) {
+	isFriend, err := r.friendshipRepo.ExistsBetween(ctx, ownerID, friendID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	aggregateVersion, err := loadRelationOutboxAggregateVersion(
+		r.db,
+		aggregate.FriendContactAggregateID(ownerID, friendID),
+		eventpkg.AggregateTypeName(&aggregate.FriendContactAggregate{}),
+	)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	contact, err := loadFriendContact(ctx, r.db, ownerID, friendID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	agg, err := aggregate.NewFriendContact(aggregate.FriendContactSnapshot{
+		OwnerID:          ownerID,
+		FriendID:         friendID,
+		IsFriend:         isFriend,
+		Contact:          contact,
+		AggregateVersion: aggregateVersion,
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return agg, nil
+}
+
+func (r *friendContactAggregateRepo) Save(ctx context.Context, agg *aggregate.FriendContactAggregate) error {
+	if agg == nil || agg.Contact() == nil {
+		return stackErr.Error(errors.New("friend contact aggregate is required"))
+	}
+	if len(agg.Events()) == 0 {
+		return nil
+	}
+
+	contact := agg.Contact()
+	model, err := toFriendContactModel(contact)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}, {Name: "friend_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"remark_name", "description", "phone_notes_json", "audience_list", "updated_at"}),
+		}).
+		Create(model).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	if err := r.replaceTagMembers(ctx, contact); err != nil {
+		return stackErr.Error(err)
+	}
+
+	if err := r.outboxPublisher.PublishAggregate(ctx, agg); err != nil {
+		return stackErr.Error(err)
+	}
+	agg.MarkPersisted()
+	return nil
+}
+
+// DeleteBetween drops the contact metadata both users keep about each other.
+// It runs when a friendship ends so remarks and tags do not outlive it.
+func (r *friendContactAggregateRepo) DeleteBetween(ctx context.Context, userA, userB string) error {
+	pairFilter := "(owner_id = ? AND friend_id = ?) OR (owner_id = ? AND friend_id = ?)"
+	if err := r.db.WithContext(ctx).
+		Where(pairFilter, userA, userB, userB, userA).
+		Delete(&models.ContactTagMember{}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where(pairFilter, userA, userB, userB, userA).
+		Delete(&models.FriendContact{}).Error)
+}
+
+func (r *friendContactAggregateRepo) replaceTagMembers(ctx context.Context, contact *entity.FriendContact) error {
+	if err := r.db.WithContext(ctx).
+		Where("owner_id = ? AND friend_id = ?", contact.OwnerID, contact.FriendID).
+		Delete(&models.ContactTagMember{}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	if len(contact.TagIDs) == 0 {
+		return nil
+	}
+
+	members := make([]models.ContactTagMember, 0, len(contact.TagIDs))
+	for _, tagID := range contact.TagIDs {
+		members = append(members, models.ContactTagMember{
+			TagID:     tagID,
+			FriendID:  contact.FriendID,
+			OwnerID:   contact.OwnerID,
+			CreatedAt: contact.UpdatedAt,
+		})
+	}
+	return stackErr.Error(r.db.WithContext(ctx).Create(&members).Error)
+}
+
+func loadFriendContact(ctx context.Context, db *gorm.DB, ownerID, friendID string) (*entity.FriendContact, error) {
+	var model models.FriendContact
+	err := db.WithContext(ctx).
+		Where("owner_id = ? AND friend_id = ?", ownerID, friendID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+
+	var tagIDs []string
+	if err := db.WithContext(ctx).
+		Model(&models.ContactTagMember{}).
+		Where("owner_id = ? AND friend_id = ?", ownerID, friendID).
+		Order("created_at ASC, tag_id ASC").
+		Pluck("tag_id", &tagIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	contact, err := toFriendContactEntity(&model)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	contact.TagIDs = tagIDs
+	return contact, nil
+}
+
+func toFriendContactModel(contact *entity.FriendContact) (*models.FriendContact, error) {
+	phoneNotes := contact.PhoneNotes
+	if phoneNotes == nil {
+		phoneNotes = []string{}
+	}
+	phoneNotesJSON, err := json.Marshal(phoneNotes)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("marshal phone notes failed: %w", err))
+	}
+
+	createdAt := contact.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+	return &models.FriendContact{
+		OwnerID:        contact.OwnerID,
+		FriendID:       contact.FriendID,
+		RemarkName:     contact.RemarkName,
+		Description:    contact.Description,
+		PhoneNotesJSON: string(phoneNotesJSON),
+		AudienceList:   contact.AudienceList.String(),
+		CreatedAt:      createdAt,
+		UpdatedAt:      contact.UpdatedAt,
+	}, nil
+}
+
+func toFriendContactEntity(model *models.FriendContact) (*entity.FriendContact, error) {
+	var phoneNotes []string
+	if model.PhoneNotesJSON != "" {
+		if err := json.Unmarshal([]byte(model.PhoneNotesJSON), &phoneNotes); err != nil {
+			return nil, stackErr.Error(fmt.Errorf("unmarshal phone notes failed: %w", err))
+		}
+	}
+	return &entity.FriendContact{
+		OwnerID:      model.OwnerID,
+		FriendID:     model.FriendID,
+		RemarkName:   model.RemarkName,
+		Description:  model.Description,
+		PhoneNotes:   phoneNotes,
+		AudienceList: entity.AudienceList(model.AudienceList),
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 	"wechat-clone/core/modules/relationship/domain/aggregate"
 	"wechat-clone/core/modules/relationship/domain/entity"
 	"wechat-clone/core/modules/relationship/domain/repos"
@@ -12,6 +13,7 @@ import (
 
 	eventpkg "wechat-clone/core/shared/pkg/event"
 	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
 
 	"gorm.io/gorm"
 )
@@ -92,6 +94,28 @@ func (f *friendRequestAggregateRepo) LoadPendingBetween(ctx context.Context, use
 	return f.loadAggregateFromModel(&friendRequestModel)
 }
 
+func (f *friendRequestAggregateRepo) ListStalePending(ctx context.Context, createdBefore time.Time, limit int) ([]*entity.FriendRequest, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var friendRequestModels []models.FriendRequest
+	err := f.db.WithContext(ctx).
+		Where("status = ? AND created_at <= ?", models.FriendRequestStatusPending, createdBefore.UTC()).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&friendRequestModels).Error
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]*entity.FriendRequest, 0, len(friendRequestModels))
+	for idx := range friendRequestModels {
+		items = append(items, toFriendRequestEntity(&friendRequestModels[idx]))
+	}
+	return items, nil
+}
+
 func (f *friendRequestAggregateRepo) loadAggregateFromModel(friendRequestModel *models.FriendRequest) (*aggregate.FriendRequestAggregate, error) {
 	friendRequestEntity := toFriendRequestEntity(friendRequestModel)
 
@@ -168,6 +192,8 @@ func toFriendRequestModel(e *entity.FriendRequest) *models.FriendRequest {
 		AddresseeID:    e.AddresseeID,
 		Status:         toFriendRequestModelStatus(e.Status),
 		Message:        e.Message,
+		Source:         toFriendRequestModelSource(e.Source),
+		SharedByID:     utils.NullableString(e.SharedByID),
 		CreatedAt:      e.CreatedAt,
 		RespondedAt:    e.RespondedAt,
 		ExpiredAt:      e.ExpiredAt,
@@ -187,6 +213,8 @@ func toFriendRequestEntity(m *models.FriendRequest) *entity.FriendRequest {
 		AddresseeID:    m.AddresseeID,
 		Status:         toFriendRequestEntityStatus(m.Status),
 		Message:        m.Message,
+		Source:         entity.FriendRequestSource(m.Source),
+		SharedByID:     utils.StringValue(m.SharedByID),
 		CreatedAt:      m.CreatedAt,
 		RespondedAt:    m.RespondedAt,
 		ExpiredAt:      m.ExpiredAt,
@@ -199,6 +227,13 @@ func toFriendRequestModelStatus(s entity.FriendRequestStatus) models.FriendReque
 	return models.FriendRequestStatus(s)
 }
 
+func toFriendRequestModelSource(s entity.FriendRequestSource) string {
+	if s == "" {
+		return entity.FriendRequestSourceDirect.String()
+	}
+	return s.String()
+}
+
 func toFriendRequestEntityStatus(s models.FriendRequestStatus) entity.FriendRequestStatus {
 	return entity.FriendRequestStatus(s)
 }
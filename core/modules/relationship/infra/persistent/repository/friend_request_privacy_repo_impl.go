@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	eventpkg "wechat-clone/core/shared/pkg/event"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// friendRequestPrivacyAggregateType names the outbox stream of privacy
+// updates; the account id is the aggregate id.
+const friendRequestPrivacyAggregateType = "FriendRequestPrivacy"
+
+type friendRequestPrivacyRepo struct {
+	db              *gorm.DB
+	outboxPublisher eventpkg.Publisher
+}
+
+func newFriendRequestPrivacyRepo(db *gorm.DB) repos.FriendRequestPrivacyRepository {
+	return &friendRequestPrivacyRepo{
+		db: db,
+		outboxPublisher: eventpkg.NewPublisher(&relationOutboxEventStore{
+			db:         db,
+			serializer: eventpkg.NewSerializer(),
+		}),
+	}
+}
+
+func (r *friendRequestPrivacyRepo) Get(ctx context.Context, accountID string) (*entity.FriendRequestPrivacy, error) {
+	var model models.FriendRequestPrivacy
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.DefaultFriendRequestPrivacy(accountID), nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toFriendRequestPrivacyEntity(&model), nil
+}
+
+// Save stores the settings and publishes the findability switches, so the
+// account lookups can hide the account on the channels it switched off.
+func (r *friendRequestPrivacyRepo) Save(ctx context.Context, privacy *entity.FriendRequestPrivacy) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"allow_requests_from",
+				"findable_by_username",
+				"findable_by_email",
+				"findable_by_qr",
+				"allow_from_shared_groups",
+				"updated_at",
+			}),
+		}).
+		Create(&models.FriendRequestPrivacy{
+			AccountID:             privacy.AccountID,
+			AllowRequestsFrom:     privacy.AllowRequestsFrom.String(),
+			FindableByUsername:    privacy.FindableByUsername,
+			FindableByEmail:       privacy.FindableByEmail,
+			FindableByQR:          privacy.FindableByQR,
+			AllowFromSharedGroups: privacy.AllowFromSharedGroups,
+			UpdatedAt:             privacy.UpdatedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+
+	version, err := loadRelationOutboxAggregateVersion(r.db.WithContext(ctx), privacy.AccountID, friendRequestPrivacyAggregateType)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(r.outboxPublisher.Publish(ctx, eventpkg.Event{
+		AggregateID:   privacy.AccountID,
+		AggregateType: friendRequestPrivacyAggregateType,
+		Version:       version + 1,
+		EventName:     sharedevents.EventFriendRequestPrivacyUpdated,
+		EventData: &sharedevents.FriendRequestPrivacyUpdatedEvent{
+			AccountID:          privacy.AccountID,
+			FindableByUsername: privacy.FindableByUsername,
+			FindableByEmail:    privacy.FindableByEmail,
+			FindableByQR:       privacy.FindableByQR,
+			UpdatedAt:          privacy.UpdatedAt.UTC(),
+		},
+		CreatedAt: privacy.UpdatedAt.Unix(),
+	}))
+}
+
+func toFriendRequestPrivacyEntity(model *models.FriendRequestPrivacy) *entity.FriendRequestPrivacy {
+	return &entity.FriendRequestPrivacy{
+		AccountID:             model.AccountID,
+		AllowRequestsFrom:     entity.FriendRequestAudience(model.AllowRequestsFrom),
+		FindableByUsername:    model.FindableByUsername,
+		FindableByEmail:       model.FindableByEmail,
+		FindableByQR:          model.FindableByQR,
+		AllowFromSharedGroups: model.AllowFromSharedGroups,
+		UpdatedAt:             model.UpdatedAt,
+	}
+}
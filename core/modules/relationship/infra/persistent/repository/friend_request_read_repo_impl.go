@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"gorm.io/gorm"
+)
+
+// RelationshipFriendRequestReadRepo serves the add-me settings and request
+// sources from the relational tables; neither is part of the Cassandra
+// projection.
+type RelationshipFriendRequestReadRepo struct {
+	db          *gorm.DB
+	privacyRepo *friendRequestPrivacyRepo
+}
+
+func NewRelationshipFriendRequestReadRepo(db *gorm.DB) *RelationshipFriendRequestReadRepo {
+	return &RelationshipFriendRequestReadRepo{
+		db:          db,
+		privacyRepo: &friendRequestPrivacyRepo{db: db},
+	}
+}
+
+func (r *RelationshipFriendRequestReadRepo) GetFriendRequestPrivacy(ctx context.Context, accountID string) (*entity.FriendRequestPrivacy, error) {
+	privacy, err := r.privacyRepo.Get(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return privacy, nil
+}
+
+func (r *RelationshipFriendRequestReadRepo) ListPendingRequests(
+	ctx context.Context,
+	addresseeID string,
+	requesterIDs []string,
+) (map[string]*entity.FriendRequest, error) {
+	requests := make(map[string]*entity.FriendRequest, len(requesterIDs))
+	if len(requesterIDs) == 0 {
+		return requests, nil
+	}
+
+	var rows []models.FriendRequest
+	if err := r.db.WithContext(ctx).
+		Select("requester_id", "source", "shared_by_id").
+		Where("addressee_id = ? AND status = ? AND requester_id IN ?", addresseeID, models.FriendRequestStatusPending, requesterIDs).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	for _, row := range rows {
+		requests[row.RequesterID] = &entity.FriendRequest{
+			RequesterID: row.RequesterID,
+			AddresseeID: addresseeID,
+			Status:      entity.FriendRequestStatusPending,
+			Source:      entity.FriendRequestSource(row.Source),
+			SharedByID:  utils.StringValue(row.SharedByID),
+		}
+	}
+	return requests, nil
+}
@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// suggestionRefreshChunkSize bounds how many pairs go into one counting query.
+const suggestionRefreshChunkSize = 200
+
+type friendSuggestionRepo struct {
+	db *gorm.DB
+}
+
+func newFriendSuggestionRepo(db *gorm.DB) repos.FriendSuggestionRepository {
+	return &friendSuggestionRepo{db: db}
+}
+
+func (r *friendSuggestionRepo) ListFriendIDs(ctx context.Context, userID string) ([]string, error) {
+	var friendIDs []string
+	if err := r.db.WithContext(ctx).
+		Raw(`SELECT CASE WHEN user_low_id = ? THEN user_high_id ELSE user_low_id END
+			FROM relationship_friendships WHERE user_low_id = ? OR user_high_id = ?`, userID, userID, userID).
+		Scan(&friendIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return friendIDs, nil
+}
+
+func (r *friendSuggestionRepo) ListGroupRoomMembers(ctx context.Context, roomID string) ([]string, error) {
+	var accountIDs []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.SuggestionRoomMember{}).
+		Where("room_id = ?", roomID).
+		Pluck("account_id", &accountIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return accountIDs, nil
+}
+
+func (r *friendSuggestionRepo) ReplaceGroupRoomMembers(ctx context.Context, roomID string, accountIDs []string) error {
+	return stackErr.Error(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("room_id = ?", roomID).Delete(&models.SuggestionRoomMember{}).Error; err != nil {
+			return stackErr.Error(err)
+		}
+		if len(accountIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]models.SuggestionRoomMember, 0, len(accountIDs))
+		for _, accountID := range accountIDs {
+			rows = append(rows, models.SuggestionRoomMember{RoomID: roomID, AccountID: accountID})
+		}
+		return stackErr.Error(tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error)
+	}))
+}
+
+func (r *friendSuggestionRepo) RefreshPairs(ctx context.Context, pairs []entity.SuggestionPair, now time.Time) error {
+	pairs = uniqueSuggestionPairs(pairs)
+	for start := 0; start < len(pairs); start += suggestionRefreshChunkSize {
+		end := min(start+suggestionRefreshChunkSize, len(pairs))
+		if err := r.refreshChunk(ctx, pairs[start:end], now); err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	return nil
+}
+
+func (r *friendSuggestionRepo) refreshChunk(ctx context.Context, pairs []entity.SuggestionPair, now time.Time) error {
+	values, args := suggestionPairValues(pairs)
+
+	var counts []struct {
+		UserID            string `gorm:"column:user_id"`
+		CandidateID       string `gorm:"column:candidate_id"`
+		MutualFriendCount int    `gorm:"column:mutual_friend_count"`
+		SharedRoomCount   int    `gorm:"column:shared_room_count"`
+	}
+	query := `WITH pairs(user_id, candidate_id) AS (VALUES ` + values + `)
+		SELECT p.user_id, p.candidate_id,
+			(SELECT COUNT(*) FROM
+				(SELECT CASE WHEN f.user_low_id = p.user_id THEN f.user_high_id ELSE f.user_low_id END AS friend_id
+					FROM relationship_friendships f WHERE f.user_low_id = p.user_id OR f.user_high_id = p.user_id) a
+				JOIN
+				(SELECT CASE WHEN f.user_low_id = p.candidate_id THEN f.user_high_id ELSE f.user_low_id END AS friend_id
+					FROM relationship_friendships f WHERE f.user_low_id = p.candidate_id OR f.user_high_id = p.candidate_id) b
+				ON a.friend_id = b.friend_id) AS mutual_friend_count,
+			(SELECT COUNT(*) FROM relationship_suggestion_room_members a
+				JOIN relationship_suggestion_room_members b ON a.room_id = b.room_id
+				WHERE a.account_id = p.user_id AND b.account_id = p.candidate_id
+					AND (SELECT COUNT(*) FROM relationship_suggestion_room_members c WHERE c.room_id = a.room_id) <= ?) AS shared_room_count
+		FROM pairs p`
+	countArgs := append(append([]interface{}{}, args...), entity.MaxSuggestionGroupRoomSize)
+	if err := r.db.WithContext(ctx).Raw(query, countArgs...).Scan(&counts).Error; err != nil {
+		return stackErr.Error(err)
+	}
+
+	var existing []models.FriendSuggestion
+	if err := r.db.WithContext(ctx).
+		Where("(user_id, candidate_id) IN ("+values+")", args...).
+		Find(&existing).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	byPair := make(map[entity.SuggestionPair]*entity.FriendSuggestion, len(existing))
+	for i := range existing {
+		suggestion := toFriendSuggestionEntity(&existing[i])
+		byPair[entity.SuggestionPair{UserID: suggestion.UserID, CandidateID: suggestion.CandidateID}] = suggestion
+	}
+
+	for _, count := range counts {
+		pair := entity.SuggestionPair{UserID: count.UserID, CandidateID: count.CandidateID}
+		suggestion, ok := byPair[pair]
+		if !ok {
+			suggestion = entity.NewFriendSuggestion(pair.UserID, pair.CandidateID, now)
+		}
+		suggestion.MutualFriendCount = count.MutualFriendCount
+		suggestion.SharedRoomCount = count.SharedRoomCount
+		suggestion.Rescore(now)
+
+		if suggestion.IsEmpty() {
+			if ok {
+				if err := r.db.WithContext(ctx).
+					Where("user_id = ? AND candidate_id = ?", pair.UserID, pair.CandidateID).
+					Delete(&models.FriendSuggestion{}).Error; err != nil {
+					return stackErr.Error(err)
+				}
+			}
+			continue
+		}
+
+		if err := r.db.WithContext(ctx).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "candidate_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"mutual_friend_count", "shared_room_count", "score", "updated_at"}),
+			}).
+			Create(toFriendSuggestionModel(suggestion)).Error; err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	return nil
+}
+
+func (r *friendSuggestionRepo) RecordProfileView(ctx context.Context, viewerID, ownerID string, viewedAt time.Time) error {
+	viewedAt = viewedAt.UTC()
+	suggestion := entity.NewFriendSuggestion(viewerID, ownerID, viewedAt)
+	suggestion.RecordProfileView(viewedAt)
+	// Only a view newer than the last one counts, so a redelivered event does
+	// not bump the count twice. The score is rebuilt from the incremented
+	// count the way FriendSuggestion.Rescore builds it; the view is recent by
+	// definition, so its bonus always applies.
+	return stackErr.Error(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "candidate_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"profile_view_count": gorm.Expr("relationship_friend_suggestions.profile_view_count + 1"),
+				"score": gorm.Expr(`relationship_friend_suggestions.mutual_friend_count * ? +
+					relationship_friend_suggestions.shared_room_count * ? +
+					LEAST(relationship_friend_suggestions.profile_view_count + 1, ?) * ?`,
+					entity.SuggestionMutualFriendWeight, entity.SuggestionSharedRoomWeight,
+					entity.SuggestionMaxCountedViews, entity.SuggestionProfileViewWeight),
+				"last_profile_viewed_at": gorm.Expr("EXCLUDED.last_profile_viewed_at"),
+				"updated_at":             gorm.Expr("EXCLUDED.updated_at"),
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{clause.Expr{
+				SQL: "relationship_friend_suggestions.last_profile_viewed_at IS NULL OR relationship_friend_suggestions.last_profile_viewed_at < EXCLUDED.last_profile_viewed_at",
+			}}},
+		}).
+		Create(toFriendSuggestionModel(suggestion)).Error)
+}
+
+func (r *friendSuggestionRepo) ExpireProfileViews(ctx context.Context, viewedBefore, now time.Time, limit int) (int, error) {
+	expired := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`UPDATE relationship_friend_suggestions s
+			SET profile_view_count = 0,
+				score = s.mutual_friend_count * ? + s.shared_room_count * ?,
+				updated_at = ?
+			FROM (SELECT user_id, candidate_id FROM relationship_friend_suggestions
+				WHERE profile_view_count > 0 AND last_profile_viewed_at < ?
+				ORDER BY last_profile_viewed_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED) stale
+			WHERE s.user_id = stale.user_id AND s.candidate_id = stale.candidate_id`,
+			entity.SuggestionMutualFriendWeight, entity.SuggestionSharedRoomWeight, now, viewedBefore, limit)
+		if result.Error != nil {
+			return stackErr.Error(result.Error)
+		}
+		expired = int(result.RowsAffected)
+		if expired == 0 {
+			return nil
+		}
+		return stackErr.Error(tx.
+			Where("mutual_friend_count = 0 AND shared_room_count = 0 AND profile_view_count = 0 AND last_profile_viewed_at < ?", viewedBefore).
+			Delete(&models.FriendSuggestion{}).Error)
+	})
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	return expired, nil
+}
+
+func (r *friendSuggestionRepo) Dismiss(ctx context.Context, userID, candidateID string, dismissedAt time.Time) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "candidate_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"dismissed_at"}),
+		}).
+		Create(&models.FriendSuggestionDismissal{
+			UserID:      userID,
+			CandidateID: candidateID,
+			DismissedAt: dismissedAt.UTC(),
+		}).Error)
+}
+
+func uniqueSuggestionPairs(pairs []entity.SuggestionPair) []entity.SuggestionPair {
+	seen := make(map[entity.SuggestionPair]struct{}, len(pairs))
+	unique := make([]entity.SuggestionPair, 0, len(pairs))
+	for _, pair := range pairs {
+		pair.UserID = strings.TrimSpace(pair.UserID)
+		pair.CandidateID = strings.TrimSpace(pair.CandidateID)
+		if pair.UserID == "" || pair.CandidateID == "" || pair.UserID == pair.CandidateID {
+			continue
+		}
+		if _, ok := seen[pair]; ok {
+			continue
+		}
+		seen[pair] = struct{}{}
+		unique = append(unique, pair)
+	}
+	return unique
+}
+
+func suggestionPairValues(pairs []entity.SuggestionPair) (string, []interface{}) {
+	placeholders := make([]string, 0, len(pairs))
+	args := make([]interface{}, 0, len(pairs)*2)
+	for _, pair := range pairs {
+		placeholders = append(placeholders, "(?::varchar, ?::varchar)")
+		args = append(args, pair.UserID, pair.CandidateID)
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+func toFriendSuggestionModel(suggestion *entity.FriendSuggestion) *models.FriendSuggestion {
+	return &models.FriendSuggestion{
+		UserID:              suggestion.UserID,
+		CandidateID:         suggestion.CandidateID,
+		MutualFriendCount:   suggestion.MutualFriendCount,
+		SharedRoomCount:     suggestion.SharedRoomCount,
+		ProfileViewCount:    suggestion.ProfileViewCount,
+		LastProfileViewedAt: suggestion.LastProfileViewedAt,
+		Score:               suggestion.Score,
+		UpdatedAt:           suggestion.UpdatedAt,
+	}
+}
+
+func toFriendSuggestionEntity(model *models.FriendSuggestion) *entity.FriendSuggestion {
+	return &entity.FriendSuggestion{
+		UserID:              model.UserID,
+		CandidateID:         model.CandidateID,
+		MutualFriendCount:   model.MutualFriendCount,
+		SharedRoomCount:     model.SharedRoomCount,
+		ProfileViewCount:    model.ProfileViewCount,
+		LastProfileViewedAt: model.LastProfileViewedAt,
+		Score:               model.Score,
+		UpdatedAt:           model.UpdatedAt,
+	}
+}
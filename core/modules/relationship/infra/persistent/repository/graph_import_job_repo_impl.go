@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/domain/repos"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+const graphImportRowInsertBatchSize = 500
+
+type graphImportJobRepo struct {
+	db *gorm.DB
+}
+
+func newGraphImportJobRepo(db *gorm.DB) repos.GraphImportJobRepository {
+	return &graphImportJobRepo{db: db}
+}
+
+func (r *graphImportJobRepo) Create(ctx context.Context, job *entity.GraphImportJob, rows []entity.GraphImportRow) error {
+	if job == nil {
+		return stackErr.Error(errors.New("graph import job is required"))
+	}
+	if err := r.db.WithContext(ctx).Create(toGraphImportJobModel(job)).Error; err != nil {
+		return stackErr.Error(err)
+	}
+
+	rowModels := make([]models.GraphImportRow, 0, len(rows))
+	for _, row := range rows {
+		row.JobID = job.ID
+		rowModels = append(rowModels, toGraphImportRowModel(row))
+	}
+	if len(rowModels) == 0 {
+		return nil
+	}
+	return stackErr.Error(r.db.WithContext(ctx).CreateInBatches(rowModels, graphImportRowInsertBatchSize).Error)
+}
+
+func (r *graphImportJobRepo) ListRunnable(ctx context.Context, limit int) ([]*entity.GraphImportJob, error) {
+	var rows []models.GraphImportJob
+	if err := r.db.WithContext(ctx).
+		Where("status IN ?", []string{
+			entity.GraphImportJobStatusPending.String(),
+			entity.GraphImportJobStatusRunning.String(),
+		}).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	jobs := make([]*entity.GraphImportJob, 0, len(rows))
+	for idx := range rows {
+		jobs = append(jobs, toGraphImportJobEntity(&rows[idx]))
+	}
+	return jobs, nil
+}
+
+func (r *graphImportJobRepo) ListRows(ctx context.Context, jobID string, fromRow, limit int) ([]entity.GraphImportRow, error) {
+	var rows []models.GraphImportRow
+	if err := r.db.WithContext(ctx).
+		Where("job_id = ? AND row_number >= ?", jobID, fromRow).
+		Order("row_number ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	results := make([]entity.GraphImportRow, 0, len(rows))
+	for idx := range rows {
+		results = append(results, toGraphImportRowEntity(&rows[idx]))
+	}
+	return results, nil
+}
+
+func (r *graphImportJobRepo) SaveRowResult(ctx context.Context, job *entity.GraphImportJob, row entity.GraphImportRow) error {
+	if job == nil {
+		return stackErr.Error(errors.New("graph import job is required"))
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&models.GraphImportRow{}).
+		Where("job_id = ? AND row_number = ?", job.ID, row.RowNumber).
+		Updates(map[string]interface{}{
+			"status": row.Status.String(),
+			"error":  row.Error,
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(r.db.WithContext(ctx).Save(toGraphImportJobModel(job)).Error)
+}
+
+func toGraphImportJobModel(job *entity.GraphImportJob) *models.GraphImportJob {
+	return &models.GraphImportJob{
+		ID:           job.ID,
+		RequestedBy:  job.RequestedBy,
+		Format:       job.Format.String(),
+		Status:       job.Status.String(),
+		TotalRows:    job.TotalRows,
+		NextRow:      job.NextRow,
+		ImportedRows: job.ImportedRows,
+		SkippedRows:  job.SkippedRows,
+		FailedRows:   job.FailedRows,
+		CreatedAt:    job.CreatedAt,
+		UpdatedAt:    job.UpdatedAt,
+		StartedAt:    job.StartedAt,
+		CompletedAt:  job.CompletedAt,
+	}
+}
+
+func toGraphImportJobEntity(model *models.GraphImportJob) *entity.GraphImportJob {
+	return &entity.GraphImportJob{
+		ID:           model.ID,
+		RequestedBy:  model.RequestedBy,
+		Format:       entity.GraphFormat(model.Format),
+		Status:       entity.GraphImportJobStatus(model.Status),
+		TotalRows:    model.TotalRows,
+		NextRow:      model.NextRow,
+		ImportedRows: model.ImportedRows,
+		SkippedRows:  model.SkippedRows,
+		FailedRows:   model.FailedRows,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		StartedAt:    model.StartedAt,
+		CompletedAt:  model.CompletedAt,
+	}
+}
+
+func toGraphImportRowModel(row entity.GraphImportRow) models.GraphImportRow {
+	return models.GraphImportRow{
+		JobID:     row.JobID,
+		RowNumber: row.RowNumber,
+		UserID:    row.Edge.UserID,
+		TargetID:  row.Edge.TargetID,
+		Relation:  row.Edge.Relation.String(),
+		Status:    row.Status.String(),
+		Error:     row.Error,
+	}
+}
+
+func toGraphImportRowEntity(model *models.GraphImportRow) entity.GraphImportRow {
+	return entity.GraphImportRow{
+		JobID:     model.JobID,
+		RowNumber: model.RowNumber,
+		Edge: entity.GraphEdge{
+			UserID:   model.UserID,
+			TargetID: model.TargetID,
+			Relation: entity.GraphRelation(model.Relation),
+		},
+		Status: entity.GraphImportRowStatus(model.Status),
+		Error:  model.Error,
+	}
+}
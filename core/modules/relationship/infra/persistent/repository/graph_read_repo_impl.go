@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/relationship/domain"
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+// RelationshipGraphReadRepo serves graph exports and import reports from the
+// relational tables, which hold every edge and are what imports write to.
+type RelationshipGraphReadRepo struct {
+	db *gorm.DB
+}
+
+func NewRelationshipGraphReadRepo(db *gorm.DB) *RelationshipGraphReadRepo {
+	return &RelationshipGraphReadRepo{db: db}
+}
+
+func (r *RelationshipGraphReadRepo) ListAccountEdges(ctx context.Context, accountID string) ([]entity.GraphEdge, error) {
+	edges := make([]entity.GraphEdge, 0)
+
+	var friendships []models.Friendship
+	if err := r.db.WithContext(ctx).
+		Where("user_low_id = ? OR user_high_id = ?", accountID, accountID).
+		Order("created_at ASC").
+		Find(&friendships).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	for _, friendship := range friendships {
+		friendID := friendship.UserHighID
+		if friendID == accountID {
+			friendID = friendship.UserLowID
+		}
+		edges = append(edges, entity.GraphEdge{UserID: accountID, TargetID: friendID, Relation: entity.GraphRelationFriend})
+	}
+
+	var follows []models.FollowRelation
+	if err := r.db.WithContext(ctx).
+		Where("follower_id = ? OR followee_id = ?", accountID, accountID).
+		Order("created_at ASC").
+		Find(&follows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	for _, follow := range follows {
+		edges = append(edges, entity.GraphEdge{UserID: follow.FollowerID, TargetID: follow.FolloweeID, Relation: entity.GraphRelationFollow})
+	}
+
+	var blocks []models.BlockRelation
+	if err := r.db.WithContext(ctx).
+		Where("blocker_id = ?", accountID).
+		Order("created_at ASC").
+		Find(&blocks).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	for _, block := range blocks {
+		edges = append(edges, entity.GraphEdge{UserID: block.BlockerID, TargetID: block.BlockedID, Relation: entity.GraphRelationBlock})
+	}
+
+	return edges, nil
+}
+
+func (r *RelationshipGraphReadRepo) GetImportJob(ctx context.Context, jobID string) (*entity.GraphImportJob, error) {
+	var model models.GraphImportJob
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", jobID).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(domain.ErrGraphImportJobNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toGraphImportJobEntity(&model), nil
+}
+
+func (r *RelationshipGraphReadRepo) ListImportRows(
+	ctx context.Context,
+	jobID string,
+	status entity.GraphImportRowStatus,
+	afterRow int,
+	limit int,
+) ([]entity.GraphImportRow, error) {
+	query := r.db.WithContext(ctx).
+		Where("job_id = ? AND row_number > ?", jobID, afterRow)
+	if status != "" {
+		query = query.Where("status = ?", status.String())
+	}
+
+	var rows []models.GraphImportRow
+	if err := query.
+		Order("row_number ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	results := make([]entity.GraphImportRow, 0, len(rows))
+	for idx := range rows {
+		results = append(results, toGraphImportRowEntity(&rows[idx]))
+	}
+	return results, nil
+}
@@ -22,11 +22,13 @@ type relationshipPairAggregateRepo struct {
 	outboxPublisher             eventpkg.Publisher
 	friendRequestAggregateRepo  repos.FriendRequestAggregateRepository
 	friendshipRepo              friendshipStore
+	friendContactRepo           friendContactStore
 	followRelationRepo          followRelationStore
 	blockRelationRepo           blockRelationStore
 	userRelationshipCounterRepo userRelationshipCounterStore
 	accountProjectionRepo       relationshipAccountStore
 	relationshipPairGuardRepo   relationshipPairGuardStore
+	friendRequestPrivacyRepo    repos.FriendRequestPrivacyRepository
 }
 
 func newRelationshipPairAggregateRepo(db *gorm.DB) repos.RelationshipPairAggregateRepository {
@@ -40,11 +42,13 @@ func newRelationshipPairAggregateRepo(db *gorm.DB) repos.RelationshipPairAggrega
 		}),
 		friendRequestAggregateRepo:  newFriendRequestAggregateRepo(db),
 		friendshipRepo:              newFriendshipRepo(db),
+		friendContactRepo:           newFriendContactAggregateRepo(db),
 		followRelationRepo:          newFollowRelationRepo(db),
 		blockRelationRepo:           newBlockRelationRepo(db),
 		userRelationshipCounterRepo: newUserRelationshipCounterRepo(db),
 		accountProjectionRepo:       newRelationshipAccountRepo(db),
 		relationshipPairGuardRepo:   newRelationshipPairGuardRepo(db),
+		friendRequestPrivacyRepo:    newFriendRequestPrivacyRepo(db),
 	}
 }
 
@@ -104,6 +108,9 @@ func (r *relationshipPairAggregateRepo) LoadForUpdate(ctx context.Context, actor
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	if err := r.loadTargetPrivacy(ctx, &snapshot); err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	agg, err := aggregate.NewRelationshipPair(snapshot)
 	if err != nil {
@@ -190,6 +197,9 @@ func (r *relationshipPairAggregateRepo) persistFriendship(
 		if !deleted {
 			return stackErr.Error(domain.ErrFriendshipNotFound)
 		}
+		if err := r.friendContactRepo.DeleteBetween(ctx, agg.ActorID(), agg.TargetID()); err != nil {
+			return stackErr.Error(err)
+		}
 	}
 	return nil
 }
@@ -276,6 +286,70 @@ func (r *relationshipPairAggregateRepo) loadFriendshipBetween(ctx context.Contex
 	}, nil
 }
 
+// loadTargetPrivacy loads the target's add-me settings and only runs the
+// mutual friend and shared room lookups the settings can actually consult.
+func (r *relationshipPairAggregateRepo) loadTargetPrivacy(ctx context.Context, snapshot *aggregate.RelationshipPairSnapshot) error {
+	if !snapshot.TargetExists || snapshot.ActorID == snapshot.TargetID {
+		return nil
+	}
+
+	privacy, err := r.friendRequestPrivacyRepo.Get(ctx, snapshot.TargetID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	snapshot.TargetPrivacy = privacy
+	if privacy.AllowRequestsFrom == entity.FriendRequestAudienceNobody {
+		return nil
+	}
+
+	if privacy.AllowRequestsFrom == entity.FriendRequestAudienceFriendsOfFriends {
+		snapshot.SharesFriend, err = r.sharesFriend(ctx, snapshot.ActorID, snapshot.TargetID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	if privacy.AllowFromSharedGroups {
+		snapshot.SharesGroupRoom, err = r.sharesGroupRoom(ctx, snapshot.ActorID, snapshot.TargetID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	return nil
+}
+
+func (r *relationshipPairAggregateRepo) sharesFriend(ctx context.Context, userA, userB string) (bool, error) {
+	var exists bool
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT EXISTS (
+			SELECT 1 FROM
+				(SELECT CASE WHEN user_low_id = ? THEN user_high_id ELSE user_low_id END AS friend_id
+					FROM relationship_friendships WHERE user_low_id = ? OR user_high_id = ?) a
+			JOIN
+				(SELECT CASE WHEN user_low_id = ? THEN user_high_id ELSE user_low_id END AS friend_id
+					FROM relationship_friendships WHERE user_low_id = ? OR user_high_id = ?) b
+			ON a.friend_id = b.friend_id)`,
+			userA, userA, userA, userB, userB, userB).
+		Scan(&exists).Error
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return exists, nil
+}
+
+func (r *relationshipPairAggregateRepo) sharesGroupRoom(ctx context.Context, userA, userB string) (bool, error) {
+	var exists bool
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT EXISTS (
+			SELECT 1 FROM relationship_suggestion_room_members a
+			JOIN relationship_suggestion_room_members b ON a.room_id = b.room_id
+			WHERE a.account_id = ? AND b.account_id = ?)`, userA, userB).
+		Scan(&exists).Error
+	if err != nil {
+		return false, stackErr.Error(err)
+	}
+	return exists, nil
+}
+
 func (r *relationshipPairAggregateRepo) loadFollow(ctx context.Context, followerID, followeeID string) (*entity.FollowRelation, error) {
 	var model models.FollowRelation
 	err := r.db.WithContext(ctx).
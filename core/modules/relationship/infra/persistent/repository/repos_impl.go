@@ -19,6 +19,11 @@ type repoImpl struct {
 
 	relationshipPairAggregateRepo repos.RelationshipPairAggregateRepository
 	friendRequestAggregateRepo    repos.FriendRequestAggregateRepository
+	friendContactAggregateRepo    repos.FriendContactAggregateRepository
+	contactTagRepo                repos.ContactTagRepository
+	friendSuggestionRepo          repos.FriendSuggestionRepository
+	friendRequestPrivacyRepo      repos.FriendRequestPrivacyRepository
+	graphImportJobRepo            repos.GraphImportJobRepository
 }
 
 func NewRepoImpl(appCtx *appCtx.AppContext) repos.Repos {
@@ -31,6 +36,11 @@ func newRepoImplWithDB(appCtx *appCtx.AppContext, db *gorm.DB) repos.Repos {
 		db:                            db,
 		relationshipPairAggregateRepo: newRelationshipPairAggregateRepo(db),
 		friendRequestAggregateRepo:    newFriendRequestAggregateRepo(db),
+		friendContactAggregateRepo:    newFriendContactAggregateRepo(db),
+		contactTagRepo:                newContactTagRepo(db),
+		friendSuggestionRepo:          newFriendSuggestionRepo(db),
+		friendRequestPrivacyRepo:      newFriendRequestPrivacyRepo(db),
+		graphImportJobRepo:            newGraphImportJobRepo(db),
 	}
 }
 
@@ -42,6 +52,26 @@ func (r *repoImpl) FriendRequestAggregateRepository() repos.FriendRequestAggrega
 	return r.friendRequestAggregateRepo
 }
 
+func (r *repoImpl) FriendContactAggregateRepository() repos.FriendContactAggregateRepository {
+	return r.friendContactAggregateRepo
+}
+
+func (r *repoImpl) ContactTagRepository() repos.ContactTagRepository {
+	return r.contactTagRepo
+}
+
+func (r *repoImpl) FriendSuggestionRepository() repos.FriendSuggestionRepository {
+	return r.friendSuggestionRepo
+}
+
+func (r *repoImpl) FriendRequestPrivacyRepository() repos.FriendRequestPrivacyRepository {
+	return r.friendRequestPrivacyRepo
+}
+
+func (r *repoImpl) GraphImportJobRepository() repos.GraphImportJobRepository {
+	return r.graphImportJobRepo
+}
+
 func (r *repoImpl) WithTransaction(ctx context.Context, fn func(repos.Repos) error) (err error) {
 	log := logging.FromContext(ctx).Named("RelationshipTransaction")
 	tx := r.db.WithContext(ctx).Begin()
@@ -12,6 +12,10 @@ type friendshipStore interface {
 	DeleteBetween(ctx context.Context, userA, userB string) (bool, error)
 }
 
+type friendContactStore interface {
+	DeleteBetween(ctx context.Context, userA, userB string) error
+}
+
 type followRelationStore interface {
 	Exists(ctx context.Context, followerID, followeeID string) (bool, error)
 	Create(ctx context.Context, relation *entity.FollowRelation) error
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/relationship/domain/entity"
+	"wechat-clone/core/modules/relationship/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+// RelationshipSuggestionReadRepo ranks the precomputed suggestion rows and
+// filters out anyone the user is already connected to or has dismissed. The
+// stored score already carries the profile view bonus, so the ranking walks
+// idx_friend_suggestions_user_score instead of sorting every row.
+type RelationshipSuggestionReadRepo struct {
+	db *gorm.DB
+}
+
+func NewRelationshipSuggestionReadRepo(db *gorm.DB) *RelationshipSuggestionReadRepo {
+	return &RelationshipSuggestionReadRepo{db: db}
+}
+
+func (r *RelationshipSuggestionReadRepo) ListSuggestions(ctx context.Context, userID string, offset, limit int) ([]*entity.FriendSuggestion, error) {
+	var rows []models.FriendSuggestion
+	err := r.db.WithContext(ctx).
+		Table("relationship_friend_suggestions s").
+		Select("s.*").
+		Where("s.user_id = ?", userID).
+		Where(`NOT EXISTS (SELECT 1 FROM relationship_friendships f
+			WHERE (f.user_low_id = s.user_id AND f.user_high_id = s.candidate_id)
+			OR (f.user_low_id = s.candidate_id AND f.user_high_id = s.user_id))`).
+		Where(`NOT EXISTS (SELECT 1 FROM relationship_blocks b
+			WHERE (b.blocker_id = s.user_id AND b.blocked_id = s.candidate_id)
+			OR (b.blocker_id = s.candidate_id AND b.blocked_id = s.user_id))`).
+		Where(`NOT EXISTS (SELECT 1 FROM relationship_friend_requests fr
+			WHERE fr.status = ?
+			AND ((fr.requester_id = s.user_id AND fr.addressee_id = s.candidate_id)
+			OR (fr.requester_id = s.candidate_id AND fr.addressee_id = s.user_id)))`, models.FriendRequestStatusPending).
+		Where(`NOT EXISTS (SELECT 1 FROM relationship_friend_suggestion_dismissals d
+			WHERE d.user_id = s.user_id AND d.candidate_id = s.candidate_id)`).
+		Order("s.score DESC, s.candidate_id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := make([]*entity.FriendSuggestion, 0, len(rows))
+	for i := range rows {
+		items = append(items, toFriendSuggestionEntity(&rows[i]))
+	}
+	return items, nil
+}
@@ -3,10 +3,16 @@ package support
 import (
 	"context"
 	"errors"
+	"net/http"
+
+	accountsupport "wechat-clone/core/modules/account/application/support"
 	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/apperr"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
+var ErrAdminRequired = apperr.New("relationship.admin_required", "admin role is required", http.StatusForbidden)
+
 func ActorFromCtx(ctx context.Context) (*actorctx.Actor, error) {
 	actor, ok := actorctx.FromContext(ctx)
 	if !ok || actor == nil {
@@ -22,3 +28,20 @@ func AccountIDFromCtx(ctx context.Context) (string, error) {
 	}
 	return actor.AccountID, nil
 }
+
+// AdminFromAccounts checks the admin claim of the token against the account
+// record, so a demoted admin's token stops working before it expires.
+func AdminFromAccounts(ctx context.Context, admins accountsupport.AdminRoles) (*actorctx.Actor, error) {
+	actor, err := ActorFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	isAdmin, err := accountsupport.ActorIsAdmin(ctx, actor, admins)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !isAdmin {
+		return nil, stackErr.Error(ErrAdminRequired)
+	}
+	return actor, nil
+}
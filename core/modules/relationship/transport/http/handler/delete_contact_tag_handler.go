@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type deleteContactTagHandler struct {
+	deleteContactTag cqrs.Dispatcher[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse]
+}
+
+func NewDeleteContactTagHandler(
+	deleteContactTag cqrs.Dispatcher[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse],
+) *deleteContactTagHandler {
+	return &deleteContactTagHandler{
+		deleteContactTag: deleteContactTag,
+	}
+}
+
+func (h *deleteContactTagHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.DeleteContactTagRequest
+	request.TagID = c.Param("tag_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.deleteContactTag.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("DeleteContactTag failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type getFriendRequestPrivacyHandler struct {
+	getFriendRequestPrivacy cqrs.Dispatcher[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse]
+}
+
+func NewGetFriendRequestPrivacyHandler(
+	getFriendRequestPrivacy cqrs.Dispatcher[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse],
+) *getFriendRequestPrivacyHandler {
+	return &getFriendRequestPrivacyHandler{
+		getFriendRequestPrivacy: getFriendRequestPrivacy,
+	}
+}
+
+func (h *getFriendRequestPrivacyHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.GetFriendRequestPrivacyRequest
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.getFriendRequestPrivacy.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("GetFriendRequestPrivacy failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type listContactTagsHandler struct {
+	listContactTags cqrs.Dispatcher[*in.ListContactTagsRequest, *out.ListContactTagsResponse]
+}
+
+func NewListContactTagsHandler(
+	listContactTags cqrs.Dispatcher[*in.ListContactTagsRequest, *out.ListContactTagsResponse],
+) *listContactTagsHandler {
+	return &listContactTagsHandler{
+		listContactTags: listContactTags,
+	}
+}
+
+func (h *listContactTagsHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.ListContactTagsRequest
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.listContactTags.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("ListContactTags failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type listFriendSuggestionsHandler struct {
+	listFriendSuggestions cqrs.Dispatcher[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse]
+}
+
+func NewListFriendSuggestionsHandler(
+	listFriendSuggestions cqrs.Dispatcher[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse],
+) *listFriendSuggestionsHandler {
+	return &listFriendSuggestionsHandler{
+		listFriendSuggestions: listFriendSuggestions,
+	}
+}
+
+func (h *listFriendSuggestionsHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.ListFriendSuggestionsRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.listFriendSuggestions.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("ListFriendSuggestions failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
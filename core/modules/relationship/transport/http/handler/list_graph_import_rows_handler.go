@@ -0,0 +1,52 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type listGraphImportRowsHandler struct {
+	listGraphImportRows cqrs.Dispatcher[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse]
+}
+
+func NewListGraphImportRowsHandler(
+	listGraphImportRows cqrs.Dispatcher[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse],
+) *listGraphImportRowsHandler {
+	return &listGraphImportRowsHandler{
+		listGraphImportRows: listGraphImportRows,
+	}
+}
+
+func (h *listGraphImportRowsHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.ListGraphImportRowsRequest
+	request.JobID = c.Param("job_id")
+	if err := c.ShouldBindQuery(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.listGraphImportRows.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("ListGraphImportRows failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/relationship/application/dto/in"
+	"wechat-clone/core/modules/relationship/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type setFriendAudienceListHandler struct {
+	setFriendAudienceList cqrs.Dispatcher[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse]
+}
+
+func NewSetFriendAudienceListHandler(
+	setFriendAudienceList cqrs.Dispatcher[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse],
+) *setFriendAudienceListHandler {
+	return &setFriendAudienceListHandler{
+		setFriendAudienceList: setFriendAudienceList,
+	}
+}
+
+func (h *setFriendAudienceListHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.SetFriendAudienceListRequest
+	request.TargetUserID = c.Param("target_user_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.setFriendAudienceList.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("SetFriendAudienceList failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
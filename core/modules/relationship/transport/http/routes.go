@@ -32,6 +32,23 @@ func RegisterPrivateRoutes(
 	getRelationshipStatus cqrs.Dispatcher[*in.GetRelationshipStatusRequest, *out.GetRelationshipStatusResponse],
 	getMutualFriends cqrs.Dispatcher[*in.GetMutualFriendsRequest, *out.GetMutualFriendsResponse],
 	getRelationshipSummary cqrs.Dispatcher[*in.GetRelationshipSummaryRequest, *out.GetRelationshipSummaryResponse],
+	getFriendRemark cqrs.Dispatcher[*in.GetFriendRemarkRequest, *out.FriendRemarkResponse],
+	updateFriendRemark cqrs.Dispatcher[*in.UpdateFriendRemarkRequest, *out.FriendRemarkResponse],
+	setFriendTags cqrs.Dispatcher[*in.SetFriendTagsRequest, *out.FriendRemarkResponse],
+	createContactTag cqrs.Dispatcher[*in.CreateContactTagRequest, *out.ContactTagResponse],
+	listContactTags cqrs.Dispatcher[*in.ListContactTagsRequest, *out.ListContactTagsResponse],
+	updateContactTag cqrs.Dispatcher[*in.UpdateContactTagRequest, *out.ContactTagResponse],
+	deleteContactTag cqrs.Dispatcher[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse],
+	listFriendSuggestions cqrs.Dispatcher[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse],
+	dismissFriendSuggestion cqrs.Dispatcher[*in.DismissFriendSuggestionRequest, *out.DismissFriendSuggestionResponse],
+	getFriendRequestPrivacy cqrs.Dispatcher[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse],
+	updateFriendRequestPrivacy cqrs.Dispatcher[*in.UpdateFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse],
+	setFriendAudienceList cqrs.Dispatcher[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse],
+	listAudienceListMembers cqrs.Dispatcher[*in.ListAudienceListMembersRequest, *out.ListAudienceListMembersResponse],
+	createGraphImport cqrs.Dispatcher[*in.CreateGraphImportRequest, *out.GraphImportJobResponse],
+	getGraphImport cqrs.Dispatcher[*in.GetGraphImportRequest, *out.GraphImportJobResponse],
+	listGraphImportRows cqrs.Dispatcher[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse],
+	exportRelationshipGraph cqrs.Dispatcher[*in.ExportRelationshipGraphRequest, *out.ExportRelationshipGraphResponse],
 ) {
 	routes.POST("/relationship/friend-requests", httpx.Wrap(handler.NewSendFriendRequestHandler(sendFriendRequest)))
 	routes.DELETE("/relationship/friend-requests/:target_user_id", httpx.Wrap(handler.NewCancelFriendRequestHandler(cancelFriendRequest)))
@@ -51,4 +68,21 @@ func RegisterPrivateRoutes(
 	routes.GET("/relationship/status/:target_user_id", httpx.Wrap(handler.NewGetRelationshipStatusHandler(getRelationshipStatus)))
 	routes.GET("/relationship/mutual-friends/:target_user_id", httpx.Wrap(handler.NewGetMutualFriendsHandler(getMutualFriends)))
 	routes.GET("/relationship/summary/:target_user_id", httpx.Wrap(handler.NewGetRelationshipSummaryHandler(getRelationshipSummary)))
+	routes.GET("/relationship/friends/:target_user_id/remark", httpx.Wrap(handler.NewGetFriendRemarkHandler(getFriendRemark)))
+	routes.PUT("/relationship/friends/:target_user_id/remark", httpx.Wrap(handler.NewUpdateFriendRemarkHandler(updateFriendRemark)))
+	routes.PUT("/relationship/friends/:target_user_id/tags", httpx.Wrap(handler.NewSetFriendTagsHandler(setFriendTags)))
+	routes.POST("/relationship/tags", httpx.Wrap(handler.NewCreateContactTagHandler(createContactTag)))
+	routes.GET("/relationship/tags", httpx.Wrap(handler.NewListContactTagsHandler(listContactTags)))
+	routes.PATCH("/relationship/tags/:tag_id", httpx.Wrap(handler.NewUpdateContactTagHandler(updateContactTag)))
+	routes.DELETE("/relationship/tags/:tag_id", httpx.Wrap(handler.NewDeleteContactTagHandler(deleteContactTag)))
+	routes.GET("/relationship/suggestions", httpx.Wrap(handler.NewListFriendSuggestionsHandler(listFriendSuggestions)))
+	routes.POST("/relationship/suggestions/:target_user_id/dismiss", httpx.Wrap(handler.NewDismissFriendSuggestionHandler(dismissFriendSuggestion)))
+	routes.GET("/relationship/privacy", httpx.Wrap(handler.NewGetFriendRequestPrivacyHandler(getFriendRequestPrivacy)))
+	routes.PUT("/relationship/privacy", httpx.Wrap(handler.NewUpdateFriendRequestPrivacyHandler(updateFriendRequestPrivacy)))
+	routes.PUT("/relationship/friends/:target_user_id/audience-list", httpx.Wrap(handler.NewSetFriendAudienceListHandler(setFriendAudienceList)))
+	routes.GET("/relationship/audience-lists/:audience_list/members", httpx.Wrap(handler.NewListAudienceListMembersHandler(listAudienceListMembers)))
+	routes.POST("/relationship/admin/graph-imports", httpx.Wrap(handler.NewCreateGraphImportHandler(createGraphImport)))
+	routes.GET("/relationship/admin/graph-imports/:job_id", httpx.Wrap(handler.NewGetGraphImportHandler(getGraphImport)))
+	routes.GET("/relationship/admin/graph-imports/:job_id/rows", httpx.Wrap(handler.NewListGraphImportRowsHandler(listGraphImportRows)))
+	routes.GET("/relationship/graph/export", httpx.Wrap(handler.NewExportRelationshipGraphHandler(exportRelationshipGraph)))
 }
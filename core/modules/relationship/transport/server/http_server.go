@@ -32,6 +32,23 @@ type relationshipHTTPServer struct {
 	getRelationshipStatus      cqrs.Dispatcher[*in.GetRelationshipStatusRequest, *out.GetRelationshipStatusResponse]
 	getMutualFriends           cqrs.Dispatcher[*in.GetMutualFriendsRequest, *out.GetMutualFriendsResponse]
 	getRelationshipSummary     cqrs.Dispatcher[*in.GetRelationshipSummaryRequest, *out.GetRelationshipSummaryResponse]
+	getFriendRemark            cqrs.Dispatcher[*in.GetFriendRemarkRequest, *out.FriendRemarkResponse]
+	updateFriendRemark         cqrs.Dispatcher[*in.UpdateFriendRemarkRequest, *out.FriendRemarkResponse]
+	setFriendTags              cqrs.Dispatcher[*in.SetFriendTagsRequest, *out.FriendRemarkResponse]
+	createContactTag           cqrs.Dispatcher[*in.CreateContactTagRequest, *out.ContactTagResponse]
+	listContactTags            cqrs.Dispatcher[*in.ListContactTagsRequest, *out.ListContactTagsResponse]
+	updateContactTag           cqrs.Dispatcher[*in.UpdateContactTagRequest, *out.ContactTagResponse]
+	deleteContactTag           cqrs.Dispatcher[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse]
+	listFriendSuggestions      cqrs.Dispatcher[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse]
+	dismissFriendSuggestion    cqrs.Dispatcher[*in.DismissFriendSuggestionRequest, *out.DismissFriendSuggestionResponse]
+	getFriendRequestPrivacy    cqrs.Dispatcher[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse]
+	updateFriendRequestPrivacy cqrs.Dispatcher[*in.UpdateFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse]
+	setFriendAudienceList      cqrs.Dispatcher[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse]
+	listAudienceListMembers    cqrs.Dispatcher[*in.ListAudienceListMembersRequest, *out.ListAudienceListMembersResponse]
+	createGraphImport          cqrs.Dispatcher[*in.CreateGraphImportRequest, *out.GraphImportJobResponse]
+	getGraphImport             cqrs.Dispatcher[*in.GetGraphImportRequest, *out.GraphImportJobResponse]
+	listGraphImportRows        cqrs.Dispatcher[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse]
+	exportRelationshipGraph    cqrs.Dispatcher[*in.ExportRelationshipGraphRequest, *out.ExportRelationshipGraphResponse]
 }
 
 func NewHTTPServer(
@@ -53,6 +70,23 @@ func NewHTTPServer(
 	getRelationshipStatus cqrs.Dispatcher[*in.GetRelationshipStatusRequest, *out.GetRelationshipStatusResponse],
 	getMutualFriends cqrs.Dispatcher[*in.GetMutualFriendsRequest, *out.GetMutualFriendsResponse],
 	getRelationshipSummary cqrs.Dispatcher[*in.GetRelationshipSummaryRequest, *out.GetRelationshipSummaryResponse],
+	getFriendRemark cqrs.Dispatcher[*in.GetFriendRemarkRequest, *out.FriendRemarkResponse],
+	updateFriendRemark cqrs.Dispatcher[*in.UpdateFriendRemarkRequest, *out.FriendRemarkResponse],
+	setFriendTags cqrs.Dispatcher[*in.SetFriendTagsRequest, *out.FriendRemarkResponse],
+	createContactTag cqrs.Dispatcher[*in.CreateContactTagRequest, *out.ContactTagResponse],
+	listContactTags cqrs.Dispatcher[*in.ListContactTagsRequest, *out.ListContactTagsResponse],
+	updateContactTag cqrs.Dispatcher[*in.UpdateContactTagRequest, *out.ContactTagResponse],
+	deleteContactTag cqrs.Dispatcher[*in.DeleteContactTagRequest, *out.DeleteContactTagResponse],
+	listFriendSuggestions cqrs.Dispatcher[*in.ListFriendSuggestionsRequest, *out.ListFriendSuggestionsResponse],
+	dismissFriendSuggestion cqrs.Dispatcher[*in.DismissFriendSuggestionRequest, *out.DismissFriendSuggestionResponse],
+	getFriendRequestPrivacy cqrs.Dispatcher[*in.GetFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse],
+	updateFriendRequestPrivacy cqrs.Dispatcher[*in.UpdateFriendRequestPrivacyRequest, *out.FriendRequestPrivacyResponse],
+	setFriendAudienceList cqrs.Dispatcher[*in.SetFriendAudienceListRequest, *out.FriendAudienceListResponse],
+	listAudienceListMembers cqrs.Dispatcher[*in.ListAudienceListMembersRequest, *out.ListAudienceListMembersResponse],
+	createGraphImport cqrs.Dispatcher[*in.CreateGraphImportRequest, *out.GraphImportJobResponse],
+	getGraphImport cqrs.Dispatcher[*in.GetGraphImportRequest, *out.GraphImportJobResponse],
+	listGraphImportRows cqrs.Dispatcher[*in.ListGraphImportRowsRequest, *out.ListGraphImportRowsResponse],
+	exportRelationshipGraph cqrs.Dispatcher[*in.ExportRelationshipGraphRequest, *out.ExportRelationshipGraphResponse],
 ) (infrahttp.HTTPServer, error) {
 	return &relationshipHTTPServer{
 		sendFriendRequest:          sendFriendRequest,
@@ -73,6 +107,23 @@ func NewHTTPServer(
 		getRelationshipStatus:      getRelationshipStatus,
 		getMutualFriends:           getMutualFriends,
 		getRelationshipSummary:     getRelationshipSummary,
+		getFriendRemark:            getFriendRemark,
+		updateFriendRemark:         updateFriendRemark,
+		setFriendTags:              setFriendTags,
+		createContactTag:           createContactTag,
+		listContactTags:            listContactTags,
+		updateContactTag:           updateContactTag,
+		deleteContactTag:           deleteContactTag,
+		listFriendSuggestions:      listFriendSuggestions,
+		dismissFriendSuggestion:    dismissFriendSuggestion,
+		getFriendRequestPrivacy:    getFriendRequestPrivacy,
+		updateFriendRequestPrivacy: updateFriendRequestPrivacy,
+		setFriendAudienceList:      setFriendAudienceList,
+		listAudienceListMembers:    listAudienceListMembers,
+		createGraphImport:          createGraphImport,
+		getGraphImport:             getGraphImport,
+		listGraphImportRows:        listGraphImportRows,
+		exportRelationshipGraph:    exportRelationshipGraph,
 	}, nil
 }
 
@@ -81,7 +132,7 @@ func (s *relationshipHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
 }
 
 func (s *relationshipHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	relationshiphttp.RegisterPrivateRoutes(routes, s.sendFriendRequest, s.cancelFriendRequest, s.acceptFriendRequest, s.rejectFriendRequest, s.listIncomingFriendRequests, s.listOutgoingFriendRequests, s.unfriendUser, s.listFriends, s.followUser, s.unfollowUser, s.listFollowers, s.listFollowing, s.blockUser, s.unblockUser, s.listBlockedUsers, s.getRelationshipStatus, s.getMutualFriends, s.getRelationshipSummary)
+	relationshiphttp.RegisterPrivateRoutes(routes, s.sendFriendRequest, s.cancelFriendRequest, s.acceptFriendRequest, s.rejectFriendRequest, s.listIncomingFriendRequests, s.listOutgoingFriendRequests, s.unfriendUser, s.listFriends, s.followUser, s.unfollowUser, s.listFollowers, s.listFollowing, s.blockUser, s.unblockUser, s.listBlockedUsers, s.getRelationshipStatus, s.getMutualFriends, s.getRelationshipSummary, s.getFriendRemark, s.updateFriendRemark, s.setFriendTags, s.createContactTag, s.listContactTags, s.updateContactTag, s.deleteContactTag, s.listFriendSuggestions, s.dismissFriendSuggestion, s.getFriendRequestPrivacy, s.updateFriendRequestPrivacy, s.setFriendAudienceList, s.listAudienceListMembers, s.createGraphImport, s.getGraphImport, s.listGraphImportRows, s.exportRelationshipGraph)
 }
 
 func (s *relationshipHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/modules/room/application/service"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/entity"
+	repos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type claimRedPacketHandler struct {
+	baseRepo   repos.Repos
+	redPackets service.RedPacketService
+}
+
+func NewClaimRedPacket(
+	baseRepo repos.Repos,
+	redPackets service.RedPacketService,
+) cqrs.Handler[*in.ClaimRedPacketRequest, *out.ClaimRedPacketResponse] {
+	return &claimRedPacketHandler{baseRepo: baseRepo, redPackets: redPackets}
+}
+
+func (u *claimRedPacketHandler) Handle(ctx context.Context, req *in.ClaimRedPacketRequest) (*out.ClaimRedPacketResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	packet, err := u.baseRepo.RedPacketRepository().Get(ctx, req.RedPacketID)
+	if err != nil {
+		return nil, stackErr.Error(mapRedPacketError(err))
+	}
+	roomAgg, err := u.baseRepo.RoomAggregateRepository().Load(ctx, packet.RoomID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	// The share is reserved under the packet lock; paying it out happens
+	// afterwards so a ledger failure leaves a claim that can be resumed.
+	var claim *entity.RedPacketClaim
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		locked, err := txRepos.RedPacketRepository().GetForUpdate(ctx, packet.ID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		packet = locked
+
+		existing, err := txRepos.RedPacketRepository().GetClaim(ctx, packet.ID, accountID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if existing != nil {
+			if existing.IsSettled() {
+				return stackErr.Error(entity.ErrRedPacketAlreadyClaimed)
+			}
+			claim = existing
+			return nil
+		}
+
+		claim, err = roomAgg.ClaimRedPacket(packet, accountID, rand.Int64N, time.Now().UTC())
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.RedPacketRepository().CreateClaim(ctx, claim); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RedPacketRepository().Update(ctx, packet))
+	}); err != nil {
+		return nil, stackErr.Error(mapRedPacketError(err))
+	}
+
+	if err := u.redPackets.SettleClaim(ctx, packet, claim); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &out.ClaimRedPacketResponse{
+		RedPacketID:    packet.ID,
+		RoomID:         packet.RoomID,
+		AccountID:      claim.AccountID,
+		Currency:       packet.Currency,
+		Amount:         claim.Amount,
+		RemainingCount: packet.RemainingCount,
+		ClaimedAt:      claim.ClaimedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
@@ -39,6 +39,14 @@ func (h *createDirectConversationHandler) Handle(ctx context.Context, req *in.Cr
 		return nil, stackErr.Error(err)
 	}
 
+	blocked, err := h.baseRepo.BlockRepository().IsBlockedBetween(ctx, accountID, req.PeerAccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if blocked {
+		return nil, stackErr.Error(ErrRoomCommandBlocked)
+	}
+
 	existing, err := h.baseRepo.RoomAggregateRepository().LoadByDirectKey(ctx, room.DirectKey)
 	if err == nil && existing != nil {
 		return &out.ChatRoomCommandResponse{RoomID: existing.Room().ID, Status: CommandStatusAlreadyExists}, nil
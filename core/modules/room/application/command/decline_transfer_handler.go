@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/modules/room/application/service"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type declineTransferHandler struct {
+	transfers service.TransferService
+}
+
+func NewDeclineTransfer(transfers service.TransferService) cqrs.Handler[*in.DeclineTransferRequest, *out.TransferResponse] {
+	return &declineTransferHandler{transfers: transfers}
+}
+
+func (u *declineTransferHandler) Handle(ctx context.Context, req *in.DeclineTransferRequest) (*out.TransferResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	transfer, err := u.transfers.Resolve(ctx, req.TransferID, func(transfer *entity.Transfer, now time.Time) error {
+		return stackErr.Error(transfer.Decline(accountID, now))
+	})
+	if err != nil {
+		return nil, stackErr.Error(mapTransferError(err))
+	}
+	return roomsupport.ToTransferResponse(transfer), nil
+}
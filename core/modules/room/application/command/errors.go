@@ -4,6 +4,8 @@ import (
 	"errors"
 	"net/http"
 
+	"wechat-clone/core/modules/room/domain/entity"
+	domainservice "wechat-clone/core/modules/room/domain/service"
 	"wechat-clone/core/shared/pkg/apperr"
 )
 
@@ -16,4 +18,87 @@ var (
 	ErrRoomCommandInvalidState = apperr.New("room.invalid_state", "room command is not valid for the current state", http.StatusConflict)
 	ErrRoomCommandForbidden    = apperr.New("room.forbidden", "account is not allowed to mutate this room", http.StatusForbidden)
 	ErrRoomCommandNotFound     = apperr.New("room.not_found", "room or message was not found", http.StatusNotFound)
+	ErrRoomCommandBlocked      = apperr.New("room.blocked", "conversation is unavailable because one account has blocked the other", http.StatusForbidden)
+
+	ErrContactCardUnavailable = apperr.New("room.contact_card_unavailable", "this contact card cannot be shared", http.StatusForbidden)
+
+	ErrRedPacketNotFound          = apperr.New("room.red_packet_not_found", "red packet not found", http.StatusNotFound)
+	ErrRedPacketAlreadyClaimed    = apperr.New("room.red_packet_already_claimed", "you have already claimed this red packet", http.StatusConflict)
+	ErrRedPacketUnavailable       = apperr.New("room.red_packet_unavailable", "red packet is no longer open", http.StatusConflict)
+	ErrRedPacketExpired           = apperr.New("room.red_packet_expired", "red packet has expired", http.StatusConflict)
+	ErrRedPacketEmpty             = apperr.New("room.red_packet_empty", "red packet has been fully claimed", http.StatusConflict)
+	ErrRedPacketSenderCannotClaim = apperr.New("room.red_packet_sender_cannot_claim", "sender cannot claim their own red packet in a direct chat", http.StatusForbidden)
+	ErrRedPacketInsufficientFunds = apperr.New("room.red_packet_insufficient_funds", "insufficient balance to fund the red packet", http.StatusUnprocessableEntity)
+	ErrRedPacketLimitExceeded     = apperr.New("room.red_packet_limit_exceeded", "red packet exceeds your wallet limits", http.StatusUnprocessableEntity)
+
+	ErrTransferNotFound          = apperr.New("room.transfer_not_found", "transfer not found", http.StatusNotFound)
+	ErrTransferDirectRoomOnly    = apperr.New("room.transfer_direct_room_only", "transfers can only be sent in direct chats", http.StatusConflict)
+	ErrTransferUnavailable       = apperr.New("room.transfer_unavailable", "transfer is no longer pending", http.StatusConflict)
+	ErrTransferExpired           = apperr.New("room.transfer_expired", "transfer has expired", http.StatusConflict)
+	ErrTransferNotRecipient      = apperr.New("room.transfer_not_recipient", "only the recipient can respond to a transfer", http.StatusForbidden)
+	ErrTransferInsufficientFunds = apperr.New("room.transfer_insufficient_funds", "insufficient balance for the transfer", http.StatusUnprocessableEntity)
+	ErrTransferLimitExceeded     = apperr.New("room.transfer_limit_exceeded", "transfer exceeds your wallet limits", http.StatusUnprocessableEntity)
 )
+
+func mapRedPacketError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrRedPacketCurrencyInvalid),
+		errors.Is(err, entity.ErrRedPacketAmountInvalid),
+		errors.Is(err, entity.ErrRedPacketCountInvalid),
+		errors.Is(err, entity.ErrRedPacketAmountTooSmall),
+		errors.Is(err, entity.ErrRedPacketCountExceedsRoom),
+		errors.Is(err, entity.ErrRedPacketSplitModeInvalid),
+		errors.Is(err, entity.ErrRedPacketGreetingTooLong):
+		return apperr.New("room.red_packet_invalid", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, entity.ErrRedPacketNotFound):
+		return ErrRedPacketNotFound
+	case errors.Is(err, entity.ErrRedPacketAlreadyClaimed):
+		return ErrRedPacketAlreadyClaimed
+	case errors.Is(err, entity.ErrRedPacketNotOpen):
+		return ErrRedPacketUnavailable
+	case errors.Is(err, entity.ErrRedPacketExpired):
+		return ErrRedPacketExpired
+	case errors.Is(err, entity.ErrRedPacketEmpty):
+		return ErrRedPacketEmpty
+	case errors.Is(err, entity.ErrRedPacketSenderCannotClaim):
+		return ErrRedPacketSenderCannotClaim
+	case errors.Is(err, entity.ErrRoomMemberRequired):
+		return ErrRoomCommandForbidden
+	case errors.Is(err, domainservice.ErrRedPacketInsufficientFunds):
+		return ErrRedPacketInsufficientFunds
+	case errors.Is(err, domainservice.ErrRedPacketLimitExceeded):
+		return ErrRedPacketLimitExceeded
+	default:
+		return err
+	}
+}
+
+func mapTransferError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrTransferCurrencyInvalid),
+		errors.Is(err, entity.ErrTransferAmountInvalid),
+		errors.Is(err, entity.ErrTransferNoteTooLong),
+		errors.Is(err, entity.ErrTransferSelf):
+		return apperr.New("room.transfer_invalid", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, entity.ErrTransferNotFound):
+		return ErrTransferNotFound
+	case errors.Is(err, entity.ErrTransferDirectRoomOnly),
+		errors.Is(err, entity.ErrTransferRecipientRequired):
+		return ErrTransferDirectRoomOnly
+	case errors.Is(err, entity.ErrTransferNotPending),
+		errors.Is(err, entity.ErrTransferNotHolding):
+		return ErrTransferUnavailable
+	case errors.Is(err, entity.ErrTransferExpired):
+		return ErrTransferExpired
+	case errors.Is(err, entity.ErrTransferNotRecipient):
+		return ErrTransferNotRecipient
+	case errors.Is(err, entity.ErrRoomMemberRequired):
+		return ErrRoomCommandForbidden
+	case errors.Is(err, domainservice.ErrTransferInsufficientFunds):
+		return ErrTransferInsufficientFunds
+	case errors.Is(err, domainservice.ErrTransferLimitExceeded):
+		return ErrTransferLimitExceeded
+	default:
+		return err
+	}
+}
@@ -9,17 +9,19 @@ import (
 	roomsupport "wechat-clone/core/modules/room/application/support"
 	apptypes "wechat-clone/core/modules/room/application/types"
 	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type forwardChatMessageHandler struct {
-	baseRepo roomrepos.Repos
-	realtime service.RealtimeService
+	baseRepo     roomrepos.Repos
+	realtime     service.RealtimeService
+	contactCards contactcard.Signer
 }
 
-func NewForwardChatMessageHandler(baseRepo roomrepos.Repos, realtime service.RealtimeService) cqrs.Handler[*in.ForwardChatMessageRequest, *out.ChatMessageCommandResponse] {
-	return &forwardChatMessageHandler{baseRepo: baseRepo, realtime: realtime}
+func NewForwardChatMessageHandler(baseRepo roomrepos.Repos, realtime service.RealtimeService, contactCards contactcard.Signer) cqrs.Handler[*in.ForwardChatMessageRequest, *out.ChatMessageCommandResponse] {
+	return &forwardChatMessageHandler{baseRepo: baseRepo, realtime: realtime, contactCards: contactCards}
 }
 
 func (h *forwardChatMessageHandler) Handle(ctx context.Context, req *in.ForwardChatMessageRequest) (*out.ChatMessageCommandResponse, error) {
@@ -33,7 +35,12 @@ func (h *forwardChatMessageHandler) Handle(ctx context.Context, req *in.ForwardC
 		return nil, stackErr.Error(err)
 	}
 
-	res, err := executeSendMessage(ctx, h.baseRepo, accountID, apptypes.SendMessageCommand{
+	var contactAccountID string
+	if card := sourceMessage.Message().ContactCard; card != nil {
+		contactAccountID = card.AccountID
+	}
+
+	res, err := executeSendMessage(ctx, h.baseRepo, h.contactCards, accountID, apptypes.SendMessageCommand{
 		RoomID:                 req.TargetRoomID,
 		Message:                sourceMessage.Message().Message,
 		MessageType:            sourceMessage.Message().MessageType,
@@ -42,6 +49,7 @@ func (h *forwardChatMessageHandler) Handle(ctx context.Context, req *in.ForwardC
 		FileSize:               sourceMessage.Message().FileSize,
 		MimeType:               sourceMessage.Message().MimeType,
 		ObjectKey:              sourceMessage.Message().ObjectKey,
+		ContactAccountID:       contactAccountID,
 	})
 	if err != nil {
 		return nil, stackErr.Error(err)
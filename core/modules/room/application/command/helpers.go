@@ -13,6 +13,7 @@ import (
 	"wechat-clone/core/modules/room/domain/repos"
 	sharedevents "wechat-clone/core/shared/contracts/events"
 	"wechat-clone/core/shared/pkg/actorctx"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/stackErr"
 
 	"github.com/google/uuid"
@@ -180,18 +181,149 @@ func appendUniqueString(values []string, value string) []string {
 	return append(values, value)
 }
 
-func executeSendMessage(ctx context.Context, baseRepo repos.Repos, accountID string, command apptypes.SendMessageCommand) (*apptypes.MessageResult, error) {
+// ensureDirectRoomNotBlocked rejects writes into a direct room once either
+// side has blocked the other. Group rooms stay writable; see
+// filterBlockedMentionTargets for how blocks apply there.
+func ensureDirectRoomNotBlocked(ctx context.Context, baseRepo repos.Repos, room *entity.Room, accountID string, members []*entity.RoomMemberEntity) error {
+	if room == nil || room.IsGroup() {
+		return nil
+	}
+	for _, member := range members {
+		if member == nil || strings.TrimSpace(member.AccountID) == strings.TrimSpace(accountID) {
+			continue
+		}
+		blocked, err := baseRepo.BlockRepository().IsBlockedBetween(ctx, accountID, member.AccountID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if blocked {
+			return stackErr.Error(ErrRoomCommandBlocked)
+		}
+	}
+	return nil
+}
+
+// filterBlockedMentionTargets drops notification targets that have a block
+// with the sender. The mention itself stays in the message so the group
+// transcript reads the same for everyone.
+func filterBlockedMentionTargets(ctx context.Context, baseRepo repos.Repos, senderID string, targetIDs []string) ([]string, error) {
+	if len(targetIDs) == 0 {
+		return targetIDs, nil
+	}
+	blockedIDs, err := baseRepo.BlockRepository().ListBlockedBetween(ctx, senderID, targetIDs)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if len(blockedIDs) == 0 {
+		return targetIDs, nil
+	}
+	return lo.Without(targetIDs, blockedIDs...), nil
+}
+
+// resolveMessageContactCard builds the card preview from the room account
+// projection and signs a token naming the sender as the sharer, so a friend
+// request sent from the card can credit them. A card cannot be shared when its
+// owner hid their contact card or has a block with the sender or, in a direct
+// room, with the recipient. The token expires after contactcard.SharedCardTTL
+// so a forwarded card does not vouch for the sharer forever.
+func resolveMessageContactCard(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	contactCards contactcard.Signer,
+	room *entity.Room,
+	members []*entity.RoomMemberEntity,
+	accountID string,
+	command apptypes.SendMessageCommand,
+	now time.Time,
+) (*entity.MessageContactCard, error) {
+	contactAccountID := strings.TrimSpace(command.ContactAccountID)
+	if entity.NormalizeMessageType(command.MessageType) != entity.MessageTypeContactCard || contactAccountID == "" {
+		return nil, nil
+	}
+	if contactAccountID == accountID {
+		return nil, stackErr.Error(entity.ErrMessageContactCardSelf)
+	}
+
+	accounts, err := baseRepo.AccountRepository().ListByAccountIDs(ctx, []string{contactAccountID})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if len(accounts) == 0 || accounts[0] == nil {
+		return nil, stackErr.Error(ErrRoomAccountNotFound)
+	}
+	account := accounts[0]
+
+	hidden, err := baseRepo.HiddenCardRepository().IsHidden(ctx, account.AccountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if hidden {
+		return nil, stackErr.Error(ErrContactCardUnavailable)
+	}
+	peerIDs := []string{accountID}
+	if room != nil && !room.IsGroup() {
+		for _, member := range members {
+			if member != nil && member.AccountID != accountID && member.AccountID != account.AccountID {
+				peerIDs = append(peerIDs, member.AccountID)
+			}
+		}
+	}
+	blockedIDs, err := baseRepo.BlockRepository().ListBlockedBetween(ctx, account.AccountID, peerIDs)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if len(blockedIDs) > 0 {
+		return nil, stackErr.Error(ErrContactCardUnavailable)
+	}
+
+	token, err := contactCards.Sign(contactcard.Card{
+		AccountID: account.AccountID,
+		SharedBy:  accountID,
+		ExpiresAt: now.Add(contactcard.SharedCardTTL),
+	})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &entity.MessageContactCard{
+		AccountID:       account.AccountID,
+		DisplayName:     account.DisplayName,
+		Username:        account.Username,
+		AvatarObjectKey: account.AvatarObjectKey,
+		Token:           token,
+	}, nil
+}
+
+func executeSendMessage(
+	ctx context.Context,
+	baseRepo repos.Repos,
+	contactCards contactcard.Signer,
+	accountID string,
+	command apptypes.SendMessageCommand,
+) (*apptypes.MessageResult, error) {
 	roomAgg, err := baseRepo.RoomAggregateRepository().Load(ctx, strings.TrimSpace(command.RoomID))
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
 
+	if err := ensureDirectRoomNotBlocked(ctx, baseRepo, roomAgg.Room(), accountID, roomAgg.Members()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	mentions, err := resolveMessageMentions(roomAgg.Room(), accountID, command, roomAgg.Members())
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	if mentions.MentionedAccountIDs, err = filterBlockedMentionTargets(ctx, baseRepo, accountID, mentions.MentionedAccountIDs); err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	now := time.Now().UTC()
+	contactCard, err := resolveMessageContactCard(ctx, baseRepo, contactCards, roomAgg.Room(), roomAgg.Members(), accountID, command, now)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	message, err := roomAgg.SendMessage(
 		uuid.NewString(),
 		accountID,
@@ -206,6 +338,7 @@ func executeSendMessage(ctx context.Context, baseRepo repos.Repos, accountID str
 			FileSize:               command.FileSize,
 			MimeType:               command.MimeType,
 			ObjectKey:              command.ObjectKey,
+			ContactCard:            contactCard,
 		},
 		buildSenderIdentity(ctx, roomAgg.Members(), accountID),
 		aggregate.MessageOutboxPayload{
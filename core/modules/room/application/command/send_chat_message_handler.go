@@ -9,17 +9,19 @@ import (
 	roomsupport "wechat-clone/core/modules/room/application/support"
 	apptypes "wechat-clone/core/modules/room/application/types"
 	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type sendChatMessageHandler struct {
-	baseRepo roomrepos.Repos
-	realtime service.RealtimeService
+	baseRepo     roomrepos.Repos
+	realtime     service.RealtimeService
+	contactCards contactcard.Signer
 }
 
-func NewSendChatMessageHandler(baseRepo roomrepos.Repos, realtime service.RealtimeService) cqrs.Handler[*in.SendChatMessageRequest, *out.ChatMessageCommandResponse] {
-	return &sendChatMessageHandler{baseRepo: baseRepo, realtime: realtime}
+func NewSendChatMessageHandler(baseRepo roomrepos.Repos, realtime service.RealtimeService, contactCards contactcard.Signer) cqrs.Handler[*in.SendChatMessageRequest, *out.ChatMessageCommandResponse] {
+	return &sendChatMessageHandler{baseRepo: baseRepo, realtime: realtime, contactCards: contactCards}
 }
 
 func (h *sendChatMessageHandler) Handle(ctx context.Context, req *in.SendChatMessageRequest) (*out.ChatMessageCommandResponse, error) {
@@ -28,7 +30,7 @@ func (h *sendChatMessageHandler) Handle(ctx context.Context, req *in.SendChatMes
 		return nil, stackErr.Error(err)
 	}
 
-	res, err := executeSendMessage(ctx, h.baseRepo, accountID, apptypes.SendMessageCommand{
+	res, err := executeSendMessage(ctx, h.baseRepo, h.contactCards, accountID, apptypes.SendMessageCommand{
 		RoomID:                 req.RoomID,
 		Message:                req.Message,
 		MessageType:            req.MessageType,
@@ -40,6 +42,7 @@ func (h *sendChatMessageHandler) Handle(ctx context.Context, req *in.SendChatMes
 		FileSize:               req.FileSize,
 		MimeType:               req.MimeType,
 		ObjectKey:              req.ObjectKey,
+		ContactAccountID:       req.ContactAccountID,
 	})
 	if err != nil {
 		return nil, stackErr.Error(err)
@@ -0,0 +1,144 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/aggregate"
+	"wechat-clone/core/modules/room/domain/entity"
+	repos "wechat-clone/core/modules/room/domain/repos"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultRedPacketTTL = 24 * time.Hour
+
+	redPacketInsufficientFundsReason = "insufficient funds"
+	redPacketLimitExceededReason     = "wallet limit exceeded"
+)
+
+type sendRedPacketHandler struct {
+	baseRepo repos.Repos
+	ledger   domainservice.RedPacketLedger
+	ttl      time.Duration
+}
+
+func NewSendRedPacket(
+	appContext *appCtx.AppContext,
+	baseRepo repos.Repos,
+	ledger domainservice.RedPacketLedger,
+) cqrs.Handler[*in.SendRedPacketRequest, *out.RedPacketResponse] {
+	handler := &sendRedPacketHandler{
+		baseRepo: baseRepo,
+		ledger:   ledger,
+		ttl:      defaultRedPacketTTL,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if ttlSeconds := appContext.GetConfig().RoomConfig.RedPacket.TTLSeconds; ttlSeconds > 0 {
+			handler.ttl = time.Duration(ttlSeconds) * time.Second
+		}
+	}
+	return handler
+}
+
+func (u *sendRedPacketHandler) Handle(ctx context.Context, req *in.SendRedPacketRequest) (*out.RedPacketResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	roomAgg, err := u.baseRepo.RoomAggregateRepository().Load(ctx, strings.TrimSpace(req.RoomID))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := ensureDirectRoomNotBlocked(ctx, u.baseRepo, roomAgg.Room(), accountID, roomAgg.Members()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	maxCount, err := roomAgg.RedPacketShareLimit(accountID)
+	if err != nil {
+		return nil, stackErr.Error(mapRedPacketError(err))
+	}
+
+	now := time.Now().UTC()
+	packet, err := entity.NewRedPacket(uuid.NewString(), roomAgg.Room().ID, accountID, entity.RedPacketParams{
+		Currency:    req.Currency,
+		TotalAmount: req.TotalAmount,
+		Count:       req.Count,
+		SplitMode:   req.SplitMode,
+		Greeting:    req.Greeting,
+		MaxCount:    maxCount,
+		TTL:         u.ttl,
+	}, now)
+	if err != nil {
+		return nil, stackErr.Error(mapRedPacketError(err))
+	}
+
+	// The packet is recorded as pending before any money moves, so a send
+	// interrupted after funding is still found and refunded by the sweep.
+	if err := u.baseRepo.RedPacketRepository().Create(ctx, packet); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := u.ledger.Fund(ctx, packet); err != nil {
+		if reason, ok := redPacketFundFailureReason(err); ok {
+			if failErr := packet.Fail(reason, now); failErr == nil {
+				if updateErr := u.baseRepo.RedPacketRepository().Update(ctx, packet); updateErr != nil {
+					return nil, stackErr.Error(updateErr)
+				}
+			}
+		}
+		return nil, stackErr.Error(mapRedPacketError(err))
+	}
+
+	message, err := roomAgg.SendMessage(
+		uuid.NewString(),
+		accountID,
+		entity.MessageParams{
+			Message:     packet.Greeting,
+			MessageType: entity.MessageTypeRedPacket,
+			RedPacket:   packet.MessageCard(),
+		},
+		buildSenderIdentity(ctx, roomAgg.Members(), accountID),
+		aggregate.MessageOutboxPayload{},
+		now,
+	)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := packet.Open(message.ID, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if err := txRepos.RedPacketRepository().Update(ctx, packet); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RoomAggregateRepository().Save(ctx, roomAgg))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return roomsupport.ToRedPacketResponse(packet, nil), nil
+}
+
+// redPacketFundFailureReason returns the reason a red packet is failed with
+// when the ledger refuses its funding, or false when the sweep should retry it.
+func redPacketFundFailureReason(err error) (string, bool) {
+	switch {
+	case errors.Is(err, domainservice.ErrRedPacketInsufficientFunds):
+		return redPacketInsufficientFundsReason, true
+	case errors.Is(err, domainservice.ErrRedPacketLimitExceeded):
+		return redPacketLimitExceededReason, true
+	default:
+		return "", false
+	}
+}
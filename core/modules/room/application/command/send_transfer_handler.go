@@ -0,0 +1,145 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/aggregate"
+	"wechat-clone/core/modules/room/domain/entity"
+	repos "wechat-clone/core/modules/room/domain/repos"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTransferTTL = 24 * time.Hour
+
+	transferInsufficientFundsReason = "insufficient funds"
+	transferLimitExceededReason     = "wallet limit exceeded"
+)
+
+type sendTransferHandler struct {
+	baseRepo repos.Repos
+	ledger   domainservice.TransferLedger
+	ttl      time.Duration
+}
+
+func NewSendTransfer(
+	appContext *appCtx.AppContext,
+	baseRepo repos.Repos,
+	ledger domainservice.TransferLedger,
+) cqrs.Handler[*in.SendTransferRequest, *out.TransferResponse] {
+	handler := &sendTransferHandler{
+		baseRepo: baseRepo,
+		ledger:   ledger,
+		ttl:      defaultTransferTTL,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if ttlSeconds := appContext.GetConfig().RoomConfig.Transfer.TTLSeconds; ttlSeconds > 0 {
+			handler.ttl = time.Duration(ttlSeconds) * time.Second
+		}
+	}
+	return handler
+}
+
+func (u *sendTransferHandler) Handle(ctx context.Context, req *in.SendTransferRequest) (*out.TransferResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	roomAgg, err := u.baseRepo.RoomAggregateRepository().Load(ctx, strings.TrimSpace(req.RoomID))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	recipientID, err := roomAgg.TransferRecipient(accountID)
+	if err != nil {
+		return nil, stackErr.Error(mapTransferError(err))
+	}
+	if err := ensureDirectRoomNotBlocked(ctx, u.baseRepo, roomAgg.Room(), accountID, roomAgg.Members()); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	now := time.Now().UTC()
+	transfer, err := entity.NewTransfer(uuid.NewString(), roomAgg.Room().ID, accountID, recipientID, entity.TransferParams{
+		Currency: req.Currency,
+		Amount:   req.Amount,
+		Note:     req.Note,
+		TTL:      u.ttl,
+	}, now)
+	if err != nil {
+		return nil, stackErr.Error(mapTransferError(err))
+	}
+
+	// The transfer is recorded as holding before any money moves, so a send
+	// interrupted after the hold is still found and released by the sweep.
+	if err := u.baseRepo.TransferRepository().Create(ctx, transfer); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := u.ledger.Hold(ctx, transfer); err != nil {
+		if reason, ok := transferHoldFailureReason(err); ok {
+			if failErr := transfer.Fail(reason, now); failErr == nil {
+				transfer.Settle(now)
+				if updateErr := u.baseRepo.TransferRepository().Update(ctx, transfer); updateErr != nil {
+					return nil, stackErr.Error(updateErr)
+				}
+			}
+		}
+		return nil, stackErr.Error(mapTransferError(err))
+	}
+
+	messageID := uuid.NewString()
+	if err := transfer.Open(messageID, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if _, err := roomAgg.SendMessage(
+		messageID,
+		accountID,
+		entity.MessageParams{
+			Message:     transfer.Note,
+			MessageType: entity.MessageTypeTransfer,
+			Transfer:    transfer.MessageCard(),
+		},
+		buildSenderIdentity(ctx, roomAgg.Members(), accountID),
+		aggregate.MessageOutboxPayload{},
+		now,
+	); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := roomAgg.RecordTransferUpdated(transfer, now); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	if err := u.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+		if err := txRepos.TransferRepository().Update(ctx, transfer); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RoomAggregateRepository().Save(ctx, roomAgg))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return roomsupport.ToTransferResponse(transfer), nil
+}
+
+// transferHoldFailureReason returns the reason a transfer is failed with when
+// the ledger refuses its hold, or false when the sweep should retry it.
+func transferHoldFailureReason(err error) (string, bool) {
+	switch {
+	case errors.Is(err, domainservice.ErrTransferInsufficientFunds):
+		return transferInsufficientFundsReason, true
+	case errors.Is(err, domainservice.ErrTransferLimitExceeded):
+		return transferLimitExceededReason, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type AcceptTransferRequest struct {
+	TransferID string `json:"transfer_id" form:"transfer_id" binding:"required"`
+}
+
+func (r *AcceptTransferRequest) Normalize() {
+	r.TransferID = strings.TrimSpace(r.TransferID)
+}
+
+func (r *AcceptTransferRequest) Validate() error {
+	r.Normalize()
+	if r.TransferID == "" {
+		return stackErr.Error(errors.New("transfer_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type ClaimRedPacketRequest struct {
+	RedPacketID string `json:"red_packet_id" form:"red_packet_id" binding:"required"`
+}
+
+func (r *ClaimRedPacketRequest) Normalize() {
+	r.RedPacketID = strings.TrimSpace(r.RedPacketID)
+}
+
+func (r *ClaimRedPacketRequest) Validate() error {
+	r.Normalize()
+	if r.RedPacketID == "" {
+		return stackErr.Error(errors.New("red_packet_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type DeclineTransferRequest struct {
+	TransferID string `json:"transfer_id" form:"transfer_id" binding:"required"`
+}
+
+func (r *DeclineTransferRequest) Normalize() {
+	r.TransferID = strings.TrimSpace(r.TransferID)
+}
+
+func (r *DeclineTransferRequest) Validate() error {
+	r.Normalize()
+	if r.TransferID == "" {
+		return stackErr.Error(errors.New("transfer_id is required"))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type GetRedPacketRequest struct {
+	RedPacketID string `json:"red_packet_id" form:"red_packet_id" binding:"required"`
+}
+
+func (r *GetRedPacketRequest) Normalize() {
+	r.RedPacketID = strings.TrimSpace(r.RedPacketID)
+}
+
+func (r *GetRedPacketRequest) Validate() error {
+	r.Normalize()
+	if r.RedPacketID == "" {
+		return stackErr.Error(errors.New("red_packet_id is required"))
+	}
+	return nil
+}
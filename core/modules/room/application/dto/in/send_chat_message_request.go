@@ -20,6 +20,7 @@ type SendChatMessageRequest struct {
 	FileSize               int64                           `json:"file_size" form:"file_size"`
 	MimeType               string                          `json:"mime_type" form:"mime_type"`
 	ObjectKey              string                          `json:"object_key" form:"object_key"`
+	ContactAccountID       string                          `json:"contact_account_id" form:"contact_account_id"`
 }
 
 type SendChatMessageMentionRequest struct {
@@ -42,6 +43,7 @@ func (r *SendChatMessageRequest) Normalize() {
 	r.FileName = strings.TrimSpace(r.FileName)
 	r.MimeType = strings.TrimSpace(r.MimeType)
 	r.ObjectKey = strings.TrimSpace(r.ObjectKey)
+	r.ContactAccountID = strings.TrimSpace(r.ContactAccountID)
 }
 
 func (r *SendChatMessageRequest) Validate() error {
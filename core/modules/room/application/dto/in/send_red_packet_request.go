@@ -0,0 +1,42 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SendRedPacketRequest struct {
+	RoomID      string `json:"room_id" form:"room_id" binding:"required"`
+	Currency    string `json:"currency" form:"currency" binding:"required"`
+	TotalAmount int64  `json:"total_amount" form:"total_amount" binding:"required"`
+	Count       int    `json:"count" form:"count" binding:"required"`
+	SplitMode   string `json:"split_mode" form:"split_mode"`
+	Greeting    string `json:"greeting" form:"greeting"`
+}
+
+func (r *SendRedPacketRequest) Normalize() {
+	r.RoomID = strings.TrimSpace(r.RoomID)
+	r.Currency = strings.TrimSpace(r.Currency)
+	r.SplitMode = strings.TrimSpace(r.SplitMode)
+	r.Greeting = strings.TrimSpace(r.Greeting)
+}
+
+func (r *SendRedPacketRequest) Validate() error {
+	r.Normalize()
+	if r.RoomID == "" {
+		return stackErr.Error(errors.New("room_id is required"))
+	}
+	if r.Currency == "" {
+		return stackErr.Error(errors.New("currency is required"))
+	}
+	if r.TotalAmount == 0 {
+		return stackErr.Error(errors.New("total_amount is required"))
+	}
+	if r.Count == 0 {
+		return stackErr.Error(errors.New("count is required"))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// CODE_GENERATOR - do not edit: request
+
+package in
+
+import (
+	"errors"
+	"strings"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type SendTransferRequest struct {
+	RoomID   string `json:"room_id" form:"room_id" binding:"required"`
+	Currency string `json:"currency" form:"currency" binding:"required"`
+	Amount   int64  `json:"amount" form:"amount" binding:"required"`
+	Note     string `json:"note" form:"note"`
+}
+
+func (r *SendTransferRequest) Normalize() {
+	r.RoomID = strings.TrimSpace(r.RoomID)
+	r.Currency = strings.TrimSpace(r.Currency)
+	r.Note = strings.TrimSpace(r.Note)
+}
+
+func (r *SendTransferRequest) Validate() error {
+	r.Normalize()
+	if r.RoomID == "" {
+		return stackErr.Error(errors.New("room_id is required"))
+	}
+	if r.Currency == "" {
+		return stackErr.Error(errors.New("currency is required"))
+	}
+	if r.Amount == 0 {
+		return stackErr.Error(errors.New("amount is required"))
+	}
+	return nil
+}
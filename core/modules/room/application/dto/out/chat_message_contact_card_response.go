@@ -0,0 +1,9 @@
+package out
+
+type ChatMessageContactCardResponse struct {
+	AccountID       string `json:"account_id"`
+	DisplayName     string `json:"display_name"`
+	Username        string `json:"username,omitempty"`
+	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
+	Token           string `json:"token,omitempty"`
+}
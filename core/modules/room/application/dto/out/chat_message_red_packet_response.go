@@ -0,0 +1,9 @@
+package out
+
+type ChatMessageRedPacketResponse struct {
+	RedPacketID string `json:"red_packet_id"`
+	Currency    string `json:"currency"`
+	TotalAmount int64  `json:"total_amount"`
+	Count       int    `json:"count"`
+	SplitMode   string `json:"split_mode"`
+}
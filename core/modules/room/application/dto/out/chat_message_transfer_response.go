@@ -0,0 +1,9 @@
+package out
+
+type ChatMessageTransferResponse struct {
+	TransferID  string `json:"transfer_id"`
+	RecipientID string `json:"recipient_id"`
+	Currency    string `json:"currency"`
+	Amount      int64  `json:"amount"`
+	Status      string `json:"status"`
+}
@@ -0,0 +1,12 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type ClaimRedPacketResponse struct {
+	RedPacketID    string `json:"red_packet_id,omitempty"`
+	RoomID         string `json:"room_id,omitempty"`
+	AccountID      string `json:"account_id,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	Amount         int64  `json:"amount,omitempty"`
+	RemainingCount int    `json:"remaining_count,omitempty"`
+	ClaimedAt      string `json:"claimed_at,omitempty"`
+}
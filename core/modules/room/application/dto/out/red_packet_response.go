@@ -0,0 +1,26 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type RedPacketResponse struct {
+	RedPacketID     string                       `json:"red_packet_id,omitempty"`
+	RoomID          string                       `json:"room_id,omitempty"`
+	MessageID       string                       `json:"message_id,omitempty"`
+	SenderID        string                       `json:"sender_id,omitempty"`
+	Currency        string                       `json:"currency,omitempty"`
+	TotalAmount     int64                        `json:"total_amount,omitempty"`
+	Count           int                          `json:"count,omitempty"`
+	SplitMode       string                       `json:"split_mode,omitempty"`
+	Greeting        string                       `json:"greeting,omitempty"`
+	Status          string                       `json:"status,omitempty"`
+	RemainingAmount int64                        `json:"remaining_amount,omitempty"`
+	RemainingCount  int                          `json:"remaining_count,omitempty"`
+	ExpiresAt       string                       `json:"expires_at,omitempty"`
+	CreatedAt       string                       `json:"created_at,omitempty"`
+	Claims          []RedPacketClaimItemResponse `json:"claims,omitempty"`
+}
+
+type RedPacketClaimItemResponse struct {
+	AccountID string `json:"account_id,omitempty"`
+	Amount    int64  `json:"amount,omitempty"`
+	ClaimedAt string `json:"claimed_at,omitempty"`
+}
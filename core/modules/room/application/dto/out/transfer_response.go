@@ -0,0 +1,18 @@
+// CODE_GENERATOR - do not edit: response
+package out
+
+type TransferResponse struct {
+	TransferID  string `json:"transfer_id,omitempty"`
+	RoomID      string `json:"room_id,omitempty"`
+	MessageID   string `json:"message_id,omitempty"`
+	SenderID    string `json:"sender_id,omitempty"`
+	RecipientID string `json:"recipient_id,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	Amount      int64  `json:"amount,omitempty"`
+	Note        string `json:"note,omitempty"`
+	Status      string `json:"status,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+	SettledAt   string `json:"settled_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/modules/room/domain/repos"
 	sharedevents "wechat-clone/core/shared/contracts/events"
 	"wechat-clone/core/shared/pkg/stackErr"
 	"wechat-clone/core/shared/utils"
@@ -56,6 +57,64 @@ func (h *messageHandler) handleAccountUpdatedEvent(ctx context.Context, raw json
 		return stackErr.Error(err)
 	}
 
+	return stackErr.Error(h.refreshContactCards(ctx, payload.AccountID))
+}
+
+func (h *messageHandler) handleAccountUsernameChangedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventAccountUsernameChanged, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.AccountUsernameChangedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventAccountUsernameChanged))
+	}
+
+	if err := h.accountRepo.ProjectAccountUsername(ctx, payload.AccountID, payload.Username, payload.ChangedAt); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return stackErr.Error(h.refreshContactCards(ctx, payload.AccountID))
+}
+
+// refreshContactCards copies the projected profile of accountID onto every
+// contact card message that shares it. Saving a changed message syncs the
+// read model, so recipients see the new name and avatar on old cards.
+func (h *messageHandler) refreshContactCards(ctx context.Context, accountID string) error {
+	messageIDs, err := h.baseRepo.MessageAggregateRepository().ListContactCardMessageIDs(ctx, accountID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	accounts, err := h.baseRepo.AccountRepository().ListByAccountIDs(ctx, []string{accountID})
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	for _, messageID := range messageIDs {
+		if err := h.baseRepo.WithTransaction(ctx, func(txRepos repos.Repos) error {
+			messageAgg, err := txRepos.MessageAggregateRepository().Load(ctx, messageID)
+			if err != nil {
+				return stackErr.Error(err)
+			}
+			if err := messageAgg.RefreshContactCard(accounts[0]); err != nil {
+				return stackErr.Error(err)
+			}
+			if !messageAgg.MessageDirty() {
+				return nil
+			}
+			return stackErr.Error(txRepos.MessageAggregateRepository().Save(ctx, messageAgg))
+		}); err != nil {
+			return stackErr.Error(err)
+		}
+	}
 	return nil
 }
 
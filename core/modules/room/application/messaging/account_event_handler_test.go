@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"wechat-clone/core/modules/room/domain/aggregate"
 	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
 	sharedevents "wechat-clone/core/shared/contracts/events"
 
 	"go.uber.org/mock/gomock"
@@ -37,7 +39,11 @@ func TestHandleAccountEventProfileUpdatedProjectsUsernameAndAvatar(t *testing.T)
 	defer ctrl.Finish()
 
 	accountRepo := NewMockAccountProjectionRepository(ctrl)
-	handler := &messageHandler{accountRepo: accountRepo}
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	messageRepo := roomrepos.NewMockMessageAggregateRepository(ctrl)
+	baseRepo.EXPECT().MessageAggregateRepository().Return(messageRepo).AnyTimes()
+	messageRepo.EXPECT().ListContactCardMessageIDs(gomock.Any(), "acc-3").Return(nil, nil).Times(1)
+	handler := &messageHandler{accountRepo: accountRepo, baseRepo: baseRepo}
 
 	raw := []byte(`{
 		"id": 1,
@@ -82,6 +88,59 @@ func TestHandleAccountEventProfileUpdatedProjectsUsernameAndAvatar(t *testing.T)
 	}
 }
 
+func TestHandleAccountEventUsernameChangedRefreshesContactCards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accountRepo := NewMockAccountProjectionRepository(ctrl)
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	messageRepo := roomrepos.NewMockMessageAggregateRepository(ctrl)
+	roomAccountRepo := roomrepos.NewMockAccountRepository(ctrl)
+	baseRepo.EXPECT().MessageAggregateRepository().Return(messageRepo).AnyTimes()
+	baseRepo.EXPECT().AccountRepository().Return(roomAccountRepo).AnyTimes()
+	baseRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(roomrepos.Repos) error) error {
+			return fn(baseRepo)
+		}).
+		Times(1)
+	handler := &messageHandler{accountRepo: accountRepo, baseRepo: baseRepo}
+
+	changedAt := time.Date(2026, 3, 3, 6, 5, 32, 0, time.UTC)
+	message := &entity.MessageEntity{
+		ID:          "msg-1",
+		RoomID:      "room-1",
+		SenderID:    "acc-1",
+		MessageType: entity.MessageTypeContactCard,
+		ContactCard: &entity.MessageContactCard{AccountID: "acc-3", DisplayName: "Alice", Username: "alice", Token: "token-1"},
+	}
+
+	accountRepo.EXPECT().ProjectAccountUsername(gomock.Any(), "acc-3", "alice_new", changedAt).Return(nil).Times(1)
+	messageRepo.EXPECT().ListContactCardMessageIDs(gomock.Any(), "acc-3").Return([]string{"msg-1"}, nil).Times(1)
+	roomAccountRepo.EXPECT().ListByAccountIDs(gomock.Any(), []string{"acc-3"}).
+		Return([]*entity.AccountEntity{{AccountID: "acc-3", DisplayName: "Alice", Username: "alice_new"}}, nil).
+		Times(1)
+	messageRepo.EXPECT().Load(gomock.Any(), "msg-1").DoAndReturn(func(context.Context, string) (*aggregate.MessageStateAggregate, error) {
+		return aggregate.NewMessageStateAggregate(message)
+	}).Times(1)
+	messageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, agg *aggregate.MessageStateAggregate) error {
+			card := agg.Message().ContactCard
+			if card.Username != "alice_new" || card.Token != "token-1" {
+				t.Fatalf("expected refreshed card keeping its token, got %+v", card)
+			}
+			return nil
+		}).
+		Times(1)
+
+	raw := []byte(`{
+		"event_name": "EventAccountUsernameChanged",
+		"event_data": {"AccountID":"acc-3","Username":"alice_new","ChangedAt":"2026-03-03T06:05:32Z"}
+	}`)
+	if err := handler.handleAccountEvent(context.Background(), raw); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
 func TestHandleAccountEventCreatedFallsBackToEmailWhenDisplayNameMissing(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -2,6 +2,7 @@ package messaging
 
 import (
 	"context"
+	"time"
 
 	"wechat-clone/core/modules/room/domain/entity"
 )
@@ -9,4 +10,5 @@ import (
 //go:generate mockgen -package=messaging -destination=account_projection_repository_mock.go -source=account_projection_repository.go
 type AccountProjectionRepository interface {
 	ProjectAccount(context.Context, *entity.AccountEntity) error
+	ProjectAccountUsername(ctx context.Context, accountID, username string, updatedAt time.Time) error
 }
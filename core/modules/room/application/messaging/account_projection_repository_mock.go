@@ -12,6 +12,7 @@ package messaging
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	entity "wechat-clone/core/modules/room/domain/entity"
 
 	gomock "go.uber.org/mock/gomock"
@@ -54,3 +55,17 @@ func (mr *MockAccountProjectionRepositoryMockRecorder) ProjectAccount(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectAccount", reflect.TypeOf((*MockAccountProjectionRepository)(nil).ProjectAccount), arg0, arg1)
 }
+
+// ProjectAccountUsername mocks base method.
+func (m *MockAccountProjectionRepository) ProjectAccountUsername(ctx context.Context, accountID, username string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectAccountUsername", ctx, accountID, username, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectAccountUsername indicates an expected call of ProjectAccountUsername.
+func (mr *MockAccountProjectionRepositoryMockRecorder) ProjectAccountUsername(ctx, accountID, username, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectAccountUsername", reflect.TypeOf((*MockAccountProjectionRepository)(nil).ProjectAccountUsername), ctx, accountID, username, updatedAt)
+}
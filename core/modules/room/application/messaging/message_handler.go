@@ -44,6 +44,11 @@ func NewMessageHandler(cfg *config.Config, baseRepo repos.Repos, accountRepo Acc
 			return instance.handleAccountEvent(ctx, value)
 		}
 	}
+	if topic := strings.TrimSpace(cfg.KafkaConfig.KafkaRoomConsumer.RelationshipOutboxTopic); topic != "" {
+		topicHandlers[topic] = func(ctx context.Context, value []byte) error {
+			return instance.handleRelationshipEvent(ctx, value)
+		}
+	}
 
 	for topic, handler := range topicHandlers {
 		consumer, err := infraMessaging.NewConsumer(&infraMessaging.Config{
@@ -94,6 +99,59 @@ func (h *messageHandler) handleAccountEvent(ctx context.Context, value []byte) e
 			log.Errorw("handle account updated event failed", zap.Error(err))
 			return stackErr.Error(err)
 		}
+	case sharedevents.EventAccountUsernameChanged:
+		if err := h.handleAccountUsernameChangedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle account username changed event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *messageHandler) handleRelationshipEvent(ctx context.Context, value []byte) error {
+	log := logging.FromContext(ctx).Named("handleRelationshipEvent")
+	var event contracts.OutboxMessage
+	if err := json.Unmarshal(value, &event); err != nil {
+		return stackErr.Error(fmt.Errorf("unmarshal relationship outbox event failed: %w", err))
+	}
+	switch event.EventName {
+	case sharedevents.EventRelationshipPairBlocked:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleRelationshipBlockedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle relationship blocked event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	case sharedevents.EventRelationshipPairUnblocked:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleRelationshipUnblockedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle relationship unblocked event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	case sharedevents.EventRelationshipPairUnfriended:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleRelationshipUnfriendedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle relationship unfriended event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	case sharedevents.EventFriendContactRemarkUpdated:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleFriendContactRemarkUpdatedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle friend contact remark updated event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	case sharedevents.EventFriendContactAudienceListUpdated:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleFriendContactAudienceListUpdatedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle friend contact audience list updated event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
+	case sharedevents.EventFriendRequestPrivacyUpdated:
+		log.Infow("handle relationship event", zap.String("event_name", event.EventName))
+		if err := h.handleFriendRequestPrivacyUpdatedEvent(ctx, event.EventData); err != nil {
+			log.Errorw("handle friend request privacy updated event failed", zap.Error(err))
+			return stackErr.Error(err)
+		}
 	}
 
 	return nil
@@ -13,8 +13,16 @@ import (
 )
 
 var eventPayloadTypes = map[string]reflect.Type{
-	sharedevents.EventAccountCreated:        reflect.TypeOf(sharedevents.AccountCreatedEvent{}),
-	sharedevents.EventAccountProfileUpdated: reflect.TypeOf(sharedevents.AccountProfileUpdatedEvent{}),
+	sharedevents.EventAccountCreated:         reflect.TypeOf(sharedevents.AccountCreatedEvent{}),
+	sharedevents.EventAccountProfileUpdated:  reflect.TypeOf(sharedevents.AccountProfileUpdatedEvent{}),
+	sharedevents.EventAccountUsernameChanged: reflect.TypeOf(sharedevents.AccountUsernameChangedEvent{}),
+
+	sharedevents.EventRelationshipPairBlocked:          reflect.TypeOf(sharedevents.RelationshipPairBlockedEvent{}),
+	sharedevents.EventRelationshipPairUnblocked:        reflect.TypeOf(sharedevents.RelationshipPairUnblockedEvent{}),
+	sharedevents.EventRelationshipPairUnfriended:       reflect.TypeOf(sharedevents.RelationshipPairUnfriendedEvent{}),
+	sharedevents.EventFriendContactRemarkUpdated:       reflect.TypeOf(sharedevents.FriendContactRemarkUpdatedEvent{}),
+	sharedevents.EventFriendContactAudienceListUpdated: reflect.TypeOf(sharedevents.FriendContactAudienceListUpdatedEvent{}),
+	sharedevents.EventFriendRequestPrivacyUpdated:      reflect.TypeOf(sharedevents.FriendRequestPrivacyUpdatedEvent{}),
 }
 
 func decodeEventPayload(ctx context.Context, eventName string, raw []byte) (interface{}, error) {
@@ -0,0 +1,114 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sharedevents "wechat-clone/core/shared/contracts/events"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+func (h *messageHandler) handleRelationshipBlockedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventRelationshipPairBlocked, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.RelationshipPairBlockedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventRelationshipPairBlocked))
+	}
+
+	if err := h.baseRepo.BlockRepository().ProjectBlocked(ctx, payload.BlockerID, payload.BlockedID, payload.CreatedAt); err != nil {
+		return stackErr.Error(err)
+	}
+	// Blocking ends the friendship, and remarks and restrictions go with it.
+	if err := h.baseRepo.ContactRemarkRepository().RemoveBetween(ctx, payload.BlockerID, payload.BlockedID); err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(h.baseRepo.RestrictionRepository().RemoveBetween(ctx, payload.BlockerID, payload.BlockedID))
+}
+
+func (h *messageHandler) handleRelationshipUnblockedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventRelationshipPairUnblocked, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.RelationshipPairUnblockedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventRelationshipPairUnblocked))
+	}
+
+	return stackErr.Error(h.baseRepo.BlockRepository().RemoveBlocked(ctx, payload.BlockerID, payload.BlockedID))
+}
+
+func (h *messageHandler) handleRelationshipUnfriendedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventRelationshipPairUnfriended, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.RelationshipPairUnfriendedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventRelationshipPairUnfriended))
+	}
+
+	if err := h.baseRepo.ContactRemarkRepository().RemoveBetween(ctx, payload.UserID, payload.FriendID); err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(h.baseRepo.RestrictionRepository().RemoveBetween(ctx, payload.UserID, payload.FriendID))
+}
+
+func (h *messageHandler) handleFriendContactRemarkUpdatedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventFriendContactRemarkUpdated, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.FriendContactRemarkUpdatedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventFriendContactRemarkUpdated))
+	}
+
+	return stackErr.Error(h.baseRepo.ContactRemarkRepository().ProjectRemark(ctx, payload.OwnerID, payload.FriendID, payload.RemarkName, payload.UpdatedAt))
+}
+
+// Only the restricted list matters here; moving a friend to close friends or
+// off every list lifts the restriction.
+func (h *messageHandler) handleFriendContactAudienceListUpdatedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventFriendContactAudienceListUpdated, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.FriendContactAudienceListUpdatedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventFriendContactAudienceListUpdated))
+	}
+
+	if payload.AudienceList == "restricted" {
+		return stackErr.Error(h.baseRepo.RestrictionRepository().ProjectRestricted(ctx, payload.OwnerID, payload.FriendID, payload.UpdatedAt))
+	}
+	return stackErr.Error(h.baseRepo.RestrictionRepository().RemoveRestricted(ctx, payload.OwnerID, payload.FriendID))
+}
+
+// handleFriendRequestPrivacyUpdatedEvent tracks the contact-card switch only;
+// the other findability flags have no bearing on rooms.
+func (h *messageHandler) handleFriendRequestPrivacyUpdatedEvent(ctx context.Context, raw json.RawMessage) error {
+	payloadAny, err := decodeEventPayload(ctx, sharedevents.EventFriendRequestPrivacyUpdated, raw)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("decode event payload failed: %w", err))
+	}
+
+	payload, ok := payloadAny.(*sharedevents.FriendRequestPrivacyUpdatedEvent)
+	if !ok {
+		return stackErr.Error(fmt.Errorf("invalid payload type for event %s", sharedevents.EventFriendRequestPrivacyUpdated))
+	}
+
+	if !payload.FindableByQR {
+		return stackErr.Error(h.baseRepo.HiddenCardRepository().ProjectHidden(ctx, payload.AccountID, payload.UpdatedAt))
+	}
+	return stackErr.Error(h.baseRepo.HiddenCardRepository().RemoveHidden(ctx, payload.AccountID))
+}
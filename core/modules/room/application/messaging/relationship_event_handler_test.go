@@ -0,0 +1,140 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandleRelationshipEventProjectsBlockAndUnblock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	blockRepo := roomrepos.NewMockBlockRepository(ctrl)
+	remarkRepo := roomrepos.NewMockContactRemarkRepository(ctrl)
+	restrictionRepo := roomrepos.NewMockRestrictionRepository(ctrl)
+	baseRepo.EXPECT().BlockRepository().Return(blockRepo).AnyTimes()
+	baseRepo.EXPECT().ContactRemarkRepository().Return(remarkRepo).AnyTimes()
+	baseRepo.EXPECT().RestrictionRepository().Return(restrictionRepo).AnyTimes()
+	handler := &messageHandler{baseRepo: baseRepo}
+
+	blockedAt := time.Date(2026, 3, 3, 6, 5, 32, 0, time.UTC)
+	blockRepo.EXPECT().ProjectBlocked(gomock.Any(), "acc-1", "acc-2", blockedAt).Return(nil).Times(1)
+	blockRepo.EXPECT().RemoveBlocked(gomock.Any(), "acc-1", "acc-2").Return(nil).Times(1)
+	remarkRepo.EXPECT().RemoveBetween(gomock.Any(), "acc-1", "acc-2").Return(nil).Times(1)
+	restrictionRepo.EXPECT().RemoveBetween(gomock.Any(), "acc-1", "acc-2").Return(nil).Times(1)
+
+	blocked := []byte(`{
+		"event_name": "EventRelationshipPairBlocked",
+		"event_data": {"BlockID":"block-1","BlockerID":"acc-1","BlockedID":"acc-2","CreatedAt":"2026-03-03T06:05:32Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), blocked); err != nil {
+		t.Fatalf("handleRelationshipEvent(blocked) error = %v", err)
+	}
+
+	unblocked := []byte(`{
+		"event_name": "EventRelationshipPairUnblocked",
+		"event_data": {"BlockerID":"acc-1","BlockedID":"acc-2"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), unblocked); err != nil {
+		t.Fatalf("handleRelationshipEvent(unblocked) error = %v", err)
+	}
+}
+
+func TestHandleRelationshipEventProjectsRemarksAndDropsThemOnUnfriend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	remarkRepo := roomrepos.NewMockContactRemarkRepository(ctrl)
+	restrictionRepo := roomrepos.NewMockRestrictionRepository(ctrl)
+	baseRepo.EXPECT().ContactRemarkRepository().Return(remarkRepo).AnyTimes()
+	baseRepo.EXPECT().RestrictionRepository().Return(restrictionRepo).AnyTimes()
+	handler := &messageHandler{baseRepo: baseRepo}
+
+	updatedAt := time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC)
+	remarkRepo.EXPECT().ProjectRemark(gomock.Any(), "acc-1", "acc-2", "Mom", updatedAt).Return(nil).Times(1)
+	remarkRepo.EXPECT().RemoveBetween(gomock.Any(), "acc-2", "acc-1").Return(nil).Times(1)
+	restrictionRepo.EXPECT().RemoveBetween(gomock.Any(), "acc-2", "acc-1").Return(nil).Times(1)
+
+	remarked := []byte(`{
+		"event_name": "EventFriendContactRemarkUpdated",
+		"event_data": {"OwnerID":"acc-1","FriendID":"acc-2","RemarkName":"Mom","UpdatedAt":"2026-03-04T08:00:00Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), remarked); err != nil {
+		t.Fatalf("handleRelationshipEvent(remarked) error = %v", err)
+	}
+
+	unfriended := []byte(`{
+		"event_name": "EventRelationshipPairUnfriended",
+		"event_data": {"UserID":"acc-2","FriendID":"acc-1"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), unfriended); err != nil {
+		t.Fatalf("handleRelationshipEvent(unfriended) error = %v", err)
+	}
+}
+
+func TestHandleRelationshipEventProjectsRestrictedAudienceList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	restrictionRepo := roomrepos.NewMockRestrictionRepository(ctrl)
+	baseRepo.EXPECT().RestrictionRepository().Return(restrictionRepo).AnyTimes()
+	handler := &messageHandler{baseRepo: baseRepo}
+
+	updatedAt := time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)
+	restrictionRepo.EXPECT().ProjectRestricted(gomock.Any(), "acc-1", "acc-2", updatedAt).Return(nil).Times(1)
+	restrictionRepo.EXPECT().RemoveRestricted(gomock.Any(), "acc-1", "acc-2").Return(nil).Times(1)
+
+	restricted := []byte(`{
+		"event_name": "EventFriendContactAudienceListUpdated",
+		"event_data": {"OwnerID":"acc-1","FriendID":"acc-2","AudienceList":"restricted","UpdatedAt":"2026-03-05T09:00:00Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), restricted); err != nil {
+		t.Fatalf("handleRelationshipEvent(restricted) error = %v", err)
+	}
+
+	closeFriends := []byte(`{
+		"event_name": "EventFriendContactAudienceListUpdated",
+		"event_data": {"OwnerID":"acc-1","FriendID":"acc-2","AudienceList":"close_friends","UpdatedAt":"2026-03-05T10:00:00Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), closeFriends); err != nil {
+		t.Fatalf("handleRelationshipEvent(close_friends) error = %v", err)
+	}
+}
+
+func TestHandleRelationshipEventProjectsHiddenContactCards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	hiddenCardRepo := roomrepos.NewMockHiddenCardRepository(ctrl)
+	baseRepo.EXPECT().HiddenCardRepository().Return(hiddenCardRepo).AnyTimes()
+	handler := &messageHandler{baseRepo: baseRepo}
+
+	updatedAt := time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC)
+	hiddenCardRepo.EXPECT().ProjectHidden(gomock.Any(), "acc-1", updatedAt).Return(nil).Times(1)
+	hiddenCardRepo.EXPECT().RemoveHidden(gomock.Any(), "acc-1").Return(nil).Times(1)
+
+	hidden := []byte(`{
+		"event_name": "EventFriendRequestPrivacyUpdated",
+		"event_data": {"AccountID":"acc-1","FindableByUsername":true,"FindableByEmail":true,"FindableByQR":false,"UpdatedAt":"2026-03-06T09:00:00Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), hidden); err != nil {
+		t.Fatalf("handleRelationshipEvent(hidden) error = %v", err)
+	}
+
+	shown := []byte(`{
+		"event_name": "EventFriendRequestPrivacyUpdated",
+		"event_data": {"AccountID":"acc-1","FindableByUsername":true,"FindableByEmail":true,"FindableByQR":true,"UpdatedAt":"2026-03-06T10:00:00Z"}
+	}`)
+	if err := handler.handleRelationshipEvent(context.Background(), shown); err != nil {
+		t.Fatalf("handleRelationshipEvent(shown) error = %v", err)
+	}
+}
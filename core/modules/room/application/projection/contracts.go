@@ -27,6 +27,9 @@ type MessageSearchIndexer interface {
 
 type ProjectionMention = sharedevents.RoomProjectionMention
 type ProjectionReaction = sharedevents.RoomProjectionReaction
+type ProjectionContactCard = sharedevents.RoomMessageContactCard
+type ProjectionRedPacket = sharedevents.RoomMessageRedPacket
+type ProjectionTransfer = sharedevents.RoomMessageTransfer
 type RoomAggregateDeleted = sharedevents.RoomAggregateProjectionDeletedEvent
 type RoomAggregateSync = sharedevents.RoomAggregateProjectionSyncedEvent
 type RoomProjection = sharedevents.RoomProjection
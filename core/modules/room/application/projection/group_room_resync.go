@@ -0,0 +1,42 @@
+package projection
+
+import (
+	"context"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// GroupRoomRepublisher pages through group rooms in id order and publishes a
+// fresh aggregate projection event for one of them through the room outbox.
+type GroupRoomRepublisher interface {
+	ListGroupRoomIDsAfter(ctx context.Context, afterID string, limit int) ([]string, error)
+	RepublishRoom(ctx context.Context, roomID string) error
+}
+
+// ResyncGroupRooms publishes every group room to its consumers again. Other
+// modules that mirror room membership from room events, such as friend
+// suggestions, use it to catch up on rooms that existed before they did.
+func ResyncGroupRooms(ctx context.Context, rooms GroupRoomRepublisher, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	published := 0
+	afterID := ""
+	for {
+		roomIDs, err := rooms.ListGroupRoomIDsAfter(ctx, afterID, batchSize)
+		if err != nil {
+			return published, stackErr.Error(err)
+		}
+		for _, roomID := range roomIDs {
+			if err := rooms.RepublishRoom(ctx, roomID); err != nil {
+				return published, stackErr.Error(err)
+			}
+			published++
+		}
+		if len(roomIDs) < batchSize {
+			return published, nil
+		}
+		afterID = roomIDs[len(roomIDs)-1]
+	}
+}
@@ -0,0 +1,14 @@
+package query
+
+import (
+	"net/http"
+
+	"wechat-clone/core/shared/pkg/apperr"
+)
+
+var (
+	ErrRedPacketNotFound  = apperr.New("room.red_packet_not_found", "red packet not found", http.StatusNotFound)
+	ErrRedPacketForbidden = apperr.New("room.forbidden", "account is not a member of this room", http.StatusForbidden)
+
+	ErrTransferNotFound = apperr.New("room.transfer_not_found", "transfer not found", http.StatusNotFound)
+)
@@ -2,25 +2,50 @@ package query
 
 import (
 	"context"
+	"strings"
 
 	"wechat-clone/core/modules/room/application/dto/in"
 	"wechat-clone/core/modules/room/application/dto/out"
 	roomservice "wechat-clone/core/modules/room/application/service"
 	roomsupport "wechat-clone/core/modules/room/application/support"
 	apptypes "wechat-clone/core/modules/room/application/types"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 )
 
 type getChatPresenceHandler struct {
 	presence roomservice.PresenceQueryService
+	baseRepo roomrepos.Repos
 }
 
-func NewGetChatPresenceHandler(presence roomservice.PresenceQueryService) cqrs.Handler[*in.GetChatPresenceRequest, *out.ChatPresenceResponse] {
-	return &getChatPresenceHandler{presence: presence}
+func NewGetChatPresenceHandler(presence roomservice.PresenceQueryService, baseRepo roomrepos.Repos) cqrs.Handler[*in.GetChatPresenceRequest, *out.ChatPresenceResponse] {
+	return &getChatPresenceHandler{presence: presence, baseRepo: baseRepo}
 }
 
 func (h *getChatPresenceHandler) Handle(ctx context.Context, req *in.GetChatPresenceRequest) (*out.ChatPresenceResponse, error) {
+	viewerID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	// An account that blocked or restricted the viewer always reads as
+	// offline to them.
+	accountID := strings.TrimSpace(req.AccountID)
+	blocked, err := h.baseRepo.BlockRepository().IsBlocked(ctx, accountID, viewerID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !blocked {
+		blocked, err = h.baseRepo.RestrictionRepository().IsRestricted(ctx, accountID, viewerID)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+	}
+	if blocked {
+		return roomsupport.ToPresenceResponse(&apptypes.PresenceResult{AccountID: accountID, Status: "offline"}), nil
+	}
+
 	res, err := h.presence.GetPresence(ctx, apptypes.GetPresenceQuery{AccountID: req.AccountID})
 	if err != nil {
 		return nil, stackErr.Error(err)
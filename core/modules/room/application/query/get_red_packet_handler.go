@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getRedPacketHandler struct {
+	baseRepo roomrepos.Repos
+}
+
+func NewGetRedPacket(baseRepo roomrepos.Repos) cqrs.Handler[*in.GetRedPacketRequest, *out.RedPacketResponse] {
+	return &getRedPacketHandler{baseRepo: baseRepo}
+}
+
+func (u *getRedPacketHandler) Handle(ctx context.Context, req *in.GetRedPacketRequest) (*out.RedPacketResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	packet, err := u.baseRepo.RedPacketRepository().Get(ctx, req.RedPacketID)
+	if err != nil {
+		if errors.Is(err, entity.ErrRedPacketNotFound) {
+			return nil, stackErr.Error(ErrRedPacketNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	// Packets that never opened were not visible in the room.
+	if packet.Status == entity.RedPacketStatusPending || packet.Status == entity.RedPacketStatusFailed {
+		if packet.SenderID != accountID {
+			return nil, stackErr.Error(ErrRedPacketNotFound)
+		}
+	}
+
+	agg, err := u.baseRepo.RoomAggregateRepository().Load(ctx, packet.RoomID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if !isRoomMember(agg.Members(), accountID) && packet.SenderID != accountID {
+		return nil, stackErr.Error(ErrRedPacketForbidden)
+	}
+
+	claims, err := u.baseRepo.RedPacketRepository().ListClaims(ctx, packet.ID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return roomsupport.ToRedPacketResponse(packet, claims), nil
+}
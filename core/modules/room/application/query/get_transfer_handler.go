@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	roomsupport "wechat-clone/core/modules/room/application/support"
+	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+type getTransferHandler struct {
+	baseRepo roomrepos.Repos
+}
+
+func NewGetTransfer(baseRepo roomrepos.Repos) cqrs.Handler[*in.GetTransferRequest, *out.TransferResponse] {
+	return &getTransferHandler{baseRepo: baseRepo}
+}
+
+func (u *getTransferHandler) Handle(ctx context.Context, req *in.GetTransferRequest) (*out.TransferResponse, error) {
+	accountID, err := roomsupport.AccountIDFromCtx(ctx)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	transfer, err := u.baseRepo.TransferRepository().Get(ctx, req.TransferID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTransferNotFound) {
+			return nil, stackErr.Error(ErrTransferNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	// Only the two parties can see a transfer, and the recipient only once
+	// it has been posted in the room.
+	switch accountID {
+	case transfer.SenderID:
+	case transfer.RecipientID:
+		if transfer.Status == entity.TransferStatusHolding || transfer.Status == entity.TransferStatusFailed {
+			return nil, stackErr.Error(ErrTransferNotFound)
+		}
+	default:
+		return nil, stackErr.Error(ErrTransferNotFound)
+	}
+
+	return roomsupport.ToTransferResponse(transfer), nil
+}
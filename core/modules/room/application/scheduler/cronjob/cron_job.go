@@ -0,0 +1,69 @@
+package cronjob
+
+import (
+	"time"
+
+	roomtask "wechat-clone/core/modules/room/application/scheduler/task"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+)
+
+type CronJob interface {
+	Start() error
+	Stop() error
+}
+
+type cronJob struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewCronJob(scheduler *asynq.Scheduler, refundInterval, transferSweepInterval time.Duration) (CronJob, error) {
+	if scheduler == nil {
+		return &cronJob{}, nil
+	}
+
+	if err := registerPeriodic(scheduler, roomtask.RefundExpiredRedPacketsTask, refundInterval); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if err := registerPeriodic(scheduler, roomtask.SweepTransfersTask, transferSweepInterval); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return &cronJob{scheduler: scheduler}, nil
+}
+
+func registerPeriodic(scheduler *asynq.Scheduler, taskType string, interval time.Duration) error {
+	task := asynq.NewTask(taskType, nil)
+	if _, err := scheduler.Register(
+		roomtask.PeriodicSpec(interval),
+		task,
+		asynq.Queue(roomtask.QueueName),
+		asynq.MaxRetry(0),
+		asynq.Unique(interval),
+	); err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (j *cronJob) Start() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	if err := j.scheduler.Start(); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (j *cronJob) Stop() error {
+	if j == nil || j.scheduler == nil {
+		return nil
+	}
+
+	j.scheduler.Shutdown()
+	return nil
+}
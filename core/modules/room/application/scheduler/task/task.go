@@ -0,0 +1,20 @@
+package task
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	RefundExpiredRedPacketsTask = "room:red-packet:refund-expired"
+	SweepTransfersTask          = "room:transfer:sweep"
+	QueueName                   = "room:scheduler"
+)
+
+func PeriodicSpec(interval time.Duration) string {
+	seconds := int(interval / time.Second)
+	if seconds <= 0 {
+		seconds = 60
+	}
+	return fmt.Sprintf("@every %ds", seconds)
+}
@@ -0,0 +1,98 @@
+package taskhandler
+
+import (
+	"context"
+
+	roomtask "wechat-clone/core/modules/room/application/scheduler/task"
+	roomservice "wechat-clone/core/modules/room/application/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+)
+
+type TaskHandler interface {
+	Start() error
+	Stop() error
+}
+
+type taskHandler struct {
+	redPackets roomservice.RedPacketService
+	transfers  roomservice.TransferService
+	server     *asynq.Server
+}
+
+func NewTaskHandler(
+	redPackets roomservice.RedPacketService,
+	transfers roomservice.TransferService,
+	server *asynq.Server,
+) TaskHandler {
+	if redPackets == nil || transfers == nil || server == nil {
+		return &taskHandler{}
+	}
+	return &taskHandler{
+		redPackets: redPackets,
+		transfers:  transfers,
+		server:     server,
+	}
+}
+
+func (h *taskHandler) Start() error {
+	if h == nil || h.redPackets == nil || h.server == nil {
+		return nil
+	}
+
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(roomtask.RefundExpiredRedPacketsTask, h.handleRefundExpiredRedPackets)
+	mux.HandleFunc(roomtask.SweepTransfersTask, h.handleSweepTransfers)
+
+	if err := h.server.Start(mux); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return nil
+}
+
+func (h *taskHandler) Stop() error {
+	if h == nil || h.server == nil {
+		return nil
+	}
+
+	h.server.Shutdown()
+	return nil
+}
+
+func (h *taskHandler) handleRefundExpiredRedPackets(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.redPackets == nil {
+		return nil
+	}
+
+	refunded, err := h.redPackets.RefundExpired(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("refund expired red packets failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if refunded > 0 {
+		logging.FromContext(ctx).Infow("refunded expired red packets", zap.Int("count", refunded))
+	}
+
+	return nil
+}
+
+func (h *taskHandler) handleSweepTransfers(ctx context.Context, _ *asynq.Task) error {
+	if h == nil || h.transfers == nil {
+		return nil
+	}
+
+	handled, err := h.transfers.Sweep(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("sweep transfers failed", zap.Error(err))
+		return stackErr.Error(err)
+	}
+	if handled > 0 {
+		logging.FromContext(ctx).Infow("swept transfers", zap.Int("count", handled))
+	}
+
+	return nil
+}
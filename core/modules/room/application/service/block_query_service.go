@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// BlockQueryService answers block lookups for the websocket fan-out, where a
+// blocker's typing and presence signals must not reach the accounts they
+// blocked, and presence must not reach the friends they restricted.
+type BlockQueryService interface {
+	ListBlockedBy(ctx context.Context, blockerID string, accountIDs []string) ([]string, error)
+	ListRestrictedBy(ctx context.Context, ownerID string, accountIDs []string) ([]string, error)
+}
+
+type blockQueryService struct {
+	baseRepo roomrepos.Repos
+}
+
+func NewBlockQueryService(baseRepo roomrepos.Repos) BlockQueryService {
+	if baseRepo == nil {
+		return nil
+	}
+	return &blockQueryService{baseRepo: baseRepo}
+}
+
+func (s *blockQueryService) ListBlockedBy(ctx context.Context, blockerID string, accountIDs []string) ([]string, error) {
+	blockerID = strings.TrimSpace(blockerID)
+	if blockerID == "" || len(accountIDs) == 0 {
+		return []string{}, nil
+	}
+	blockedIDs, err := s.baseRepo.BlockRepository().ListBlockedBy(ctx, blockerID, accountIDs)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return blockedIDs, nil
+}
+
+func (s *blockQueryService) ListRestrictedBy(ctx context.Context, ownerID string, accountIDs []string) ([]string, error) {
+	ownerID = strings.TrimSpace(ownerID)
+	if ownerID == "" || len(accountIDs) == 0 {
+		return []string{}, nil
+	}
+	restrictedIDs, err := s.baseRepo.RestrictionRepository().ListRestrictedBy(ctx, ownerID, accountIDs)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return restrictedIDs, nil
+}
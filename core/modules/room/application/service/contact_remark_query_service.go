@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// ContactRemarkQueryService resolves the private remark names a viewer gave
+// their friends, so conversation names and mention candidates read the way
+// the viewer labelled them. Other viewers keep seeing display names.
+type ContactRemarkQueryService interface {
+	ListRemarks(ctx context.Context, viewerID string, targetIDs []string) (map[string]string, error)
+	SearchRemarkTargets(ctx context.Context, viewerID, keyword string, limit int) ([]string, error)
+}
+
+type contactRemarkQueryService struct {
+	baseRepo roomrepos.Repos
+}
+
+func NewContactRemarkQueryService(baseRepo roomrepos.Repos) ContactRemarkQueryService {
+	if baseRepo == nil {
+		return nil
+	}
+	return &contactRemarkQueryService{baseRepo: baseRepo}
+}
+
+func (s *contactRemarkQueryService) ListRemarks(ctx context.Context, viewerID string, targetIDs []string) (map[string]string, error) {
+	viewerID = strings.TrimSpace(viewerID)
+	if viewerID == "" || len(targetIDs) == 0 {
+		return map[string]string{}, nil
+	}
+	remarks, err := s.baseRepo.ContactRemarkRepository().ListRemarks(ctx, viewerID, targetIDs)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return remarks, nil
+}
+
+func (s *contactRemarkQueryService) SearchRemarkTargets(ctx context.Context, viewerID, keyword string, limit int) ([]string, error) {
+	viewerID = strings.TrimSpace(viewerID)
+	if viewerID == "" || strings.TrimSpace(keyword) == "" {
+		return []string{}, nil
+	}
+	targetIDs, err := s.baseRepo.ContactRemarkRepository().SearchRemarkTargets(ctx, viewerID, keyword, limit)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return targetIDs, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"wechat-clone/core/modules/room/application/projection"
 	roomsupport "wechat-clone/core/modules/room/application/support"
@@ -11,6 +12,7 @@ import (
 	"wechat-clone/core/shared/pkg/stackErr"
 	"wechat-clone/core/shared/utils"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -22,10 +24,11 @@ type ConversationQueryService interface {
 
 type conversationQueryService struct {
 	readRepos projection.QueryRepos
+	remarks   ContactRemarkQueryService
 }
 
-func newConversationQueryService(readRepos projection.QueryRepos) ConversationQueryService {
-	return &conversationQueryService{readRepos: readRepos}
+func newConversationQueryService(readRepos projection.QueryRepos, remarks ContactRemarkQueryService) ConversationQueryService {
+	return &conversationQueryService{readRepos: readRepos, remarks: remarks}
 }
 
 func (s *conversationQueryService) ListConversations(ctx context.Context, accountID string, query apptypes.ListConversationsQuery) ([]apptypes.ConversationResult, error) {
@@ -72,6 +75,9 @@ func (s *conversationQueryService) ListConversations(ctx context.Context, accoun
 		out = append(out, *item)
 	}
 
+	if err := s.applyRemarks(ctx, accountID, out); err != nil {
+		return nil, stackErr.Error(err)
+	}
 	return out, nil
 }
 
@@ -80,7 +86,16 @@ func (s *conversationQueryService) GetConversation(ctx context.Context, accountI
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	return roomsupport.BuildConversationResult(ctx, s.readRepos, accountID, room, true)
+	item, err := roomsupport.BuildConversationResult(ctx, s.readRepos, accountID, room, true)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	items := []apptypes.ConversationResult{*item}
+	if err := s.applyRemarks(ctx, accountID, items); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &items[0], nil
 }
 
 func (s *conversationQueryService) GetConversationMetadata(ctx context.Context, accountID string, query apptypes.GetConversationQuery) (*apptypes.ConversationMetadataResult, error) {
@@ -88,5 +103,59 @@ func (s *conversationQueryService) GetConversationMetadata(ctx context.Context,
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	return roomsupport.BuildConversationMetadataResult(ctx, s.readRepos, accountID, room)
+	metadata, err := roomsupport.BuildConversationMetadataResult(ctx, s.readRepos, accountID, room)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if s.remarks == nil || metadata == nil || metadata.DirectPeer == nil {
+		return metadata, nil
+	}
+
+	remarks, err := s.remarks.ListRemarks(ctx, accountID, []string{metadata.DirectPeer.AccountID})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if remark := remarks[metadata.DirectPeer.AccountID]; remark != "" {
+		metadata.DirectPeer.DisplayName = remark
+	}
+	return metadata, nil
+}
+
+// applyRemarks replaces member display names with the viewer's remark names,
+// and renames direct rooms after the remarked peer.
+func (s *conversationQueryService) applyRemarks(ctx context.Context, viewerID string, items []apptypes.ConversationResult) error {
+	if s.remarks == nil || len(items) == 0 {
+		return nil
+	}
+
+	targetIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		for _, member := range item.Members {
+			if member.AccountID != viewerID {
+				targetIDs = append(targetIDs, member.AccountID)
+			}
+		}
+	}
+	remarks, err := s.remarks.ListRemarks(ctx, viewerID, lo.Uniq(targetIDs))
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	if len(remarks) == 0 {
+		return nil
+	}
+
+	for i := range items {
+		for j := range items[i].Members {
+			member := &items[i].Members[j]
+			remark := remarks[member.AccountID]
+			if remark == "" || member.AccountID == viewerID {
+				continue
+			}
+			member.DisplayName = remark
+			if strings.EqualFold(strings.TrimSpace(items[i].RoomType), "direct") {
+				items[i].Name = remark
+			}
+		}
+	}
+	return nil
 }
@@ -19,10 +19,11 @@ type MentionQueryService interface {
 
 type mentionQueryService struct {
 	readRepos projection.QueryRepos
+	remarks   ContactRemarkQueryService
 }
 
-func newMentionQueryService(readRepos projection.QueryRepos) MentionQueryService {
-	return &mentionQueryService{readRepos: readRepos}
+func newMentionQueryService(readRepos projection.QueryRepos, remarks ContactRemarkQueryService) MentionQueryService {
+	return &mentionQueryService{readRepos: readRepos, remarks: remarks}
 }
 
 func (s *mentionQueryService) SearchMentionCandidates(ctx context.Context, accountID string, query apptypes.SearchMentionCandidatesQuery) ([]apptypes.MentionCandidateResult, error) {
@@ -57,14 +58,24 @@ func (s *mentionQueryService) SearchMentionCandidates(ctx context.Context, accou
 		return nil, stackErr.Error(err)
 	}
 
+	candidates, remarks, err := s.mergeRemarkCandidates(ctx, accountID, roomID, query, candidates)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
 	results := lo.FilterMap(candidates, func(candidate *views.MentionCandidateView, _ int) (apptypes.MentionCandidateResult, bool) {
 		if candidate == nil {
 			return apptypes.MentionCandidateResult{}, false
 		}
 
+		displayName := resolveMentionCandidateDisplayName(candidate)
+		if remark := remarks[candidate.AccountID]; remark != "" {
+			displayName = remark
+		}
+
 		return apptypes.MentionCandidateResult{
 			AccountID:       candidate.AccountID,
-			DisplayName:     resolveMentionCandidateDisplayName(candidate),
+			DisplayName:     displayName,
 			Username:        strings.TrimSpace(candidate.Username),
 			AvatarObjectKey: strings.TrimSpace(candidate.AvatarObjectKey),
 		}, true
@@ -75,6 +86,60 @@ func (s *mentionQueryService) SearchMentionCandidates(ctx context.Context, accou
 	return results, nil
 }
 
+// mergeRemarkCandidates adds room members whose remark name matches the
+// keyword and returns the viewer's remarks for every candidate.
+func (s *mentionQueryService) mergeRemarkCandidates(
+	ctx context.Context,
+	accountID string,
+	roomID string,
+	query apptypes.SearchMentionCandidatesQuery,
+	candidates []*views.MentionCandidateView,
+) ([]*views.MentionCandidateView, map[string]string, error) {
+	if s.remarks == nil {
+		return candidates, nil, nil
+	}
+
+	seen := make(map[string]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		if candidate != nil {
+			seen[candidate.AccountID] = struct{}{}
+		}
+	}
+
+	targetIDs, err := s.remarks.SearchRemarkTargets(ctx, accountID, query.Query, query.Limit)
+	if err != nil {
+		return nil, nil, stackErr.Error(err)
+	}
+	for _, targetID := range targetIDs {
+		if query.Limit > 0 && len(candidates) >= query.Limit {
+			break
+		}
+		if _, ok := seen[targetID]; ok || targetID == accountID {
+			continue
+		}
+		member, err := s.readRepos.RoomMemberReadRepository().GetRoomMemberByAccount(ctx, roomID, targetID)
+		if err != nil {
+			return nil, nil, stackErr.Error(err)
+		}
+		if member == nil {
+			continue
+		}
+		seen[targetID] = struct{}{}
+		candidates = append(candidates, &views.MentionCandidateView{
+			AccountID:       member.AccountID,
+			DisplayName:     member.DisplayName,
+			Username:        member.Username,
+			AvatarObjectKey: member.AvatarObjectKey,
+		})
+	}
+
+	remarks, err := s.remarks.ListRemarks(ctx, accountID, lo.Keys(seen))
+	if err != nil {
+		return nil, nil, stackErr.Error(err)
+	}
+	return candidates, remarks, nil
+}
+
 func resolveMentionCandidateDisplayName(candidate *views.MentionCandidateView) string {
 	if candidate == nil {
 		return ""
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRedPacketRefundBatchSize = 100
+
+	redPacketUnfundedReason = "escrow was never funded"
+)
+
+type RedPacketService interface {
+	// SettleClaim pays a reserved share out of escrow and announces it in the
+	// room. Settled claims are left untouched.
+	SettleClaim(ctx context.Context, packet *entity.RedPacket, claim *entity.RedPacketClaim) error
+	// RefundExpired closes one batch of expired packets, returning their
+	// unclaimed remainder to the sender, and returns how many were closed.
+	RefundExpired(ctx context.Context) (int, error)
+}
+
+type redPacketService struct {
+	baseRepo  roomrepos.Repos
+	ledger    domainservice.RedPacketLedger
+	batchSize int
+}
+
+func NewRedPacketService(appContext *appCtx.AppContext, baseRepo roomrepos.Repos, ledger domainservice.RedPacketLedger) RedPacketService {
+	service := &redPacketService{
+		baseRepo:  baseRepo,
+		ledger:    ledger,
+		batchSize: defaultRedPacketRefundBatchSize,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if batchSize := appContext.GetConfig().RoomConfig.RedPacket.RefundSweepBatchSize; batchSize > 0 {
+			service.batchSize = batchSize
+		}
+	}
+	return service
+}
+
+func (s *redPacketService) SettleClaim(ctx context.Context, packet *entity.RedPacket, claim *entity.RedPacketClaim) error {
+	if claim.IsSettled() {
+		return nil
+	}
+	if err := s.ledger.PayClaim(ctx, packet, claim); err != nil {
+		return stackErr.Error(err)
+	}
+
+	roomAgg, err := s.baseRepo.RoomAggregateRepository().Load(ctx, packet.RoomID)
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	now := time.Now().UTC()
+	claim.Settle(now)
+	if err := roomAgg.AnnounceRedPacketClaim(packet, claim, now); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos roomrepos.Repos) error {
+		// The packet lock serialises a retried claim with the refund sweep so
+		// the share is announced exactly once.
+		if _, err := txRepos.RedPacketRepository().GetForUpdate(ctx, packet.ID); err != nil {
+			return stackErr.Error(err)
+		}
+		current, err := txRepos.RedPacketRepository().GetClaim(ctx, claim.PacketID, claim.AccountID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if current == nil || current.IsSettled() {
+			return nil
+		}
+		if err := txRepos.RedPacketRepository().UpdateClaim(ctx, claim); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RoomAggregateRepository().Save(ctx, roomAgg))
+	}))
+}
+
+func (s *redPacketService) RefundExpired(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	packets, err := s.baseRepo.RedPacketRepository().ListExpired(ctx, now, s.batchSize)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	refunded := 0
+	for _, packet := range packets {
+		if packet == nil {
+			continue
+		}
+		if err := s.refundPacket(ctx, packet.ID, now); err != nil {
+			logging.FromContext(ctx).Warnw(
+				"refund red packet failed",
+				"red_packet_id", packet.ID,
+				zap.Error(err),
+			)
+			continue
+		}
+		refunded++
+	}
+
+	return refunded, nil
+}
+
+// refundPacket keeps the packet locked from reading its claims until it is
+// closed, so a claim cannot commit between what the sweep reads and what it
+// refunds. The ledger calls are idempotent, so a transaction that fails after
+// them is simply retried by the next sweep.
+func (s *redPacketService) refundPacket(ctx context.Context, packetID string, now time.Time) error {
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos roomrepos.Repos) error {
+		locked, err := txRepos.RedPacketRepository().GetForUpdate(ctx, packetID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if locked.Status != entity.RedPacketStatusOpen && locked.Status != entity.RedPacketStatusPending {
+			return nil
+		}
+		if locked.IsPending() {
+			return stackErr.Error(s.refundPending(ctx, txRepos, locked, now))
+		}
+
+		roomAgg, err := txRepos.RoomAggregateRepository().Load(ctx, locked.RoomID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+
+		// Shares reserved before expiry belong to their claimants, so any claim
+		// interrupted before payout is settled before the remainder goes back.
+		claims, err := txRepos.RedPacketRepository().ListClaims(ctx, locked.ID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		for _, claim := range claims {
+			if claim.IsSettled() {
+				continue
+			}
+			if err := s.ledger.PayClaim(ctx, locked, claim); err != nil {
+				return stackErr.Error(err)
+			}
+			claim.Settle(now)
+			if err := roomAgg.AnnounceRedPacketClaim(locked, claim, now); err != nil {
+				return stackErr.Error(err)
+			}
+			if err := txRepos.RedPacketRepository().UpdateClaim(ctx, claim); err != nil {
+				return stackErr.Error(err)
+			}
+		}
+
+		if err := s.ledger.Refund(ctx, locked, locked.RemainingAmount); err != nil {
+			return stackErr.Error(err)
+		}
+		if _, err := locked.Refund(now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.RedPacketRepository().Update(ctx, locked); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := roomAgg.AnnounceRedPacketRefund(locked, now); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RoomAggregateRepository().Save(ctx, roomAgg))
+	}))
+}
+
+// refundPending closes a packet that never opened. Its escrow is refunded in
+// full, or the packet is failed when the escrow was never funded.
+func (s *redPacketService) refundPending(ctx context.Context, txRepos roomrepos.Repos, locked *entity.RedPacket, now time.Time) error {
+	if err := s.ledger.Refund(ctx, locked, locked.RemainingAmount); err != nil {
+		if !errors.Is(err, domainservice.ErrRedPacketInsufficientFunds) {
+			return stackErr.Error(err)
+		}
+		if err := locked.Fail(redPacketUnfundedReason, now); err != nil {
+			return stackErr.Error(err)
+		}
+		return stackErr.Error(txRepos.RedPacketRepository().Update(ctx, locked))
+	}
+	if _, err := locked.Refund(now); err != nil {
+		return stackErr.Error(err)
+	}
+	return stackErr.Error(txRepos.RedPacketRepository().Update(ctx, locked))
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/aggregate"
+	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedPacketServiceRefundExpiredSettlesClaimCommittedAfterSweepRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repos := roomrepos.NewMockRepos(ctrl)
+	txRepos := roomrepos.NewMockRepos(ctrl)
+	packetRepo := roomrepos.NewMockRedPacketRepository(ctrl)
+	txPacketRepo := roomrepos.NewMockRedPacketRepository(ctrl)
+	txRoomAggRepo := roomrepos.NewMockRoomAggregateRepository(ctrl)
+	ledger := domainservice.NewMockRedPacketLedger(ctrl)
+
+	expiredAt := time.Now().UTC().Add(-time.Minute)
+	// The sweep lists the packet before anyone has claimed it.
+	snapshot := &entity.RedPacket{
+		ID:              "packet-1",
+		RoomID:          "room-1",
+		SenderID:        "sender-1",
+		Currency:        "VND",
+		TotalAmount:     100,
+		TotalCount:      2,
+		Status:          entity.RedPacketStatusOpen,
+		RemainingAmount: 100,
+		RemainingCount:  2,
+		ExpiresAt:       expiredAt,
+	}
+	// A claim commits before the sweep takes the lock.
+	locked := *snapshot
+	locked.RemainingAmount = 60
+	locked.RemainingCount = 1
+	claim := &entity.RedPacketClaim{PacketID: "packet-1", AccountID: "member-1", Amount: 40, ClaimedAt: expiredAt}
+
+	repos.EXPECT().RedPacketRepository().Return(packetRepo).AnyTimes()
+	packetRepo.EXPECT().ListExpired(gomock.Any(), gomock.Any(), defaultRedPacketRefundBatchSize).Return([]*entity.RedPacket{snapshot}, nil)
+	repos.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(roomrepos.Repos) error) error {
+		return fn(txRepos)
+	})
+	txRepos.EXPECT().RedPacketRepository().Return(txPacketRepo).AnyTimes()
+	txRepos.EXPECT().RoomAggregateRepository().Return(txRoomAggRepo).AnyTimes()
+	txPacketRepo.EXPECT().GetForUpdate(gomock.Any(), "packet-1").Return(&locked, nil)
+	txRoomAggRepo.EXPECT().Load(gomock.Any(), "room-1").Return(testRoomAggregate(t, "room-1", "sender-1", "member-1"), nil)
+	txPacketRepo.EXPECT().ListClaims(gomock.Any(), "packet-1").Return([]*entity.RedPacketClaim{claim}, nil)
+
+	gomock.InOrder(
+		ledger.EXPECT().PayClaim(gomock.Any(), &locked, claim).Return(nil),
+		ledger.EXPECT().Refund(gomock.Any(), &locked, int64(60)).Return(nil),
+	)
+	txPacketRepo.EXPECT().UpdateClaim(gomock.Any(), claim).Return(nil)
+	txPacketRepo.EXPECT().Update(gomock.Any(), &locked).DoAndReturn(func(_ context.Context, packet *entity.RedPacket) error {
+		if packet.Status != entity.RedPacketStatusRefunded || packet.RefundedAmount != 60 {
+			t.Fatalf("expected packet refunded with 60, got status=%s refunded=%d", packet.Status, packet.RefundedAmount)
+		}
+		return nil
+	})
+	txRoomAggRepo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&aggregate.RoomAggregate{})).Return(nil)
+
+	service := &redPacketService{baseRepo: repos, ledger: ledger, batchSize: defaultRedPacketRefundBatchSize}
+	refunded, err := service.RefundExpired(context.Background())
+	if err != nil {
+		t.Fatalf("RefundExpired() error = %v", err)
+	}
+	if refunded != 1 {
+		t.Fatalf("refunded = %d, want 1", refunded)
+	}
+	if !claim.IsSettled() {
+		t.Fatal("expected the interleaved claim to be settled")
+	}
+}
+
+func TestRedPacketServiceRefundExpiredSkipsPacketSettledAfterSweepRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repos := roomrepos.NewMockRepos(ctrl)
+	txRepos := roomrepos.NewMockRepos(ctrl)
+	packetRepo := roomrepos.NewMockRedPacketRepository(ctrl)
+	txPacketRepo := roomrepos.NewMockRedPacketRepository(ctrl)
+	ledger := domainservice.NewMockRedPacketLedger(ctrl)
+
+	snapshot := &entity.RedPacket{ID: "packet-1", RoomID: "room-1", Status: entity.RedPacketStatusOpen, RemainingAmount: 40, RemainingCount: 1}
+	locked := *snapshot
+	locked.Status = entity.RedPacketStatusClaimed
+	locked.RemainingAmount = 0
+	locked.RemainingCount = 0
+
+	repos.EXPECT().RedPacketRepository().Return(packetRepo).AnyTimes()
+	packetRepo.EXPECT().ListExpired(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.RedPacket{snapshot}, nil)
+	repos.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(roomrepos.Repos) error) error {
+		return fn(txRepos)
+	})
+	txRepos.EXPECT().RedPacketRepository().Return(txPacketRepo).AnyTimes()
+	txPacketRepo.EXPECT().GetForUpdate(gomock.Any(), "packet-1").Return(&locked, nil)
+	ledger.EXPECT().Refund(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	service := &redPacketService{baseRepo: repos, ledger: ledger, batchSize: defaultRedPacketRefundBatchSize}
+	if _, err := service.RefundExpired(context.Background()); err != nil {
+		t.Fatalf("RefundExpired() error = %v", err)
+	}
+}
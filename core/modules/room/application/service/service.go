@@ -32,11 +32,11 @@ type chatService struct {
 	room          RoomQueryService
 }
 
-func NewService(appCtx *appCtx.AppContext, readRepos projection.QueryRepos) Service {
+func NewService(appCtx *appCtx.AppContext, readRepos projection.QueryRepos, remarks ContactRemarkQueryService) Service {
 	return &chatService{
-		conversations: newConversationQueryService(readRepos),
+		conversations: newConversationQueryService(readRepos, remarks),
 		messages:      newMessageQueryService(readRepos),
-		mentions:      newMentionQueryService(readRepos),
+		mentions:      newMentionQueryService(readRepos, remarks),
 		presence:      newPresenceQueryService(appCtx),
 		realtime:      newRealtimeService(appCtx),
 		room:          newRoomQueryService(readRepos),
@@ -8,6 +8,7 @@ import (
 	appCtx "wechat-clone/core/context"
 	"wechat-clone/core/modules/room/application/projection"
 	apptypes "wechat-clone/core/modules/room/application/types"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
 	"wechat-clone/core/modules/room/infra/projection/cassandra/views"
 	"wechat-clone/core/shared/utils"
 
@@ -102,7 +103,7 @@ func TestChatQueryServiceListConversationsSkipsRoomsWithoutViewerMembership(t *t
 		}, nil).
 		Times(1)
 
-	service := NewService(&appCtx.AppContext{}, queryRepos)
+	service := NewService(&appCtx.AppContext{}, queryRepos, nil)
 
 	results, err := service.ListConversations(context.Background(), viewerID, apptypes.ListConversationsQuery{
 		Limit:  20,
@@ -122,3 +123,67 @@ func TestChatQueryServiceListConversationsSkipsRoomsWithoutViewerMembership(t *t
 		t.Fatalf("expected direct room name to resolve from peer member, got %s", results[0].Name)
 	}
 }
+
+func TestChatQueryServiceGetConversationUsesViewerRemarkForDirectPeer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	viewerID := "viewer-account"
+	now := time.Date(2026, time.April, 14, 1, 30, 0, 0, time.UTC)
+
+	queryRepos := projection.NewMockQueryRepos(ctrl)
+	roomRepo := projection.NewMockRoomReadRepository(ctrl)
+	messageRepo := projection.NewMockMessageReadRepository(ctrl)
+	memberRepo := projection.NewMockRoomMemberReadRepository(ctrl)
+	queryRepos.EXPECT().RoomReadRepository().Return(roomRepo).AnyTimes()
+	queryRepos.EXPECT().MessageReadRepository().Return(messageRepo).AnyTimes()
+	queryRepos.EXPECT().RoomMemberReadRepository().Return(memberRepo).AnyTimes()
+
+	baseRepo := roomrepos.NewMockRepos(ctrl)
+	remarkRepo := roomrepos.NewMockContactRemarkRepository(ctrl)
+	baseRepo.EXPECT().ContactRemarkRepository().Return(remarkRepo).AnyTimes()
+
+	roomRepo.EXPECT().
+		GetRoomByID(gomock.Any(), "direct-room").
+		Return(&views.RoomView{
+			ID:        "direct-room",
+			RoomType:  "direct",
+			OwnerID:   viewerID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}, nil).
+		Times(1)
+	messageRepo.EXPECT().CountUnreadMessages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+	messageRepo.EXPECT().GetLastMessage(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	memberRepo.EXPECT().
+		ListRoomMembers(gomock.Any(), "direct-room").
+		Return([]*views.RoomMemberView{
+			{ID: "member-1", RoomID: "direct-room", AccountID: viewerID, Role: "owner", DisplayName: "Viewer", CreatedAt: now, UpdatedAt: now},
+			{ID: "member-2", RoomID: "direct-room", AccountID: "peer-account", Role: "member", DisplayName: "Peer User", CreatedAt: now, UpdatedAt: now},
+		}, nil).
+		Times(1)
+	remarkRepo.EXPECT().
+		ListRemarks(gomock.Any(), viewerID, []string{"peer-account"}).
+		Return(map[string]string{"peer-account": "Mom"}, nil).
+		Times(1)
+
+	service := NewService(&appCtx.AppContext{}, queryRepos, NewContactRemarkQueryService(baseRepo))
+
+	result, err := service.GetConversation(context.Background(), viewerID, apptypes.GetConversationQuery{RoomID: "direct-room"})
+	if err != nil {
+		t.Fatalf("GetConversation() error = %v", err)
+	}
+	if result.Name != "Mom" {
+		t.Fatalf("expected direct room name to use the viewer's remark, got %s", result.Name)
+	}
+	for _, member := range result.Members {
+		if member.AccountID == "peer-account" && member.DisplayName != "Mom" {
+			t.Fatalf("expected peer display name to use the remark, got %s", member.DisplayName)
+		}
+		if member.AccountID == viewerID && member.DisplayName != "Viewer" {
+			t.Fatalf("expected viewer display name to stay unchanged, got %s", member.DisplayName)
+		}
+	}
+}
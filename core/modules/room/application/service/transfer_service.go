@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appCtx "wechat-clone/core/context"
+	"wechat-clone/core/modules/room/domain/entity"
+	roomrepos "wechat-clone/core/modules/room/domain/repos"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTransferSweepBatchSize = 100
+
+	transferUnfundedReason = "hold was never funded"
+	transferExpiredReason  = "transfer expired before its hold was confirmed"
+)
+
+type TransferService interface {
+	// Resolve applies a status change to a pending transfer under its row
+	// lock, updates the transfer card and announces it in the room, then
+	// settles the hold.
+	Resolve(ctx context.Context, transferID string, apply func(transfer *entity.Transfer, now time.Time) error) (*entity.Transfer, error)
+	// Settle pays a resolved transfer out of its hold, to the recipient if it
+	// was accepted and back to the sender otherwise. Settled transfers are
+	// left untouched.
+	Settle(ctx context.Context, transfer *entity.Transfer) error
+	// Sweep returns one batch of expired transfers, closes transfers that
+	// never opened and retries interrupted settlements. It returns how many
+	// transfers were handled.
+	Sweep(ctx context.Context) (int, error)
+}
+
+type transferService struct {
+	baseRepo  roomrepos.Repos
+	ledger    domainservice.TransferLedger
+	batchSize int
+}
+
+func NewTransferService(appContext *appCtx.AppContext, baseRepo roomrepos.Repos, ledger domainservice.TransferLedger) TransferService {
+	service := &transferService{
+		baseRepo:  baseRepo,
+		ledger:    ledger,
+		batchSize: defaultTransferSweepBatchSize,
+	}
+	if appContext != nil && appContext.GetConfig() != nil {
+		if batchSize := appContext.GetConfig().RoomConfig.Transfer.SweepBatchSize; batchSize > 0 {
+			service.batchSize = batchSize
+		}
+	}
+	return service
+}
+
+func (s *transferService) Resolve(
+	ctx context.Context,
+	transferID string,
+	apply func(transfer *entity.Transfer, now time.Time) error,
+) (*entity.Transfer, error) {
+	var transfer *entity.Transfer
+	if err := s.baseRepo.WithTransaction(ctx, func(txRepos roomrepos.Repos) error {
+		locked, err := txRepos.TransferRepository().GetForUpdate(ctx, transferID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		now := time.Now().UTC()
+		if err := apply(locked, now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.TransferRepository().Update(ctx, locked); err != nil {
+			return stackErr.Error(err)
+		}
+		transfer = locked
+
+		roomAgg, err := txRepos.RoomAggregateRepository().Load(ctx, locked.RoomID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := roomAgg.RecordTransferUpdated(locked, now); err != nil {
+			return stackErr.Error(err)
+		}
+		if err := txRepos.RoomAggregateRepository().Save(ctx, roomAgg); err != nil {
+			return stackErr.Error(err)
+		}
+
+		messageAgg, err := txRepos.MessageAggregateRepository().Load(ctx, locked.MessageID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if err := messageAgg.UpdateTransferCard(locked); err != nil {
+			return stackErr.Error(err)
+		}
+		if !messageAgg.MessageDirty() {
+			return nil
+		}
+		return stackErr.Error(txRepos.MessageAggregateRepository().Save(ctx, messageAgg))
+	}); err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	// The status is committed before the money moves; a failed settlement is
+	// picked up again by the sweep.
+	if err := s.Settle(ctx, transfer); err != nil {
+		logging.FromContext(ctx).Warnw(
+			"settle transfer failed",
+			"transfer_id", transfer.ID,
+			zap.Error(err),
+		)
+	}
+	return transfer, nil
+}
+
+func (s *transferService) Settle(ctx context.Context, transfer *entity.Transfer) error {
+	if transfer.IsSettled() {
+		return nil
+	}
+	if transfer.PaysRecipient() {
+		if err := s.ledger.Pay(ctx, transfer); err != nil {
+			return stackErr.Error(err)
+		}
+	} else if err := s.ledger.Release(ctx, transfer); err != nil {
+		return stackErr.Error(err)
+	}
+
+	return stackErr.Error(s.markSettled(ctx, transfer, time.Now().UTC()))
+}
+
+func (s *transferService) Sweep(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	expired, err := s.baseRepo.TransferRepository().ListExpired(ctx, now, s.batchSize)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+	unsettled, err := s.baseRepo.TransferRepository().ListUnsettled(ctx, s.batchSize)
+	if err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	handled := 0
+	for _, transfer := range expired {
+		if transfer == nil {
+			continue
+		}
+		if err := s.closeExpired(ctx, transfer, now); err != nil {
+			logging.FromContext(ctx).Warnw(
+				"return expired transfer failed",
+				"transfer_id", transfer.ID,
+				zap.Error(err),
+			)
+			continue
+		}
+		handled++
+	}
+	for _, transfer := range unsettled {
+		if transfer == nil {
+			continue
+		}
+		if err := s.Settle(ctx, transfer); err != nil {
+			logging.FromContext(ctx).Warnw(
+				"settle transfer failed",
+				"transfer_id", transfer.ID,
+				zap.Error(err),
+			)
+			continue
+		}
+		handled++
+	}
+
+	return handled, nil
+}
+
+func (s *transferService) closeExpired(ctx context.Context, transfer *entity.Transfer, now time.Time) error {
+	if transfer.IsPending() {
+		_, err := s.Resolve(ctx, transfer.ID, func(locked *entity.Transfer, now time.Time) error {
+			return stackErr.Error(locked.Return(now))
+		})
+		return stackErr.Error(err)
+	}
+
+	// A transfer still holding at expiry was interrupted before it opened.
+	// Whatever reached its hold goes back to the sender.
+	reason := transferExpiredReason
+	if err := s.ledger.Release(ctx, transfer); err != nil {
+		if !errors.Is(err, domainservice.ErrTransferInsufficientFunds) {
+			return stackErr.Error(err)
+		}
+		reason = transferUnfundedReason
+	}
+
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos roomrepos.Repos) error {
+		locked, err := txRepos.TransferRepository().GetForUpdate(ctx, transfer.ID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if !locked.IsHolding() {
+			return nil
+		}
+		if err := locked.Fail(reason, now); err != nil {
+			return stackErr.Error(err)
+		}
+		locked.Settle(now)
+		return stackErr.Error(txRepos.TransferRepository().Update(ctx, locked))
+	}))
+}
+
+func (s *transferService) markSettled(ctx context.Context, transfer *entity.Transfer, now time.Time) error {
+	return stackErr.Error(s.baseRepo.WithTransaction(ctx, func(txRepos roomrepos.Repos) error {
+		// The lock serialises a settlement retried by the sweep with the one
+		// started by the response, so SettledAt is written once.
+		locked, err := txRepos.TransferRepository().GetForUpdate(ctx, transfer.ID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		if locked.IsSettled() {
+			transfer.SettledAt = locked.SettledAt
+			return nil
+		}
+		locked.Settle(now)
+		if err := txRepos.TransferRepository().Update(ctx, locked); err != nil {
+			return stackErr.Error(err)
+		}
+		transfer.SettledAt = locked.SettledAt
+		return nil
+	}))
+}
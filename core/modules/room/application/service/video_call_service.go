@@ -11,6 +11,7 @@ import (
 	appCtx "wechat-clone/core/context"
 	apptypes "wechat-clone/core/modules/room/application/types"
 	"wechat-clone/core/modules/room/constant"
+	"wechat-clone/core/modules/room/domain/aggregate"
 	"wechat-clone/core/modules/room/domain/entity"
 	roomrepos "wechat-clone/core/modules/room/domain/repos"
 	sharedcache "wechat-clone/core/shared/infra/cache"
@@ -79,7 +80,7 @@ func (s *videoCallService) GetActiveCall(ctx context.Context, query apptypes.Get
 
 func (s *videoCallService) StartCall(ctx context.Context, command apptypes.StartVideoCallCommand) (*apptypes.VideoCallSessionResult, error) {
 	return withVideoCallRoomLock(ctx, s.locker, command.RoomID, func() (*apptypes.VideoCallSessionResult, error) {
-		if _, err := s.requireRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
+		if _, err := s.requireCallableRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
 			return nil, stackErr.Error(err)
 		}
 
@@ -104,7 +105,7 @@ func (s *videoCallService) StartCall(ctx context.Context, command apptypes.Start
 
 func (s *videoCallService) JoinCall(ctx context.Context, command apptypes.JoinVideoCallCommand) (*apptypes.VideoCallSessionResult, error) {
 	return withVideoCallRoomLock(ctx, s.locker, command.RoomID, func() (*apptypes.VideoCallSessionResult, error) {
-		if _, err := s.requireRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
+		if _, err := s.requireCallableRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
 			return nil, stackErr.Error(err)
 		}
 
@@ -174,7 +175,7 @@ func (s *videoCallService) EndCall(ctx context.Context, command apptypes.EndVide
 }
 
 func (s *videoCallService) RelaySignal(ctx context.Context, command apptypes.RelayVideoCallSignalCommand) (*apptypes.VideoCallSignalResult, error) {
-	if _, err := s.requireRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
+	if _, err := s.requireCallableRoomMember(ctx, command.RoomID, command.ActorID); err != nil {
 		return nil, stackErr.Error(err)
 	}
 	if err := entity.ValidateVideoCallSignalType(command.SignalType); err != nil {
@@ -203,20 +204,52 @@ func (s *videoCallService) RelaySignal(ctx context.Context, command apptypes.Rel
 }
 
 func (s *videoCallService) requireRoomMember(ctx context.Context, roomID, actorID string) (*entity.RoomMemberEntity, error) {
-	roomAgg, err := s.baseRepo.RoomAggregateRepository().Load(ctx, strings.TrimSpace(roomID))
+	_, member, err := s.loadRoomMember(ctx, roomID, actorID)
+	return member, stackErr.Error(err)
+}
+
+// requireCallableRoomMember is requireRoomMember plus the block check: in a
+// direct room a block in either direction stops the call from being started,
+// joined or signalled. Leaving and ending stay open so a live call can wind down.
+func (s *videoCallService) requireCallableRoomMember(ctx context.Context, roomID, actorID string) (*entity.RoomMemberEntity, error) {
+	roomAgg, member, err := s.loadRoomMember(ctx, roomID, actorID)
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	if roomAgg.Room() == nil || roomAgg.Room().IsGroup() {
+		return member, nil
+	}
+
+	for _, peer := range roomAgg.Members() {
+		if peer == nil || strings.TrimSpace(peer.AccountID) == strings.TrimSpace(actorID) {
+			continue
+		}
+		blocked, err := s.baseRepo.BlockRepository().IsBlockedBetween(ctx, actorID, peer.AccountID)
+		if err != nil {
+			return nil, stackErr.Error(err)
+		}
+		if blocked {
+			return nil, stackErr.Error(entity.ErrRoomPeerBlocked)
+		}
+	}
+	return member, nil
+}
+
+func (s *videoCallService) loadRoomMember(ctx context.Context, roomID, actorID string) (*aggregate.RoomAggregate, *entity.RoomMemberEntity, error) {
+	roomAgg, err := s.baseRepo.RoomAggregateRepository().Load(ctx, strings.TrimSpace(roomID))
+	if err != nil {
+		return nil, nil, stackErr.Error(err)
+	}
 
 	for _, member := range roomAgg.Members() {
 		if member == nil {
 			continue
 		}
 		if strings.TrimSpace(member.AccountID) == strings.TrimSpace(actorID) {
-			return member, nil
+			return roomAgg, member, nil
 		}
 	}
-	return nil, stackErr.Error(entity.ErrRoomMemberRequired)
+	return nil, nil, stackErr.Error(entity.ErrRoomMemberRequired)
 }
 
 func (s *videoCallService) requireActiveSession(ctx context.Context, roomID, sessionID string) (*entity.VideoCallSession, error) {
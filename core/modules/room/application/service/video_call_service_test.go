@@ -106,6 +106,45 @@ func TestVideoCallServiceRelaySignalRequiresParticipant(t *testing.T) {
 	}
 }
 
+func TestVideoCallServiceStartCallRejectsBlockedDirectRoom(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repos := roomrepos.NewMockRepos(ctrl)
+	roomAggRepo := roomrepos.NewMockRoomAggregateRepository(ctrl)
+	blockRepo := roomrepos.NewMockBlockRepository(ctrl)
+	locker := lock.NewMockLock(ctrl)
+
+	service := &videoCallService{
+		baseRepo: repos,
+		locker:   locker,
+		store:    newVideoCallSessionCacheStore(sharedcache.NewMockCache(ctrl)),
+	}
+
+	room, err := entity.NewDirectConversationRoom("room-1", "actor-1", "peer-1", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("NewDirectConversationRoom() error = %v", err)
+	}
+	roomAgg := testRoomAggregateWithRoom(t, room, "actor-1", "peer-1")
+
+	locker.EXPECT().AcquireLock(gomock.Any(), "room:video_call:room-1", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	locker.EXPECT().ReleaseLock(gomock.Any(), "room:video_call:room-1", gomock.Any()).Return(true, nil)
+	repos.EXPECT().RoomAggregateRepository().Return(roomAggRepo).AnyTimes()
+	repos.EXPECT().BlockRepository().Return(blockRepo).AnyTimes()
+	roomAggRepo.EXPECT().Load(gomock.Any(), "room-1").Return(roomAgg, nil).Times(1)
+	blockRepo.EXPECT().IsBlockedBetween(gomock.Any(), "actor-1", "peer-1").Return(true, nil).Times(1)
+
+	_, err = service.StartCall(context.Background(), apptypes.StartVideoCallCommand{
+		RoomID:  "room-1",
+		ActorID: "actor-1",
+	})
+	if !errors.Is(err, entity.ErrRoomPeerBlocked) {
+		t.Fatalf("StartCall() error = %v, want %v", err, entity.ErrRoomPeerBlocked)
+	}
+}
+
 func testRoomAggregate(t *testing.T, roomID string, memberIDs ...string) *aggregate.RoomAggregate {
 	t.Helper()
 
@@ -113,6 +152,12 @@ func testRoomAggregate(t *testing.T, roomID string, memberIDs ...string) *aggreg
 	if err != nil {
 		t.Fatalf("NewRoom() error = %v", err)
 	}
+	return testRoomAggregateWithRoom(t, room, memberIDs...)
+}
+
+func testRoomAggregateWithRoom(t *testing.T, room *entity.Room, memberIDs ...string) *aggregate.RoomAggregate {
+	t.Helper()
+	roomID := room.ID
 
 	members := make([]*entity.RoomMemberEntity, 0, len(memberIDs))
 	for idx, memberID := range memberIDs {
@@ -104,6 +104,9 @@ func ToMessageResponse(res *apptypes.MessageResult) *out.ChatMessageResponse {
 		FileSize:               res.FileSize,
 		MimeType:               res.MimeType,
 		ObjectKey:              res.ObjectKey,
+		ContactCard:            toContactCardResponse(res.ContactCard),
+		RedPacket:              toRedPacketCardResponse(res.RedPacket),
+		Transfer:               toTransferCardResponse(res.Transfer),
 		EditedAt:               res.EditedAt,
 		DeletedForEveryone:     res.DeletedForEveryone,
 		CreatedAt:              res.CreatedAt,
@@ -122,6 +125,45 @@ func ToPresenceResponse(res *apptypes.PresenceResult) *out.ChatPresenceResponse
 	}
 }
 
+func toContactCardResponse(res *apptypes.MessageContactCardResult) *out.ChatMessageContactCardResponse {
+	if res == nil {
+		return nil
+	}
+	return &out.ChatMessageContactCardResponse{
+		AccountID:       res.AccountID,
+		DisplayName:     res.DisplayName,
+		Username:        res.Username,
+		AvatarObjectKey: res.AvatarObjectKey,
+		Token:           res.Token,
+	}
+}
+
+func toRedPacketCardResponse(res *apptypes.MessageRedPacketResult) *out.ChatMessageRedPacketResponse {
+	if res == nil {
+		return nil
+	}
+	return &out.ChatMessageRedPacketResponse{
+		RedPacketID: res.PacketID,
+		Currency:    res.Currency,
+		TotalAmount: res.TotalAmount,
+		Count:       res.Count,
+		SplitMode:   res.SplitMode,
+	}
+}
+
+func toTransferCardResponse(res *apptypes.MessageTransferResult) *out.ChatMessageTransferResponse {
+	if res == nil {
+		return nil
+	}
+	return &out.ChatMessageTransferResponse{
+		TransferID:  res.TransferID,
+		RecipientID: res.RecipientID,
+		Currency:    res.Currency,
+		Amount:      res.Amount,
+		Status:      res.Status,
+	}
+}
+
 func toPreviewResponse(res *apptypes.MessagePreviewResult) *out.ChatMessagePreviewResponse {
 	if res == nil {
 		return nil
@@ -0,0 +1,46 @@
+package support
+
+import (
+	"time"
+
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+// ToRedPacketResponse maps a packet and its claims. Only settled claims are
+// listed; a reserved share appears once it has actually been paid out.
+func ToRedPacketResponse(packet *entity.RedPacket, claims []*entity.RedPacketClaim) *out.RedPacketResponse {
+	if packet == nil {
+		return nil
+	}
+
+	items := make([]out.RedPacketClaimItemResponse, 0, len(claims))
+	for _, claim := range claims {
+		if !claim.IsSettled() {
+			continue
+		}
+		items = append(items, out.RedPacketClaimItemResponse{
+			AccountID: claim.AccountID,
+			Amount:    claim.Amount,
+			ClaimedAt: claim.ClaimedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return &out.RedPacketResponse{
+		RedPacketID:     packet.ID,
+		RoomID:          packet.RoomID,
+		MessageID:       packet.MessageID,
+		SenderID:        packet.SenderID,
+		Currency:        packet.Currency,
+		TotalAmount:     packet.TotalAmount,
+		Count:           packet.TotalCount,
+		SplitMode:       packet.SplitMode,
+		Greeting:        packet.Greeting,
+		Status:          packet.Status,
+		RemainingAmount: packet.RemainingAmount,
+		RemainingCount:  packet.RemainingCount,
+		ExpiresAt:       packet.ExpiresAt.UTC().Format(time.RFC3339),
+		CreatedAt:       packet.CreatedAt.UTC().Format(time.RFC3339),
+		Claims:          items,
+	}
+}
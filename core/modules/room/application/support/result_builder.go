@@ -306,6 +306,30 @@ func (b *messageQueryBuilder) Build(ctx context.Context, input MessageBuildInput
 	}
 	if input.Message.DeletedForEveryoneAt != nil {
 		result.Message = ""
+	} else if card := input.Message.ContactCard; card != nil {
+		result.ContactCard = &apptypes.MessageContactCardResult{
+			AccountID:       card.AccountID,
+			DisplayName:     card.DisplayName,
+			Username:        card.Username,
+			AvatarObjectKey: card.AvatarObjectKey,
+			Token:           card.Token,
+		}
+	} else if card := input.Message.RedPacket; card != nil {
+		result.RedPacket = &apptypes.MessageRedPacketResult{
+			PacketID:    card.PacketID,
+			Currency:    card.Currency,
+			TotalAmount: card.TotalAmount,
+			Count:       card.Count,
+			SplitMode:   card.SplitMode,
+		}
+	} else if card := input.Message.Transfer; card != nil {
+		result.Transfer = &apptypes.MessageTransferResult{
+			TransferID:  card.TransferID,
+			RecipientID: card.RecipientID,
+			Currency:    card.Currency,
+			Amount:      card.Amount,
+			Status:      card.Status,
+		}
 	}
 
 	if len(input.Message.Mentions) > 0 {
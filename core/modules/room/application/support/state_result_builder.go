@@ -124,6 +124,30 @@ func BuildMessageResultFromState(
 	}
 	if message.DeletedForEveryoneAt != nil {
 		result.Message = ""
+	} else if card := message.ContactCard; card != nil {
+		result.ContactCard = &apptypes.MessageContactCardResult{
+			AccountID:       card.AccountID,
+			DisplayName:     card.DisplayName,
+			Username:        card.Username,
+			AvatarObjectKey: card.AvatarObjectKey,
+			Token:           card.Token,
+		}
+	} else if card := message.RedPacket; card != nil {
+		result.RedPacket = &apptypes.MessageRedPacketResult{
+			PacketID:    card.PacketID,
+			Currency:    card.Currency,
+			TotalAmount: card.TotalAmount,
+			Count:       card.Count,
+			SplitMode:   card.SplitMode,
+		}
+	} else if card := message.Transfer; card != nil {
+		result.Transfer = &apptypes.MessageTransferResult{
+			TransferID:  card.TransferID,
+			RecipientID: card.RecipientID,
+			Currency:    card.Currency,
+			Amount:      card.Amount,
+			Status:      card.Status,
+		}
 	}
 
 	if len(message.Mentions) > 0 {
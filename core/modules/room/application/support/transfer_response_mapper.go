@@ -0,0 +1,35 @@
+package support
+
+import (
+	"time"
+
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+func ToTransferResponse(transfer *entity.Transfer) *out.TransferResponse {
+	if transfer == nil {
+		return nil
+	}
+
+	settledAt := ""
+	if transfer.SettledAt != nil {
+		settledAt = transfer.SettledAt.UTC().Format(time.RFC3339)
+	}
+
+	return &out.TransferResponse{
+		TransferID:  transfer.ID,
+		RoomID:      transfer.RoomID,
+		MessageID:   transfer.MessageID,
+		SenderID:    transfer.SenderID,
+		RecipientID: transfer.RecipientID,
+		Currency:    transfer.Currency,
+		Amount:      transfer.Amount,
+		Note:        transfer.Note,
+		Status:      transfer.Status,
+		ExpiresAt:   transfer.ExpiresAt.UTC().Format(time.RFC3339),
+		SettledAt:   settledAt,
+		CreatedAt:   transfer.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   transfer.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -46,6 +46,7 @@ type SendMessageCommand struct {
 	FileSize               int64
 	MimeType               string
 	ObjectKey              string
+	ContactAccountID       string
 }
 
 type EditMessageCommand struct {
@@ -26,6 +26,30 @@ type MessageMentionResult struct {
 	Username    string
 }
 
+type MessageContactCardResult struct {
+	AccountID       string
+	DisplayName     string
+	Username        string
+	AvatarObjectKey string
+	Token           string
+}
+
+type MessageRedPacketResult struct {
+	PacketID    string
+	Currency    string
+	TotalAmount int64
+	Count       int
+	SplitMode   string
+}
+
+type MessageTransferResult struct {
+	TransferID  string
+	RecipientID string
+	Currency    string
+	Amount      int64
+	Status      string
+}
+
 type MessageReactionResult struct {
 	Emoji       string
 	Count       int
@@ -56,6 +80,9 @@ type MessageResult struct {
 	FileSize               int64
 	MimeType               string
 	ObjectKey              string
+	ContactCard            *MessageContactCardResult
+	RedPacket              *MessageRedPacketResult
+	Transfer               *MessageTransferResult
 	EditedAt               string
 	DeletedForEveryone     bool
 	CreatedAt              string
@@ -23,6 +23,6 @@ func buildProjectionHandler(cfg *config.Config, appCtx *appCtx.AppContext) (room
 		return nil, stackErr.Error(err)
 	}
 	accountProjectionRepo := roomrepo.NewRoomAccountImpl(appCtx.GetDB())
-	roomService := roomservice.NewService(appCtx, roomReadRepos)
+	roomService := roomservice.NewService(appCtx, roomReadRepos, roomservice.NewContactRemarkQueryService(repos))
 	return roomprojection.NewMessageHandler(cfg, repos, accountProjectionRepo, roomService)
 }
@@ -0,0 +1,12 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	roomledger "wechat-clone/core/modules/room/infra/ledger"
+)
+
+func newRedPacketLedger(appContext *appCtx.AppContext) domainservice.RedPacketLedger {
+	return roomledger.NewRedPacketLedger(ledgerassembly.BuildService(appContext))
+}
@@ -11,6 +11,7 @@ import (
 	roomserver "wechat-clone/core/modules/room/transport/server"
 	roomsocket "wechat-clone/core/modules/room/transport/websocket"
 	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/contactcard"
 	"wechat-clone/core/shared/pkg/cqrs"
 	"wechat-clone/core/shared/pkg/stackErr"
 	modruntime "wechat-clone/core/shared/runtime"
@@ -30,29 +31,45 @@ func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (http.H
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
-	roomService := roomservice.NewService(appContext, roomReadRepos)
+	roomService := roomservice.NewService(appContext, roomReadRepos, roomservice.NewContactRemarkQueryService(roomRepos))
 	videoCallService := roomservice.NewVideoCallService(appContext, roomRepos)
+	blockQueryService := roomservice.NewBlockQueryService(roomRepos)
+	contactCardSigner, err := contactcard.NewHMACSigner(appContext.GetConfig().AuthConfig.ContactCard.Secret)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 	createDirectConversation := cqrs.NewDispatcher(roomcommand.NewCreateDirectConversationHandler(roomRepos))
 	createGroupChat := cqrs.NewDispatcher(roomcommand.NewCreateGroupChatHandler(roomRepos))
 	updateGroupChat := cqrs.NewDispatcher(roomcommand.NewUpdateGroupChatHandler(roomRepos, roomService))
 	addChatMember := cqrs.NewDispatcher(roomcommand.NewAddChatMemberHandler(roomRepos, roomService))
 	removeChatMember := cqrs.NewDispatcher(roomcommand.NewRemoveChatMemberHandler(roomRepos, roomService))
 	pinChatMessage := cqrs.NewDispatcher(roomcommand.NewPinChatMessageHandler(roomRepos, roomService))
-	sendChatMessage := cqrs.NewDispatcher(roomcommand.NewSendChatMessageHandler(roomRepos, roomService))
+	sendChatMessage := cqrs.NewDispatcher(roomcommand.NewSendChatMessageHandler(roomRepos, roomService, contactCardSigner))
 	editChatMessage := cqrs.NewDispatcher(roomcommand.NewEditChatMessageHandler(roomRepos, roomService))
 	deleteChatMessage := cqrs.NewDispatcher(roomcommand.NewDeleteChatMessageHandler(roomRepos, roomService))
-	forwardChatMessage := cqrs.NewDispatcher(roomcommand.NewForwardChatMessageHandler(roomRepos, roomService))
+	forwardChatMessage := cqrs.NewDispatcher(roomcommand.NewForwardChatMessageHandler(roomRepos, roomService, contactCardSigner))
 	markChatMessageStatus := cqrs.NewDispatcher(roomcommand.NewMarkChatMessageStatusHandler(roomRepos, roomService))
 	listChatConversations := cqrs.NewDispatcher(roomquery.NewListChatConversationsHandler(roomService))
 	getChatConversation := cqrs.NewDispatcher(roomquery.NewGetChatConversationHandler(roomService))
 	getChatConversationMetadata := cqrs.NewDispatcher(roomquery.NewGetChatConversationMetadataHandler(roomService))
 	listChatMessages := cqrs.NewDispatcher(roomquery.NewListChatMessagesHandler(roomService))
 	searchChatMentions := cqrs.NewDispatcher(roomquery.NewSearchChatMentionsHandler(roomService))
-	getChatPresence := cqrs.NewDispatcher(roomquery.NewGetChatPresenceHandler(roomService))
+	getChatPresence := cqrs.NewDispatcher(roomquery.NewGetChatPresenceHandler(roomService, roomRepos))
 	createChatMessagePresignedURL := cqrs.NewDispatcher(roomcommand.NewCreateChatMessagePresignedURLHandler(appContext, roomRepos))
 	getChatMessageMedia := cqrs.NewDispatcher(roomquery.NewGetChatMessageMediaHandler(appContext, roomRepos))
 	toggleChatMessageReaction := cqrs.NewDispatcher(roomcommand.NewToggleChatMessageReactionHandler(roomRepos, roomService))
-	socketHub := roomsocket.NewHub(ctx, appContext, videoCallService)
+	redPacketLedger := newRedPacketLedger(appContext)
+	redPacketService := roomservice.NewRedPacketService(appContext, roomRepos, redPacketLedger)
+	sendRedPacket := cqrs.NewDispatcher(roomcommand.NewSendRedPacket(appContext, roomRepos, redPacketLedger))
+	claimRedPacket := cqrs.NewDispatcher(roomcommand.NewClaimRedPacket(roomRepos, redPacketService))
+	getRedPacket := cqrs.NewDispatcher(roomquery.NewGetRedPacket(roomRepos))
+	transferLedger := newTransferLedger(appContext)
+	transferService := roomservice.NewTransferService(appContext, roomRepos, transferLedger)
+	sendTransfer := cqrs.NewDispatcher(roomcommand.NewSendTransfer(appContext, roomRepos, transferLedger))
+	acceptTransfer := cqrs.NewDispatcher(roomcommand.NewAcceptTransfer(transferService))
+	declineTransfer := cqrs.NewDispatcher(roomcommand.NewDeclineTransfer(transferService))
+	getTransfer := cqrs.NewDispatcher(roomquery.NewGetTransfer(roomRepos))
+	socketHub := roomsocket.NewHub(ctx, appContext, videoCallService, blockQueryService)
 	socketUpgrader := sharedsocket.NewUpgrader()
 	socketHandler := roomsocket.NewWSHandler(appContext, socketHub, socketUpgrader)
 	server, err := roomserver.NewHTTPServer(
@@ -76,6 +93,13 @@ func buildHTTPServer(ctx context.Context, appContext *appCtx.AppContext) (http.H
 		removeChatMember,
 		pinChatMessage,
 		getChatPresence,
+		sendRedPacket,
+		claimRedPacket,
+		getRedPacket,
+		sendTransfer,
+		acceptTransfer,
+		declineTransfer,
+		getTransfer,
 		socketHandler.Handle,
 		socketHub.Close,
 	)
@@ -0,0 +1,44 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	roomtask "wechat-clone/core/modules/room/application/scheduler/task"
+	"wechat-clone/core/modules/room/application/scheduler/taskhandler"
+	roomservice "wechat-clone/core/modules/room/application/service"
+	roomrepo "wechat-clone/core/modules/room/infra/persistent/repository"
+	"wechat-clone/core/shared/config"
+	"wechat-clone/core/shared/pkg/stackErr"
+	modruntime "wechat-clone/core/shared/runtime"
+
+	"github.com/hibiken/asynq"
+)
+
+func buildTaskRuntime(_ *config.Config, appContext *appCtx.AppContext) (modruntime.Module, error) {
+	roomRepos, err := roomrepo.NewRepoImpl(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	redPacketService := roomservice.NewRedPacketService(appContext, roomRepos, newRedPacketLedger(appContext))
+	transferService := roomservice.NewTransferService(appContext, roomRepos, newTransferLedger(appContext))
+
+	server, err := newAsynqServer(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return taskhandler.NewTaskHandler(redPacketService, transferService, server), nil
+}
+
+func newAsynqServer(appContext *appCtx.AppContext) (*asynq.Server, error) {
+	redisConnOpt, err := newAsynqRedisConnOpt(appContext)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return asynq.NewServer(redisConnOpt, asynq.Config{
+		Concurrency: 1,
+		Queues: map[string]int{
+			roomtask.QueueName: 1,
+		},
+	}), nil
+}
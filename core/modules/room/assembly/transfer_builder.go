@@ -0,0 +1,12 @@
+package assembly
+
+import (
+	appCtx "wechat-clone/core/context"
+	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
+	domainservice "wechat-clone/core/modules/room/domain/service"
+	roomledger "wechat-clone/core/modules/room/infra/ledger"
+)
+
+func newTransferLedger(appContext *appCtx.AppContext) domainservice.TransferLedger {
+	return roomledger.NewTransferLedger(ledgerassembly.BuildService(appContext))
+}
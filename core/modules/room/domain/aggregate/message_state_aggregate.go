@@ -82,6 +82,30 @@ func (a *MessageStateAggregate) Edit(actorID, content string, editedAt time.Time
 	return nil
 }
 
+// RefreshContactCard updates a shared contact card with the account's current
+// profile.
+func (a *MessageStateAggregate) RefreshContactCard(account *entity.AccountEntity) error {
+	if a == nil || a.message == nil {
+		return stackErr.Error(ErrMessageAggregateNil)
+	}
+	if a.message.RefreshContactCard(account) {
+		a.messageDirty = true
+	}
+	return nil
+}
+
+// UpdateTransferCard moves a transfer message's card to the transfer's
+// current status.
+func (a *MessageStateAggregate) UpdateTransferCard(transfer *entity.Transfer) error {
+	if a == nil || a.message == nil {
+		return stackErr.Error(ErrMessageAggregateNil)
+	}
+	if a.message.UpdateTransferStatus(transfer) {
+		a.messageDirty = true
+	}
+	return nil
+}
+
 func (a *MessageStateAggregate) ToggleReaction(accountID, emoji string, reactedAt time.Time) error {
 	if a == nil || a.message == nil {
 		return stackErr.Error(ErrMessageAggregateNil)
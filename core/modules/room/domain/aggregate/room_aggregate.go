@@ -132,6 +132,7 @@ func (r *RoomAggregate) RegisterEvents(register event.RegisterEventsFunc) error
 		&EventRoomMemberRemoved{},
 		&EventRoomMessageCreated{},
 		&sharedevents.RoomMessageCreatedEvent{},
+		&sharedevents.RoomTransferUpdatedEvent{},
 	)
 }
 
@@ -153,6 +154,8 @@ func (r *RoomAggregate) Transition(e event.Event) error {
 		return r.applyRoomMessageCreated(data.RoomID, data.MessageID, data.MessageContent, data.MessageSentAt)
 	case *sharedevents.RoomMessageCreatedEvent:
 		return r.applyRoomMessageCreated(data.RoomID, data.MessageID, data.MessageContent, data.MessageSentAt)
+	case *sharedevents.RoomTransferUpdatedEvent:
+		return r.ensureRoomID(data.RoomID)
 	default:
 		return event.ErrUnsupportedEventType
 	}
@@ -527,6 +530,108 @@ func (a *RoomAggregate) SendMessage(
 	return message, nil
 }
 
+// RedPacketShareLimit returns how many shares a packet sent by senderID may
+// be split into: every member of a group, or the other member of a direct room.
+func (a *RoomAggregate) RedPacketShareLimit(senderID string) (int, error) {
+	if _, err := a.requireMember(senderID); err != nil {
+		return 0, stackErr.Error(err)
+	}
+
+	limit := len(a.members)
+	if !a.room.IsGroup() {
+		limit--
+	}
+	return limit, nil
+}
+
+// ClaimRedPacket reserves the next share of a packet posted in this room.
+// The caller must hold a lock on the packet.
+func (a *RoomAggregate) ClaimRedPacket(packet *entity.RedPacket, accountID string, randN func(n int64) int64, now time.Time) (*entity.RedPacketClaim, error) {
+	if _, err := a.requireMember(accountID); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	if packet == nil || packet.RoomID != a.room.ID {
+		return nil, stackErr.Error(entity.ErrRedPacketNotFound)
+	}
+
+	claim, err := packet.Claim(accountID, !a.room.IsGroup(), randN, now)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return claim, nil
+}
+
+// AnnounceRedPacketClaim posts the system message for a claimed share, and a
+// second one once the last share is gone. Membership was checked when the
+// share was reserved, so a claimant who has since left is still announced.
+func (a *RoomAggregate) AnnounceRedPacketClaim(packet *entity.RedPacket, claim *entity.RedPacketClaim, now time.Time) error {
+	if a == nil || a.room == nil {
+		return stackErr.Error(ErrRoomAggregateNil)
+	}
+
+	if _, err := a.appendSystemMessage(claim.AccountID, fmt.Sprintf("%s claimed a red packet from %s", claim.AccountID, packet.SenderID), now); err != nil {
+		return stackErr.Error(err)
+	}
+	if packet.Status == entity.RedPacketStatusClaimed {
+		if _, err := a.appendSystemMessage(claim.AccountID, fmt.Sprintf("red packet from %s has been fully claimed", packet.SenderID), now); err != nil {
+			return stackErr.Error(err)
+		}
+	}
+	return nil
+}
+
+// AnnounceRedPacketRefund posts the system message for an expired packet
+// whose unclaimed remainder went back to the sender.
+func (a *RoomAggregate) AnnounceRedPacketRefund(packet *entity.RedPacket, now time.Time) error {
+	if a == nil || a.room == nil {
+		return stackErr.Error(ErrRoomAggregateNil)
+	}
+
+	_, err := a.appendSystemMessage(packet.SenderID, fmt.Sprintf("red packet from %s expired, %d %s returned to the sender", packet.SenderID, packet.RefundedAmount, packet.Currency), now)
+	return stackErr.Error(err)
+}
+
+// TransferRecipient returns the other member of a direct room, who is the
+// only account senderID may send a transfer to.
+func (a *RoomAggregate) TransferRecipient(senderID string) (string, error) {
+	if _, err := a.requireMember(senderID); err != nil {
+		return "", stackErr.Error(err)
+	}
+	if a.room.IsGroup() {
+		return "", stackErr.Error(entity.ErrTransferDirectRoomOnly)
+	}
+
+	for _, member := range a.Members() {
+		if member != nil && member.AccountID != strings.TrimSpace(senderID) {
+			return member.AccountID, nil
+		}
+	}
+	return "", stackErr.Error(entity.ErrTransferRecipientRequired)
+}
+
+// RecordTransferUpdated publishes a transfer's current status. Membership is
+// not checked, so a transfer still resolves after either party has left.
+func (a *RoomAggregate) RecordTransferUpdated(transfer *entity.Transfer, now time.Time) error {
+	if a == nil || a.room == nil {
+		return stackErr.Error(ErrRoomAggregateNil)
+	}
+	if transfer == nil || transfer.RoomID != a.room.ID {
+		return stackErr.Error(entity.ErrTransferNotFound)
+	}
+
+	return stackErr.Error(a.recordEvent(&sharedevents.RoomTransferUpdatedEvent{
+		TransferID:  transfer.ID,
+		RoomID:      transfer.RoomID,
+		MessageID:   transfer.MessageID,
+		SenderID:    transfer.SenderID,
+		RecipientID: transfer.RecipientID,
+		Currency:    transfer.Currency,
+		Amount:      transfer.Amount,
+		Status:      transfer.Status,
+		UpdatedAt:   transfer.UpdatedAt,
+	}, now))
+}
+
 func (a *RoomAggregate) recordMessageCreated(
 	message *entity.MessageEntity,
 	sender MessageSenderIdentity,
@@ -546,6 +651,9 @@ func (a *RoomAggregate) recordMessageCreated(
 		FileSize:               message.FileSize,
 		MimeType:               message.MimeType,
 		ObjectKey:              message.ObjectKey,
+		ContactCard:            toRoomMessageContactCard(message.ContactCard),
+		RedPacket:              toRoomMessageRedPacket(message.RedPacket),
+		Transfer:               toRoomMessageTransfer(message.Transfer),
 		MessageSenderID:        message.SenderID,
 		MessageSenderName:      strings.TrimSpace(sender.Name),
 		MessageSenderEmail:     strings.TrimSpace(sender.Email),
@@ -636,3 +744,42 @@ func (a *RoomAggregate) recordEvent(payload interface{}, createdAt time.Time) er
 	events[len(events)-1].CreatedAt = createdAt.UTC().UnixMilli()
 	return nil
 }
+
+func toRoomMessageContactCard(card *entity.MessageContactCard) *sharedevents.RoomMessageContactCard {
+	if card == nil {
+		return nil
+	}
+	return &sharedevents.RoomMessageContactCard{
+		AccountID:       card.AccountID,
+		DisplayName:     card.DisplayName,
+		Username:        card.Username,
+		AvatarObjectKey: card.AvatarObjectKey,
+		Token:           card.Token,
+	}
+}
+
+func toRoomMessageRedPacket(card *entity.MessageRedPacket) *sharedevents.RoomMessageRedPacket {
+	if card == nil {
+		return nil
+	}
+	return &sharedevents.RoomMessageRedPacket{
+		PacketID:    card.PacketID,
+		Currency:    card.Currency,
+		TotalAmount: card.TotalAmount,
+		Count:       card.Count,
+		SplitMode:   card.SplitMode,
+	}
+}
+
+func toRoomMessageTransfer(card *entity.MessageTransfer) *sharedevents.RoomMessageTransfer {
+	if card == nil {
+		return nil
+	}
+	return &sharedevents.RoomMessageTransfer{
+		TransferID:  card.TransferID,
+		RecipientID: card.RecipientID,
+		Currency:    card.Currency,
+		Amount:      card.Amount,
+		Status:      card.Status,
+	}
+}
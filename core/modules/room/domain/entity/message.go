@@ -17,6 +17,9 @@ type MessageEntity struct {
 	FileSize               int64
 	MimeType               string
 	ObjectKey              string
+	ContactCard            *MessageContactCard
+	RedPacket              *MessageRedPacket
+	Transfer               *MessageTransfer
 	EditedAt               *time.Time
 	DeletedForEveryoneAt   *time.Time
 	CreatedAt              time.Time
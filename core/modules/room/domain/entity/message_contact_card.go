@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrMessageContactCardRequired    = errors.New("contact_account_id is required for contact card messages")
+	ErrMessageContactCardSelf        = errors.New("cannot share your own contact card")
+	ErrMessageContactCardNotEditable = errors.New("contact card messages cannot be edited")
+)
+
+// MessageContactCard is the profile shared by a contact card message. The
+// preview fields are copied from the room account projection when the card is
+// sent and refreshed when that account changes its profile. Token lets the
+// recipients send a friend request that credits the sharer.
+type MessageContactCard struct {
+	AccountID       string
+	DisplayName     string
+	Username        string
+	AvatarObjectKey string
+	Token           string
+}
+
+func NormalizeMessageContactCard(card *MessageContactCard) *MessageContactCard {
+	if card == nil || strings.TrimSpace(card.AccountID) == "" {
+		return nil
+	}
+
+	return &MessageContactCard{
+		AccountID:       strings.TrimSpace(card.AccountID),
+		DisplayName:     strings.TrimSpace(card.DisplayName),
+		Username:        strings.TrimSpace(card.Username),
+		AvatarObjectKey: strings.TrimSpace(card.AvatarObjectKey),
+		Token:           strings.TrimSpace(card.Token),
+	}
+}
+
+// RefreshContactCard copies the account's current profile onto the card and
+// reports whether anything changed.
+func (m *MessageEntity) RefreshContactCard(account *AccountEntity) bool {
+	if m.ContactCard == nil || account == nil || m.ContactCard.AccountID != strings.TrimSpace(account.AccountID) {
+		return false
+	}
+
+	refreshed := *m.ContactCard
+	refreshed.DisplayName = strings.TrimSpace(account.DisplayName)
+	refreshed.Username = strings.TrimSpace(account.Username)
+	refreshed.AvatarObjectKey = strings.TrimSpace(account.AvatarObjectKey)
+	if refreshed == *m.ContactCard {
+		return false
+	}
+
+	m.ContactCard = &refreshed
+	return true
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrMessageRedPacketRequired    = errors.New("red packet messages must be sent through the red packet endpoint")
+	ErrMessageRedPacketNotEditable = errors.New("red packet messages cannot be edited")
+)
+
+// MessageRedPacket is the card posted when a red packet opens. It only
+// describes the packet; claim progress is read from the packet itself and
+// announced with system messages.
+type MessageRedPacket struct {
+	PacketID    string
+	Currency    string
+	TotalAmount int64
+	Count       int
+	SplitMode   string
+}
+
+func NormalizeMessageRedPacket(card *MessageRedPacket) *MessageRedPacket {
+	if card == nil || strings.TrimSpace(card.PacketID) == "" {
+		return nil
+	}
+
+	return &MessageRedPacket{
+		PacketID:    strings.TrimSpace(card.PacketID),
+		Currency:    strings.ToUpper(strings.TrimSpace(card.Currency)),
+		TotalAmount: card.TotalAmount,
+		Count:       card.Count,
+		SplitMode:   NormalizeRedPacketSplitMode(card.SplitMode),
+	}
+}
+
+func (p *RedPacket) MessageCard() *MessageRedPacket {
+	return &MessageRedPacket{
+		PacketID:    p.ID,
+		Currency:    p.Currency,
+		TotalAmount: p.TotalAmount,
+		Count:       p.TotalCount,
+		SplitMode:   p.SplitMode,
+	}
+}
@@ -9,12 +9,18 @@ import (
 )
 
 const (
-	MessageTypeText     = "text"
-	MessageTypeSystem   = "system"
-	MessageTypeImage    = "image"
-	MessageTypeFile     = "file"
-	MessageTypeSticker  = "sticker"
+	MessageTypeText    = "text"
+	MessageTypeSystem  = "system"
+	MessageTypeImage   = "image"
+	MessageTypeFile    = "file"
+	MessageTypeSticker = "sticker"
+	// MessageTypeTransfer carries money sent to the other member of a direct
+	// room, waiting for them to accept or decline it.
 	MessageTypeTransfer = "transfer"
+	// MessageTypeContactCard shares another account's profile in the chat.
+	MessageTypeContactCard = "contact_card"
+	// MessageTypeRedPacket announces a red packet that room members can claim.
+	MessageTypeRedPacket = "red_packet"
 )
 
 var (
@@ -40,6 +46,9 @@ type MessageParams struct {
 	FileSize               int64
 	MimeType               string
 	ObjectKey              string
+	ContactCard            *MessageContactCard
+	RedPacket              *MessageRedPacket
+	Transfer               *MessageTransfer
 }
 
 func NewMessage(id, roomID, senderID string, params MessageParams, now time.Time) (*MessageEntity, error) {
@@ -49,6 +58,18 @@ func NewMessage(id, roomID, senderID string, params MessageParams, now time.Time
 	messageType := NormalizeMessageType(params.MessageType)
 	content := strings.TrimSpace(params.Message)
 	objectKey := strings.TrimSpace(params.ObjectKey)
+	var contactCard *MessageContactCard
+	if messageType == MessageTypeContactCard {
+		contactCard = NormalizeMessageContactCard(params.ContactCard)
+	}
+	var redPacket *MessageRedPacket
+	if messageType == MessageTypeRedPacket {
+		redPacket = NormalizeMessageRedPacket(params.RedPacket)
+	}
+	var transfer *MessageTransfer
+	if messageType == MessageTypeTransfer {
+		transfer = NormalizeMessageTransfer(params.Transfer)
+	}
 	mentions, err := NormalizeMessageMentions(params.Mentions)
 	if err != nil {
 		return nil, stackErr.Error(err)
@@ -67,6 +88,14 @@ func NewMessage(id, roomID, senderID string, params MessageParams, now time.Time
 		return nil, stackErr.Error(ErrMessageBodyRequired)
 	case (messageType == MessageTypeImage || messageType == MessageTypeFile || messageType == MessageTypeSticker) && objectKey == "":
 		return nil, stackErr.Error(ErrMessageObjectKeyRequired)
+	case messageType == MessageTypeContactCard && contactCard == nil:
+		return nil, stackErr.Error(ErrMessageContactCardRequired)
+	case contactCard != nil && contactCard.AccountID == senderID:
+		return nil, stackErr.Error(ErrMessageContactCardSelf)
+	case messageType == MessageTypeRedPacket && redPacket == nil:
+		return nil, stackErr.Error(ErrMessageRedPacketRequired)
+	case messageType == MessageTypeTransfer && transfer == nil:
+		return nil, stackErr.Error(ErrMessageTransferRequired)
 	}
 
 	return &MessageEntity{
@@ -84,6 +113,9 @@ func NewMessage(id, roomID, senderID string, params MessageParams, now time.Time
 		FileSize:               params.FileSize,
 		MimeType:               strings.TrimSpace(params.MimeType),
 		ObjectKey:              objectKey,
+		ContactCard:            contactCard,
+		RedPacket:              redPacket,
+		Transfer:               transfer,
 		CreatedAt:              normalizeRoomTime(now),
 	}, nil
 }
@@ -102,6 +134,10 @@ func NormalizeMessageType(value string) string {
 		return MessageTypeSticker
 	case MessageTypeTransfer:
 		return MessageTypeTransfer
+	case MessageTypeContactCard:
+		return MessageTypeContactCard
+	case MessageTypeRedPacket:
+		return MessageTypeRedPacket
 	default:
 		return ""
 	}
@@ -114,6 +150,15 @@ func (m *MessageEntity) Edit(actorID, content string, editedAt time.Time) error
 	if NormalizeMessageType(m.MessageType) == MessageTypeSystem {
 		return stackErr.Error(ErrMessageCannotEditSystem)
 	}
+	if NormalizeMessageType(m.MessageType) == MessageTypeContactCard {
+		return stackErr.Error(ErrMessageContactCardNotEditable)
+	}
+	if NormalizeMessageType(m.MessageType) == MessageTypeRedPacket {
+		return stackErr.Error(ErrMessageRedPacketNotEditable)
+	}
+	if NormalizeMessageType(m.MessageType) == MessageTypeTransfer {
+		return stackErr.Error(ErrMessageTransferNotEditable)
+	}
 	if content = strings.TrimSpace(content); content == "" {
 		return stackErr.Error(ErrMessageBodyRequired)
 	}
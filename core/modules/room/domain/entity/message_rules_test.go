@@ -68,3 +68,76 @@ func TestNewMessageNormalizesMentions(t *testing.T) {
 		t.Fatalf("expected trimmed display_name Alice, got %q", message.Mentions[0].DisplayName)
 	}
 }
+
+func TestNewMessageContactCardRules(t *testing.T) {
+	_, err := NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		MessageType: MessageTypeContactCard,
+	}, time.Now().UTC())
+	if !errors.Is(err, ErrMessageContactCardRequired) {
+		t.Fatalf("expected contact card required error, got %v", err)
+	}
+
+	_, err = NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		MessageType: MessageTypeContactCard,
+		ContactCard: &MessageContactCard{AccountID: "user-1"},
+	}, time.Now().UTC())
+	if !errors.Is(err, ErrMessageContactCardSelf) {
+		t.Fatalf("expected own contact card error, got %v", err)
+	}
+
+	message, err := NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		MessageType: MessageTypeContactCard,
+		ContactCard: &MessageContactCard{AccountID: " user-2 ", DisplayName: "Alice"},
+	}, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if message.ContactCard == nil || message.ContactCard.AccountID != "user-2" {
+		t.Fatalf("expected normalized contact card, got %+v", message.ContactCard)
+	}
+	if err := message.Edit("user-1", "updated", time.Now().UTC()); !errors.Is(err, ErrMessageContactCardNotEditable) {
+		t.Fatalf("expected contact card not editable error, got %v", err)
+	}
+
+	if message.RefreshContactCard(&AccountEntity{AccountID: "user-2", DisplayName: "Alice"}) {
+		t.Fatalf("expected unchanged profile not to refresh the card")
+	}
+	if !message.RefreshContactCard(&AccountEntity{AccountID: "user-2", DisplayName: "Alice B", Username: "alice"}) {
+		t.Fatalf("expected changed profile to refresh the card")
+	}
+	if message.ContactCard.DisplayName != "Alice B" || message.ContactCard.Username != "alice" {
+		t.Fatalf("expected refreshed preview, got %+v", message.ContactCard)
+	}
+}
+
+func TestNewMessageDropsContactCardForOtherTypes(t *testing.T) {
+	message, err := NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		Message:     "hello",
+		MessageType: MessageTypeText,
+		ContactCard: &MessageContactCard{AccountID: "user-2"},
+	}, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if message.ContactCard != nil {
+		t.Fatalf("expected text message without contact card, got %+v", message.ContactCard)
+	}
+}
+
+func TestNewMessageRequiresRedPacketCard(t *testing.T) {
+	_, err := NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		MessageType: MessageTypeRedPacket,
+	}, time.Now().UTC())
+	if !errors.Is(err, ErrMessageRedPacketRequired) {
+		t.Fatalf("expected red packet required error, got %v", err)
+	}
+}
+
+func TestNewMessageRequiresTransferCard(t *testing.T) {
+	_, err := NewMessage("msg-1", "room-1", "user-1", MessageParams{
+		MessageType: MessageTypeTransfer,
+	}, time.Now().UTC())
+	if !errors.Is(err, ErrMessageTransferRequired) {
+		t.Fatalf("expected transfer required error, got %v", err)
+	}
+}
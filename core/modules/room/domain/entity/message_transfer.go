@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrMessageTransferRequired    = errors.New("transfer messages must be sent through the transfer endpoint")
+	ErrMessageTransferNotEditable = errors.New("transfer messages cannot be edited")
+)
+
+// MessageTransfer is the card posted with a transfer. Unlike other cards its
+// Status follows the transfer, so the recipient sees it resolve in place.
+type MessageTransfer struct {
+	TransferID  string
+	RecipientID string
+	Currency    string
+	Amount      int64
+	Status      string
+}
+
+func NormalizeMessageTransfer(card *MessageTransfer) *MessageTransfer {
+	if card == nil || strings.TrimSpace(card.TransferID) == "" {
+		return nil
+	}
+
+	return &MessageTransfer{
+		TransferID:  strings.TrimSpace(card.TransferID),
+		RecipientID: strings.TrimSpace(card.RecipientID),
+		Currency:    strings.ToUpper(strings.TrimSpace(card.Currency)),
+		Amount:      card.Amount,
+		Status:      strings.TrimSpace(card.Status),
+	}
+}
+
+func (t *Transfer) MessageCard() *MessageTransfer {
+	return &MessageTransfer{
+		TransferID:  t.ID,
+		RecipientID: t.RecipientID,
+		Currency:    t.Currency,
+		Amount:      t.Amount,
+		Status:      t.Status,
+	}
+}
+
+// UpdateTransferStatus copies the transfer's status onto its card and
+// reports whether anything changed.
+func (m *MessageEntity) UpdateTransferStatus(transfer *Transfer) bool {
+	if m.Transfer == nil || transfer == nil || m.Transfer.TransferID != transfer.ID || m.Transfer.Status == transfer.Status {
+		return false
+	}
+
+	updated := *m.Transfer
+	updated.Status = transfer.Status
+	m.Transfer = &updated
+	return true
+}
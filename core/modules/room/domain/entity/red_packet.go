@@ -0,0 +1,284 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	RedPacketSplitRandom = "random"
+	RedPacketSplitEqual  = "equal"
+
+	// RedPacketStatusPending is a packet whose escrow funding has not been
+	// confirmed yet; it is not visible in the room.
+	RedPacketStatusPending  = "pending"
+	RedPacketStatusOpen     = "open"
+	RedPacketStatusClaimed  = "claimed"
+	RedPacketStatusRefunded = "refunded"
+	RedPacketStatusFailed   = "failed"
+
+	redPacketGreetingMaxLength = 128
+)
+
+var (
+	ErrRedPacketIDRequired        = errors.New("red_packet_id is required")
+	ErrRedPacketNotFound          = errors.New("red packet not found")
+	ErrRedPacketAlreadyClaimed    = errors.New("red packet already claimed by this account")
+	ErrRedPacketCurrencyInvalid   = errors.New("currency must be a 3-letter code")
+	ErrRedPacketAmountInvalid     = errors.New("total_amount must be greater than 0")
+	ErrRedPacketCountInvalid      = errors.New("count must be greater than 0")
+	ErrRedPacketAmountTooSmall    = errors.New("total_amount must be at least one minor unit per share")
+	ErrRedPacketCountExceedsRoom  = errors.New("count cannot exceed the number of members who can claim")
+	ErrRedPacketSplitModeInvalid  = errors.New("split_mode must be random or equal")
+	ErrRedPacketGreetingTooLong   = errors.New("greeting is too long")
+	ErrRedPacketNotPending        = errors.New("red packet is not pending")
+	ErrRedPacketNotOpen           = errors.New("red packet is no longer open")
+	ErrRedPacketExpired           = errors.New("red packet has expired")
+	ErrRedPacketEmpty             = errors.New("red packet has been fully claimed")
+	ErrRedPacketSenderCannotClaim = errors.New("sender cannot claim their own red packet in a direct chat")
+)
+
+// RedPacket is money split between the members of a room. The sender's
+// funds sit in a per-packet escrow account from the moment the packet opens
+// until they are claimed or refunded, so RemainingAmount always equals the
+// escrow balance minus claims that are still being settled.
+type RedPacket struct {
+	ID              string
+	RoomID          string
+	SenderID        string
+	MessageID       string
+	Currency        string
+	TotalAmount     int64
+	TotalCount      int
+	SplitMode       string
+	Greeting        string
+	Status          string
+	RemainingAmount int64
+	RemainingCount  int
+	RefundedAmount  int64
+	FailureReason   string
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type RedPacketParams struct {
+	Currency    string
+	TotalAmount int64
+	Count       int
+	SplitMode   string
+	Greeting    string
+	// MaxCount is the number of members who may claim a share.
+	MaxCount int
+	TTL      time.Duration
+}
+
+// RedPacketClaim is one member's share. SettledAt stays nil until the share
+// has been moved out of escrow, so an interrupted claim can be resumed.
+type RedPacketClaim struct {
+	PacketID  string
+	AccountID string
+	Amount    int64
+	ClaimedAt time.Time
+	SettledAt *time.Time
+}
+
+func NewRedPacket(id, roomID, senderID string, params RedPacketParams, now time.Time) (*RedPacket, error) {
+	id = strings.TrimSpace(id)
+	roomID = strings.TrimSpace(roomID)
+	senderID = strings.TrimSpace(senderID)
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	splitMode := NormalizeRedPacketSplitMode(params.SplitMode)
+	greeting := strings.TrimSpace(params.Greeting)
+
+	switch {
+	case id == "":
+		return nil, stackErr.Error(ErrRedPacketIDRequired)
+	case roomID == "":
+		return nil, stackErr.Error(ErrMessageRoomRequired)
+	case senderID == "":
+		return nil, stackErr.Error(ErrMessageSenderRequired)
+	case !isCurrencyCode(currency):
+		return nil, stackErr.Error(ErrRedPacketCurrencyInvalid)
+	case params.TotalAmount <= 0:
+		return nil, stackErr.Error(ErrRedPacketAmountInvalid)
+	case params.Count <= 0:
+		return nil, stackErr.Error(ErrRedPacketCountInvalid)
+	case params.TotalAmount < int64(params.Count):
+		return nil, stackErr.Error(ErrRedPacketAmountTooSmall)
+	case params.Count > params.MaxCount:
+		return nil, stackErr.Error(ErrRedPacketCountExceedsRoom)
+	case splitMode == "":
+		return nil, stackErr.Error(ErrRedPacketSplitModeInvalid)
+	case len([]rune(greeting)) > redPacketGreetingMaxLength:
+		return nil, stackErr.Error(ErrRedPacketGreetingTooLong)
+	}
+
+	now = normalizeRoomTime(now)
+	return &RedPacket{
+		ID:              id,
+		RoomID:          roomID,
+		SenderID:        senderID,
+		Currency:        currency,
+		TotalAmount:     params.TotalAmount,
+		TotalCount:      params.Count,
+		SplitMode:       splitMode,
+		Greeting:        greeting,
+		Status:          RedPacketStatusPending,
+		RemainingAmount: params.TotalAmount,
+		RemainingCount:  params.Count,
+		ExpiresAt:       now.Add(params.TTL),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+func NormalizeRedPacketSplitMode(value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", RedPacketSplitRandom:
+		return RedPacketSplitRandom
+	case RedPacketSplitEqual:
+		return RedPacketSplitEqual
+	default:
+		return ""
+	}
+}
+
+func (p *RedPacket) IsPending() bool {
+	return p != nil && p.Status == RedPacketStatusPending
+}
+
+func (p *RedPacket) IsExpired(now time.Time) bool {
+	return p != nil && !normalizeRoomTime(now).Before(p.ExpiresAt)
+}
+
+// Open records that the escrow has been funded and the packet message posted.
+func (p *RedPacket) Open(messageID string, now time.Time) error {
+	if !p.IsPending() {
+		return stackErr.Error(ErrRedPacketNotPending)
+	}
+	if messageID = strings.TrimSpace(messageID); messageID == "" {
+		return stackErr.Error(ErrMessageIDRequired)
+	}
+
+	p.MessageID = messageID
+	p.Status = RedPacketStatusOpen
+	p.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+// Fail marks a packet whose escrow was never funded.
+func (p *RedPacket) Fail(reason string, now time.Time) error {
+	if !p.IsPending() {
+		return stackErr.Error(ErrRedPacketNotPending)
+	}
+
+	p.Status = RedPacketStatusFailed
+	p.FailureReason = strings.TrimSpace(reason)
+	p.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+// Claim reserves the next share for accountID. randN must return a value in
+// [0, n) and is only used by random packets. Callers must hold a lock on the
+// packet and must have checked that accountID has not claimed before.
+func (p *RedPacket) Claim(accountID string, directRoom bool, randN func(n int64) int64, now time.Time) (*RedPacketClaim, error) {
+	accountID = strings.TrimSpace(accountID)
+	now = normalizeRoomTime(now)
+	switch {
+	case accountID == "":
+		return nil, stackErr.Error(ErrMessageSenderRequired)
+	case directRoom && accountID == p.SenderID:
+		return nil, stackErr.Error(ErrRedPacketSenderCannotClaim)
+	case p.Status == RedPacketStatusClaimed:
+		return nil, stackErr.Error(ErrRedPacketEmpty)
+	case p.Status != RedPacketStatusOpen:
+		return nil, stackErr.Error(ErrRedPacketNotOpen)
+	case p.IsExpired(now):
+		return nil, stackErr.Error(ErrRedPacketExpired)
+	}
+
+	amount := p.nextShare(randN)
+	p.RemainingAmount -= amount
+	p.RemainingCount--
+	if p.RemainingCount == 0 {
+		p.Status = RedPacketStatusClaimed
+	}
+	p.UpdatedAt = now
+
+	return &RedPacketClaim{
+		PacketID:  p.ID,
+		AccountID: accountID,
+		Amount:    amount,
+		ClaimedAt: now,
+	}, nil
+}
+
+// nextShare splits equal packets so the first TotalAmount%TotalCount shares
+// carry the extra minor unit. Random packets use the double-average method:
+// each share is drawn from [1, 2*average) and capped so every remaining
+// share still gets at least one minor unit.
+func (p *RedPacket) nextShare(randN func(n int64) int64) int64 {
+	if p.RemainingCount == 1 {
+		return p.RemainingAmount
+	}
+
+	if p.SplitMode == RedPacketSplitEqual {
+		share := p.TotalAmount / int64(p.TotalCount)
+		claimed := p.TotalCount - p.RemainingCount
+		if int64(claimed) < p.TotalAmount%int64(p.TotalCount) {
+			share++
+		}
+		return share
+	}
+
+	upper := 2 * (p.RemainingAmount / int64(p.RemainingCount))
+	share := int64(1)
+	if upper > 1 {
+		share += randN(upper - 1)
+	}
+	if maxShare := p.RemainingAmount - int64(p.RemainingCount-1); share > maxShare {
+		share = maxShare
+	}
+	return share
+}
+
+// Refund closes an expired packet and returns the amount to send back to the
+// sender. Pending packets are refunded in full; whether their escrow was
+// ever funded is up to the ledger to decide.
+func (p *RedPacket) Refund(now time.Time) (int64, error) {
+	if p.Status != RedPacketStatusOpen && p.Status != RedPacketStatusPending {
+		return 0, stackErr.Error(ErrRedPacketNotOpen)
+	}
+
+	amount := p.RemainingAmount
+	p.RefundedAmount = amount
+	p.RemainingAmount = 0
+	p.Status = RedPacketStatusRefunded
+	p.UpdatedAt = normalizeRoomTime(now)
+	return amount, nil
+}
+
+func (c *RedPacketClaim) IsSettled() bool {
+	return c != nil && c.SettledAt != nil
+}
+
+func (c *RedPacketClaim) Settle(now time.Time) {
+	settledAt := normalizeRoomTime(now)
+	c.SettledAt = &settledAt
+}
+
+func isCurrencyCode(value string) bool {
+	if len(value) != 3 {
+		return false
+	}
+	for _, r := range value {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+func newOpenRedPacket(t *testing.T, params RedPacketParams, now time.Time) *RedPacket {
+	t.Helper()
+
+	if params.MaxCount == 0 {
+		params.MaxCount = params.Count
+	}
+	if params.TTL == 0 {
+		params.TTL = time.Hour
+	}
+	packet, err := NewRedPacket("packet-1", "room-1", "sender", params, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := packet.Open("msg-1", now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return packet
+}
+
+func TestNewRedPacketValidatesParams(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name   string
+		params RedPacketParams
+		want   error
+	}{
+		{name: "currency", params: RedPacketParams{Currency: "usd1", TotalAmount: 100, Count: 1, MaxCount: 1}, want: ErrRedPacketCurrencyInvalid},
+		{name: "amount", params: RedPacketParams{Currency: "USD", TotalAmount: 0, Count: 1, MaxCount: 1}, want: ErrRedPacketAmountInvalid},
+		{name: "count", params: RedPacketParams{Currency: "USD", TotalAmount: 100, Count: 0, MaxCount: 1}, want: ErrRedPacketCountInvalid},
+		{name: "amount per share", params: RedPacketParams{Currency: "USD", TotalAmount: 2, Count: 3, MaxCount: 3}, want: ErrRedPacketAmountTooSmall},
+		{name: "room size", params: RedPacketParams{Currency: "USD", TotalAmount: 100, Count: 3, MaxCount: 2}, want: ErrRedPacketCountExceedsRoom},
+		{name: "split mode", params: RedPacketParams{Currency: "USD", TotalAmount: 100, Count: 1, MaxCount: 1, SplitMode: "lucky"}, want: ErrRedPacketSplitModeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRedPacket("packet-1", "room-1", "sender", tt.params, now)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRedPacketEqualSplitSpreadsRemainder(t *testing.T) {
+	now := time.Now().UTC()
+	packet := newOpenRedPacket(t, RedPacketParams{Currency: "usd", TotalAmount: 100, Count: 3, SplitMode: RedPacketSplitEqual}, now)
+
+	want := []int64{34, 33, 33}
+	for idx, accountID := range []string{"a", "b", "c"} {
+		claim, err := packet.Claim(accountID, false, nil, now)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if claim.Amount != want[idx] {
+			t.Fatalf("claim %d: expected %d, got %d", idx, want[idx], claim.Amount)
+		}
+	}
+	if packet.Status != RedPacketStatusClaimed || packet.RemainingAmount != 0 || packet.RemainingCount != 0 {
+		t.Fatalf("expected fully claimed packet, got %+v", packet)
+	}
+	if _, err := packet.Claim("d", false, nil, now); !errors.Is(err, ErrRedPacketEmpty) {
+		t.Fatalf("expected empty error, got %v", err)
+	}
+}
+
+func TestRedPacketRandomSplitKeepsEverySharePositive(t *testing.T) {
+	now := time.Now().UTC()
+	rng := rand.New(rand.NewPCG(1, 2))
+	for round := 0; round < 200; round++ {
+		packet := newOpenRedPacket(t, RedPacketParams{Currency: "USD", TotalAmount: 10, Count: 7}, now)
+
+		var total int64
+		for idx := 0; idx < 7; idx++ {
+			claim, err := packet.Claim(string(rune('a'+idx)), false, rng.Int64N, now)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if claim.Amount < 1 {
+				t.Fatalf("expected positive share, got %d", claim.Amount)
+			}
+			total += claim.Amount
+		}
+		if total != 10 {
+			t.Fatalf("expected shares to sum to 10, got %d", total)
+		}
+	}
+}
+
+func TestRedPacketClaimRules(t *testing.T) {
+	now := time.Now().UTC()
+	packet := newOpenRedPacket(t, RedPacketParams{Currency: "USD", TotalAmount: 100, Count: 1}, now)
+
+	if _, err := packet.Claim("sender", true, nil, now); !errors.Is(err, ErrRedPacketSenderCannotClaim) {
+		t.Fatalf("expected sender cannot claim error, got %v", err)
+	}
+	if _, err := packet.Claim("user-2", false, nil, now.Add(time.Hour)); !errors.Is(err, ErrRedPacketExpired) {
+		t.Fatalf("expected expired error, got %v", err)
+	}
+
+	pending, err := NewRedPacket("packet-2", "room-1", "sender", RedPacketParams{Currency: "USD", TotalAmount: 100, Count: 1, MaxCount: 1, TTL: time.Hour}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := pending.Claim("user-2", false, nil, now); !errors.Is(err, ErrRedPacketNotOpen) {
+		t.Fatalf("expected not open error, got %v", err)
+	}
+}
+
+func TestRedPacketRefundReturnsRemainder(t *testing.T) {
+	now := time.Now().UTC()
+	packet := newOpenRedPacket(t, RedPacketParams{Currency: "USD", TotalAmount: 90, Count: 3, SplitMode: RedPacketSplitEqual}, now)
+	if _, err := packet.Claim("a", false, nil, now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	amount, err := packet.Refund(now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if amount != 60 || packet.RefundedAmount != 60 || packet.RemainingAmount != 0 || packet.Status != RedPacketStatusRefunded {
+		t.Fatalf("expected refunded packet, got amount %d and %+v", amount, packet)
+	}
+	if _, err := packet.Refund(now.Add(time.Hour)); !errors.Is(err, ErrRedPacketNotOpen) {
+		t.Fatalf("expected not open error, got %v", err)
+	}
+}
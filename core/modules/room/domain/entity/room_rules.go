@@ -22,6 +22,7 @@ var (
 	ErrRoomMemberRequired         = errors.New("account is not a member of this room")
 	ErrRoomMentionsRequireGroup   = errors.New("mentions are only supported in group rooms")
 	ErrRoomMentionTargetNotMember = errors.New("mentioned account is not a member of this room")
+	ErrRoomPeerBlocked            = errors.New("a block exists between the room members")
 )
 
 func NewRoom(id, name, description, ownerID string, roomType roomtypes.RoomType, directKey string, now time.Time) (*Room, error) {
@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	// TransferStatusHolding is a transfer whose hold has not been confirmed
+	// yet; it is not visible in the room.
+	TransferStatusHolding  = "holding"
+	TransferStatusPending  = "pending"
+	TransferStatusAccepted = "accepted"
+	TransferStatusDeclined = "declined"
+	TransferStatusReturned = "returned"
+	TransferStatusFailed   = "failed"
+
+	transferNoteMaxLength = 128
+)
+
+var (
+	ErrTransferIDRequired        = errors.New("transfer_id is required")
+	ErrTransferNotFound          = errors.New("transfer not found")
+	ErrTransferCurrencyInvalid   = errors.New("currency must be a 3-letter code")
+	ErrTransferAmountInvalid     = errors.New("amount must be greater than 0")
+	ErrTransferNoteTooLong       = errors.New("note is too long")
+	ErrTransferDirectRoomOnly    = errors.New("transfers can only be sent in direct chats")
+	ErrTransferRecipientRequired = errors.New("transfer recipient is required")
+	ErrTransferSelf              = errors.New("cannot send a transfer to yourself")
+	ErrTransferNotHolding        = errors.New("transfer is not waiting for its hold")
+	ErrTransferNotPending        = errors.New("transfer is no longer pending")
+	ErrTransferExpired           = errors.New("transfer has expired")
+	ErrTransferNotExpired        = errors.New("transfer has not expired yet")
+	ErrTransferNotRecipient      = errors.New("only the recipient can respond to a transfer")
+)
+
+// Transfer is money sent to the other member of a direct room. The amount
+// sits in a per-transfer hold account while the transfer is pending and is
+// paid to the recipient on accept, or released to the sender on decline or
+// return. Status changes are committed before the money moves; SettledAt
+// stays nil until the hold has been paid out or released.
+type Transfer struct {
+	ID            string
+	RoomID        string
+	SenderID      string
+	RecipientID   string
+	MessageID     string
+	Currency      string
+	Amount        int64
+	Note          string
+	Status        string
+	FailureReason string
+	ExpiresAt     time.Time
+	SettledAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type TransferParams struct {
+	Currency string
+	Amount   int64
+	Note     string
+	TTL      time.Duration
+}
+
+func NewTransfer(id, roomID, senderID, recipientID string, params TransferParams, now time.Time) (*Transfer, error) {
+	id = strings.TrimSpace(id)
+	roomID = strings.TrimSpace(roomID)
+	senderID = strings.TrimSpace(senderID)
+	recipientID = strings.TrimSpace(recipientID)
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	note := strings.TrimSpace(params.Note)
+
+	switch {
+	case id == "":
+		return nil, stackErr.Error(ErrTransferIDRequired)
+	case roomID == "":
+		return nil, stackErr.Error(ErrMessageRoomRequired)
+	case senderID == "":
+		return nil, stackErr.Error(ErrMessageSenderRequired)
+	case recipientID == "":
+		return nil, stackErr.Error(ErrTransferRecipientRequired)
+	case recipientID == senderID:
+		return nil, stackErr.Error(ErrTransferSelf)
+	case !isCurrencyCode(currency):
+		return nil, stackErr.Error(ErrTransferCurrencyInvalid)
+	case params.Amount <= 0:
+		return nil, stackErr.Error(ErrTransferAmountInvalid)
+	case len([]rune(note)) > transferNoteMaxLength:
+		return nil, stackErr.Error(ErrTransferNoteTooLong)
+	}
+
+	now = normalizeRoomTime(now)
+	return &Transfer{
+		ID:          id,
+		RoomID:      roomID,
+		SenderID:    senderID,
+		RecipientID: recipientID,
+		Currency:    currency,
+		Amount:      params.Amount,
+		Note:        note,
+		Status:      TransferStatusHolding,
+		ExpiresAt:   now.Add(params.TTL),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (t *Transfer) IsHolding() bool {
+	return t != nil && t.Status == TransferStatusHolding
+}
+
+func (t *Transfer) IsPending() bool {
+	return t != nil && t.Status == TransferStatusPending
+}
+
+func (t *Transfer) IsExpired(now time.Time) bool {
+	return t != nil && !normalizeRoomTime(now).Before(t.ExpiresAt)
+}
+
+func (t *Transfer) IsSettled() bool {
+	return t != nil && t.SettledAt != nil
+}
+
+// PaysRecipient reports whether the hold goes to the recipient once the
+// transfer is settled; every other resolved transfer goes back to the sender.
+func (t *Transfer) PaysRecipient() bool {
+	return t != nil && t.Status == TransferStatusAccepted
+}
+
+// Open records that the hold has been funded and the transfer message posted.
+func (t *Transfer) Open(messageID string, now time.Time) error {
+	if !t.IsHolding() {
+		return stackErr.Error(ErrTransferNotHolding)
+	}
+	if messageID = strings.TrimSpace(messageID); messageID == "" {
+		return stackErr.Error(ErrMessageIDRequired)
+	}
+
+	t.MessageID = messageID
+	t.Status = TransferStatusPending
+	t.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+// Fail marks a transfer whose hold was never funded.
+func (t *Transfer) Fail(reason string, now time.Time) error {
+	if !t.IsHolding() {
+		return stackErr.Error(ErrTransferNotHolding)
+	}
+
+	t.Status = TransferStatusFailed
+	t.FailureReason = strings.TrimSpace(reason)
+	t.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+func (t *Transfer) Accept(actorID string, now time.Time) error {
+	if err := t.ensureRecipientResponse(actorID, now); err != nil {
+		return stackErr.Error(err)
+	}
+
+	t.Status = TransferStatusAccepted
+	t.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+func (t *Transfer) Decline(actorID string, now time.Time) error {
+	if err := t.ensureRecipientResponse(actorID, now); err != nil {
+		return stackErr.Error(err)
+	}
+
+	t.Status = TransferStatusDeclined
+	t.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+// Return sends an expired pending transfer back to the sender.
+func (t *Transfer) Return(now time.Time) error {
+	switch {
+	case !t.IsPending():
+		return stackErr.Error(ErrTransferNotPending)
+	case !t.IsExpired(now):
+		return stackErr.Error(ErrTransferNotExpired)
+	}
+
+	t.Status = TransferStatusReturned
+	t.UpdatedAt = normalizeRoomTime(now)
+	return nil
+}
+
+func (t *Transfer) Settle(now time.Time) {
+	settledAt := normalizeRoomTime(now)
+	t.SettledAt = &settledAt
+}
+
+func (t *Transfer) ensureRecipientResponse(actorID string, now time.Time) error {
+	switch {
+	case strings.TrimSpace(actorID) != t.RecipientID:
+		return ErrTransferNotRecipient
+	case !t.IsPending():
+		return ErrTransferNotPending
+	case t.IsExpired(now):
+		return ErrTransferExpired
+	}
+	return nil
+}
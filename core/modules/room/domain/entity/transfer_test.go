@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newPendingTransfer(t *testing.T, now time.Time) *Transfer {
+	t.Helper()
+
+	transfer, err := NewTransfer("transfer-1", "room-1", "sender", "recipient", TransferParams{
+		Currency: "usd",
+		Amount:   500,
+		TTL:      time.Hour,
+	}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := transfer.Open("msg-1", now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return transfer
+}
+
+func TestNewTransferValidatesParams(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name        string
+		recipientID string
+		params      TransferParams
+		want        error
+	}{
+		{name: "recipient", recipientID: "", params: TransferParams{Currency: "USD", Amount: 100}, want: ErrTransferRecipientRequired},
+		{name: "self", recipientID: "sender", params: TransferParams{Currency: "USD", Amount: 100}, want: ErrTransferSelf},
+		{name: "currency", recipientID: "recipient", params: TransferParams{Currency: "us", Amount: 100}, want: ErrTransferCurrencyInvalid},
+		{name: "amount", recipientID: "recipient", params: TransferParams{Currency: "USD", Amount: 0}, want: ErrTransferAmountInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransfer("transfer-1", "room-1", "sender", tt.recipientID, tt.params, now)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestTransferResponseRules(t *testing.T) {
+	now := time.Now().UTC()
+
+	transfer := newPendingTransfer(t, now)
+	if transfer.Currency != "USD" {
+		t.Fatalf("expected normalized currency, got %s", transfer.Currency)
+	}
+	if err := transfer.Accept("sender", now); !errors.Is(err, ErrTransferNotRecipient) {
+		t.Fatalf("expected not recipient error, got %v", err)
+	}
+	if err := transfer.Accept("recipient", now.Add(time.Hour)); !errors.Is(err, ErrTransferExpired) {
+		t.Fatalf("expected expired error, got %v", err)
+	}
+	if err := transfer.Accept("recipient", now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !transfer.PaysRecipient() {
+		t.Fatalf("expected accepted transfer to pay the recipient, got %+v", transfer)
+	}
+	if err := transfer.Decline("recipient", now); !errors.Is(err, ErrTransferNotPending) {
+		t.Fatalf("expected not pending error, got %v", err)
+	}
+
+	declined := newPendingTransfer(t, now)
+	if err := declined.Decline("recipient", now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if declined.Status != TransferStatusDeclined || declined.PaysRecipient() {
+		t.Fatalf("expected declined transfer to go back to the sender, got %+v", declined)
+	}
+}
+
+func TestTransferReturnRequiresExpiry(t *testing.T) {
+	now := time.Now().UTC()
+	transfer := newPendingTransfer(t, now)
+
+	if err := transfer.Return(now); !errors.Is(err, ErrTransferNotExpired) {
+		t.Fatalf("expected not expired error, got %v", err)
+	}
+	if err := transfer.Return(now.Add(time.Hour)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if transfer.Status != TransferStatusReturned {
+		t.Fatalf("expected returned transfer, got %+v", transfer)
+	}
+
+	holding, err := NewTransfer("transfer-2", "room-1", "sender", "recipient", TransferParams{Currency: "USD", Amount: 100, TTL: time.Hour}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := holding.Return(now.Add(time.Hour)); !errors.Is(err, ErrTransferNotPending) {
+		t.Fatalf("expected not pending error, got %v", err)
+	}
+}
+
+func TestMessageTransferCardFollowsStatus(t *testing.T) {
+	now := time.Now().UTC()
+	transfer := newPendingTransfer(t, now)
+	message, err := NewMessage("msg-1", "room-1", "sender", MessageParams{
+		MessageType: MessageTypeTransfer,
+		Transfer:    transfer.MessageCard(),
+	}, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if message.UpdateTransferStatus(transfer) {
+		t.Fatalf("expected unchanged card")
+	}
+	if err := transfer.Accept("recipient", now); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !message.UpdateTransferStatus(transfer) || message.Transfer.Status != TransferStatusAccepted {
+		t.Fatalf("expected accepted card, got %+v", message.Transfer)
+	}
+	if err := message.Edit("sender", "hello", now); !errors.Is(err, ErrMessageTransferNotEditable) {
+		t.Fatalf("expected not editable error, got %v", err)
+	}
+}
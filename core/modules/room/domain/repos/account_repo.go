@@ -0,0 +1,15 @@
+package repos
+
+import (
+	"context"
+
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+// AccountRepository reads the account profiles projected from the account
+// module, used to build previews such as shared contact cards.
+//
+//go:generate mockgen -package=repos -destination=account_repo_mock.go -source=account_repo.go
+type AccountRepository interface {
+	ListByAccountIDs(ctx context.Context, accountIDs []string) ([]*entity.AccountEntity, error)
+}
@@ -0,0 +1,23 @@
+package repos
+
+import (
+	"context"
+	"time"
+)
+
+// BlockRepository mirrors the relationship module's block edges so direct
+// rooms, calls and realtime fan-out can honour a block without calling across
+// modules.
+//
+//go:generate mockgen -package=repos -destination=block_repo_mock.go -source=block_repo.go
+type BlockRepository interface {
+	ProjectBlocked(ctx context.Context, blockerID, blockedID string, blockedAt time.Time) error
+	RemoveBlocked(ctx context.Context, blockerID, blockedID string) error
+	IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error)
+	IsBlockedBetween(ctx context.Context, accountID, otherAccountID string) (bool, error)
+	// ListBlockedBetween returns the subset of peerIDs that have a block with
+	// accountID in either direction.
+	ListBlockedBetween(ctx context.Context, accountID string, peerIDs []string) ([]string, error)
+	// ListBlockedBy returns the subset of accountIDs that blockerID has blocked.
+	ListBlockedBy(ctx context.Context, blockerID string, accountIDs []string) ([]string, error)
+}
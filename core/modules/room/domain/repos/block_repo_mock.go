@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: block_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=block_repo_mock.go -source=block_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockRepository is a mock of BlockRepository interface.
+type MockBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockRepositoryMockRecorder is the mock recorder for MockBlockRepository.
+type MockBlockRepositoryMockRecorder struct {
+	mock *MockBlockRepository
+}
+
+// NewMockBlockRepository creates a new mock instance.
+func NewMockBlockRepository(ctrl *gomock.Controller) *MockBlockRepository {
+	mock := &MockBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockRepository) EXPECT() *MockBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *MockBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockBlockRepositoryMockRecorder) IsBlocked(ctx, blockerID, blockedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockBlockRepository)(nil).IsBlocked), ctx, blockerID, blockedID)
+}
+
+// IsBlockedBetween mocks base method.
+func (m *MockBlockRepository) IsBlockedBetween(ctx context.Context, accountID, otherAccountID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlockedBetween", ctx, accountID, otherAccountID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlockedBetween indicates an expected call of IsBlockedBetween.
+func (mr *MockBlockRepositoryMockRecorder) IsBlockedBetween(ctx, accountID, otherAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlockedBetween", reflect.TypeOf((*MockBlockRepository)(nil).IsBlockedBetween), ctx, accountID, otherAccountID)
+}
+
+// ListBlockedBetween mocks base method.
+func (m *MockBlockRepository) ListBlockedBetween(ctx context.Context, accountID string, peerIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockedBetween", ctx, accountID, peerIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedBetween indicates an expected call of ListBlockedBetween.
+func (mr *MockBlockRepositoryMockRecorder) ListBlockedBetween(ctx, accountID, peerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedBetween", reflect.TypeOf((*MockBlockRepository)(nil).ListBlockedBetween), ctx, accountID, peerIDs)
+}
+
+// ListBlockedBy mocks base method.
+func (m *MockBlockRepository) ListBlockedBy(ctx context.Context, blockerID string, accountIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockedBy", ctx, blockerID, accountIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedBy indicates an expected call of ListBlockedBy.
+func (mr *MockBlockRepositoryMockRecorder) ListBlockedBy(ctx, blockerID, accountIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedBy", reflect.TypeOf((*MockBlockRepository)(nil).ListBlockedBy), ctx, blockerID, accountIDs)
+}
+
+// ProjectBlocked mocks base method.
+func (m *MockBlockRepository) ProjectBlocked(ctx context.Context, blockerID, blockedID string, blockedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectBlocked", ctx, blockerID, blockedID, blockedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectBlocked indicates an expected call of ProjectBlocked.
+func (mr *MockBlockRepositoryMockRecorder) ProjectBlocked(ctx, blockerID, blockedID, blockedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectBlocked", reflect.TypeOf((*MockBlockRepository)(nil).ProjectBlocked), ctx, blockerID, blockedID, blockedAt)
+}
+
+// RemoveBlocked mocks base method.
+func (m *MockBlockRepository) RemoveBlocked(ctx context.Context, blockerID, blockedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlocked", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlocked indicates an expected call of RemoveBlocked.
+func (mr *MockBlockRepositoryMockRecorder) RemoveBlocked(ctx, blockerID, blockedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlocked", reflect.TypeOf((*MockBlockRepository)(nil).RemoveBlocked), ctx, blockerID, blockedID)
+}
@@ -0,0 +1,23 @@
+package repos
+
+import (
+	"context"
+	"time"
+)
+
+// ContactRemarkRepository mirrors the remark names owners gave their friends
+// in the relationship module so conversation names and mention search can
+// show them to the owner only.
+//
+//go:generate mockgen -package=repos -destination=contact_remark_repo_mock.go -source=contact_remark_repo.go
+type ContactRemarkRepository interface {
+	// ProjectRemark stores remarkName for ownerID's view of targetID; an empty
+	// remark removes the row.
+	ProjectRemark(ctx context.Context, ownerID, targetID, remarkName string, updatedAt time.Time) error
+	// RemoveBetween drops remarks in both directions, used when a friendship ends.
+	RemoveBetween(ctx context.Context, accountID, otherAccountID string) error
+	// ListRemarks returns remark names keyed by target for the given targetIDs.
+	ListRemarks(ctx context.Context, ownerID string, targetIDs []string) (map[string]string, error)
+	// SearchRemarkTargets returns targets whose remark name contains keyword.
+	SearchRemarkTargets(ctx context.Context, ownerID, keyword string, limit int) ([]string, error)
+}
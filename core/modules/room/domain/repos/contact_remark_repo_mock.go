@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contact_remark_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=contact_remark_repo_mock.go -source=contact_remark_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContactRemarkRepository is a mock of ContactRemarkRepository interface.
+type MockContactRemarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactRemarkRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockContactRemarkRepositoryMockRecorder is the mock recorder for MockContactRemarkRepository.
+type MockContactRemarkRepositoryMockRecorder struct {
+	mock *MockContactRemarkRepository
+}
+
+// NewMockContactRemarkRepository creates a new mock instance.
+func NewMockContactRemarkRepository(ctrl *gomock.Controller) *MockContactRemarkRepository {
+	mock := &MockContactRemarkRepository{ctrl: ctrl}
+	mock.recorder = &MockContactRemarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactRemarkRepository) EXPECT() *MockContactRemarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListRemarks mocks base method.
+func (m *MockContactRemarkRepository) ListRemarks(ctx context.Context, ownerID string, targetIDs []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemarks", ctx, ownerID, targetIDs)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemarks indicates an expected call of ListRemarks.
+func (mr *MockContactRemarkRepositoryMockRecorder) ListRemarks(ctx, ownerID, targetIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemarks", reflect.TypeOf((*MockContactRemarkRepository)(nil).ListRemarks), ctx, ownerID, targetIDs)
+}
+
+// ProjectRemark mocks base method.
+func (m *MockContactRemarkRepository) ProjectRemark(ctx context.Context, ownerID, targetID, remarkName string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRemark", ctx, ownerID, targetID, remarkName, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectRemark indicates an expected call of ProjectRemark.
+func (mr *MockContactRemarkRepositoryMockRecorder) ProjectRemark(ctx, ownerID, targetID, remarkName, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRemark", reflect.TypeOf((*MockContactRemarkRepository)(nil).ProjectRemark), ctx, ownerID, targetID, remarkName, updatedAt)
+}
+
+// RemoveBetween mocks base method.
+func (m *MockContactRemarkRepository) RemoveBetween(ctx context.Context, accountID, otherAccountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBetween", ctx, accountID, otherAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBetween indicates an expected call of RemoveBetween.
+func (mr *MockContactRemarkRepositoryMockRecorder) RemoveBetween(ctx, accountID, otherAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBetween", reflect.TypeOf((*MockContactRemarkRepository)(nil).RemoveBetween), ctx, accountID, otherAccountID)
+}
+
+// SearchRemarkTargets mocks base method.
+func (m *MockContactRemarkRepository) SearchRemarkTargets(ctx context.Context, ownerID, keyword string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRemarkTargets", ctx, ownerID, keyword, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchRemarkTargets indicates an expected call of SearchRemarkTargets.
+func (mr *MockContactRemarkRepositoryMockRecorder) SearchRemarkTargets(ctx, ownerID, keyword, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRemarkTargets", reflect.TypeOf((*MockContactRemarkRepository)(nil).SearchRemarkTargets), ctx, ownerID, keyword, limit)
+}
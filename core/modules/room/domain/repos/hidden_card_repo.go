@@ -0,0 +1,17 @@
+package repos
+
+import (
+	"context"
+	"time"
+)
+
+// HiddenCardRepository mirrors the accounts that switched off contact-card
+// findability in the relationship module, so their cards cannot be shared in
+// chat without calling across modules.
+//
+//go:generate mockgen -package=repos -destination=hidden_card_repo_mock.go -source=hidden_card_repo.go
+type HiddenCardRepository interface {
+	ProjectHidden(ctx context.Context, accountID string, hiddenAt time.Time) error
+	RemoveHidden(ctx context.Context, accountID string) error
+	IsHidden(ctx context.Context, accountID string) (bool, error)
+}
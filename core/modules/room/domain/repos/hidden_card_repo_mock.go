@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hidden_card_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=hidden_card_repo_mock.go -source=hidden_card_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHiddenCardRepository is a mock of HiddenCardRepository interface.
+type MockHiddenCardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHiddenCardRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHiddenCardRepositoryMockRecorder is the mock recorder for MockHiddenCardRepository.
+type MockHiddenCardRepositoryMockRecorder struct {
+	mock *MockHiddenCardRepository
+}
+
+// NewMockHiddenCardRepository creates a new mock instance.
+func NewMockHiddenCardRepository(ctrl *gomock.Controller) *MockHiddenCardRepository {
+	mock := &MockHiddenCardRepository{ctrl: ctrl}
+	mock.recorder = &MockHiddenCardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHiddenCardRepository) EXPECT() *MockHiddenCardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// IsHidden mocks base method.
+func (m *MockHiddenCardRepository) IsHidden(ctx context.Context, accountID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHidden", ctx, accountID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHidden indicates an expected call of IsHidden.
+func (mr *MockHiddenCardRepositoryMockRecorder) IsHidden(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHidden", reflect.TypeOf((*MockHiddenCardRepository)(nil).IsHidden), ctx, accountID)
+}
+
+// ProjectHidden mocks base method.
+func (m *MockHiddenCardRepository) ProjectHidden(ctx context.Context, accountID string, hiddenAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectHidden", ctx, accountID, hiddenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectHidden indicates an expected call of ProjectHidden.
+func (mr *MockHiddenCardRepositoryMockRecorder) ProjectHidden(ctx, accountID, hiddenAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectHidden", reflect.TypeOf((*MockHiddenCardRepository)(nil).ProjectHidden), ctx, accountID, hiddenAt)
+}
+
+// RemoveHidden mocks base method.
+func (m *MockHiddenCardRepository) RemoveHidden(ctx context.Context, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHidden", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveHidden indicates an expected call of RemoveHidden.
+func (mr *MockHiddenCardRepositoryMockRecorder) RemoveHidden(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHidden", reflect.TypeOf((*MockHiddenCardRepository)(nil).RemoveHidden), ctx, accountID)
+}
@@ -11,4 +11,6 @@ type MessageAggregateRepository interface {
 	Load(ctx context.Context, messageID string) (*aggregate.MessageStateAggregate, error)
 	LoadForRecipient(ctx context.Context, messageID, recipientAccountID string) (*aggregate.MessageStateAggregate, error)
 	Save(ctx context.Context, agg *aggregate.MessageStateAggregate) error
+	// ListContactCardMessageIDs returns messages that share accountID's contact card.
+	ListContactCardMessageIDs(ctx context.Context, accountID string) ([]string, error)
 }
@@ -41,6 +41,21 @@ func (m *MockMessageAggregateRepository) EXPECT() *MockMessageAggregateRepositor
 	return m.recorder
 }
 
+// ListContactCardMessageIDs mocks base method.
+func (m *MockMessageAggregateRepository) ListContactCardMessageIDs(ctx context.Context, accountID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContactCardMessageIDs", ctx, accountID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContactCardMessageIDs indicates an expected call of ListContactCardMessageIDs.
+func (mr *MockMessageAggregateRepositoryMockRecorder) ListContactCardMessageIDs(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContactCardMessageIDs", reflect.TypeOf((*MockMessageAggregateRepository)(nil).ListContactCardMessageIDs), ctx, accountID)
+}
+
 // Load mocks base method.
 func (m *MockMessageAggregateRepository) Load(ctx context.Context, messageID string) (*aggregate.MessageStateAggregate, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,29 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=red_packet_repo_mock.go -source=red_packet_repo.go
+type RedPacketRepository interface {
+	Create(ctx context.Context, packet *entity.RedPacket) error
+	// Get returns entity.ErrRedPacketNotFound when the packet does not exist.
+	Get(ctx context.Context, packetID string) (*entity.RedPacket, error)
+	// GetForUpdate locks the packet row until the surrounding transaction ends.
+	GetForUpdate(ctx context.Context, packetID string) (*entity.RedPacket, error)
+	Update(ctx context.Context, packet *entity.RedPacket) error
+	// ListExpired returns pending or open packets whose expiry has passed,
+	// oldest first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*entity.RedPacket, error)
+
+	// CreateClaim returns entity.ErrRedPacketAlreadyClaimed when the account
+	// already holds a share of the packet.
+	CreateClaim(ctx context.Context, claim *entity.RedPacketClaim) error
+	// GetClaim returns nil when the account has not claimed the packet.
+	GetClaim(ctx context.Context, packetID, accountID string) (*entity.RedPacketClaim, error)
+	UpdateClaim(ctx context.Context, claim *entity.RedPacketClaim) error
+	ListClaims(ctx context.Context, packetID string) ([]*entity.RedPacketClaim, error)
+}
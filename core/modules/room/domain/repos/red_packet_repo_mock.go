@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: red_packet_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=red_packet_repo_mock.go -source=red_packet_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	entity "wechat-clone/core/modules/room/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRedPacketRepository is a mock of RedPacketRepository interface.
+type MockRedPacketRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedPacketRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRedPacketRepositoryMockRecorder is the mock recorder for MockRedPacketRepository.
+type MockRedPacketRepositoryMockRecorder struct {
+	mock *MockRedPacketRepository
+}
+
+// NewMockRedPacketRepository creates a new mock instance.
+func NewMockRedPacketRepository(ctrl *gomock.Controller) *MockRedPacketRepository {
+	mock := &MockRedPacketRepository{ctrl: ctrl}
+	mock.recorder = &MockRedPacketRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedPacketRepository) EXPECT() *MockRedPacketRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRedPacketRepository) Create(ctx context.Context, packet *entity.RedPacket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, packet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRedPacketRepositoryMockRecorder) Create(ctx, packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRedPacketRepository)(nil).Create), ctx, packet)
+}
+
+// CreateClaim mocks base method.
+func (m *MockRedPacketRepository) CreateClaim(ctx context.Context, claim *entity.RedPacketClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClaim", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClaim indicates an expected call of CreateClaim.
+func (mr *MockRedPacketRepositoryMockRecorder) CreateClaim(ctx, claim any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClaim", reflect.TypeOf((*MockRedPacketRepository)(nil).CreateClaim), ctx, claim)
+}
+
+// Get mocks base method.
+func (m *MockRedPacketRepository) Get(ctx context.Context, packetID string) (*entity.RedPacket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, packetID)
+	ret0, _ := ret[0].(*entity.RedPacket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRedPacketRepositoryMockRecorder) Get(ctx, packetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedPacketRepository)(nil).Get), ctx, packetID)
+}
+
+// GetClaim mocks base method.
+func (m *MockRedPacketRepository) GetClaim(ctx context.Context, packetID, accountID string) (*entity.RedPacketClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaim", ctx, packetID, accountID)
+	ret0, _ := ret[0].(*entity.RedPacketClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaim indicates an expected call of GetClaim.
+func (mr *MockRedPacketRepositoryMockRecorder) GetClaim(ctx, packetID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaim", reflect.TypeOf((*MockRedPacketRepository)(nil).GetClaim), ctx, packetID, accountID)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockRedPacketRepository) GetForUpdate(ctx context.Context, packetID string) (*entity.RedPacket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, packetID)
+	ret0, _ := ret[0].(*entity.RedPacket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockRedPacketRepositoryMockRecorder) GetForUpdate(ctx, packetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockRedPacketRepository)(nil).GetForUpdate), ctx, packetID)
+}
+
+// ListClaims mocks base method.
+func (m *MockRedPacketRepository) ListClaims(ctx context.Context, packetID string) ([]*entity.RedPacketClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaims", ctx, packetID)
+	ret0, _ := ret[0].([]*entity.RedPacketClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockRedPacketRepositoryMockRecorder) ListClaims(ctx, packetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockRedPacketRepository)(nil).ListClaims), ctx, packetID)
+}
+
+// ListExpired mocks base method.
+func (m *MockRedPacketRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*entity.RedPacket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpired", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.RedPacket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpired indicates an expected call of ListExpired.
+func (mr *MockRedPacketRepositoryMockRecorder) ListExpired(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpired", reflect.TypeOf((*MockRedPacketRepository)(nil).ListExpired), ctx, now, limit)
+}
+
+// Update mocks base method.
+func (m *MockRedPacketRepository) Update(ctx context.Context, packet *entity.RedPacket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, packet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRedPacketRepositoryMockRecorder) Update(ctx, packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRedPacketRepository)(nil).Update), ctx, packet)
+}
+
+// UpdateClaim mocks base method.
+func (m *MockRedPacketRepository) UpdateClaim(ctx context.Context, claim *entity.RedPacketClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClaim", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClaim indicates an expected call of UpdateClaim.
+func (mr *MockRedPacketRepositoryMockRecorder) UpdateClaim(ctx, claim any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClaim", reflect.TypeOf((*MockRedPacketRepository)(nil).UpdateClaim), ctx, claim)
+}
@@ -8,6 +8,13 @@ import (
 type Repos interface {
 	RoomAggregateRepository() RoomAggregateRepository
 	MessageAggregateRepository() MessageAggregateRepository
+	BlockRepository() BlockRepository
+	ContactRemarkRepository() ContactRemarkRepository
+	RestrictionRepository() RestrictionRepository
+	HiddenCardRepository() HiddenCardRepository
+	AccountRepository() AccountRepository
+	RedPacketRepository() RedPacketRepository
+	TransferRepository() TransferRepository
 
 	WithTransaction(ctx context.Context, fn func(Repos) error) error
 }
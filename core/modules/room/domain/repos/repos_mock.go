@@ -40,6 +40,62 @@ func (m *MockRepos) EXPECT() *MockReposMockRecorder {
 	return m.recorder
 }
 
+// AccountRepository mocks base method.
+func (m *MockRepos) AccountRepository() AccountRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountRepository")
+	ret0, _ := ret[0].(AccountRepository)
+	return ret0
+}
+
+// AccountRepository indicates an expected call of AccountRepository.
+func (mr *MockReposMockRecorder) AccountRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountRepository", reflect.TypeOf((*MockRepos)(nil).AccountRepository))
+}
+
+// BlockRepository mocks base method.
+func (m *MockRepos) BlockRepository() BlockRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockRepository")
+	ret0, _ := ret[0].(BlockRepository)
+	return ret0
+}
+
+// BlockRepository indicates an expected call of BlockRepository.
+func (mr *MockReposMockRecorder) BlockRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockRepository", reflect.TypeOf((*MockRepos)(nil).BlockRepository))
+}
+
+// ContactRemarkRepository mocks base method.
+func (m *MockRepos) ContactRemarkRepository() ContactRemarkRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContactRemarkRepository")
+	ret0, _ := ret[0].(ContactRemarkRepository)
+	return ret0
+}
+
+// ContactRemarkRepository indicates an expected call of ContactRemarkRepository.
+func (mr *MockReposMockRecorder) ContactRemarkRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactRemarkRepository", reflect.TypeOf((*MockRepos)(nil).ContactRemarkRepository))
+}
+
+// HiddenCardRepository mocks base method.
+func (m *MockRepos) HiddenCardRepository() HiddenCardRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HiddenCardRepository")
+	ret0, _ := ret[0].(HiddenCardRepository)
+	return ret0
+}
+
+// HiddenCardRepository indicates an expected call of HiddenCardRepository.
+func (mr *MockReposMockRecorder) HiddenCardRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HiddenCardRepository", reflect.TypeOf((*MockRepos)(nil).HiddenCardRepository))
+}
+
 // MessageAggregateRepository mocks base method.
 func (m *MockRepos) MessageAggregateRepository() MessageAggregateRepository {
 	m.ctrl.T.Helper()
@@ -54,6 +110,34 @@ func (mr *MockReposMockRecorder) MessageAggregateRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageAggregateRepository", reflect.TypeOf((*MockRepos)(nil).MessageAggregateRepository))
 }
 
+// RedPacketRepository mocks base method.
+func (m *MockRepos) RedPacketRepository() RedPacketRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedPacketRepository")
+	ret0, _ := ret[0].(RedPacketRepository)
+	return ret0
+}
+
+// RedPacketRepository indicates an expected call of RedPacketRepository.
+func (mr *MockReposMockRecorder) RedPacketRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedPacketRepository", reflect.TypeOf((*MockRepos)(nil).RedPacketRepository))
+}
+
+// RestrictionRepository mocks base method.
+func (m *MockRepos) RestrictionRepository() RestrictionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestrictionRepository")
+	ret0, _ := ret[0].(RestrictionRepository)
+	return ret0
+}
+
+// RestrictionRepository indicates an expected call of RestrictionRepository.
+func (mr *MockReposMockRecorder) RestrictionRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestrictionRepository", reflect.TypeOf((*MockRepos)(nil).RestrictionRepository))
+}
+
 // RoomAggregateRepository mocks base method.
 func (m *MockRepos) RoomAggregateRepository() RoomAggregateRepository {
 	m.ctrl.T.Helper()
@@ -68,6 +152,20 @@ func (mr *MockReposMockRecorder) RoomAggregateRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoomAggregateRepository", reflect.TypeOf((*MockRepos)(nil).RoomAggregateRepository))
 }
 
+// TransferRepository mocks base method.
+func (m *MockRepos) TransferRepository() TransferRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferRepository")
+	ret0, _ := ret[0].(TransferRepository)
+	return ret0
+}
+
+// TransferRepository indicates an expected call of TransferRepository.
+func (mr *MockReposMockRecorder) TransferRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferRepository", reflect.TypeOf((*MockRepos)(nil).TransferRepository))
+}
+
 // WithTransaction mocks base method.
 func (m *MockRepos) WithTransaction(ctx context.Context, fn func(Repos) error) error {
 	m.ctrl.T.Helper()
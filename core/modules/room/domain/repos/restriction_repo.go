@@ -0,0 +1,22 @@
+package repos
+
+import (
+	"context"
+	"time"
+)
+
+// RestrictionRepository mirrors the friends each owner put on their restricted
+// audience list in the relationship module, so presence can be withheld from
+// them without calling across modules.
+//
+//go:generate mockgen -package=repos -destination=restriction_repo_mock.go -source=restriction_repo.go
+type RestrictionRepository interface {
+	ProjectRestricted(ctx context.Context, ownerID, restrictedID string, restrictedAt time.Time) error
+	RemoveRestricted(ctx context.Context, ownerID, restrictedID string) error
+	// RemoveBetween drops restrictions in both directions, used when a
+	// friendship ends.
+	RemoveBetween(ctx context.Context, accountID, otherAccountID string) error
+	IsRestricted(ctx context.Context, ownerID, accountID string) (bool, error)
+	// ListRestrictedBy returns the subset of accountIDs that ownerID has restricted.
+	ListRestrictedBy(ctx context.Context, ownerID string, accountIDs []string) ([]string, error)
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: restriction_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -package=repos -destination=restriction_repo_mock.go -source=restriction_repo.go
+//
+
+// Package repos is a generated GoMock package.
+package repos
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRestrictionRepository is a mock of RestrictionRepository interface.
+type MockRestrictionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestrictionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRestrictionRepositoryMockRecorder is the mock recorder for MockRestrictionRepository.
+type MockRestrictionRepositoryMockRecorder struct {
+	mock *MockRestrictionRepository
+}
+
+// NewMockRestrictionRepository creates a new mock instance.
+func NewMockRestrictionRepository(ctrl *gomock.Controller) *MockRestrictionRepository {
+	mock := &MockRestrictionRepository{ctrl: ctrl}
+	mock.recorder = &MockRestrictionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestrictionRepository) EXPECT() *MockRestrictionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// IsRestricted mocks base method.
+func (m *MockRestrictionRepository) IsRestricted(ctx context.Context, ownerID, accountID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRestricted", ctx, ownerID, accountID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRestricted indicates an expected call of IsRestricted.
+func (mr *MockRestrictionRepositoryMockRecorder) IsRestricted(ctx, ownerID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRestricted", reflect.TypeOf((*MockRestrictionRepository)(nil).IsRestricted), ctx, ownerID, accountID)
+}
+
+// ListRestrictedBy mocks base method.
+func (m *MockRestrictionRepository) ListRestrictedBy(ctx context.Context, ownerID string, accountIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRestrictedBy", ctx, ownerID, accountIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRestrictedBy indicates an expected call of ListRestrictedBy.
+func (mr *MockRestrictionRepositoryMockRecorder) ListRestrictedBy(ctx, ownerID, accountIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRestrictedBy", reflect.TypeOf((*MockRestrictionRepository)(nil).ListRestrictedBy), ctx, ownerID, accountIDs)
+}
+
+// ProjectRestricted mocks base method.
+func (m *MockRestrictionRepository) ProjectRestricted(ctx context.Context, ownerID, restrictedID string, restrictedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRestricted", ctx, ownerID, restrictedID, restrictedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProjectRestricted indicates an expected call of ProjectRestricted.
+func (mr *MockRestrictionRepositoryMockRecorder) ProjectRestricted(ctx, ownerID, restrictedID, restrictedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRestricted", reflect.TypeOf((*MockRestrictionRepository)(nil).ProjectRestricted), ctx, ownerID, restrictedID, restrictedAt)
+}
+
+// RemoveBetween mocks base method.
+func (m *MockRestrictionRepository) RemoveBetween(ctx context.Context, accountID, otherAccountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBetween", ctx, accountID, otherAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBetween indicates an expected call of RemoveBetween.
+func (mr *MockRestrictionRepositoryMockRecorder) RemoveBetween(ctx, accountID, otherAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBetween", reflect.TypeOf((*MockRestrictionRepository)(nil).RemoveBetween), ctx, accountID, otherAccountID)
+}
+
+// RemoveRestricted mocks base method.
+func (m *MockRestrictionRepository) RemoveRestricted(ctx context.Context, ownerID, restrictedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRestricted", ctx, ownerID, restrictedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRestricted indicates an expected call of RemoveRestricted.
+func (mr *MockRestrictionRepositoryMockRecorder) RemoveRestricted(ctx, ownerID, restrictedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRestricted", reflect.TypeOf((*MockRestrictionRepository)(nil).RemoveRestricted), ctx, ownerID, restrictedID)
+}
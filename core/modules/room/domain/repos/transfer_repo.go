@@ -0,0 +1,24 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+//go:generate mockgen -package=repos -destination=transfer_repo_mock.go -source=transfer_repo.go
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *entity.Transfer) error
+	// Get returns entity.ErrTransferNotFound when the transfer does not exist.
+	Get(ctx context.Context, transferID string) (*entity.Transfer, error)
+	// GetForUpdate locks the transfer row until the surrounding transaction ends.
+	GetForUpdate(ctx context.Context, transferID string) (*entity.Transfer, error)
+	Update(ctx context.Context, transfer *entity.Transfer) error
+	// ListExpired returns holding or pending transfers whose expiry has
+	// passed, oldest first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*entity.Transfer, error)
+	// ListUnsettled returns accepted, declined or returned transfers whose
+	// hold has not been paid out or released yet, oldest first.
+	ListUnsettled(ctx context.Context, limit int) ([]*entity.Transfer, error)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+var (
+	ErrRedPacketInsufficientFunds = errors.New("insufficient funds for red packet")
+	ErrRedPacketLimitExceeded     = errors.New("red packet exceeds the sender's wallet limits")
+)
+
+// RedPacketLedger moves red packet money through a per-packet escrow account
+// in the wallet ledger. Every call is idempotent, so an interrupted send,
+// claim or refund can simply be retried.
+//
+//go:generate mockgen -package=service -destination=red_packet_ledger_mock.go -source=red_packet_ledger.go
+type RedPacketLedger interface {
+	// Fund moves TotalAmount from the sender into escrow and returns
+	// ErrRedPacketInsufficientFunds when the sender cannot cover it, or
+	// ErrRedPacketLimitExceeded when it is over the sender's wallet limits.
+	Fund(ctx context.Context, packet *entity.RedPacket) error
+	// PayClaim moves a claimed share from escrow to the claimant.
+	PayClaim(ctx context.Context, packet *entity.RedPacket, claim *entity.RedPacketClaim) error
+	// Refund moves amount from escrow back to the sender. It returns
+	// ErrRedPacketInsufficientFunds when the escrow holds less than amount,
+	// which for a pending packet means it was never funded.
+	Refund(ctx context.Context, packet *entity.RedPacket, amount int64) error
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: red_packet_ledger.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=red_packet_ledger_mock.go -source=red_packet_ledger.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/room/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRedPacketLedger is a mock of RedPacketLedger interface.
+type MockRedPacketLedger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedPacketLedgerMockRecorder
+	isgomock struct{}
+}
+
+// MockRedPacketLedgerMockRecorder is the mock recorder for MockRedPacketLedger.
+type MockRedPacketLedgerMockRecorder struct {
+	mock *MockRedPacketLedger
+}
+
+// NewMockRedPacketLedger creates a new mock instance.
+func NewMockRedPacketLedger(ctrl *gomock.Controller) *MockRedPacketLedger {
+	mock := &MockRedPacketLedger{ctrl: ctrl}
+	mock.recorder = &MockRedPacketLedgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedPacketLedger) EXPECT() *MockRedPacketLedgerMockRecorder {
+	return m.recorder
+}
+
+// Fund mocks base method.
+func (m *MockRedPacketLedger) Fund(ctx context.Context, packet *entity.RedPacket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fund", ctx, packet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fund indicates an expected call of Fund.
+func (mr *MockRedPacketLedgerMockRecorder) Fund(ctx, packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fund", reflect.TypeOf((*MockRedPacketLedger)(nil).Fund), ctx, packet)
+}
+
+// PayClaim mocks base method.
+func (m *MockRedPacketLedger) PayClaim(ctx context.Context, packet *entity.RedPacket, claim *entity.RedPacketClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayClaim", ctx, packet, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PayClaim indicates an expected call of PayClaim.
+func (mr *MockRedPacketLedgerMockRecorder) PayClaim(ctx, packet, claim any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayClaim", reflect.TypeOf((*MockRedPacketLedger)(nil).PayClaim), ctx, packet, claim)
+}
+
+// Refund mocks base method.
+func (m *MockRedPacketLedger) Refund(ctx context.Context, packet *entity.RedPacket, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, packet, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockRedPacketLedgerMockRecorder) Refund(ctx, packet, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockRedPacketLedger)(nil).Refund), ctx, packet, amount)
+}
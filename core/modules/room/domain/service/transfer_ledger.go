@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"wechat-clone/core/modules/room/domain/entity"
+)
+
+var (
+	ErrTransferInsufficientFunds = errors.New("insufficient funds for transfer")
+	ErrTransferLimitExceeded     = errors.New("transfer exceeds the sender's wallet limits")
+)
+
+// TransferLedger moves transfer money through a per-transfer hold account in
+// the wallet ledger. Every call is idempotent, so an interrupted send or
+// settlement can simply be retried.
+//
+//go:generate mockgen -package=service -destination=transfer_ledger_mock.go -source=transfer_ledger.go
+type TransferLedger interface {
+	// Hold moves Amount from the sender into the transfer's hold account and
+	// returns ErrTransferInsufficientFunds when the sender cannot cover it, or
+	// ErrTransferLimitExceeded when it is over the sender's wallet limits.
+	Hold(ctx context.Context, transfer *entity.Transfer) error
+	// Pay moves the held amount to the recipient.
+	Pay(ctx context.Context, transfer *entity.Transfer) error
+	// Release moves the held amount back to the sender. It returns
+	// ErrTransferInsufficientFunds when the hold is empty, which for a
+	// holding transfer means it was never funded.
+	Release(ctx context.Context, transfer *entity.Transfer) error
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transfer_ledger.go
+//
+// Generated by this command:
+//
+//	mockgen -package=service -destination=transfer_ledger_mock.go -source=transfer_ledger.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "wechat-clone/core/modules/room/domain/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransferLedger is a mock of TransferLedger interface.
+type MockTransferLedger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLedgerMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferLedgerMockRecorder is the mock recorder for MockTransferLedger.
+type MockTransferLedgerMockRecorder struct {
+	mock *MockTransferLedger
+}
+
+// NewMockTransferLedger creates a new mock instance.
+func NewMockTransferLedger(ctrl *gomock.Controller) *MockTransferLedger {
+	mock := &MockTransferLedger{ctrl: ctrl}
+	mock.recorder = &MockTransferLedgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLedger) EXPECT() *MockTransferLedgerMockRecorder {
+	return m.recorder
+}
+
+// Hold mocks base method.
+func (m *MockTransferLedger) Hold(ctx context.Context, transfer *entity.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockTransferLedgerMockRecorder) Hold(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockTransferLedger)(nil).Hold), ctx, transfer)
+}
+
+// Pay mocks base method.
+func (m *MockTransferLedger) Pay(ctx context.Context, transfer *entity.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockTransferLedgerMockRecorder) Pay(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockTransferLedger)(nil).Pay), ctx, transfer)
+}
+
+// Release mocks base method.
+func (m *MockTransferLedger) Release(ctx context.Context, transfer *entity.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockTransferLedgerMockRecorder) Release(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockTransferLedger)(nil).Release), ctx, transfer)
+}
@@ -0,0 +1,73 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const redPacketEscrowAccountPrefix = "ledger:escrow:red-packet:"
+
+type redPacketLedger struct {
+	ledger ledgerservice.LedgerService
+}
+
+// NewRedPacketLedger books red packets through the in-process ledger
+// service. Each packet gets its own escrow account, so its balance is
+// exactly the money still owed to claimants or the sender. Red packets are
+// fee exempt (finance.FeeOperationRedPacket), so no leg carries a fee.
+func NewRedPacketLedger(ledger ledgerservice.LedgerService) service.RedPacketLedger {
+	return &redPacketLedger{ledger: ledger}
+}
+
+func (l *redPacketLedger) Fund(ctx context.Context, packet *entity.RedPacket) error {
+	return l.transfer(ctx, ledgerservice.TransferToAccountCommand{
+		TransactionID: fmt.Sprintf("redpacket:%s:fund", packet.ID),
+		FromAccountID: packet.SenderID,
+		ToAccountID:   escrowAccountID(packet),
+		Currency:      packet.Currency,
+		Amount:        packet.TotalAmount,
+	})
+}
+
+func (l *redPacketLedger) PayClaim(ctx context.Context, packet *entity.RedPacket, claim *entity.RedPacketClaim) error {
+	return l.transfer(ctx, ledgerservice.TransferToAccountCommand{
+		TransactionID: fmt.Sprintf("redpacket:%s:claim:%s", packet.ID, claim.AccountID),
+		FromAccountID: escrowAccountID(packet),
+		ToAccountID:   claim.AccountID,
+		Currency:      packet.Currency,
+		Amount:        claim.Amount,
+	})
+}
+
+func (l *redPacketLedger) Refund(ctx context.Context, packet *entity.RedPacket, amount int64) error {
+	return l.transfer(ctx, ledgerservice.TransferToAccountCommand{
+		TransactionID: fmt.Sprintf("redpacket:%s:refund", packet.ID),
+		FromAccountID: escrowAccountID(packet),
+		ToAccountID:   packet.SenderID,
+		Currency:      packet.Currency,
+		Amount:        amount,
+	})
+}
+
+func (l *redPacketLedger) transfer(ctx context.Context, command ledgerservice.TransferToAccountCommand) error {
+	if _, err := l.ledger.TransferToAccount(ctx, command); err != nil {
+		if errors.Is(err, ledgerservice.ErrInsufficientFunds) {
+			return stackErr.Error(fmt.Errorf("%w: %w", service.ErrRedPacketInsufficientFunds, err))
+		}
+		if errors.Is(err, ledgerservice.ErrLimitExceeded) {
+			return stackErr.Error(fmt.Errorf("%w: %w", service.ErrRedPacketLimitExceeded, err))
+		}
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func escrowAccountID(packet *entity.RedPacket) string {
+	return redPacketEscrowAccountPrefix + packet.ID
+}
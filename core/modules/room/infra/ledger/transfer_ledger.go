@@ -0,0 +1,79 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ledgerservice "wechat-clone/core/modules/ledger/application/service"
+	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/modules/room/domain/service"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const transferHoldAccountPrefix = "ledger:hold:transfer:"
+
+type transferLedger struct {
+	ledger ledgerservice.LedgerService
+}
+
+// NewTransferLedger books chat transfers through the in-process ledger
+// service. Holding and releasing use the dedicated transfer hold events, so
+// the sender's statement shows the money as held rather than sent. Chat
+// transfers are fee exempt (finance.FeeOperationRoomTransfer), so no fee is
+// booked on payout.
+func NewTransferLedger(ledger ledgerservice.LedgerService) service.TransferLedger {
+	return &transferLedger{ledger: ledger}
+}
+
+func (l *transferLedger) Hold(ctx context.Context, transfer *entity.Transfer) error {
+	_, err := l.ledger.HoldTransfer(ctx, ledgerservice.TransferHoldCommand{
+		TransferID:    transfer.ID,
+		AccountID:     transfer.SenderID,
+		HoldAccountID: holdAccountID(transfer),
+		Currency:      transfer.Currency,
+		Amount:        transfer.Amount,
+		BookedAt:      transfer.CreatedAt,
+	})
+	return mapTransferLedgerError(err)
+}
+
+func (l *transferLedger) Pay(ctx context.Context, transfer *entity.Transfer) error {
+	_, err := l.ledger.TransferToAccount(ctx, ledgerservice.TransferToAccountCommand{
+		TransactionID: fmt.Sprintf("chat-transfer:%s:accept", transfer.ID),
+		FromAccountID: holdAccountID(transfer),
+		ToAccountID:   transfer.RecipientID,
+		Currency:      transfer.Currency,
+		Amount:        transfer.Amount,
+	})
+	return mapTransferLedgerError(err)
+}
+
+func (l *transferLedger) Release(ctx context.Context, transfer *entity.Transfer) error {
+	_, err := l.ledger.ReleaseTransferHold(ctx, ledgerservice.TransferHoldCommand{
+		TransferID:    transfer.ID,
+		AccountID:     transfer.SenderID,
+		HoldAccountID: holdAccountID(transfer),
+		Currency:      transfer.Currency,
+		Amount:        transfer.Amount,
+		BookedAt:      transfer.UpdatedAt,
+	})
+	return mapTransferLedgerError(err)
+}
+
+func mapTransferLedgerError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, ledgerservice.ErrInsufficientFunds) {
+		return stackErr.Error(fmt.Errorf("%w: %w", service.ErrTransferInsufficientFunds, err))
+	}
+	if errors.Is(err, ledgerservice.ErrLimitExceeded) {
+		return stackErr.Error(fmt.Errorf("%w: %w", service.ErrTransferLimitExceeded, err))
+	}
+	return stackErr.Error(err)
+}
+
+func holdAccountID(transfer *entity.Transfer) string {
+	return transferHoldAccountPrefix + transfer.ID
+}
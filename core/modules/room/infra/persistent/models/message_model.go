@@ -17,6 +17,10 @@ type MessageModel struct {
 	FileSize               *int64     `json:"file_size"`
 	MimeType               *string    `gorm:"type:varchar(255)" json:"mime_type"`
 	ObjectKey              *string    `gorm:"type:varchar(2048)" json:"object_key"`
+	ContactAccountID       *string    `gorm:"index" json:"contact_account_id"`
+	ContactCardJSON        *string    `gorm:"type:text" json:"contact_card_json"`
+	RedPacketJSON          *string    `gorm:"type:text" json:"red_packet_json"`
+	TransferJSON           *string    `gorm:"type:text" json:"transfer_json"`
 	EditedAt               *time.Time `json:"edited_at"`
 	DeletedForEveryoneAt   *time.Time `json:"deleted_for_everyone_at"`
 	CreatedAt              time.Time  `gorm:"autoCreateTime" json:"created_at"`
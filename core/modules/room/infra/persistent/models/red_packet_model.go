@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+type RedPacketModel struct {
+	ID              string    `gorm:"primaryKey" json:"id"`
+	RoomID          string    `gorm:"not null;index" json:"room_id"`
+	SenderID        string    `gorm:"not null" json:"sender_id"`
+	MessageID       *string   `json:"message_id"`
+	Currency        string    `gorm:"type:varchar(3);not null" json:"currency"`
+	TotalAmount     int64     `gorm:"not null" json:"total_amount"`
+	TotalCount      int       `gorm:"not null" json:"total_count"`
+	SplitMode       string    `gorm:"type:varchar(20);not null" json:"split_mode"`
+	Greeting        string    `gorm:"type:varchar(512);not null;default:''" json:"greeting"`
+	Status          string    `gorm:"type:varchar(20);not null" json:"status"`
+	RemainingAmount int64     `gorm:"not null" json:"remaining_amount"`
+	RemainingCount  int       `gorm:"not null" json:"remaining_count"`
+	RefundedAmount  int64     `gorm:"not null;default:0" json:"refunded_amount"`
+	FailureReason   string    `gorm:"type:varchar(255);not null;default:''" json:"failure_reason"`
+	ExpiresAt       time.Time `gorm:"not null" json:"expires_at"`
+	CreatedAt       time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (RedPacketModel) TableName() string {
+	return "red_packets"
+}
+
+type RedPacketClaimModel struct {
+	PacketID  string     `gorm:"primaryKey" json:"packet_id"`
+	AccountID string     `gorm:"primaryKey" json:"account_id"`
+	Amount    int64      `gorm:"not null" json:"amount"`
+	ClaimedAt time.Time  `gorm:"not null" json:"claimed_at"`
+	SettledAt *time.Time `json:"settled_at"`
+}
+
+func (RedPacketClaimModel) TableName() string {
+	return "red_packet_claims"
+}
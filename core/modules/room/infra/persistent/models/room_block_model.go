@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type RoomBlock struct {
+	BlockerID string    `gorm:"primaryKey"`
+	BlockedID string    `gorm:"primaryKey"`
+	BlockedAt time.Time `gorm:"not null"`
+}
+
+func (r *RoomBlock) TableName() string {
+	return "room_blocks"
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type RoomContactRemark struct {
+	OwnerID    string    `gorm:"primaryKey"`
+	TargetID   string    `gorm:"primaryKey"`
+	RemarkName string    `gorm:"not null"`
+	UpdatedAt  time.Time `gorm:"not null"`
+}
+
+func (r *RoomContactRemark) TableName() string {
+	return "room_contact_remarks"
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type RoomHiddenCard struct {
+	AccountID string    `gorm:"primaryKey"`
+	HiddenAt  time.Time `gorm:"not null"`
+}
+
+func (r *RoomHiddenCard) TableName() string {
+	return "room_hidden_cards"
+}
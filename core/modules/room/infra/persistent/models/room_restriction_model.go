@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type RoomRestriction struct {
+	OwnerID      string    `gorm:"primaryKey"`
+	RestrictedID string    `gorm:"primaryKey"`
+	RestrictedAt time.Time `gorm:"not null"`
+}
+
+func (r *RoomRestriction) TableName() string {
+	return "room_restrictions"
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type TransferModel struct {
+	ID            string     `gorm:"primaryKey" json:"id"`
+	RoomID        string     `gorm:"not null;index" json:"room_id"`
+	SenderID      string     `gorm:"not null" json:"sender_id"`
+	RecipientID   string     `gorm:"not null" json:"recipient_id"`
+	MessageID     *string    `json:"message_id"`
+	Currency      string     `gorm:"type:varchar(3);not null" json:"currency"`
+	Amount        int64      `gorm:"not null" json:"amount"`
+	Note          string     `gorm:"type:varchar(512);not null;default:''" json:"note"`
+	Status        string     `gorm:"type:varchar(20);not null" json:"status"`
+	FailureReason string     `gorm:"type:varchar(255);not null;default:''" json:"failure_reason"`
+	ExpiresAt     time.Time  `gorm:"not null" json:"expires_at"`
+	SettledAt     *time.Time `json:"settled_at"`
+	CreatedAt     time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+func (TransferModel) TableName() string {
+	return "chat_transfers"
+}
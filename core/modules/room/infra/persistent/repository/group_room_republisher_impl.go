@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	roomprojection "wechat-clone/core/modules/room/application/projection"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	roomtypes "wechat-clone/core/modules/room/types"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+)
+
+type groupRoomRepublisherImpl struct {
+	db *gorm.DB
+}
+
+func NewGroupRoomRepublisherImpl(db *gorm.DB) roomprojection.GroupRoomRepublisher {
+	return &groupRoomRepublisherImpl{db: db}
+}
+
+func (r *groupRoomRepublisherImpl) ListGroupRoomIDsAfter(ctx context.Context, afterID string, limit int) ([]string, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.RoomModel{}).
+		Where("LOWER(room_type) <> ?", string(roomtypes.RoomTypeDirect)).
+		Order("id ASC").
+		Limit(limit)
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var roomIDs []string
+	if err := query.Pluck("id", &roomIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return roomIDs, nil
+}
+
+// RepublishRoom appends the same projection event Save would write for the
+// room as it stands, so consumers treat it like any other room change.
+func (r *groupRoomRepublisherImpl) RepublishRoom(ctx context.Context, roomID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model models.RoomModel
+		if err := tx.Where("id = ?", roomID).First(&model).Error; err != nil {
+			return stackErr.Error(err)
+		}
+		room := (&roomRepoImpl{}).toEntity(&model)
+
+		members, err := NewRoomMemberImpl(tx).ListRoomMembers(ctx, roomID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		members, err = enrichRoomMembersWithAccountProjections(ctx, NewRoomAccountImpl(tx), sortRoomMembersByAccount(members))
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		lastMessage, err := NewMessageRepoImpl(tx).GetLastMessageByRoomID(ctx, roomID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+
+		baseVersion, err := loadLatestRoomOutboxVersion(ctx, tx, roomID)
+		if err != nil {
+			return stackErr.Error(err)
+		}
+		_, err = appendRoomOutboxEvents(ctx, NewRoomOutboxEventsRepoImpl(tx), roomID, baseVersion, []pendingRoomOutboxEvent{
+			buildRoomAggregateProjectionSyncEvent(room, members, lastMessage),
+		})
+		return stackErr.Error(err)
+	})
+}
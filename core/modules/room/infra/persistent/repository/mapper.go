@@ -18,6 +18,24 @@ func (r *messageRepoImpl) toModel(e *entity.MessageEntity) (*models.MessageModel
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	contactCardJSON, err := marshalMessageContactCard(e.ContactCard)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	redPacketJSON, err := marshalMessageRedPacket(e.RedPacket)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	transferJSON, err := marshalMessageTransfer(e.Transfer)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	var contactAccountID *string
+	if e.ContactCard != nil {
+		contactAccountID = utils.NullableString(e.ContactCard.AccountID)
+	}
 
 	return &models.MessageModel{
 		ID:                     e.ID,
@@ -34,6 +52,10 @@ func (r *messageRepoImpl) toModel(e *entity.MessageEntity) (*models.MessageModel
 		FileSize:               utils.Int64Ptr(e.FileSize),
 		MimeType:               utils.NullableString(e.MimeType),
 		ObjectKey:              utils.NullableString(e.ObjectKey),
+		ContactAccountID:       contactAccountID,
+		ContactCardJSON:        contactCardJSON,
+		RedPacketJSON:          redPacketJSON,
+		TransferJSON:           transferJSON,
 		EditedAt:               e.EditedAt,
 		DeletedForEveryoneAt:   e.DeletedForEveryoneAt,
 		CreatedAt:              e.CreatedAt,
@@ -49,6 +71,18 @@ func (r *messageRepoImpl) toEntity(m *models.MessageModel) (*entity.MessageEntit
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	contactCard, err := unmarshalMessageContactCard(utils.StringValue(m.ContactCardJSON))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	redPacket, err := unmarshalMessageRedPacket(utils.StringValue(m.RedPacketJSON))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	transfer, err := unmarshalMessageTransfer(utils.StringValue(m.TransferJSON))
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	var fileSize int64
 	if m.FileSize != nil {
@@ -70,6 +104,9 @@ func (r *messageRepoImpl) toEntity(m *models.MessageModel) (*entity.MessageEntit
 		FileSize:               fileSize,
 		MimeType:               utils.StringValue(m.MimeType),
 		ObjectKey:              utils.StringValue(m.ObjectKey),
+		ContactCard:            contactCard,
+		RedPacket:              redPacket,
+		Transfer:               transfer,
 		EditedAt:               m.EditedAt,
 		DeletedForEveryoneAt:   m.DeletedForEveryoneAt,
 		CreatedAt:              m.CreatedAt,
@@ -125,3 +162,81 @@ func unmarshalMessageMentions(raw string) ([]entity.MessageMention, error) {
 	}
 	return entity.NormalizeMessageMentions(mentions)
 }
+
+func marshalMessageContactCard(card *entity.MessageContactCard) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	raw := string(data)
+	return &raw, nil
+}
+
+func unmarshalMessageContactCard(raw string) (*entity.MessageContactCard, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var card entity.MessageContactCard
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return entity.NormalizeMessageContactCard(&card), nil
+}
+
+func marshalMessageRedPacket(card *entity.MessageRedPacket) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	raw := string(data)
+	return &raw, nil
+}
+
+func unmarshalMessageRedPacket(raw string) (*entity.MessageRedPacket, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var card entity.MessageRedPacket
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return entity.NormalizeMessageRedPacket(&card), nil
+}
+
+func marshalMessageTransfer(card *entity.MessageTransfer) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	raw := string(data)
+	return &raw, nil
+}
+
+func unmarshalMessageTransfer(raw string) (*entity.MessageTransfer, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var card entity.MessageTransfer
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return entity.NormalizeMessageTransfer(&card), nil
+}
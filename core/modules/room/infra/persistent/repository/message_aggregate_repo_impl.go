@@ -65,6 +65,14 @@ func (r *messageAggregateRepoImpl) LoadForRecipient(ctx context.Context, message
 	return aggregate.NewMessageStateAggregateForRecipient(message, member)
 }
 
+func (r *messageAggregateRepoImpl) ListContactCardMessageIDs(ctx context.Context, accountID string) ([]string, error) {
+	ids, err := r.messageRepo.ListContactCardMessageIDs(ctx, accountID)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return ids, nil
+}
+
 func (r *messageAggregateRepoImpl) Save(ctx context.Context, agg *aggregate.MessageStateAggregate) error {
 	if agg == nil {
 		return stackErr.Error(aggregate.ErrMessageAggregateNil)
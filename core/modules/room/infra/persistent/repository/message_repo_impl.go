@@ -48,6 +48,10 @@ func (r *messageRepoImpl) UpdateMessage(ctx context.Context, message *entity.Mes
 		"file_size":                 m.FileSize,
 		"mime_type":                 m.MimeType,
 		"object_key":                m.ObjectKey,
+		"contact_account_id":        m.ContactAccountID,
+		"contact_card_json":         m.ContactCardJSON,
+		"red_packet_json":           m.RedPacketJSON,
+		"transfer_json":             m.TransferJSON,
 		"edited_at":                 m.EditedAt,
 		"deleted_for_everyone_at":   m.DeletedForEveryoneAt,
 		"created_at":                m.CreatedAt,
@@ -87,3 +91,17 @@ func (r *messageRepoImpl) GetLastMessageByRoomID(ctx context.Context, roomID str
 	}
 	return entityMessage, nil
 }
+
+// ListContactCardMessageIDs returns the messages that share accountID's
+// contact card, so their previews can be refreshed after a profile change.
+func (r *messageRepoImpl) ListContactCardMessageIDs(ctx context.Context, accountID string) ([]string, error) {
+	var ids []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.MessageModel{}).
+		Where("contact_account_id = ?", accountID).
+		Order("created_at ASC, id ASC").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return ids, nil
+}
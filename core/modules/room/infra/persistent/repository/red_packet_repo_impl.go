@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	dbinfra "wechat-clone/core/shared/infra/db"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type redPacketRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewRedPacketRepoImpl(db *gorm.DB) repos.RedPacketRepository {
+	return &redPacketRepoImpl{db: db}
+}
+
+func (r *redPacketRepoImpl) Create(ctx context.Context, packet *entity.RedPacket) error {
+	return stackErr.Error(r.db.WithContext(ctx).Create(toRedPacketModel(packet)).Error)
+}
+
+func (r *redPacketRepoImpl) Get(ctx context.Context, packetID string) (*entity.RedPacket, error) {
+	return r.get(r.db.WithContext(ctx), packetID)
+}
+
+func (r *redPacketRepoImpl) GetForUpdate(ctx context.Context, packetID string) (*entity.RedPacket, error) {
+	return r.get(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), packetID)
+}
+
+func (r *redPacketRepoImpl) get(db *gorm.DB, packetID string) (*entity.RedPacket, error) {
+	var m models.RedPacketModel
+	if err := db.Where("id = ?", strings.TrimSpace(packetID)).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(entity.ErrRedPacketNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toRedPacketEntity(&m), nil
+}
+
+func (r *redPacketRepoImpl) Update(ctx context.Context, packet *entity.RedPacket) error {
+	m := toRedPacketModel(packet)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&models.RedPacketModel{}).
+		Where("id = ?", m.ID).
+		Updates(map[string]interface{}{
+			"message_id":       m.MessageID,
+			"status":           m.Status,
+			"remaining_amount": m.RemainingAmount,
+			"remaining_count":  m.RemainingCount,
+			"refunded_amount":  m.RefundedAmount,
+			"failure_reason":   m.FailureReason,
+			"updated_at":       m.UpdatedAt,
+		}).Error)
+}
+
+func (r *redPacketRepoImpl) ListExpired(ctx context.Context, now time.Time, limit int) ([]*entity.RedPacket, error) {
+	var rows []models.RedPacketModel
+	if err := r.db.WithContext(ctx).
+		Where("status IN ? AND expires_at <= ?", []string{entity.RedPacketStatusPending, entity.RedPacketStatusOpen}, now.UTC()).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	packets := make([]*entity.RedPacket, 0, len(rows))
+	for idx := range rows {
+		packets = append(packets, toRedPacketEntity(&rows[idx]))
+	}
+	return packets, nil
+}
+
+func (r *redPacketRepoImpl) CreateClaim(ctx context.Context, claim *entity.RedPacketClaim) error {
+	if err := r.db.WithContext(ctx).Create(toRedPacketClaimModel(claim)).Error; err != nil {
+		if dbinfra.IsUniqueConstraintError(err) {
+			return stackErr.Error(entity.ErrRedPacketAlreadyClaimed)
+		}
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *redPacketRepoImpl) GetClaim(ctx context.Context, packetID, accountID string) (*entity.RedPacketClaim, error) {
+	var m models.RedPacketClaimModel
+	err := r.db.WithContext(ctx).
+		Where("packet_id = ? AND account_id = ?", strings.TrimSpace(packetID), strings.TrimSpace(accountID)).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toRedPacketClaimEntity(&m), nil
+}
+
+func (r *redPacketRepoImpl) UpdateClaim(ctx context.Context, claim *entity.RedPacketClaim) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&models.RedPacketClaimModel{}).
+		Where("packet_id = ? AND account_id = ?", claim.PacketID, claim.AccountID).
+		Update("settled_at", claim.SettledAt).Error)
+}
+
+func (r *redPacketRepoImpl) ListClaims(ctx context.Context, packetID string) ([]*entity.RedPacketClaim, error) {
+	var rows []models.RedPacketClaimModel
+	if err := r.db.WithContext(ctx).
+		Where("packet_id = ?", strings.TrimSpace(packetID)).
+		Order("claimed_at ASC").
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	claims := make([]*entity.RedPacketClaim, 0, len(rows))
+	for idx := range rows {
+		claims = append(claims, toRedPacketClaimEntity(&rows[idx]))
+	}
+	return claims, nil
+}
+
+func toRedPacketModel(packet *entity.RedPacket) *models.RedPacketModel {
+	return &models.RedPacketModel{
+		ID:              packet.ID,
+		RoomID:          packet.RoomID,
+		SenderID:        packet.SenderID,
+		MessageID:       utils.NullableString(packet.MessageID),
+		Currency:        packet.Currency,
+		TotalAmount:     packet.TotalAmount,
+		TotalCount:      packet.TotalCount,
+		SplitMode:       packet.SplitMode,
+		Greeting:        packet.Greeting,
+		Status:          packet.Status,
+		RemainingAmount: packet.RemainingAmount,
+		RemainingCount:  packet.RemainingCount,
+		RefundedAmount:  packet.RefundedAmount,
+		FailureReason:   packet.FailureReason,
+		ExpiresAt:       packet.ExpiresAt.UTC(),
+		CreatedAt:       packet.CreatedAt.UTC(),
+		UpdatedAt:       packet.UpdatedAt.UTC(),
+	}
+}
+
+func toRedPacketEntity(m *models.RedPacketModel) *entity.RedPacket {
+	return &entity.RedPacket{
+		ID:              m.ID,
+		RoomID:          m.RoomID,
+		SenderID:        m.SenderID,
+		MessageID:       utils.StringValue(m.MessageID),
+		Currency:        m.Currency,
+		TotalAmount:     m.TotalAmount,
+		TotalCount:      m.TotalCount,
+		SplitMode:       m.SplitMode,
+		Greeting:        m.Greeting,
+		Status:          m.Status,
+		RemainingAmount: m.RemainingAmount,
+		RemainingCount:  m.RemainingCount,
+		RefundedAmount:  m.RefundedAmount,
+		FailureReason:   m.FailureReason,
+		ExpiresAt:       m.ExpiresAt.UTC(),
+		CreatedAt:       m.CreatedAt.UTC(),
+		UpdatedAt:       m.UpdatedAt.UTC(),
+	}
+}
+
+func toRedPacketClaimModel(claim *entity.RedPacketClaim) *models.RedPacketClaimModel {
+	return &models.RedPacketClaimModel{
+		PacketID:  claim.PacketID,
+		AccountID: claim.AccountID,
+		Amount:    claim.Amount,
+		ClaimedAt: claim.ClaimedAt.UTC(),
+		SettledAt: claim.SettledAt,
+	}
+}
+
+func toRedPacketClaimEntity(m *models.RedPacketClaimModel) *entity.RedPacketClaim {
+	return &entity.RedPacketClaim{
+		PacketID:  m.PacketID,
+		AccountID: m.AccountID,
+		Amount:    m.Amount,
+		ClaimedAt: m.ClaimedAt.UTC(),
+		SettledAt: m.SettledAt,
+	}
+}
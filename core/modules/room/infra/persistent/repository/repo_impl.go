@@ -17,6 +17,13 @@ type repoImpl struct {
 
 	roomAggregateRepo repos.RoomAggregateRepository
 	messageAggRepo    repos.MessageAggregateRepository
+	blockRepo         repos.BlockRepository
+	remarkRepo        repos.ContactRemarkRepository
+	restrictionRepo   repos.RestrictionRepository
+	hiddenCardRepo    repos.HiddenCardRepository
+	accountRepo       repos.AccountRepository
+	redPacketRepo     repos.RedPacketRepository
+	transferRepo      repos.TransferRepository
 }
 
 func NewRepoImpl(appCtx *appCtx.AppContext) (repos.Repos, error) {
@@ -35,6 +42,13 @@ func newRepoImplWithDB(appCtx *appCtx.AppContext, db *gorm.DB) (repos.Repos, err
 	return &repoImpl{
 		roomAggregateRepo: roomAggregateRepo,
 		messageAggRepo:    messageAggregateRepo,
+		blockRepo:         NewRoomBlockRepoImpl(db),
+		remarkRepo:        NewRoomContactRemarkRepoImpl(db),
+		restrictionRepo:   NewRoomRestrictionRepoImpl(db),
+		hiddenCardRepo:    NewRoomHiddenCardRepoImpl(db),
+		accountRepo:       accountRepo,
+		redPacketRepo:     NewRedPacketRepoImpl(db),
+		transferRepo:      NewTransferRepoImpl(db),
 		db:                db,
 		appCtx:            appCtx,
 	}, nil
@@ -48,6 +62,34 @@ func (r *repoImpl) MessageAggregateRepository() repos.MessageAggregateRepository
 	return r.messageAggRepo
 }
 
+func (r *repoImpl) BlockRepository() repos.BlockRepository {
+	return r.blockRepo
+}
+
+func (r *repoImpl) ContactRemarkRepository() repos.ContactRemarkRepository {
+	return r.remarkRepo
+}
+
+func (r *repoImpl) RestrictionRepository() repos.RestrictionRepository {
+	return r.restrictionRepo
+}
+
+func (r *repoImpl) HiddenCardRepository() repos.HiddenCardRepository {
+	return r.hiddenCardRepo
+}
+
+func (r *repoImpl) AccountRepository() repos.AccountRepository {
+	return r.accountRepo
+}
+
+func (r *repoImpl) RedPacketRepository() repos.RedPacketRepository {
+	return r.redPacketRepo
+}
+
+func (r *repoImpl) TransferRepository() repos.TransferRepository {
+	return r.transferRepo
+}
+
 func (r *repoImpl) WithTransaction(ctx context.Context, fn func(repos.Repos) error) (err error) {
 	log := logging.FromContext(ctx).Named("StartRoomTransaction")
 	tx := r.db.WithContext(ctx).Begin()
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"wechat-clone/core/modules/room/domain/entity"
 	"wechat-clone/core/modules/room/infra/persistent/models"
 	"wechat-clone/core/shared/pkg/stackErr"
@@ -40,6 +42,21 @@ func (r *RoomAccountImpl) ProjectAccount(ctx context.Context, account *entity.Ac
 	return nil
 }
 
+// ProjectAccountUsername only touches the handle so a rename cannot race with
+// a profile update that carries an older display name.
+func (r *RoomAccountImpl) ProjectAccountUsername(ctx context.Context, accountID, username string, updatedAt time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomAccount{}).
+		Where("account_id = ?", accountID).
+		Updates(map[string]interface{}{
+			"username":   username,
+			"updated_at": updatedAt,
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
 func (r *RoomAccountImpl) ListByAccountIDs(ctx context.Context, accountIDs []string) ([]*entity.AccountEntity, error) {
 	if len(accountIDs) == 0 {
 		return []*entity.AccountEntity{}, nil
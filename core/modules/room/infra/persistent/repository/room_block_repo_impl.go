@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomBlockRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewRoomBlockRepoImpl(db *gorm.DB) repos.BlockRepository {
+	return &roomBlockRepoImpl{db: db}
+}
+
+func (r *roomBlockRepoImpl) ProjectBlocked(ctx context.Context, blockerID, blockedID string, blockedAt time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "blocker_id"}, {Name: "blocked_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"blocked_at"}),
+		}).
+		Create(&models.RoomBlock{
+			BlockerID: strings.TrimSpace(blockerID),
+			BlockedID: strings.TrimSpace(blockedID),
+			BlockedAt: blockedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *roomBlockRepoImpl) RemoveBlocked(ctx context.Context, blockerID, blockedID string) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", strings.TrimSpace(blockerID), strings.TrimSpace(blockedID)).
+		Delete(&models.RoomBlock{}).Error)
+}
+
+func (r *roomBlockRepoImpl) IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomBlock{}).
+		Where("blocker_id = ? AND blocked_id = ?", strings.TrimSpace(blockerID), strings.TrimSpace(blockedID)).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
+
+func (r *roomBlockRepoImpl) IsBlockedBetween(ctx context.Context, accountID, otherAccountID string) (bool, error) {
+	accountID = strings.TrimSpace(accountID)
+	otherAccountID = strings.TrimSpace(otherAccountID)
+
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)",
+			accountID, otherAccountID, otherAccountID, accountID).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
+
+func (r *roomBlockRepoImpl) ListBlockedBetween(ctx context.Context, accountID string, peerIDs []string) ([]string, error) {
+	if len(peerIDs) == 0 {
+		return []string{}, nil
+	}
+	accountID = strings.TrimSpace(accountID)
+
+	var rows []models.RoomBlock
+	if err := r.db.WithContext(ctx).
+		Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)",
+			accountID, peerIDs, accountID, peerIDs).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	return lo.Uniq(lo.Map(rows, func(row models.RoomBlock, _ int) string {
+		if row.BlockerID == accountID {
+			return row.BlockedID
+		}
+		return row.BlockerID
+	})), nil
+}
+
+func (r *roomBlockRepoImpl) ListBlockedBy(ctx context.Context, blockerID string, accountIDs []string) ([]string, error) {
+	if len(accountIDs) == 0 {
+		return []string{}, nil
+	}
+
+	var blockedIDs []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomBlock{}).
+		Where("blocker_id = ? AND blocked_id IN ?", strings.TrimSpace(blockerID), accountIDs).
+		Pluck("blocked_id", &blockedIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return blockedIDs, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomContactRemarkRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewRoomContactRemarkRepoImpl(db *gorm.DB) repos.ContactRemarkRepository {
+	return &roomContactRemarkRepoImpl{db: db}
+}
+
+func (r *roomContactRemarkRepoImpl) ProjectRemark(ctx context.Context, ownerID, targetID, remarkName string, updatedAt time.Time) error {
+	ownerID = strings.TrimSpace(ownerID)
+	targetID = strings.TrimSpace(targetID)
+	remarkName = strings.TrimSpace(remarkName)
+	if remarkName == "" {
+		return stackErr.Error(r.db.WithContext(ctx).
+			Where("owner_id = ? AND target_id = ?", ownerID, targetID).
+			Delete(&models.RoomContactRemark{}).Error)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}, {Name: "target_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"remark_name", "updated_at"}),
+		}).
+		Create(&models.RoomContactRemark{
+			OwnerID:    ownerID,
+			TargetID:   targetID,
+			RemarkName: remarkName,
+			UpdatedAt:  updatedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *roomContactRemarkRepoImpl) RemoveBetween(ctx context.Context, accountID, otherAccountID string) error {
+	accountID = strings.TrimSpace(accountID)
+	otherAccountID = strings.TrimSpace(otherAccountID)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("(owner_id = ? AND target_id = ?) OR (owner_id = ? AND target_id = ?)",
+			accountID, otherAccountID, otherAccountID, accountID).
+		Delete(&models.RoomContactRemark{}).Error)
+}
+
+func (r *roomContactRemarkRepoImpl) ListRemarks(ctx context.Context, ownerID string, targetIDs []string) (map[string]string, error) {
+	remarks := make(map[string]string, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return remarks, nil
+	}
+
+	var rows []models.RoomContactRemark
+	if err := r.db.WithContext(ctx).
+		Where("owner_id = ? AND target_id IN ?", strings.TrimSpace(ownerID), targetIDs).
+		Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	for _, row := range rows {
+		remarks[row.TargetID] = row.RemarkName
+	}
+	return remarks, nil
+}
+
+func (r *roomContactRemarkRepoImpl) SearchRemarkTargets(ctx context.Context, ownerID, keyword string, limit int) ([]string, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return []string{}, nil
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var targetIDs []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomContactRemark{}).
+		Where("owner_id = ? AND LOWER(remark_name) LIKE ?", strings.TrimSpace(ownerID), "%"+strings.ToLower(keyword)+"%").
+		Order("remark_name ASC").
+		Limit(limit).
+		Pluck("target_id", &targetIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return targetIDs, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomHiddenCardRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewRoomHiddenCardRepoImpl(db *gorm.DB) repos.HiddenCardRepository {
+	return &roomHiddenCardRepoImpl{db: db}
+}
+
+func (r *roomHiddenCardRepoImpl) ProjectHidden(ctx context.Context, accountID string, hiddenAt time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"hidden_at"}),
+		}).
+		Create(&models.RoomHiddenCard{
+			AccountID: strings.TrimSpace(accountID),
+			HiddenAt:  hiddenAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *roomHiddenCardRepoImpl) RemoveHidden(ctx context.Context, accountID string) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("account_id = ?", strings.TrimSpace(accountID)).
+		Delete(&models.RoomHiddenCard{}).Error)
+}
+
+func (r *roomHiddenCardRepoImpl) IsHidden(ctx context.Context, accountID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomHiddenCard{}).
+		Where("account_id = ?", strings.TrimSpace(accountID)).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
@@ -179,6 +179,9 @@ func buildMessageProjection(payload messageProjectionPayload) *roomprojection.Me
 		FileSize:               payload.Message.FileSize,
 		MimeType:               payload.Message.MimeType,
 		ObjectKey:              payload.Message.ObjectKey,
+		ContactCard:            mapProjectionContactCard(payload.Message.ContactCard),
+		RedPacket:              mapProjectionRedPacket(payload.Message.RedPacket),
+		Transfer:               mapProjectionTransfer(payload.Message.Transfer),
 		MessageSenderID:        payload.Message.SenderID,
 		MessageSenderName:      strings.TrimSpace(payload.Sender.Name),
 		MessageSenderEmail:     strings.TrimSpace(payload.Sender.Email),
@@ -192,6 +195,48 @@ func buildMessageProjection(payload messageProjectionPayload) *roomprojection.Me
 	}
 }
 
+func mapProjectionContactCard(card *entity.MessageContactCard) *roomprojection.ProjectionContactCard {
+	if card == nil {
+		return nil
+	}
+
+	return &roomprojection.ProjectionContactCard{
+		AccountID:       card.AccountID,
+		DisplayName:     card.DisplayName,
+		Username:        card.Username,
+		AvatarObjectKey: card.AvatarObjectKey,
+		Token:           card.Token,
+	}
+}
+
+func mapProjectionRedPacket(card *entity.MessageRedPacket) *roomprojection.ProjectionRedPacket {
+	if card == nil {
+		return nil
+	}
+
+	return &roomprojection.ProjectionRedPacket{
+		PacketID:    card.PacketID,
+		Currency:    card.Currency,
+		TotalAmount: card.TotalAmount,
+		Count:       card.Count,
+		SplitMode:   card.SplitMode,
+	}
+}
+
+func mapProjectionTransfer(card *entity.MessageTransfer) *roomprojection.ProjectionTransfer {
+	if card == nil {
+		return nil
+	}
+
+	return &roomprojection.ProjectionTransfer{
+		TransferID:  card.TransferID,
+		RecipientID: card.RecipientID,
+		Currency:    card.Currency,
+		Amount:      card.Amount,
+		Status:      card.Status,
+	}
+}
+
 func mapProjectionReactions(items []entity.MessageReaction) []roomprojection.ProjectionReaction {
 	if len(items) == 0 {
 		return nil
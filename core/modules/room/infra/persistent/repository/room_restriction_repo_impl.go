@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomRestrictionRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewRoomRestrictionRepoImpl(db *gorm.DB) repos.RestrictionRepository {
+	return &roomRestrictionRepoImpl{db: db}
+}
+
+func (r *roomRestrictionRepoImpl) ProjectRestricted(ctx context.Context, ownerID, restrictedID string, restrictedAt time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}, {Name: "restricted_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"restricted_at"}),
+		}).
+		Create(&models.RoomRestriction{
+			OwnerID:      strings.TrimSpace(ownerID),
+			RestrictedID: strings.TrimSpace(restrictedID),
+			RestrictedAt: restrictedAt.UTC(),
+		}).Error; err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (r *roomRestrictionRepoImpl) RemoveRestricted(ctx context.Context, ownerID, restrictedID string) error {
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("owner_id = ? AND restricted_id = ?", strings.TrimSpace(ownerID), strings.TrimSpace(restrictedID)).
+		Delete(&models.RoomRestriction{}).Error)
+}
+
+func (r *roomRestrictionRepoImpl) RemoveBetween(ctx context.Context, accountID, otherAccountID string) error {
+	accountID = strings.TrimSpace(accountID)
+	otherAccountID = strings.TrimSpace(otherAccountID)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Where("(owner_id = ? AND restricted_id = ?) OR (owner_id = ? AND restricted_id = ?)",
+			accountID, otherAccountID, otherAccountID, accountID).
+		Delete(&models.RoomRestriction{}).Error)
+}
+
+func (r *roomRestrictionRepoImpl) IsRestricted(ctx context.Context, ownerID, accountID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomRestriction{}).
+		Where("owner_id = ? AND restricted_id = ?", strings.TrimSpace(ownerID), strings.TrimSpace(accountID)).
+		Count(&count).Error; err != nil {
+		return false, stackErr.Error(err)
+	}
+	return count > 0, nil
+}
+
+func (r *roomRestrictionRepoImpl) ListRestrictedBy(ctx context.Context, ownerID string, accountIDs []string) ([]string, error) {
+	if len(accountIDs) == 0 {
+		return []string{}, nil
+	}
+
+	var restrictedIDs []string
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoomRestriction{}).
+		Where("owner_id = ? AND restricted_id IN ?", strings.TrimSpace(ownerID), accountIDs).
+		Pluck("restricted_id", &restrictedIDs).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return restrictedIDs, nil
+}
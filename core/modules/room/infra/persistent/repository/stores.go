@@ -21,6 +21,7 @@ type messageStore interface {
 	UpdateMessage(ctx context.Context, message *entity.MessageEntity) error
 	GetMessageByID(ctx context.Context, id string) (*entity.MessageEntity, error)
 	GetLastMessageByRoomID(ctx context.Context, roomID string) (*entity.MessageEntity, error)
+	ListContactCardMessageIDs(ctx context.Context, accountID string) ([]string, error)
 }
 
 type roomMemberStore interface {
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"wechat-clone/core/modules/room/domain/entity"
+	"wechat-clone/core/modules/room/domain/repos"
+	"wechat-clone/core/modules/room/infra/persistent/models"
+	"wechat-clone/core/shared/pkg/stackErr"
+	"wechat-clone/core/shared/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type transferRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewTransferRepoImpl(db *gorm.DB) repos.TransferRepository {
+	return &transferRepoImpl{db: db}
+}
+
+func (r *transferRepoImpl) Create(ctx context.Context, transfer *entity.Transfer) error {
+	return stackErr.Error(r.db.WithContext(ctx).Create(toTransferModel(transfer)).Error)
+}
+
+func (r *transferRepoImpl) Get(ctx context.Context, transferID string) (*entity.Transfer, error) {
+	return r.get(r.db.WithContext(ctx), transferID)
+}
+
+func (r *transferRepoImpl) GetForUpdate(ctx context.Context, transferID string) (*entity.Transfer, error) {
+	return r.get(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), transferID)
+}
+
+func (r *transferRepoImpl) get(db *gorm.DB, transferID string) (*entity.Transfer, error) {
+	var m models.TransferModel
+	if err := db.Where("id = ?", strings.TrimSpace(transferID)).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, stackErr.Error(entity.ErrTransferNotFound)
+		}
+		return nil, stackErr.Error(err)
+	}
+	return toTransferEntity(&m), nil
+}
+
+func (r *transferRepoImpl) Update(ctx context.Context, transfer *entity.Transfer) error {
+	m := toTransferModel(transfer)
+	return stackErr.Error(r.db.WithContext(ctx).
+		Model(&models.TransferModel{}).
+		Where("id = ?", m.ID).
+		Updates(map[string]interface{}{
+			"message_id":     m.MessageID,
+			"status":         m.Status,
+			"failure_reason": m.FailureReason,
+			"settled_at":     m.SettledAt,
+			"updated_at":     m.UpdatedAt,
+		}).Error)
+}
+
+func (r *transferRepoImpl) ListExpired(ctx context.Context, now time.Time, limit int) ([]*entity.Transfer, error) {
+	return r.list(r.db.WithContext(ctx).
+		Where("status IN ? AND expires_at <= ?", []string{entity.TransferStatusHolding, entity.TransferStatusPending}, now.UTC()).
+		Order("expires_at ASC").
+		Limit(limit))
+}
+
+func (r *transferRepoImpl) ListUnsettled(ctx context.Context, limit int) ([]*entity.Transfer, error) {
+	return r.list(r.db.WithContext(ctx).
+		Where("status IN ? AND settled_at IS NULL", []string{entity.TransferStatusAccepted, entity.TransferStatusDeclined, entity.TransferStatusReturned}).
+		Order("updated_at ASC").
+		Limit(limit))
+}
+
+func (r *transferRepoImpl) list(db *gorm.DB) ([]*entity.Transfer, error) {
+	var rows []models.TransferModel
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, stackErr.Error(err)
+	}
+
+	transfers := make([]*entity.Transfer, 0, len(rows))
+	for idx := range rows {
+		transfers = append(transfers, toTransferEntity(&rows[idx]))
+	}
+	return transfers, nil
+}
+
+func toTransferModel(transfer *entity.Transfer) *models.TransferModel {
+	return &models.TransferModel{
+		ID:            transfer.ID,
+		RoomID:        transfer.RoomID,
+		SenderID:      transfer.SenderID,
+		RecipientID:   transfer.RecipientID,
+		MessageID:     utils.NullableString(transfer.MessageID),
+		Currency:      transfer.Currency,
+		Amount:        transfer.Amount,
+		Note:          transfer.Note,
+		Status:        transfer.Status,
+		FailureReason: transfer.FailureReason,
+		ExpiresAt:     transfer.ExpiresAt.UTC(),
+		SettledAt:     transfer.SettledAt,
+		CreatedAt:     transfer.CreatedAt.UTC(),
+		UpdatedAt:     transfer.UpdatedAt.UTC(),
+	}
+}
+
+func toTransferEntity(m *models.TransferModel) *entity.Transfer {
+	return &entity.Transfer{
+		ID:            m.ID,
+		RoomID:        m.RoomID,
+		SenderID:      m.SenderID,
+		RecipientID:   m.RecipientID,
+		MessageID:     utils.StringValue(m.MessageID),
+		Currency:      m.Currency,
+		Amount:        m.Amount,
+		Note:          m.Note,
+		Status:        m.Status,
+		FailureReason: m.FailureReason,
+		ExpiresAt:     m.ExpiresAt.UTC(),
+		SettledAt:     m.SettledAt,
+		CreatedAt:     m.CreatedAt.UTC(),
+		UpdatedAt:     m.UpdatedAt.UTC(),
+	}
+}
@@ -634,6 +634,18 @@ func messageRowToEntity(row *messageProjectionRow) (*views.MessageView, error) {
 	if err != nil {
 		return nil, stackErr.Error(err)
 	}
+	contactCard, err := unmarshalProjectionContactCard(row.ContactCardJSON)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	redPacket, err := unmarshalProjectionRedPacket(row.RedPacketJSON)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	transfer, err := unmarshalProjectionTransfer(row.TransferJSON)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
 
 	return &views.MessageView{
 		ID:                     row.MessageID,
@@ -650,6 +662,9 @@ func messageRowToEntity(row *messageProjectionRow) (*views.MessageView, error) {
 		FileSize:               row.FileSize,
 		MimeType:               strings.TrimSpace(row.MimeType),
 		ObjectKey:              strings.TrimSpace(row.ObjectKey),
+		ContactCard:            contactCard,
+		RedPacket:              redPacket,
+		Transfer:               transfer,
 		EditedAt:               utils.ClonePtr(row.EditedAt),
 		DeletedForEveryoneAt:   utils.ClonePtr(row.DeletedForEveryoneAt),
 		CreatedAt:              row.MessageSentAt.UTC(),
@@ -728,6 +743,9 @@ func messageViewToProjection(message *views.MessageView) *roomprojection.Message
 		FileSize:               message.FileSize,
 		MimeType:               message.MimeType,
 		ObjectKey:              message.ObjectKey,
+		ContactCard:            mapProjectionContactCardFromView(message.ContactCard),
+		RedPacket:              mapProjectionRedPacketFromView(message.RedPacket),
+		Transfer:               mapProjectionTransferFromView(message.Transfer),
 		MessageSenderID:        message.SenderID,
 		MessageSentAt:          message.CreatedAt.UTC(),
 		Mentions:               mentions,
@@ -899,3 +917,96 @@ func mapProjectionReactionsFromView(items []views.MessageReactionView) []roompro
 	}
 	return results
 }
+
+func unmarshalProjectionContactCard(raw string) (*views.MessageContactCardView, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var card roomprojection.ProjectionContactCard
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &views.MessageContactCardView{
+		AccountID:       strings.TrimSpace(card.AccountID),
+		DisplayName:     strings.TrimSpace(card.DisplayName),
+		Username:        strings.TrimSpace(card.Username),
+		AvatarObjectKey: strings.TrimSpace(card.AvatarObjectKey),
+		Token:           strings.TrimSpace(card.Token),
+	}, nil
+}
+
+func mapProjectionContactCardFromView(card *views.MessageContactCardView) *roomprojection.ProjectionContactCard {
+	if card == nil {
+		return nil
+	}
+	return &roomprojection.ProjectionContactCard{
+		AccountID:       card.AccountID,
+		DisplayName:     card.DisplayName,
+		Username:        card.Username,
+		AvatarObjectKey: card.AvatarObjectKey,
+		Token:           card.Token,
+	}
+}
+
+func unmarshalProjectionRedPacket(raw string) (*views.MessageRedPacketView, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var card roomprojection.ProjectionRedPacket
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &views.MessageRedPacketView{
+		PacketID:    strings.TrimSpace(card.PacketID),
+		Currency:    strings.TrimSpace(card.Currency),
+		TotalAmount: card.TotalAmount,
+		Count:       card.Count,
+		SplitMode:   strings.TrimSpace(card.SplitMode),
+	}, nil
+}
+
+func mapProjectionRedPacketFromView(card *views.MessageRedPacketView) *roomprojection.ProjectionRedPacket {
+	if card == nil {
+		return nil
+	}
+	return &roomprojection.ProjectionRedPacket{
+		PacketID:    card.PacketID,
+		Currency:    card.Currency,
+		TotalAmount: card.TotalAmount,
+		Count:       card.Count,
+		SplitMode:   card.SplitMode,
+	}
+}
+
+func unmarshalProjectionTransfer(raw string) (*views.MessageTransferView, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var card roomprojection.ProjectionTransfer
+	if err := json.Unmarshal([]byte(raw), &card); err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return &views.MessageTransferView{
+		TransferID:  strings.TrimSpace(card.TransferID),
+		RecipientID: strings.TrimSpace(card.RecipientID),
+		Currency:    strings.TrimSpace(card.Currency),
+		Amount:      card.Amount,
+		Status:      strings.TrimSpace(card.Status),
+	}, nil
+}
+
+func mapProjectionTransferFromView(card *views.MessageTransferView) *roomprojection.ProjectionTransfer {
+	if card == nil {
+		return nil
+	}
+	return &roomprojection.ProjectionTransfer{
+		TransferID:  card.TransferID,
+		RecipientID: card.RecipientID,
+		Currency:    card.Currency,
+		Amount:      card.Amount,
+		Status:      card.Status,
+	}
+}
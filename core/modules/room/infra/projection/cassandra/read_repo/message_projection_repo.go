@@ -35,6 +35,9 @@ type MessageProjectionRow struct {
 	MessageSentAt          time.Time
 	MentionsJSON           string
 	ReactionsJSON          string
+	ContactCardJSON        string
+	RedPacketJSON          string
+	TransferJSON           string
 	MentionAll             bool
 	MentionedAccountIDs    []string
 	EditedAt               *time.Time
@@ -64,8 +67,20 @@ func (r *MessageProjectionRepo) UpsertTimelineRow(ctx context.Context, projectio
 	if err != nil {
 		return stackErr.Error(fmt.Errorf("marshal cassandra timeline reactions failed: %w", err))
 	}
-	statement := fmt.Sprintf(`INSERT INTO %s (room_id,message_sent_at,message_id,room_name,room_type,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.roomTimelineTable)
-	return stackErr.Error(r.session.Query(statement, projection.RoomID, projection.MessageSentAt.UTC(), projection.MessageID, projection.RoomName, projection.RoomType, projection.MessageContent, projection.MessageType, nullableProjectionString(projection.ReplyToMessageID), nullableProjectionString(projection.ForwardedFromMessageID), nullableProjectionString(projection.FileName), projection.FileSize, nullableProjectionString(projection.MimeType), nullableProjectionString(projection.ObjectKey), projection.MessageSenderID, nullableProjectionString(projection.MessageSenderName), nullableProjectionString(projection.MessageSenderEmail), string(mentionsJSON), string(reactionsJSON), projection.MentionAll, projection.MentionedAccountIDs, projection.EditedAt, projection.DeletedForEveryoneAt).WithContext(ctx).Exec())
+	contactCardJSON, err := marshalProjectionContactCard(projection.ContactCard)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra timeline contact card failed: %w", err))
+	}
+	redPacketJSON, err := marshalProjectionRedPacket(projection.RedPacket)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra timeline red packet failed: %w", err))
+	}
+	transferJSON, err := marshalProjectionTransfer(projection.Transfer)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra timeline transfer failed: %w", err))
+	}
+	statement := fmt.Sprintf(`INSERT INTO %s (room_id,message_sent_at,message_id,room_name,room_type,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.roomTimelineTable)
+	return stackErr.Error(r.session.Query(statement, projection.RoomID, projection.MessageSentAt.UTC(), projection.MessageID, projection.RoomName, projection.RoomType, projection.MessageContent, projection.MessageType, nullableProjectionString(projection.ReplyToMessageID), nullableProjectionString(projection.ForwardedFromMessageID), nullableProjectionString(projection.FileName), projection.FileSize, nullableProjectionString(projection.MimeType), nullableProjectionString(projection.ObjectKey), projection.MessageSenderID, nullableProjectionString(projection.MessageSenderName), nullableProjectionString(projection.MessageSenderEmail), string(mentionsJSON), string(reactionsJSON), contactCardJSON, redPacketJSON, transferJSON, projection.MentionAll, projection.MentionedAccountIDs, projection.EditedAt, projection.DeletedForEveryoneAt).WithContext(ctx).Exec())
 }
 
 func (r *MessageProjectionRepo) UpsertByIDRow(ctx context.Context, projection *roomprojection.MessageProjection) error {
@@ -77,14 +92,26 @@ func (r *MessageProjectionRepo) UpsertByIDRow(ctx context.Context, projection *r
 	if err != nil {
 		return stackErr.Error(fmt.Errorf("marshal cassandra message-by-id reactions failed: %w", err))
 	}
-	statement := fmt.Sprintf(`INSERT INTO %s (message_id,room_id,room_name,room_type,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.messageByIDTable)
-	return stackErr.Error(r.session.Query(statement, projection.MessageID, projection.RoomID, projection.RoomName, projection.RoomType, projection.MessageContent, projection.MessageType, nullableProjectionString(projection.ReplyToMessageID), nullableProjectionString(projection.ForwardedFromMessageID), nullableProjectionString(projection.FileName), projection.FileSize, nullableProjectionString(projection.MimeType), nullableProjectionString(projection.ObjectKey), projection.MessageSenderID, nullableProjectionString(projection.MessageSenderName), nullableProjectionString(projection.MessageSenderEmail), projection.MessageSentAt.UTC(), string(mentionsJSON), string(reactionsJSON), projection.MentionAll, projection.MentionedAccountIDs, projection.EditedAt, projection.DeletedForEveryoneAt).WithContext(ctx).Exec())
+	contactCardJSON, err := marshalProjectionContactCard(projection.ContactCard)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra message-by-id contact card failed: %w", err))
+	}
+	redPacketJSON, err := marshalProjectionRedPacket(projection.RedPacket)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra message-by-id red packet failed: %w", err))
+	}
+	transferJSON, err := marshalProjectionTransfer(projection.Transfer)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("marshal cassandra message-by-id transfer failed: %w", err))
+	}
+	statement := fmt.Sprintf(`INSERT INTO %s (message_id,room_id,room_name,room_type,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.messageByIDTable)
+	return stackErr.Error(r.session.Query(statement, projection.MessageID, projection.RoomID, projection.RoomName, projection.RoomType, projection.MessageContent, projection.MessageType, nullableProjectionString(projection.ReplyToMessageID), nullableProjectionString(projection.ForwardedFromMessageID), nullableProjectionString(projection.FileName), projection.FileSize, nullableProjectionString(projection.MimeType), nullableProjectionString(projection.ObjectKey), projection.MessageSenderID, nullableProjectionString(projection.MessageSenderName), nullableProjectionString(projection.MessageSenderEmail), projection.MessageSentAt.UTC(), string(mentionsJSON), string(reactionsJSON), contactCardJSON, redPacketJSON, transferJSON, projection.MentionAll, projection.MentionedAccountIDs, projection.EditedAt, projection.DeletedForEveryoneAt).WithContext(ctx).Exec())
 }
 
 func (r *MessageProjectionRepo) GetMessageByIDRow(ctx context.Context, id string) (*MessageProjectionRow, error) {
-	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE message_id = ?`, r.messageByIDTable)
+	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE message_id = ?`, r.messageByIDTable)
 	row := &MessageProjectionRow{}
-	if err := r.session.Query(statement, strings.TrimSpace(id)).WithContext(ctx).Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
+	if err := r.session.Query(statement, strings.TrimSpace(id)).WithContext(ctx).Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.ContactCardJSON, &row.RedPacketJSON, &row.TransferJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
 		if errors.Is(err, gocql.ErrNotFound) {
 			return nil, nil
 		}
@@ -94,9 +121,9 @@ func (r *MessageProjectionRepo) GetMessageByIDRow(ctx context.Context, id string
 }
 
 func (r *MessageProjectionRepo) GetLastMessageRow(ctx context.Context, roomID string) (*MessageProjectionRow, error) {
-	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ? LIMIT 1`, r.roomTimelineTable)
+	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ? LIMIT 1`, r.roomTimelineTable)
 	row := &MessageProjectionRow{}
-	if err := r.session.Query(statement, strings.TrimSpace(roomID)).WithContext(ctx).Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
+	if err := r.session.Query(statement, strings.TrimSpace(roomID)).WithContext(ctx).Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.ContactCardJSON, &row.RedPacketJSON, &row.TransferJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
 		if errors.Is(err, gocql.ErrNotFound) {
 			return nil, nil
 		}
@@ -111,7 +138,7 @@ func (r *MessageProjectionRepo) ListTimelineBatch(ctx context.Context, roomID st
 	if ascending {
 		order = " ORDER BY message_sent_at ASC, message_id ASC"
 	}
-	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ?`, r.roomTimelineTable)
+	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ?`, r.roomTimelineTable)
 	if beforeAt != nil {
 		statement += " AND message_sent_at < ?"
 		args = append(args, beforeAt.UTC())
@@ -122,7 +149,7 @@ func (r *MessageProjectionRepo) ListTimelineBatch(ctx context.Context, roomID st
 }
 
 func (r *MessageProjectionRepo) ListUnreadTimelineBatch(ctx context.Context, roomID string, afterAt *time.Time, limit int) ([]*MessageProjectionRow, error) {
-	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ?`, r.roomTimelineTable)
+	statement := fmt.Sprintf(`SELECT room_id,room_name,room_type,message_id,message_content,message_type,reply_to_message_id,forwarded_from_message_id,file_name,file_size,mime_type,object_key,message_sender_id,message_sender_name,message_sender_email,message_sent_at,mentions_json,reactions_json,contact_card_json,red_packet_json,transfer_json,mention_all,mentioned_account_ids,edited_at,deleted_for_everyone_at FROM %s WHERE room_id = ?`, r.roomTimelineTable)
 	args := []interface{}{roomID}
 	if afterAt != nil {
 		statement += " AND message_sent_at > ?"
@@ -144,7 +171,7 @@ func (r *MessageProjectionRepo) scanMessageRows(ctx context.Context, statement s
 	scanner := iter.Scanner()
 	for scanner.Next() {
 		row := &MessageProjectionRow{}
-		if err := scanner.Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
+		if err := scanner.Scan(&row.RoomID, &row.RoomName, &row.RoomType, &row.MessageID, &row.MessageContent, &row.MessageType, &row.ReplyToMessageID, &row.ForwardedFromMessageID, &row.FileName, &row.FileSize, &row.MimeType, &row.ObjectKey, &row.MessageSenderID, &row.MessageSenderName, &row.MessageSenderEmail, &row.MessageSentAt, &row.MentionsJSON, &row.ReactionsJSON, &row.ContactCardJSON, &row.RedPacketJSON, &row.TransferJSON, &row.MentionAll, &row.MentionedAccountIDs, &row.EditedAt, &row.DeletedForEveryoneAt); err != nil {
 			return nil, stackErr.Error(fmt.Errorf("scan cassandra timeline projection failed: %w", err))
 		}
 		row.MessageSentAt = row.MessageSentAt.UTC()
@@ -160,3 +187,39 @@ func (r *MessageProjectionRepo) scanMessageRows(ctx context.Context, statement s
 	}
 	return rows, nil
 }
+
+func marshalProjectionContactCard(card *roomprojection.ProjectionContactCard) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+	raw := string(data)
+	return &raw, nil
+}
+
+func marshalProjectionRedPacket(card *roomprojection.ProjectionRedPacket) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+	raw := string(data)
+	return &raw, nil
+}
+
+func marshalProjectionTransfer(card *roomprojection.ProjectionTransfer) (*string, error) {
+	if card == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+	raw := string(data)
+	return &raw, nil
+}
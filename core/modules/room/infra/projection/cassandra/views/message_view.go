@@ -17,6 +17,9 @@ type MessageView struct {
 	FileSize               int64
 	MimeType               string
 	ObjectKey              string
+	ContactCard            *MessageContactCardView
+	RedPacket              *MessageRedPacketView
+	Transfer               *MessageTransferView
 	EditedAt               *time.Time
 	DeletedForEveryoneAt   *time.Time
 	CreatedAt              time.Time
@@ -27,3 +30,27 @@ type MessageReactionView struct {
 	Emoji     string
 	ReactedAt time.Time
 }
+
+type MessageContactCardView struct {
+	AccountID       string
+	DisplayName     string
+	Username        string
+	AvatarObjectKey string
+	Token           string
+}
+
+type MessageRedPacketView struct {
+	PacketID    string
+	Currency    string
+	TotalAmount int64
+	Count       int
+	SplitMode   string
+}
+
+type MessageTransferView struct {
+	TransferID  string
+	RecipientID string
+	Currency    string
+	Amount      int64
+	Status      string
+}
@@ -0,0 +1,47 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type declineTransferHandler struct {
+	declineTransfer cqrs.Dispatcher[*in.DeclineTransferRequest, *out.TransferResponse]
+}
+
+func NewDeclineTransferHandler(
+	declineTransfer cqrs.Dispatcher[*in.DeclineTransferRequest, *out.TransferResponse],
+) *declineTransferHandler {
+	return &declineTransferHandler{
+		declineTransfer: declineTransfer,
+	}
+}
+
+func (h *declineTransferHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.DeclineTransferRequest
+	request.TransferID = c.Param("transfer_id")
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.declineTransfer.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("DeclineTransfer failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	return result, nil
+}
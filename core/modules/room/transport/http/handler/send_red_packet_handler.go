@@ -0,0 +1,53 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type sendRedPacketHandler struct {
+	sendRedPacket cqrs.Dispatcher[*in.SendRedPacketRequest, *out.RedPacketResponse]
+}
+
+func NewSendRedPacketHandler(
+	sendRedPacket cqrs.Dispatcher[*in.SendRedPacketRequest, *out.RedPacketResponse],
+) *sendRedPacketHandler {
+	return &sendRedPacketHandler{
+		sendRedPacket: sendRedPacket,
+	}
+}
+
+func (h *sendRedPacketHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.SendRedPacketRequest
+	request.RoomID = c.Param("room_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.sendRedPacket.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("SendRedPacket failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	c.JSON(201, result)
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// CODE_GENERATOR - do not edit: handler
+package handler
+
+import (
+	"net/http"
+
+	"wechat-clone/core/modules/room/application/dto/in"
+	"wechat-clone/core/modules/room/application/dto/out"
+	"wechat-clone/core/shared/pkg/cqrs"
+	"wechat-clone/core/shared/pkg/logging"
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type sendTransferHandler struct {
+	sendTransfer cqrs.Dispatcher[*in.SendTransferRequest, *out.TransferResponse]
+}
+
+func NewSendTransferHandler(
+	sendTransfer cqrs.Dispatcher[*in.SendTransferRequest, *out.TransferResponse],
+) *sendTransferHandler {
+	return &sendTransferHandler{
+		sendTransfer: sendTransfer,
+	}
+}
+
+func (h *sendTransferHandler) Handle(c *gin.Context) (interface{}, error) {
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx)
+	var request in.SendTransferRequest
+	request.RoomID = c.Param("room_id")
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Errorw("Unmarshal request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	if err := request.Validate(); err != nil {
+		logger.Errorw("Validate request failed", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, stackErr.Error(err)
+	}
+
+	result, err := h.sendTransfer.Dispatch(ctx, &request)
+	if err != nil {
+		logger.Errorw("SendTransfer failed", zap.Error(err))
+		return nil, stackErr.Error(err)
+	}
+	c.JSON(201, result)
+	return nil, nil
+}
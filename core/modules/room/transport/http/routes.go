@@ -34,6 +34,13 @@ func RegisterPrivateRoutes(
 	removeChatMember cqrs.Dispatcher[*in.RemoveChatMemberRequest, *out.ChatRoomCommandResponse],
 	pinChatMessage cqrs.Dispatcher[*in.PinChatMessageRequest, *out.ChatRoomCommandResponse],
 	getChatPresence cqrs.Dispatcher[*in.GetChatPresenceRequest, *out.ChatPresenceResponse],
+	sendRedPacket cqrs.Dispatcher[*in.SendRedPacketRequest, *out.RedPacketResponse],
+	claimRedPacket cqrs.Dispatcher[*in.ClaimRedPacketRequest, *out.ClaimRedPacketResponse],
+	getRedPacket cqrs.Dispatcher[*in.GetRedPacketRequest, *out.RedPacketResponse],
+	sendTransfer cqrs.Dispatcher[*in.SendTransferRequest, *out.TransferResponse],
+	acceptTransfer cqrs.Dispatcher[*in.AcceptTransferRequest, *out.TransferResponse],
+	declineTransfer cqrs.Dispatcher[*in.DeclineTransferRequest, *out.TransferResponse],
+	getTransfer cqrs.Dispatcher[*in.GetTransferRequest, *out.TransferResponse],
 ) {
 	routes.POST("/chat/direct", httpx.Wrap(handler.NewCreateDirectConversationHandler(createDirectConversation)))
 	routes.POST("/chat/groups", httpx.Wrap(handler.NewCreateGroupChatHandler(createGroupChat)))
@@ -55,4 +62,11 @@ func RegisterPrivateRoutes(
 	routes.DELETE("/chat/rooms/:room_id/members/:account_id", httpx.Wrap(handler.NewRemoveChatMemberHandler(removeChatMember)))
 	routes.POST("/chat/rooms/:room_id/pin", httpx.Wrap(handler.NewPinChatMessageHandler(pinChatMessage)))
 	routes.GET("/chat/presence/:account_id", httpx.Wrap(handler.NewGetChatPresenceHandler(getChatPresence)))
+	routes.POST("/chat/rooms/:room_id/red-packets", httpx.Wrap(handler.NewSendRedPacketHandler(sendRedPacket)))
+	routes.POST("/chat/red-packets/:red_packet_id/claim", httpx.Wrap(handler.NewClaimRedPacketHandler(claimRedPacket)))
+	routes.GET("/chat/red-packets/:red_packet_id", httpx.Wrap(handler.NewGetRedPacketHandler(getRedPacket)))
+	routes.POST("/chat/rooms/:room_id/transfers", httpx.Wrap(handler.NewSendTransferHandler(sendTransfer)))
+	routes.POST("/chat/transfers/:transfer_id/accept", httpx.Wrap(handler.NewAcceptTransferHandler(acceptTransfer)))
+	routes.POST("/chat/transfers/:transfer_id/decline", httpx.Wrap(handler.NewDeclineTransferHandler(declineTransfer)))
+	routes.GET("/chat/transfers/:transfer_id", httpx.Wrap(handler.NewGetTransferHandler(getTransfer)))
 }
@@ -35,6 +35,13 @@ type roomHTTPServer struct {
 	removeChatMember              cqrs.Dispatcher[*in.RemoveChatMemberRequest, *out.ChatRoomCommandResponse]
 	pinChatMessage                cqrs.Dispatcher[*in.PinChatMessageRequest, *out.ChatRoomCommandResponse]
 	getChatPresence               cqrs.Dispatcher[*in.GetChatPresenceRequest, *out.ChatPresenceResponse]
+	sendRedPacket                 cqrs.Dispatcher[*in.SendRedPacketRequest, *out.RedPacketResponse]
+	claimRedPacket                cqrs.Dispatcher[*in.ClaimRedPacketRequest, *out.ClaimRedPacketResponse]
+	getRedPacket                  cqrs.Dispatcher[*in.GetRedPacketRequest, *out.RedPacketResponse]
+	sendTransfer                  cqrs.Dispatcher[*in.SendTransferRequest, *out.TransferResponse]
+	acceptTransfer                cqrs.Dispatcher[*in.AcceptTransferRequest, *out.TransferResponse]
+	declineTransfer               cqrs.Dispatcher[*in.DeclineTransferRequest, *out.TransferResponse]
+	getTransfer                   cqrs.Dispatcher[*in.GetTransferRequest, *out.TransferResponse]
 	socketHandler                 gin.HandlerFunc
 	socketStopper                 func(context.Context)
 }
@@ -60,6 +67,13 @@ func NewHTTPServer(
 	removeChatMember cqrs.Dispatcher[*in.RemoveChatMemberRequest, *out.ChatRoomCommandResponse],
 	pinChatMessage cqrs.Dispatcher[*in.PinChatMessageRequest, *out.ChatRoomCommandResponse],
 	getChatPresence cqrs.Dispatcher[*in.GetChatPresenceRequest, *out.ChatPresenceResponse],
+	sendRedPacket cqrs.Dispatcher[*in.SendRedPacketRequest, *out.RedPacketResponse],
+	claimRedPacket cqrs.Dispatcher[*in.ClaimRedPacketRequest, *out.ClaimRedPacketResponse],
+	getRedPacket cqrs.Dispatcher[*in.GetRedPacketRequest, *out.RedPacketResponse],
+	sendTransfer cqrs.Dispatcher[*in.SendTransferRequest, *out.TransferResponse],
+	acceptTransfer cqrs.Dispatcher[*in.AcceptTransferRequest, *out.TransferResponse],
+	declineTransfer cqrs.Dispatcher[*in.DeclineTransferRequest, *out.TransferResponse],
+	getTransfer cqrs.Dispatcher[*in.GetTransferRequest, *out.TransferResponse],
 	socketHandler gin.HandlerFunc,
 	socketStopper func(context.Context),
 ) (infrahttp.HTTPServer, error) {
@@ -84,6 +98,13 @@ func NewHTTPServer(
 		removeChatMember:              removeChatMember,
 		pinChatMessage:                pinChatMessage,
 		getChatPresence:               getChatPresence,
+		sendRedPacket:                 sendRedPacket,
+		claimRedPacket:                claimRedPacket,
+		getRedPacket:                  getRedPacket,
+		sendTransfer:                  sendTransfer,
+		acceptTransfer:                acceptTransfer,
+		declineTransfer:               declineTransfer,
+		getTransfer:                   getTransfer,
 		socketHandler:                 socketHandler,
 		socketStopper:                 socketStopper,
 	}, nil
@@ -94,7 +115,7 @@ func (s *roomHTTPServer) RegisterPublicRoutes(routes *gin.RouterGroup) {
 }
 
 func (s *roomHTTPServer) RegisterPrivateRoutes(routes *gin.RouterGroup) {
-	roomhttp.RegisterPrivateRoutes(routes, s.createDirectConversation, s.createGroupChat, s.updateGroupChat, s.listChatConversations, s.getChatConversation, s.getChatConversationMetadata, s.listChatMessages, s.searchChatMentions, s.createChatMessagePresignedURL, s.getChatMessageMedia, s.sendChatMessage, s.toggleChatMessageReaction, s.editChatMessage, s.deleteChatMessage, s.forwardChatMessage, s.markChatMessageStatus, s.addChatMember, s.removeChatMember, s.pinChatMessage, s.getChatPresence)
+	roomhttp.RegisterPrivateRoutes(routes, s.createDirectConversation, s.createGroupChat, s.updateGroupChat, s.listChatConversations, s.getChatConversation, s.getChatConversationMetadata, s.listChatMessages, s.searchChatMentions, s.createChatMessagePresignedURL, s.getChatMessageMedia, s.sendChatMessage, s.toggleChatMessageReaction, s.editChatMessage, s.deleteChatMessage, s.forwardChatMessage, s.markChatMessageStatus, s.addChatMember, s.removeChatMember, s.pinChatMessage, s.getChatPresence, s.sendRedPacket, s.claimRedPacket, s.getRedPacket, s.sendTransfer, s.acceptTransfer, s.declineTransfer, s.getTransfer)
 }
 
 func (s *roomHTTPServer) RegisterSocketRoutes(routes *gin.RouterGroup) {
@@ -43,6 +43,7 @@ func (s *roomSubscription) Close() error {
 type Hub struct {
 	redisClient *redis.Client
 	videoCall   roomservice.VideoCallService
+	blocks      roomservice.BlockQueryService
 
 	mu            sync.RWMutex
 	clients       map[string]IClient
@@ -54,10 +55,11 @@ type Hub struct {
 	isClosed bool
 }
 
-func NewHub(ctx context.Context, appCtx *appCtx.AppContext, videoCall roomservice.VideoCallService) *Hub {
+func NewHub(ctx context.Context, appCtx *appCtx.AppContext, videoCall roomservice.VideoCallService, blocks roomservice.BlockQueryService) *Hub {
 	return &Hub{
 		redisClient:   appCtx.GetRedisClient(),
 		videoCall:     videoCall,
+		blocks:        blocks,
 		clients:       make(map[string]IClient),
 		rooms:         make(map[string]IRoom),
 		clientRooms:   make(map[string]map[string]struct{}),
@@ -615,7 +617,62 @@ func (h *Hub) broadcastLocal(ctx context.Context, roomID string, payload []byte)
 	if !ok {
 		return
 	}
-	room.Broadcast(ctx, payload)
+
+	excluded, deliver := h.blockedRecipients(ctx, room, payload)
+	if !deliver {
+		return
+	}
+	if len(excluded) == 0 {
+		room.Broadcast(ctx, payload)
+		return
+	}
+	room.BroadcastExcept(ctx, payload, excluded)
+}
+
+// blockedRecipients returns the local users that must not receive a typing or
+// presence signal because its sender has blocked them, plus the friends the
+// sender restricted for presence. When the lookup fails the signal is dropped
+// rather than leaked; both are ephemeral.
+func (h *Hub) blockedRecipients(ctx context.Context, room IRoom, payload []byte) (map[string]struct{}, bool) {
+	if h.blocks == nil {
+		return nil, true
+	}
+
+	var header struct {
+		Action   string `json:"action"`
+		SenderID string `json:"sender_id"`
+	}
+	if err := json.Unmarshal(payload, &header); err != nil {
+		return nil, true
+	}
+	if header.Action != ActionTyping && header.Action != ActionPresence {
+		return nil, true
+	}
+	senderID := strings.TrimSpace(header.SenderID)
+	if senderID == "" {
+		return nil, true
+	}
+
+	blockedIDs, err := h.blocks.ListBlockedBy(ctx, senderID, room.UserIDs())
+	if err != nil {
+		logging.FromContext(ctx).Warnw("failed to resolve blocked recipients", "sender_id", senderID, zap.Error(err))
+		return nil, false
+	}
+
+	if header.Action == ActionPresence {
+		restrictedIDs, err := h.blocks.ListRestrictedBy(ctx, senderID, room.UserIDs())
+		if err != nil {
+			logging.FromContext(ctx).Warnw("failed to resolve restricted recipients", "sender_id", senderID, zap.Error(err))
+			return nil, false
+		}
+		blockedIDs = append(blockedIDs, restrictedIDs...)
+	}
+
+	excluded := make(map[string]struct{}, len(blockedIDs))
+	for _, blockedID := range blockedIDs {
+		excluded[blockedID] = struct{}{}
+	}
+	return excluded, true
 }
 
 func (h *Hub) detachSubscription(_ context.Context, roomID string) *roomSubscription {
@@ -82,3 +82,24 @@ func TestHubRoomsForUserDeduplicatesRoomIDsAcrossClients(t *testing.T) {
 		t.Fatalf("roomsForUser() len = %d, want 2", len(roomIDs))
 	}
 }
+
+func TestRoomBroadcastExceptSkipsExcludedUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blocker := NewMockIClient(ctrl)
+	blocker.EXPECT().GetID().Return("client-1").AnyTimes()
+	blocker.EXPECT().GetUserID().Return("user-1").AnyTimes()
+	blocker.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1)
+
+	blocked := NewMockIClient(ctrl)
+	blocked.EXPECT().GetID().Return("client-2").AnyTimes()
+	blocked.EXPECT().GetUserID().Return("user-2").AnyTimes()
+	blocked.EXPECT().Send(gomock.Any(), gomock.Any()).Times(0)
+
+	room := NewRoom(context.Background(), "room-1")
+	room.AddClient(context.Background(), blocker)
+	room.AddClient(context.Background(), blocked)
+
+	room.BroadcastExcept(context.Background(), []byte(`{"action":"TYPING"}`), map[string]struct{}{"user-2": {}})
+}
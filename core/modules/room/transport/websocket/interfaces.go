@@ -18,6 +18,8 @@ type IRoom interface {
 	AddClient(ctx context.Context, client IClient)
 	RemoveClient(ctx context.Context, client IClient)
 	Broadcast(ctx context.Context, message []byte)
+	BroadcastExcept(ctx context.Context, message []byte, excludedUserIDs map[string]struct{})
+	UserIDs() []string
 	IsEmpty() bool
 	ClientCount() int
 }
@@ -164,6 +164,18 @@ func (mr *MockIRoomMockRecorder) Broadcast(ctx, message any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockIRoom)(nil).Broadcast), ctx, message)
 }
 
+// BroadcastExcept mocks base method.
+func (m *MockIRoom) BroadcastExcept(ctx context.Context, message []byte, excludedUserIDs map[string]struct{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BroadcastExcept", ctx, message, excludedUserIDs)
+}
+
+// BroadcastExcept indicates an expected call of BroadcastExcept.
+func (mr *MockIRoomMockRecorder) BroadcastExcept(ctx, message, excludedUserIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastExcept", reflect.TypeOf((*MockIRoom)(nil).BroadcastExcept), ctx, message, excludedUserIDs)
+}
+
 // ClientCount mocks base method.
 func (m *MockIRoom) ClientCount() int {
 	m.ctrl.T.Helper()
@@ -218,6 +230,20 @@ func (mr *MockIRoomMockRecorder) RemoveClient(ctx, client any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClient", reflect.TypeOf((*MockIRoom)(nil).RemoveClient), ctx, client)
 }
 
+// UserIDs mocks base method.
+func (m *MockIRoom) UserIDs() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserIDs")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// UserIDs indicates an expected call of UserIDs.
+func (mr *MockIRoomMockRecorder) UserIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserIDs", reflect.TypeOf((*MockIRoom)(nil).UserIDs))
+}
+
 // MockIHub is a mock of IHub interface.
 type MockIHub struct {
 	ctrl     *gomock.Controller
@@ -49,6 +49,41 @@ func (r *Room) Broadcast(ctx context.Context, message []byte) {
 	}
 }
 
+// BroadcastExcept delivers message to every local client whose user is not in
+// excludedUserIDs.
+func (r *Room) BroadcastExcept(ctx context.Context, message []byte, excludedUserIDs map[string]struct{}) {
+	r.mu.RLock()
+	localClients := make([]IClient, 0, len(r.clients))
+	for _, client := range r.clients {
+		if _, excluded := excludedUserIDs[client.GetUserID()]; excluded {
+			continue
+		}
+		localClients = append(localClients, client)
+	}
+	r.mu.RUnlock()
+
+	for _, client := range localClients {
+		client.Send(ctx, message)
+	}
+}
+
+func (r *Room) UserIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]struct{}, len(r.clients))
+	userIDs := make([]string, 0, len(r.clients))
+	for _, client := range r.clients {
+		userID := client.GetUserID()
+		if _, exists := seen[userID]; exists || userID == "" {
+			continue
+		}
+		seen[userID] = struct{}{}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
+
 func (r *Room) IsEmpty() bool {
 	return r.ClientCount() == 0
 }
@@ -1,20 +1,29 @@
 package config
 
+import (
+	"strings"
+
+	"wechat-clone/core/shared/finance"
+)
+
 type Config struct {
-	ServerConfig        ServerConfig
-	RedisConfig         RedisConfig
-	DBConfig            DBConfig
-	AuthConfig          AuthConfig
-	KafkaConfig         KafkaConfig
-	SecurityConfig      SecurityConfig
-	WebPushConfig       WebPushConfig
-	ConsulConfig        ConsulConfig
-	LedgerConfig        LedgerConfig
-	StorageConfig       StorageConfig
-	CassandraConfig     CassandraConfig
-	ElasticsearchConfig ElasticsearchConfig
-	SMTPConfig          SMTPConfig
-	GrpcConfig          GrpcConfig
+	ServerConfig          ServerConfig
+	RedisConfig           RedisConfig
+	DBConfig              DBConfig
+	AuthConfig            AuthConfig
+	KafkaConfig           KafkaConfig
+	SecurityConfig        SecurityConfig
+	WebPushConfig         WebPushConfig
+	ConsulConfig          ConsulConfig
+	LedgerConfig          LedgerConfig
+	StorageConfig         StorageConfig
+	CassandraConfig       CassandraConfig
+	ElasticsearchConfig   ElasticsearchConfig
+	SMTPConfig            SMTPConfig
+	GrpcConfig            GrpcConfig
+	RelationshipConfig    RelationshipConfig
+	RoomConfig            RoomConfig
+	ForeignExchangeConfig ForeignExchangeConfig
 }
 
 type ServerConfig struct {
@@ -51,6 +60,75 @@ type AuthConfig struct {
 	RefreshTokenTTLSeconds int64  `env:"AUTH_REFRESH_TOKEN_TTL_SECONDS"`
 	VerifyEmailURL         string `env:"AUTH_VERIFY_EMAIL_URL"`
 	GoogleConfig           GoogleConfig
+	LoginRisk              LoginRiskConfig
+	Username               UsernameConfig
+	ContactCard            ContactCardConfig
+}
+
+type ContactCardConfig struct {
+	Secret  string `env:"AUTH_CONTACT_CARD_SECRET"`
+	LinkURL string `env:"AUTH_CONTACT_CARD_LINK_URL"`
+	QRSize  int    `env:"AUTH_CONTACT_CARD_QR_SIZE,default=512"`
+}
+
+type UsernameConfig struct {
+	ChangeCooldownSeconds int    `env:"AUTH_USERNAME_CHANGE_COOLDOWN_SECONDS,default=2592000"`
+	ReservationSeconds    int    `env:"AUTH_USERNAME_RESERVATION_SECONDS,default=1209600"`
+	ReservedWords         string `env:"AUTH_USERNAME_RESERVED_WORDS"`
+}
+
+type LoginRiskConfig struct {
+	GeoIPDatabasePath           string  `env:"AUTH_LOGIN_RISK_GEOIP_DATABASE_PATH"`
+	RevokeSessionURL            string  `env:"AUTH_LOGIN_RISK_REVOKE_SESSION_URL"`
+	StepUpScore                 int     `env:"AUTH_LOGIN_RISK_STEP_UP_SCORE,default=40"`
+	LockScore                   int     `env:"AUTH_LOGIN_RISK_LOCK_SCORE,default=90"`
+	LockDurationSeconds         int     `env:"AUTH_LOGIN_RISK_LOCK_DURATION_SECONDS,default=900"`
+	FailedPasswordThreshold     int     `env:"AUTH_LOGIN_RISK_FAILED_PASSWORD_THRESHOLD,default=5"`
+	FailedPasswordLockThreshold int     `env:"AUTH_LOGIN_RISK_FAILED_PASSWORD_LOCK_THRESHOLD,default=10"`
+	FailedPasswordWindowSeconds int     `env:"AUTH_LOGIN_RISK_FAILED_PASSWORD_WINDOW_SECONDS,default=900"`
+	MaxTravelSpeedKmh           float64 `env:"AUTH_LOGIN_RISK_MAX_TRAVEL_SPEED_KMH,default=900"`
+}
+
+type RelationshipConfig struct {
+	FriendRequest FriendRequestConfig
+	GraphImport   GraphImportConfig
+	Suggestion    SuggestionConfig
+}
+
+type FriendRequestConfig struct {
+	TTLSeconds                 int  `env:"RELATIONSHIP_FRIEND_REQUEST_TTL_SECONDS,default=1209600"`
+	ExpirySweepIntervalSeconds int  `env:"RELATIONSHIP_FRIEND_REQUEST_EXPIRY_SWEEP_INTERVAL_SECONDS,default=300"`
+	ExpirySweepBatchSize       int  `env:"RELATIONSHIP_FRIEND_REQUEST_EXPIRY_SWEEP_BATCH_SIZE,default=200"`
+	NotifyRequesterOnExpiry    bool `env:"RELATIONSHIP_FRIEND_REQUEST_NOTIFY_REQUESTER_ON_EXPIRY"`
+	ResendLimit                int  `env:"RELATIONSHIP_FRIEND_REQUEST_RESEND_LIMIT,default=3"`
+	ResendWindowSeconds        int  `env:"RELATIONSHIP_FRIEND_REQUEST_RESEND_WINDOW_SECONDS,default=86400"`
+}
+
+type GraphImportConfig struct {
+	ProcessIntervalSeconds int `env:"RELATIONSHIP_GRAPH_IMPORT_PROCESS_INTERVAL_SECONDS,default=30"`
+	ProcessBatchSize       int `env:"RELATIONSHIP_GRAPH_IMPORT_PROCESS_BATCH_SIZE,default=500"`
+}
+
+type SuggestionConfig struct {
+	ViewExpiryIntervalSeconds int `env:"RELATIONSHIP_SUGGESTION_VIEW_EXPIRY_INTERVAL_SECONDS,default=3600"`
+	ViewExpiryBatchSize       int `env:"RELATIONSHIP_SUGGESTION_VIEW_EXPIRY_BATCH_SIZE,default=500"`
+}
+
+type RoomConfig struct {
+	RedPacket RedPacketConfig
+	Transfer  TransferConfig
+}
+
+type RedPacketConfig struct {
+	TTLSeconds                 int `env:"ROOM_RED_PACKET_TTL_SECONDS,default=86400"`
+	RefundSweepIntervalSeconds int `env:"ROOM_RED_PACKET_REFUND_SWEEP_INTERVAL_SECONDS,default=60"`
+	RefundSweepBatchSize       int `env:"ROOM_RED_PACKET_REFUND_SWEEP_BATCH_SIZE,default=100"`
+}
+
+type TransferConfig struct {
+	TTLSeconds           int `env:"ROOM_TRANSFER_TTL_SECONDS,default=86400"`
+	SweepIntervalSeconds int `env:"ROOM_TRANSFER_SWEEP_INTERVAL_SECONDS,default=60"`
+	SweepBatchSize       int `env:"ROOM_TRANSFER_SWEEP_BATCH_SIZE,default=100"`
 }
 
 type GoogleConfig struct {
@@ -88,11 +166,12 @@ type KafkaPaymentConsumer struct {
 }
 
 type KafkaRoomConsumer struct {
-	RoomMessagingGroup  string `env:"KAFKA_ROOM_CONSUMER_MESSAGING_GROUP"`
-	RoomProjectionGroup string `env:"KAFKA_ROOM_CONSUMER_PROJECTION_GROUP"`
-	AccountTopic        string `env:"KAFKA_CONSUMER_ACCOUNT_TOPIC"`
-	RoomOutboxTopic     string `env:"KAFKA_CONSUMER_ROOM_OUTBOX_TOPIC"`
-	LedgerOutboxTopic   string `env:"KAFKA_CONSUMER_LEDGER_OUTBOX_TOPIC"`
+	RoomMessagingGroup      string `env:"KAFKA_ROOM_CONSUMER_MESSAGING_GROUP"`
+	RoomProjectionGroup     string `env:"KAFKA_ROOM_CONSUMER_PROJECTION_GROUP"`
+	AccountTopic            string `env:"KAFKA_CONSUMER_ACCOUNT_TOPIC"`
+	RoomOutboxTopic         string `env:"KAFKA_CONSUMER_ROOM_OUTBOX_TOPIC"`
+	LedgerOutboxTopic       string `env:"KAFKA_CONSUMER_LEDGER_OUTBOX_TOPIC"`
+	RelationshipOutboxTopic string `env:"KAFKA_CONSUMER_RELATIONSHIP_OUTBOX_TOPIC"`
 }
 
 type KafkaLedgerConsumer struct {
@@ -105,13 +184,16 @@ type KafkaLedgerConsumer struct {
 
 type KafkaRelationshipConsumer struct {
 	RelationshipProjectionGroup string `env:"KAFKA_RELATIONSHIP_CONSUMER_PROJECTION_GROUP"`
+	RelationshipSuggestionGroup string `env:"KAFKA_RELATIONSHIP_CONSUMER_SUGGESTION_GROUP,default=relationship-suggestion"`
 	RelationshipOutboxTopic     string `env:"KAFKA_CONSUMER_RELATIONSHIP_OUTBOX_TOPIC"`
+	RoomOutboxTopic             string `env:"KAFKA_CONSUMER_ROOM_OUTBOX_TOPIC"`
 	AccountTopic                string `env:"KAFKA_CONSUMER_ACCOUNT_TOPIC"`
 }
 
 type KafkaAccountConsumer struct {
-	AccountProjectionGroup string `env:"KAFKA_ACCOUNT_CONSUMER_PROJECTION_GROUP,default=account-projection"`
-	AccountOutboxTopic     string `env:"KAFKA_CONSUMER_ACCOUNT_TOPIC"`
+	AccountProjectionGroup  string `env:"KAFKA_ACCOUNT_CONSUMER_PROJECTION_GROUP,default=account-projection"`
+	AccountOutboxTopic      string `env:"KAFKA_CONSUMER_ACCOUNT_TOPIC"`
+	RelationshipOutboxTopic string `env:"KAFKA_CONSUMER_RELATIONSHIP_OUTBOX_TOPIC"`
 }
 
 type SecurityConfig struct {
@@ -132,23 +214,127 @@ type ConsulConfig struct {
 }
 
 type LedgerConfig struct {
-	MockWebhookSecret string `env:"LEDGER_MOCK_WEBHOOK_SECRET,default=mock-secret"`
-	Stripe            LedgerStripeConfig
+	MockWebhookSecret        string `env:"LEDGER_MOCK_WEBHOOK_SECRET,default=mock-secret"`
+	MockSettlementReportFile string `env:"LEDGER_MOCK_SETTLEMENT_REPORT_FILE"`
+	Stripe                   LedgerStripeConfig
+	RedirectCheckout         LedgerRedirectCheckoutConfig
+	BankTransfer             LedgerBankTransferConfig
+	Reconciliation           LedgerReconciliationConfig
+	Limits                   LedgerLimitsConfig
+	Fees                     LedgerFeesConfig
 }
 
+// LedgerStripeConfig configures the Stripe provider. FeeRateBPS,
+// FeeFlatAmount and FeeAccountID are deprecated in favour of the fee
+// schedule and are only read through LedgerConfig.FeeRules and
+// LedgerConfig.FeeRevenueAccountID.
 type LedgerStripeConfig struct {
 	PublicKey                        string `env:"LEDGER_STRIPE_PUBLIC_KEY"`
 	SecretKey                        string `env:"LEDGER_STRIPE_SECRET_KEY"`
+	APIBaseURL                       string `env:"LEDGER_STRIPE_API_BASE_URL"`
 	WebhookSecret                    string `env:"LEDGER_STRIPE_WEBHOOK_SECRET"`
 	SuccessURL                       string `env:"LEDGER_STRIPE_SUCCESS_URL"`
 	CancelURL                        string `env:"LEDGER_STRIPE_CANCEL_URL"`
 	FeeRateBPS                       int64  `env:"LEDGER_STRIPE_FEE_RATE_BPS,default=0"`
 	FeeFlatAmount                    int64  `env:"LEDGER_STRIPE_FEE_FLAT_AMOUNT,default=0"`
-	FeeAccountID                     string `env:"LEDGER_STRIPE_FEE_ACCOUNT_ID,default=ledger:fee:provider:stripe"`
+	FeeAccountID                     string `env:"LEDGER_STRIPE_FEE_ACCOUNT_ID"`
 	WithdrawalScheduleIntervalSecond int    `env:"LEDGER_STRIPE_WITHDRAWAL_POLL_INTERVAL_SECONDS,default=5"`
 	WithdrawalBatchSize              int    `env:"LEDGER_STRIPE_WITHDRAWAL_BATCH_SIZE,default=20"`
 }
 
+type LedgerRedirectCheckoutConfig struct {
+	APIBaseURL    string `env:"LEDGER_REDIRECT_CHECKOUT_API_BASE_URL"`
+	ClientID      string `env:"LEDGER_REDIRECT_CHECKOUT_CLIENT_ID"`
+	ClientSecret  string `env:"LEDGER_REDIRECT_CHECKOUT_CLIENT_SECRET"`
+	WebhookSecret string `env:"LEDGER_REDIRECT_CHECKOUT_WEBHOOK_SECRET"`
+	ReturnURL     string `env:"LEDGER_REDIRECT_CHECKOUT_RETURN_URL"`
+	CancelURL     string `env:"LEDGER_REDIRECT_CHECKOUT_CANCEL_URL"`
+}
+
+type LedgerBankTransferConfig struct {
+	APIBaseURL        string `env:"LEDGER_BANK_TRANSFER_API_BASE_URL"`
+	APIKey            string `env:"LEDGER_BANK_TRANSFER_API_KEY"`
+	WebhookSecret     string `env:"LEDGER_BANK_TRANSFER_WEBHOOK_SECRET"`
+	BankCode          string `env:"LEDGER_BANK_TRANSFER_BANK_CODE"`
+	AccountName       string `env:"LEDGER_BANK_TRANSFER_ACCOUNT_NAME"`
+	AccountTTLSeconds int    `env:"LEDGER_BANK_TRANSFER_ACCOUNT_TTL_SECONDS,default=86400"`
+}
+
+type LedgerReconciliationConfig struct {
+	IntervalSecond    int `env:"LEDGER_RECONCILIATION_INTERVAL_SECONDS,default=3600"`
+	LookbackSecond    int `env:"LEDGER_RECONCILIATION_LOOKBACK_SECONDS,default=86400"`
+	SettleDelaySecond int `env:"LEDGER_RECONCILIATION_SETTLE_DELAY_SECONDS,default=900"`
+}
+
+// LedgerLimitsConfig sets the caps of each account tier, written as
+// "transfer/VND:5000000/20000000/100000000,withdrawal/USD:0/500000/2000000"
+// (single, daily and monthly caps in minor units, 0 for no cap), and the
+// velocity rules shared by all tiers, written as "transfer:10/5m".
+type LedgerLimitsConfig struct {
+	Unverified string `env:"LEDGER_LIMITS_UNVERIFIED"`
+	Verified   string `env:"LEDGER_LIMITS_VERIFIED"`
+	Business   string `env:"LEDGER_LIMITS_BUSINESS"`
+	Velocity   string `env:"LEDGER_LIMITS_VELOCITY"`
+}
+
+// LedgerFeesConfig sets the fee of each operation, written as
+// "top_up/stripe/USD/*:*@290+30:50/0" (operation/provider/currency/tier,
+// then "<up_to>@<rate_bps>+<flat>" brackets joined by "|" and an optional
+// min/max fee), zero-fee promotions written as
+// "top_up/*/VND/*:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z", and the
+// account every fee is credited to.
+type LedgerFeesConfig struct {
+	Schedule         string `env:"LEDGER_FEES_SCHEDULE"`
+	Promotions       string `env:"LEDGER_FEES_PROMOTIONS"`
+	RevenueAccountID string `env:"LEDGER_FEES_REVENUE_ACCOUNT_ID"`
+}
+
+// defaultFeeRevenueAccountID is the account fees were credited to before the
+// fee schedule, kept so fee balances do not split across two accounts.
+const defaultFeeRevenueAccountID = "ledger:fee:provider:stripe"
+
+// FeeRevenueAccountID is LEDGER_FEES_REVENUE_ACCOUNT_ID, falling back to the
+// deprecated LEDGER_STRIPE_FEE_ACCOUNT_ID and then to the account fees were
+// always credited to.
+func (c LedgerConfig) FeeRevenueAccountID() string {
+	if accountID := strings.TrimSpace(c.Fees.RevenueAccountID); accountID != "" {
+		return accountID
+	}
+	if accountID := strings.TrimSpace(c.Stripe.FeeAccountID); accountID != "" {
+		return accountID
+	}
+	return defaultFeeRevenueAccountID
+}
+
+// FeeRules is LEDGER_FEES_SCHEDULE plus the deprecated Stripe fee, which
+// used to price every top-up, withdrawal and transfer, as catch-all rules
+// for those operations. A catch-all the schedule already has wins.
+func (c LedgerConfig) FeeRules() string {
+	return finance.WithLegacyFeeRules(
+		c.Fees.Schedule,
+		c.Stripe.FeeRateBPS,
+		c.Stripe.FeeFlatAmount,
+		finance.FeeOperationTopUp,
+		finance.FeeOperationWithdrawal,
+		finance.FeeOperationTransfer,
+	)
+}
+
+type ForeignExchangeConfig struct {
+	RateProvider           string `env:"FX_RATE_PROVIDER,default=static"`
+	RatesFile              string `env:"FX_RATES_FILE"`
+	DefaultSpreadBPS       int64  `env:"FX_DEFAULT_SPREAD_BPS,default=50"`
+	PairSpreadsBPS         string `env:"FX_PAIR_SPREADS_BPS"`
+	QuoteTTLSeconds        int    `env:"FX_QUOTE_TTL_SECONDS,default=30"`
+	HouseAccountID         string `env:"FX_HOUSE_ACCOUNT_ID,default=ledger:fx:house"`
+	SpreadRevenueAccountID string `env:"FX_SPREAD_REVENUE_ACCOUNT_ID,default=ledger:fx:spread-revenue"`
+	// Conversions whose ledger posting failed stay pending; the sweeper
+	// resumes those untouched for PendingSweepGraceSeconds.
+	PendingSweepIntervalSeconds int `env:"FX_PENDING_SWEEP_INTERVAL_SECONDS,default=60"`
+	PendingSweepGraceSeconds    int `env:"FX_PENDING_SWEEP_GRACE_SECONDS,default=60"`
+	PendingSweepBatchSize       int `env:"FX_PENDING_SWEEP_BATCH_SIZE,default=100"`
+}
+
 type StorageConfig struct {
 	MinIOEndpoint      string `env:"MINIO_ENDPOINT"`
 	MinIOPublicBaseURL string `env:"MINIO_PUBLIC_BASE_URL"`
@@ -179,7 +365,7 @@ type ElasticsearchConfig struct {
 	Username                 string `env:"ELASTICSEARCH_USERNAME"`
 	Password                 string `env:"ELASTICSEARCH_PASSWORD"`
 	RoomMessageIndex         string `env:"ELASTICSEARCH_ROOM_MESSAGE_INDEX,default=room_messages_v1"`
-	AccountIndex             string `env:"ELASTICSEARCH_ACCOUNT_INDEX,default=accounts_v1"`
+	AccountIndex             string `env:"ELASTICSEARCH_ACCOUNT_INDEX,default=accounts_v2"`
 	ConnectTimeoutSeconds    int    `env:"ELASTICSEARCH_CONNECT_TIMEOUT_SECONDS,default=10"`
 	ResponseHeaderTimeoutSec int    `env:"ELASTICSEARCH_RESPONSE_HEADER_TIMEOUT_SECONDS,default=10"`
 }
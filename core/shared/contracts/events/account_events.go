@@ -3,12 +3,19 @@ package events
 import "time"
 
 const (
-	EventAccountCreated         = "EventAccountCreated"
-	EventAccountUpdated         = "EventAccountUpdated"
-	EventAccountProfileUpdated  = "EventAccountProfileUpdated"
-	EventAccountEmailVerified   = "EventAccountEmailVerified"
-	EventAccountPasswordChanged = "EventAccountPasswordChanged"
-	EventAccountBanned          = "EventAccountBanned"
+	EventAccountCreated               = "EventAccountCreated"
+	EventAccountUpdated               = "EventAccountUpdated"
+	EventAccountProfileUpdated        = "EventAccountProfileUpdated"
+	EventAccountUsernameChanged       = "EventAccountUsernameChanged"
+	EventAccountProfileDetailsUpdated = "EventAccountProfileDetailsUpdated"
+	EventAccountEmailVerified         = "EventAccountEmailVerified"
+	EventAccountPasswordChanged       = "EventAccountPasswordChanged"
+	EventAccountBanned                = "EventAccountBanned"
+	EventAccountUnbanned              = "EventAccountUnbanned"
+	EventAccountLocked                = "EventAccountLocked"
+	EventAccountNewDeviceLogin        = "EventAccountNewDeviceLogin"
+	EventAccountProfileViewed         = "EventAccountProfileViewed"
+	EventAccountContactCardRotated    = "EventAccountContactCardRotated"
 )
 
 type AccountCreatedEvent struct {
@@ -32,10 +39,58 @@ type AccountProfileUpdatedEvent struct {
 	UpdatedAt       time.Time
 }
 
+type AccountUsernameChangedEvent struct {
+	AccountID        string
+	Username         string
+	PreviousUsername *string
+	ChangedAt        time.Time
+}
+
+// AccountContactCardRotatedEvent carries the nonce of the owner's current
+// contact card; cards signed with any other nonce are revoked.
+type AccountContactCardRotatedEvent struct {
+	AccountID string
+	Nonce     string
+	RotatedAt time.Time
+}
+
 type AccountBannedEvent struct {
 	AccountID string
 	BanReason string
 	BanUntil  *time.Time
+	BannedAt  time.Time
+}
+
+type AccountUnbannedEvent struct {
+	AccountID  string
+	UnbannedAt time.Time
+}
+
+type AccountLockedEvent struct {
+	AccountID   string
+	Reason      string
+	LockedUntil time.Time
+	LockedAt    time.Time
+}
+
+type AccountNewDeviceLoginEvent struct {
+	AccountID   string
+	Email       string
+	DisplayName string
+	SessionID   string
+	DeviceID    string
+	DeviceName  string
+	IPAddress   string
+	UserAgent   string
+	CountryCode string
+	LoggedInAt  time.Time
+}
+
+// AccountProfileViewedEvent records one account opening another's profile.
+type AccountProfileViewedEvent struct {
+	ViewerID string
+	OwnerID  string
+	ViewedAt time.Time
 }
 
 func (e *AccountCreatedEvent) GetName() string {
@@ -69,3 +124,43 @@ func (e *AccountBannedEvent) GetName() string {
 func (e *AccountBannedEvent) GetData() interface{} {
 	return e
 }
+
+func (e *AccountUnbannedEvent) GetName() string {
+	return EventAccountUnbanned
+}
+
+func (e *AccountUnbannedEvent) GetData() interface{} {
+	return e
+}
+
+func (e *AccountLockedEvent) GetName() string {
+	return EventAccountLocked
+}
+
+func (e *AccountLockedEvent) GetData() interface{} {
+	return e
+}
+
+func (e *AccountNewDeviceLoginEvent) GetName() string {
+	return EventAccountNewDeviceLogin
+}
+
+func (e *AccountNewDeviceLoginEvent) GetData() interface{} {
+	return e
+}
+
+func (e *AccountUsernameChangedEvent) GetName() string {
+	return EventAccountUsernameChanged
+}
+
+func (e *AccountUsernameChangedEvent) GetData() interface{} {
+	return e
+}
+
+func (e *AccountContactCardRotatedEvent) GetName() string {
+	return EventAccountContactCardRotated
+}
+
+func (e *AccountContactCardRotatedEvent) GetData() interface{} {
+	return e
+}
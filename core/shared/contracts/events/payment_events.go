@@ -91,6 +91,10 @@ type PaymentFailedEvent struct {
 	OccurredAt         time.Time `json:"occurred_at"`
 }
 
+// PaymentRefundedEvent is published for each refund. Amount is this refund
+// alone and RefundedAmount the total refunded so far; events published before
+// payments could be refunded in parts leave RefundedAmount zero and refund the
+// whole payment.
 type PaymentRefundedEvent struct {
 	Workflow           string    `json:"workflow"`
 	PaymentID          string    `json:"payment_id"`
@@ -101,7 +105,9 @@ type PaymentRefundedEvent struct {
 	ProviderEventID    string    `json:"provider_event_id,omitempty"`
 	ProviderEventType  string    `json:"provider_event_type,omitempty"`
 	ProviderPaymentRef string    `json:"provider_payment_ref,omitempty"`
+	RefundID           string    `json:"refund_id,omitempty"`
 	Amount             int64     `json:"amount"`
+	RefundedAmount     int64     `json:"refunded_amount,omitempty"`
 	FeeAmount          int64     `json:"fee_amount"`
 	ProviderAmount     int64     `json:"provider_amount"`
 	Currency           string    `json:"currency"`
@@ -125,10 +131,54 @@ type PaymentChargebackEvent struct {
 	ProviderAmount     int64     `json:"provider_amount"`
 	Currency           string    `json:"currency"`
 	CreditAccountID    string    `json:"credit_account_id,omitempty"`
+	DisputeID          string    `json:"dispute_id,omitempty"`
 	IdempotencyKey     string    `json:"idempotency_key"`
 	ChargedBackAt      time.Time `json:"charged_back_at"`
 }
 
+// PaymentDisputeOpenedEvent starts a dispute on a top-up. The ledger holds
+// Amount from AccountID until the dispute is won or lost.
+type PaymentDisputeOpenedEvent struct {
+	DisputeID         string     `json:"dispute_id"`
+	PaymentID         string     `json:"payment_id"`
+	TransactionID     string     `json:"transaction_id"`
+	Provider          string     `json:"provider"`
+	ProviderDisputeID string     `json:"provider_dispute_id"`
+	AccountID         string     `json:"account_id"`
+	Amount            int64      `json:"amount"`
+	Currency          string     `json:"currency"`
+	Reason            string     `json:"reason,omitempty"`
+	Status            string     `json:"status"`
+	EvidenceDueBy     *time.Time `json:"evidence_due_by,omitempty"`
+	OpenedAt          time.Time  `json:"opened_at"`
+}
+
+// PaymentDisputeWonEvent closes a dispute in the merchant's favour; the ledger
+// releases the held funds back to AccountID.
+type PaymentDisputeWonEvent struct {
+	DisputeID     string    `json:"dispute_id"`
+	PaymentID     string    `json:"payment_id"`
+	TransactionID string    `json:"transaction_id"`
+	Provider      string    `json:"provider"`
+	AccountID     string    `json:"account_id"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	WonAt         time.Time `json:"won_at"`
+}
+
+// PaymentDisputeLostEvent closes a dispute in the cardholder's favour. The
+// held funds leave through the payment's chargeback, which carries DisputeID.
+type PaymentDisputeLostEvent struct {
+	DisputeID     string    `json:"dispute_id"`
+	PaymentID     string    `json:"payment_id"`
+	TransactionID string    `json:"transaction_id"`
+	Provider      string    `json:"provider"`
+	AccountID     string    `json:"account_id"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	LostAt        time.Time `json:"lost_at"`
+}
+
 var (
 	EventPaymentCreated                = event.EventName((*PaymentCreatedEvent)(nil))
 	EventPaymentWithdrawalRequested    = event.EventName((*PaymentWithdrawalRequestedEvent)(nil))
@@ -137,4 +187,7 @@ var (
 	EventPaymentFailed                 = event.EventName((*PaymentFailedEvent)(nil))
 	EventPaymentRefunded               = event.EventName((*PaymentRefundedEvent)(nil))
 	EventPaymentChargeback             = event.EventName((*PaymentChargebackEvent)(nil))
+	EventPaymentDisputeOpened          = event.EventName((*PaymentDisputeOpenedEvent)(nil))
+	EventPaymentDisputeWon             = event.EventName((*PaymentDisputeWonEvent)(nil))
+	EventPaymentDisputeLost            = event.EventName((*PaymentDisputeLostEvent)(nil))
 )
@@ -5,6 +5,7 @@ import "time"
 const (
 	EventRelationshipPairFriendRequestSent      = "EventRelationshipPairFriendRequestSent"
 	EventRelationshipPairFriendRequestCancelled = "EventRelationshipPairFriendRequestCancelled"
+	EventRelationshipPairFriendRequestExpired   = "EventRelationshipPairFriendRequestExpired"
 	EventRelationshipPairFriendRequestAccepted  = "EventRelationshipPairFriendRequestAccepted"
 	EventRelationshipPairFriendRequestRejected  = "EventRelationshipPairFriendRequestRejected"
 	EventRelationshipPairFollowed               = "EventRelationshipPairFollowed"
@@ -12,12 +13,17 @@ const (
 	EventRelationshipPairUnfriended             = "EventRelationshipPairUnfriended"
 	EventRelationshipPairBlocked                = "EventRelationshipPairBlocked"
 	EventRelationshipPairUnblocked              = "EventRelationshipPairUnblocked"
+	EventFriendContactRemarkUpdated             = "EventFriendContactRemarkUpdated"
+	EventFriendContactAudienceListUpdated       = "EventFriendContactAudienceListUpdated"
+	EventFriendRequestPrivacyUpdated            = "EventFriendRequestPrivacyUpdated"
 )
 
 type RelationshipPairFriendRequestSentEvent struct {
 	RequestID   string
 	RequesterID string
 	AddresseeID string
+	Source      string
+	SharedByID  string
 	CreatedAt   time.Time
 }
 
@@ -29,6 +35,15 @@ type RelationshipPairFriendRequestCancelledEvent struct {
 	CancelledAt time.Time
 }
 
+type RelationshipPairFriendRequestExpiredEvent struct {
+	RequestID       string
+	RequesterID     string
+	AddresseeID     string
+	CreatedAt       time.Time
+	ExpiredAt       time.Time
+	NotifyRequester bool
+}
+
 type RelationshipPairFriendRequestAcceptedEvent struct {
 	RequestID    string
 	RequesterID  string
@@ -76,3 +91,29 @@ type RelationshipPairUnblockedEvent struct {
 	BlockerID string
 	BlockedID string
 }
+
+type FriendContactRemarkUpdatedEvent struct {
+	OwnerID    string
+	FriendID   string
+	RemarkName string
+	UpdatedAt  time.Time
+}
+
+// FriendContactAudienceListUpdatedEvent carries the owner's classification of
+// a friend. An empty AudienceList means the friend was taken off every list.
+type FriendContactAudienceListUpdatedEvent struct {
+	OwnerID      string
+	FriendID     string
+	AudienceList string
+	UpdatedAt    time.Time
+}
+
+// FriendRequestPrivacyUpdatedEvent carries the add-by switches other modules
+// enforce when someone looks the account up.
+type FriendRequestPrivacyUpdatedEvent struct {
+	AccountID          string
+	FindableByUsername bool
+	FindableByEmail    bool
+	FindableByQR       bool
+	UpdatedAt          time.Time
+}
@@ -3,7 +3,8 @@ package events
 import "time"
 
 const (
-	EventRoomMessageCreated = "EventRoomMessageCreated"
+	EventRoomMessageCreated  = "EventRoomMessageCreated"
+	EventRoomTransferUpdated = "EventRoomTransferUpdated"
 )
 
 type RoomMessageMention struct {
@@ -12,24 +13,68 @@ type RoomMessageMention struct {
 	Username    string `json:"username,omitempty"`
 }
 
+// RoomMessageContactCard is the profile shared by a contact card message.
+type RoomMessageContactCard struct {
+	AccountID       string `json:"account_id"`
+	DisplayName     string `json:"display_name,omitempty"`
+	Username        string `json:"username,omitempty"`
+	AvatarObjectKey string `json:"avatar_object_key,omitempty"`
+	Token           string `json:"token,omitempty"`
+}
+
+// RoomMessageRedPacket describes the red packet announced by a message.
+type RoomMessageRedPacket struct {
+	PacketID    string `json:"packet_id"`
+	Currency    string `json:"currency"`
+	TotalAmount int64  `json:"total_amount"`
+	Count       int    `json:"count"`
+	SplitMode   string `json:"split_mode"`
+}
+
+// RoomMessageTransfer describes the transfer carried by a message.
+type RoomMessageTransfer struct {
+	TransferID  string `json:"transfer_id"`
+	RecipientID string `json:"recipient_id"`
+	Currency    string `json:"currency"`
+	Amount      int64  `json:"amount"`
+	Status      string `json:"status"`
+}
+
 type RoomMessageCreatedEvent struct {
-	RoomID                 string               `json:"room_id"`
-	RoomName               string               `json:"room_name,omitempty"`
-	RoomType               string               `json:"room_type,omitempty"`
-	MessageID              string               `json:"message_id"`
-	MessageContent         string               `json:"message_content,omitempty"`
-	MessageType            string               `json:"message_type,omitempty"`
-	ReplyToMessageID       string               `json:"reply_to_message_id,omitempty"`
-	ForwardedFromMessageID string               `json:"forwarded_from_message_id,omitempty"`
-	FileName               string               `json:"file_name,omitempty"`
-	FileSize               int64                `json:"file_size,omitempty"`
-	MimeType               string               `json:"mime_type,omitempty"`
-	ObjectKey              string               `json:"object_key,omitempty"`
-	MessageSenderID        string               `json:"message_sender_id"`
-	MessageSenderName      string               `json:"message_sender_name,omitempty"`
-	MessageSenderEmail     string               `json:"message_sender_email,omitempty"`
-	MessageSentAt          time.Time            `json:"message_sent_at"`
-	Mentions               []RoomMessageMention `json:"mentions,omitempty"`
-	MentionAll             bool                 `json:"mention_all"`
-	MentionedAccountIDs    []string             `json:"mentioned_account_ids,omitempty"`
+	RoomID                 string                  `json:"room_id"`
+	RoomName               string                  `json:"room_name,omitempty"`
+	RoomType               string                  `json:"room_type,omitempty"`
+	MessageID              string                  `json:"message_id"`
+	MessageContent         string                  `json:"message_content,omitempty"`
+	MessageType            string                  `json:"message_type,omitempty"`
+	ReplyToMessageID       string                  `json:"reply_to_message_id,omitempty"`
+	ForwardedFromMessageID string                  `json:"forwarded_from_message_id,omitempty"`
+	FileName               string                  `json:"file_name,omitempty"`
+	FileSize               int64                   `json:"file_size,omitempty"`
+	MimeType               string                  `json:"mime_type,omitempty"`
+	ObjectKey              string                  `json:"object_key,omitempty"`
+	ContactCard            *RoomMessageContactCard `json:"contact_card,omitempty"`
+	RedPacket              *RoomMessageRedPacket   `json:"red_packet,omitempty"`
+	Transfer               *RoomMessageTransfer    `json:"transfer,omitempty"`
+	MessageSenderID        string                  `json:"message_sender_id"`
+	MessageSenderName      string                  `json:"message_sender_name,omitempty"`
+	MessageSenderEmail     string                  `json:"message_sender_email,omitempty"`
+	MessageSentAt          time.Time               `json:"message_sent_at"`
+	Mentions               []RoomMessageMention    `json:"mentions,omitempty"`
+	MentionAll             bool                    `json:"mention_all"`
+	MentionedAccountIDs    []string                `json:"mentioned_account_ids,omitempty"`
+}
+
+// RoomTransferUpdatedEvent is published whenever a chat transfer changes
+// status, so both parties can be told about it.
+type RoomTransferUpdatedEvent struct {
+	TransferID  string    `json:"transfer_id"`
+	RoomID      string    `json:"room_id"`
+	MessageID   string    `json:"message_id"`
+	SenderID    string    `json:"sender_id"`
+	RecipientID string    `json:"recipient_id"`
+	Currency    string    `json:"currency"`
+	Amount      int64     `json:"amount"`
+	Status      string    `json:"status"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -83,6 +83,9 @@ type RoomMessageProjection struct {
 	FileSize               int64                    `json:"file_size"`
 	MimeType               string                   `json:"mime_type"`
 	ObjectKey              string                   `json:"object_key"`
+	ContactCard            *RoomMessageContactCard  `json:"contact_card,omitempty"`
+	RedPacket              *RoomMessageRedPacket    `json:"red_packet,omitempty"`
+	Transfer               *RoomMessageTransfer     `json:"transfer,omitempty"`
 	MessageSenderID        string                   `json:"message_sender_id"`
 	MessageSenderName      string                   `json:"message_sender_name"`
 	MessageSenderEmail     string                   `json:"message_sender_email"`
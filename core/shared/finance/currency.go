@@ -0,0 +1,113 @@
+package finance
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// minorUnitExponents lists the ISO 4217 exponent of each supported currency:
+// amounts are stored as integers in 10^-exponent of the major unit.
+var minorUnitExponents = map[string]int{
+	"AUD": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HKD": 2,
+	"IDR": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"MYR": 2,
+	"PHP": 2,
+	"SGD": 2,
+	"THB": 2,
+	"TWD": 2,
+	"USD": 2,
+	"VND": 0,
+}
+
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// MinorUnitExponent returns the number of decimal places of currency's minor
+// unit, e.g. 2 for USD and 0 for VND.
+func MinorUnitExponent(currency string) (int, error) {
+	exponent, ok := minorUnitExponents[NormalizeCurrency(currency)]
+	if !ok {
+		return 0, stackErr.Error(fmt.Errorf("unsupported currency %q", currency))
+	}
+	return exponent, nil
+}
+
+// ConvertMinorAmount converts amount, in from's minor units, at rate (units of
+// to per unit of from) into to's minor units. Fractions are rounded up when
+// roundUp is set and truncated otherwise, so the caller picks the side the
+// rounding favours.
+func ConvertMinorAmount(amount int64, from, to string, rate *big.Rat, roundUp bool) (int64, error) {
+	if amount < 0 {
+		return 0, stackErr.Error(fmt.Errorf("amount must be greater than or equal to 0"))
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return 0, stackErr.Error(fmt.Errorf("rate must be greater than 0"))
+	}
+	fromExponent, err := MinorUnitExponent(from)
+	if err != nil {
+		return 0, err
+	}
+	toExponent, err := MinorUnitExponent(to)
+	if err != nil {
+		return 0, err
+	}
+
+	value := new(big.Rat).SetInt64(amount)
+	value.Mul(value, rate)
+	value.Mul(value, new(big.Rat).SetInt(pow10(toExponent)))
+	value.Quo(value, new(big.Rat).SetInt(pow10(fromExponent)))
+
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if roundUp && remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() {
+		return 0, stackErr.Error(fmt.Errorf("converted amount overflows for amount=%d", amount))
+	}
+	return quotient.Int64(), nil
+}
+
+// FormatMinorAmount renders amount, in currency's minor units, as a decimal
+// string in the major unit, e.g. 1250 USD as "12.50" and 1250 VND as "1250".
+func FormatMinorAmount(amount int64, currency string) (string, error) {
+	exponent, err := MinorUnitExponent(currency)
+	if err != nil {
+		return "", err
+	}
+	return new(big.Rat).SetFrac(big.NewInt(amount), pow10(exponent)).FloatString(exponent), nil
+}
+
+// ParseMajorAmount is the inverse of FormatMinorAmount. Values with more
+// decimal places than the currency's minor unit are rejected rather than
+// rounded.
+func ParseMajorAmount(value, currency string) (int64, error) {
+	exponent, err := MinorUnitExponent(currency)
+	if err != nil {
+		return 0, err
+	}
+	amount, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return 0, stackErr.Error(fmt.Errorf("invalid amount %q", value))
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(pow10(exponent)))
+	if !amount.IsInt() || !amount.Num().IsInt64() {
+		return 0, stackErr.Error(fmt.Errorf("amount %q does not fit %s minor units", value, NormalizeCurrency(currency)))
+	}
+	return amount.Num().Int64(), nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
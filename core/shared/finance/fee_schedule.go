@@ -0,0 +1,424 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const (
+	FeeOperationTopUp      = "top_up"
+	FeeOperationWithdrawal = "withdrawal"
+	FeeOperationTransfer   = "transfer"
+	FeeOperationConversion = "conversion"
+
+	// Transfers and red packets sent inside a chat are exempt from fees: they
+	// are quoted at zero and no rule or promotion may name them, so the
+	// transfer rule charged by the ledger transfer endpoint never reaches
+	// them.
+	FeeOperationRoomTransfer = "room_transfer"
+	FeeOperationRedPacket    = "red_packet"
+
+	// FeeWildcard matches any provider, currency or tier in a fee rule.
+	FeeWildcard = "*"
+)
+
+var (
+	ErrFeeOperationInvalid = errors.New("fee operation must be top_up, withdrawal, transfer, conversion, room_transfer or red_packet")
+	ErrFeeScheduleInvalid  = errors.New("fee schedule is invalid")
+	ErrFeePromotionInvalid = errors.New("fee promotions are invalid")
+	ErrFeeQuoteChanged     = errors.New("fee changed since it was quoted")
+)
+
+// FeeBracket prices amounts up to UpTo, inclusive; the last bracket of a rule
+// has no upper bound and UpTo 0.
+type FeeBracket struct {
+	UpTo       int64
+	RateBPS    int64
+	FlatAmount int64
+}
+
+// FeeRule prices one operation. Provider, Currency and Tier may be
+// FeeWildcard. The amount picks a single bracket whose rate and flat amount
+// apply to the whole amount, and the result is then held within MinFee and
+// MaxFee, where a zero MaxFee is no cap.
+type FeeRule struct {
+	Operation string
+	Provider  string
+	Currency  string
+	Tier      string
+	Brackets  []FeeBracket
+	MinFee    int64
+	MaxFee    int64
+}
+
+// FeePromotion waives the fee of matching operations from From until, but
+// not including, Until.
+type FeePromotion struct {
+	Operation string
+	Provider  string
+	Currency  string
+	Tier      string
+	From      time.Time
+	Until     time.Time
+}
+
+// FeeSchedule holds every fee rule and promotion. Operations without a
+// matching rule are free.
+type FeeSchedule struct {
+	Rules      []FeeRule
+	Promotions []FeePromotion
+}
+
+// FeeQuery describes an operation about to be executed. Provider is empty
+// for operations that do not go through a payment provider.
+type FeeQuery struct {
+	Operation string
+	Provider  string
+	Currency  string
+	Tier      string
+	Amount    int64
+	At        time.Time
+}
+
+// FeeQuote is the fee charged for a FeeQuery, in the query's currency.
+type FeeQuote struct {
+	Operation   string
+	Provider    string
+	Currency    string
+	Tier        string
+	Amount      int64
+	FeeAmount   int64
+	RateBPS     int64
+	FlatAmount  int64
+	Promotional bool
+}
+
+func NormalizeFeeOperation(operation string) (string, error) {
+	operation = strings.ToLower(strings.TrimSpace(operation))
+	switch operation {
+	case FeeOperationTopUp, FeeOperationWithdrawal, FeeOperationTransfer, FeeOperationConversion,
+		FeeOperationRoomTransfer, FeeOperationRedPacket:
+		return operation, nil
+	default:
+		return "", ErrFeeOperationInvalid
+	}
+}
+
+// IsFeeExempt reports whether operation is never charged a fee.
+func IsFeeExempt(operation string) bool {
+	return operation == FeeOperationRoomTransfer || operation == FeeOperationRedPacket
+}
+
+// ParseFeeSchedule builds a schedule from rules in the ParseFeeRules format
+// and promotions in the ParseFeePromotions format.
+func ParseFeeSchedule(rules, promotions string) (FeeSchedule, error) {
+	parsedRules, err := ParseFeeRules(rules)
+	if err != nil {
+		return FeeSchedule{}, err
+	}
+	parsedPromotions, err := ParseFeePromotions(promotions)
+	if err != nil {
+		return FeeSchedule{}, err
+	}
+	return FeeSchedule{Rules: parsedRules, Promotions: parsedPromotions}, nil
+}
+
+// ParseFeeRules reads rules written as
+// "top_up/stripe/USD/*:*@290+30:50/0,transfer/*/VND/unverified:1000000@50+0|*@30+0:1000/50000".
+// The key is operation/provider/currency/tier, brackets are
+// "<up_to>@<rate_bps>+<flat>" joined by "|" with "*" as the open-ended last
+// bracket, and the optional suffix is the min and max fee in minor units.
+func ParseFeeRules(spec string) ([]FeeRule, error) {
+	rules := make([]FeeRule, 0)
+	seen := make(map[string]struct{})
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 && len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %q", ErrFeeScheduleInvalid, entry)
+		}
+		operation, provider, currency, tier, ok := parseFeeKey(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrFeeScheduleInvalid, entry)
+		}
+		key := feeKey(operation, provider, currency, tier)
+		if _, duplicate := seen[key]; duplicate {
+			return nil, fmt.Errorf("%w: duplicate rule %q", ErrFeeScheduleInvalid, key)
+		}
+		seen[key] = struct{}{}
+
+		brackets, ok := parseFeeBrackets(parts[1])
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrFeeScheduleInvalid, entry)
+		}
+		rule := FeeRule{
+			Operation: operation,
+			Provider:  provider,
+			Currency:  currency,
+			Tier:      tier,
+			Brackets:  brackets,
+		}
+		if len(parts) == 3 {
+			minFee, maxFee, caps := strings.Cut(parts[2], "/")
+			rule.MinFee, ok = parseNonNegative(minFee)
+			if !caps || !ok {
+				return nil, fmt.Errorf("%w: %q", ErrFeeScheduleInvalid, entry)
+			}
+			rule.MaxFee, ok = parseNonNegative(maxFee)
+			if !ok || (rule.MaxFee > 0 && rule.MaxFee < rule.MinFee) {
+				return nil, fmt.Errorf("%w: %q", ErrFeeScheduleInvalid, entry)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// WithLegacyFeeRules appends a catch-all rule charging rateBPS plus
+// flatAmount for each of operations, the way a single flat fee priced them
+// before the schedule. Operations whose catch-all rules already has are
+// left alone, and nothing is added when both amounts are zero.
+func WithLegacyFeeRules(rules string, rateBPS, flatAmount int64, operations ...string) string {
+	if rateBPS == 0 && flatAmount == 0 {
+		return rules
+	}
+	defined := make(map[string]struct{})
+	for _, entry := range strings.Split(rules, ",") {
+		key, _, _ := strings.Cut(entry, ":")
+		if operation, provider, currency, tier, ok := parseFeeKey(key); ok {
+			defined[feeKey(operation, provider, currency, tier)] = struct{}{}
+		}
+	}
+
+	entries := make([]string, 0, len(operations)+1)
+	if strings.TrimSpace(rules) != "" {
+		entries = append(entries, rules)
+	}
+	for _, operation := range operations {
+		key := feeKey(operation, FeeWildcard, FeeWildcard, FeeWildcard)
+		if _, ok := defined[key]; ok {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("%s:*@%d+%d", key, rateBPS, flatAmount))
+	}
+	return strings.Join(entries, ",")
+}
+
+// ParseFeePromotions reads zero-fee windows written as
+// "top_up/*/VND/*:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z", keyed like
+// fee rules.
+func ParseFeePromotions(spec string) ([]FeePromotion, error) {
+	promotions := make([]FeePromotion, 0)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, window, ok := strings.Cut(entry, ":")
+		from, until, windowOK := strings.Cut(window, "~")
+		if !ok || !windowOK {
+			return nil, fmt.Errorf("%w: %q", ErrFeePromotionInvalid, entry)
+		}
+		operation, provider, currency, tier, keyOK := parseFeeKey(key)
+		if !keyOK {
+			return nil, fmt.Errorf("%w: %q", ErrFeePromotionInvalid, entry)
+		}
+		fromAt, fromErr := time.Parse(time.RFC3339, strings.TrimSpace(from))
+		untilAt, untilErr := time.Parse(time.RFC3339, strings.TrimSpace(until))
+		if fromErr != nil || untilErr != nil || !untilAt.After(fromAt) {
+			return nil, fmt.Errorf("%w: %q", ErrFeePromotionInvalid, entry)
+		}
+		promotions = append(promotions, FeePromotion{
+			Operation: operation,
+			Provider:  provider,
+			Currency:  currency,
+			Tier:      tier,
+			From:      fromAt.UTC(),
+			Until:     untilAt.UTC(),
+		})
+	}
+	return promotions, nil
+}
+
+// Quote prices query with the most specific matching rule, where a concrete
+// provider outranks a concrete currency, which outranks a concrete tier.
+func (s FeeSchedule) Quote(query FeeQuery) (FeeQuote, error) {
+	operation, err := NormalizeFeeOperation(query.Operation)
+	if err != nil {
+		return FeeQuote{}, stackErr.Error(err)
+	}
+	if query.Amount <= 0 {
+		return FeeQuote{}, stackErr.Error(fmt.Errorf("amount must be greater than 0"))
+	}
+	quote := FeeQuote{
+		Operation: operation,
+		Provider:  strings.ToLower(strings.TrimSpace(query.Provider)),
+		Currency:  NormalizeCurrency(query.Currency),
+		Tier:      strings.ToLower(strings.TrimSpace(query.Tier)),
+		Amount:    query.Amount,
+	}
+	if IsFeeExempt(operation) {
+		return quote, nil
+	}
+
+	for _, promotion := range s.Promotions {
+		if feeKeyMatches(promotion.Operation, promotion.Provider, promotion.Currency, promotion.Tier, quote) &&
+			!query.At.Before(promotion.From) && query.At.Before(promotion.Until) {
+			quote.Promotional = true
+			return quote, nil
+		}
+	}
+
+	rule, ok := s.match(quote)
+	if !ok {
+		return quote, nil
+	}
+	bracket := rule.Brackets[len(rule.Brackets)-1]
+	for _, candidate := range rule.Brackets {
+		if candidate.UpTo == 0 || query.Amount <= candidate.UpTo {
+			bracket = candidate
+			break
+		}
+	}
+
+	rateFee := int64(0)
+	if bracket.RateBPS > 0 {
+		if query.Amount > (math.MaxInt64-9999)/bracket.RateBPS {
+			return FeeQuote{}, stackErr.Error(fmt.Errorf("fee overflow for amount=%d", query.Amount))
+		}
+		rateFee = (query.Amount*bracket.RateBPS + 9999) / 10000
+	}
+	fee := rateFee + bracket.FlatAmount
+	if fee < 0 {
+		return FeeQuote{}, stackErr.Error(fmt.Errorf("fee overflow for amount=%d", query.Amount))
+	}
+	if fee < rule.MinFee {
+		fee = rule.MinFee
+	}
+	if rule.MaxFee > 0 && fee > rule.MaxFee {
+		fee = rule.MaxFee
+	}
+
+	quote.FeeAmount = fee
+	quote.RateBPS = bracket.RateBPS
+	quote.FlatAmount = bracket.FlatAmount
+	return quote, nil
+}
+
+// CheckQuotedFee reports ErrFeeQuoteChanged when the caller was quoted a
+// different fee than the one about to be charged. Sending the quote is
+// optional in the API, since gRPC callers have no field for it, so a nil
+// quote skips the check.
+func CheckQuotedFee(quoted *int64, feeAmount int64) error {
+	if quoted == nil || *quoted == feeAmount {
+		return nil
+	}
+	return fmt.Errorf("%w: quoted %d, now %d", ErrFeeQuoteChanged, *quoted, feeAmount)
+}
+
+func (s FeeSchedule) match(quote FeeQuote) (FeeRule, bool) {
+	best := -1
+	var matched FeeRule
+	for _, rule := range s.Rules {
+		if !feeKeyMatches(rule.Operation, rule.Provider, rule.Currency, rule.Tier, quote) {
+			continue
+		}
+		rank := 0
+		if rule.Provider != FeeWildcard {
+			rank += 4
+		}
+		if rule.Currency != FeeWildcard {
+			rank += 2
+		}
+		if rule.Tier != FeeWildcard {
+			rank++
+		}
+		if rank > best {
+			best = rank
+			matched = rule
+		}
+	}
+	return matched, best >= 0
+}
+
+func feeKeyMatches(operation, provider, currency, tier string, quote FeeQuote) bool {
+	return operation == quote.Operation &&
+		(provider == FeeWildcard || provider == quote.Provider) &&
+		(currency == FeeWildcard || currency == quote.Currency) &&
+		(tier == FeeWildcard || tier == quote.Tier)
+}
+
+func parseFeeKey(key string) (operation, provider, currency, tier string, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 4 {
+		return "", "", "", "", false
+	}
+	operation, err := NormalizeFeeOperation(parts[0])
+	if err != nil || IsFeeExempt(operation) {
+		return "", "", "", "", false
+	}
+	provider = strings.ToLower(strings.TrimSpace(parts[1]))
+	currency = NormalizeCurrency(parts[2])
+	tier = strings.ToLower(strings.TrimSpace(parts[3]))
+	if provider == "" || currency == "" || tier == "" {
+		return "", "", "", "", false
+	}
+	return operation, provider, currency, tier, true
+}
+
+func parseFeeBrackets(spec string) ([]FeeBracket, bool) {
+	entries := strings.Split(spec, "|")
+	brackets := make([]FeeBracket, 0, len(entries))
+	for idx, entry := range entries {
+		upTo, price, ok := strings.Cut(strings.TrimSpace(entry), "@")
+		rate, flat, priceOK := strings.Cut(price, "+")
+		if !ok || !priceOK {
+			return nil, false
+		}
+
+		bracket := FeeBracket{}
+		last := idx == len(entries)-1
+		if strings.TrimSpace(upTo) == FeeWildcard {
+			if !last {
+				return nil, false
+			}
+		} else {
+			bracket.UpTo, ok = parseNonNegative(upTo)
+			if !ok || bracket.UpTo == 0 || last {
+				return nil, false
+			}
+			if idx > 0 && bracket.UpTo <= brackets[idx-1].UpTo {
+				return nil, false
+			}
+		}
+		if bracket.RateBPS, ok = parseNonNegative(rate); !ok || bracket.RateBPS > 10000 {
+			return nil, false
+		}
+		if bracket.FlatAmount, ok = parseNonNegative(flat); !ok {
+			return nil, false
+		}
+		brackets = append(brackets, bracket)
+	}
+	return brackets, true
+}
+
+func parseNonNegative(value string) (int64, bool) {
+	parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || parsed < 0 {
+		return 0, false
+	}
+	return parsed, true
+}
+
+func feeKey(operation, provider, currency, tier string) string {
+	return operation + "/" + provider + "/" + currency + "/" + tier
+}
@@ -0,0 +1,167 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseFeeSchedule(t *testing.T) {
+	schedule, err := ParseFeeSchedule(
+		"top_up/stripe/usd/*:*@290+30, transfer/*/VND/unverified:1000000@50+0|*@30+0:1000/50000",
+		"top_up/*/VND/*:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(schedule.Rules) != 2 || len(schedule.Promotions) != 1 {
+		t.Fatalf("unexpected schedule %+v", schedule)
+	}
+	transfer := schedule.Rules[1]
+	if transfer.MinFee != 1000 || transfer.MaxFee != 50000 || len(transfer.Brackets) != 2 || transfer.Brackets[0].UpTo != 1000000 {
+		t.Fatalf("unexpected transfer rule %+v", transfer)
+	}
+	if schedule.Rules[0].Currency != "USD" {
+		t.Fatalf("expected currency to be normalized, got %q", schedule.Rules[0].Currency)
+	}
+
+	for _, spec := range []string{
+		"payout/*/VND/*:*@10+0",
+		"transfer/VND:*@10+0",
+		"transfer/*/VND/*:1000@10+0",
+		"transfer/*/VND/*:*@10+0|1000@5+0",
+		"transfer/*/VND/*:2000@10+0|1000@5+0|*@1+0",
+		"transfer/*/VND/*:*@10001+0",
+		"transfer/*/VND/*:*@10+0:500/100",
+		"transfer/*/VND/*:*@10+0,transfer/*/vnd/*:*@20+0",
+	} {
+		if _, err := ParseFeeRules(spec); !errors.Is(err, ErrFeeScheduleInvalid) {
+			t.Fatalf("expected rules %q to be rejected, got %v", spec, err)
+		}
+	}
+	for _, spec := range []string{
+		"top_up/*/VND/*:2026-11-08T00:00:00Z~2026-11-01T00:00:00Z",
+		"top_up/*/VND/*:2026-11-01",
+	} {
+		if _, err := ParseFeePromotions(spec); !errors.Is(err, ErrFeePromotionInvalid) {
+			t.Fatalf("expected promotions %q to be rejected, got %v", spec, err)
+		}
+	}
+}
+
+func TestFeeScheduleQuote(t *testing.T) {
+	schedule, err := ParseFeeSchedule(
+		"transfer/*/VND/*:1000000@50+0|*@30+0:1000/20000,"+
+			"transfer/*/VND/business:*@0+0,"+
+			"top_up/*/USD/*:*@300+0,"+
+			"top_up/stripe/USD/*:*@290+30",
+		"top_up/*/USD/verified:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	at := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		query       FeeQuery
+		fee         int64
+		promotional bool
+	}{
+		{name: "min fee", query: FeeQuery{Operation: "transfer", Currency: "VND", Tier: "unverified", Amount: 10000}, fee: 1000},
+		{name: "first bracket", query: FeeQuery{Operation: "transfer", Currency: "VND", Tier: "verified", Amount: 1000000}, fee: 5000},
+		{name: "second bracket", query: FeeQuery{Operation: "transfer", Currency: "VND", Tier: "verified", Amount: 3000000}, fee: 9000},
+		{name: "max fee", query: FeeQuery{Operation: "transfer", Currency: "VND", Tier: "verified", Amount: 100000000}, fee: 20000},
+		{name: "tier override", query: FeeQuery{Operation: "transfer", Currency: "VND", Tier: "business", Amount: 3000000}, fee: 0},
+		{name: "provider outranks currency", query: FeeQuery{Operation: "top_up", Provider: "Stripe", Currency: "usd", Tier: "verified", Amount: 1000}, fee: 59},
+		{name: "wildcard provider", query: FeeQuery{Operation: "top_up", Provider: "mock", Currency: "USD", Tier: "verified", Amount: 1000}, fee: 30},
+		{name: "no rule", query: FeeQuery{Operation: "withdrawal", Provider: "stripe", Currency: "USD", Amount: 1000}, fee: 0},
+		{name: "promotion", query: FeeQuery{Operation: "top_up", Provider: "stripe", Currency: "USD", Tier: "verified", Amount: 1000, At: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}, promotional: true},
+		{name: "promotion ended", query: FeeQuery{Operation: "top_up", Provider: "stripe", Currency: "USD", Tier: "verified", Amount: 1000, At: time.Date(2026, 11, 8, 0, 0, 0, 0, time.UTC)}, fee: 59},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.query.At.IsZero() {
+				tt.query.At = at
+			}
+			quote, err := schedule.Quote(tt.query)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if quote.FeeAmount != tt.fee || quote.Promotional != tt.promotional {
+				t.Fatalf("expected fee %d (promotional=%v), got %+v", tt.fee, tt.promotional, quote)
+			}
+		})
+	}
+
+	if _, err := schedule.Quote(FeeQuery{Operation: "payout", Currency: "VND", Amount: 1}); !errors.Is(err, ErrFeeOperationInvalid) {
+		t.Fatalf("expected invalid operation error, got %v", err)
+	}
+}
+
+func TestFeeScheduleExemptsChatTransfers(t *testing.T) {
+	schedule, err := ParseFeeSchedule("transfer/*/*/*:*@50+100", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, operation := range []string{FeeOperationRoomTransfer, FeeOperationRedPacket} {
+		quote, err := schedule.Quote(FeeQuery{Operation: operation, Currency: "VND", Tier: "verified", Amount: 1000000})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if quote.FeeAmount != 0 {
+			t.Fatalf("expected %s to be free under the transfer rule, got %+v", operation, quote)
+		}
+		if _, err := ParseFeeRules(operation + "/*/*/*:*@50+0"); !errors.Is(err, ErrFeeScheduleInvalid) {
+			t.Fatalf("expected a %s rule to be rejected, got %v", operation, err)
+		}
+		if _, err := ParseFeePromotions(operation + "/*/*/*:2026-11-01T00:00:00Z~2026-11-08T00:00:00Z"); !errors.Is(err, ErrFeePromotionInvalid) {
+			t.Fatalf("expected a %s promotion to be rejected, got %v", operation, err)
+		}
+	}
+}
+
+func TestCheckQuotedFee(t *testing.T) {
+	quoted := int64(0)
+	if err := CheckQuotedFee(nil, 100); err != nil {
+		t.Fatalf("expected missing quote to be accepted, got %v", err)
+	}
+	if err := CheckQuotedFee(&quoted, 100); !errors.Is(err, ErrFeeQuoteChanged) {
+		t.Fatalf("expected quote changed error, got %v", err)
+	}
+}
+
+func TestWithLegacyFeeRules(t *testing.T) {
+	if rules := WithLegacyFeeRules("top_up/stripe/USD/*:*@290+30", 0, 0, FeeOperationTopUp); rules != "top_up/stripe/USD/*:*@290+30" {
+		t.Fatalf("expected rules to stay unchanged without a legacy fee, got %q", rules)
+	}
+
+	rules := WithLegacyFeeRules("top_up/stripe/USD/*:*@290+30,Withdrawal/*/*/*:*@0+5000", 250, 10,
+		FeeOperationTopUp, FeeOperationWithdrawal, FeeOperationTransfer)
+	schedule, err := ParseFeeSchedule(rules, "")
+	if err != nil {
+		t.Fatalf("expected legacy rules to parse, got %v", err)
+	}
+	if len(schedule.Rules) != 4 {
+		t.Fatalf("expected the withdrawal catch-all to be kept, got %q", rules)
+	}
+
+	for _, tc := range []struct {
+		operation string
+		provider  string
+		fee       int64
+	}{
+		{FeeOperationTopUp, "stripe", 59},
+		{FeeOperationTopUp, "bank_transfer", 35},
+		{FeeOperationWithdrawal, "stripe", 5000},
+		{FeeOperationTransfer, "", 35},
+	} {
+		quote, err := schedule.Quote(FeeQuery{Operation: tc.operation, Provider: tc.provider, Currency: "USD", Amount: 1000})
+		if err != nil {
+			t.Fatalf("quote %s/%s: %v", tc.operation, tc.provider, err)
+		}
+		if quote.FeeAmount != tc.fee {
+			t.Fatalf("expected %s/%s fee %d, got %d", tc.operation, tc.provider, tc.fee, quote.FeeAmount)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package geoip
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+// Location is what the resolver knows about an IP address. Country and ASN are
+// independent; either may be empty when the database has no data for it.
+type Location struct {
+	CountryCode    string
+	ASN            uint32
+	ASOrganization string
+	Latitude       float64
+	Longitude      float64
+	HasCoordinates bool
+}
+
+//go:generate mockgen -package=geoip -destination=geoip_mock.go -source=geoip.go
+type Resolver interface {
+	Lookup(ctx context.Context, ip string) (*Location, bool)
+}
+
+type entry struct {
+	prefix   netip.Prefix
+	location Location
+}
+
+type fileResolver struct {
+	entries []entry
+}
+
+type noopResolver struct{}
+
+// NewResolver loads the database at path. An empty path disables lookups so
+// deployments without a GeoIP file keep working with location signals off.
+func NewResolver(path string) (Resolver, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return noopResolver{}, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, stackErr.Error(fmt.Errorf("open geoip database failed: %w", err))
+	}
+	defer file.Close()
+	return Load(file)
+}
+
+// Load reads a CSV database with the columns
+// network,country_code,asn,as_organization,latitude,longitude.
+// Blank lines, lines starting with '#' and a header row are ignored.
+func Load(reader io.Reader) (Resolver, error) {
+	csvReader := csv.NewReader(bufio.NewReader(reader))
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	entries := make([]entry, 0)
+	line := 0
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("read geoip database line %d failed: %w", line, err))
+		}
+		if len(record) == 0 || strings.EqualFold(strings.TrimSpace(record[0]), "network") {
+			continue
+		}
+		parsed, err := parseRecord(record)
+		if err != nil {
+			return nil, stackErr.Error(fmt.Errorf("parse geoip database line %d failed: %w", line, err))
+		}
+		entries = append(entries, parsed)
+	}
+
+	// Longest prefix first so the first match is the most specific one.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].prefix.Bits() > entries[j].prefix.Bits()
+	})
+	return &fileResolver{entries: entries}, nil
+}
+
+func (r *fileResolver) Lookup(_ context.Context, ip string) (*Location, bool) {
+	addr, ok := ParseClientIP(ip)
+	if !ok {
+		return nil, false
+	}
+	for idx := range r.entries {
+		if r.entries[idx].prefix.Contains(addr) {
+			location := r.entries[idx].location
+			return &location, true
+		}
+	}
+	return nil, false
+}
+
+func (noopResolver) Lookup(context.Context, string) (*Location, bool) {
+	return nil, false
+}
+
+// ParseClientIP accepts a bare address or an X-Forwarded-For list and returns
+// the first (client) address.
+func ParseClientIP(value string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+	if idx := strings.IndexByte(value, ','); idx >= 0 {
+		value = strings.TrimSpace(value[:idx])
+	}
+	if value == "" {
+		return netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		addrPort, portErr := netip.ParseAddrPort(value)
+		if portErr != nil {
+			return netip.Addr{}, false
+		}
+		addr = addrPort.Addr()
+	}
+	return addr.Unmap(), true
+}
+
+func parseRecord(record []string) (entry, error) {
+	for len(record) < 6 {
+		record = append(record, "")
+	}
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(record[0]))
+	if err != nil {
+		return entry{}, err
+	}
+	location := Location{
+		CountryCode:    strings.ToUpper(strings.TrimSpace(record[1])),
+		ASOrganization: strings.TrimSpace(record[3]),
+	}
+	if rawASN := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(record[2])), "AS"); rawASN != "" {
+		asn, err := strconv.ParseUint(rawASN, 10, 32)
+		if err != nil {
+			return entry{}, fmt.Errorf("asn is invalid: %w", err)
+		}
+		location.ASN = uint32(asn)
+	}
+	rawLat, rawLon := strings.TrimSpace(record[4]), strings.TrimSpace(record[5])
+	if rawLat != "" && rawLon != "" {
+		lat, err := strconv.ParseFloat(rawLat, 64)
+		if err != nil {
+			return entry{}, fmt.Errorf("latitude is invalid: %w", err)
+		}
+		lon, err := strconv.ParseFloat(rawLon, 64)
+		if err != nil {
+			return entry{}, fmt.Errorf("longitude is invalid: %w", err)
+		}
+		location.Latitude = lat
+		location.Longitude = lon
+		location.HasCoordinates = true
+	}
+	return entry{prefix: prefix.Masked(), location: location}, nil
+}
@@ -0,0 +1,56 @@
+package geoip
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const testDatabase = `network,country_code,asn,as_organization,latitude,longitude
+# comment lines are skipped
+10.0.0.0/8,VN,AS7552,Viettel,21.0285,105.8542
+10.1.0.0/16,SG,4773,Singtel,1.3521,103.8198
+2001:db8::/32,US,15169,Google,,
+`
+
+func TestResolverLookupPrefersLongestPrefix(t *testing.T) {
+	resolver, err := Load(strings.NewReader(testDatabase))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	location, ok := resolver.Lookup(context.Background(), "10.1.2.3, 172.16.0.1")
+	if !ok {
+		t.Fatalf("Lookup() ok = false, want true")
+	}
+	if location.CountryCode != "SG" || location.ASN != 4773 {
+		t.Fatalf("Lookup() = %+v, want SG/4773", location)
+	}
+
+	location, ok = resolver.Lookup(context.Background(), "10.200.0.1")
+	if !ok || location.CountryCode != "VN" || location.ASN != 7552 || !location.HasCoordinates {
+		t.Fatalf("Lookup() = %+v, %v, want VN/7552 with coordinates", location, ok)
+	}
+
+	location, ok = resolver.Lookup(context.Background(), "2001:db8::1")
+	if !ok || location.CountryCode != "US" || location.HasCoordinates {
+		t.Fatalf("Lookup() = %+v, %v, want US without coordinates", location, ok)
+	}
+
+	if _, ok := resolver.Lookup(context.Background(), "192.168.1.1"); ok {
+		t.Fatalf("Lookup() unknown ip ok = true, want false")
+	}
+	if _, ok := resolver.Lookup(context.Background(), "not-an-ip"); ok {
+		t.Fatalf("Lookup() invalid ip ok = true, want false")
+	}
+}
+
+func TestNewResolverWithoutPathDisablesLookups(t *testing.T) {
+	resolver, err := NewResolver("")
+	if err != nil {
+		t.Fatalf("NewResolver() error = %v", err)
+	}
+	if _, ok := resolver.Lookup(context.Background(), "10.0.0.1"); ok {
+		t.Fatalf("Lookup() ok = true, want false")
+	}
+}
@@ -1,5 +1,6 @@
 package smtp
 
 const (
-	Template_OTP = "otp.html"
+	Template_OTP            = "otp.html"
+	Template_NewDeviceLogin = "new_device_login.html"
 )
@@ -1,8 +1,10 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
 	"time"
@@ -18,6 +20,8 @@ import (
 type Storage interface {
 	PresignedGetObjectURL(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
 	PresignedPutObjectURL(ctx context.Context, objectKey string, expiry time.Duration) (string, time.Time, error)
+	PutObject(ctx context.Context, objectKey, contentType string, content []byte) error
+	GetObject(ctx context.Context, objectKey string) ([]byte, error)
 }
 
 type minioStorage struct {
@@ -98,6 +102,40 @@ func (s *minioStorage) PresignedPutObjectURL(ctx context.Context, objectKey stri
 	return s.publicURL(presignedURL), expiredAt, nil
 }
 
+func (s *minioStorage) PutObject(ctx context.Context, objectKey, contentType string, content []byte) error {
+	objectKey = strings.TrimSpace(objectKey)
+	if objectKey == "" {
+		return stackErr.Error(fmt.Errorf("object key is required"))
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, objectKey, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{
+		ContentType: strings.TrimSpace(contentType),
+	})
+	if err != nil {
+		return stackErr.Error(err)
+	}
+	return nil
+}
+
+func (s *minioStorage) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	objectKey = strings.TrimSpace(objectKey)
+	if objectKey == "" {
+		return nil, stackErr.Error(fmt.Errorf("object key is required"))
+	}
+
+	object, err := s.client.GetObject(ctx, s.bucket, objectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return content, nil
+}
+
 func parsePublicBaseURL(raw string) (*url.URL, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -41,6 +41,21 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// GetObject mocks base method.
+func (m *MockStorage) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", ctx, objectKey)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockStorageMockRecorder) GetObject(ctx, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockStorage)(nil).GetObject), ctx, objectKey)
+}
+
 // PresignedGetObjectURL mocks base method.
 func (m *MockStorage) PresignedGetObjectURL(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
 	m.ctrl.T.Helper()
@@ -71,3 +86,17 @@ func (mr *MockStorageMockRecorder) PresignedPutObjectURL(ctx, objectKey, expiry
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedPutObjectURL", reflect.TypeOf((*MockStorage)(nil).PresignedPutObjectURL), ctx, objectKey, expiry)
 }
+
+// PutObject mocks base method.
+func (m *MockStorage) PutObject(ctx context.Context, objectKey, contentType string, content []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", ctx, objectKey, contentType, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockStorageMockRecorder) PutObject(ctx, objectKey, contentType, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockStorage)(nil).PutObject), ctx, objectKey, contentType, content)
+}
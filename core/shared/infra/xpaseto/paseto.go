@@ -27,6 +27,7 @@ const (
 type PasetoPayload struct {
 	AccountID string
 	Email     string
+	Role      string
 	SessionID string
 	DeviceID  string
 	TokenType TokenType
@@ -153,6 +154,9 @@ func (p *pasetoService) generateToken(account *entity.Account, tokenType TokenTy
 	}
 
 	payload.Set("email", account.Email.Value())
+	if account.Role != "" {
+		payload.Set("role", account.Role.String())
+	}
 	payload.Set("token_use", string(tokenType))
 	if tokenType == TokenTypeRefresh {
 		if subject.SessionID == "" || subject.DeviceID == "" {
@@ -222,6 +226,7 @@ func (p *pasetoService) parseToken(ctx context.Context, token string, expectedTy
 	}
 
 	email := jsonToken.Get("email")
+	role := jsonToken.Get("role")
 	sessionID := jsonToken.Get("session_id")
 	deviceID := jsonToken.Get("device_id")
 	if expectedType == TokenTypeRefresh && (sessionID == "" || deviceID == "") {
@@ -231,6 +236,7 @@ func (p *pasetoService) parseToken(ctx context.Context, token string, expectedTy
 	return &PasetoPayload{
 		AccountID: jsonToken.Subject,
 		Email:     email,
+		Role:      role,
 		SessionID: sessionID,
 		DeviceID:  deviceID,
 		TokenType: expectedType,
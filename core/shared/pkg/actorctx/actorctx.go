@@ -29,6 +29,13 @@ func FromContext(ctx context.Context) (*Actor, bool) {
 	return &actor, true
 }
 
+func (a *Actor) HasRole(role string) bool {
+	if a == nil {
+		return false
+	}
+	return strings.EqualFold(a.Role, strings.TrimSpace(role))
+}
+
 func AccountIDFromContext(ctx context.Context) (string, error) {
 	actor, ok := FromContext(ctx)
 	if !ok {
@@ -0,0 +1,69 @@
+package contactcard
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// FriendRequestSource is the source recorded on friend requests sent after
+	// scanning a contact card.
+	FriendRequestSource = "qr"
+	// FriendRequestSourceSearchUsername and FriendRequestSourceSearchEmail
+	// are the sources a lookup card vouches for.
+	FriendRequestSourceSearchUsername = "search_username"
+	FriendRequestSourceSearchEmail    = "search_email"
+
+	// SharedCardTTL and LookupCardTTL bound how long a card without a nonce
+	// keeps vouching for how the account was found.
+	SharedCardTTL = 7 * 24 * time.Hour
+	LookupCardTTL = 24 * time.Hour
+)
+
+var (
+	ErrSecretEmpty  = errors.New("contact card secret is empty")
+	ErrInvalidToken = errors.New("contact card token is invalid")
+	ErrExpiredToken = errors.New("contact card token has expired")
+)
+
+// Card is the identity a contact-card token vouches for. Nonce changes every
+// time the owner resets their card, which is how old codes are revoked.
+// SharedBy is set on cards shared in chat, which carry no nonce and instead
+// name the account that shared them. Lookup cards are handed out by account
+// searches: Via names the search that found the account and IssuedTo the
+// account that ran it, so the friend request source is not the client's word.
+// Shared and lookup cards cannot be revoked by a reset, so they carry
+// ExpiresAt instead.
+type Card struct {
+	AccountID string
+	Nonce     string
+	SharedBy  string
+	Via       string
+	IssuedTo  string
+	ExpiresAt time.Time
+}
+
+//go:generate mockgen -package=contactcard -destination=contactcard_mock.go -source=contactcard.go
+type Signer interface {
+	Sign(card Card) (string, error)
+	Parse(token string) (Card, error)
+}
+
+// ExtractToken accepts either a bare token or a scanned link carrying the
+// token in its "token" query parameter.
+func ExtractToken(scanned string) string {
+	scanned = strings.TrimSpace(scanned)
+	if !strings.Contains(scanned, "://") {
+		return scanned
+	}
+	parsed, err := url.Parse(scanned)
+	if err != nil {
+		return scanned
+	}
+	if token := strings.TrimSpace(parsed.Query().Get("token")); token != "" {
+		return token
+	}
+	return scanned
+}
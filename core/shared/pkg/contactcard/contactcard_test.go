@@ -0,0 +1,121 @@
+package contactcard
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHMACSignerRoundTrip(t *testing.T) {
+	signer, err := NewHMACSigner("secret")
+	if err != nil {
+		t.Fatalf("NewHMACSigner() error = %v", err)
+	}
+
+	token, err := signer.Sign(Card{AccountID: "account-1", Nonce: "nonce-1"})
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	card, err := signer.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if card.AccountID != "account-1" || card.Nonce != "nonce-1" {
+		t.Fatalf("Parse() = %+v, want account-1/nonce-1", card)
+	}
+
+	other, err := NewHMACSigner("other-secret")
+	if err != nil {
+		t.Fatalf("NewHMACSigner() error = %v", err)
+	}
+	if _, err := other.Parse(token); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Parse() with other secret error = %v, want %v", err, ErrInvalidToken)
+	}
+	if _, err := signer.Parse(token + "x"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Parse() tampered token error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestHMACSignerSharedCard(t *testing.T) {
+	signer, err := NewHMACSigner("secret")
+	if err != nil {
+		t.Fatalf("NewHMACSigner() error = %v", err)
+	}
+
+	expiresAt := time.Now().UTC().Add(SharedCardTTL).Truncate(time.Second)
+	token, err := signer.Sign(Card{AccountID: "account-1", SharedBy: "account-2", ExpiresAt: expiresAt})
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	card, err := signer.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if card.AccountID != "account-1" || card.Nonce != "" || card.SharedBy != "account-2" || !card.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("Parse() = %+v, want account-1 shared by account-2 until %v", card, expiresAt)
+	}
+
+	if _, err := signer.Sign(Card{AccountID: "account-1"}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Sign() without nonce or sharer error = %v, want %v", err, ErrInvalidToken)
+	}
+	if _, err := signer.Sign(Card{AccountID: "account-1", SharedBy: "account-2"}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Sign() shared card without expiry error = %v, want %v", err, ErrInvalidToken)
+	}
+	if _, err := signer.Sign(Card{AccountID: "account-1", Nonce: "nonce-1", SharedBy: "account-2", ExpiresAt: expiresAt}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Sign() shared card with nonce error = %v, want %v", err, ErrInvalidToken)
+	}
+
+	expired, err := signer.Sign(Card{AccountID: "account-1", SharedBy: "account-2", ExpiresAt: time.Now().Add(-time.Minute)})
+	if err != nil {
+		t.Fatalf("Sign() expired card error = %v", err)
+	}
+	if _, err := signer.Parse(expired); !errors.Is(err, ErrExpiredToken) {
+		t.Fatalf("Parse() expired card error = %v, want %v", err, ErrExpiredToken)
+	}
+}
+
+func TestHMACSignerLookupCard(t *testing.T) {
+	signer, err := NewHMACSigner("secret")
+	if err != nil {
+		t.Fatalf("NewHMACSigner() error = %v", err)
+	}
+
+	token, err := signer.Sign(Card{AccountID: "account-1", Via: FriendRequestSourceSearchUsername, IssuedTo: "account-2", ExpiresAt: time.Now().Add(LookupCardTTL)})
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	card, err := signer.Parse(token)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if card.AccountID != "account-1" || card.Via != FriendRequestSourceSearchUsername || card.IssuedTo != "account-2" {
+		t.Fatalf("Parse() = %+v, want account-1 found by account-2 through username search", card)
+	}
+	if card.Nonce != "" || card.SharedBy != "" {
+		t.Fatalf("Parse() = %+v, want no nonce or sharer on a lookup card", card)
+	}
+
+	if _, err := signer.Sign(Card{AccountID: "account-1", Via: FriendRequestSourceSearchEmail, ExpiresAt: time.Now().Add(LookupCardTTL)}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Sign() without IssuedTo error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestExtractToken(t *testing.T) {
+	if got := ExtractToken(" c1.abc.def "); got != "c1.abc.def" {
+		t.Fatalf("ExtractToken(bare) = %q", got)
+	}
+	if got := ExtractToken("https://example.com/contact-card?token=c1.abc.def"); got != "c1.abc.def" {
+		t.Fatalf("ExtractToken(link) = %q", got)
+	}
+}
+
+func TestRenderPNG(t *testing.T) {
+	png, err := RenderPNG("c1.abc.def", 0)
+	if err != nil {
+		t.Fatalf("RenderPNG() error = %v", err)
+	}
+	if !bytes.HasPrefix(png, []byte("\x89PNG")) {
+		t.Fatalf("RenderPNG() did not return a PNG image")
+	}
+}
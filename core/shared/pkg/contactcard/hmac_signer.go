@@ -0,0 +1,118 @@
+package contactcard
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+	"wechat-clone/core/shared/pkg/stackErr"
+)
+
+const tokenVersion = "c1"
+
+type hmacSigner struct {
+	secret []byte
+}
+
+func NewHMACSigner(secret string) (Signer, error) {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, stackErr.Error(ErrSecretEmpty)
+	}
+	return &hmacSigner{secret: []byte(secret)}, nil
+}
+
+// Sign renders the card as "c1.<payload>.<signature>", URL-safe so it can be
+// embedded in links and QR codes as-is. The payload is colon-joined:
+// "account:nonce" for QR cards, "account:nonce:sharer:expiry" for shared
+// cards and "account:nonce:sharer:via:issuedTo:expiry" for lookup cards.
+func (s *hmacSigner) Sign(card Card) (string, error) {
+	if card.AccountID == "" || !card.vouches() {
+		return "", stackErr.Error(ErrInvalidToken)
+	}
+	fields := []string{card.AccountID, card.Nonce}
+	switch {
+	case card.Via != "":
+		fields = append(fields, card.SharedBy, card.Via, card.IssuedTo)
+	case card.SharedBy != "":
+		fields = append(fields, card.SharedBy)
+	}
+	if len(fields) > 2 {
+		fields = append(fields, strconv.FormatInt(card.ExpiresAt.Unix(), 10))
+	}
+	for _, field := range fields {
+		if strings.Contains(field, ":") {
+			return "", stackErr.Error(ErrInvalidToken)
+		}
+	}
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strings.Join(fields, ":")))
+	return tokenVersion + "." + payload + "." + s.signature(payload), nil
+}
+
+// Parse verifies the signature and rejects shared and lookup cards past their
+// expiry with ErrExpiredToken.
+func (s *hmacSigner) Parse(token string) (Card, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 || parts[0] != tokenVersion {
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(parts[1]))) {
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+	fields := strings.Split(string(raw), ":")
+	if fields[0] == "" {
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+	card := Card{AccountID: fields[0]}
+	switch len(fields) {
+	case 2:
+		card.Nonce = fields[1]
+	case 4:
+		card.Nonce, card.SharedBy = fields[1], fields[2]
+	case 6:
+		card.Nonce, card.SharedBy, card.Via, card.IssuedTo = fields[1], fields[2], fields[3], fields[4]
+	default:
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+	if len(fields) > 2 {
+		expiresAt, err := strconv.ParseInt(fields[len(fields)-1], 10, 64)
+		if err != nil {
+			return Card{}, stackErr.Error(ErrInvalidToken)
+		}
+		card.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+	}
+	if !card.vouches() {
+		return Card{}, stackErr.Error(ErrInvalidToken)
+	}
+	if !card.ExpiresAt.IsZero() && !time.Now().Before(card.ExpiresAt) {
+		return Card{}, stackErr.Error(ErrExpiredToken)
+	}
+	return card, nil
+}
+
+// vouches reports whether the card names exactly one way of having found the
+// account: a current QR nonce, an expiring share, or an expiring lookup run by
+// IssuedTo.
+func (c Card) vouches() bool {
+	switch {
+	case c.Via != "":
+		return c.IssuedTo != "" && c.Nonce == "" && c.SharedBy == "" && !c.ExpiresAt.IsZero()
+	case c.SharedBy != "":
+		return c.Nonce == "" && c.IssuedTo == "" && !c.ExpiresAt.IsZero()
+	default:
+		return c.Nonce != "" && c.IssuedTo == "" && c.ExpiresAt.IsZero()
+	}
+}
+
+func (s *hmacSigner) signature(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(tokenVersion + "." + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
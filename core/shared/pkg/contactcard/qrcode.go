@@ -0,0 +1,22 @@
+package contactcard
+
+import (
+	"wechat-clone/core/shared/pkg/stackErr"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const DefaultQRSize = 512
+
+// RenderPNG encodes content as a QR code PNG with medium error correction,
+// which leaves room for a small logo overlay on the client.
+func RenderPNG(content string, size int) ([]byte, error) {
+	if size <= 0 {
+		size = DefaultQRSize
+	}
+	png, err := qrcode.Encode(content, qrcode.Medium, size)
+	if err != nil {
+		return nil, stackErr.Error(err)
+	}
+	return png, nil
+}
@@ -0,0 +1,107 @@
+// Package textpdf renders plain text lines as a minimal PDF document. It
+// uses the built-in Courier font, so no font files are embedded and columns
+// padded with spaces stay aligned.
+package textpdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth    = 595 // A4 in points
+	pageHeight   = 842
+	margin       = 40
+	fontSize     = 8
+	lineHeight   = 11
+	linesPerPage = (pageHeight - 2*margin) / lineHeight
+	// maxLineRunes is how many Courier glyphs fit between the margins.
+	maxLineRunes = (pageWidth - 2*margin) * 10 / (fontSize * 6)
+)
+
+// Render lays the lines out top to bottom, starting a new page when one is
+// full. Lines longer than the printable width are truncated and characters
+// outside printable ASCII are replaced with '?'.
+func Render(lines []string) []byte {
+	pages := paginate(lines)
+
+	var buf bytes.Buffer
+	offsets := make([]int, 0, 3+2*len(pages))
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		content := pageContent(page)
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 5+2*i,
+		))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xrefOffset)
+
+	return buf.Bytes()
+}
+
+func paginate(lines []string) [][]string {
+	if len(lines) == 0 {
+		return [][]string{{}}
+	}
+
+	pages := make([][]string, 0, len(lines)/linesPerPage+1)
+	for start := 0; start < len(lines); start += linesPerPage {
+		end := min(start+linesPerPage, len(lines))
+		pages = append(pages, lines[start:end])
+	}
+	return pages
+}
+
+func pageContent(lines []string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, lineHeight, margin, pageHeight-margin)
+	for _, line := range lines {
+		fmt.Fprintf(&content, "(%s) Tj T*\n", escape(line))
+	}
+	content.WriteString("ET")
+	return content.String()
+}
+
+func escape(line string) string {
+	var escaped strings.Builder
+	count := 0
+	for _, r := range line {
+		if count == maxLineRunes {
+			break
+		}
+		count++
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			escaped.WriteByte('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
@@ -7,6 +7,7 @@ import (
 
 	appCtx "wechat-clone/core/context"
 	accountassembly "wechat-clone/core/modules/account/assembly"
+	foreignexchangeassembly "wechat-clone/core/modules/foreign_exchange/assembly"
 	ledgerassembly "wechat-clone/core/modules/ledger/assembly"
 	notificationassembly "wechat-clone/core/modules/notification/assembly"
 	paymentassembly "wechat-clone/core/modules/payment/assembly"
@@ -134,11 +135,31 @@ func (s *appServer) buildModuleRuntimes(appContext *appCtx.AppContext) error {
 		return stackErr.Error(fmt.Errorf("build room projection runtime failed: %w", err))
 	}
 
+	roomTaskRuntime, err := roomassembly.BuildTaskRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build room task runtime failed: %w", err))
+	}
+
+	roomCronRuntime, err := roomassembly.BuildCronRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build room cron runtime failed: %w", err))
+	}
+
 	relationshipMessagingRuntime, err := relationshipassembly.BuildMessagingRuntime(s.cfg, appContext)
 	if err != nil {
 		return stackErr.Error(fmt.Errorf("build relationship messaging runtime failed: %w", err))
 	}
 
+	relationshipTaskRuntime, err := relationshipassembly.BuildTaskRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build relationship task runtime failed: %w", err))
+	}
+
+	relationshipCronRuntime, err := relationshipassembly.BuildCronRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build relationship cron runtime failed: %w", err))
+	}
+
 	paymentMessagingRuntime, err := paymentassembly.BuildMessagingRuntime(s.cfg, appContext)
 	if err != nil {
 		return stackErr.Error(fmt.Errorf("build payment messaging runtime failed: %w", err))
@@ -154,15 +175,31 @@ func (s *appServer) buildModuleRuntimes(appContext *appCtx.AppContext) error {
 		return stackErr.Error(fmt.Errorf("build payment cron runtime failed: %w", err))
 	}
 
+	fxTaskRuntime, err := foreignexchangeassembly.BuildTaskRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build foreign exchange task runtime failed: %w", err))
+	}
+
+	fxCronRuntime, err := foreignexchangeassembly.BuildCronRuntime(s.cfg, appContext)
+	if err != nil {
+		return stackErr.Error(fmt.Errorf("build foreign exchange cron runtime failed: %w", err))
+	}
+
 	s.moduleRuntimes = []modruntime.Module{
 		notificationRuntime,
 		accountProjectionRuntime,
 		roomProjectionRuntime,
+		roomTaskRuntime,
+		roomCronRuntime,
 		relationshipMessagingRuntime,
+		relationshipTaskRuntime,
+		relationshipCronRuntime,
 		ledgerProjectionRuntime,
 		paymentMessagingRuntime,
 		paymentTaskRuntime,
 		paymentCronRuntime,
+		fxTaskRuntime,
+		fxCronRuntime,
 	}
 	return nil
 }
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	PaymentService_CreatePaymentIntent_FullMethodName    = "/payment.v1.PaymentService/CreatePaymentIntent"
 	PaymentService_ProcessProviderWebhook_FullMethodName = "/payment.v1.PaymentService/ProcessProviderWebhook"
+	PaymentService_CreateWithdrawal_FullMethodName       = "/payment.v1.PaymentService/CreateWithdrawal"
 	PaymentService_RefundPayment_FullMethodName          = "/payment.v1.PaymentService/RefundPayment"
 )
 
@@ -30,6 +31,7 @@ const (
 type PaymentServiceClient interface {
 	CreatePaymentIntent(ctx context.Context, in *CreatePaymentIntentRequest, opts ...grpc.CallOption) (*CreatePaymentIntentResponse, error)
 	ProcessProviderWebhook(ctx context.Context, in *ProcessProviderWebhookRequest, opts ...grpc.CallOption) (*ProcessProviderWebhookResponse, error)
+	CreateWithdrawal(ctx context.Context, in *CreateWithdrawalRequest, opts ...grpc.CallOption) (*CreateWithdrawalResponse, error)
 	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
 }
 
@@ -59,6 +61,15 @@ func (c *paymentServiceClient) ProcessProviderWebhook(ctx context.Context, in *P
 	return out, nil
 }
 
+func (c *paymentServiceClient) CreateWithdrawal(ctx context.Context, in *CreateWithdrawalRequest, opts ...grpc.CallOption) (*CreateWithdrawalResponse, error) {
+	out := new(CreateWithdrawalResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreateWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
 	out := new(RefundPaymentResponse)
 	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, opts...)
@@ -74,6 +85,7 @@ func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaym
 type PaymentServiceServer interface {
 	CreatePaymentIntent(context.Context, *CreatePaymentIntentRequest) (*CreatePaymentIntentResponse, error)
 	ProcessProviderWebhook(context.Context, *ProcessProviderWebhookRequest) (*ProcessProviderWebhookResponse, error)
+	CreateWithdrawal(context.Context, *CreateWithdrawalRequest) (*CreateWithdrawalResponse, error)
 	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
@@ -88,6 +100,9 @@ func (UnimplementedPaymentServiceServer) CreatePaymentIntent(context.Context, *C
 func (UnimplementedPaymentServiceServer) ProcessProviderWebhook(context.Context, *ProcessProviderWebhookRequest) (*ProcessProviderWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessProviderWebhook not implemented")
 }
+func (UnimplementedPaymentServiceServer) CreateWithdrawal(context.Context, *CreateWithdrawalRequest) (*CreateWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWithdrawal not implemented")
+}
 func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
 }
@@ -140,6 +155,24 @@ func _PaymentService_ProcessProviderWebhook_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_CreateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateWithdrawal(ctx, req.(*CreateWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefundPaymentRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +206,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessProviderWebhook",
 			Handler:    _PaymentService_ProcessProviderWebhook_Handler,
 		},
+		{
+			MethodName: "CreateWithdrawal",
+			Handler:    _PaymentService_CreateWithdrawal_Handler,
+		},
 		{
 			MethodName: "RefundPayment",
 			Handler:    _PaymentService_RefundPayment_Handler,
@@ -46,6 +46,7 @@ func AuthenMiddleware(appCtx *appCtx.AppContext) gin.HandlerFunc {
 		ctx := actorctx.WithActor(c.Request.Context(), actorctx.Actor{
 			AccountID: claims.AccountID,
 			Email:     claims.Email,
+			Role:      claims.Role,
 		})
 		ctx = context.WithValue(ctx, accountContextKey, claims)
 		c.Request = c.Request.WithContext(ctx)
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS trg_admin_audit_logs_immutable ON admin_audit_logs;
+
+DROP FUNCTION IF EXISTS trg_admin_audit_logs_immutable_fn();
+
+DROP TABLE IF EXISTS admin_audit_logs;
+
+DROP INDEX IF EXISTS idx_accounts_banned_until;
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS ck_accounts_role;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS role;
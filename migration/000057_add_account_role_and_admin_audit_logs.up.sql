@@ -0,0 +1,36 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS role VARCHAR(20) DEFAULT 'user' NOT NULL;
+
+ALTER TABLE accounts
+  ADD CONSTRAINT ck_accounts_role
+  CHECK (role IN ('user', 'admin'));
+
+CREATE INDEX IF NOT EXISTS idx_accounts_banned_until
+    ON accounts (banned_until)
+    WHERE banned_until IS NOT NULL;
+
+CREATE TABLE admin_audit_logs (
+    id                 VARCHAR(36)   NOT NULL,
+    actor_account_id   VARCHAR(36)   NOT NULL,
+    action             VARCHAR(64)   NOT NULL,
+    target_account_id  VARCHAR(36)   NOT NULL,
+    before_value       TEXT          NOT NULL,
+    after_value        TEXT          NOT NULL,
+    reason             VARCHAR(1024) DEFAULT '' NOT NULL,
+    request_id         VARCHAR(128)  DEFAULT '' NOT NULL,
+    created_at         TIMESTAMPTZ   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT pk_admin_audit_logs PRIMARY KEY (id)
+);
+
+CREATE INDEX ix_aal_actor_created  ON admin_audit_logs (actor_account_id, created_at DESC);
+CREATE INDEX ix_aal_target_created ON admin_audit_logs (target_account_id, created_at DESC);
+CREATE INDEX ix_aal_action_created ON admin_audit_logs (action, created_at DESC);
+CREATE INDEX ix_aal_request        ON admin_audit_logs (request_id);
+
+CREATE OR REPLACE FUNCTION trg_admin_audit_logs_immutable_fn() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER trg_admin_audit_logs_immutable
+    BEFORE UPDATE OR DELETE ON admin_audit_logs
+    FOR EACH ROW EXECUTE FUNCTION trg_admin_audit_logs_immutable_fn();
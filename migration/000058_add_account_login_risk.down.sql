@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS account_login_attempts;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,22 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+CREATE TABLE account_login_attempts (
+    id            VARCHAR(36)      NOT NULL,
+    account_id    VARCHAR(36)      NOT NULL,
+    device_uid    VARCHAR(255)     DEFAULT '' NOT NULL,
+    ip_address    VARCHAR(64)      DEFAULT '' NOT NULL,
+    user_agent    VARCHAR(1024)    DEFAULT '' NOT NULL,
+    country_code  VARCHAR(2)       DEFAULT '' NOT NULL,
+    asn           BIGINT           DEFAULT 0 NOT NULL,
+    latitude      DOUBLE PRECISION,
+    longitude     DOUBLE PRECISION,
+    outcome       VARCHAR(32)      NOT NULL,
+    risk_score    INTEGER          DEFAULT 0 NOT NULL,
+    risk_signals  VARCHAR(255)     DEFAULT '' NOT NULL,
+    created_at    TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT pk_account_login_attempts PRIMARY KEY (id),
+    CONSTRAINT ck_account_login_attempts_outcome
+        CHECK (outcome IN ('succeeded', 'invalid_password', 'step_up_required', 'step_up_failed', 'locked'))
+);
+
+CREATE INDEX ix_login_attempts_account_created ON account_login_attempts (account_id, created_at DESC);
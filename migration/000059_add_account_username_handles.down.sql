@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS account_block_projections;
+
+DROP TABLE IF EXISTS account_username_reservations;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS username_changed_at;
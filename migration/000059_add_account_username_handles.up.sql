@@ -0,0 +1,20 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS username_changed_at TIMESTAMPTZ;
+
+CREATE TABLE account_username_reservations (
+    username_key    VARCHAR(100)  NOT NULL,
+    account_id      VARCHAR(36)   NOT NULL,
+    reserved_until  TIMESTAMPTZ   NOT NULL,
+    created_at      TIMESTAMPTZ   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT pk_account_username_reservations PRIMARY KEY (username_key)
+);
+
+CREATE INDEX ix_aur_reserved_until ON account_username_reservations (reserved_until);
+
+CREATE TABLE account_block_projections (
+    blocker_id  VARCHAR(36)  NOT NULL,
+    blocked_id  VARCHAR(36)  NOT NULL,
+    blocked_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_account_block_projections PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE INDEX ix_abp_blocked ON account_block_projections (blocked_id);
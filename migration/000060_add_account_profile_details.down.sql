@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS account_friend_projections;
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS ck_accounts_gender;
+ALTER TABLE accounts DROP COLUMN IF EXISTS profile_privacy;
+ALTER TABLE accounts DROP COLUMN IF EXISTS cover_object_key;
+ALTER TABLE accounts DROP COLUMN IF EXISTS birthday;
+ALTER TABLE accounts DROP COLUMN IF EXISTS gender;
+ALTER TABLE accounts DROP COLUMN IF EXISTS region;
+ALTER TABLE accounts DROP COLUMN IF EXISTS bio;
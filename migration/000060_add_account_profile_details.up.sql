@@ -0,0 +1,18 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS bio VARCHAR(200) DEFAULT '' NOT NULL;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS region VARCHAR(100) DEFAULT '' NOT NULL;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS gender VARCHAR(16) DEFAULT 'unspecified' NOT NULL;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS birthday DATE;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS cover_object_key VARCHAR(1024);
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS profile_privacy TEXT DEFAULT '{}' NOT NULL;
+
+ALTER TABLE accounts ADD CONSTRAINT ck_accounts_gender
+    CHECK (gender IN ('unspecified', 'male', 'female', 'other'));
+
+CREATE TABLE account_friend_projections (
+    account_low_id   VARCHAR(36)  NOT NULL,
+    account_high_id  VARCHAR(36)  NOT NULL,
+    since            TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_account_friend_projections PRIMARY KEY (account_low_id, account_high_id)
+);
+
+CREATE INDEX ix_afp_high ON account_friend_projections (account_high_id);
@@ -0,0 +1,4 @@
+ALTER TABLE relationship_friend_requests DROP COLUMN IF EXISTS source;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS contact_card_rotated_at;
+ALTER TABLE accounts DROP COLUMN IF EXISTS contact_card_nonce;
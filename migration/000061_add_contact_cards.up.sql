@@ -0,0 +1,10 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS contact_card_nonce VARCHAR(64) DEFAULT '' NOT NULL;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS contact_card_rotated_at TIMESTAMPTZ;
+
+-- Existing accounts get a card straight away; new ones are issued one at registration.
+UPDATE accounts
+SET contact_card_nonce = md5(random()::text || id),
+    contact_card_rotated_at = CURRENT_TIMESTAMP
+WHERE contact_card_nonce = '';
+
+ALTER TABLE relationship_friend_requests ADD COLUMN IF NOT EXISTS source VARCHAR(20) DEFAULT 'direct' NOT NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE room_blocks (
+    blocker_id  VARCHAR(36)  NOT NULL,
+    blocked_id  VARCHAR(36)  NOT NULL,
+    blocked_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_room_blocks PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE INDEX ix_rb_blocked ON room_blocks (blocked_id);
+
+INSERT INTO room_blocks (blocker_id, blocked_id, blocked_at)
+SELECT blocker_id, blocked_id, created_at
+FROM relationship_blocks
+ON CONFLICT (blocker_id, blocked_id) DO NOTHING;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_friend_requests_pending_created;
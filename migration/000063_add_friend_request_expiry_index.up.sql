@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_friend_requests_pending_created
+ON relationship_friend_requests (created_at)
+WHERE status = 'PENDING';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS room_contact_remarks;
+DROP TABLE IF EXISTS relationship_contact_tag_members;
+DROP TABLE IF EXISTS relationship_contact_tags;
+DROP TABLE IF EXISTS relationship_friend_contacts;
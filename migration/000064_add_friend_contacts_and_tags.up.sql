@@ -0,0 +1,42 @@
+CREATE TABLE relationship_friend_contacts (
+    owner_id          VARCHAR(36)   NOT NULL,
+    friend_id         VARCHAR(36)   NOT NULL,
+    remark_name       VARCHAR(64)   NOT NULL DEFAULT '',
+    description       VARCHAR(500)  NOT NULL DEFAULT '',
+    phone_notes_json  TEXT          NOT NULL DEFAULT '[]',
+    created_at        TIMESTAMPTZ   NOT NULL,
+    updated_at        TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT pk_relationship_friend_contacts PRIMARY KEY (owner_id, friend_id)
+);
+
+CREATE TABLE relationship_contact_tags (
+    id          VARCHAR(36)  NOT NULL,
+    owner_id    VARCHAR(36)  NOT NULL,
+    name        VARCHAR(32)  NOT NULL,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_relationship_contact_tags PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX ux_contact_tags_owner_name ON relationship_contact_tags (owner_id, LOWER(name));
+CREATE INDEX idx_contact_tags_owner_created ON relationship_contact_tags (owner_id, created_at);
+
+CREATE TABLE relationship_contact_tag_members (
+    tag_id      VARCHAR(36)  NOT NULL,
+    friend_id   VARCHAR(36)  NOT NULL,
+    owner_id    VARCHAR(36)  NOT NULL,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_relationship_contact_tag_members PRIMARY KEY (tag_id, friend_id),
+    CONSTRAINT fk_contact_tag_members_tag FOREIGN KEY (tag_id)
+        REFERENCES relationship_contact_tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_contact_tag_members_owner_friend ON relationship_contact_tag_members (owner_id, friend_id);
+
+CREATE TABLE room_contact_remarks (
+    owner_id     VARCHAR(36)  NOT NULL,
+    target_id    VARCHAR(36)  NOT NULL,
+    remark_name  VARCHAR(64)  NOT NULL,
+    updated_at   TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_room_contact_remarks PRIMARY KEY (owner_id, target_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS relationship_suggestion_room_members;
+DROP TABLE IF EXISTS relationship_friend_suggestion_dismissals;
+DROP TABLE IF EXISTS relationship_friend_suggestions;
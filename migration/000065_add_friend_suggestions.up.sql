@@ -0,0 +1,28 @@
+CREATE TABLE relationship_friend_suggestions (
+    user_id                 VARCHAR(36)       NOT NULL,
+    candidate_id            VARCHAR(36)       NOT NULL,
+    mutual_friend_count     INTEGER           NOT NULL DEFAULT 0,
+    shared_room_count       INTEGER           NOT NULL DEFAULT 0,
+    profile_view_count      INTEGER           NOT NULL DEFAULT 0,
+    last_profile_viewed_at  TIMESTAMPTZ       NULL,
+    score                   DOUBLE PRECISION  NOT NULL DEFAULT 0,
+    updated_at              TIMESTAMPTZ       NOT NULL,
+    CONSTRAINT pk_relationship_friend_suggestions PRIMARY KEY (user_id, candidate_id)
+);
+
+CREATE INDEX idx_friend_suggestions_user_score ON relationship_friend_suggestions (user_id, score DESC);
+
+CREATE TABLE relationship_friend_suggestion_dismissals (
+    user_id       VARCHAR(36)  NOT NULL,
+    candidate_id  VARCHAR(36)  NOT NULL,
+    dismissed_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_relationship_friend_suggestion_dismissals PRIMARY KEY (user_id, candidate_id)
+);
+
+CREATE TABLE relationship_suggestion_room_members (
+    room_id     VARCHAR(36)  NOT NULL,
+    account_id  VARCHAR(36)  NOT NULL,
+    CONSTRAINT pk_relationship_suggestion_room_members PRIMARY KEY (room_id, account_id)
+);
+
+CREATE INDEX idx_suggestion_room_members_account ON relationship_suggestion_room_members (account_id);
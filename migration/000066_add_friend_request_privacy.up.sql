@@ -0,0 +1,10 @@
+CREATE TABLE relationship_friend_request_privacy (
+    account_id                VARCHAR(36)  NOT NULL,
+    allow_requests_from       VARCHAR(32)  NOT NULL,
+    findable_by_username      BOOLEAN      NOT NULL,
+    findable_by_email         BOOLEAN      NOT NULL,
+    findable_by_qr            BOOLEAN      NOT NULL,
+    allow_from_shared_groups  BOOLEAN      NOT NULL,
+    updated_at                TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_relationship_friend_request_privacy PRIMARY KEY (account_id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS room_restrictions;
+DROP TABLE IF EXISTS account_audience_projections;
+DROP INDEX IF EXISTS idx_friend_contacts_owner_audience;
+ALTER TABLE relationship_friend_contacts DROP COLUMN IF EXISTS audience_list;
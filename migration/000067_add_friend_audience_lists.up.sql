@@ -0,0 +1,18 @@
+ALTER TABLE relationship_friend_contacts ADD COLUMN audience_list VARCHAR(32) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_friend_contacts_owner_audience ON relationship_friend_contacts (owner_id, audience_list, friend_id);
+
+CREATE TABLE account_audience_projections (
+    owner_id       VARCHAR(36)  NOT NULL,
+    friend_id      VARCHAR(36)  NOT NULL,
+    audience_list  VARCHAR(32)  NOT NULL,
+    updated_at     TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_account_audience_projections PRIMARY KEY (owner_id, friend_id)
+);
+
+CREATE TABLE room_restrictions (
+    owner_id       VARCHAR(36)  NOT NULL,
+    restricted_id  VARCHAR(36)  NOT NULL,
+    restricted_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_room_restrictions PRIMARY KEY (owner_id, restricted_id)
+);
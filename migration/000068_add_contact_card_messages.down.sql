@@ -0,0 +1,4 @@
+ALTER TABLE relationship_friend_requests DROP COLUMN IF EXISTS shared_by_id;
+DROP INDEX IF EXISTS idx_messages_contact_account_id;
+ALTER TABLE messages DROP COLUMN IF EXISTS contact_card_json;
+ALTER TABLE messages DROP COLUMN IF EXISTS contact_account_id;
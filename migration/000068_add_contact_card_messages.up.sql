@@ -0,0 +1,6 @@
+ALTER TABLE messages ADD COLUMN contact_account_id VARCHAR(36) NULL;
+ALTER TABLE messages ADD COLUMN contact_card_json TEXT NULL;
+
+CREATE INDEX idx_messages_contact_account_id ON messages (contact_account_id);
+
+ALTER TABLE relationship_friend_requests ADD COLUMN shared_by_id VARCHAR(36) NULL;
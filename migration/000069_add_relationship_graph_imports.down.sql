@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS relationship_graph_import_rows;
+DROP TABLE IF EXISTS relationship_graph_import_jobs;
@@ -0,0 +1,29 @@
+CREATE TABLE relationship_graph_import_jobs (
+    id             VARCHAR(36)  NOT NULL,
+    requested_by   VARCHAR(36)  NOT NULL,
+    format         VARCHAR(10)  NOT NULL,
+    status         VARCHAR(20)  NOT NULL,
+    total_rows     INTEGER      NOT NULL,
+    next_row       INTEGER      NOT NULL,
+    imported_rows  INTEGER      NOT NULL DEFAULT 0,
+    skipped_rows   INTEGER      NOT NULL DEFAULT 0,
+    failed_rows    INTEGER      NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ  NOT NULL,
+    updated_at     TIMESTAMPTZ  NOT NULL,
+    started_at     TIMESTAMPTZ  NULL,
+    completed_at   TIMESTAMPTZ  NULL,
+    CONSTRAINT pk_relationship_graph_import_jobs PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_graph_import_jobs_status_created ON relationship_graph_import_jobs (status, created_at);
+
+CREATE TABLE relationship_graph_import_rows (
+    job_id      VARCHAR(36)   NOT NULL,
+    row_number  INTEGER       NOT NULL,
+    user_id     VARCHAR(36)   NOT NULL,
+    target_id   VARCHAR(36)   NOT NULL,
+    relation    VARCHAR(20)   NOT NULL,
+    status      VARCHAR(20)   NOT NULL,
+    error       VARCHAR(500)  NOT NULL DEFAULT '',
+    CONSTRAINT pk_relationship_graph_import_rows PRIMARY KEY (job_id, row_number)
+);
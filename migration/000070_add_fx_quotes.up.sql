@@ -0,0 +1,19 @@
+CREATE TABLE fx_quotes (
+    id             VARCHAR(36)     NOT NULL,
+    account_id     VARCHAR(36)     NOT NULL,
+    from_currency  VARCHAR(3)      NOT NULL,
+    to_currency    VARCHAR(3)      NOT NULL,
+    from_amount    BIGINT          NOT NULL,
+    to_amount      BIGINT          NOT NULL,
+    mid_rate       NUMERIC(24, 8)  NOT NULL,
+    customer_rate  NUMERIC(24, 8)  NOT NULL,
+    spread_bps     BIGINT          NOT NULL,
+    rate_source    VARCHAR(32)     NOT NULL,
+    purpose        VARCHAR(20)     NOT NULL,
+    status         VARCHAR(20)     NOT NULL,
+    created_at     TIMESTAMPTZ     NOT NULL,
+    expires_at     TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT pk_fx_quotes PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_fx_quotes_account_created ON fx_quotes (account_id, created_at);
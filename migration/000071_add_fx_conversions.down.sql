@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS fx_conversions;
+
+ALTER TABLE fx_quotes DROP COLUMN IF EXISTS consumed_at;
@@ -0,0 +1,22 @@
+ALTER TABLE fx_quotes ADD COLUMN consumed_at TIMESTAMPTZ;
+
+CREATE TABLE fx_conversions (
+    id               VARCHAR(36)     NOT NULL,
+    account_id       VARCHAR(36)     NOT NULL,
+    quote_id         VARCHAR(36)     NOT NULL,
+    idempotency_key  VARCHAR(128)    NOT NULL,
+    from_currency    VARCHAR(3)      NOT NULL,
+    to_currency      VARCHAR(3)      NOT NULL,
+    from_amount      BIGINT          NOT NULL,
+    to_amount        BIGINT          NOT NULL,
+    customer_rate    NUMERIC(24, 8)  NOT NULL,
+    spread_amount    BIGINT          NOT NULL,
+    status           VARCHAR(20)     NOT NULL,
+    failure_reason   VARCHAR(255)    NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ     NOT NULL,
+    updated_at       TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT pk_fx_conversions PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX uq_fx_conversions_account_idempotency ON fx_conversions (account_id, idempotency_key);
+CREATE UNIQUE INDEX uq_fx_conversions_quote ON fx_conversions (quote_id);
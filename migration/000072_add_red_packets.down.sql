@@ -0,0 +1,3 @@
+ALTER TABLE messages DROP COLUMN IF EXISTS red_packet_json;
+DROP TABLE IF EXISTS red_packet_claims;
+DROP TABLE IF EXISTS red_packets;
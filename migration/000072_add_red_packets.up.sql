@@ -0,0 +1,35 @@
+CREATE TABLE red_packets (
+    id                VARCHAR(36)     NOT NULL,
+    room_id           VARCHAR(1024)   NOT NULL,
+    sender_id         VARCHAR(36)     NOT NULL,
+    message_id        VARCHAR(36)     NULL,
+    currency          VARCHAR(3)      NOT NULL,
+    total_amount      BIGINT          NOT NULL,
+    total_count       INT             NOT NULL,
+    split_mode        VARCHAR(20)     NOT NULL,
+    greeting          VARCHAR(512)    NOT NULL DEFAULT '',
+    status            VARCHAR(20)     NOT NULL,
+    remaining_amount  BIGINT          NOT NULL,
+    remaining_count   INT             NOT NULL,
+    refunded_amount   BIGINT          NOT NULL DEFAULT 0,
+    failure_reason    VARCHAR(255)    NOT NULL DEFAULT '',
+    expires_at        TIMESTAMPTZ     NOT NULL,
+    created_at        TIMESTAMPTZ     NOT NULL,
+    updated_at        TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT pk_red_packets PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_red_packets_room_id ON red_packets (room_id);
+CREATE INDEX idx_red_packets_status_expires_at ON red_packets (status, expires_at);
+
+CREATE TABLE red_packet_claims (
+    packet_id   VARCHAR(36)  NOT NULL,
+    account_id  VARCHAR(36)  NOT NULL,
+    amount      BIGINT       NOT NULL,
+    claimed_at  TIMESTAMPTZ  NOT NULL,
+    settled_at  TIMESTAMPTZ  NULL,
+    CONSTRAINT pk_red_packet_claims PRIMARY KEY (packet_id, account_id),
+    CONSTRAINT fk_red_packet_claims_packet FOREIGN KEY (packet_id) REFERENCES red_packets (id)
+);
+
+ALTER TABLE messages ADD COLUMN red_packet_json TEXT NULL;
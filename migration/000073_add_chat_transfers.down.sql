@@ -0,0 +1,2 @@
+ALTER TABLE messages DROP COLUMN IF EXISTS transfer_json;
+DROP TABLE IF EXISTS chat_transfers;
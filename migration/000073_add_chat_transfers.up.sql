@@ -0,0 +1,23 @@
+CREATE TABLE chat_transfers (
+    id              VARCHAR(36)     NOT NULL,
+    room_id         VARCHAR(1024)   NOT NULL,
+    sender_id       VARCHAR(36)     NOT NULL,
+    recipient_id    VARCHAR(36)     NOT NULL,
+    message_id      VARCHAR(36)     NULL,
+    currency        VARCHAR(3)      NOT NULL,
+    amount          BIGINT          NOT NULL,
+    note            VARCHAR(512)    NOT NULL DEFAULT '',
+    status          VARCHAR(20)     NOT NULL,
+    failure_reason  VARCHAR(255)    NOT NULL DEFAULT '',
+    expires_at      TIMESTAMPTZ     NOT NULL,
+    settled_at      TIMESTAMPTZ     NULL,
+    created_at      TIMESTAMPTZ     NOT NULL,
+    updated_at      TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT pk_chat_transfers PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_chat_transfers_room_id ON chat_transfers (room_id);
+CREATE INDEX idx_chat_transfers_status_expires_at ON chat_transfers (status, expires_at);
+CREATE INDEX idx_chat_transfers_unsettled ON chat_transfers (status, updated_at) WHERE settled_at IS NULL;
+
+ALTER TABLE messages ADD COLUMN transfer_json TEXT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_payment_intents_debit_account_created_at;
+DROP INDEX IF EXISTS idx_payment_intents_credit_account_created_at;
+DROP TABLE IF EXISTS payment_idempotency_keys;
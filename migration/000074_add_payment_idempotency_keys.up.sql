@@ -0,0 +1,14 @@
+CREATE TABLE payment_idempotency_keys (
+    account_id      VARCHAR(1024)   NOT NULL,
+    scope           VARCHAR(32)     NOT NULL,
+    idempotency_key VARCHAR(128)    NOT NULL,
+    transaction_id  VARCHAR(1024)   NOT NULL,
+    created_at      TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT pk_payment_idempotency_keys PRIMARY KEY (account_id, scope, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_intents_credit_account_created_at
+    ON payment_intents(credit_account_id, created_at DESC, transaction_id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_intents_debit_account_created_at
+    ON payment_intents(debit_account_id, created_at DESC, transaction_id DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_payment_intents_provider_created_at;
+DROP TABLE IF EXISTS payment_reconciliation_discrepancies;
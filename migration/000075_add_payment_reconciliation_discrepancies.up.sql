@@ -0,0 +1,27 @@
+CREATE TABLE payment_reconciliation_discrepancies (
+    id               VARCHAR(64)     PRIMARY KEY,
+    discrepancy_key  VARCHAR(2048)   NOT NULL,
+    provider         VARCHAR(64)     NOT NULL,
+    kind             VARCHAR(32)     NOT NULL,
+    transaction_id   VARCHAR(1024)   NOT NULL DEFAULT '',
+    external_ref     VARCHAR(1024)   NOT NULL DEFAULT '',
+    workflow         VARCHAR(32)     NOT NULL DEFAULT '',
+    expected_amount  BIGINT          NOT NULL DEFAULT 0,
+    actual_amount    BIGINT          NOT NULL DEFAULT 0,
+    currency         VARCHAR(16)     NOT NULL DEFAULT '',
+    detail           TEXT            NOT NULL DEFAULT '',
+    status           VARCHAR(16)     NOT NULL,
+    review_note      TEXT            NOT NULL DEFAULT '',
+    reviewed_by      VARCHAR(1024)   NOT NULL DEFAULT '',
+    reviewed_at      TIMESTAMPTZ,
+    detected_at      TIMESTAMPTZ     NOT NULL,
+    last_detected_at TIMESTAMPTZ     NOT NULL,
+    updated_at       TIMESTAMPTZ     NOT NULL,
+    CONSTRAINT uq_payment_reconciliation_discrepancies_key UNIQUE (discrepancy_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_reconciliation_discrepancies_status
+    ON payment_reconciliation_discrepancies(status, last_detected_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_intents_provider_created_at
+    ON payment_intents(provider, created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_ledger_entries_account_currency_created_id;
+
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS reference_id;
+
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS reference_type;
@@ -0,0 +1,27 @@
+ALTER TABLE ledger_entries ADD COLUMN reference_type VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE ledger_entries ADD COLUMN reference_id VARCHAR(1024) NOT NULL DEFAULT '';
+
+ALTER TABLE ledger_entries DISABLE TRIGGER trg_ledger_entries_append_only;
+
+UPDATE ledger_entries le
+SET reference_type = CASE
+        WHEN pt.event_name IN ('EventLedgerAccountTransferredToAccount', 'EventLedgerAccountReceivedTransfer')
+            THEN 'ledger.transfer.internal'
+        ELSE pt.event_name
+    END,
+    reference_id = COALESCE(
+        NULLIF(pt.event_data::jsonb ->> 'payment_id', ''),
+        NULLIF(pt.event_data::jsonb ->> 'transfer_id', ''),
+        NULLIF(pt.event_data::jsonb ->> 'conversion_id', ''),
+        le.transaction_id
+    )
+FROM ledger_posted_transactions pt
+WHERE pt.aggregate_id = le.account_id
+  AND pt.transaction_id = le.transaction_id
+  AND le.reference_type = '';
+
+ALTER TABLE ledger_entries ENABLE TRIGGER trg_ledger_entries_append_only;
+
+CREATE INDEX idx_ledger_entries_account_currency_created_id
+    ON ledger_entries(account_id, currency, created_at, id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ledger_limit_usages_account_operation_created_at;
+DROP TABLE IF EXISTS ledger_limit_usages;
+DROP TABLE IF EXISTS ledger_account_limit_tiers;
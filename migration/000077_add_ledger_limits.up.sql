@@ -0,0 +1,20 @@
+CREATE TABLE ledger_account_limit_tiers (
+    account_id VARCHAR(1024) NOT NULL,
+    tier       VARCHAR(32)   NOT NULL,
+    updated_at TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT pk_ledger_account_limit_tiers PRIMARY KEY (account_id)
+);
+
+CREATE TABLE ledger_limit_usages (
+    id           BIGSERIAL     PRIMARY KEY,
+    account_id   VARCHAR(1024) NOT NULL,
+    operation    VARCHAR(32)   NOT NULL,
+    currency     VARCHAR(16)   NOT NULL,
+    amount       BIGINT        NOT NULL,
+    reference_id VARCHAR(1024) NOT NULL,
+    created_at   TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT uq_ledger_limit_usages_operation_reference UNIQUE (operation, reference_id)
+);
+
+CREATE INDEX idx_ledger_limit_usages_account_operation_created_at
+    ON ledger_limit_usages(account_id, operation, created_at);
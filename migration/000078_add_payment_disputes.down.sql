@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payment_dispute_evidence;
+DROP TABLE IF EXISTS payment_disputes;
@@ -0,0 +1,40 @@
+CREATE TABLE payment_disputes (
+    id                    VARCHAR(64)     PRIMARY KEY,
+    provider              VARCHAR(64)     NOT NULL,
+    provider_dispute_id   VARCHAR(1024)   NOT NULL,
+    transaction_id        VARCHAR(1024)   NOT NULL,
+    account_id            VARCHAR(1024)   NOT NULL,
+    currency              VARCHAR(16)     NOT NULL,
+    amount                BIGINT          NOT NULL,
+    reason                VARCHAR(255)    NOT NULL DEFAULT '',
+    status                VARCHAR(32)     NOT NULL,
+    evidence_due_by       TIMESTAMPTZ,
+    evidence_submitted_at TIMESTAMPTZ,
+    version               INTEGER         NOT NULL DEFAULT 0,
+    opened_at             TIMESTAMPTZ     NOT NULL,
+    updated_at            TIMESTAMPTZ     NOT NULL,
+    closed_at             TIMESTAMPTZ,
+    CONSTRAINT uq_payment_disputes_provider_dispute UNIQUE (provider, provider_dispute_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_disputes_status
+    ON payment_disputes(status, opened_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_disputes_transaction_id
+    ON payment_disputes(transaction_id);
+
+CREATE TABLE payment_dispute_evidence (
+    id            VARCHAR(64)     PRIMARY KEY,
+    dispute_id    VARCHAR(64)     NOT NULL REFERENCES payment_disputes(id),
+    kind          VARCHAR(32)     NOT NULL,
+    file_name     VARCHAR(255)    NOT NULL,
+    content_type  VARCHAR(255)    NOT NULL DEFAULT '',
+    object_key    VARCHAR(2048)   NOT NULL,
+    size          BIGINT          NOT NULL DEFAULT 0,
+    uploaded_by   VARCHAR(1024)   NOT NULL DEFAULT '',
+    submitted_at  TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ     NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_dispute_evidence_dispute_id
+    ON payment_dispute_evidence(dispute_id, created_at);
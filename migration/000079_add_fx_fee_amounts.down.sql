@@ -0,0 +1,3 @@
+ALTER TABLE fx_conversions DROP COLUMN fee_amount;
+
+ALTER TABLE fx_quotes DROP COLUMN fee_amount;
@@ -0,0 +1,3 @@
+ALTER TABLE fx_quotes ADD COLUMN fee_amount BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE fx_conversions ADD COLUMN fee_amount BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE relationship_accounts DROP COLUMN IF EXISTS contact_card_rotated_at;
+ALTER TABLE relationship_accounts DROP COLUMN IF EXISTS contact_card_nonce;
@@ -0,0 +1,9 @@
+ALTER TABLE relationship_accounts ADD COLUMN IF NOT EXISTS contact_card_nonce VARCHAR(64) DEFAULT '' NOT NULL;
+ALTER TABLE relationship_accounts ADD COLUMN IF NOT EXISTS contact_card_rotated_at TIMESTAMPTZ;
+
+-- Later rotations arrive as EventAccountContactCardRotated; existing cards are copied over once.
+UPDATE relationship_accounts ra
+SET contact_card_nonce = a.contact_card_nonce,
+    contact_card_rotated_at = a.contact_card_rotated_at
+FROM accounts a
+WHERE a.id = ra.account_id;
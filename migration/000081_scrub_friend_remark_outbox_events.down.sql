@@ -0,0 +1 @@
+-- no-op: the scrubbed notes cannot be restored; they remain on relationship_friend_contacts.
@@ -0,0 +1,4 @@
+-- Remark events used to carry the owner's private description and phone notes.
+UPDATE relationship_outbox_events
+SET event_data = ((event_data::jsonb) - 'Description' - 'PhoneNotes')::text
+WHERE event_name = 'EventFriendContactRemarkUpdated';
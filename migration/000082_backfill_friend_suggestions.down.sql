@@ -0,0 +1,6 @@
+-- no-op for the backfilled rows; they are rebuilt by the suggestion consumer.
+DROP INDEX IF EXISTS idx_friend_suggestions_profile_viewed;
+
+DROP INDEX IF EXISTS idx_friend_suggestions_user_score;
+
+CREATE INDEX idx_friend_suggestions_user_score ON relationship_friend_suggestions (user_id, score DESC);
@@ -0,0 +1,64 @@
+-- The stored score now carries the profile view bonus, so the ranking can be
+-- read off the index; the view index backs the bonus expiry sweep.
+DROP INDEX IF EXISTS idx_friend_suggestions_user_score;
+
+CREATE INDEX idx_friend_suggestions_user_score ON relationship_friend_suggestions (user_id, score DESC, candidate_id);
+
+CREATE INDEX idx_friend_suggestions_profile_viewed ON relationship_friend_suggestions (last_profile_viewed_at)
+    WHERE profile_view_count > 0;
+
+-- Suggestions were only fed by events, so friendships that existed before
+-- them never produced a row. Count mutual friends and shared rooms for every
+-- pair from the relationship tables. Group rooms are mirrored by running
+-- cmd/room-resync afterwards, which republishes them through the room outbox
+-- so the suggestion consumer recounts their members.
+WITH edges AS (
+    SELECT user_low_id AS user_id, user_high_id AS friend_id FROM relationship_friendships
+    UNION ALL
+    SELECT user_high_id, user_low_id FROM relationship_friendships
+),
+mutual AS (
+    SELECT a.user_id, b.user_id AS candidate_id, COUNT(*) AS mutual_friend_count
+    FROM edges a
+    JOIN edges b ON b.friend_id = a.friend_id AND b.user_id <> a.user_id
+    GROUP BY a.user_id, b.user_id
+),
+counted_rooms AS (
+    SELECT room_id
+    FROM relationship_suggestion_room_members
+    GROUP BY room_id
+    HAVING COUNT(*) <= 100
+),
+shared AS (
+    SELECT a.account_id AS user_id, b.account_id AS candidate_id, COUNT(*) AS shared_room_count
+    FROM relationship_suggestion_room_members a
+    JOIN counted_rooms c ON c.room_id = a.room_id
+    JOIN relationship_suggestion_room_members b ON b.room_id = a.room_id AND b.account_id <> a.account_id
+    GROUP BY a.account_id, b.account_id
+)
+INSERT INTO relationship_friend_suggestions (user_id, candidate_id, mutual_friend_count, shared_room_count, updated_at)
+SELECT
+    COALESCE(m.user_id, s.user_id),
+    COALESCE(m.candidate_id, s.candidate_id),
+    COALESCE(m.mutual_friend_count, 0),
+    COALESCE(s.shared_room_count, 0),
+    NOW()
+FROM mutual m
+FULL OUTER JOIN shared s ON s.user_id = m.user_id AND s.candidate_id = m.candidate_id
+ON CONFLICT (user_id, candidate_id) DO UPDATE SET
+    mutual_friend_count = EXCLUDED.mutual_friend_count,
+    shared_room_count = EXCLUDED.shared_room_count,
+    updated_at = EXCLUDED.updated_at;
+
+UPDATE relationship_friend_suggestions
+SET profile_view_count = CASE
+        WHEN last_profile_viewed_at >= NOW() - INTERVAL '30 days' THEN profile_view_count
+        ELSE 0
+    END,
+    score = mutual_friend_count * 10 + shared_room_count * 4 + CASE
+        WHEN last_profile_viewed_at >= NOW() - INTERVAL '30 days' THEN 3 * LEAST(profile_view_count, 5)
+        ELSE 0
+    END;
+
+DELETE FROM relationship_friend_suggestions
+WHERE mutual_friend_count = 0 AND shared_room_count = 0 AND profile_view_count = 0;
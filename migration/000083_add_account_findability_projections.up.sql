@@ -0,0 +1,14 @@
+CREATE TABLE account_findability_projections (
+    account_id            VARCHAR(36)  NOT NULL,
+    findable_by_username  BOOLEAN      NOT NULL DEFAULT TRUE,
+    findable_by_email     BOOLEAN      NOT NULL DEFAULT TRUE,
+    findable_by_qr        BOOLEAN      NOT NULL DEFAULT TRUE,
+    updated_at            TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_account_findability_projections PRIMARY KEY (account_id)
+);
+
+-- Settings saved before the projection existed never produced an event.
+INSERT INTO account_findability_projections (account_id, findable_by_username, findable_by_email, findable_by_qr, updated_at)
+SELECT account_id, findable_by_username, findable_by_email, findable_by_qr, updated_at
+FROM relationship_friend_request_privacy
+ON CONFLICT (account_id) DO NOTHING;
@@ -0,0 +1,12 @@
+CREATE TABLE room_hidden_cards (
+    account_id  VARCHAR(36)  NOT NULL,
+    hidden_at   TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT pk_room_hidden_cards PRIMARY KEY (account_id)
+);
+
+-- Settings saved before the projection existed never produced an event.
+INSERT INTO room_hidden_cards (account_id, hidden_at)
+SELECT account_id, updated_at
+FROM relationship_friend_request_privacy
+WHERE findable_by_qr = FALSE
+ON CONFLICT (account_id) DO NOTHING;
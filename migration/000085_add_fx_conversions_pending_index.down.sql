@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_fx_conversions_pending;
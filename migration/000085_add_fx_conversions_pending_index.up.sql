@@ -0,0 +1 @@
+CREATE INDEX idx_fx_conversions_pending ON fx_conversions (updated_at) WHERE status = 'pending';
@@ -0,0 +1,5 @@
+ALTER TABLE payment_idempotency_keys
+    DROP COLUMN IF EXISTS result_amount;
+
+ALTER TABLE payment_intents
+    DROP COLUMN IF EXISTS refunded_amount;
@@ -0,0 +1,9 @@
+ALTER TABLE payment_intents
+    ADD COLUMN IF NOT EXISTS refunded_amount BIGINT DEFAULT 0 NOT NULL;
+
+UPDATE payment_intents
+SET refunded_amount = provider_amount
+WHERE status = 'REFUNDED';
+
+ALTER TABLE payment_idempotency_keys
+    ADD COLUMN IF NOT EXISTS result_amount BIGINT DEFAULT 0 NOT NULL;
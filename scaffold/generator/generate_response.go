@@ -110,12 +110,19 @@ func mapResponseFields(fields []models.FieldSpec) []responseField {
 			GoName:   utils.Pascal(f.Name),
 			Type:     fieldType,
 			JSONName: f.Name,
-			JSONTag:  fmt.Sprintf(`json:"%s,omitempty"`, f.Name),
+			JSONTag:  responseJSONTag(f),
 		})
 	}
 	return result
 }
 
+func responseJSONTag(field models.FieldSpec) string {
+	if field.OmitEmpty != nil && !*field.OmitEmpty {
+		return fmt.Sprintf(`json:"%s"`, field.Name)
+	}
+	return fmt.Sprintf(`json:"%s,omitempty"`, field.Name)
+}
+
 func mapNestedStructs(fields []models.FieldSpec) []nestedStruct {
 	result := make([]nestedStruct, 0)
 	seen := make(map[string]bool)
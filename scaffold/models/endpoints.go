@@ -48,6 +48,11 @@ type FieldSpec struct {
 	Items    *Payload `json:"items,omitempty" yaml:"items,omitempty"`
 	Required bool     `json:"required,omitempty" yaml:"required,omitempty"`
 	Pointer  bool     `json:"pointer,omitempty" yaml:"pointer,omitempty"`
+	// Description is copied into the generated OpenAPI document.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// OmitEmpty set to false keeps the field in responses even when it holds
+	// its zero value, e.g. a switch that is off.
+	OmitEmpty *bool `json:"omitempty,omitempty" yaml:"omitempty,omitempty"`
 }
 
 func LoadAPISpec(path string) (*APISpec, error) {
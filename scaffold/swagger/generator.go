@@ -187,8 +187,8 @@ func buildParameters(ep models.Endpoint) []*Parameter {
 			Name:        name,
 			In:          source,
 			Required:    source == "path" || field.Required,
-			Description: fmt.Sprintf("%s parameter", field.Name),
-			Schema:      fieldSchemaForPrefix(endpointTag(ep), field),
+			Description: parameterDescription(field),
+			Schema:      fieldTypeSchema(endpointTag(ep), field),
 		})
 	}
 
@@ -322,6 +322,12 @@ func registerPayloadSchemas(dst map[string]*Schema, name string, payload models.
 }
 
 func fieldSchemaForPrefix(prefix string, field models.FieldSpec) *Schema {
+	schema := fieldTypeSchema(prefix, field)
+	schema.Description = field.Description
+	return schema
+}
+
+func fieldTypeSchema(prefix string, field models.FieldSpec) *Schema {
 	switch strings.ToLower(strings.TrimSpace(field.Type)) {
 	case "string":
 		return &Schema{Type: "string"}
@@ -360,6 +366,13 @@ func fieldSchemaForPrefix(prefix string, field models.FieldSpec) *Schema {
 	}
 }
 
+func parameterDescription(field models.FieldSpec) string {
+	if field.Description != "" {
+		return field.Description
+	}
+	return fmt.Sprintf("%s parameter", field.Name)
+}
+
 func payloadSchema(prefix string, payload models.Payload) *Schema {
 	schema := &Schema{
 		Type:       "object",
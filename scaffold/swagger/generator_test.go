@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"wechat-clone/scaffold/models"
@@ -48,6 +49,7 @@ func TestBuildDocument(t *testing.T) {
 					Fields: []models.FieldSpec{
 						{Name: "provider", Type: "string", Required: true},
 						{Name: "amount", Type: "int64", Required: true},
+						{Name: "quoted_fee_amount", Type: "int64", Pointer: true, Description: "Fee the caller was quoted."},
 						{Name: "metadata", Type: "object"},
 					},
 				},
@@ -112,6 +114,10 @@ func TestBuildDocument(t *testing.T) {
 	if got := createPath.Post.Responses["201"]; got == nil {
 		t.Fatalf("expected 201 response")
 	}
+	createSchema := doc.Components.Schemas[strings.TrimPrefix(createPath.Post.RequestBody.Content["application/json"].Schema.Ref, "#/components/schemas/")]
+	if createSchema == nil || createSchema.Properties["quoted_fee_amount"].Description != "Fee the caller was quoted." {
+		t.Fatalf("expected the field description on the request schema, got %+v", createSchema)
+	}
 
 	roomListPath := doc.Paths["/room/list"]
 	if roomListPath == nil || roomListPath.Get == nil {
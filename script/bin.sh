@@ -58,6 +58,18 @@ run_migrate() {
   go run "$ROOT_DIR/cmd/migrate"
 }
 
+run_ledger_audit() {
+  apply_env
+  echo "Auditing ledger integrity..." >&2
+  go run "$ROOT_DIR/cmd/ledger-audit"
+}
+
+run_account_reindex() {
+  apply_env
+  echo "Reindexing account search..."
+  go run "$ROOT_DIR/cmd/account-reindex" "$@"
+}
+
 case "${1:-}" in
   run)
     run_server
@@ -68,8 +80,15 @@ case "${1:-}" in
   migrate)
     run_migrate
     ;;
+  ledger-audit)
+    run_ledger_audit
+    ;;
+  account-reindex)
+    shift
+    run_account_reindex "$@"
+    ;;
   *)
-    echo "Usage: $0 {run|bootstrap|migrate}"
+    echo "Usage: $0 {run|bootstrap|migrate|ledger-audit|account-reindex}"
     exit 1
     ;;
 esac